@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -42,14 +43,35 @@ func main() {
 	groupRepo := postgres.NewGroupRepository(dbClient.DB)
 	notificationRepo := postgres.NewNotificationRepository(dbClient.DB)
 	venueRepo := postgres.NewVenueRepository(dbClient.DB)
+	groupWebhookRepo := postgres.NewGroupWebhookRepository(dbClient.DB)
+	groupInviteRepo := postgres.NewGroupInviteRepository(dbClient.DB)
+	groupJoinRequestRepo := postgres.NewGroupJoinRequestRepository(dbClient.DB)
+	groupLeagueRepo := postgres.NewGroupLeagueRepository(dbClient.DB)
+	groupAnnouncementRepo := postgres.NewGroupAnnouncementRepository(dbClient.DB)
+	groupModerationRepo := postgres.NewGroupModerationRepository(dbClient.DB)
+	groupCustomRoleRepo := postgres.NewGroupCustomRoleRepository(dbClient.DB)
+	eventCoHostRepo := postgres.NewEventCoHostRepository(dbClient.DB)
+	eventInviteRepo := postgres.NewEventInviteRepository(dbClient.DB)
+	userBlockRepo := postgres.NewUserBlockRepository(dbClient.DB)
+	contentModerationRepo := postgres.NewContentModerationRepository(dbClient.DB)
+	platformAdminRepo := postgres.NewPlatformAdminRepository(dbClient.DB)
+	auditLogRepo := postgres.NewAuditLogRepository(dbClient.DB)
+	consentRepo := postgres.NewConsentRepository(dbClient.DB)
+	dataExportRepo := postgres.NewDataExportRepository(dbClient.DB)
+	accountDeletionRepo := postgres.NewAccountDeletionRepository(dbClient.DB)
+	retentionRepo := postgres.NewRetentionRepository(dbClient.DB)
+	venueClaimRepo := postgres.NewVenueClaimRepository(dbClient.DB)
+	venueDuplicateRepo := postgres.NewVenueDuplicateRepository(dbClient.DB)
+	venueReviewRepo := postgres.NewVenueReviewRepository(dbClient.DB)
+	mapClusterRepo := postgres.NewMapClusterRepository(dbClient.DB)
 
 	// Services
 
-	nominatimCfg := service.NominatimConfig{
-		BaseURL: cfg.Geocoding.NominatimBaseURL,
+	geocodingProvider, err := newGeocodingProvider(cfg.Geocoding)
+	if err != nil {
+		log.Fatalf("Error initialize geocodingProvider: %v", err)
 	}
-	nominatimProvider := service.NewNominatimProvider(nominatimCfg)
-	geoService := service.NewGeocodingService(nominatimProvider)
+	geoService := service.NewGeocodingService(geocodingProvider)
 
 	emailProvider := service.NewSMTPProvider(cfg.Email.SMTPHost, strconv.Itoa(cfg.Email.SMTPPort), cfg.Email.SMTPUser, cfg.Email.SMTPPassword, cfg.Email.FromEmail, cfg.Email.FromName)
 	emailService := service.NewEmailService(emailProvider, cfg.Email.FromEmail, cfg.Email.FromName)
@@ -57,6 +79,17 @@ func main() {
 	templateManager := service.NewNotificationTemplateManager(cfg.Email.BaseURL)
 
 	notificationService := service.NewNotificationService(notificationRepo, userRepo, emailService, templateManager)
+	i18nService := service.NewI18nService()
+
+	discordCfg := service.DiscordConfig{
+		BaseURL:  cfg.Email.BaseURL,
+		Username: cfg.Discord.Username,
+		Timeout:  cfg.Discord.Timeout,
+	}
+	discordAnnouncer := service.NewDiscordAnnouncer(groupWebhookRepo, eventRepo, i18nService, discordCfg)
+
+	notificationTriggers := service.NewNotificationTriggerService(notificationService, eventRepo, groupRepo, userRepo)
+	notificationTriggers.SetGroupEventAnnouncer(discordAnnouncer)
 
 	geospatialService := domain.NewGeospatialService()
 
@@ -93,15 +126,59 @@ func main() {
 	oauthService := service.NewOAuthService(oauthCfg, stateStore, nil)
 	passwordService := service.NewPasswordService(nil)
 	calService := service.NewCalendarService(cfg.Email.BaseURL)
+	auditService := service.NewAuditService(auditLogRepo)
+
+	exportStorage, err := service.NewLocalExportStorage(cfg.Export.StorageDir)
+	if err != nil {
+		log.Fatalf("Error initialize exportStorage: %v", err)
+	}
+	exportLinkSigner := service.NewDataExportLinkSigner(cfg.Export.SigningSecret, cfg.Export.DownloadBaseURL)
 
 	// Use cases
 	ucRegisterUser := usecase.NewRegisterUserUseCase(userRepo, passwordService)
 	ucUpdateProfile := usecase.NewUpdateProfileUseCase(userRepo)
-	ucGetUserProfile := usecase.NewGetUserProfileUseCase(userRepo)
-	ucGDRPCompliance := usecase.NewGDPRComplianceUseCase(userRepo, eventRepo, groupRepo, notificationRepo)
-	ucEventManagement := usecase.NewEventManagementUseCase(eventRepo, venueRepo, groupRepo, geoService, notificationService, geospatialService)
-	ucGroupManagement := usecase.NewGroupManagementUseCase(groupRepo, userRepo, eventRepo)
+	ucGetUserProfile := usecase.NewGetUserProfileUseCase(userRepo, userBlockRepo)
+	ucBlockUser := usecase.NewBlockUserUseCase(userBlockRepo, userRepo)
+	ucUnblockUser := usecase.NewUnblockUserUseCase(userBlockRepo)
+	ucListBlockedUsers := usecase.NewListBlockedUsersUseCase(userBlockRepo)
+	ucGDRPCompliance := usecase.NewGDPRComplianceUseCase(userRepo, eventRepo, groupRepo, notificationRepo, consentRepo, auditLogRepo, eventCoHostRepo, accountDeletionRepo)
+	ucEventManagement := usecase.NewEventManagementUseCase(eventRepo, venueRepo, groupRepo, groupModerationRepo, geoService, notificationService, geospatialService, userRepo, eventCoHostRepo, eventInviteRepo, userBlockRepo)
+	ucGroupManagement := usecase.NewGroupManagementUseCase(groupRepo, userRepo, eventRepo, groupInviteRepo, groupJoinRequestRepo, groupLeagueRepo, groupAnnouncementRepo, groupModerationRepo, groupCustomRoleRepo, userBlockRepo)
 	ucVenueManagement := usecase.NewVenueManagementUseCase(venueRepo, geoService, geospatialService)
+	ucGroupWebhookManagement := usecase.NewGroupWebhookManagementUseCase(groupRepo, groupWebhookRepo)
+	ucContentModeration := usecase.NewContentModerationUseCase(contentModerationRepo, eventRepo, groupRepo, venueRepo, userRepo)
+	ucPlatformAdmin := usecase.NewPlatformAdminUseCase(platformAdminRepo, userRepo, notificationRepo, jwtService, notificationService)
+	ucChangePassword := usecase.NewChangePasswordUseCase(userRepo, passwordService, auditService)
+	ucAuditLog := usecase.NewAuditLogUseCase(auditLogRepo, userRepo)
+	ucDataExport := usecase.NewDataExportUseCase(dataExportRepo, ucGDRPCompliance, exportStorage, exportLinkSigner, service.BuildDataExportArchive)
+	ucDataRetention := usecase.NewDataRetentionUseCase(retentionRepo, accountDeletionRepo, userRepo, exportStorage, []domain.RetentionPolicy{
+		{Entity: domain.RetentionEntityNotifications, MaxAge: cfg.Retention.Notifications},
+		{Entity: domain.RetentionEntityAuditLog, MaxAge: cfg.Retention.AuditLog},
+		{Entity: domain.RetentionEntityInactiveAccounts, MaxAge: cfg.Retention.InactiveAccounts},
+		{Entity: domain.RetentionEntityPastRSVPs, MaxAge: cfg.Retention.PastRSVPs},
+		{Entity: domain.RetentionEntityExportArchives, MaxAge: cfg.Retention.ExportArchives},
+	})
+	ucVenueClaims := usecase.NewVenueClaimsUseCase(venueClaimRepo, venueRepo, eventRepo, userRepo)
+	ucVenueDuplicates := usecase.NewVenueDuplicatesUseCase(venueRepo, venueDuplicateRepo, userRepo)
+	ucVenueReviews := usecase.NewVenueReviewsUseCase(venueReviewRepo, venueRepo)
+	ucMapClusters := usecase.NewMapClustersUseCase(mapClusterRepo)
+	ucMapExport := usecase.NewMapExportUseCase(ucVenueManagement, ucEventManagement)
+
+	ucEventManagement.SetNotificationTriggers(notificationTriggers)
+	ucVenueManagement.SetDuplicateRepository(venueDuplicateRepo)
+	ucGroupManagement.SetNotifier(notificationTriggers)
+	notificationService.SetConsentChecker(ucGDRPCompliance)
+	ucDataExport.SetNotifier(notificationTriggers)
+	ucDataRetention.SetNotifier(notificationTriggers)
+	ucGDRPCompliance.SetNotifier(notificationTriggers)
+	ucGDRPCompliance.SetExportStorage(exportStorage)
+
+	ucEventManagement.SetAuditRecorder(auditService)
+	ucGroupManagement.SetAuditRecorder(auditService)
+	ucVenueManagement.SetAuditRecorder(auditService)
+	ucVenueDuplicates.SetAuditRecorder(auditService)
+	ucGDRPCompliance.SetAuditRecorder(auditService)
+	ucPlatformAdmin.SetAuditRecorder(auditService)
 
 	// Middlewares
 
@@ -152,19 +229,36 @@ func main() {
 
 	routerCfg := handler.RouterConfig{
 		// Use cases
-		RegisterUserUseCase:    ucRegisterUser,
-		UpdateProfileUseCase:   ucUpdateProfile,
-		GetUserProfileUseCase:  ucGetUserProfile,
-		GDPRComplianceUseCase:  ucGDRPCompliance,
-		EventManagementUseCase: ucEventManagement,
-		GroupManagementUseCase: ucGroupManagement,
-		VenueManagementUseCase: ucVenueManagement,
+		RegisterUserUseCase:     ucRegisterUser,
+		UpdateProfileUseCase:    ucUpdateProfile,
+		GetUserProfileUseCase:   ucGetUserProfile,
+		GDPRComplianceUseCase:   ucGDRPCompliance,
+		ChangePasswordUseCase:   ucChangePassword,
+		BlockUserUseCase:        ucBlockUser,
+		UnblockUserUseCase:      ucUnblockUser,
+		ListBlockedUsersUseCase: ucListBlockedUsers,
+		EventManagementUseCase:  ucEventManagement,
+		GroupManagementUseCase:  ucGroupManagement,
+		VenueManagementUseCase:  ucVenueManagement,
+
+		GroupWebhookManagementUseCase: ucGroupWebhookManagement,
+		ContentModerationUseCase:      ucContentModeration,
+		PlatformAdminUseCase:          ucPlatformAdmin,
+		AuditLogUseCase:               ucAuditLog,
+		DataExportUseCase:             ucDataExport,
+		DataRetentionUseCase:          ucDataRetention,
+		VenueClaimsUseCase:            ucVenueClaims,
+		VenueDuplicatesUseCase:        ucVenueDuplicates,
+		VenueReviewsUseCase:           ucVenueReviews,
+		MapClustersUseCase:            ucMapClusters,
+		MapExportUseCase:              ucMapExport,
 
 		// Services
 		JWTService:      jwtService,
 		OAuthService:    oauthService,
 		PasswordService: passwordService,
 		CalendarService: calService,
+		AuditService:    auditService,
 
 		// Repositories (for handlers that need direct access)
 		UserRepository:  userRepo,
@@ -189,6 +283,14 @@ func main() {
 		IdleTimeout:  cfg.Server.IdleTimeout,
 	}
 
+	// Start background workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	go ucDataExport.Scheduler(usecase.DefaultDataExportBatchSize, cfg.Export.ProcessInterval).Start(workerCtx)
+	go ucGDRPCompliance.DeletionScheduler(usecase.DefaultAccountDeletionBatchSize, time.Hour).Start(workerCtx)
+	go ucDataRetention.Scheduler(cfg.Retention.Interval, cfg.Retention.DryRun).Start(workerCtx)
+
 	// Start server in a goroutine
 	go func() {
 		log.Printf("Starting server on port %d", cfg.Server.Port)
@@ -204,6 +306,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutting down server...")
+	stopWorkers()
 
 	// Give outstanding requests 30 seconds to complete
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -216,6 +319,36 @@ func main() {
 	log.Println("Server exited")
 }
 
+// newGeocodingProvider chains the configured geocoding providers in order
+func newGeocodingProvider(cfg config.GeocodingConfig) (service.GeocodingProvider, error) {
+	var providers []service.ChainedProvider
+	for _, name := range cfg.Providers {
+		switch strings.TrimSpace(name) {
+		case "nominatim":
+			providers = append(providers, service.ChainedProvider{
+				Provider:  service.NewNominatimProvider(service.NominatimConfig{BaseURL: cfg.NominatimBaseURL}),
+				RateLimit: cfg.RateLimit,
+			})
+		case "photon":
+			providers = append(providers, service.ChainedProvider{
+				Provider:  service.NewPhotonProvider(service.PhotonConfig{BaseURL: cfg.PhotonBaseURL}),
+				RateLimit: cfg.PhotonRateLimit,
+			})
+		case "geonames":
+			geoNamesProvider, err := service.LoadGeoNamesProvider(cfg.GeoNamesCitiesFile)
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, service.ChainedProvider{Provider: geoNamesProvider})
+		}
+	}
+
+	return service.NewChainProvider(service.ChainConfig{
+		FailureThreshold: cfg.FailureThreshold,
+		Cooldown:         cfg.CircuitCooldown,
+	}, providers...), nil
+}
+
 // initializeBasicRouter creates a basic router with health check and API docs
 func initializeBasicRouter() *mux.Router {
 	router := mux.NewRouter()
@@ -18,7 +18,10 @@ type Config struct {
 	OAuth     OAuthConfig
 	Email     EmailConfig
 	Geocoding GeocodingConfig
+	Discord   DiscordConfig
 	CORS      CORSConfig
+	Export    DataExportConfig
+	Retention RetentionConfig
 }
 
 // ServerConfig holds server-related configuration
@@ -71,8 +74,20 @@ type EmailConfig struct {
 
 // GeocodingConfig holds geocoding service configuration
 type GeocodingConfig struct {
-	NominatimBaseURL string
-	RateLimit        time.Duration
+	Providers          []string // Tried in order; any of nominatim, photon and geonames
+	NominatimBaseURL   string
+	RateLimit          time.Duration // Minimum time between Nominatim calls
+	PhotonBaseURL      string
+	PhotonRateLimit    time.Duration
+	GeoNamesCitiesFile string        // GeoNames city dataset for offline city-level lookups
+	FailureThreshold   int           // Consecutive failures that take a provider out of the chain
+	CircuitCooldown    time.Duration // How long a failing provider stays out of the chain
+}
+
+// DiscordConfig holds Discord webhook integration configuration
+type DiscordConfig struct {
+	Username string
+	Timeout  time.Duration
 }
 
 // CORSConfig holds CORS configuration
@@ -82,6 +97,25 @@ type CORSConfig struct {
 	AllowedHeaders []string
 }
 
+// DataExportConfig holds data export archive configuration
+type DataExportConfig struct {
+	StorageDir      string
+	SigningSecret   string
+	DownloadBaseURL string
+	ProcessInterval time.Duration
+}
+
+// RetentionConfig holds data retention configuration. A zero duration disables the entity's policy.
+type RetentionConfig struct {
+	Interval         time.Duration
+	DryRun           bool
+	Notifications    time.Duration
+	AuditLog         time.Duration
+	InactiveAccounts time.Duration // Opt-in: schedules deletion of accounts that haven't logged in for this long
+	PastRSVPs        time.Duration // Removes replies to finished events other than attendance, which stays for venue reviews
+	ExportArchives   time.Duration
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file if it exists (ignore error if file doesn't exist)
@@ -127,14 +161,44 @@ func Load() (*Config, error) {
 			BaseURL:      getEnv("BASE_URL", ""),
 		},
 		Geocoding: GeocodingConfig{
-			NominatimBaseURL: getEnv("NOMINATIM_BASE_URL", "https://nominatim.openstreetmap.org"),
-			RateLimit:        getEnvAsDuration("GEOCODING_RATE_LIMIT", 1*time.Second),
+			Providers:          getEnvAsSlice("GEOCODING_PROVIDERS", []string{"nominatim", "photon"}),
+			NominatimBaseURL:   getEnv("NOMINATIM_BASE_URL", "https://nominatim.openstreetmap.org"),
+			RateLimit:          getEnvAsDuration("GEOCODING_RATE_LIMIT", 1*time.Second),
+			PhotonBaseURL:      getEnv("PHOTON_BASE_URL", "https://photon.komoot.io"),
+			PhotonRateLimit:    getEnvAsDuration("PHOTON_RATE_LIMIT", 1*time.Second),
+			GeoNamesCitiesFile: getEnv("GEONAMES_CITIES_FILE", ""),
+			FailureThreshold:   getEnvAsInt("GEOCODING_FAILURE_THRESHOLD", 3),
+			CircuitCooldown:    getEnvAsDuration("GEOCODING_CIRCUIT_COOLDOWN", 1*time.Minute),
+		},
+		Discord: DiscordConfig{
+			Username: getEnv("DISCORD_USERNAME", "MatchTCG"),
+			Timeout:  getEnvAsDuration("DISCORD_TIMEOUT", 10*time.Second),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: getEnvAsSlice("CORS_ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
 			AllowedMethods: getEnvAsSlice("CORS_ALLOWED_METHODS", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}),
 			AllowedHeaders: getEnvAsSlice("CORS_ALLOWED_HEADERS", []string{"Content-Type", "Authorization"}),
 		},
+		Export: DataExportConfig{
+			StorageDir:      getEnv("DATA_EXPORT_DIR", "data/exports"),
+			SigningSecret:   getEnv("DATA_EXPORT_SIGNING_SECRET", ""),
+			DownloadBaseURL: getEnv("DATA_EXPORT_DOWNLOAD_BASE_URL", "http://localhost:8080/api/v1"),
+			ProcessInterval: getEnvAsDuration("DATA_EXPORT_PROCESS_INTERVAL", 1*time.Minute),
+		},
+		Retention: RetentionConfig{
+			Interval:         getEnvAsDuration("RETENTION_INTERVAL", 24*time.Hour),
+			DryRun:           getEnvAsBool("RETENTION_DRY_RUN", false),
+			Notifications:    getEnvAsDuration("RETENTION_NOTIFICATIONS", 90*24*time.Hour),
+			AuditLog:         getEnvAsDuration("RETENTION_AUDIT_LOG", 2*365*24*time.Hour),
+			InactiveAccounts: getEnvAsDuration("RETENTION_INACTIVE_ACCOUNTS", 0),
+			PastRSVPs:        getEnvAsDuration("RETENTION_PAST_RSVPS", 2*365*24*time.Hour),
+			ExportArchives:   getEnvAsDuration("RETENTION_EXPORT_ARCHIVES", 7*24*time.Hour),
+		},
+	}
+
+	// Download links are signed with the JWT secret unless a dedicated secret is configured
+	if cfg.Export.SigningSecret == "" {
+		cfg.Export.SigningSecret = cfg.JWT.Secret
 	}
 
 	// Validate required configuration
@@ -156,6 +220,17 @@ func (c *Config) validate() error {
 	if c.Database.URL == "" {
 		return fmt.Errorf("DATABASE_URL is required")
 	}
+	for _, provider := range c.Geocoding.Providers {
+		switch strings.TrimSpace(provider) {
+		case "nominatim", "photon":
+		case "geonames":
+			if c.Geocoding.GeoNamesCitiesFile == "" {
+				return fmt.Errorf("GEONAMES_CITIES_FILE is required for the geonames geocoding provider")
+			}
+		default:
+			return fmt.Errorf("unknown geocoding provider %q in GEOCODING_PROVIDERS", provider)
+		}
+	}
 	return nil
 }
 
@@ -186,6 +261,15 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
 func getEnvAsSlice(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
 		return strings.Split(value, ",")
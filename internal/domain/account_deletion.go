@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountDeletionStatus represents the state of a scheduled account deletion
+type AccountDeletionStatus string
+
+const (
+	AccountDeletionStatusScheduled AccountDeletionStatus = "scheduled"
+	AccountDeletionStatusCancelled AccountDeletionStatus = "cancelled"
+	AccountDeletionStatusCompleted AccountDeletionStatus = "completed"
+)
+
+// AccountDeletion represents a user's request to delete their account. The deletion is carried out
+// once ScheduledFor has passed unless the user cancels it first.
+type AccountDeletion struct {
+	ID           uuid.UUID              `json:"id" db:"id"`
+	UserID       uuid.UUID              `json:"user_id" db:"user_id"`
+	Status       AccountDeletionStatus  `json:"status" db:"status"`
+	RequestedAt  time.Time              `json:"requested_at" db:"requested_at"`
+	ScheduledFor time.Time              `json:"scheduled_for" db:"scheduled_for"`
+	CancelledAt  *time.Time             `json:"cancelled_at,omitempty" db:"cancelled_at"`
+	CompletedAt  *time.Time             `json:"completed_at,omitempty" db:"completed_at"`
+	Report       *AccountDeletionReport `json:"report,omitempty" db:"report"`
+}
+
+// AccountDeletionReport records what happened to the data a deleted account left behind
+type AccountDeletionReport struct {
+	EventHandovers     []EventHandover `json:"event_handovers"`
+	CancelledEventIDs  []uuid.UUID     `json:"cancelled_event_ids"`
+	AnonymizedEventIDs []uuid.UUID     `json:"anonymized_event_ids"`
+	GroupTransfers     []GroupTransfer `json:"group_transfers"`
+	DeletedGroupIDs    []uuid.UUID     `json:"deleted_group_ids"`
+	RemovedRSVPs       int             `json:"removed_rsvps"`
+	RemovedMemberships int             `json:"removed_memberships"`
+}
+
+// EventHandover records a future event passed to a new host
+type EventHandover struct {
+	EventID       uuid.UUID `json:"event_id"`
+	NewHostUserID uuid.UUID `json:"new_host_user_id"`
+}
+
+// GroupTransfer records a group passed to a new owner
+type GroupTransfer struct {
+	GroupID        uuid.UUID `json:"group_id"`
+	NewOwnerUserID uuid.UUID `json:"new_owner_user_id"`
+}
+
+var (
+	ErrInvalidAccountDeletionStatus = errors.New("invalid account deletion status")
+	ErrAccountDeletionNotScheduled  = errors.New("account deletion is not scheduled")
+	ErrAccountDeletionSchedule      = errors.New("account deletion cannot be scheduled before it was requested")
+)
+
+// Validate validates the AccountDeletion entity
+func (d *AccountDeletion) Validate() error {
+	if !IsValidAccountDeletionStatus(d.Status) {
+		return ErrInvalidAccountDeletionStatus
+	}
+
+	if d.ScheduledFor.Before(d.RequestedAt) {
+		return ErrAccountDeletionSchedule
+	}
+
+	return nil
+}
+
+// IsScheduled reports whether the deletion is still waiting to be carried out
+func (d *AccountDeletion) IsScheduled() bool {
+	return d.Status == AccountDeletionStatusScheduled
+}
+
+// IsDue reports whether the grace period is over and the deletion should be carried out
+func (d *AccountDeletion) IsDue(now time.Time) bool {
+	return d.IsScheduled() && !now.Before(d.ScheduledFor)
+}
+
+// Cancel withdraws a scheduled deletion
+func (d *AccountDeletion) Cancel(now time.Time) error {
+	if !d.IsScheduled() {
+		return ErrAccountDeletionNotScheduled
+	}
+
+	d.Status = AccountDeletionStatusCancelled
+	d.CancelledAt = &now
+	return nil
+}
+
+// MarkCompleted records that the deletion was carried out and what it did
+func (d *AccountDeletion) MarkCompleted(report *AccountDeletionReport, now time.Time) {
+	d.Status = AccountDeletionStatusCompleted
+	d.Report = report
+	d.CompletedAt = &now
+}
+
+// IsValidAccountDeletionStatus checks if the account deletion status is valid
+func IsValidAccountDeletionStatus(status AccountDeletionStatus) bool {
+	switch status {
+	case AccountDeletionStatusScheduled, AccountDeletionStatusCancelled, AccountDeletionStatusCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewAccountDeletion schedules the deletion of a user's account once the grace period has passed
+func NewAccountDeletion(userID uuid.UUID, now time.Time, gracePeriod time.Duration) *AccountDeletion {
+	return &AccountDeletion{
+		ID:           uuid.New(),
+		UserID:       userID,
+		Status:       AccountDeletionStatusScheduled,
+		RequestedAt:  now,
+		ScheduledFor: now.Add(gracePeriod),
+	}
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAccountDeletion_Validate(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		deletion AccountDeletion
+		wantErr  error
+	}{
+		{
+			name:     "valid scheduled deletion",
+			deletion: AccountDeletion{Status: AccountDeletionStatusScheduled, RequestedAt: now, ScheduledFor: now.Add(time.Hour)},
+			wantErr:  nil,
+		},
+		{
+			name:     "invalid status",
+			deletion: AccountDeletion{Status: "pending", RequestedAt: now, ScheduledFor: now},
+			wantErr:  ErrInvalidAccountDeletionStatus,
+		},
+		{
+			name:     "scheduled before request",
+			deletion: AccountDeletion{Status: AccountDeletionStatusScheduled, RequestedAt: now, ScheduledFor: now.Add(-time.Hour)},
+			wantErr:  ErrAccountDeletionSchedule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.deletion.Validate(); err != tt.wantErr {
+				t.Errorf("AccountDeletion.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccountDeletion_Lifecycle(t *testing.T) {
+	now := time.Now()
+	deletion := NewAccountDeletion(uuid.New(), now, 24*time.Hour)
+
+	if !deletion.IsScheduled() {
+		t.Errorf("new deletion should be scheduled")
+	}
+	if deletion.IsDue(now.Add(time.Hour)) {
+		t.Errorf("deletion should not be due during the grace period")
+	}
+	if !deletion.IsDue(now.Add(24 * time.Hour)) {
+		t.Errorf("deletion should be due once the grace period is over")
+	}
+
+	if err := deletion.Cancel(now); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if deletion.Status != AccountDeletionStatusCancelled || deletion.CancelledAt == nil {
+		t.Errorf("Status = %v, want cancelled with a cancellation time", deletion.Status)
+	}
+	if deletion.IsDue(now.Add(48 * time.Hour)) {
+		t.Errorf("cancelled deletion should never be due")
+	}
+	if err := deletion.Cancel(now); err != ErrAccountDeletionNotScheduled {
+		t.Errorf("Cancel() twice error = %v, want %v", err, ErrAccountDeletionNotScheduled)
+	}
+
+	completed := NewAccountDeletion(uuid.New(), now, 0)
+	completed.MarkCompleted(&AccountDeletionReport{RemovedRSVPs: 2}, now)
+	if completed.Status != AccountDeletionStatusCompleted || completed.CompletedAt == nil || completed.Report.RemovedRSVPs != 2 {
+		t.Errorf("MarkCompleted() did not record the report")
+	}
+}
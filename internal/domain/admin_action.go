@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdminActionType represents an operation a platform admin performed on an account
+type AdminActionType string
+
+const (
+	AdminActionDeactivateUser     AdminActionType = "deactivate_user"
+	AdminActionReactivateUser     AdminActionType = "reactivate_user"
+	AdminActionChangeRole         AdminActionType = "change_role"
+	AdminActionImpersonateUser    AdminActionType = "impersonate_user"
+	AdminActionResendNotification AdminActionType = "resend_notification"
+)
+
+// MaxAdminActionReasonLength bounds the free-text reason recorded with an admin action
+const MaxAdminActionReasonLength = 500
+
+// AdminAction represents an entry in the platform admin audit trail
+type AdminAction struct {
+	ID           uuid.UUID              `json:"id" db:"id"`
+	AdminID      uuid.UUID              `json:"admin_id" db:"admin_id"`
+	Action       AdminActionType        `json:"action" db:"action"`
+	TargetUserID uuid.UUID              `json:"target_user_id" db:"target_user_id"`
+	Reason       *string                `json:"reason,omitempty" db:"reason"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty" db:"metadata"`
+	CreatedAt    time.Time              `json:"created_at" db:"created_at"`
+}
+
+var (
+	ErrInvalidAdminAction          = errors.New("invalid admin action")
+	ErrAdminActionTargetRequired   = errors.New("admin action target user is required")
+	ErrAdminActionReasonTooLong    = errors.New("admin action reason must be 500 characters or less")
+	ErrImpersonationReasonRequired = errors.New("a reason is required to impersonate a user")
+	ErrAdminCannotTargetSelf       = errors.New("admins cannot perform this action on their own account")
+	ErrInvalidPlatformRole         = errors.New("invalid platform role")
+)
+
+// Validate validates the AdminAction entity
+func (a *AdminAction) Validate() error {
+	if a.AdminID == uuid.Nil {
+		return ErrModerationActorRequired
+	}
+
+	if !IsValidAdminActionType(a.Action) {
+		return ErrInvalidAdminAction
+	}
+
+	if a.TargetUserID == uuid.Nil {
+		return ErrAdminActionTargetRequired
+	}
+
+	if a.Reason != nil && len(*a.Reason) > MaxAdminActionReasonLength {
+		return ErrAdminActionReasonTooLong
+	}
+
+	if a.Action == AdminActionImpersonateUser && (a.Reason == nil || *a.Reason == "") {
+		return ErrImpersonationReasonRequired
+	}
+
+	return nil
+}
+
+// IsValidAdminActionType checks if the admin action type is valid
+func IsValidAdminActionType(action AdminActionType) bool {
+	switch action {
+	case AdminActionDeactivateUser, AdminActionReactivateUser, AdminActionChangeRole,
+		AdminActionImpersonateUser, AdminActionResendNotification:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewAdminAction creates an admin audit trail entry
+func NewAdminAction(adminID uuid.UUID, action AdminActionType, targetUserID uuid.UUID, reason *string, metadata map[string]interface{}, now time.Time) *AdminAction {
+	return &AdminAction{
+		ID:           uuid.New(),
+		AdminID:      adminID,
+		Action:       action,
+		TargetUserID: targetUserID,
+		Reason:       reason,
+		Metadata:     metadata,
+		CreatedAt:    now,
+	}
+}
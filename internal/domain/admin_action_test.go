@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAdminAction_Validate(t *testing.T) {
+	adminID := uuid.New()
+	reason := "Support ticket #4521"
+	emptyReason := ""
+	longReason := strings.Repeat("a", 501)
+
+	tests := []struct {
+		name    string
+		action  AdminAction
+		wantErr error
+	}{
+		{
+			name:    "valid deactivation",
+			action:  AdminAction{AdminID: adminID, Action: AdminActionDeactivateUser, TargetUserID: uuid.New()},
+			wantErr: nil,
+		},
+		{
+			name:    "valid impersonation",
+			action:  AdminAction{AdminID: adminID, Action: AdminActionImpersonateUser, TargetUserID: uuid.New(), Reason: &reason},
+			wantErr: nil,
+		},
+		{
+			name:    "missing admin",
+			action:  AdminAction{Action: AdminActionReactivateUser, TargetUserID: uuid.New()},
+			wantErr: ErrModerationActorRequired,
+		},
+		{
+			name:    "invalid action",
+			action:  AdminAction{AdminID: adminID, Action: "delete_everything", TargetUserID: uuid.New()},
+			wantErr: ErrInvalidAdminAction,
+		},
+		{
+			name:    "missing target",
+			action:  AdminAction{AdminID: adminID, Action: AdminActionChangeRole},
+			wantErr: ErrAdminActionTargetRequired,
+		},
+		{
+			name:    "reason too long",
+			action:  AdminAction{AdminID: adminID, Action: AdminActionDeactivateUser, TargetUserID: uuid.New(), Reason: &longReason},
+			wantErr: ErrAdminActionReasonTooLong,
+		},
+		{
+			name:    "impersonation without reason",
+			action:  AdminAction{AdminID: adminID, Action: AdminActionImpersonateUser, TargetUserID: uuid.New(), Reason: &emptyReason},
+			wantErr: ErrImpersonationReasonRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.action.Validate(); err != tt.wantErr {
+				t.Errorf("AdminAction.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction represents a security or data change recorded in the audit log
+type AuditAction string
+
+const (
+	AuditActionLogin          AuditAction = "login"
+	AuditActionLoginFailed    AuditAction = "login_failed"
+	AuditActionPasswordChange AuditAction = "password_change"
+	AuditActionRoleChange     AuditAction = "role_change"
+	AuditActionEventUpdate    AuditAction = "event_update"
+	AuditActionEventDelete    AuditAction = "event_delete"
+	AuditActionRSVPChange     AuditAction = "rsvp_change"
+	AuditActionGroupDelete    AuditAction = "group_delete"
+	AuditActionVenueDelete    AuditAction = "venue_delete"
+	AuditActionVenueMerge     AuditAction = "venue_merge"
+	AuditActionAccountDelete  AuditAction = "account_delete"
+)
+
+// AuditTargetType represents the kind of record an audit log entry is about
+type AuditTargetType string
+
+const (
+	AuditTargetUser  AuditTargetType = "user"
+	AuditTargetEvent AuditTargetType = "event"
+	AuditTargetGroup AuditTargetType = "group"
+	AuditTargetVenue AuditTargetType = "venue"
+)
+
+// auditIgnoredFields are bookkeeping fields left out of before/after diffs
+var auditIgnoredFields = map[string]bool{
+	"updated_at": true,
+}
+
+// AuditLogEntry represents an immutable record of who changed what.
+// ActorID is nil for changes made by the system or by unauthenticated callers, such as a failed login.
+// SubjectUserID is the account the change concerns and is what users query for their own history.
+// ImpersonatorID is the admin who acted as ActorID through a support session, if any.
+type AuditLogEntry struct {
+	ID             uuid.UUID              `json:"id" db:"id"`
+	ActorID        *uuid.UUID             `json:"actor_id,omitempty" db:"actor_id"`
+	ImpersonatorID *uuid.UUID             `json:"impersonator_id,omitempty" db:"impersonator_id"`
+	Action         AuditAction            `json:"action" db:"action"`
+	TargetType     AuditTargetType        `json:"target_type" db:"target_type"`
+	TargetID       uuid.UUID              `json:"target_id" db:"target_id"`
+	SubjectUserID  *uuid.UUID             `json:"subject_user_id,omitempty" db:"subject_user_id"`
+	Before         map[string]interface{} `json:"before,omitempty" db:"before_state"`
+	After          map[string]interface{} `json:"after,omitempty" db:"after_state"`
+	RequestID      *string                `json:"request_id,omitempty" db:"request_id"`
+	CreatedAt      time.Time              `json:"created_at" db:"created_at"`
+}
+
+// AuditLogFilter narrows an audit log query. Zero-valued fields are not filtered on.
+type AuditLogFilter struct {
+	// AccountID matches entries the user performed or that concern their account
+	AccountID  *uuid.UUID       `json:"account_id,omitempty"`
+	ActorID    *uuid.UUID       `json:"actor_id,omitempty"`
+	Action     *AuditAction     `json:"action,omitempty"`
+	TargetType *AuditTargetType `json:"target_type,omitempty"`
+	TargetID   *uuid.UUID       `json:"target_id,omitempty"`
+	RequestID  *string          `json:"request_id,omitempty"`
+}
+
+var (
+	ErrInvalidAuditAction     = errors.New("invalid audit action")
+	ErrInvalidAuditTargetType = errors.New("invalid audit target type")
+	ErrAuditTargetRequired    = errors.New("audit log target is required")
+)
+
+// Validate validates the AuditLogEntry entity
+func (e *AuditLogEntry) Validate() error {
+	if !IsValidAuditAction(e.Action) {
+		return ErrInvalidAuditAction
+	}
+
+	if !IsValidAuditTargetType(e.TargetType) {
+		return ErrInvalidAuditTargetType
+	}
+
+	if e.TargetID == uuid.Nil {
+		return ErrAuditTargetRequired
+	}
+
+	return nil
+}
+
+// SetChanges records the fields that differ between two snapshots of a record.
+// A nil before or after keeps the full other snapshot, so deletions retain what was removed.
+func (e *AuditLogEntry) SetChanges(before, after interface{}) error {
+	beforeMap, err := auditSnapshot(before)
+	if err != nil {
+		return err
+	}
+
+	afterMap, err := auditSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	if beforeMap == nil || afterMap == nil {
+		e.Before = beforeMap
+		e.After = afterMap
+		return nil
+	}
+
+	e.Before = make(map[string]interface{})
+	e.After = make(map[string]interface{})
+
+	for key, oldValue := range beforeMap {
+		newValue, ok := afterMap[key]
+		if !ok || !reflect.DeepEqual(oldValue, newValue) {
+			e.Before[key] = oldValue
+			if ok {
+				e.After[key] = newValue
+			}
+		}
+	}
+
+	for key, newValue := range afterMap {
+		if _, ok := beforeMap[key]; !ok {
+			e.After[key] = newValue
+		}
+	}
+
+	return nil
+}
+
+// HasChanges reports whether the entry carries any before or after state
+func (e *AuditLogEntry) HasChanges() bool {
+	return len(e.Before) > 0 || len(e.After) > 0
+}
+
+// auditSnapshot converts a record into a JSON-shaped map, honouring its json tags so hidden fields stay out
+func auditSnapshot(value interface{}) (map[string]interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot map[string]interface{}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+
+	for field := range auditIgnoredFields {
+		delete(snapshot, field)
+	}
+
+	return snapshot, nil
+}
+
+// IsValidAuditAction checks if the audit action is valid
+func IsValidAuditAction(action AuditAction) bool {
+	switch action {
+	case AuditActionLogin, AuditActionLoginFailed, AuditActionPasswordChange, AuditActionRoleChange,
+		AuditActionEventUpdate, AuditActionEventDelete, AuditActionRSVPChange,
+		AuditActionGroupDelete, AuditActionVenueDelete, AuditActionVenueMerge, AuditActionAccountDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidAuditTargetType checks if the audit target type is valid
+func IsValidAuditTargetType(targetType AuditTargetType) bool {
+	switch targetType {
+	case AuditTargetUser, AuditTargetEvent, AuditTargetGroup, AuditTargetVenue:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewAuditLogEntry creates an audit log entry without before/after state
+func NewAuditLogEntry(actorID *uuid.UUID, action AuditAction, targetType AuditTargetType, targetID uuid.UUID, subjectUserID *uuid.UUID, now time.Time) *AuditLogEntry {
+	return &AuditLogEntry{
+		ID:            uuid.New(),
+		ActorID:       actorID,
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+		SubjectUserID: subjectUserID,
+		CreatedAt:     now,
+	}
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAuditLogEntry_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   AuditLogEntry
+		wantErr error
+	}{
+		{
+			name:    "valid event update",
+			entry:   AuditLogEntry{Action: AuditActionEventUpdate, TargetType: AuditTargetEvent, TargetID: uuid.New()},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid action",
+			entry:   AuditLogEntry{Action: "event_view", TargetType: AuditTargetEvent, TargetID: uuid.New()},
+			wantErr: ErrInvalidAuditAction,
+		},
+		{
+			name:    "invalid target type",
+			entry:   AuditLogEntry{Action: AuditActionLogin, TargetType: "session", TargetID: uuid.New()},
+			wantErr: ErrInvalidAuditTargetType,
+		},
+		{
+			name:    "missing target",
+			entry:   AuditLogEntry{Action: AuditActionLogin, TargetType: AuditTargetUser},
+			wantErr: ErrAuditTargetRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.entry.Validate(); err != tt.wantErr {
+				t.Errorf("AuditLogEntry.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuditLogEntry_SetChanges(t *testing.T) {
+	capacity := 8
+	before := &Event{
+		ID:        uuid.New(),
+		Title:     "Friday Draft",
+		Capacity:  &capacity,
+		UpdatedAt: time.Now().Add(-time.Hour),
+	}
+	after := *before
+	after.Title = "Friday Night Draft"
+	after.Capacity = nil
+	after.UpdatedAt = time.Now()
+
+	t.Run("update keeps changed fields only", func(t *testing.T) {
+		entry := AuditLogEntry{}
+		if err := entry.SetChanges(before, &after); err != nil {
+			t.Fatalf("SetChanges() error = %v", err)
+		}
+
+		if entry.Before["title"] != "Friday Draft" || entry.After["title"] != "Friday Night Draft" {
+			t.Errorf("title diff = %v -> %v", entry.Before["title"], entry.After["title"])
+		}
+		if entry.Before["capacity"] != float64(8) {
+			t.Errorf("Before[capacity] = %v, want 8", entry.Before["capacity"])
+		}
+		if _, ok := entry.After["capacity"]; ok {
+			t.Errorf("After[capacity] should be absent when the field was cleared")
+		}
+		if _, ok := entry.Before["updated_at"]; ok {
+			t.Errorf("updated_at should not be part of the diff")
+		}
+		if _, ok := entry.Before["id"]; ok {
+			t.Errorf("unchanged fields should not be part of the diff")
+		}
+	})
+
+	t.Run("deletion keeps full snapshot", func(t *testing.T) {
+		entry := AuditLogEntry{}
+		var deleted *Event
+		if err := entry.SetChanges(before, deleted); err != nil {
+			t.Fatalf("SetChanges() error = %v", err)
+		}
+
+		if entry.Before["id"] != before.ID.String() {
+			t.Errorf("Before[id] = %v, want %v", entry.Before["id"], before.ID)
+		}
+		if entry.After != nil {
+			t.Errorf("After = %v, want nil", entry.After)
+		}
+	})
+
+	t.Run("hidden fields are not recorded", func(t *testing.T) {
+		entry := AuditLogEntry{}
+		user := &User{ID: uuid.New(), Email: "a@example.com", PasswordHash: "secret"}
+		if err := entry.SetChanges(user, nil); err != nil {
+			t.Fatalf("SetChanges() error = %v", err)
+		}
+
+		for key, value := range entry.Before {
+			if value == "secret" {
+				t.Errorf("Before[%s] leaked the password hash", key)
+			}
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ConsentType represents a purpose a user can grant or revoke consent for
+type ConsentType string
+
+const (
+	ConsentTypeMarketingEmails   ConsentType = "marketing_emails"
+	ConsentTypeAnalytics         ConsentType = "analytics"
+	ConsentTypeThirdPartySharing ConsentType = "third_party_sharing"
+)
+
+// CurrentPolicyVersion is the privacy policy version recorded when a client does not send one
+const CurrentPolicyVersion = "2025-10"
+
+const (
+	maxConsentPolicyVersionLength = 50
+	maxConsentIPAddressLength     = 64
+	maxConsentUserAgentLength     = 512
+)
+
+// ConsentRecord represents one grant or revocation of consent.
+// Records are append-only; a user's current consent for a type is their most recent record.
+type ConsentRecord struct {
+	ID            uuid.UUID              `json:"id" db:"id"`
+	UserID        uuid.UUID              `json:"user_id" db:"user_id"`
+	ConsentType   ConsentType            `json:"consent_type" db:"consent_type"`
+	Granted       bool                   `json:"granted" db:"granted"`
+	PolicyVersion string                 `json:"policy_version" db:"policy_version"`
+	IPAddress     *string                `json:"ip_address,omitempty" db:"ip_address"`
+	UserAgent     *string                `json:"user_agent,omitempty" db:"user_agent"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty" db:"metadata"`
+	CreatedAt     time.Time              `json:"created_at" db:"created_at"`
+}
+
+var (
+	ErrInvalidConsentType           = errors.New("invalid consent type")
+	ErrConsentPolicyVersionRequired = errors.New("consent policy version is required")
+	ErrConsentPolicyVersionTooLong  = errors.New("consent policy version must be at most 50 characters")
+)
+
+// Validate validates the ConsentRecord entity
+func (c *ConsentRecord) Validate() error {
+	if !IsValidConsentType(c.ConsentType) {
+		return ErrInvalidConsentType
+	}
+
+	if c.PolicyVersion == "" {
+		return ErrConsentPolicyVersionRequired
+	}
+
+	if len(c.PolicyVersion) > maxConsentPolicyVersionLength {
+		return ErrConsentPolicyVersionTooLong
+	}
+
+	return nil
+}
+
+// IsValidConsentType checks if the consent type is valid
+func IsValidConsentType(consentType ConsentType) bool {
+	switch consentType {
+	case ConsentTypeMarketingEmails, ConsentTypeAnalytics, ConsentTypeThirdPartySharing:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewConsentRecord creates a consent record, trimming request details that exceed their column sizes
+func NewConsentRecord(userID uuid.UUID, consentType ConsentType, granted bool, policyVersion, ipAddress, userAgent string, now time.Time) *ConsentRecord {
+	record := &ConsentRecord{
+		ID:            uuid.New(),
+		UserID:        userID,
+		ConsentType:   consentType,
+		Granted:       granted,
+		PolicyVersion: policyVersion,
+		CreatedAt:     now,
+	}
+
+	if record.PolicyVersion == "" {
+		record.PolicyVersion = CurrentPolicyVersion
+	}
+
+	if ipAddress != "" {
+		if len(ipAddress) > maxConsentIPAddressLength {
+			ipAddress = ipAddress[:maxConsentIPAddressLength]
+		}
+		record.IPAddress = &ipAddress
+	}
+
+	if userAgent != "" {
+		if len(userAgent) > maxConsentUserAgentLength {
+			userAgent = userAgent[:maxConsentUserAgentLength]
+		}
+		record.UserAgent = &userAgent
+	}
+
+	return record
+}
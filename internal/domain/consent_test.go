@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestConsentRecord_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		record  ConsentRecord
+		wantErr error
+	}{
+		{
+			name:    "valid marketing consent",
+			record:  ConsentRecord{ConsentType: ConsentTypeMarketingEmails, Granted: true, PolicyVersion: "2025-10"},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid consent type",
+			record:  ConsentRecord{ConsentType: "newsletter", PolicyVersion: "2025-10"},
+			wantErr: ErrInvalidConsentType,
+		},
+		{
+			name:    "missing policy version",
+			record:  ConsentRecord{ConsentType: ConsentTypeAnalytics},
+			wantErr: ErrConsentPolicyVersionRequired,
+		},
+		{
+			name:    "policy version too long",
+			record:  ConsentRecord{ConsentType: ConsentTypeAnalytics, PolicyVersion: strings.Repeat("v", 51)},
+			wantErr: ErrConsentPolicyVersionTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.record.Validate(); err != tt.wantErr {
+				t.Errorf("ConsentRecord.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewConsentRecord(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+
+	t.Run("defaults policy version and omits empty request details", func(t *testing.T) {
+		record := NewConsentRecord(userID, ConsentTypeMarketingEmails, true, "", "", "", now)
+
+		if record.PolicyVersion != CurrentPolicyVersion {
+			t.Errorf("PolicyVersion = %q, want %q", record.PolicyVersion, CurrentPolicyVersion)
+		}
+		if record.IPAddress != nil || record.UserAgent != nil {
+			t.Errorf("IPAddress = %v, UserAgent = %v, want nil", record.IPAddress, record.UserAgent)
+		}
+	})
+
+	t.Run("truncates oversized user agent", func(t *testing.T) {
+		record := NewConsentRecord(userID, ConsentTypeAnalytics, false, "2024-01", "203.0.113.7", strings.Repeat("a", 600), now)
+
+		if record.IPAddress == nil || *record.IPAddress != "203.0.113.7" {
+			t.Errorf("IPAddress = %v, want 203.0.113.7", record.IPAddress)
+		}
+		if record.UserAgent == nil || len(*record.UserAgent) != maxConsentUserAgentLength {
+			t.Errorf("UserAgent length should be truncated to %d", maxConsentUserAgentLength)
+		}
+		if record.PolicyVersion != "2024-01" {
+			t.Errorf("PolicyVersion = %q, want 2024-01", record.PolicyVersion)
+		}
+	})
+}
+
+func TestNotification_RequiredConsent(t *testing.T) {
+	digest := Notification{Type: NotificationTypeMarketingDigest}
+	if consentType, ok := digest.RequiredConsent(); !ok || consentType != ConsentTypeMarketingEmails {
+		t.Errorf("marketing digest RequiredConsent() = %v, %v, want %v, true", consentType, ok, ConsentTypeMarketingEmails)
+	}
+
+	reminder := Notification{Type: NotificationTypeEventReminder}
+	if _, ok := reminder.RequiredConsent(); ok {
+		t.Errorf("event reminder should not require consent")
+	}
+}
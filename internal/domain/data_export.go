@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExportStatus represents the processing state of a data export archive
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "pending"
+	DataExportStatusProcessing DataExportStatus = "processing"
+	DataExportStatusReady      DataExportStatus = "ready"
+	DataExportStatusFailed     DataExportStatus = "failed"
+)
+
+// DataExport represents a user's request for a downloadable archive of their data.
+// StorageKey, FileSize and ExpiresAt are set once the archive is ready. StartedAt and Attempts record
+// when a worker last claimed the export and how many times it has been claimed.
+type DataExport struct {
+	ID          uuid.UUID        `json:"id" db:"id"`
+	UserID      uuid.UUID        `json:"user_id" db:"user_id"`
+	Status      DataExportStatus `json:"status" db:"status"`
+	StorageKey  *string          `json:"-" db:"storage_key"`
+	FileSize    *int64           `json:"file_size,omitempty" db:"file_size"`
+	Error       *string          `json:"error,omitempty" db:"error"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	StartedAt   *time.Time       `json:"started_at,omitempty" db:"started_at"`
+	Attempts    int              `json:"-" db:"attempts"`
+	CompletedAt *time.Time       `json:"completed_at,omitempty" db:"completed_at"`
+	ExpiresAt   *time.Time       `json:"expires_at,omitempty" db:"expires_at"`
+}
+
+var (
+	ErrInvalidDataExportStatus = errors.New("invalid data export status")
+	ErrDataExportStorageKey    = errors.New("ready data export must have a storage key")
+)
+
+// Validate validates the DataExport entity
+func (e *DataExport) Validate() error {
+	if !IsValidDataExportStatus(e.Status) {
+		return ErrInvalidDataExportStatus
+	}
+
+	if e.Status == DataExportStatusReady && (e.StorageKey == nil || *e.StorageKey == "") {
+		return ErrDataExportStorageKey
+	}
+
+	return nil
+}
+
+// IsActive reports whether the export is still waiting for or undergoing processing
+func (e *DataExport) IsActive() bool {
+	return e.Status == DataExportStatusPending || e.Status == DataExportStatusProcessing
+}
+
+// IsDownloadable reports whether the archive is ready and its download window is still open
+func (e *DataExport) IsDownloadable(now time.Time) bool {
+	return e.Status == DataExportStatusReady && e.StorageKey != nil && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
+
+// MarkProcessing marks the export as being built
+func (e *DataExport) MarkProcessing() {
+	e.Status = DataExportStatusProcessing
+	e.Error = nil
+}
+
+// MarkReady records the stored archive and when its download link expires
+func (e *DataExport) MarkReady(storageKey string, fileSize int64, now, expiresAt time.Time) {
+	e.Status = DataExportStatusReady
+	e.StorageKey = &storageKey
+	e.FileSize = &fileSize
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	e.Error = nil
+}
+
+// MarkFailed records why the archive could not be built
+func (e *DataExport) MarkFailed(reason string, now time.Time) {
+	e.Status = DataExportStatusFailed
+	e.Error = &reason
+	e.CompletedAt = &now
+}
+
+// IsValidDataExportStatus checks if the data export status is valid
+func IsValidDataExportStatus(status DataExportStatus) bool {
+	switch status {
+	case DataExportStatusPending, DataExportStatusProcessing, DataExportStatusReady, DataExportStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewDataExport creates a pending data export request
+func NewDataExport(userID uuid.UUID, now time.Time) *DataExport {
+	return &DataExport{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    DataExportStatusPending,
+		CreatedAt: now,
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDataExport_Validate(t *testing.T) {
+	key := "export.zip"
+
+	tests := []struct {
+		name    string
+		export  DataExport
+		wantErr error
+	}{
+		{
+			name:    "valid pending export",
+			export:  DataExport{Status: DataExportStatusPending},
+			wantErr: nil,
+		},
+		{
+			name:    "valid ready export",
+			export:  DataExport{Status: DataExportStatusReady, StorageKey: &key},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid status",
+			export:  DataExport{Status: "queued"},
+			wantErr: ErrInvalidDataExportStatus,
+		},
+		{
+			name:    "ready without archive",
+			export:  DataExport{Status: DataExportStatusReady},
+			wantErr: ErrDataExportStorageKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.export.Validate(); err != tt.wantErr {
+				t.Errorf("DataExport.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDataExport_Lifecycle(t *testing.T) {
+	now := time.Now()
+	export := NewDataExport(uuid.New(), now)
+
+	if !export.IsActive() {
+		t.Errorf("new export should be active")
+	}
+
+	export.MarkProcessing()
+	if export.Status != DataExportStatusProcessing || !export.IsActive() {
+		t.Errorf("Status = %v, want processing and active", export.Status)
+	}
+
+	export.MarkReady("archive.zip", 1024, now, now.Add(time.Hour))
+	if export.IsActive() {
+		t.Errorf("ready export should not be active")
+	}
+	if !export.IsDownloadable(now.Add(30 * time.Minute)) {
+		t.Errorf("export should be downloadable before it expires")
+	}
+	if export.IsDownloadable(now.Add(2 * time.Hour)) {
+		t.Errorf("export should not be downloadable after it expires")
+	}
+
+	failed := NewDataExport(uuid.New(), now)
+	failed.MarkFailed("disk full", now)
+	if failed.IsDownloadable(now) || failed.Error == nil || *failed.Error != "disk full" {
+		t.Errorf("failed export = %+v, want error recorded and not downloadable", failed)
+	}
+}
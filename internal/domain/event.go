@@ -60,15 +60,20 @@ type Event struct {
 	RecurrenceRule *string                `json:"recurrence_rule,omitempty" db:"recurrence_rule"`
 	CreatedAt      time.Time              `json:"created_at" db:"created_at"`
 	UpdatedAt      time.Time              `json:"updated_at" db:"updated_at"`
+	HiddenAt       *time.Time             `json:"hidden_at,omitempty" db:"hidden_at"`       // Set when platform moderators hide the event
+	CancelledAt    *time.Time             `json:"cancelled_at,omitempty" db:"cancelled_at"` // Set when the event is called off without a host to take it over
+	IsOfficial     bool                   `json:"is_official" db:"is_official"`             // Marked by the verified owner of the event's venue
+	CoHosts        []EventCoHost          `json:"co_hosts,omitempty" db:"-"`                // Accepted co-hosts, loaded with the event
 }
 
 // EventRSVP represents an RSVP for an event
 type EventRSVP struct {
-	EventID   uuid.UUID  `json:"event_id" db:"event_id"`
-	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
-	Status    RSVPStatus `json:"status" db:"status"`
-	CreatedAt time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+	EventID     uuid.UUID  `json:"event_id" db:"event_id"`
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	Status      RSVPStatus `json:"status" db:"status"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty" db:"checked_in_at"` // Set when an organizer checks the attendee in at the event
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
 }
 
 // EventWithDetails represents an event with additional details
@@ -79,6 +84,7 @@ type EventWithDetails struct {
 	Group    *Group           `json:"group,omitempty"`
 	RSVPs    []EventRSVP      `json:"rsvps,omitempty"`
 	UserRSVP *EventRSVP       `json:"user_rsvp,omitempty"`
+	Warnings []string         `json:"warnings,omitempty"` // Non-blocking issues found while saving
 }
 
 // EventSearchParams represents parameters for searching events
@@ -107,6 +113,7 @@ var (
 	ErrEmptyTimezone      = errors.New("timezone cannot be empty")
 	ErrEmptyLanguage      = errors.New("language cannot be empty")
 	ErrInvalidRSVPStatus  = errors.New("invalid RSVP status")
+	ErrCheckInNotGoing    = errors.New("only attendees who are going can be checked in")
 )
 
 // Validate validates the Event entity
@@ -156,7 +163,12 @@ func (e *Event) Validate() error {
 
 // IsValidGameType checks if the game type is valid
 func (e *Event) IsValidGameType() bool {
-	switch e.Game {
+	return IsValidGame(e.Game)
+}
+
+// IsValidGame checks if a game type is one of the supported games
+func IsValidGame(game GameType) bool {
+	switch game {
 	case GameTypeMTG, GameTypeLorcana, GameTypePokemon, GameTypeOther:
 		return true
 	default:
@@ -207,11 +219,50 @@ func (e *Event) IsPrivate() bool {
 	return e.Visibility == EventVisibilityPrivate
 }
 
+// IsHidden checks if platform moderators have hidden the event
+func (e *Event) IsHidden() bool {
+	return e.HiddenAt != nil
+}
+
+// IsCancelled checks if the event has been cancelled
+func (e *Event) IsCancelled() bool {
+	return e.CancelledAt != nil
+}
+
+// GetCoHost returns the accepted co-host record for a user, or nil if the user does not co-host the event
+func (e *Event) GetCoHost(userID uuid.UUID) *EventCoHost {
+	for i := range e.CoHosts {
+		if e.CoHosts[i].UserID == userID && e.CoHosts[i].IsAccepted() {
+			return &e.CoHosts[i]
+		}
+	}
+	return nil
+}
+
+// IsOrganizer checks if a user is the host or an accepted co-host of the event
+func (e *Event) IsOrganizer(userID uuid.UUID) bool {
+	return e.HostUserID == userID || e.GetCoHost(userID) != nil
+}
+
+// OrganizerUserIDs returns the host followed by every accepted co-host
+func (e *Event) OrganizerUserIDs() []uuid.UUID {
+	organizers := []uuid.UUID{e.HostUserID}
+	for _, coHost := range e.CoHosts {
+		if coHost.IsAccepted() {
+			organizers = append(organizers, coHost.UserID)
+		}
+	}
+	return organizers
+}
+
 // Validate validates the EventRSVP entity
 func (r *EventRSVP) Validate() error {
 	if !r.IsValidStatus() {
 		return ErrInvalidRSVPStatus
 	}
+	if r.IsCheckedIn() && !r.IsGoing() {
+		return ErrCheckInNotGoing
+	}
 	return nil
 }
 
@@ -234,3 +285,8 @@ func (r *EventRSVP) IsGoing() bool {
 func (r *EventRSVP) IsWaitlisted() bool {
 	return r.Status == RSVPStatusWaitlisted
 }
+
+// IsCheckedIn checks if the attendee has been checked in at the event
+func (r *EventRSVP) IsCheckedIn() bool {
+	return r.CheckedInAt != nil
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventCoHostStatus represents the state of a co-host invitation
+type EventCoHostStatus string
+
+const (
+	EventCoHostStatusPending  EventCoHostStatus = "pending"
+	EventCoHostStatusAccepted EventCoHostStatus = "accepted"
+	EventCoHostStatusDeclined EventCoHostStatus = "declined"
+)
+
+// CoHostPermissions lists the rights an event host can delegate to a co-host
+var CoHostPermissions = []Permission{
+	PermissionEditEvent,
+	PermissionManageAttendees,
+	PermissionCheckIn,
+}
+
+// EventCoHost represents a user invited by the host to help run an event.
+// Co-hosts only gain their scoped rights once they accept the invitation.
+type EventCoHost struct {
+	EventID     uuid.UUID         `json:"event_id" db:"event_id"`
+	UserID      uuid.UUID         `json:"user_id" db:"user_id"`
+	InvitedBy   uuid.UUID         `json:"invited_by" db:"invited_by"`
+	Permissions []Permission      `json:"permissions" db:"permissions"`
+	Status      EventCoHostStatus `json:"status" db:"status"`
+	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at" db:"updated_at"`
+	RespondedAt *time.Time        `json:"responded_at,omitempty" db:"responded_at"`
+}
+
+var (
+	ErrEmptyCoHostPermissions     = errors.New("co-host must be granted at least one permission")
+	ErrInvalidCoHostPermission    = errors.New("invalid co-host permission")
+	ErrDuplicateCoHostPermission  = errors.New("co-host permissions must be unique")
+	ErrInvalidCoHostStatus        = errors.New("invalid co-host status")
+	ErrCoHostInvitationNotPending = errors.New("co-host invitation has already been answered")
+)
+
+// Validate validates the EventCoHost entity
+func (c *EventCoHost) Validate() error {
+	if len(c.Permissions) == 0 {
+		return ErrEmptyCoHostPermissions
+	}
+
+	seen := make(map[Permission]bool, len(c.Permissions))
+	for _, permission := range c.Permissions {
+		if !IsCoHostPermission(permission) {
+			return ErrInvalidCoHostPermission
+		}
+		if seen[permission] {
+			return ErrDuplicateCoHostPermission
+		}
+		seen[permission] = true
+	}
+
+	switch c.Status {
+	case EventCoHostStatusPending, EventCoHostStatusAccepted, EventCoHostStatusDeclined:
+	default:
+		return ErrInvalidCoHostStatus
+	}
+
+	return nil
+}
+
+// IsAccepted checks if the co-host accepted the invitation
+func (c *EventCoHost) IsAccepted() bool {
+	return c.Status == EventCoHostStatusAccepted
+}
+
+// Grants checks if the co-host was delegated a specific permission
+func (c *EventCoHost) Grants(permission Permission) bool {
+	for _, granted := range c.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Respond records the invitee's answer to a pending invitation
+func (c *EventCoHost) Respond(accept bool, now time.Time) error {
+	if c.Status != EventCoHostStatusPending {
+		return ErrCoHostInvitationNotPending
+	}
+
+	c.Status = EventCoHostStatusDeclined
+	if accept {
+		c.Status = EventCoHostStatusAccepted
+	}
+	c.RespondedAt = &now
+	c.UpdatedAt = now
+
+	return nil
+}
+
+// IsCoHostPermission checks if a permission can be delegated to an event co-host
+func IsCoHostPermission(permission Permission) bool {
+	for _, delegable := range CoHostPermissions {
+		if delegable == permission {
+			return true
+		}
+	}
+	return false
+}
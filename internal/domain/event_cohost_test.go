@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestEventCoHost_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		coHost  EventCoHost
+		wantErr error
+	}{
+		{
+			name: "valid co-host",
+			coHost: EventCoHost{
+				EventID:     uuid.New(),
+				UserID:      uuid.New(),
+				Permissions: []Permission{PermissionEditEvent, PermissionCheckIn},
+				Status:      EventCoHostStatusPending,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "no permissions",
+			coHost:  EventCoHost{Status: EventCoHostStatusPending},
+			wantErr: ErrEmptyCoHostPermissions,
+		},
+		{
+			name:    "permission that cannot be delegated",
+			coHost:  EventCoHost{Permissions: []Permission{PermissionDeleteEvent}, Status: EventCoHostStatusPending},
+			wantErr: ErrInvalidCoHostPermission,
+		},
+		{
+			name:    "duplicate permission",
+			coHost:  EventCoHost{Permissions: []Permission{PermissionCheckIn, PermissionCheckIn}, Status: EventCoHostStatusPending},
+			wantErr: ErrDuplicateCoHostPermission,
+		},
+		{
+			name:    "invalid status",
+			coHost:  EventCoHost{Permissions: []Permission{PermissionCheckIn}, Status: "maybe"},
+			wantErr: ErrInvalidCoHostStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.coHost.Validate()
+			if err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEventCoHost_Respond(t *testing.T) {
+	now := time.Now()
+
+	coHost := EventCoHost{Permissions: []Permission{PermissionCheckIn}, Status: EventCoHostStatusPending}
+	if err := coHost.Respond(true, now); err != nil {
+		t.Fatalf("Respond() error = %v", err)
+	}
+	if !coHost.IsAccepted() {
+		t.Errorf("Expected invitation to be accepted, got %v", coHost.Status)
+	}
+	if coHost.RespondedAt == nil || !coHost.RespondedAt.Equal(now) {
+		t.Errorf("Expected responded at %v, got %v", now, coHost.RespondedAt)
+	}
+
+	// An answered invitation cannot be answered again
+	if err := coHost.Respond(false, now); err != ErrCoHostInvitationNotPending {
+		t.Errorf("Respond() error = %v, want %v", err, ErrCoHostInvitationNotPending)
+	}
+
+	declined := EventCoHost{Permissions: []Permission{PermissionCheckIn}, Status: EventCoHostStatusPending}
+	if err := declined.Respond(false, now); err != nil {
+		t.Fatalf("Respond() error = %v", err)
+	}
+	if declined.Status != EventCoHostStatusDeclined {
+		t.Errorf("Expected invitation to be declined, got %v", declined.Status)
+	}
+}
+
+func TestEvent_Organizers(t *testing.T) {
+	hostID := uuid.New()
+	coHostID := uuid.New()
+	pendingID := uuid.New()
+
+	event := Event{
+		HostUserID: hostID,
+		CoHosts: []EventCoHost{
+			{UserID: coHostID, Permissions: []Permission{PermissionCheckIn}, Status: EventCoHostStatusAccepted},
+			{UserID: pendingID, Permissions: []Permission{PermissionCheckIn}, Status: EventCoHostStatusPending},
+		},
+	}
+
+	if !event.IsOrganizer(hostID) || !event.IsOrganizer(coHostID) {
+		t.Error("Expected host and accepted co-host to be organizers")
+	}
+	if event.IsOrganizer(pendingID) {
+		t.Error("Expected pending co-host not to be an organizer")
+	}
+
+	organizers := event.OrganizerUserIDs()
+	if len(organizers) != 2 || organizers[0] != hostID || organizers[1] != coHostID {
+		t.Errorf("OrganizerUserIDs() = %v, want [%v %v]", organizers, hostID, coHostID)
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventGuest represents a user on the guest list of a private event.
+// Guests are added directly by an organizer or by redeeming an invite link.
+type EventGuest struct {
+	EventID      uuid.UUID  `json:"event_id" db:"event_id"`
+	UserID       uuid.UUID  `json:"user_id" db:"user_id"`
+	InvitedBy    uuid.UUID  `json:"invited_by" db:"invited_by"`
+	InviteLinkID *uuid.UUID `json:"invite_link_id,omitempty" db:"invite_link_id"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+}
+
+// EventInviteLink represents a shareable link that adds whoever redeems it to an event's guest list
+type EventInviteLink struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	EventID   uuid.UUID  `json:"event_id" db:"event_id"`
+	CreatedBy uuid.UUID  `json:"created_by" db:"created_by"`
+	TokenHash string     `json:"-" db:"token_hash"`
+	MaxUses   *int       `json:"max_uses,omitempty" db:"max_uses"`
+	UseCount  int        `json:"use_count" db:"use_count"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+var (
+	ErrGuestListNotSupported = errors.New("guest lists are only available for private events")
+)
+
+// Validate validates the EventInviteLink entity
+func (l *EventInviteLink) Validate() error {
+	if l.TokenHash == "" {
+		return ErrEmptyInviteToken
+	}
+
+	if !l.ExpiresAt.After(l.CreatedAt) {
+		return ErrInvalidInviteExpiry
+	}
+
+	if l.MaxUses != nil && *l.MaxUses <= 0 {
+		return ErrInvalidInviteMaxUses
+	}
+
+	return nil
+}
+
+// IsRevoked checks if the link has been revoked by an organizer
+func (l *EventInviteLink) IsRevoked() bool {
+	return l.RevokedAt != nil
+}
+
+// IsExpired checks if the link has passed its expiry time
+func (l *EventInviteLink) IsExpired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
+
+// IsExhausted checks if the link has reached its maximum number of uses
+func (l *EventInviteLink) IsExhausted() bool {
+	return l.MaxUses != nil && l.UseCount >= *l.MaxUses
+}
+
+// CanBeRedeemed checks if the link can still be used to join the guest list
+func (l *EventInviteLink) CanBeRedeemed(now time.Time) bool {
+	return !l.IsRevoked() && !l.IsExpired(now) && !l.IsExhausted()
+}
+
+// SupportsGuestList checks if access to the event is granted through a guest list
+func (e *Event) SupportsGuestList() bool {
+	return e.Visibility == EventVisibilityPrivate
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventInviteLink_Validate(t *testing.T) {
+	now := time.Now()
+	zero := 0
+	five := 5
+
+	tests := []struct {
+		name    string
+		link    EventInviteLink
+		wantErr error
+	}{
+		{
+			name: "valid link",
+			link: EventInviteLink{
+				TokenHash: HashInviteToken("token"),
+				MaxUses:   &five,
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "missing token hash",
+			link: EventInviteLink{
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: ErrEmptyInviteToken,
+		},
+		{
+			name: "expiry not after creation",
+			link: EventInviteLink{
+				TokenHash: "hash",
+				ExpiresAt: now,
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidInviteExpiry,
+		},
+		{
+			name: "zero max uses",
+			link: EventInviteLink{
+				TokenHash: "hash",
+				MaxUses:   &zero,
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidInviteMaxUses,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.link.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEventInviteLink_CanBeRedeemed(t *testing.T) {
+	now := time.Now()
+	one := 1
+	revokedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name string
+		link EventInviteLink
+		want bool
+	}{
+		{
+			name: "active link",
+			link: EventInviteLink{ExpiresAt: now.Add(time.Hour)},
+			want: true,
+		},
+		{
+			name: "expired link",
+			link: EventInviteLink{ExpiresAt: now.Add(-time.Hour)},
+			want: false,
+		},
+		{
+			name: "revoked link",
+			link: EventInviteLink{ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			want: false,
+		},
+		{
+			name: "exhausted link",
+			link: EventInviteLink{ExpiresAt: now.Add(time.Hour), MaxUses: &one, UseCount: 1},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.link.CanBeRedeemed(now); got != tt.want {
+				t.Errorf("CanBeRedeemed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -299,6 +299,8 @@ func TestEvent_BusinessLogic(t *testing.T) {
 }
 
 func TestEventRSVP_Validate(t *testing.T) {
+	checkedInAt := time.Now()
+
 	tests := []struct {
 		name    string
 		rsvp    EventRSVP
@@ -337,6 +339,30 @@ func TestEventRSVP_Validate(t *testing.T) {
 			},
 			wantErr: ErrInvalidRSVPStatus,
 		},
+		{
+			name: "valid RSVP - checked in",
+			rsvp: EventRSVP{
+				EventID:     uuid.New(),
+				UserID:      uuid.New(),
+				Status:      RSVPStatusGoing,
+				CheckedInAt: &checkedInAt,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			},
+			wantErr: nil,
+		},
+		{
+			name: "checked in without going",
+			rsvp: EventRSVP{
+				EventID:     uuid.New(),
+				UserID:      uuid.New(),
+				Status:      RSVPStatusWaitlisted,
+				CheckedInAt: &checkedInAt,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			},
+			wantErr: ErrCheckInNotGoing,
+		},
 	}
 
 	for _, tt := range tests {
@@ -372,4 +398,14 @@ func TestEventRSVP_StatusChecks(t *testing.T) {
 	if rsvp.IsGoing() {
 		t.Error("RSVP should not be going")
 	}
+
+	if rsvp.IsCheckedIn() {
+		t.Error("RSVP should not be checked in")
+	}
+
+	checkedInAt := time.Now()
+	rsvp.CheckedInAt = &checkedInAt
+	if !rsvp.IsCheckedIn() {
+		t.Error("RSVP should be checked in")
+	}
 }
@@ -17,6 +17,14 @@ const (
 	GroupRoleMember GroupRole = "member"
 )
 
+// GroupVisibility represents whether a group can be discovered by non-members
+type GroupVisibility string
+
+const (
+	GroupVisibilityPublic  GroupVisibility = "public"
+	GroupVisibilityPrivate GroupVisibility = "private"
+)
+
 // Group represents a group in the system
 type Group struct {
 	ID          uuid.UUID `json:"id" db:"id"`
@@ -26,6 +34,14 @@ type Group struct {
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 	IsActive    bool      `json:"is_active" db:"is_active"`
+
+	// Discovery
+	Visibility GroupVisibility `json:"visibility" db:"visibility"`
+	City       *string         `json:"city,omitempty" db:"city"`
+	Country    *string         `json:"country,omitempty" db:"country"`
+	Latitude   *float64        `json:"latitude,omitempty" db:"latitude"`
+	Longitude  *float64        `json:"longitude,omitempty" db:"longitude"`
+	Games      []GameType      `json:"games,omitempty" db:"games"`
 }
 
 // GroupMember represents a member of a group
@@ -34,6 +50,10 @@ type GroupMember struct {
 	UserID   uuid.UUID `json:"user_id" db:"user_id"`
 	Role     GroupRole `json:"role" db:"role"`
 	JoinedAt time.Time `json:"joined_at" db:"joined_at"`
+
+	// Custom role
+	CustomRoleID *uuid.UUID   `json:"custom_role_id,omitempty" db:"custom_role_id"`
+	Permissions  []Permission `json:"permissions,omitempty" db:"-"` // Granted by the custom role
 }
 
 // GroupWithMembers represents a group with its members
@@ -47,6 +67,9 @@ var (
 	ErrGroupNameTooLong        = errors.New("group name cannot exceed 100 characters")
 	ErrGroupDescriptionTooLong = errors.New("group description cannot exceed 1000 characters")
 	ErrInvalidGroupRole        = errors.New("invalid group role")
+	ErrInvalidGroupVisibility  = errors.New("invalid group visibility")
+	ErrIncompleteGroupLocation = errors.New("group latitude and longitude must be set together")
+	ErrInvalidGroupCountry     = errors.New("group country must be a 2-letter code")
 )
 
 // Validate validates the Group entity
@@ -63,9 +86,52 @@ func (g *Group) Validate() error {
 		return ErrGroupDescriptionTooLong
 	}
 
+	if !g.IsValidVisibility() {
+		return ErrInvalidGroupVisibility
+	}
+
+	if (g.Latitude == nil) != (g.Longitude == nil) {
+		return ErrIncompleteGroupLocation
+	}
+
+	if g.HasLocation() && !IsValidCoordinates(*g.Latitude, *g.Longitude) {
+		return ErrInvalidCoordinates
+	}
+
+	if g.Country != nil && len(*g.Country) != 2 {
+		return ErrInvalidGroupCountry
+	}
+
+	for _, game := range g.Games {
+		if !IsValidGame(game) {
+			return ErrInvalidGameType
+		}
+	}
+
 	return nil
 }
 
+// IsValidVisibility checks if the group visibility is valid.
+// An empty visibility is treated as private.
+func (g *Group) IsValidVisibility() bool {
+	switch g.Visibility {
+	case "", GroupVisibilityPublic, GroupVisibilityPrivate:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPublic checks if the group can be discovered and joined by request
+func (g *Group) IsPublic() bool {
+	return g.Visibility == GroupVisibilityPublic
+}
+
+// HasLocation checks if the group has coordinates set
+func (g *Group) HasLocation() bool {
+	return g.Latitude != nil && g.Longitude != nil
+}
+
 // Validate validates the GroupMember entity
 func (gm *GroupMember) Validate() error {
 	if !gm.IsValidRole() {
@@ -123,6 +189,16 @@ func (gm *GroupMember) HasPermission(requiredRole GroupRole) bool {
 	}
 }
 
+// HasGrantedPermission checks if the member's custom role grants a specific permission
+func (gm *GroupMember) HasGrantedPermission(permission Permission) bool {
+	for _, granted := range gm.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
 // GroupWithDetails represents a group with additional details
 type GroupWithDetails struct {
 	Group
@@ -131,3 +207,20 @@ type GroupWithDetails struct {
 	MemberCount int              `json:"member_count"`
 	UserRole    *string          `json:"user_role,omitempty"`
 }
+
+// GroupSearchParams represents parameters for discovering public groups
+type GroupSearchParams struct {
+	Query    *string      `json:"query,omitempty"`
+	Near     *Coordinates `json:"near,omitempty"`
+	RadiusKm *int         `json:"radius_km,omitempty"`
+	Game     *GameType    `json:"game,omitempty"`
+	Limit    int          `json:"limit"`
+	Offset   int          `json:"offset"`
+}
+
+// GroupSearchResult represents a public group found by a search
+type GroupSearchResult struct {
+	Group
+	MemberCount int      `json:"member_count"`
+	DistanceKm  *float64 `json:"distance_km,omitempty"`
+}
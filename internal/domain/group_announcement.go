@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupAnnouncement represents an update posted to a group's announcement board
+type GroupAnnouncement struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	GroupID      uuid.UUID  `json:"group_id" db:"group_id"`
+	AuthorUserID uuid.UUID  `json:"author_user_id" db:"author_user_id"`
+	Title        string     `json:"title" db:"title"`
+	Body         string     `json:"body" db:"body"` // Markdown
+	IsPinned     bool       `json:"is_pinned" db:"is_pinned"`
+	PinnedAt     *time.Time `json:"pinned_at,omitempty" db:"pinned_at"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+var (
+	ErrEmptyAnnouncementTitle    = errors.New("announcement title cannot be empty")
+	ErrAnnouncementTitleTooLong  = errors.New("announcement title cannot exceed 200 characters")
+	ErrEmptyAnnouncementBody     = errors.New("announcement body cannot be empty")
+	ErrAnnouncementBodyTooLong   = errors.New("announcement body cannot exceed 10000 characters")
+	ErrInvalidAnnouncementExpiry = errors.New("announcement expiry must be after it is posted")
+)
+
+// Validate validates the GroupAnnouncement entity
+func (a *GroupAnnouncement) Validate() error {
+	if strings.TrimSpace(a.Title) == "" {
+		return ErrEmptyAnnouncementTitle
+	}
+
+	if len(a.Title) > 200 {
+		return ErrAnnouncementTitleTooLong
+	}
+
+	if strings.TrimSpace(a.Body) == "" {
+		return ErrEmptyAnnouncementBody
+	}
+
+	if len(a.Body) > 10000 {
+		return ErrAnnouncementBodyTooLong
+	}
+
+	if a.ExpiresAt != nil && !a.ExpiresAt.After(a.CreatedAt) {
+		return ErrInvalidAnnouncementExpiry
+	}
+
+	return nil
+}
+
+// IsExpired checks if the announcement has expired at the given time
+func (a *GroupAnnouncement) IsExpired(now time.Time) bool {
+	return a.ExpiresAt != nil && !now.Before(*a.ExpiresAt)
+}
+
+// Pin pins the announcement to the top of the board
+func (a *GroupAnnouncement) Pin(now time.Time) {
+	a.IsPinned = true
+	a.PinnedAt = &now
+	a.UpdatedAt = now
+}
+
+// Unpin removes the announcement from the top of the board
+func (a *GroupAnnouncement) Unpin(now time.Time) {
+	a.IsPinned = false
+	a.PinnedAt = nil
+	a.UpdatedAt = now
+}
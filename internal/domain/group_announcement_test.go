@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGroupAnnouncement_Validate(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		announcement GroupAnnouncement
+		wantErr      error
+	}{
+		{
+			name: "valid announcement",
+			announcement: GroupAnnouncement{
+				ID:           uuid.New(),
+				GroupID:      uuid.New(),
+				AuthorUserID: uuid.New(),
+				Title:        "New store hours",
+				Body:         "From next week we play on **Thursdays**.",
+				ExpiresAt:    &future,
+				CreatedAt:    now,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "empty title",
+			announcement: GroupAnnouncement{
+				Title:     " ",
+				Body:      "Body",
+				CreatedAt: now,
+			},
+			wantErr: ErrEmptyAnnouncementTitle,
+		},
+		{
+			name: "empty body",
+			announcement: GroupAnnouncement{
+				Title:     "Title",
+				CreatedAt: now,
+			},
+			wantErr: ErrEmptyAnnouncementBody,
+		},
+		{
+			name: "body too long",
+			announcement: GroupAnnouncement{
+				Title:     "Title",
+				Body:      strings.Repeat("a", 10001),
+				CreatedAt: now,
+			},
+			wantErr: ErrAnnouncementBodyTooLong,
+		},
+		{
+			name: "expires before it is posted",
+			announcement: GroupAnnouncement{
+				Title:     "Title",
+				Body:      "Body",
+				ExpiresAt: &past,
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidAnnouncementExpiry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.announcement.Validate()
+			if err != tt.wantErr {
+				t.Errorf("GroupAnnouncement.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupAnnouncement_PinAndExpiry(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	announcement := GroupAnnouncement{ExpiresAt: &expiresAt}
+
+	if announcement.IsExpired(now) {
+		t.Error("Announcement should not be expired before its expiry")
+	}
+	if !announcement.IsExpired(expiresAt) {
+		t.Error("Announcement should be expired at its expiry")
+	}
+
+	announcement.Pin(now)
+	if !announcement.IsPinned || announcement.PinnedAt == nil {
+		t.Error("Announcement should be pinned")
+	}
+
+	announcement.Unpin(now)
+	if announcement.IsPinned || announcement.PinnedAt != nil {
+		t.Error("Announcement should be unpinned")
+	}
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GrantablePermissions lists the permissions a custom group role can grant
+var GrantablePermissions = []Permission{
+	PermissionCreateEvent,
+	PermissionEditEvent,
+	PermissionCheckIn,
+	PermissionManageMembers,
+	PermissionPostAnnouncements,
+}
+
+// GroupCustomRole represents a group-defined role such as "judge" or "event organizer".
+// Members assigned a custom role keep their base role and gain the role's permissions.
+type GroupCustomRole struct {
+	ID          uuid.UUID    `json:"id" db:"id"`
+	GroupID     uuid.UUID    `json:"group_id" db:"group_id"`
+	Name        string       `json:"name" db:"name"`
+	Description *string      `json:"description,omitempty" db:"description"`
+	Permissions []Permission `json:"permissions" db:"permissions"`
+	CreatedAt   time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at" db:"updated_at"`
+}
+
+var (
+	ErrEmptyCustomRoleName           = errors.New("custom role name cannot be empty")
+	ErrCustomRoleNameTooLong         = errors.New("custom role name cannot exceed 50 characters")
+	ErrReservedCustomRoleName        = errors.New("custom role name cannot be owner, admin or member")
+	ErrCustomRoleDescriptionTooLong  = errors.New("custom role description cannot exceed 500 characters")
+	ErrEmptyCustomRolePermissions    = errors.New("custom role must grant at least one permission")
+	ErrInvalidCustomRolePermission   = errors.New("invalid custom role permission")
+	ErrDuplicateCustomRolePermission = errors.New("custom role permissions must be unique")
+)
+
+// Validate validates the GroupCustomRole entity
+func (r *GroupCustomRole) Validate() error {
+	name := strings.TrimSpace(r.Name)
+	if name == "" {
+		return ErrEmptyCustomRoleName
+	}
+
+	if len(r.Name) > 50 {
+		return ErrCustomRoleNameTooLong
+	}
+
+	switch GroupRole(strings.ToLower(name)) {
+	case GroupRoleOwner, GroupRoleAdmin, GroupRoleMember:
+		return ErrReservedCustomRoleName
+	}
+
+	if r.Description != nil && len(*r.Description) > 500 {
+		return ErrCustomRoleDescriptionTooLong
+	}
+
+	if len(r.Permissions) == 0 {
+		return ErrEmptyCustomRolePermissions
+	}
+
+	seen := make(map[Permission]bool, len(r.Permissions))
+	for _, permission := range r.Permissions {
+		if !IsGrantablePermission(permission) {
+			return ErrInvalidCustomRolePermission
+		}
+		if seen[permission] {
+			return ErrDuplicateCustomRolePermission
+		}
+		seen[permission] = true
+	}
+
+	return nil
+}
+
+// Grants checks if the custom role grants a specific permission
+func (r *GroupCustomRole) Grants(permission Permission) bool {
+	for _, granted := range r.Permissions {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGrantablePermission checks if a permission can be granted by a custom group role
+func IsGrantablePermission(permission Permission) bool {
+	for _, grantable := range GrantablePermissions {
+		if grantable == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGroupCustomRole_Validate(t *testing.T) {
+	longDescription := strings.Repeat("a", 501)
+
+	tests := []struct {
+		name    string
+		role    GroupCustomRole
+		wantErr error
+	}{
+		{
+			name: "valid role",
+			role: GroupCustomRole{
+				GroupID:     uuid.New(),
+				Name:        "Judge",
+				Permissions: []Permission{PermissionCreateEvent, PermissionCheckIn},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "empty name",
+			role:    GroupCustomRole{Name: "  ", Permissions: []Permission{PermissionCheckIn}},
+			wantErr: ErrEmptyCustomRoleName,
+		},
+		{
+			name:    "name too long",
+			role:    GroupCustomRole{Name: strings.Repeat("a", 51), Permissions: []Permission{PermissionCheckIn}},
+			wantErr: ErrCustomRoleNameTooLong,
+		},
+		{
+			name:    "reserved name",
+			role:    GroupCustomRole{Name: "Admin", Permissions: []Permission{PermissionCheckIn}},
+			wantErr: ErrReservedCustomRoleName,
+		},
+		{
+			name:    "description too long",
+			role:    GroupCustomRole{Name: "Judge", Description: &longDescription, Permissions: []Permission{PermissionCheckIn}},
+			wantErr: ErrCustomRoleDescriptionTooLong,
+		},
+		{
+			name:    "no permissions",
+			role:    GroupCustomRole{Name: "Judge"},
+			wantErr: ErrEmptyCustomRolePermissions,
+		},
+		{
+			name:    "permission that cannot be granted",
+			role:    GroupCustomRole{Name: "Judge", Permissions: []Permission{PermissionDeleteGroup}},
+			wantErr: ErrInvalidCustomRolePermission,
+		},
+		{
+			name:    "duplicate permission",
+			role:    GroupCustomRole{Name: "Judge", Permissions: []Permission{PermissionCheckIn, PermissionCheckIn}},
+			wantErr: ErrDuplicateCustomRolePermission,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.role.Validate()
+			if err != tt.wantErr {
+				t.Errorf("GroupCustomRole.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupCustomRole_Grants(t *testing.T) {
+	role := GroupCustomRole{Permissions: []Permission{PermissionCreateEvent, PermissionCheckIn}}
+
+	if !role.Grants(PermissionCheckIn) {
+		t.Error("Expected role to grant check_in")
+	}
+	if role.Grants(PermissionManageMembers) {
+		t.Error("Expected role not to grant manage_members")
+	}
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupInviteStatus represents the status of a group invitation
+type GroupInviteStatus string
+
+const (
+	GroupInviteStatusPending  GroupInviteStatus = "pending"
+	GroupInviteStatusAccepted GroupInviteStatus = "accepted"
+	GroupInviteStatusDeclined GroupInviteStatus = "declined"
+	GroupInviteStatusRevoked  GroupInviteStatus = "revoked"
+	GroupInviteStatusExpired  GroupInviteStatus = "expired"
+)
+
+// GroupInvite represents an invitation to join a group.
+// Direct invites target a single user; shareable links have no invited user
+// and can be redeemed by anyone holding the token, up to MaxUses times.
+type GroupInvite struct {
+	ID            uuid.UUID         `json:"id" db:"id"`
+	GroupID       uuid.UUID         `json:"group_id" db:"group_id"`
+	InvitedUserID *uuid.UUID        `json:"invited_user_id,omitempty" db:"invited_user_id"`
+	InviterID     uuid.UUID         `json:"inviter_user_id" db:"inviter_user_id"`
+	Role          GroupRole         `json:"role" db:"role"`
+	TokenHash     string            `json:"-" db:"token_hash"`
+	Status        GroupInviteStatus `json:"status" db:"status"`
+	MaxUses       *int              `json:"max_uses,omitempty" db:"max_uses"`
+	UseCount      int               `json:"use_count" db:"use_count"`
+	ExpiresAt     time.Time         `json:"expires_at" db:"expires_at"`
+	RespondedAt   *time.Time        `json:"responded_at,omitempty" db:"responded_at"`
+	CreatedAt     time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at" db:"updated_at"`
+}
+
+// GroupInviteWithGroup represents an invitation with its group
+type GroupInviteWithGroup struct {
+	GroupInvite
+	Group *Group `json:"group,omitempty"`
+}
+
+var (
+	ErrInvalidInviteStatus  = errors.New("invalid invite status")
+	ErrInvalidInviteRole    = errors.New("invites can only grant admin or member roles")
+	ErrInvalidLinkRole      = errors.New("invite links can only grant the member role")
+	ErrInvalidInviteExpiry  = errors.New("invite expiry must be in the future")
+	ErrInvalidInviteMaxUses = errors.New("invite max uses must be positive")
+	ErrEmptyInviteToken     = errors.New("invite token hash cannot be empty")
+)
+
+// Validate validates the GroupInvite entity
+func (gi *GroupInvite) Validate() error {
+	if !gi.IsValidStatus() {
+		return ErrInvalidInviteStatus
+	}
+
+	if gi.Role != GroupRoleAdmin && gi.Role != GroupRoleMember {
+		return ErrInvalidInviteRole
+	}
+
+	if gi.IsLink() && gi.Role != GroupRoleMember {
+		return ErrInvalidLinkRole
+	}
+
+	if gi.TokenHash == "" {
+		return ErrEmptyInviteToken
+	}
+
+	if !gi.ExpiresAt.After(gi.CreatedAt) {
+		return ErrInvalidInviteExpiry
+	}
+
+	if gi.MaxUses != nil && *gi.MaxUses <= 0 {
+		return ErrInvalidInviteMaxUses
+	}
+
+	return nil
+}
+
+// IsValidStatus checks if the invite status is valid
+func (gi *GroupInvite) IsValidStatus() bool {
+	switch gi.Status {
+	case GroupInviteStatusPending, GroupInviteStatusAccepted, GroupInviteStatusDeclined,
+		GroupInviteStatusRevoked, GroupInviteStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsLink checks if the invite is a shareable link rather than a direct invite
+func (gi *GroupInvite) IsLink() bool {
+	return gi.InvitedUserID == nil
+}
+
+// IsExpired checks if the invite has passed its expiry time
+func (gi *GroupInvite) IsExpired(now time.Time) bool {
+	return !now.Before(gi.ExpiresAt)
+}
+
+// IsExhausted checks if a link has reached its maximum number of uses
+func (gi *GroupInvite) IsExhausted() bool {
+	return gi.MaxUses != nil && gi.UseCount >= *gi.MaxUses
+}
+
+// CanBeRedeemed checks if the invite can still be used to join the group
+func (gi *GroupInvite) CanBeRedeemed(now time.Time) bool {
+	return gi.Status == GroupInviteStatusPending && !gi.IsExpired(now) && !gi.IsExhausted()
+}
+
+// HashInviteToken returns the hex-encoded SHA-256 hash stored for an invite token
+func HashInviteToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGroupInvite_Validate(t *testing.T) {
+	now := time.Now()
+	invitedUserID := uuid.New()
+	zero := 0
+
+	tests := []struct {
+		name    string
+		invite  GroupInvite
+		wantErr error
+	}{
+		{
+			name: "valid direct invite",
+			invite: GroupInvite{
+				ID:            uuid.New(),
+				GroupID:       uuid.New(),
+				InvitedUserID: &invitedUserID,
+				Role:          GroupRoleAdmin,
+				TokenHash:     HashInviteToken("token"),
+				Status:        GroupInviteStatusPending,
+				ExpiresAt:     now.Add(24 * time.Hour),
+				CreatedAt:     now,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "valid invite link",
+			invite: GroupInvite{
+				Role:      GroupRoleMember,
+				TokenHash: HashInviteToken("token"),
+				Status:    GroupInviteStatusPending,
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "owner role",
+			invite: GroupInvite{
+				InvitedUserID: &invitedUserID,
+				Role:          GroupRoleOwner,
+				TokenHash:     "hash",
+				Status:        GroupInviteStatusPending,
+				ExpiresAt:     now.Add(time.Hour),
+				CreatedAt:     now,
+			},
+			wantErr: ErrInvalidInviteRole,
+		},
+		{
+			name: "admin role on link",
+			invite: GroupInvite{
+				Role:      GroupRoleAdmin,
+				TokenHash: "hash",
+				Status:    GroupInviteStatusPending,
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidLinkRole,
+		},
+		{
+			name: "missing token hash",
+			invite: GroupInvite{
+				Role:      GroupRoleMember,
+				Status:    GroupInviteStatusPending,
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: ErrEmptyInviteToken,
+		},
+		{
+			name: "expiry before creation",
+			invite: GroupInvite{
+				Role:      GroupRoleMember,
+				TokenHash: "hash",
+				Status:    GroupInviteStatusPending,
+				ExpiresAt: now.Add(-time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidInviteExpiry,
+		},
+		{
+			name: "zero max uses",
+			invite: GroupInvite{
+				Role:      GroupRoleMember,
+				TokenHash: "hash",
+				Status:    GroupInviteStatusPending,
+				MaxUses:   &zero,
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidInviteMaxUses,
+		},
+		{
+			name: "invalid status",
+			invite: GroupInvite{
+				Role:      GroupRoleMember,
+				TokenHash: "hash",
+				Status:    "unknown",
+				ExpiresAt: now.Add(time.Hour),
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidInviteStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.invite.Validate()
+			if err != tt.wantErr {
+				t.Errorf("GroupInvite.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupInvite_CanBeRedeemed(t *testing.T) {
+	now := time.Now()
+	maxUses := 2
+
+	tests := []struct {
+		name   string
+		invite GroupInvite
+		want   bool
+	}{
+		{
+			name:   "pending and valid",
+			invite: GroupInvite{Status: GroupInviteStatusPending, ExpiresAt: now.Add(time.Hour)},
+			want:   true,
+		},
+		{
+			name:   "expired",
+			invite: GroupInvite{Status: GroupInviteStatusPending, ExpiresAt: now.Add(-time.Minute)},
+			want:   false,
+		},
+		{
+			name:   "revoked",
+			invite: GroupInvite{Status: GroupInviteStatusRevoked, ExpiresAt: now.Add(time.Hour)},
+			want:   false,
+		},
+		{
+			name:   "link with uses left",
+			invite: GroupInvite{Status: GroupInviteStatusPending, MaxUses: &maxUses, UseCount: 1, ExpiresAt: now.Add(time.Hour)},
+			want:   true,
+		},
+		{
+			name:   "link exhausted",
+			invite: GroupInvite{Status: GroupInviteStatusPending, MaxUses: &maxUses, UseCount: 2, ExpiresAt: now.Add(time.Hour)},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.invite.CanBeRedeemed(now); got != tt.want {
+				t.Errorf("GroupInvite.CanBeRedeemed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashInviteToken(t *testing.T) {
+	hash := HashInviteToken("secret-token")
+
+	if len(hash) != 64 {
+		t.Errorf("Expected 64 character hash, got %d", len(hash))
+	}
+	if hash == "secret-token" {
+		t.Error("Expected token to be hashed")
+	}
+	if hash != HashInviteToken("secret-token") {
+		t.Error("Expected hashing to be deterministic")
+	}
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupJoinRequestStatus represents the status of a request to join a group
+type GroupJoinRequestStatus string
+
+const (
+	GroupJoinRequestStatusPending   GroupJoinRequestStatus = "pending"
+	GroupJoinRequestStatusApproved  GroupJoinRequestStatus = "approved"
+	GroupJoinRequestStatusRejected  GroupJoinRequestStatus = "rejected"
+	GroupJoinRequestStatusCancelled GroupJoinRequestStatus = "cancelled"
+)
+
+// GroupJoinRequest represents a user's request to join a public group
+type GroupJoinRequest struct {
+	ID         uuid.UUID              `json:"id" db:"id"`
+	GroupID    uuid.UUID              `json:"group_id" db:"group_id"`
+	UserID     uuid.UUID              `json:"user_id" db:"user_id"`
+	Message    *string                `json:"message,omitempty" db:"message"`
+	Status     GroupJoinRequestStatus `json:"status" db:"status"`
+	ReviewedBy *uuid.UUID             `json:"reviewed_by,omitempty" db:"reviewed_by"`
+	ReviewedAt *time.Time             `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time              `json:"updated_at" db:"updated_at"`
+}
+
+var (
+	ErrInvalidJoinRequestStatus  = errors.New("invalid join request status")
+	ErrJoinRequestMessageTooLong = errors.New("join request message cannot exceed 500 characters")
+)
+
+// Validate validates the GroupJoinRequest entity
+func (jr *GroupJoinRequest) Validate() error {
+	if !jr.IsValidStatus() {
+		return ErrInvalidJoinRequestStatus
+	}
+
+	if jr.Message != nil && len(*jr.Message) > 500 {
+		return ErrJoinRequestMessageTooLong
+	}
+
+	return nil
+}
+
+// IsValidStatus checks if the join request status is valid
+func (jr *GroupJoinRequest) IsValidStatus() bool {
+	switch jr.Status {
+	case GroupJoinRequestStatusPending, GroupJoinRequestStatusApproved,
+		GroupJoinRequestStatusRejected, GroupJoinRequestStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPending checks if the join request is still awaiting a decision
+func (jr *GroupJoinRequest) IsPending() bool {
+	return jr.Status == GroupJoinRequestStatusPending
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGroupJoinRequest_Validate(t *testing.T) {
+	longMessage := strings.Repeat("a", 501)
+
+	tests := []struct {
+		name    string
+		request GroupJoinRequest
+		wantErr error
+	}{
+		{
+			name: "valid pending request",
+			request: GroupJoinRequest{
+				ID:      uuid.New(),
+				GroupID: uuid.New(),
+				UserID:  uuid.New(),
+				Message: stringPtr("I play every Friday"),
+				Status:  GroupJoinRequestStatusPending,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid status",
+			request: GroupJoinRequest{
+				Status: "maybe",
+			},
+			wantErr: ErrInvalidJoinRequestStatus,
+		},
+		{
+			name: "message too long",
+			request: GroupJoinRequest{
+				Message: &longMessage,
+				Status:  GroupJoinRequestStatusPending,
+			},
+			wantErr: ErrJoinRequestMessageTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if err != tt.wantErr {
+				t.Errorf("GroupJoinRequest.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
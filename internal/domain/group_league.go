@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LeaguePointScheme describes how many points a league awards per event
+type LeaguePointScheme struct {
+	// PlacementPoints holds the points for each placement, starting with first place
+	PlacementPoints []int `json:"placement_points"`
+	// ParticipationPoints are awarded to every player with a recorded result
+	ParticipationPoints int `json:"participation_points"`
+}
+
+// GroupLeague represents a season-long league run by a group
+type GroupLeague struct {
+	ID          uuid.UUID         `json:"id" db:"id"`
+	GroupID     uuid.UUID         `json:"group_id" db:"group_id"`
+	Name        string            `json:"name" db:"name"`
+	Description *string           `json:"description,omitempty" db:"description"`
+	SeasonStart time.Time         `json:"season_start" db:"season_start"`
+	SeasonEnd   time.Time         `json:"season_end" db:"season_end"`
+	PointScheme LeaguePointScheme `json:"point_scheme"`
+	CreatedBy   uuid.UUID         `json:"created_by" db:"created_by"`
+	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at" db:"updated_at"`
+}
+
+// LeagueResult represents a player's result at a league event
+type LeagueResult struct {
+	LeagueID   uuid.UUID `json:"league_id" db:"league_id"`
+	EventID    uuid.UUID `json:"event_id" db:"event_id"`
+	UserID     uuid.UUID `json:"user_id" db:"user_id"`
+	Placement  *int      `json:"placement,omitempty" db:"placement"` // nil when the player only participated
+	RecordedBy uuid.UUID `json:"recorded_by" db:"recorded_by"`
+	RecordedAt time.Time `json:"recorded_at" db:"recorded_at"`
+}
+
+// LeagueStanding represents a player's position in a league table
+type LeagueStanding struct {
+	Rank          int       `json:"rank"`
+	UserID        uuid.UUID `json:"user_id"`
+	Points        int       `json:"points"`
+	EventsPlayed  int       `json:"events_played"`
+	Wins          int       `json:"wins"`
+	BestPlacement *int      `json:"best_placement,omitempty"`
+}
+
+var (
+	ErrEmptyLeagueName          = errors.New("league name cannot be empty")
+	ErrLeagueNameTooLong        = errors.New("league name cannot exceed 100 characters")
+	ErrLeagueDescriptionTooLong = errors.New("league description cannot exceed 1000 characters")
+	ErrInvalidLeagueSeason      = errors.New("league season must end after it starts")
+	ErrInvalidLeaguePoints      = errors.New("league points cannot be negative")
+	ErrTooManyPlacementPoints   = errors.New("league point scheme cannot exceed 64 placements")
+	ErrInvalidLeaguePlacement   = errors.New("placement must be at least 1")
+)
+
+// Validate validates the GroupLeague entity
+func (l *GroupLeague) Validate() error {
+	if strings.TrimSpace(l.Name) == "" {
+		return ErrEmptyLeagueName
+	}
+
+	if len(l.Name) > 100 {
+		return ErrLeagueNameTooLong
+	}
+
+	if l.Description != nil && len(*l.Description) > 1000 {
+		return ErrLeagueDescriptionTooLong
+	}
+
+	if !l.SeasonEnd.After(l.SeasonStart) {
+		return ErrInvalidLeagueSeason
+	}
+
+	return l.PointScheme.Validate()
+}
+
+// IsInSeason checks if the given time falls within the league season
+func (l *GroupLeague) IsInSeason(t time.Time) bool {
+	return !t.Before(l.SeasonStart) && !t.After(l.SeasonEnd)
+}
+
+// Validate validates the LeaguePointScheme
+func (s *LeaguePointScheme) Validate() error {
+	if s.ParticipationPoints < 0 {
+		return ErrInvalidLeaguePoints
+	}
+
+	if len(s.PlacementPoints) > 64 {
+		return ErrTooManyPlacementPoints
+	}
+
+	for _, points := range s.PlacementPoints {
+		if points < 0 {
+			return ErrInvalidLeaguePoints
+		}
+	}
+
+	return nil
+}
+
+// PointsFor returns the points awarded for a result with the given placement
+func (s *LeaguePointScheme) PointsFor(placement *int) int {
+	points := s.ParticipationPoints
+	if placement != nil && *placement >= 1 && *placement <= len(s.PlacementPoints) {
+		points += s.PlacementPoints[*placement-1]
+	}
+	return points
+}
+
+// Validate validates the LeagueResult entity
+func (r *LeagueResult) Validate() error {
+	if r.Placement != nil && *r.Placement < 1 {
+		return ErrInvalidLeaguePlacement
+	}
+	return nil
+}
+
+// ComputeLeagueStandings builds the league table from the recorded results.
+// Players are ordered by points, then wins, then best placement; tied players share a rank.
+func ComputeLeagueStandings(scheme LeaguePointScheme, results []*LeagueResult) []*LeagueStanding {
+	byUser := make(map[uuid.UUID]*LeagueStanding)
+	for _, result := range results {
+		standing, exists := byUser[result.UserID]
+		if !exists {
+			standing = &LeagueStanding{UserID: result.UserID}
+			byUser[result.UserID] = standing
+		}
+
+		standing.Points += scheme.PointsFor(result.Placement)
+		standing.EventsPlayed++
+
+		if result.Placement != nil {
+			if *result.Placement == 1 {
+				standing.Wins++
+			}
+			if standing.BestPlacement == nil || *result.Placement < *standing.BestPlacement {
+				placement := *result.Placement
+				standing.BestPlacement = &placement
+			}
+		}
+	}
+
+	standings := make([]*LeagueStanding, 0, len(byUser))
+	for _, standing := range byUser {
+		standings = append(standings, standing)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		if cmp := compareStandings(standings[i], standings[j]); cmp != 0 {
+			return cmp < 0
+		}
+		// Keep the order stable for players that are fully tied
+		return standings[i].UserID.String() < standings[j].UserID.String()
+	})
+
+	for i, standing := range standings {
+		if i > 0 && compareStandings(standings[i-1], standing) == 0 {
+			standing.Rank = standings[i-1].Rank
+		} else {
+			standing.Rank = i + 1
+		}
+	}
+
+	return standings
+}
+
+// compareStandings returns a negative number when a ranks above b, positive when below and 0 when tied
+func compareStandings(a, b *LeagueStanding) int {
+	if a.Points != b.Points {
+		return b.Points - a.Points
+	}
+	if a.Wins != b.Wins {
+		return b.Wins - a.Wins
+	}
+
+	switch {
+	case a.BestPlacement == nil && b.BestPlacement == nil:
+		return 0
+	case a.BestPlacement == nil:
+		return 1
+	case b.BestPlacement == nil:
+		return -1
+	default:
+		return *a.BestPlacement - *b.BestPlacement
+	}
+}
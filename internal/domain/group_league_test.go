@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGroupLeague_Validate(t *testing.T) {
+	seasonStart := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	seasonEnd := seasonStart.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name    string
+		league  GroupLeague
+		wantErr error
+	}{
+		{
+			name: "valid league",
+			league: GroupLeague{
+				ID:          uuid.New(),
+				GroupID:     uuid.New(),
+				Name:        "October Pauper League",
+				SeasonStart: seasonStart,
+				SeasonEnd:   seasonEnd,
+				PointScheme: LeaguePointScheme{
+					PlacementPoints:     []int{10, 7, 5, 3},
+					ParticipationPoints: 1,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "empty name",
+			league: GroupLeague{
+				Name:        "  ",
+				SeasonStart: seasonStart,
+				SeasonEnd:   seasonEnd,
+			},
+			wantErr: ErrEmptyLeagueName,
+		},
+		{
+			name: "season ends before it starts",
+			league: GroupLeague{
+				Name:        "Backwards League",
+				SeasonStart: seasonEnd,
+				SeasonEnd:   seasonStart,
+			},
+			wantErr: ErrInvalidLeagueSeason,
+		},
+		{
+			name: "negative placement points",
+			league: GroupLeague{
+				Name:        "Odd League",
+				SeasonStart: seasonStart,
+				SeasonEnd:   seasonEnd,
+				PointScheme: LeaguePointScheme{PlacementPoints: []int{3, -1}},
+			},
+			wantErr: ErrInvalidLeaguePoints,
+		},
+		{
+			name: "negative participation points",
+			league: GroupLeague{
+				Name:        "Odd League",
+				SeasonStart: seasonStart,
+				SeasonEnd:   seasonEnd,
+				PointScheme: LeaguePointScheme{ParticipationPoints: -2},
+			},
+			wantErr: ErrInvalidLeaguePoints,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.league.Validate()
+			if err != tt.wantErr {
+				t.Errorf("GroupLeague.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupLeague_IsInSeason(t *testing.T) {
+	league := GroupLeague{
+		SeasonStart: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+		SeasonEnd:   time.Date(2025, 10, 31, 23, 59, 59, 0, time.UTC),
+	}
+
+	if !league.IsInSeason(league.SeasonStart) {
+		t.Error("Season start should be in season")
+	}
+	if !league.IsInSeason(time.Date(2025, 10, 15, 19, 0, 0, 0, time.UTC)) {
+		t.Error("Mid season should be in season")
+	}
+	if league.IsInSeason(time.Date(2025, 11, 1, 19, 0, 0, 0, time.UTC)) {
+		t.Error("After season end should not be in season")
+	}
+}
+
+func TestLeaguePointScheme_PointsFor(t *testing.T) {
+	scheme := LeaguePointScheme{
+		PlacementPoints:     []int{10, 7, 5},
+		ParticipationPoints: 1,
+	}
+
+	first, fourth, invalid := 1, 4, 0
+
+	tests := []struct {
+		name      string
+		placement *int
+		want      int
+	}{
+		{"first place", &first, 11},
+		{"placement without points", &fourth, 1},
+		{"participation only", nil, 1},
+		{"invalid placement", &invalid, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scheme.PointsFor(tt.placement); got != tt.want {
+				t.Errorf("PointsFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeLeagueStandings(t *testing.T) {
+	scheme := LeaguePointScheme{
+		PlacementPoints:     []int{3, 2, 1},
+		ParticipationPoints: 1,
+	}
+
+	alice, bruno, carla, diogo := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	eventOne, eventTwo := uuid.New(), uuid.New()
+	first, second, third := 1, 2, 3
+
+	results := []*LeagueResult{
+		{EventID: eventOne, UserID: alice, Placement: &first},
+		{EventID: eventOne, UserID: bruno, Placement: &second},
+		{EventID: eventOne, UserID: carla, Placement: &third},
+		{EventID: eventTwo, UserID: bruno, Placement: &first},
+		{EventID: eventTwo, UserID: alice, Placement: &second},
+		{EventID: eventTwo, UserID: diogo},
+	}
+
+	standings := ComputeLeagueStandings(scheme, results)
+
+	if len(standings) != 4 {
+		t.Fatalf("Expected 4 standings, got %d", len(standings))
+	}
+
+	// Alice and Bruno both have 7 points, 1 win and a best placement of 1, so they share first place
+	if standings[0].Points != 7 || standings[1].Points != 7 {
+		t.Errorf("Expected the top two players to have 7 points, got %d and %d", standings[0].Points, standings[1].Points)
+	}
+	if standings[0].Rank != 1 || standings[1].Rank != 1 {
+		t.Errorf("Expected tied players to share rank 1, got %d and %d", standings[0].Rank, standings[1].Rank)
+	}
+	if standings[0].EventsPlayed != 2 || standings[0].Wins != 1 {
+		t.Errorf("Expected 2 events played and 1 win, got %d and %d", standings[0].EventsPlayed, standings[0].Wins)
+	}
+
+	// Carla has 2 points with a placement; Diogo has 1 point from participation only
+	if standings[2].UserID != carla || standings[2].Rank != 3 {
+		t.Errorf("Expected Carla in third place, got %v at rank %d", standings[2].UserID, standings[2].Rank)
+	}
+	if standings[3].UserID != diogo || standings[3].BestPlacement != nil {
+		t.Errorf("Expected Diogo last without a placement")
+	}
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupModerationActionType represents a kind of moderation action taken in a group
+type GroupModerationActionType string
+
+const (
+	GroupModerationActionRemoveMember GroupModerationActionType = "remove_member"
+	GroupModerationActionBan          GroupModerationActionType = "ban"
+	GroupModerationActionUnban        GroupModerationActionType = "unban"
+)
+
+// GroupBan bars a user from joining or taking part in a group until it expires or is lifted
+type GroupBan struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	GroupID   uuid.UUID  `json:"group_id" db:"group_id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	BannedBy  uuid.UUID  `json:"banned_by" db:"banned_by"`
+	Reason    *string    `json:"reason,omitempty" db:"reason"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" db:"expires_at"` // Nil for permanent bans
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// GroupModerationAction is an entry in a group's moderation audit trail
+type GroupModerationAction struct {
+	ID           uuid.UUID                 `json:"id" db:"id"`
+	GroupID      uuid.UUID                 `json:"group_id" db:"group_id"`
+	ActorUserID  uuid.UUID                 `json:"actor_user_id" db:"actor_user_id"`
+	TargetUserID uuid.UUID                 `json:"target_user_id" db:"target_user_id"`
+	Action       GroupModerationActionType `json:"action" db:"action"`
+	Reason       *string                   `json:"reason,omitempty" db:"reason"`
+	ExpiresAt    *time.Time                `json:"expires_at,omitempty" db:"expires_at"` // Ban expiry at the time of the action
+	CreatedAt    time.Time                 `json:"created_at" db:"created_at"`
+}
+
+var (
+	ErrBanReasonTooLong        = errors.New("ban reason cannot exceed 500 characters")
+	ErrInvalidBanExpiry        = errors.New("ban expiry must be after the ban is issued")
+	ErrCannotBanSelf           = errors.New("cannot ban yourself")
+	ErrInvalidModerationAction = errors.New("invalid moderation action")
+	ErrModerationReasonTooLong = errors.New("moderation reason cannot exceed 500 characters")
+	ErrModerationActorRequired = errors.New("moderation action requires an actor")
+)
+
+// Validate validates the GroupBan entity
+func (b *GroupBan) Validate() error {
+	if b.UserID == b.BannedBy {
+		return ErrCannotBanSelf
+	}
+
+	if b.Reason != nil && len(*b.Reason) > 500 {
+		return ErrBanReasonTooLong
+	}
+
+	if b.ExpiresAt != nil && !b.ExpiresAt.After(b.CreatedAt) {
+		return ErrInvalidBanExpiry
+	}
+
+	return nil
+}
+
+// IsActive checks if the ban is in effect at the given time
+func (b *GroupBan) IsActive(now time.Time) bool {
+	return b.ExpiresAt == nil || now.Before(*b.ExpiresAt)
+}
+
+// IsPermanent checks if the ban has no expiry
+func (b *GroupBan) IsPermanent() bool {
+	return b.ExpiresAt == nil
+}
+
+// Validate validates the GroupModerationAction entity
+func (a *GroupModerationAction) Validate() error {
+	if !IsValidGroupModerationAction(a.Action) {
+		return ErrInvalidModerationAction
+	}
+
+	if a.ActorUserID == uuid.Nil {
+		return ErrModerationActorRequired
+	}
+
+	if a.Reason != nil && len(*a.Reason) > 500 {
+		return ErrModerationReasonTooLong
+	}
+
+	return nil
+}
+
+// IsValidGroupModerationAction checks if the moderation action type is valid
+func IsValidGroupModerationAction(action GroupModerationActionType) bool {
+	switch action {
+	case GroupModerationActionRemoveMember, GroupModerationActionBan, GroupModerationActionUnban:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewGroupModerationAction records a moderation action taken by actorID against targetID
+func NewGroupModerationAction(groupID, actorID, targetID uuid.UUID, action GroupModerationActionType, reason *string, now time.Time) *GroupModerationAction {
+	return &GroupModerationAction{
+		ID:           uuid.New(),
+		GroupID:      groupID,
+		ActorUserID:  actorID,
+		TargetUserID: targetID,
+		Action:       action,
+		Reason:       reason,
+		CreatedAt:    now,
+	}
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGroupBan_Validate(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(7 * 24 * time.Hour)
+	adminID := uuid.New()
+	longReason := strings.Repeat("a", 501)
+
+	tests := []struct {
+		name    string
+		ban     GroupBan
+		wantErr error
+	}{
+		{
+			name: "valid temporary ban",
+			ban: GroupBan{
+				GroupID:   uuid.New(),
+				UserID:    uuid.New(),
+				BannedBy:  adminID,
+				ExpiresAt: &future,
+				CreatedAt: now,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "valid permanent ban",
+			ban: GroupBan{
+				UserID:    uuid.New(),
+				BannedBy:  adminID,
+				CreatedAt: now,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "banning yourself",
+			ban: GroupBan{
+				UserID:    adminID,
+				BannedBy:  adminID,
+				CreatedAt: now,
+			},
+			wantErr: ErrCannotBanSelf,
+		},
+		{
+			name: "reason too long",
+			ban: GroupBan{
+				UserID:    uuid.New(),
+				BannedBy:  adminID,
+				Reason:    &longReason,
+				CreatedAt: now,
+			},
+			wantErr: ErrBanReasonTooLong,
+		},
+		{
+			name: "expires before it is issued",
+			ban: GroupBan{
+				UserID:    uuid.New(),
+				BannedBy:  adminID,
+				ExpiresAt: &past,
+				CreatedAt: now,
+			},
+			wantErr: ErrInvalidBanExpiry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ban.Validate()
+			if err != tt.wantErr {
+				t.Errorf("GroupBan.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupBan_IsActive(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+
+	permanent := GroupBan{CreatedAt: now}
+	if !permanent.IsActive(now.AddDate(10, 0, 0)) {
+		t.Error("Expected permanent ban to stay active")
+	}
+
+	temporary := GroupBan{CreatedAt: now, ExpiresAt: &expiresAt}
+	if !temporary.IsActive(now) {
+		t.Error("Expected ban to be active before expiry")
+	}
+	if temporary.IsActive(expiresAt) {
+		t.Error("Expected ban to be lifted at expiry")
+	}
+}
+
+func TestGroupModerationAction_Validate(t *testing.T) {
+	action := NewGroupModerationAction(uuid.New(), uuid.New(), uuid.New(), GroupModerationActionBan, nil, time.Now())
+	if err := action.Validate(); err != nil {
+		t.Errorf("Expected valid action, got %v", err)
+	}
+
+	action.Action = "mute"
+	if err := action.Validate(); err != ErrInvalidModerationAction {
+		t.Errorf("Expected ErrInvalidModerationAction, got %v", err)
+	}
+}
@@ -87,6 +87,62 @@ func TestGroup_Validate(t *testing.T) {
 			},
 			wantErr: ErrGroupDescriptionTooLong,
 		},
+		{
+			name: "valid public group with location and games",
+			group: Group{
+				ID:          uuid.New(),
+				Name:        "Lisbon Lorcana",
+				OwnerUserID: uuid.New(),
+				Visibility:  GroupVisibilityPublic,
+				City:        stringPtr("Lisbon"),
+				Country:     stringPtr("PT"),
+				Latitude:    float64Ptr(38.7223),
+				Longitude:   float64Ptr(-9.1393),
+				Games:       []GameType{GameTypeLorcana, GameTypeMTG},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid visibility",
+			group: Group{
+				ID:          uuid.New(),
+				Name:        "Magic Players",
+				OwnerUserID: uuid.New(),
+				Visibility:  "hidden",
+			},
+			wantErr: ErrInvalidGroupVisibility,
+		},
+		{
+			name: "latitude without longitude",
+			group: Group{
+				ID:          uuid.New(),
+				Name:        "Magic Players",
+				OwnerUserID: uuid.New(),
+				Latitude:    float64Ptr(38.7223),
+			},
+			wantErr: ErrIncompleteGroupLocation,
+		},
+		{
+			name: "out of range coordinates",
+			group: Group{
+				ID:          uuid.New(),
+				Name:        "Magic Players",
+				OwnerUserID: uuid.New(),
+				Latitude:    float64Ptr(120),
+				Longitude:   float64Ptr(-9.1393),
+			},
+			wantErr: ErrInvalidCoordinates,
+		},
+		{
+			name: "invalid game",
+			group: Group{
+				ID:          uuid.New(),
+				Name:        "Magic Players",
+				OwnerUserID: uuid.New(),
+				Games:       []GameType{"chess"},
+			},
+			wantErr: ErrInvalidGameType,
+		},
 	}
 
 	for _, tt := range tests {
@@ -279,3 +335,8 @@ func TestGroupMember_RoleChecks(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+// Helper function for float64 pointers
+func float64Ptr(f float64) *float64 {
+	return &f
+}
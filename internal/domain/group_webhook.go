@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GroupWebhook represents a Discord webhook registered by a group for event announcements
+type GroupWebhook struct {
+	ID              uuid.UUID  `json:"id" db:"id"`
+	GroupID         uuid.UUID  `json:"group_id" db:"group_id"`
+	URL             string     `json:"-" db:"url"`
+	Locale          string     `json:"locale" db:"locale"`
+	Timezone        string     `json:"timezone" db:"timezone"`
+	IsActive        bool       `json:"is_active" db:"is_active"`
+	CreatedBy       *uuid.UUID `json:"created_by,omitempty" db:"created_by"`
+	LastDeliveredAt *time.Time `json:"last_delivered_at,omitempty" db:"last_delivered_at"`
+	LastError       *string    `json:"last_error,omitempty" db:"last_error"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+var (
+	ErrInvalidWebhookURL      = errors.New("webhook URL must be a Discord webhook URL")
+	ErrInvalidWebhookTimezone = errors.New("invalid webhook timezone")
+)
+
+// discordWebhookHosts lists the hosts Discord issues webhook URLs on
+var discordWebhookHosts = map[string]bool{
+	"discord.com":        true,
+	"discordapp.com":     true,
+	"ptb.discord.com":    true,
+	"canary.discord.com": true,
+}
+
+// Validate validates the GroupWebhook entity
+func (w *GroupWebhook) Validate() error {
+	if !IsValidDiscordWebhookURL(w.URL) {
+		return ErrInvalidWebhookURL
+	}
+
+	if w.Locale != "en" && w.Locale != "pt" {
+		return ErrInvalidLocale
+	}
+
+	if w.Timezone == "" {
+		return ErrInvalidWebhookTimezone
+	}
+	if _, err := time.LoadLocation(w.Timezone); err != nil {
+		return ErrInvalidWebhookTimezone
+	}
+
+	return nil
+}
+
+// MaskedURL returns the webhook URL with its secret token hidden
+func (w *GroupWebhook) MaskedURL() string {
+	idx := strings.LastIndex(w.URL, "/")
+	if idx < 0 {
+		return ""
+	}
+
+	token := w.URL[idx+1:]
+	if len(token) <= 4 {
+		return w.URL[:idx+1] + "****"
+	}
+	return w.URL[:idx+1] + "****" + token[len(token)-4:]
+}
+
+// IsValidDiscordWebhookURL checks if a URL points to a Discord webhook
+func IsValidDiscordWebhookURL(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != "https" || !discordWebhookHosts[strings.ToLower(u.Host)] {
+		return false
+	}
+
+	// Expected path: /api/webhooks/{id}/{token}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) != 4 || parts[0] != "api" || parts[1] != "webhooks" {
+		return false
+	}
+
+	return parts[2] != "" && parts[3] != ""
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGroupWebhook_Validate(t *testing.T) {
+	validURL := "https://discord.com/api/webhooks/123456789/abcdefTOKEN"
+
+	tests := []struct {
+		name    string
+		webhook GroupWebhook
+		wantErr error
+	}{
+		{
+			name: "valid webhook",
+			webhook: GroupWebhook{
+				ID:       uuid.New(),
+				GroupID:  uuid.New(),
+				URL:      validURL,
+				Locale:   "pt",
+				Timezone: "Europe/Lisbon",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "non discord host",
+			webhook: GroupWebhook{
+				URL:      "https://example.com/api/webhooks/123/token",
+				Locale:   "en",
+				Timezone: "UTC",
+			},
+			wantErr: ErrInvalidWebhookURL,
+		},
+		{
+			name: "plain http",
+			webhook: GroupWebhook{
+				URL:      "http://discord.com/api/webhooks/123/token",
+				Locale:   "en",
+				Timezone: "UTC",
+			},
+			wantErr: ErrInvalidWebhookURL,
+		},
+		{
+			name: "missing token",
+			webhook: GroupWebhook{
+				URL:      "https://discord.com/api/webhooks/123",
+				Locale:   "en",
+				Timezone: "UTC",
+			},
+			wantErr: ErrInvalidWebhookURL,
+		},
+		{
+			name: "invalid locale",
+			webhook: GroupWebhook{
+				URL:      validURL,
+				Locale:   "fr",
+				Timezone: "UTC",
+			},
+			wantErr: ErrInvalidLocale,
+		},
+		{
+			name: "empty timezone",
+			webhook: GroupWebhook{
+				URL:    validURL,
+				Locale: "en",
+			},
+			wantErr: ErrInvalidWebhookTimezone,
+		},
+		{
+			name: "unknown timezone",
+			webhook: GroupWebhook{
+				URL:      validURL,
+				Locale:   "en",
+				Timezone: "Mars/Olympus_Mons",
+			},
+			wantErr: ErrInvalidWebhookTimezone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.webhook.Validate()
+			if err != tt.wantErr {
+				t.Errorf("GroupWebhook.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroupWebhook_MaskedURL(t *testing.T) {
+	webhook := GroupWebhook{URL: "https://discord.com/api/webhooks/123456789/abcdefTOKEN"}
+
+	want := "https://discord.com/api/webhooks/123456789/****OKEN"
+	if got := webhook.MaskedURL(); got != want {
+		t.Errorf("GroupWebhook.MaskedURL() = %v, want %v", got, want)
+	}
+}
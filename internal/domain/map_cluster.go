@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// MapClusterLayer identifies what a map cluster counts
+type MapClusterLayer string
+
+const (
+	MapClusterLayerVenues MapClusterLayer = "venues"
+	MapClusterLayerEvents MapClusterLayer = "events"
+)
+
+const (
+	// MinMapZoom and MaxMapZoom bound web map zoom levels
+	MinMapZoom = 0
+	MaxMapZoom = 20
+
+	// MapClusterCellsPerTile splits each 256px map tile into cells of roughly 64px
+	MapClusterCellsPerTile = 4
+
+	// MaxMapClusterCells caps how many grid cells a single request may span
+	MaxMapClusterCells = 4096
+)
+
+var (
+	ErrInvalidMapZoom    = errors.New("map zoom must be between 0 and 20")
+	ErrInvalidMapBounds  = errors.New("map bounds must have south below north and west below east")
+	ErrMapBoundsTooLarge = errors.New("map bounds span too many clusters for the zoom level")
+	ErrInvalidMapLayer   = errors.New("map layer must be venues or events")
+)
+
+// MapClusterParams represents the map area and filters to cluster
+type MapClusterParams struct {
+	Bounds BoundingBox `json:"bounds"`
+	Zoom   int         `json:"zoom"`
+	Game   *GameType   `json:"game,omitempty"`
+
+	// Events starting at or after From count as upcoming, both for the events layer and for the
+	// game breakdown of venues
+	From time.Time `json:"from"`
+}
+
+// MapGrid divides the map into square cells whose size in degrees halves with each zoom level
+type MapGrid struct {
+	Zoom     int     `json:"zoom"`
+	CellSize float64 `json:"cell_size"` // Degrees of latitude and longitude
+}
+
+// MapClusterBucket is a partial count for one grid cell, as aggregated by the database. Buckets for
+// the same cell are summed.
+type MapClusterBucket struct {
+	CellX        int       `json:"cell_x"`
+	CellY        int       `json:"cell_y"`
+	Game         *GameType `json:"game,omitempty"` // Set for per-game breakdowns
+	Count        int       `json:"count"`
+	LatitudeSum  float64   `json:"latitude_sum"`
+	LongitudeSum float64   `json:"longitude_sum"`
+}
+
+// MapCluster groups the venues or events within one grid cell. It sits at their average position.
+type MapCluster struct {
+	ID        string           `json:"id"` // zoom/x/y
+	Layer     MapClusterLayer  `json:"layer"`
+	Latitude  float64          `json:"latitude"`
+	Longitude float64          `json:"longitude"`
+	Count     int              `json:"count"`
+	Games     map[GameType]int `json:"games"`
+	Bounds    BoundingBox      `json:"bounds"`
+}
+
+// Validate validates the map cluster parameters
+func (p *MapClusterParams) Validate() error {
+	if p.Zoom < MinMapZoom || p.Zoom > MaxMapZoom {
+		return ErrInvalidMapZoom
+	}
+
+	if err := p.Bounds.NorthEast.Validate(); err != nil {
+		return err
+	}
+	if err := p.Bounds.SouthWest.Validate(); err != nil {
+		return err
+	}
+
+	if p.Bounds.SouthWest.Latitude >= p.Bounds.NorthEast.Latitude ||
+		p.Bounds.SouthWest.Longitude >= p.Bounds.NorthEast.Longitude {
+		return ErrInvalidMapBounds
+	}
+
+	if p.Game != nil && !IsValidGame(*p.Game) {
+		return ErrInvalidGameType
+	}
+
+	return nil
+}
+
+// IsValidMapClusterLayer checks if the map cluster layer is valid
+func IsValidMapClusterLayer(layer MapClusterLayer) bool {
+	switch layer {
+	case MapClusterLayerVenues, MapClusterLayerEvents:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewMapGrid creates the cluster grid for a zoom level
+func NewMapGrid(zoom int) (*MapGrid, error) {
+	if zoom < MinMapZoom || zoom > MaxMapZoom {
+		return nil, ErrInvalidMapZoom
+	}
+
+	return &MapGrid{
+		Zoom:     zoom,
+		CellSize: 360 / math.Exp2(float64(zoom)) / MapClusterCellsPerTile,
+	}, nil
+}
+
+// Cell returns the grid cell containing a coordinate
+func (g *MapGrid) Cell(lat, lon float64) (int, int) {
+	return int(math.Floor(lon / g.CellSize)), int(math.Floor(lat / g.CellSize))
+}
+
+// CellBounds returns the area covered by a grid cell
+func (g *MapGrid) CellBounds(x, y int) BoundingBox {
+	return BoundingBox{
+		NorthEast: Coordinates{Latitude: float64(y+1) * g.CellSize, Longitude: float64(x+1) * g.CellSize},
+		SouthWest: Coordinates{Latitude: float64(y) * g.CellSize, Longitude: float64(x) * g.CellSize},
+	}
+}
+
+// ClusterID identifies a grid cell at the grid's zoom level
+func (g *MapGrid) ClusterID(x, y int) string {
+	return fmt.Sprintf("%d/%d/%d", g.Zoom, x, y)
+}
+
+// CountCells returns how many grid cells the bounds overlap
+func (g *MapGrid) CountCells(bounds BoundingBox) int {
+	westX, southY := g.Cell(bounds.SouthWest.Latitude, bounds.SouthWest.Longitude)
+	eastX, northY := g.Cell(bounds.NorthEast.Latitude, bounds.NorthEast.Longitude)
+	return (eastX - westX + 1) * (northY - southY + 1)
+}
+
+// BuildClusters turns database buckets into clusters. Totals give each cell's count and position;
+// games give its per-game breakdown. Cells without a total are skipped. Clusters are ordered by
+// count, largest first.
+func (g *MapGrid) BuildClusters(layer MapClusterLayer, totals, games []MapClusterBucket) []*MapCluster {
+	type cellKey struct{ x, y int }
+
+	clusters := make(map[cellKey]*MapCluster)
+	sums := make(map[cellKey][2]float64)
+
+	for _, bucket := range totals {
+		key := cellKey{bucket.CellX, bucket.CellY}
+		cluster, ok := clusters[key]
+		if !ok {
+			cluster = &MapCluster{
+				ID:     g.ClusterID(bucket.CellX, bucket.CellY),
+				Layer:  layer,
+				Games:  make(map[GameType]int),
+				Bounds: g.CellBounds(bucket.CellX, bucket.CellY),
+			}
+			clusters[key] = cluster
+		}
+
+		cluster.Count += bucket.Count
+		sum := sums[key]
+		sums[key] = [2]float64{sum[0] + bucket.LatitudeSum, sum[1] + bucket.LongitudeSum}
+	}
+
+	for _, bucket := range games {
+		cluster, ok := clusters[cellKey{bucket.CellX, bucket.CellY}]
+		if !ok || bucket.Game == nil {
+			continue
+		}
+		cluster.Games[*bucket.Game] += bucket.Count
+	}
+
+	result := make([]*MapCluster, 0, len(clusters))
+	for key, cluster := range clusters {
+		if cluster.Count == 0 {
+			continue
+		}
+		cluster.Latitude = sums[key][0] / float64(cluster.Count)
+		cluster.Longitude = sums[key][1] / float64(cluster.Count)
+		result = append(result, cluster)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestMapClusterParams_Validate(t *testing.T) {
+	portugal := BoundingBox{
+		NorthEast: Coordinates{Latitude: 42.2, Longitude: -6.1},
+		SouthWest: Coordinates{Latitude: 36.9, Longitude: -9.6},
+	}
+	invalidGame := GameType("chess")
+
+	tests := []struct {
+		name    string
+		params  MapClusterParams
+		wantErr error
+	}{
+		{"valid", MapClusterParams{Bounds: portugal, Zoom: 7}, nil},
+		{"zoom too low", MapClusterParams{Bounds: portugal, Zoom: -1}, ErrInvalidMapZoom},
+		{"zoom too high", MapClusterParams{Bounds: portugal, Zoom: MaxMapZoom + 1}, ErrInvalidMapZoom},
+		{"swapped corners", MapClusterParams{Bounds: BoundingBox{NorthEast: portugal.SouthWest, SouthWest: portugal.NorthEast}, Zoom: 7}, ErrInvalidMapBounds},
+		{"out of range", MapClusterParams{Bounds: BoundingBox{NorthEast: Coordinates{Latitude: 95, Longitude: 0}, SouthWest: portugal.SouthWest}, Zoom: 7}, ErrInvalidCoordinates},
+		{"invalid game", MapClusterParams{Bounds: portugal, Zoom: 7, Game: &invalidGame}, ErrInvalidGameType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); err != tt.wantErr {
+				t.Errorf("MapClusterParams.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewMapGrid(t *testing.T) {
+	tests := []struct {
+		zoom     int
+		cellSize float64
+		wantErr  error
+	}{
+		{0, 90, nil},
+		{1, 45, nil},
+		{8, 360.0 / 256 / 4, nil},
+		{21, 0, ErrInvalidMapZoom},
+	}
+
+	for _, tt := range tests {
+		grid, err := NewMapGrid(tt.zoom)
+		if err != tt.wantErr {
+			t.Errorf("NewMapGrid(%d) error = %v, wantErr %v", tt.zoom, err, tt.wantErr)
+			continue
+		}
+		if err == nil && grid.CellSize != tt.cellSize {
+			t.Errorf("NewMapGrid(%d).CellSize = %v, want %v", tt.zoom, grid.CellSize, tt.cellSize)
+		}
+	}
+}
+
+func TestMapGrid_Cell(t *testing.T) {
+	grid, _ := NewMapGrid(2) // 22.5 degree cells
+
+	x, y := grid.Cell(41.15, -8.61)
+	if x != -1 || y != 1 {
+		t.Errorf("MapGrid.Cell() = (%d, %d), want (-1, 1)", x, y)
+	}
+
+	bounds := grid.CellBounds(x, y)
+	if !bounds.Contains(Coordinates{Latitude: 41.15, Longitude: -8.61}) {
+		t.Errorf("MapGrid.CellBounds() = %+v does not contain the point", bounds)
+	}
+
+	if id := grid.ClusterID(x, y); id != "2/-1/1" {
+		t.Errorf("MapGrid.ClusterID() = %q, want %q", id, "2/-1/1")
+	}
+
+	portugal := BoundingBox{
+		NorthEast: Coordinates{Latitude: 42.2, Longitude: -6.1},
+		SouthWest: Coordinates{Latitude: 36.9, Longitude: -9.6},
+	}
+	if cells := grid.CountCells(portugal); cells != 1 {
+		t.Errorf("MapGrid.CountCells() = %d, want 1", cells)
+	}
+}
+
+func TestMapGrid_BuildClusters(t *testing.T) {
+	grid, _ := NewMapGrid(10)
+	mtg, pokemon := GameTypeMTG, GameTypePokemon
+
+	totals := []MapClusterBucket{
+		{CellX: 1, CellY: 2, Count: 2, LatitudeSum: 82.3, LongitudeSum: -17.2},
+		{CellX: 1, CellY: 2, Count: 1, LatitudeSum: 41.2, LongitudeSum: -8.6},
+		{CellX: 5, CellY: 5, Count: 1, LatitudeSum: 38.7, LongitudeSum: -9.1},
+	}
+	games := []MapClusterBucket{
+		{CellX: 1, CellY: 2, Game: &mtg, Count: 2},
+		{CellX: 1, CellY: 2, Game: &pokemon, Count: 1},
+		{CellX: 9, CellY: 9, Game: &mtg, Count: 4}, // No total, so no cluster
+	}
+
+	clusters := grid.BuildClusters(MapClusterLayerEvents, totals, games)
+
+	if len(clusters) != 2 {
+		t.Fatalf("BuildClusters() returned %d clusters, want 2", len(clusters))
+	}
+
+	largest := clusters[0]
+	if largest.ID != "10/1/2" || largest.Count != 3 || largest.Layer != MapClusterLayerEvents {
+		t.Errorf("largest cluster = %+v, want 10/1/2 with 3 events", largest)
+	}
+	if largest.Latitude != 123.5/3 || largest.Longitude != -25.8/3 {
+		t.Errorf("largest cluster position = (%v, %v), want the average", largest.Latitude, largest.Longitude)
+	}
+	if largest.Games[GameTypeMTG] != 2 || largest.Games[GameTypePokemon] != 1 {
+		t.Errorf("largest cluster games = %v, want mtg 2 and pokemon 1", largest.Games)
+	}
+
+	if clusters[1].Count != 1 || len(clusters[1].Games) != 0 {
+		t.Errorf("second cluster = %+v, want 1 event without games", clusters[1])
+	}
+}
@@ -21,11 +21,21 @@ const (
 type NotificationType string
 
 const (
-	NotificationTypeEventRSVP     NotificationType = "event_rsvp"
-	NotificationTypeEventUpdate   NotificationType = "event_update"
-	NotificationTypeEventReminder NotificationType = "event_reminder"
-	NotificationTypeGroupInvite   NotificationType = "group_invite"
-	NotificationTypeGroupEvent    NotificationType = "group_event"
+	NotificationTypeEventRSVP                NotificationType = "event_rsvp"
+	NotificationTypeEventUpdate              NotificationType = "event_update"
+	NotificationTypeEventReminder            NotificationType = "event_reminder"
+	NotificationTypeGroupInvite              NotificationType = "group_invite"
+	NotificationTypeGroupEvent               NotificationType = "group_event"
+	NotificationTypeGroupJoinRequest         NotificationType = "group_join_request"
+	NotificationTypeGroupJoinRequestReviewed NotificationType = "group_join_request_reviewed"
+	NotificationTypeGroupAnnouncement        NotificationType = "group_announcement"
+	NotificationTypeEventCoHostInvite        NotificationType = "event_cohost_invite"
+	NotificationTypeEventCoHostResponse      NotificationType = "event_cohost_response"
+	NotificationTypeEventInvite              NotificationType = "event_invite"
+	NotificationTypeMarketingDigest          NotificationType = "marketing_digest"
+	NotificationTypeDataExportReady          NotificationType = "data_export_ready"
+	NotificationTypeEventCancelled           NotificationType = "event_cancelled"
+	NotificationTypeAccountDeletionScheduled NotificationType = "account_deletion_scheduled"
 )
 
 // Notification represents a notification in the system
@@ -72,13 +82,29 @@ func (n *Notification) Validate() error {
 func (n *Notification) IsValidType() bool {
 	switch n.Type {
 	case NotificationTypeEventRSVP, NotificationTypeEventUpdate, NotificationTypeEventReminder,
-		NotificationTypeGroupInvite, NotificationTypeGroupEvent:
+		NotificationTypeGroupInvite, NotificationTypeGroupEvent,
+		NotificationTypeGroupJoinRequest, NotificationTypeGroupJoinRequestReviewed,
+		NotificationTypeGroupAnnouncement,
+		NotificationTypeEventCoHostInvite, NotificationTypeEventCoHostResponse,
+		NotificationTypeEventInvite, NotificationTypeMarketingDigest,
+		NotificationTypeDataExportReady, NotificationTypeEventCancelled,
+		NotificationTypeAccountDeletionScheduled:
 		return true
 	default:
 		return false
 	}
 }
 
+// RequiredConsent returns the consent a user must have granted before this notification may be sent
+func (n *Notification) RequiredConsent() (ConsentType, bool) {
+	switch n.Type {
+	case NotificationTypeMarketingDigest:
+		return ConsentTypeMarketingEmails, true
+	default:
+		return "", false
+	}
+}
+
 // IsValidStatus checks if the notification status is valid
 func (n *Notification) IsValidStatus() bool {
 	switch n.Status {
@@ -28,8 +28,29 @@ const (
 	PermissionRemoveMembers    Permission = "remove_members"
 	PermissionEditGroupDetails Permission = "edit_group_details"
 	PermissionDeleteGroup      Permission = "delete_group"
+
+	PermissionViewAnnouncements   Permission = "view_announcements"
+	PermissionManageAnnouncements Permission = "manage_announcements"
+
+	PermissionBanMembers        Permission = "ban_members"
+	PermissionViewModerationLog Permission = "view_moderation_log"
+
+	// Granular permissions that custom group roles can grant
+	PermissionCreateEvent       Permission = "create_event"
+	PermissionCheckIn           Permission = "check_in"
+	PermissionManageMembers     Permission = "manage_members"
+	PermissionPostAnnouncements Permission = "post_announcements"
+
+	// Event permissions that a host can delegate to co-hosts
+	PermissionManageAttendees Permission = "manage_attendees"
+	PermissionManageCoHosts   Permission = "manage_cohosts"
 )
 
+// memberBasePermissions are the grantable permissions every group member has without a custom role
+var memberBasePermissions = []Permission{
+	PermissionCreateEvent,
+}
+
 // PermissionService handles access control for events and groups
 type PermissionService struct{}
 
@@ -50,8 +71,8 @@ func (s *PermissionService) CanViewEvent(event *Event, userID uuid.UUID, userGro
 		return true
 
 	case EventVisibilityPrivate:
-		// Only the host can view private events
-		return event.HostUserID == userID
+		// Only the host and co-hosts can view private events
+		return event.IsOrganizer(userID)
 
 	case EventVisibilityGroupOnly:
 		// Must be a member of the event's group
@@ -72,7 +93,53 @@ func (s *PermissionService) CanEditEvent(event *Event, userID uuid.UUID, userGro
 		return true
 	}
 
-	// Group admins can edit group events
+	if s.isEventCoHostWith(event, userID, PermissionEditEvent) {
+		return true
+	}
+
+	// Group admins and members whose custom role grants it can edit group events
+	if event.GroupID != nil {
+		return s.HasPermission(*event.GroupID, userID, userGroups, PermissionEditEvent)
+	}
+
+	return false
+}
+
+// CanCreateGroupEvent checks if a user can host an event in a group
+func (s *PermissionService) CanCreateGroupEvent(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
+	return s.HasPermission(groupID, userID, userGroups, PermissionCreateEvent)
+}
+
+// CanCheckInAttendees checks if a user can check attendees in at an event
+func (s *PermissionService) CanCheckInAttendees(event *Event, userID uuid.UUID, userGroups []GroupMember) bool {
+	// Event host can always check in their own attendees
+	if event.HostUserID == userID {
+		return true
+	}
+
+	if s.isEventCoHostWith(event, userID, PermissionCheckIn) {
+		return true
+	}
+
+	if event.GroupID != nil {
+		return s.HasPermission(*event.GroupID, userID, userGroups, PermissionCheckIn)
+	}
+
+	return false
+}
+
+// CanManageAttendees checks if a user can manage an event's attendee list
+func (s *PermissionService) CanManageAttendees(event *Event, userID uuid.UUID, userGroups []GroupMember) bool {
+	// Event host can always manage their own attendees
+	if event.HostUserID == userID {
+		return true
+	}
+
+	if s.isEventCoHostWith(event, userID, PermissionManageAttendees) {
+		return true
+	}
+
+	// Group admins can manage attendees of group events
 	if event.GroupID != nil {
 		return s.hasGroupPermission(*event.GroupID, userID, userGroups, GroupRoleAdmin)
 	}
@@ -80,6 +147,12 @@ func (s *PermissionService) CanEditEvent(event *Event, userID uuid.UUID, userGro
 	return false
 }
 
+// CanManageCoHosts checks if a user can invite, update and remove an event's co-hosts
+func (s *PermissionService) CanManageCoHosts(event *Event, userID uuid.UUID) bool {
+	// Only the host delegates rights; co-hosts cannot appoint further co-hosts
+	return event.HostUserID == userID
+}
+
 // CanDeleteEvent checks if a user can delete an event
 func (s *PermissionService) CanDeleteEvent(event *Event, userID uuid.UUID, userGroups []GroupMember) bool {
 	// Event host can always delete their own events
@@ -98,7 +171,7 @@ func (s *PermissionService) CanDeleteEvent(event *Event, userID uuid.UUID, userG
 // CanRSVPToEvent checks if a user can RSVP to an event
 func (s *PermissionService) CanRSVPToEvent(event *Event, userID uuid.UUID, userGroups []GroupMember) bool {
 	// Cannot RSVP to your own event
-	if event.HostUserID == userID {
+	if event.IsOrganizer(userID) {
 		return false
 	}
 
@@ -108,8 +181,8 @@ func (s *PermissionService) CanRSVPToEvent(event *Event, userID uuid.UUID, userG
 
 // CanViewAttendees checks if a user can view event attendees
 func (s *PermissionService) CanViewAttendees(event *Event, userID uuid.UUID, userGroups []GroupMember) bool {
-	// Event host can always view attendees
-	if event.HostUserID == userID {
+	// Event host and co-hosts managing attendees can always view attendees
+	if s.CanManageAttendees(event, userID, userGroups) {
 		return true
 	}
 
@@ -132,9 +205,9 @@ func (s *PermissionService) CanManageGroup(groupID uuid.UUID, userID uuid.UUID,
 	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleAdmin)
 }
 
-// CanInviteMembers checks if a user can invite members to a group
+// CanInviteMembers checks if a user can invite members to a group and review join requests
 func (s *PermissionService) CanInviteMembers(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
-	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleAdmin)
+	return s.HasPermission(groupID, userID, userGroups, PermissionManageMembers)
 }
 
 // CanRemoveMembers checks if a user can remove members from a group
@@ -161,9 +234,51 @@ func (s *PermissionService) CanRemoveMembers(groupID uuid.UUID, userID uuid.UUID
 		return targetMember.Role == GroupRoleMember
 	}
 
+	// Members granted member management can remove regular members who cannot manage members themselves
+	if userMember.HasGrantedPermission(PermissionManageMembers) {
+		return targetMember.Role == GroupRoleMember && !targetMember.HasGrantedPermission(PermissionManageMembers)
+	}
+
 	return false
 }
 
+// CanBanMember checks if a user can ban another user from a group.
+// The target does not need to be a member; users outside the group can be banned pre-emptively.
+func (s *PermissionService) CanBanMember(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember, targetUserID uuid.UUID) bool {
+	// Cannot ban yourself (use leave group instead)
+	if userID == targetUserID {
+		return false
+	}
+
+	userMember := s.getUserGroupMember(groupID, userID, userGroups)
+	if userMember == nil || !userMember.HasPermission(GroupRoleAdmin) {
+		return false
+	}
+
+	targetMember := s.getUserGroupMember(groupID, targetUserID, userGroups)
+	if targetMember == nil {
+		return true
+	}
+
+	// Owners can ban anyone except other owners
+	if userMember.Role == GroupRoleOwner {
+		return targetMember.Role != GroupRoleOwner
+	}
+
+	// Admins can ban regular members only
+	return targetMember.Role == GroupRoleMember
+}
+
+// CanUnbanMember checks if a user can lift a ban in a group
+func (s *PermissionService) CanUnbanMember(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
+	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleAdmin)
+}
+
+// CanViewModerationLog checks if a user can view a group's bans and moderation audit trail
+func (s *PermissionService) CanViewModerationLog(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
+	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleAdmin)
+}
+
 // CanEditGroupDetails checks if a user can edit group details
 func (s *PermissionService) CanEditGroupDetails(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
 	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleAdmin)
@@ -174,6 +289,21 @@ func (s *PermissionService) CanDeleteGroup(groupID uuid.UUID, userID uuid.UUID,
 	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleOwner)
 }
 
+// CanViewAnnouncements checks if a user can read a group's announcement board
+func (s *PermissionService) CanViewAnnouncements(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
+	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleMember)
+}
+
+// CanManageAnnouncements checks if a user can post, edit, pin and delete group announcements
+func (s *PermissionService) CanManageAnnouncements(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
+	return s.HasPermission(groupID, userID, userGroups, PermissionPostAnnouncements)
+}
+
+// CanManageCustomRoles checks if a user can define a group's custom roles and assign them to members
+func (s *PermissionService) CanManageCustomRoles(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember) bool {
+	return s.hasGroupPermission(groupID, userID, userGroups, GroupRoleAdmin)
+}
+
 // CanUpdateMemberRole checks if a user can update another member's role
 func (s *PermissionService) CanUpdateMemberRole(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember, targetUserID uuid.UUID, newRole GroupRole) bool {
 	// Cannot update your own role
@@ -253,6 +383,18 @@ func (s *PermissionService) GetUserEventPermissions(event *Event, userID uuid.UU
 		permissions = append(permissions, PermissionViewAttendees)
 	}
 
+	if s.CanManageAttendees(event, userID, userGroups) {
+		permissions = append(permissions, PermissionManageAttendees)
+	}
+
+	if s.CanCheckInAttendees(event, userID, userGroups) {
+		permissions = append(permissions, PermissionCheckIn)
+	}
+
+	if s.CanManageCoHosts(event, userID) {
+		permissions = append(permissions, PermissionManageCoHosts)
+	}
+
 	return permissions
 }
 
@@ -276,9 +418,53 @@ func (s *PermissionService) GetUserGroupPermissions(groupID uuid.UUID, userID uu
 		permissions = append(permissions, PermissionDeleteGroup)
 	}
 
+	if s.CanViewAnnouncements(groupID, userID, userGroups) {
+		permissions = append(permissions, PermissionViewAnnouncements)
+	}
+
+	if s.CanManageAnnouncements(groupID, userID, userGroups) {
+		permissions = append(permissions, PermissionManageAnnouncements)
+	}
+
+	if s.CanViewModerationLog(groupID, userID, userGroups) {
+		permissions = append(permissions, PermissionViewModerationLog)
+	}
+
+	for _, permission := range GrantablePermissions {
+		if s.HasPermission(groupID, userID, userGroups, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+
 	return permissions
 }
 
+// HasPermission checks if a user holds a grantable permission in a group.
+// Owners and admins hold every grantable permission; members hold the base member
+// permissions plus whatever their custom role grants.
+func (s *PermissionService) HasPermission(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember, permission Permission) bool {
+	if !IsGrantablePermission(permission) {
+		return false
+	}
+
+	member := s.getUserGroupMember(groupID, userID, userGroups)
+	if member == nil {
+		return false
+	}
+
+	if member.IsAdmin() {
+		return true
+	}
+
+	for _, basePermission := range memberBasePermissions {
+		if basePermission == permission {
+			return true
+		}
+	}
+
+	return member.HasGrantedPermission(permission)
+}
+
 // Helper methods
 
 // isUserGroupMember checks if a user is a member of a specific group
@@ -291,6 +477,12 @@ func (s *PermissionService) isUserGroupMember(groupID uuid.UUID, userID uuid.UUI
 	return false
 }
 
+// isEventCoHostWith checks if a user is an accepted co-host of the event with a delegated permission
+func (s *PermissionService) isEventCoHostWith(event *Event, userID uuid.UUID, permission Permission) bool {
+	coHost := event.GetCoHost(userID)
+	return coHost != nil && coHost.Grants(permission)
+}
+
 // hasGroupPermission checks if a user has at least the specified role in a group
 func (s *PermissionService) hasGroupPermission(groupID uuid.UUID, userID uuid.UUID, userGroups []GroupMember, requiredRole GroupRole) bool {
 	member := s.getUserGroupMember(groupID, userID, userGroups)
@@ -412,6 +412,28 @@ func TestPermissionService_GroupPermissions(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Announcements", func(t *testing.T) {
+		tests := []struct {
+			userID     uuid.UUID
+			wantView   bool
+			wantManage bool
+		}{
+			{ownerID, true, true},
+			{adminID, true, true},
+			{memberID, true, false},
+			{nonMemberID, false, false},
+		}
+
+		for _, tt := range tests {
+			if got := service.CanViewAnnouncements(groupID, tt.userID, userGroups); got != tt.wantView {
+				t.Errorf("CanViewAnnouncements(%v) = %v, want %v", tt.userID, got, tt.wantView)
+			}
+			if got := service.CanManageAnnouncements(groupID, tt.userID, userGroups); got != tt.wantManage {
+				t.Errorf("CanManageAnnouncements(%v) = %v, want %v", tt.userID, got, tt.wantManage)
+			}
+		}
+	})
 }
 
 func TestPermissionService_CanRemoveMembers(t *testing.T) {
@@ -455,6 +477,146 @@ func TestPermissionService_CanRemoveMembers(t *testing.T) {
 	}
 }
 
+func TestPermissionService_CanBanMember(t *testing.T) {
+	service := NewPermissionService()
+
+	groupID := uuid.New()
+	ownerID := uuid.New()
+	adminID := uuid.New()
+	otherAdminID := uuid.New()
+	memberID := uuid.New()
+	nonMemberID := uuid.New()
+
+	userGroups := []GroupMember{
+		{GroupID: groupID, UserID: ownerID, Role: GroupRoleOwner, JoinedAt: time.Now()},
+		{GroupID: groupID, UserID: adminID, Role: GroupRoleAdmin, JoinedAt: time.Now()},
+		{GroupID: groupID, UserID: otherAdminID, Role: GroupRoleAdmin, JoinedAt: time.Now()},
+		{GroupID: groupID, UserID: memberID, Role: GroupRoleMember, JoinedAt: time.Now()},
+	}
+
+	tests := []struct {
+		name         string
+		userID       uuid.UUID
+		targetUserID uuid.UUID
+		want         bool
+	}{
+		{"owner can ban admin", ownerID, adminID, true},
+		{"owner can ban member", ownerID, memberID, true},
+		{"admin can ban member", adminID, memberID, true},
+		{"admin can ban non-member", adminID, nonMemberID, true},
+		{"admin cannot ban another admin", adminID, otherAdminID, false},
+		{"admin cannot ban owner", adminID, ownerID, false},
+		{"member cannot ban anyone", memberID, nonMemberID, false},
+		{"non-member cannot ban anyone", nonMemberID, memberID, false},
+		{"cannot ban self", ownerID, ownerID, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := service.CanBanMember(groupID, tt.userID, userGroups, tt.targetUserID)
+			if got != tt.want {
+				t.Errorf("CanBanMember() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermissionService_CustomRolePermissions(t *testing.T) {
+	service := NewPermissionService()
+
+	groupID := uuid.New()
+	adminID := uuid.New()
+	judgeID := uuid.New()
+	moderatorID := uuid.New()
+	memberID := uuid.New()
+	roleID := uuid.New()
+
+	userGroups := []GroupMember{
+		{GroupID: groupID, UserID: adminID, Role: GroupRoleAdmin, JoinedAt: time.Now()},
+		{GroupID: groupID, UserID: judgeID, Role: GroupRoleMember, JoinedAt: time.Now(), CustomRoleID: &roleID, Permissions: []Permission{PermissionEditEvent, PermissionCheckIn}},
+		{GroupID: groupID, UserID: moderatorID, Role: GroupRoleMember, JoinedAt: time.Now(), CustomRoleID: &roleID, Permissions: []Permission{PermissionManageMembers}},
+		{GroupID: groupID, UserID: memberID, Role: GroupRoleMember, JoinedAt: time.Now()},
+	}
+
+	groupEvent := &Event{HostUserID: memberID, GroupID: &groupID, Visibility: EventVisibilityGroupOnly}
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"admin holds every grantable permission", service.HasPermission(groupID, adminID, userGroups, PermissionCheckIn), true},
+		{"admin does not gain owner-only permissions", service.HasPermission(groupID, adminID, userGroups, PermissionDeleteGroup), false},
+		{"member can create group events", service.CanCreateGroupEvent(groupID, memberID, userGroups), true},
+		{"member cannot edit others' group events", service.CanEditEvent(groupEvent, moderatorID, userGroups), false},
+		{"judge can edit group events", service.CanEditEvent(groupEvent, judgeID, userGroups), true},
+		{"judge can check in attendees", service.CanCheckInAttendees(groupEvent, judgeID, userGroups), true},
+		{"member cannot check in attendees", service.CanCheckInAttendees(groupEvent, moderatorID, userGroups), false},
+		{"judge cannot invite members", service.CanInviteMembers(groupID, judgeID, userGroups), false},
+		{"judge cannot post announcements", service.CanManageAnnouncements(groupID, judgeID, userGroups), false},
+		{"moderator can invite members", service.CanInviteMembers(groupID, moderatorID, userGroups), true},
+		{"moderator can remove regular member", service.CanRemoveMembers(groupID, moderatorID, userGroups, memberID), true},
+		{"moderator cannot remove admin", service.CanRemoveMembers(groupID, moderatorID, userGroups, adminID), false},
+		{"moderator cannot manage custom roles", service.CanManageCustomRoles(groupID, moderatorID, userGroups), false},
+		{"non-member has no permissions", service.HasPermission(groupID, uuid.New(), userGroups, PermissionCreateEvent), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermissionService_CoHostPermissions(t *testing.T) {
+	service := NewPermissionService()
+
+	hostID := uuid.New()
+	editorID := uuid.New()
+	doorID := uuid.New()
+	invitedID := uuid.New()
+
+	event := &Event{
+		HostUserID: hostID,
+		Visibility: EventVisibilityPrivate,
+		CoHosts: []EventCoHost{
+			{UserID: editorID, Permissions: []Permission{PermissionEditEvent}, Status: EventCoHostStatusAccepted},
+			{UserID: doorID, Permissions: []Permission{PermissionManageAttendees, PermissionCheckIn}, Status: EventCoHostStatusAccepted},
+			{UserID: invitedID, Permissions: []Permission{PermissionEditEvent}, Status: EventCoHostStatusPending},
+		},
+	}
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"co-host can view private event", service.CanViewEvent(event, editorID, nil), true},
+		{"pending co-host cannot view private event", service.CanViewEvent(event, invitedID, nil), false},
+		{"editor can edit event", service.CanEditEvent(event, editorID, nil), true},
+		{"editor cannot manage attendees", service.CanManageAttendees(event, editorID, nil), false},
+		{"editor cannot view private attendees", service.CanViewAttendees(event, editorID, nil), false},
+		{"door staff can manage attendees", service.CanManageAttendees(event, doorID, nil), true},
+		{"door staff can check in attendees", service.CanCheckInAttendees(event, doorID, nil), true},
+		{"door staff cannot edit event", service.CanEditEvent(event, doorID, nil), false},
+		{"pending co-host cannot edit event", service.CanEditEvent(event, invitedID, nil), false},
+		{"co-host cannot delete event", service.CanDeleteEvent(event, editorID, nil), false},
+		{"co-host cannot RSVP", service.CanRSVPToEvent(event, editorID, nil), false},
+		{"only host manages co-hosts", service.CanManageCoHosts(event, editorID), false},
+		{"host manages co-hosts", service.CanManageCoHosts(event, hostID), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
 func TestPermissionService_CanUpdateMemberRole(t *testing.T) {
 	service := NewPermissionService()
 
@@ -0,0 +1,31 @@
+package domain
+
+// PlatformRole represents a user's operator role across the whole platform.
+// It is unrelated to the roles users hold inside groups.
+type PlatformRole string
+
+const (
+	PlatformRoleUser      PlatformRole = "user"
+	PlatformRoleModerator PlatformRole = "moderator"
+	PlatformRoleAdmin     PlatformRole = "admin"
+)
+
+// IsValidPlatformRole checks if the platform role is valid
+func IsValidPlatformRole(role PlatformRole) bool {
+	switch role {
+	case PlatformRoleUser, PlatformRoleModerator, PlatformRoleAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanModerateContent checks if the role may review reports and act on reported content
+func (r PlatformRole) CanModerateContent() bool {
+	return r == PlatformRoleModerator || r == PlatformRoleAdmin
+}
+
+// IsAdmin checks if the role may operate the platform through the admin API
+func (r PlatformRole) IsAdmin() bool {
+	return r == PlatformRoleAdmin
+}
@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportTargetType represents the kind of content a report is about
+type ReportTargetType string
+
+const (
+	ReportTargetEvent ReportTargetType = "event"
+	ReportTargetGroup ReportTargetType = "group"
+	ReportTargetVenue ReportTargetType = "venue"
+	ReportTargetUser  ReportTargetType = "user"
+)
+
+// ReportReason represents the category a reporter picked for a report
+type ReportReason string
+
+const (
+	ReportReasonSpam                  ReportReason = "spam"
+	ReportReasonHarassment            ReportReason = "harassment"
+	ReportReasonInappropriateContent  ReportReason = "inappropriate_content"
+	ReportReasonScam                  ReportReason = "scam"
+	ReportReasonMisleadingInformation ReportReason = "misleading_information"
+	ReportReasonOther                 ReportReason = "other"
+)
+
+// ReportStatus represents where a report is in the moderation queue
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusResolved  ReportStatus = "resolved"
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+// ContentModerationActionType represents an action platform moderators take on reported content
+type ContentModerationActionType string
+
+const (
+	ContentActionHideEvent       ContentModerationActionType = "hide_event"
+	ContentActionDeactivateGroup ContentModerationActionType = "deactivate_group"
+	ContentActionRemoveVenue     ContentModerationActionType = "remove_venue"
+	ContentActionDeactivateUser  ContentModerationActionType = "deactivate_user"
+)
+
+// Report is a user's complaint about an event, group, venue or another user
+type Report struct {
+	ID             uuid.UUID        `json:"id" db:"id"`
+	ReporterID     uuid.UUID        `json:"reporter_id" db:"reporter_id"`
+	TargetType     ReportTargetType `json:"target_type" db:"target_type"`
+	TargetID       uuid.UUID        `json:"target_id" db:"target_id"`
+	Reason         ReportReason     `json:"reason" db:"reason"`
+	Details        *string          `json:"details,omitempty" db:"details"`
+	Status         ReportStatus     `json:"status" db:"status"`
+	ResolvedBy     *uuid.UUID       `json:"resolved_by,omitempty" db:"resolved_by"`
+	ResolvedAt     *time.Time       `json:"resolved_at,omitempty" db:"resolved_at"`
+	ResolutionNote *string          `json:"resolution_note,omitempty" db:"resolution_note"`
+	CreatedAt      time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// ContentModerationAction is an entry in the platform moderation audit log
+type ContentModerationAction struct {
+	ID          uuid.UUID                   `json:"id" db:"id"`
+	ActorUserID uuid.UUID                   `json:"actor_user_id" db:"actor_user_id"`
+	Action      ContentModerationActionType `json:"action" db:"action"`
+	TargetType  ReportTargetType            `json:"target_type" db:"target_type"`
+	TargetID    uuid.UUID                   `json:"target_id" db:"target_id"`
+	ReportID    *uuid.UUID                  `json:"report_id,omitempty" db:"report_id"` // Report that led to the action, if any
+	Note        *string                     `json:"note,omitempty" db:"note"`
+	CreatedAt   time.Time                   `json:"created_at" db:"created_at"`
+}
+
+var (
+	ErrInvalidReportTarget         = errors.New("invalid report target type")
+	ErrInvalidReportReason         = errors.New("invalid report reason")
+	ErrInvalidReportStatus         = errors.New("invalid report status")
+	ErrReportDetailsTooLong        = errors.New("report details cannot exceed 1000 characters")
+	ErrReportDetailsRequired       = errors.New("report details are required when the reason is other")
+	ErrCannotReportSelf            = errors.New("users cannot report themselves")
+	ErrReportAlreadyClosed         = errors.New("report has already been resolved or dismissed")
+	ErrResolutionNoteTooLong       = errors.New("resolution note cannot exceed 1000 characters")
+	ErrInvalidContentAction        = errors.New("invalid content moderation action")
+	ErrContentActionTargetMismatch = errors.New("moderation action does not apply to the reported content")
+)
+
+// Validate validates the Report entity
+func (r *Report) Validate() error {
+	if !IsValidReportTargetType(r.TargetType) {
+		return ErrInvalidReportTarget
+	}
+
+	if !IsValidReportReason(r.Reason) {
+		return ErrInvalidReportReason
+	}
+
+	if !IsValidReportStatus(r.Status) {
+		return ErrInvalidReportStatus
+	}
+
+	if r.TargetType == ReportTargetUser && r.TargetID == r.ReporterID {
+		return ErrCannotReportSelf
+	}
+
+	if r.Details != nil && len(*r.Details) > 1000 {
+		return ErrReportDetailsTooLong
+	}
+
+	if r.Reason == ReportReasonOther && (r.Details == nil || *r.Details == "") {
+		return ErrReportDetailsRequired
+	}
+
+	if r.ResolutionNote != nil && len(*r.ResolutionNote) > 1000 {
+		return ErrResolutionNoteTooLong
+	}
+
+	return nil
+}
+
+// IsOpen checks if the report is still waiting for review
+func (r *Report) IsOpen() bool {
+	return r.Status == ReportStatusOpen
+}
+
+// Close marks an open report as resolved or dismissed by a moderator
+func (r *Report) Close(status ReportStatus, moderatorID uuid.UUID, note *string, now time.Time) error {
+	if !r.IsOpen() {
+		return ErrReportAlreadyClosed
+	}
+
+	if status != ReportStatusResolved && status != ReportStatusDismissed {
+		return ErrInvalidReportStatus
+	}
+
+	r.Status = status
+	r.ResolvedBy = &moderatorID
+	r.ResolvedAt = &now
+	r.ResolutionNote = note
+	r.UpdatedAt = now
+
+	return r.Validate()
+}
+
+// Validate validates the ContentModerationAction entity
+func (a *ContentModerationAction) Validate() error {
+	if !IsValidContentModerationAction(a.Action) {
+		return ErrInvalidContentAction
+	}
+
+	if a.ActorUserID == uuid.Nil {
+		return ErrModerationActorRequired
+	}
+
+	if a.Action.TargetType() != a.TargetType {
+		return ErrContentActionTargetMismatch
+	}
+
+	if a.Note != nil && len(*a.Note) > 1000 {
+		return ErrResolutionNoteTooLong
+	}
+
+	return nil
+}
+
+// TargetType returns the kind of content the action applies to
+func (a ContentModerationActionType) TargetType() ReportTargetType {
+	switch a {
+	case ContentActionHideEvent:
+		return ReportTargetEvent
+	case ContentActionDeactivateGroup:
+		return ReportTargetGroup
+	case ContentActionRemoveVenue:
+		return ReportTargetVenue
+	case ContentActionDeactivateUser:
+		return ReportTargetUser
+	default:
+		return ""
+	}
+}
+
+// IsValidReportTargetType checks if the report target type is valid
+func IsValidReportTargetType(targetType ReportTargetType) bool {
+	switch targetType {
+	case ReportTargetEvent, ReportTargetGroup, ReportTargetVenue, ReportTargetUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidReportReason checks if the report reason is valid
+func IsValidReportReason(reason ReportReason) bool {
+	switch reason {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonInappropriateContent,
+		ReportReasonScam, ReportReasonMisleadingInformation, ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidReportStatus checks if the report status is valid
+func IsValidReportStatus(status ReportStatus) bool {
+	switch status {
+	case ReportStatusOpen, ReportStatusResolved, ReportStatusDismissed:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsValidContentModerationAction checks if the content moderation action type is valid
+func IsValidContentModerationAction(action ContentModerationActionType) bool {
+	return action.TargetType() != ""
+}
+
+// NewContentModerationAction records an action taken by actorID against reported content
+func NewContentModerationAction(actorID uuid.UUID, action ContentModerationActionType, targetID uuid.UUID, reportID *uuid.UUID, note *string, now time.Time) *ContentModerationAction {
+	return &ContentModerationAction{
+		ID:          uuid.New(),
+		ActorUserID: actorID,
+		Action:      action,
+		TargetType:  action.TargetType(),
+		TargetID:    targetID,
+		ReportID:    reportID,
+		Note:        note,
+		CreatedAt:   now,
+	}
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestReport_Validate(t *testing.T) {
+	reporterID := uuid.New()
+	details := "Keeps sending me threats"
+	emptyDetails := ""
+	longDetails := strings.Repeat("a", 1001)
+
+	tests := []struct {
+		name    string
+		report  Report
+		wantErr error
+	}{
+		{
+			name:    "valid event report",
+			report:  Report{ReporterID: reporterID, TargetType: ReportTargetEvent, TargetID: uuid.New(), Reason: ReportReasonScam, Status: ReportStatusOpen},
+			wantErr: nil,
+		},
+		{
+			name:    "valid user report with details",
+			report:  Report{ReporterID: reporterID, TargetType: ReportTargetUser, TargetID: uuid.New(), Reason: ReportReasonHarassment, Details: &details, Status: ReportStatusOpen},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid target type",
+			report:  Report{ReporterID: reporterID, TargetType: "comment", TargetID: uuid.New(), Reason: ReportReasonSpam, Status: ReportStatusOpen},
+			wantErr: ErrInvalidReportTarget,
+		},
+		{
+			name:    "invalid reason",
+			report:  Report{ReporterID: reporterID, TargetType: ReportTargetVenue, TargetID: uuid.New(), Reason: "boring", Status: ReportStatusOpen},
+			wantErr: ErrInvalidReportReason,
+		},
+		{
+			name:    "invalid status",
+			report:  Report{ReporterID: reporterID, TargetType: ReportTargetVenue, TargetID: uuid.New(), Reason: ReportReasonSpam, Status: "pending"},
+			wantErr: ErrInvalidReportStatus,
+		},
+		{
+			name:    "reporting self",
+			report:  Report{ReporterID: reporterID, TargetType: ReportTargetUser, TargetID: reporterID, Reason: ReportReasonSpam, Status: ReportStatusOpen},
+			wantErr: ErrCannotReportSelf,
+		},
+		{
+			name:    "details too long",
+			report:  Report{ReporterID: reporterID, TargetType: ReportTargetGroup, TargetID: uuid.New(), Reason: ReportReasonSpam, Details: &longDetails, Status: ReportStatusOpen},
+			wantErr: ErrReportDetailsTooLong,
+		},
+		{
+			name:    "other reason without details",
+			report:  Report{ReporterID: reporterID, TargetType: ReportTargetGroup, TargetID: uuid.New(), Reason: ReportReasonOther, Details: &emptyDetails, Status: ReportStatusOpen},
+			wantErr: ErrReportDetailsRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.report.Validate()
+			if err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReport_Close(t *testing.T) {
+	moderatorID := uuid.New()
+	now := time.Now()
+
+	report := Report{ReporterID: uuid.New(), TargetType: ReportTargetEvent, TargetID: uuid.New(), Reason: ReportReasonSpam, Status: ReportStatusOpen}
+
+	if err := report.Close(ReportStatusOpen, moderatorID, nil, now); err != ErrInvalidReportStatus {
+		t.Errorf("Close() to open error = %v, want %v", err, ErrInvalidReportStatus)
+	}
+
+	if err := report.Close(ReportStatusResolved, moderatorID, nil, now); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if report.Status != ReportStatusResolved || report.ResolvedBy == nil || *report.ResolvedBy != moderatorID {
+		t.Errorf("Close() did not record the resolution: %+v", report)
+	}
+
+	if err := report.Close(ReportStatusDismissed, moderatorID, nil, now); err != ErrReportAlreadyClosed {
+		t.Errorf("Close() on closed report error = %v, want %v", err, ErrReportAlreadyClosed)
+	}
+}
+
+func TestContentModerationAction_Validate(t *testing.T) {
+	actorID := uuid.New()
+
+	tests := []struct {
+		name    string
+		action  ContentModerationAction
+		wantErr error
+	}{
+		{
+			name:    "valid action",
+			action:  *NewContentModerationAction(actorID, ContentActionHideEvent, uuid.New(), nil, nil, time.Now()),
+			wantErr: nil,
+		},
+		{
+			name:    "unknown action",
+			action:  ContentModerationAction{ActorUserID: actorID, Action: "delete_everything", TargetType: ReportTargetEvent},
+			wantErr: ErrInvalidContentAction,
+		},
+		{
+			name:    "missing actor",
+			action:  ContentModerationAction{Action: ContentActionRemoveVenue, TargetType: ReportTargetVenue},
+			wantErr: ErrModerationActorRequired,
+		},
+		{
+			name:    "action does not match target",
+			action:  ContentModerationAction{ActorUserID: actorID, Action: ContentActionDeactivateUser, TargetType: ReportTargetEvent},
+			wantErr: ErrContentActionTargetMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.action.Validate()
+			if err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlatformRole_CanModerateContent(t *testing.T) {
+	tests := []struct {
+		role PlatformRole
+		want bool
+	}{
+		{PlatformRoleUser, false},
+		{PlatformRoleModerator, true},
+		{PlatformRoleAdmin, true},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.CanModerateContent(); got != tt.want {
+			t.Errorf("%q.CanModerateContent() = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+}
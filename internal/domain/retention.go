@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// RetentionEntity identifies a kind of record covered by a data retention policy
+type RetentionEntity string
+
+const (
+	RetentionEntityNotifications    RetentionEntity = "notifications"
+	RetentionEntityAuditLog         RetentionEntity = "audit_log"
+	RetentionEntityInactiveAccounts RetentionEntity = "inactive_accounts"
+	RetentionEntityPastRSVPs        RetentionEntity = "past_rsvps" // Interested, declined and waitlisted replies; attendance is kept
+	RetentionEntityExportArchives   RetentionEntity = "export_archives"
+)
+
+// RetentionAction describes what happens to records once their retention period is over
+type RetentionAction string
+
+const (
+	RetentionActionDelete    RetentionAction = "delete"
+	RetentionActionAnonymize RetentionAction = "anonymize"
+)
+
+// MinRetentionPeriod is the shortest retention period a policy may configure
+const MinRetentionPeriod = 24 * time.Hour
+
+// RetentionPolicy sets how long records of an entity are kept. A zero MaxAge disables the policy.
+type RetentionPolicy struct {
+	Entity RetentionEntity `json:"entity"`
+	MaxAge time.Duration   `json:"max_age"`
+}
+
+// RetentionResult records the outcome of applying one policy
+type RetentionResult struct {
+	Entity   RetentionEntity `json:"entity"`
+	Action   RetentionAction `json:"action"`
+	Cutoff   time.Time       `json:"cutoff"`
+	Affected int             `json:"affected"`
+	Error    *string         `json:"error,omitempty"`
+}
+
+// RetentionReport summarizes a retention run. In a dry run Affected counts what would have been changed.
+type RetentionReport struct {
+	DryRun      bool              `json:"dry_run"`
+	StartedAt   time.Time         `json:"started_at"`
+	CompletedAt time.Time         `json:"completed_at"`
+	Results     []RetentionResult `json:"results"`
+}
+
+var (
+	ErrInvalidRetentionEntity  = errors.New("invalid retention entity")
+	ErrRetentionPeriodTooShort = errors.New("retention period must be at least 24 hours")
+)
+
+// Validate validates the RetentionPolicy entity
+func (p RetentionPolicy) Validate() error {
+	if !IsValidRetentionEntity(p.Entity) {
+		return ErrInvalidRetentionEntity
+	}
+
+	if p.MaxAge < 0 || (p.MaxAge > 0 && p.MaxAge < MinRetentionPeriod) {
+		return ErrRetentionPeriodTooShort
+	}
+
+	return nil
+}
+
+// IsEnabled reports whether the policy has a retention period configured
+func (p RetentionPolicy) IsEnabled() bool {
+	return p.MaxAge > 0
+}
+
+// Action returns what happens to expired records. Inactive accounts are anonymized so that the
+// events and groups they leave behind survive; everything else is deleted.
+func (p RetentionPolicy) Action() RetentionAction {
+	if p.Entity == RetentionEntityInactiveAccounts {
+		return RetentionActionAnonymize
+	}
+	return RetentionActionDelete
+}
+
+// Cutoff returns the moment before which records fall outside the retention period
+func (p RetentionPolicy) Cutoff(now time.Time) time.Time {
+	return now.Add(-p.MaxAge)
+}
+
+// TotalAffected returns how many records the run changed, or would change in a dry run
+func (r *RetentionReport) TotalAffected() int {
+	total := 0
+	for _, result := range r.Results {
+		total += result.Affected
+	}
+	return total
+}
+
+// IsValidRetentionEntity checks if the retention entity is valid
+func IsValidRetentionEntity(entity RetentionEntity) bool {
+	switch entity {
+	case RetentionEntityNotifications, RetentionEntityAuditLog, RetentionEntityInactiveAccounts,
+		RetentionEntityPastRSVPs, RetentionEntityExportArchives:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RetentionPolicy
+		wantErr error
+	}{
+		{
+			name:    "valid policy",
+			policy:  RetentionPolicy{Entity: RetentionEntityNotifications, MaxAge: 90 * 24 * time.Hour},
+			wantErr: nil,
+		},
+		{
+			name:    "disabled policy",
+			policy:  RetentionPolicy{Entity: RetentionEntityAuditLog},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid entity",
+			policy:  RetentionPolicy{Entity: "sessions", MaxAge: 90 * 24 * time.Hour},
+			wantErr: ErrInvalidRetentionEntity,
+		},
+		{
+			name:    "period too short",
+			policy:  RetentionPolicy{Entity: RetentionEntityPastRSVPs, MaxAge: time.Hour},
+			wantErr: ErrRetentionPeriodTooShort,
+		},
+		{
+			name:    "negative period",
+			policy:  RetentionPolicy{Entity: RetentionEntityPastRSVPs, MaxAge: -MinRetentionPeriod},
+			wantErr: ErrRetentionPeriodTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); err != tt.wantErr {
+				t.Errorf("RetentionPolicy.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetentionPolicy_Action(t *testing.T) {
+	tests := []struct {
+		entity RetentionEntity
+		want   RetentionAction
+	}{
+		{RetentionEntityNotifications, RetentionActionDelete},
+		{RetentionEntityAuditLog, RetentionActionDelete},
+		{RetentionEntityInactiveAccounts, RetentionActionAnonymize},
+		{RetentionEntityPastRSVPs, RetentionActionDelete},
+		{RetentionEntityExportArchives, RetentionActionDelete},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.entity), func(t *testing.T) {
+			if got := (RetentionPolicy{Entity: tt.entity}).Action(); got != tt.want {
+				t.Errorf("RetentionPolicy.Action() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetentionPolicy_Cutoff(t *testing.T) {
+	now := time.Date(2025, 10, 15, 12, 0, 0, 0, time.UTC)
+	policy := RetentionPolicy{Entity: RetentionEntityNotifications, MaxAge: 30 * 24 * time.Hour}
+
+	if !policy.IsEnabled() {
+		t.Errorf("RetentionPolicy.IsEnabled() = false, want true")
+	}
+	if got, want := policy.Cutoff(now), time.Date(2025, 9, 15, 12, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("RetentionPolicy.Cutoff() = %v, want %v", got, want)
+	}
+	if (RetentionPolicy{Entity: RetentionEntityNotifications}).IsEnabled() {
+		t.Errorf("RetentionPolicy.IsEnabled() = true for zero MaxAge, want false")
+	}
+}
+
+func TestRetentionReport_TotalAffected(t *testing.T) {
+	report := &RetentionReport{Results: []RetentionResult{{Affected: 3}, {Affected: 0}, {Affected: 4}}}
+
+	if got := report.TotalAffected(); got != 7 {
+		t.Errorf("RetentionReport.TotalAffected() = %d, want 7", got)
+	}
+}
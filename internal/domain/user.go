@@ -12,13 +12,14 @@ import (
 
 // User represents a user in the system
 type User struct {
-	ID           uuid.UUID  `json:"id" db:"id"`
-	Email        string     `json:"email" db:"email"`
-	PasswordHash string     `json:"-" db:"password_hash"`
-	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
-	IsActive     bool       `json:"is_active" db:"is_active"`
-	LastLogin    *time.Time `json:"last_login,omitempty" db:"last_login"`
+	ID           uuid.UUID    `json:"id" db:"id"`
+	Email        string       `json:"email" db:"email"`
+	PasswordHash string       `json:"-" db:"password_hash"`
+	CreatedAt    time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at" db:"updated_at"`
+	IsActive     bool         `json:"is_active" db:"is_active"`
+	LastLogin    *time.Time   `json:"last_login,omitempty" db:"last_login"`
+	PlatformRole PlatformRole `json:"platform_role" db:"platform_role"`
 }
 
 // Profile represents a user's profile information
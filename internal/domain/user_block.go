@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserBlock records that one user has blocked another.
+// Blocks are one-directional but hide the two users from each other.
+type UserBlock struct {
+	BlockerID uuid.UUID `json:"blocker_id" db:"blocker_id"`
+	BlockedID uuid.UUID `json:"blocked_id" db:"blocked_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+var (
+	ErrCannotBlockSelf = errors.New("users cannot block themselves")
+)
+
+// Validate validates the UserBlock entity
+func (b *UserBlock) Validate() error {
+	if b.BlockerID == uuid.Nil || b.BlockedID == uuid.Nil {
+		return errors.New("blocker and blocked user are required")
+	}
+
+	if b.BlockerID == b.BlockedID {
+		return ErrCannotBlockSelf
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestUserBlock_Validate(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name    string
+		block   UserBlock
+		wantErr bool
+		err     error
+	}{
+		{
+			name:    "valid block",
+			block:   UserBlock{BlockerID: userID, BlockedID: uuid.New()},
+			wantErr: false,
+		},
+		{
+			name:    "missing blocked user",
+			block:   UserBlock{BlockerID: userID},
+			wantErr: true,
+		},
+		{
+			name:    "blocking self",
+			block:   UserBlock{BlockerID: userID, BlockedID: userID},
+			wantErr: true,
+			err:     ErrCannotBlockSelf,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.block.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.err != nil && err != tt.err {
+				t.Errorf("Validate() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -47,6 +47,36 @@ type Venue struct {
 	Metadata  map[string]interface{} `json:"metadata" db:"metadata"`
 	CreatedBy *uuid.UUID             `json:"created_by,omitempty" db:"created_by"`
 	CreatedAt time.Time              `json:"created_at" db:"created_at"`
+
+	// Opening hours are interpreted in Timezone, an IANA name such as Europe/Lisbon
+	Timezone     *string       `json:"timezone,omitempty" db:"timezone"`
+	OpeningHours *OpeningHours `json:"opening_hours,omitempty" db:"opening_hours"`
+
+	// Set when platform admins approve an ownership claim for the venue
+	OwnerID    *uuid.UUID `json:"owner_id,omitempty" db:"owner_id"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty" db:"verified_at"`
+
+	// Tables, seats and facilities players can expect
+	Amenities *VenueAmenities `json:"amenities,omitempty" db:"amenities"`
+
+	// Aggregated from the venue's reviews
+	RatingAverage float64 `json:"rating_average" db:"rating_average"`
+	RatingCount   int     `json:"rating_count" db:"rating_count"`
+}
+
+// VenueSearchParams represents parameters for searching venues; every set filter must match
+type VenueSearchParams struct {
+	Near         *Coordinates       `json:"near,omitempty"`
+	RadiusKm     *int               `json:"radius_km,omitempty"`
+	Name         *string            `json:"name,omitempty"`
+	City         *string            `json:"city,omitempty"`
+	Country      *string            `json:"country,omitempty"`
+	Type         *VenueType         `json:"type,omitempty"`
+	CreatedBy    *uuid.UUID         `json:"created_by,omitempty"`
+	Amenities    VenueAmenityFilter `json:"amenities"`
+	SortByRating bool               `json:"sort_by_rating"` // Otherwise sorted by name
+	Limit        int                `json:"limit"`
+	Offset       int                `json:"offset"`
 }
 
 var (
@@ -58,6 +88,9 @@ var (
 	ErrEmptyCountry       = errors.New("venue country cannot be empty")
 	ErrInvalidVenueType   = errors.New("invalid venue type")
 	ErrInvalidCoordinates = errors.New("invalid coordinates")
+
+	ErrInvalidVenueTimezone  = errors.New("invalid venue timezone")
+	ErrVenueTimezoneRequired = errors.New("venue timezone is required when opening hours are set")
 )
 
 // GetCoordinates returns the coordinates of the venue
@@ -102,9 +135,73 @@ func (v *Venue) Validate() error {
 		return ErrInvalidCoordinates
 	}
 
+	if v.Timezone != nil {
+		if _, err := time.LoadLocation(*v.Timezone); err != nil || *v.Timezone == "" {
+			return ErrInvalidVenueTimezone
+		}
+	}
+
+	if v.Amenities != nil {
+		if err := v.Amenities.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if v.OpeningHours != nil {
+		if err := v.OpeningHours.Validate(); err != nil {
+			return err
+		}
+		if v.OpeningHours.IsSet() && v.Timezone == nil {
+			return ErrVenueTimezoneRequired
+		}
+	}
+
 	return nil
 }
 
+// IsOpenDuring reports whether the venue is open for the whole of [start, end). Venues without
+// opening hours are treated as always open.
+func (v *Venue) IsOpenDuring(start, end time.Time) (bool, error) {
+	if !v.OpeningHours.IsSet() {
+		return true, nil
+	}
+
+	if v.Timezone == nil {
+		return false, ErrVenueTimezoneRequired
+	}
+
+	loc, err := time.LoadLocation(*v.Timezone)
+	if err != nil {
+		return false, ErrInvalidVenueTimezone
+	}
+
+	return v.OpeningHours.IsOpenDuring(start, end, loc), nil
+}
+
+// IsVerified checks if the venue has an owner verified by platform admins
+func (v *Venue) IsVerified() bool {
+	return v.OwnerID != nil && v.VerifiedAt != nil
+}
+
+// IsOwnedBy checks if the user is the venue's verified owner
+func (v *Venue) IsOwnedBy(userID uuid.UUID) bool {
+	return v.IsVerified() && *v.OwnerID == userID
+}
+
+// IsOwnerOrCreator checks if the user owns the venue, verified or not, or created its listing
+func (v *Venue) IsOwnerOrCreator(userID uuid.UUID) bool {
+	return (v.OwnerID != nil && *v.OwnerID == userID) || (v.CreatedBy != nil && *v.CreatedBy == userID)
+}
+
+// CanBeEditedBy checks if the user may edit the venue. A verified owner takes over the listing from
+// its creator.
+func (v *Venue) CanBeEditedBy(userID uuid.UUID) bool {
+	if v.IsVerified() {
+		return *v.OwnerID == userID
+	}
+	return v.CreatedBy != nil && *v.CreatedBy == userID
+}
+
 // IsValidType checks if the venue type is valid
 func (v *Venue) IsValidType() bool {
 	switch v.Type {
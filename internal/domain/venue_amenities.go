@@ -0,0 +1,87 @@
+package domain
+
+import "errors"
+
+const (
+	// MaxVenueTableCount is the most tables a venue may list
+	MaxVenueTableCount = 1000
+	// MaxVenueSeats is the most seats a venue may list
+	MaxVenueSeats = 10000
+)
+
+var (
+	ErrInvalidVenueTableCount = errors.New("venue table count must be between 0 and 1000")
+	ErrInvalidVenueSeats      = errors.New("venue seats must be between 0 and 10000")
+	ErrVenueSeatsRequired     = errors.New("venue seats are required when tables are listed")
+)
+
+// VenueAmenities describes what a venue offers players. Unknown counts are left nil.
+type VenueAmenities struct {
+	TableCount           *int `json:"table_count,omitempty"`
+	Seats                *int `json:"seats,omitempty"`
+	PlaymatsProvided     bool `json:"playmats_provided"`
+	WheelchairAccessible bool `json:"wheelchair_accessible"`
+	FoodAndDrinks        bool `json:"food_and_drinks"`
+	WiFi                 bool `json:"wifi"`
+}
+
+// VenueAmenityFilter selects venues by their amenities. Unset fields match every venue.
+type VenueAmenityFilter struct {
+	MinTables            *int `json:"min_tables,omitempty"`
+	MinSeats             *int `json:"min_seats,omitempty"`
+	PlaymatsProvided     bool `json:"playmats_provided,omitempty"`
+	WheelchairAccessible bool `json:"wheelchair_accessible,omitempty"`
+	FoodAndDrinks        bool `json:"food_and_drinks,omitempty"`
+	WiFi                 bool `json:"wifi,omitempty"`
+}
+
+// Validate validates the venue amenities
+func (a *VenueAmenities) Validate() error {
+	if a.TableCount != nil && (*a.TableCount < 0 || *a.TableCount > MaxVenueTableCount) {
+		return ErrInvalidVenueTableCount
+	}
+
+	if a.Seats != nil && (*a.Seats < 0 || *a.Seats > MaxVenueSeats) {
+		return ErrInvalidVenueSeats
+	}
+
+	if a.TableCount != nil && *a.TableCount > 0 && a.Seats == nil {
+		return ErrVenueSeatsRequired
+	}
+
+	return nil
+}
+
+// Matches checks if the amenities satisfy every set filter. Unknown counts never satisfy a minimum.
+func (a *VenueAmenities) Matches(filter VenueAmenityFilter) bool {
+	if !filter.IsSet() {
+		return true
+	}
+	if a == nil {
+		return false
+	}
+
+	if filter.MinTables != nil && (a.TableCount == nil || *a.TableCount < *filter.MinTables) {
+		return false
+	}
+	if filter.MinSeats != nil && (a.Seats == nil || *a.Seats < *filter.MinSeats) {
+		return false
+	}
+
+	return (!filter.PlaymatsProvided || a.PlaymatsProvided) &&
+		(!filter.WheelchairAccessible || a.WheelchairAccessible) &&
+		(!filter.FoodAndDrinks || a.FoodAndDrinks) &&
+		(!filter.WiFi || a.WiFi)
+}
+
+// IsSet checks if the filter restricts the venues it matches
+func (f VenueAmenityFilter) IsSet() bool {
+	return f.MinTables != nil || f.MinSeats != nil ||
+		f.PlaymatsProvided || f.WheelchairAccessible || f.FoodAndDrinks || f.WiFi
+}
+
+// ExceedsSeats checks if the venue lists fewer seats than the given number of players.
+// Venues that don't list their seats never report too few.
+func (v *Venue) ExceedsSeats(players int) bool {
+	return v.Amenities != nil && v.Amenities.Seats != nil && players > *v.Amenities.Seats
+}
@@ -0,0 +1,83 @@
+package domain
+
+import "testing"
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestVenueAmenities_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		amenities VenueAmenities
+		wantErr   error
+	}{
+		{"empty", VenueAmenities{}, nil},
+		{"tables and seats", VenueAmenities{TableCount: intPtr(16), Seats: intPtr(64), WiFi: true}, nil},
+		{"seats only", VenueAmenities{Seats: intPtr(12)}, nil},
+		{"negative tables", VenueAmenities{TableCount: intPtr(-1), Seats: intPtr(4)}, ErrInvalidVenueTableCount},
+		{"too many tables", VenueAmenities{TableCount: intPtr(MaxVenueTableCount + 1), Seats: intPtr(4)}, ErrInvalidVenueTableCount},
+		{"negative seats", VenueAmenities{Seats: intPtr(-4)}, ErrInvalidVenueSeats},
+		{"too many seats", VenueAmenities{Seats: intPtr(MaxVenueSeats + 1)}, ErrInvalidVenueSeats},
+		{"tables without seats", VenueAmenities{TableCount: intPtr(8)}, ErrVenueSeatsRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.amenities.Validate(); err != tt.wantErr {
+				t.Errorf("VenueAmenities.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVenueAmenities_Matches(t *testing.T) {
+	amenities := &VenueAmenities{TableCount: intPtr(16), Seats: intPtr(64), PlaymatsProvided: true, WiFi: true}
+
+	tests := []struct {
+		name      string
+		amenities *VenueAmenities
+		filter    VenueAmenityFilter
+		want      bool
+	}{
+		{"no filter", nil, VenueAmenityFilter{}, true},
+		{"enough seats", amenities, VenueAmenityFilter{MinSeats: intPtr(64)}, true},
+		{"too few seats", amenities, VenueAmenityFilter{MinSeats: intPtr(65)}, false},
+		{"enough tables", amenities, VenueAmenityFilter{MinTables: intPtr(10)}, true},
+		{"facilities offered", amenities, VenueAmenityFilter{PlaymatsProvided: true, WiFi: true}, true},
+		{"facility missing", amenities, VenueAmenityFilter{WiFi: true, FoodAndDrinks: true}, false},
+		{"unknown seats", &VenueAmenities{WiFi: true}, VenueAmenityFilter{MinSeats: intPtr(1)}, false},
+		{"no amenities listed", nil, VenueAmenityFilter{WheelchairAccessible: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amenities.Matches(tt.filter); got != tt.want {
+				t.Errorf("VenueAmenities.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVenue_ExceedsSeats(t *testing.T) {
+	tests := []struct {
+		name      string
+		amenities *VenueAmenities
+		players   int
+		want      bool
+	}{
+		{"fits", &VenueAmenities{Seats: intPtr(64)}, 64, false},
+		{"too many players", &VenueAmenities{Seats: intPtr(64)}, 65, true},
+		{"seats unknown", &VenueAmenities{WiFi: true}, 500, false},
+		{"no amenities", nil, 500, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			venue := &Venue{Amenities: tt.amenities}
+			if got := venue.ExceedsSeats(tt.players); got != tt.want {
+				t.Errorf("Venue.ExceedsSeats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
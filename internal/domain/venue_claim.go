@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VenueClaimStatus represents the status of a venue ownership claim
+type VenueClaimStatus string
+
+const (
+	VenueClaimStatusPending  VenueClaimStatus = "pending"
+	VenueClaimStatusApproved VenueClaimStatus = "approved"
+	VenueClaimStatusRejected VenueClaimStatus = "rejected"
+)
+
+// VenueClaim is a user's request to be recognized as the owner of a store venue
+type VenueClaim struct {
+	ID         uuid.UUID        `json:"id" db:"id"`
+	VenueID    uuid.UUID        `json:"venue_id" db:"venue_id"`
+	ClaimantID uuid.UUID        `json:"claimant_id" db:"claimant_id"`
+	Evidence   string           `json:"evidence" db:"evidence"` // How the claimant proves ownership, such as a business registration or website
+	Status     VenueClaimStatus `json:"status" db:"status"`
+	ReviewedBy *uuid.UUID       `json:"reviewed_by,omitempty" db:"reviewed_by"`
+	ReviewedAt *time.Time       `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	ReviewNote *string          `json:"review_note,omitempty" db:"review_note"`
+	CreatedAt  time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+var (
+	ErrInvalidVenueClaimStatus    = errors.New("invalid venue claim status")
+	ErrVenueClaimEvidenceRequired = errors.New("venue claim evidence is required")
+	ErrVenueClaimEvidenceTooLong  = errors.New("venue claim evidence cannot exceed 2000 characters")
+	ErrVenueClaimNoteTooLong      = errors.New("venue claim review note cannot exceed 1000 characters")
+	ErrVenueClaimAlreadyReviewed  = errors.New("venue claim has already been reviewed")
+)
+
+// NewVenueClaim creates a pending ownership claim
+func NewVenueClaim(venueID, claimantID uuid.UUID, evidence string, now time.Time) *VenueClaim {
+	return &VenueClaim{
+		ID:         uuid.New(),
+		VenueID:    venueID,
+		ClaimantID: claimantID,
+		Evidence:   strings.TrimSpace(evidence),
+		Status:     VenueClaimStatusPending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Validate validates the VenueClaim entity
+func (c *VenueClaim) Validate() error {
+	if !IsValidVenueClaimStatus(c.Status) {
+		return ErrInvalidVenueClaimStatus
+	}
+
+	if strings.TrimSpace(c.Evidence) == "" {
+		return ErrVenueClaimEvidenceRequired
+	}
+
+	if len(c.Evidence) > 2000 {
+		return ErrVenueClaimEvidenceTooLong
+	}
+
+	if c.ReviewNote != nil && len(*c.ReviewNote) > 1000 {
+		return ErrVenueClaimNoteTooLong
+	}
+
+	return nil
+}
+
+// IsPending checks if the claim is still awaiting review
+func (c *VenueClaim) IsPending() bool {
+	return c.Status == VenueClaimStatusPending
+}
+
+// Review records an admin's decision on a pending claim
+func (c *VenueClaim) Review(status VenueClaimStatus, reviewerID uuid.UUID, note *string, now time.Time) error {
+	if !c.IsPending() {
+		return ErrVenueClaimAlreadyReviewed
+	}
+
+	if status != VenueClaimStatusApproved && status != VenueClaimStatusRejected {
+		return ErrInvalidVenueClaimStatus
+	}
+
+	c.Status = status
+	c.ReviewedBy = &reviewerID
+	c.ReviewedAt = &now
+	c.ReviewNote = note
+	c.UpdatedAt = now
+
+	return c.Validate()
+}
+
+// IsValidVenueClaimStatus checks if a venue claim status is valid
+func IsValidVenueClaimStatus(status VenueClaimStatus) bool {
+	switch status {
+	case VenueClaimStatusPending, VenueClaimStatusApproved, VenueClaimStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestVenueClaim_Validate(t *testing.T) {
+	longEvidence := strings.Repeat("a", 2001)
+	longNote := strings.Repeat("a", 1001)
+
+	tests := []struct {
+		name    string
+		claim   VenueClaim
+		wantErr error
+	}{
+		{
+			name:    "valid pending claim",
+			claim:   *NewVenueClaim(uuid.New(), uuid.New(), "  Registered as the store's business owner  ", time.Now()),
+			wantErr: nil,
+		},
+		{
+			name:    "invalid status",
+			claim:   VenueClaim{Evidence: "Business registration", Status: "maybe"},
+			wantErr: ErrInvalidVenueClaimStatus,
+		},
+		{
+			name:    "missing evidence",
+			claim:   VenueClaim{Evidence: "   ", Status: VenueClaimStatusPending},
+			wantErr: ErrVenueClaimEvidenceRequired,
+		},
+		{
+			name:    "evidence too long",
+			claim:   VenueClaim{Evidence: longEvidence, Status: VenueClaimStatusPending},
+			wantErr: ErrVenueClaimEvidenceTooLong,
+		},
+		{
+			name:    "review note too long",
+			claim:   VenueClaim{Evidence: "Business registration", Status: VenueClaimStatusRejected, ReviewNote: &longNote},
+			wantErr: ErrVenueClaimNoteTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.claim.Validate()
+			if err != tt.wantErr {
+				t.Errorf("VenueClaim.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVenueClaim_Review(t *testing.T) {
+	reviewerID := uuid.New()
+	now := time.Now()
+	claim := NewVenueClaim(uuid.New(), uuid.New(), "Business registration", now)
+
+	if err := claim.Review(VenueClaimStatusPending, reviewerID, nil, now); err != ErrInvalidVenueClaimStatus {
+		t.Errorf("Review() to pending error = %v, want %v", err, ErrInvalidVenueClaimStatus)
+	}
+
+	if err := claim.Review(VenueClaimStatusApproved, reviewerID, stringPtr("Checked the registry"), now); err != nil {
+		t.Fatalf("Review() error = %v", err)
+	}
+	if claim.Status != VenueClaimStatusApproved || claim.ReviewedBy == nil || *claim.ReviewedBy != reviewerID {
+		t.Errorf("Review() did not record the decision: %+v", claim)
+	}
+
+	if err := claim.Review(VenueClaimStatusRejected, reviewerID, nil, now); err != ErrVenueClaimAlreadyReviewed {
+		t.Errorf("Review() on reviewed claim error = %v, want %v", err, ErrVenueClaimAlreadyReviewed)
+	}
+}
+
+func TestVenue_CanBeEditedBy(t *testing.T) {
+	creatorID := uuid.New()
+	ownerID := uuid.New()
+	verifiedAt := time.Now()
+
+	unclaimed := &Venue{CreatedBy: &creatorID}
+	if !unclaimed.CanBeEditedBy(creatorID) || unclaimed.CanBeEditedBy(ownerID) {
+		t.Error("CanBeEditedBy() should allow only the creator on an unclaimed venue")
+	}
+	if unclaimed.IsVerified() {
+		t.Error("IsVerified() = true for an unclaimed venue")
+	}
+
+	claimed := &Venue{CreatedBy: &creatorID, OwnerID: &ownerID, VerifiedAt: &verifiedAt}
+	if claimed.CanBeEditedBy(creatorID) || !claimed.CanBeEditedBy(ownerID) {
+		t.Error("CanBeEditedBy() should allow only the verified owner on a claimed venue")
+	}
+	if !claimed.IsOwnedBy(ownerID) || claimed.IsOwnedBy(creatorID) {
+		t.Error("IsOwnedBy() should match only the verified owner")
+	}
+	if !claimed.IsOwnerOrCreator(ownerID) || !claimed.IsOwnerOrCreator(creatorID) || claimed.IsOwnerOrCreator(uuid.New()) {
+		t.Error("IsOwnerOrCreator() should match the owner and the creator only")
+	}
+}
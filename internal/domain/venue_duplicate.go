@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// VenueDuplicateRadiusKm is how close two venues must be for a similar name to mark them as duplicates
+	VenueDuplicateRadiusKm = 0.2
+
+	// VenueDuplicateNameSimilarity is the name similarity above which nearby venues are duplicates
+	VenueDuplicateNameSimilarity = 0.5
+
+	// VenueDuplicateAddressNameSimilarity is the looser name similarity used when the addresses match
+	VenueDuplicateAddressNameSimilarity = 0.3
+)
+
+var (
+	ErrVenueMergeIntoSelf = errors.New("cannot merge a venue into itself")
+)
+
+// addressAbbreviations expands common street abbreviations so that "R. de Santa Catarina" and
+// "Rua de Santa Catarina" normalize to the same address
+var addressAbbreviations = map[string]string{
+	"r":    "rua",
+	"av":   "avenida",
+	"avda": "avenida",
+	"pca":  "praca",
+	"lg":   "largo",
+	"tv":   "travessa",
+	"st":   "street",
+	"rd":   "road",
+	"ave":  "avenue",
+	"blvd": "boulevard",
+	"dr":   "drive",
+	"ln":   "lane",
+	"sq":   "square",
+}
+
+// VenueDuplicateCandidate is an existing venue that likely describes the same place as another one
+type VenueDuplicateCandidate struct {
+	Venue          *Venue   `json:"venue"`
+	NameSimilarity float64  `json:"name_similarity"`       // Trigram similarity of the normalized names, 0 to 1
+	DistanceKm     *float64 `json:"distance_km,omitempty"` // Unset when either venue has no coordinates
+	SameAddress    bool     `json:"same_address"`
+}
+
+// VenueRedirect points the ID of a venue that was merged away to the venue that replaced it
+type VenueRedirect struct {
+	FromVenueID uuid.UUID  `json:"from_venue_id" db:"from_venue_id"`
+	ToVenueID   uuid.UUID  `json:"to_venue_id" db:"to_venue_id"`
+	MergedBy    *uuid.UUID `json:"merged_by,omitempty" db:"merged_by"`
+	MergedAt    time.Time  `json:"merged_at" db:"merged_at"`
+}
+
+// NewVenueRedirect creates the redirect left behind when one venue is merged into another
+func NewVenueRedirect(fromVenueID, toVenueID, mergedBy uuid.UUID, now time.Time) *VenueRedirect {
+	return &VenueRedirect{
+		FromVenueID: fromVenueID,
+		ToVenueID:   toVenueID,
+		MergedBy:    &mergedBy,
+		MergedAt:    now,
+	}
+}
+
+// Validate validates the VenueRedirect
+func (r *VenueRedirect) Validate() error {
+	if r.FromVenueID == r.ToVenueID {
+		return ErrVenueMergeIntoSelf
+	}
+	return nil
+}
+
+// MatchVenueDuplicate compares two venues and returns the candidate when other likely duplicates venue,
+// or nil otherwise. Nearby venues need similar names; venues at the same address in the same city only
+// need loosely similar names, which also covers venues that could not be geocoded.
+func MatchVenueDuplicate(venue, other *Venue) *VenueDuplicateCandidate {
+	if venue.ID == other.ID {
+		return nil
+	}
+
+	candidate := &VenueDuplicateCandidate{
+		Venue:          other,
+		NameSimilarity: TrigramSimilarity(NormalizeVenueName(venue.Name), NormalizeVenueName(other.Name)),
+	}
+
+	address := NormalizeVenueAddress(venue.Address)
+	candidate.SameAddress = address != "" && address == NormalizeVenueAddress(other.Address) &&
+		NormalizeVenueName(venue.City) == NormalizeVenueName(other.City)
+
+	nearby := false
+	if venue.hasLocation() && other.hasLocation() {
+		coordinates := venue.GetCoordinates()
+		distance := coordinates.DistanceTo(other.GetCoordinates())
+		candidate.DistanceKm = &distance
+		nearby = distance <= VenueDuplicateRadiusKm
+	}
+
+	if (nearby && candidate.NameSimilarity >= VenueDuplicateNameSimilarity) ||
+		(candidate.SameAddress && candidate.NameSimilarity >= VenueDuplicateAddressNameSimilarity) {
+		return candidate
+	}
+
+	return nil
+}
+
+// FindVenueDuplicates returns the venues among others that likely duplicate venue, most similar first
+func FindVenueDuplicates(venue *Venue, others []*Venue) []*VenueDuplicateCandidate {
+	candidates := []*VenueDuplicateCandidate{}
+	for _, other := range others {
+		if candidate := MatchVenueDuplicate(venue, other); candidate != nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].NameSimilarity != candidates[j].NameSimilarity {
+			return candidates[i].NameSimilarity > candidates[j].NameSimilarity
+		}
+		return candidates[i].SameAddress && !candidates[j].SameAddress
+	})
+
+	return candidates
+}
+
+// NormalizeVenueName lowercases a name, strips accents and punctuation and collapses whitespace
+func NormalizeVenueName(name string) string {
+	return strings.Join(normalizedWords(name), " ")
+}
+
+// NormalizeVenueAddress normalizes an address like a name and expands common street abbreviations
+func NormalizeVenueAddress(address string) string {
+	words := normalizedWords(address)
+	for i, word := range words {
+		if expanded, ok := addressAbbreviations[word]; ok {
+			words[i] = expanded
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// TrigramSimilarity returns the share of trigrams two strings have in common, matching PostgreSQL's
+// pg_trgm similarity(): each word is padded with two leading spaces and one trailing space
+func TrigramSimilarity(a, b string) float64 {
+	trigramsA := trigrams(a)
+	trigramsB := trigrams(b)
+	if len(trigramsA) == 0 || len(trigramsB) == 0 {
+		return 0
+	}
+
+	common := 0
+	for trigram := range trigramsA {
+		if _, ok := trigramsB[trigram]; ok {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(trigramsA)+len(trigramsB)-common)
+}
+
+// hasLocation reports whether the venue was geocoded; venues that failed geocoding are stored at 0,0
+func (v *Venue) hasLocation() bool {
+	return (v.Latitude != 0 || v.Longitude != 0) && v.HasValidCoordinates()
+}
+
+// normalizedWords splits text into lowercase, accent-free alphanumeric words
+func normalizedWords(text string) []string {
+	var builder strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(text)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Drop combining accents left by the decomposition
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune(' ')
+		}
+	}
+	return strings.Fields(builder.String())
+}
+
+// trigrams returns the set of padded trigrams of every word in text
+func trigrams(text string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, word := range normalizedWords(text) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = struct{}{}
+		}
+	}
+	return set
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNormalizeVenueName(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"lowercases", "Arena Porto", "arena porto"},
+		{"strips punctuation", "Dragon's Den - TCG!", "dragon s den tcg"},
+		{"strips accents", "Loja dos Jogos Mágicos", "loja dos jogos magicos"},
+		{"collapses whitespace", "  Arena   Porto ", "arena porto"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeVenueName(tt.in); got != tt.want {
+				t.Errorf("NormalizeVenueName(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeVenueAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+	}{
+		{"street abbreviation", "R. de Santa Catarina, 120", "Rua de Santa Catarina 120"},
+		{"avenue abbreviation", "Av. da Liberdade 10", "avenida da liberdade, 10"},
+		{"accents", "Praça da Batalha 5", "Pça. da Batalha 5"},
+		{"english abbreviation", "221B Baker St.", "221b baker street"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if NormalizeVenueAddress(tt.a) != NormalizeVenueAddress(tt.b) {
+				t.Errorf("NormalizeVenueAddress(%q) = %q, want %q", tt.a, NormalizeVenueAddress(tt.a), NormalizeVenueAddress(tt.b))
+			}
+		})
+	}
+}
+
+func TestTrigramSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{"identical", "arena porto", "arena porto", 1},
+		{"case insensitive", "Arena Porto", "arena porto", 1},
+		{"extra word", "arena porto", "arena porto tcg", 0.75},
+		{"unrelated", "arena porto", "dragon s den", 0},
+		{"empty", "", "arena porto", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TrigramSimilarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("TrigramSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchVenueDuplicate(t *testing.T) {
+	venue := &Venue{
+		ID:        uuid.New(),
+		Name:      "Arena Porto",
+		Address:   "Rua de Santa Catarina 120",
+		City:      "Porto",
+		Latitude:  41.1466,
+		Longitude: -8.6060,
+	}
+
+	tests := []struct {
+		name  string
+		other Venue
+		want  bool
+	}{
+		{
+			name:  "same name different case nearby",
+			other: Venue{Name: "arena porto", Address: "Somewhere else 1", City: "Porto", Latitude: 41.1467, Longitude: -8.6061},
+			want:  true,
+		},
+		{
+			name:  "longer name nearby",
+			other: Venue{Name: "Arena Porto TCG", Address: "Somewhere else 1", City: "Porto", Latitude: 41.1470, Longitude: -8.6060},
+			want:  true,
+		},
+		{
+			name:  "similar name too far away",
+			other: Venue{Name: "Arena Porto", Address: "Somewhere else 1", City: "Porto", Latitude: 41.1600, Longitude: -8.6060},
+			want:  false,
+		},
+		{
+			name:  "different name nearby",
+			other: Venue{Name: "Dragon's Den", Address: "Somewhere else 1", City: "Porto", Latitude: 41.1467, Longitude: -8.6061},
+			want:  false,
+		},
+		{
+			name:  "same address without coordinates",
+			other: Venue{Name: "Arena TCG", Address: "R. de Santa Catarina, 120", City: "Porto"},
+			want:  true,
+		},
+		{
+			name:  "same address in another city",
+			other: Venue{Name: "Arena TCG", Address: "R. de Santa Catarina, 120", City: "Lisbon"},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := tt.other
+			other.ID = uuid.New()
+			if got := MatchVenueDuplicate(venue, &other) != nil; got != tt.want {
+				t.Errorf("MatchVenueDuplicate() matched = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if MatchVenueDuplicate(venue, venue) != nil {
+		t.Errorf("MatchVenueDuplicate() should not match a venue with itself")
+	}
+}
+
+func TestFindVenueDuplicates(t *testing.T) {
+	venue := &Venue{ID: uuid.New(), Name: "Arena Porto", City: "Porto", Latitude: 41.1466, Longitude: -8.6060}
+	exact := &Venue{ID: uuid.New(), Name: "ARENA PORTO", City: "Porto", Latitude: 41.1467, Longitude: -8.6060}
+	longer := &Venue{ID: uuid.New(), Name: "Arena Porto TCG", City: "Porto", Latitude: 41.1467, Longitude: -8.6060}
+	unrelated := &Venue{ID: uuid.New(), Name: "Dragon's Den", City: "Porto", Latitude: 41.1467, Longitude: -8.6060}
+
+	candidates := FindVenueDuplicates(venue, []*Venue{longer, unrelated, exact})
+	if len(candidates) != 2 {
+		t.Fatalf("FindVenueDuplicates() returned %d candidates, want 2", len(candidates))
+	}
+	if candidates[0].Venue != exact || candidates[1].Venue != longer {
+		t.Errorf("FindVenueDuplicates() should order candidates by name similarity")
+	}
+	if candidates[0].DistanceKm == nil {
+		t.Errorf("FindVenueDuplicates() should report the distance of geocoded venues")
+	}
+}
+
+func TestVenueRedirect_Validate(t *testing.T) {
+	venueID := uuid.New()
+
+	if err := NewVenueRedirect(uuid.New(), venueID, uuid.New(), time.Now()).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+	if err := NewVenueRedirect(venueID, venueID, uuid.New(), time.Now()).Validate(); err != ErrVenueMergeIntoSelf {
+		t.Errorf("Validate() error = %v, want %v", err, ErrVenueMergeIntoSelf)
+	}
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// OpeningHours describes when a venue is open. Times are wall clock times in the venue's timezone.
+type OpeningHours struct {
+	Weekly     []OpeningPeriod         `json:"weekly"`
+	Exceptions []OpeningHoursException `json:"exceptions,omitempty"`
+}
+
+// OpeningPeriod is a weekly opening. A closing time at or before the opening time means the venue
+// closes after midnight.
+type OpeningPeriod struct {
+	Day    string `json:"day"`    // monday ... sunday
+	Opens  string `json:"opens"`  // HH:MM
+	Closes string `json:"closes"` // HH:MM, 24:00 for midnight
+}
+
+// OpeningTimeRange is an opening on a specific date
+type OpeningTimeRange struct {
+	Opens  string `json:"opens"`
+	Closes string `json:"closes"`
+}
+
+// OpeningHoursException replaces the weekly hours on one date, such as a holiday closure
+type OpeningHoursException struct {
+	Date    string             `json:"date"` // YYYY-MM-DD
+	Closed  bool               `json:"closed"`
+	Periods []OpeningTimeRange `json:"periods,omitempty"`
+	Note    string             `json:"note,omitempty"`
+}
+
+const (
+	// MaxOpeningHoursExceptions is the most exceptions a venue may list
+	MaxOpeningHoursExceptions = 366
+	// maxOpeningHoursCheckSpan is the longest event checked against opening hours
+	maxOpeningHoursCheckSpan = 7 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidOpeningDay              = errors.New("opening day must be a weekday name such as monday")
+	ErrInvalidOpeningTime             = errors.New("opening times must use HH:MM")
+	ErrEmptyOpeningPeriod             = errors.New("opening and closing times cannot be equal")
+	ErrInvalidOpeningHoursException   = errors.New("opening hours exception must have a YYYY-MM-DD date and be either closed or list periods")
+	ErrDuplicateOpeningHoursException = errors.New("opening hours exceptions must have distinct dates")
+	ErrTooManyOpeningHoursExceptions  = errors.New("too many opening hours exceptions")
+)
+
+var openingWeekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Validate validates the OpeningHours value
+func (h *OpeningHours) Validate() error {
+	for _, period := range h.Weekly {
+		if _, ok := openingWeekdays[period.Day]; !ok {
+			return ErrInvalidOpeningDay
+		}
+		if err := validateOpeningTimes(period.Opens, period.Closes); err != nil {
+			return err
+		}
+	}
+
+	if len(h.Exceptions) > MaxOpeningHoursExceptions {
+		return ErrTooManyOpeningHoursExceptions
+	}
+
+	dates := make(map[string]bool, len(h.Exceptions))
+	for _, exception := range h.Exceptions {
+		if _, err := time.Parse("2006-01-02", exception.Date); err != nil {
+			return ErrInvalidOpeningHoursException
+		}
+		if exception.Closed == (len(exception.Periods) > 0) {
+			return ErrInvalidOpeningHoursException
+		}
+		if dates[exception.Date] {
+			return ErrDuplicateOpeningHoursException
+		}
+		dates[exception.Date] = true
+
+		for _, period := range exception.Periods {
+			if err := validateOpeningTimes(period.Opens, period.Closes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsSet reports whether weekly hours are configured. Without them the venue's hours are unknown.
+func (h *OpeningHours) IsSet() bool {
+	return h != nil && len(h.Weekly) > 0
+}
+
+// IsOpenDuring reports whether the venue is open for the whole of [start, end) in the given location.
+// Back-to-back openings, such as a period running to midnight followed by one from midnight, count
+// as continuous.
+func (h *OpeningHours) IsOpenDuring(start, end time.Time, loc *time.Location) bool {
+	if !end.After(start) || end.Sub(start) > maxOpeningHoursCheckSpan {
+		return false
+	}
+
+	// Start a day early so openings that run past midnight into the event are included
+	startLocal := start.In(loc)
+	day := time.Date(startLocal.Year(), startLocal.Month(), startLocal.Day()-1, 0, 0, 0, 0, loc)
+
+	var intervals [][2]time.Time
+	for ; day.Before(end); day = day.AddDate(0, 0, 1) {
+		for _, period := range h.rangesOn(day) {
+			opens := atOpeningTime(day, period.Opens)
+			closes := atOpeningTime(day, period.Closes)
+			if !closes.After(opens) {
+				closes = atOpeningTime(day.AddDate(0, 0, 1), period.Closes)
+			}
+			intervals = append(intervals, [2]time.Time{opens, closes})
+		}
+	}
+
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i][0].Before(intervals[j][0])
+	})
+
+	var current *[2]time.Time
+	for i := range intervals {
+		interval := intervals[i]
+		if current != nil && !interval[0].After(current[1]) {
+			if interval[1].After(current[1]) {
+				current[1] = interval[1]
+			}
+			continue
+		}
+		if current != nil && !current[0].After(start) && !current[1].Before(end) {
+			return true
+		}
+		current = &intervals[i]
+	}
+
+	return current != nil && !current[0].After(start) && !current[1].Before(end)
+}
+
+// rangesOn returns the openings that start on the given date, applying any exception for it
+func (h *OpeningHours) rangesOn(day time.Time) []OpeningTimeRange {
+	date := day.Format("2006-01-02")
+	for _, exception := range h.Exceptions {
+		if exception.Date == date {
+			return exception.Periods
+		}
+	}
+
+	var ranges []OpeningTimeRange
+	for _, period := range h.Weekly {
+		if openingWeekdays[period.Day] == day.Weekday() {
+			ranges = append(ranges, OpeningTimeRange{Opens: period.Opens, Closes: period.Closes})
+		}
+	}
+	return ranges
+}
+
+// validateOpeningTimes checks a pair of HH:MM opening and closing times
+func validateOpeningTimes(opens, closes string) error {
+	openMinutes, err := parseOpeningTime(opens)
+	if err != nil || openMinutes == 24*60 {
+		return ErrInvalidOpeningTime
+	}
+
+	closeMinutes, err := parseOpeningTime(closes)
+	if err != nil {
+		return ErrInvalidOpeningTime
+	}
+
+	if openMinutes == closeMinutes {
+		return ErrEmptyOpeningPeriod
+	}
+
+	return nil
+}
+
+// parseOpeningTime converts HH:MM to minutes after midnight, accepting 24:00 as the end of the day
+func parseOpeningTime(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, ErrInvalidOpeningTime
+	}
+
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+// atOpeningTime returns the instant the wall clock shows value on the given day. Values are
+// validated before use, so parse errors cannot occur here.
+func atOpeningTime(day time.Time, value string) time.Time {
+	minutes, _ := parseOpeningTime(value)
+	return time.Date(day.Year(), day.Month(), day.Day(), minutes/60, minutes%60, 0, 0, day.Location())
+}
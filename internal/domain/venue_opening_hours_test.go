@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOpeningHours_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hours   OpeningHours
+		wantErr error
+	}{
+		{
+			name: "valid hours with holiday",
+			hours: OpeningHours{
+				Weekly:     []OpeningPeriod{{Day: "monday", Opens: "10:00", Closes: "20:00"}, {Day: "friday", Opens: "18:00", Closes: "02:00"}},
+				Exceptions: []OpeningHoursException{{Date: "2025-12-25", Closed: true, Note: "Christmas"}},
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "open until midnight",
+			hours:   OpeningHours{Weekly: []OpeningPeriod{{Day: "sunday", Opens: "00:00", Closes: "24:00"}}},
+			wantErr: nil,
+		},
+		{
+			name:    "invalid day",
+			hours:   OpeningHours{Weekly: []OpeningPeriod{{Day: "Mon", Opens: "10:00", Closes: "20:00"}}},
+			wantErr: ErrInvalidOpeningDay,
+		},
+		{
+			name:    "invalid time",
+			hours:   OpeningHours{Weekly: []OpeningPeriod{{Day: "monday", Opens: "10:00", Closes: "25:00"}}},
+			wantErr: ErrInvalidOpeningTime,
+		},
+		{
+			name:    "opens at end of day",
+			hours:   OpeningHours{Weekly: []OpeningPeriod{{Day: "monday", Opens: "24:00", Closes: "02:00"}}},
+			wantErr: ErrInvalidOpeningTime,
+		},
+		{
+			name:    "empty period",
+			hours:   OpeningHours{Weekly: []OpeningPeriod{{Day: "monday", Opens: "10:00", Closes: "10:00"}}},
+			wantErr: ErrEmptyOpeningPeriod,
+		},
+		{
+			name:    "exception without date",
+			hours:   OpeningHours{Exceptions: []OpeningHoursException{{Date: "25/12", Closed: true}}},
+			wantErr: ErrInvalidOpeningHoursException,
+		},
+		{
+			name: "closed exception with periods",
+			hours: OpeningHours{Exceptions: []OpeningHoursException{{
+				Date: "2025-12-24", Closed: true, Periods: []OpeningTimeRange{{Opens: "10:00", Closes: "14:00"}},
+			}}},
+			wantErr: ErrInvalidOpeningHoursException,
+		},
+		{
+			name: "duplicate exception",
+			hours: OpeningHours{Exceptions: []OpeningHoursException{
+				{Date: "2025-12-25", Closed: true},
+				{Date: "2025-12-25", Periods: []OpeningTimeRange{{Opens: "10:00", Closes: "14:00"}}},
+			}},
+			wantErr: ErrDuplicateOpeningHoursException,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.hours.Validate(); err != tt.wantErr {
+				t.Errorf("OpeningHours.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOpeningHours_IsOpenDuring(t *testing.T) {
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	// 2025-10-17 is a Friday
+	hours := &OpeningHours{
+		Weekly: []OpeningPeriod{
+			{Day: "friday", Opens: "10:00", Closes: "14:00"},
+			{Day: "friday", Opens: "14:00", Closes: "02:00"},
+			{Day: "saturday", Opens: "12:00", Closes: "20:00"},
+		},
+		Exceptions: []OpeningHoursException{
+			{Date: "2025-10-24", Closed: true, Note: "Inventory"},
+			{Date: "2025-10-25", Periods: []OpeningTimeRange{{Opens: "15:00", Closes: "18:00"}}},
+		},
+	}
+
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, time.October, day, hour, minute, 0, 0, lisbon)
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       bool
+	}{
+		{"within friday hours", at(17, 18, 0), at(17, 22, 0), true},
+		{"across back-to-back openings", at(17, 12, 0), at(17, 16, 0), true},
+		{"past midnight into saturday", at(17, 23, 0), at(18, 1, 30), true},
+		{"after late closing", at(18, 1, 0), at(18, 3, 0), false},
+		{"before opening", at(18, 11, 0), at(18, 13, 0), false},
+		{"on a closed weekday", at(20, 18, 0), at(20, 20, 0), false},
+		{"on a holiday closure", at(24, 18, 0), at(24, 20, 0), false},
+		{"within special hours", at(25, 15, 30), at(25, 17, 0), true},
+		{"outside special hours", at(25, 12, 30), at(25, 14, 0), false},
+		{"given in another timezone", at(17, 18, 0).UTC(), at(17, 22, 0).UTC(), true},
+		{"end before start", at(17, 22, 0), at(17, 18, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hours.IsOpenDuring(tt.start, tt.end, lisbon); got != tt.want {
+				t.Errorf("OpeningHours.IsOpenDuring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVenue_IsOpenDuring(t *testing.T) {
+	start := time.Date(2025, time.October, 20, 18, 0, 0, 0, time.UTC)
+	end := start.Add(3 * time.Hour)
+	timezone := "UTC"
+	hours := &OpeningHours{Weekly: []OpeningPeriod{{Day: "monday", Opens: "10:00", Closes: "20:00"}}}
+
+	open, err := (&Venue{}).IsOpenDuring(start, end)
+	if err != nil || !open {
+		t.Errorf("Venue.IsOpenDuring() without hours = %v, %v, want true, nil", open, err)
+	}
+
+	open, err = (&Venue{Timezone: &timezone, OpeningHours: hours}).IsOpenDuring(start, end)
+	if err != nil || open {
+		t.Errorf("Venue.IsOpenDuring() after closing = %v, %v, want false, nil", open, err)
+	}
+
+	if _, err := (&Venue{OpeningHours: hours}).IsOpenDuring(start, end); err != ErrVenueTimezoneRequired {
+		t.Errorf("Venue.IsOpenDuring() without timezone error = %v, want %v", err, ErrVenueTimezoneRequired)
+	}
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VenueReviewTag is a quality players can call out in a venue review
+type VenueReviewTag string
+
+const (
+	VenueReviewTagParking         VenueReviewTag = "parking"
+	VenueReviewTagAccessible      VenueReviewTag = "accessible"
+	VenueReviewTagGoodLighting    VenueReviewTag = "good_lighting"
+	VenueReviewTagSpaciousTables  VenueReviewTag = "spacious_tables"
+	VenueReviewTagFoodAndDrinks   VenueReviewTag = "food_and_drinks"
+	VenueReviewTagPublicTransport VenueReviewTag = "public_transport"
+	VenueReviewTagQuiet           VenueReviewTag = "quiet"
+)
+
+const (
+	// MinVenueRating and MaxVenueRating bound the star rating of a review
+	MinVenueRating = 1
+	MaxVenueRating = 5
+
+	// A new venue's ranking starts from VenueRatingPriorCount imaginary reviews of VenueRatingPriorMean
+	// stars, so a single five-star review does not put it above venues with many good ones
+	VenueRatingPriorCount = 3
+	VenueRatingPriorMean  = 3.0
+)
+
+// VenueReview is a player's rating of a venue they attended an event at
+type VenueReview struct {
+	ID        uuid.UUID        `json:"id" db:"id"`
+	VenueID   uuid.UUID        `json:"venue_id" db:"venue_id"`
+	UserID    uuid.UUID        `json:"user_id" db:"user_id"`
+	Rating    int              `json:"rating" db:"rating"`
+	Text      *string          `json:"text,omitempty" db:"text"`
+	Tags      []VenueReviewTag `json:"tags" db:"tags"`
+	CreatedAt time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+var (
+	ErrInvalidVenueRating     = errors.New("venue rating must be between 1 and 5 stars")
+	ErrVenueReviewTextTooLong = errors.New("venue review text cannot exceed 2000 characters")
+	ErrInvalidVenueReviewTag  = errors.New("invalid venue review tag")
+)
+
+// NewVenueReview creates a review, trimming the text and dropping repeated tags
+func NewVenueReview(venueID, userID uuid.UUID, rating int, text *string, tags []VenueReviewTag, now time.Time) *VenueReview {
+	review := &VenueReview{
+		ID:        uuid.New(),
+		VenueID:   venueID,
+		UserID:    userID,
+		CreatedAt: now,
+	}
+	review.Update(rating, text, tags, now)
+	return review
+}
+
+// Update replaces the rating, text and tags of the review
+func (r *VenueReview) Update(rating int, text *string, tags []VenueReviewTag, now time.Time) {
+	r.Rating = rating
+	r.Text = nil
+	if text != nil {
+		if trimmed := strings.TrimSpace(*text); trimmed != "" {
+			r.Text = &trimmed
+		}
+	}
+
+	r.Tags = []VenueReviewTag{}
+	seen := make(map[VenueReviewTag]bool)
+	for _, tag := range tags {
+		if !seen[tag] {
+			seen[tag] = true
+			r.Tags = append(r.Tags, tag)
+		}
+	}
+
+	r.UpdatedAt = now
+}
+
+// Validate validates the VenueReview entity
+func (r *VenueReview) Validate() error {
+	if r.Rating < MinVenueRating || r.Rating > MaxVenueRating {
+		return ErrInvalidVenueRating
+	}
+
+	if r.Text != nil && len(*r.Text) > 2000 {
+		return ErrVenueReviewTextTooLong
+	}
+
+	for _, tag := range r.Tags {
+		if !IsValidVenueReviewTag(tag) {
+			return ErrInvalidVenueReviewTag
+		}
+	}
+
+	return nil
+}
+
+// WeightedRating ranks the venue by its reviews, pulled towards the prior for venues with few of them
+func (v *Venue) WeightedRating() float64 {
+	total := v.RatingAverage*float64(v.RatingCount) + VenueRatingPriorMean*VenueRatingPriorCount
+	return total / float64(v.RatingCount+VenueRatingPriorCount)
+}
+
+// IsValidVenueReviewTag checks if the venue review tag is valid
+func IsValidVenueReviewTag(tag VenueReviewTag) bool {
+	switch tag {
+	case VenueReviewTagParking, VenueReviewTagAccessible, VenueReviewTagGoodLighting, VenueReviewTagSpaciousTables,
+		VenueReviewTagFoodAndDrinks, VenueReviewTagPublicTransport, VenueReviewTagQuiet:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestVenueReview_Validate(t *testing.T) {
+	longText := strings.Repeat("a", 2001)
+
+	tests := []struct {
+		name    string
+		review  *VenueReview
+		wantErr error
+	}{
+		{
+			name:   "valid review",
+			review: NewVenueReview(uuid.New(), uuid.New(), 4, stringPtr("Big tables, great lighting"), []VenueReviewTag{VenueReviewTagGoodLighting}, time.Now()),
+		},
+		{
+			name:    "rating too low",
+			review:  NewVenueReview(uuid.New(), uuid.New(), 0, nil, nil, time.Now()),
+			wantErr: ErrInvalidVenueRating,
+		},
+		{
+			name:    "rating too high",
+			review:  NewVenueReview(uuid.New(), uuid.New(), 6, nil, nil, time.Now()),
+			wantErr: ErrInvalidVenueRating,
+		},
+		{
+			name:    "text too long",
+			review:  NewVenueReview(uuid.New(), uuid.New(), 3, &longText, nil, time.Now()),
+			wantErr: ErrVenueReviewTextTooLong,
+		},
+		{
+			name:    "unknown tag",
+			review:  NewVenueReview(uuid.New(), uuid.New(), 3, nil, []VenueReviewTag{"karaoke"}, time.Now()),
+			wantErr: ErrInvalidVenueReviewTag,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.review.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewVenueReview(t *testing.T) {
+	review := NewVenueReview(uuid.New(), uuid.New(), 5, stringPtr("   "), []VenueReviewTag{VenueReviewTagParking, VenueReviewTagParking, VenueReviewTagQuiet}, time.Now())
+
+	if review.Text != nil {
+		t.Errorf("NewVenueReview() text = %q, want nil for blank text", *review.Text)
+	}
+	if len(review.Tags) != 2 {
+		t.Errorf("NewVenueReview() tags = %v, want repeated tags dropped", review.Tags)
+	}
+}
+
+func TestVenue_WeightedRating(t *testing.T) {
+	unrated := &Venue{}
+	oneReview := &Venue{RatingAverage: 5, RatingCount: 1}
+	manyReviews := &Venue{RatingAverage: 4.6, RatingCount: 40}
+
+	if got := unrated.WeightedRating(); got != VenueRatingPriorMean {
+		t.Errorf("WeightedRating() = %v for an unrated venue, want %v", got, VenueRatingPriorMean)
+	}
+	if oneReview.WeightedRating() >= manyReviews.WeightedRating() {
+		t.Errorf("WeightedRating() should rank many good reviews above a single perfect one")
+	}
+}
@@ -20,6 +20,8 @@ func TestVenue_Validate(t *testing.T) {
 	}
 	longAddressStr := string(longAddress)
 
+	invalidTimezone := "Mars/Olympus_Mons"
+
 	tests := []struct {
 		name    string
 		venue   Venue
@@ -165,6 +167,51 @@ func TestVenue_Validate(t *testing.T) {
 			},
 			wantErr: ErrInvalidCoordinates,
 		},
+		{
+			name: "invalid timezone",
+			venue: Venue{
+				ID:        uuid.New(),
+				Name:      "Valid Name",
+				Type:      VenueTypeStore,
+				Address:   "123 Main St",
+				City:      "Lisbon",
+				Country:   "Portugal",
+				Latitude:  38.7223,
+				Longitude: -9.1393,
+				Timezone:  &invalidTimezone,
+			},
+			wantErr: ErrInvalidVenueTimezone,
+		},
+		{
+			name: "opening hours without timezone",
+			venue: Venue{
+				ID:           uuid.New(),
+				Name:         "Valid Name",
+				Type:         VenueTypeStore,
+				Address:      "123 Main St",
+				City:         "Lisbon",
+				Country:      "Portugal",
+				Latitude:     38.7223,
+				Longitude:    -9.1393,
+				OpeningHours: &OpeningHours{Weekly: []OpeningPeriod{{Day: "monday", Opens: "10:00", Closes: "20:00"}}},
+			},
+			wantErr: ErrVenueTimezoneRequired,
+		},
+		{
+			name: "invalid amenities",
+			venue: Venue{
+				ID:        uuid.New(),
+				Name:      "Valid Name",
+				Type:      VenueTypeStore,
+				Address:   "123 Main St",
+				City:      "Lisbon",
+				Country:   "Portugal",
+				Latitude:  38.7223,
+				Longitude: -9.1393,
+				Amenities: &VenueAmenities{TableCount: intPtr(8)},
+			},
+			wantErr: ErrVenueSeatsRequired,
+		},
 	}
 
 	for _, tt := range tests {
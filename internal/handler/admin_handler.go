@@ -0,0 +1,479 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// AdminHandler handles platform admin HTTP requests
+type AdminHandler struct {
+	platformAdminUseCase *usecase.PlatformAdminUseCase
+}
+
+// AdminReasonRequest represents an admin request payload carrying only a reason
+type AdminReasonRequest struct {
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// ChangePlatformRoleRequest represents the platform role change payload
+type ChangePlatformRoleRequest struct {
+	Role   string  `json:"role" validate:"required,oneof=user moderator admin"`
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// ImpersonateUserRequest represents the impersonation request payload
+type ImpersonateUserRequest struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+// AdminUserResponse represents a user account as seen by platform admins
+type AdminUserResponse struct {
+	ID           string  `json:"id"`
+	Email        string  `json:"email"`
+	PlatformRole string  `json:"platform_role"`
+	IsActive     bool    `json:"is_active"`
+	LastLogin    *string `json:"last_login,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+// AdminUserListResponse represents a page of user accounts
+type AdminUserListResponse struct {
+	Users  []AdminUserResponse `json:"users"`
+	Total  int                 `json:"total"`
+	Limit  int                 `json:"limit"`
+	Offset int                 `json:"offset"`
+}
+
+// ImpersonationResponse represents a support session token
+type ImpersonationResponse struct {
+	UserID      string `json:"user_id"`
+	AccessToken string `json:"access_token"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+// AdminNotificationResponse represents a queued notification
+type AdminNotificationResponse struct {
+	ID           string  `json:"id"`
+	UserID       string  `json:"user_id"`
+	Type         string  `json:"type"`
+	Status       string  `json:"status"`
+	ScheduledAt  string  `json:"scheduled_at"`
+	SentAt       *string `json:"sent_at,omitempty"`
+	RetryCount   int     `json:"retry_count"`
+	ErrorMessage *string `json:"error_message,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+// AdminNotificationListResponse represents a page of the notification queue
+type AdminNotificationListResponse struct {
+	Notifications []AdminNotificationResponse `json:"notifications"`
+	Counts        map[string]int              `json:"counts"`
+	Total         int                         `json:"total"`
+	Limit         int                         `json:"limit"`
+	Offset        int                         `json:"offset"`
+}
+
+// AdminActionResponse represents an entry in the admin audit trail
+type AdminActionResponse struct {
+	ID           string                 `json:"id"`
+	AdminID      string                 `json:"admin_id"`
+	Action       string                 `json:"action"`
+	TargetUserID string                 `json:"target_user_id"`
+	Reason       *string                `json:"reason,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt    string                 `json:"created_at"`
+}
+
+// AdminActionListResponse represents a page of the admin audit trail
+type AdminActionListResponse struct {
+	Actions []AdminActionResponse `json:"actions"`
+	Total   int                   `json:"total"`
+	Limit   int                   `json:"limit"`
+	Offset  int                   `json:"offset"`
+}
+
+// NewAdminHandler creates a new platform admin handler
+func NewAdminHandler(platformAdminUseCase *usecase.PlatformAdminUseCase) *AdminHandler {
+	return &AdminHandler{
+		platformAdminUseCase: platformAdminUseCase,
+	}
+}
+
+// SearchUsers handles GET /admin/users
+func (h *AdminHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parseModerationPage(r)
+	result, err := h.platformAdminUseCase.SearchUsers(r.Context(), &usecase.SearchUsersRequest{
+		AdminID: adminID,
+		Query:   r.URL.Query().Get("q"),
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		h.writeAdminError(w, err, "user_search_failed", "Failed to search users")
+		return
+	}
+
+	users := make([]AdminUserResponse, len(result.Users))
+	for i, user := range result.Users {
+		users[i] = *h.convertToAdminUserResponse(user)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(AdminUserListResponse{
+		Users:  users,
+		Total:  len(users),
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	})
+}
+
+// DeactivateUser handles POST /admin/users/{id}/deactivate
+func (h *AdminHandler) DeactivateUser(w http.ResponseWriter, r *http.Request) {
+	h.setUserActive(w, r, false)
+}
+
+// ReactivateUser handles POST /admin/users/{id}/reactivate
+func (h *AdminHandler) ReactivateUser(w http.ResponseWriter, r *http.Request) {
+	h.setUserActive(w, r, true)
+}
+
+// setUserActive deactivates or reactivates the account in the request path
+func (h *AdminHandler) setUserActive(w http.ResponseWriter, r *http.Request, active bool) {
+	userID, adminID, ok := h.parseUserRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req AdminReasonRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+			return
+		}
+	}
+
+	user, err := h.platformAdminUseCase.SetUserActive(r.Context(), &usecase.SetUserActiveRequest{
+		AdminID: adminID,
+		UserID:  userID,
+		Active:  active,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		h.writeAdminError(w, err, "user_update_failed", "Failed to update account")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToAdminUserResponse(user))
+}
+
+// ChangePlatformRole handles PUT /admin/users/{id}/role
+func (h *AdminHandler) ChangePlatformRole(w http.ResponseWriter, r *http.Request) {
+	userID, adminID, ok := h.parseUserRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req ChangePlatformRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	user, err := h.platformAdminUseCase.ChangePlatformRole(r.Context(), &usecase.ChangePlatformRoleRequest{
+		AdminID: adminID,
+		UserID:  userID,
+		Role:    domain.PlatformRole(req.Role),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		h.writeAdminError(w, err, "role_update_failed", "Failed to change platform role")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToAdminUserResponse(user))
+}
+
+// ImpersonateUser handles POST /admin/users/{id}/impersonate
+func (h *AdminHandler) ImpersonateUser(w http.ResponseWriter, r *http.Request) {
+	userID, adminID, ok := h.parseUserRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req ImpersonateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	result, err := h.platformAdminUseCase.ImpersonateUser(r.Context(), &usecase.ImpersonateUserRequest{
+		AdminID: adminID,
+		UserID:  userID,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		h.writeAdminError(w, err, "impersonation_failed", "Failed to start support session")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(ImpersonationResponse{
+		UserID:      result.UserID.String(),
+		AccessToken: result.AccessToken,
+		ExpiresAt:   result.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	})
+}
+
+// ListNotifications handles GET /admin/notifications
+func (h *AdminHandler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parseModerationPage(r)
+	result, err := h.platformAdminUseCase.ListNotifications(r.Context(), &usecase.ListNotificationsRequest{
+		AdminID: adminID,
+		Status:  domain.NotificationStatus(r.URL.Query().Get("status")),
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		h.writeAdminError(w, err, "notifications_fetch_failed", "Failed to fetch notifications")
+		return
+	}
+
+	notifications := make([]AdminNotificationResponse, len(result.Notifications))
+	for i, notification := range result.Notifications {
+		notifications[i] = *h.convertToAdminNotificationResponse(notification)
+	}
+
+	counts := make(map[string]int, len(result.Counts))
+	for status, count := range result.Counts {
+		counts[string(status)] = count
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(AdminNotificationListResponse{
+		Notifications: notifications,
+		Counts:        counts,
+		Total:         len(notifications),
+		Limit:         result.Limit,
+		Offset:        result.Offset,
+	})
+}
+
+// ResendNotification handles POST /admin/notifications/{id}/resend
+func (h *AdminHandler) ResendNotification(w http.ResponseWriter, r *http.Request) {
+	notificationID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_notification_id", "Invalid notification ID")
+		return
+	}
+
+	adminID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AdminReasonRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+			return
+		}
+	}
+
+	notification, err := h.platformAdminUseCase.ResendNotification(r.Context(), &usecase.ResendNotificationRequest{
+		AdminID:        adminID,
+		NotificationID: notificationID,
+		Reason:         req.Reason,
+	})
+	if err != nil {
+		h.writeAdminError(w, err, "notification_resend_failed", "Failed to resend notification")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToAdminNotificationResponse(notification))
+}
+
+// ListAdminActions handles GET /admin/actions
+func (h *AdminHandler) ListAdminActions(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req := &usecase.ListAdminActionsRequest{AdminID: adminID}
+	if userIDStr := r.URL.Query().Get("user_id"); userIDStr != "" {
+		targetUserID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+			return
+		}
+		req.TargetUserID = &targetUserID
+	}
+	req.Limit, req.Offset = parseModerationPage(r)
+
+	result, err := h.platformAdminUseCase.ListAdminActions(r.Context(), req)
+	if err != nil {
+		h.writeAdminError(w, err, "admin_actions_fetch_failed", "Failed to fetch admin actions")
+		return
+	}
+
+	actions := make([]AdminActionResponse, len(result.Actions))
+	for i, action := range result.Actions {
+		actions[i] = AdminActionResponse{
+			ID:           action.ID.String(),
+			AdminID:      action.AdminID.String(),
+			Action:       string(action.Action),
+			TargetUserID: action.TargetUserID.String(),
+			Reason:       action.Reason,
+			Metadata:     action.Metadata,
+			CreatedAt:    action.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(AdminActionListResponse{
+		Actions: actions,
+		Total:   len(actions),
+		Limit:   result.Limit,
+		Offset:  result.Offset,
+	})
+}
+
+// writeAdminError maps platform admin use case errors to HTTP responses
+func (h *AdminHandler) writeAdminError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrPlatformAdminOnly:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Admin access required")
+	case usecase.ErrCannotImpersonate:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", err.Error())
+	case usecase.ErrUserNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
+	case usecase.ErrNotificationNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "notification_not_found", "Notification not found")
+	case usecase.ErrNotificationNotFailed:
+		h.writeErrorResponse(w, http.StatusConflict, "notification_not_failed", err.Error())
+	case domain.ErrAdminCannotTargetSelf, domain.ErrInvalidPlatformRole, domain.ErrImpersonationReasonRequired,
+		domain.ErrAdminActionReasonTooLong, domain.ErrInvalidNotificationStatus:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// parseUserRequest extracts the target user ID and authenticated admin ID from the request
+func (h *AdminHandler) parseUserRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	adminID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, adminID, true
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *AdminHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToAdminUserResponse converts a domain user to the admin response format
+func (h *AdminHandler) convertToAdminUserResponse(user *domain.User) *AdminUserResponse {
+	return &AdminUserResponse{
+		ID:           user.ID.String(),
+		Email:        user.Email,
+		PlatformRole: string(user.PlatformRole),
+		IsActive:     user.IsActive,
+		LastLogin:    formatOptionalTime(user.LastLogin),
+		CreatedAt:    user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// convertToAdminNotificationResponse converts a domain notification to the admin response format
+func (h *AdminHandler) convertToAdminNotificationResponse(notification *domain.Notification) *AdminNotificationResponse {
+	return &AdminNotificationResponse{
+		ID:           notification.ID.String(),
+		UserID:       notification.UserID.String(),
+		Type:         string(notification.Type),
+		Status:       string(notification.Status),
+		ScheduledAt:  notification.ScheduledAt.Format("2006-01-02T15:04:05Z07:00"),
+		SentAt:       formatOptionalTime(notification.SentAt),
+		RetryCount:   notification.RetryCount,
+		ErrorMessage: notification.ErrorMessage,
+		CreatedAt:    notification.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *AdminHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers platform admin routes with the given router
+func (h *AdminHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	// Admin routes require an admin role claim; use cases re-check the stored role
+	admin := router.PathPrefix("/admin").Subrouter()
+	admin.Use(authMiddleware.RequireAuth)
+	admin.Use(authMiddleware.RequireAdmin)
+
+	// Account operations
+	admin.HandleFunc("/users", h.SearchUsers).Methods("GET")
+	admin.HandleFunc("/users/{id}/deactivate", h.DeactivateUser).Methods("POST")
+	admin.HandleFunc("/users/{id}/reactivate", h.ReactivateUser).Methods("POST")
+	admin.HandleFunc("/users/{id}/role", h.ChangePlatformRole).Methods("PUT")
+	admin.HandleFunc("/users/{id}/impersonate", h.ImpersonateUser).Methods("POST")
+
+	// Notification queues
+	admin.HandleFunc("/notifications", h.ListNotifications).Methods("GET")
+	admin.HandleFunc("/notifications/{id}/resend", h.ResendNotification).Methods("POST")
+
+	// Audit trail
+	admin.HandleFunc("/actions", h.ListAdminActions).Methods("GET")
+}
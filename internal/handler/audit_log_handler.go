@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// AuditLogHandler handles audit log HTTP requests
+type AuditLogHandler struct {
+	auditLogUseCase *usecase.AuditLogUseCase
+}
+
+// AuditLogEntryResponse represents an audit log entry
+type AuditLogEntryResponse struct {
+	ID            string                 `json:"id"`
+	ActorID       *string                `json:"actor_id,omitempty"`
+	Action        string                 `json:"action"`
+	TargetType    string                 `json:"target_type"`
+	TargetID      string                 `json:"target_id"`
+	SubjectUserID *string                `json:"subject_user_id,omitempty"`
+	Before        map[string]interface{} `json:"before,omitempty"`
+	After         map[string]interface{} `json:"after,omitempty"`
+	RequestID     *string                `json:"request_id,omitempty"`
+	CreatedAt     string                 `json:"created_at"`
+}
+
+// AuditLogListResponse represents a page of audit log entries
+type AuditLogListResponse struct {
+	Entries []AuditLogEntryResponse `json:"entries"`
+	Total   int                     `json:"total"`
+	Limit   int                     `json:"limit"`
+	Offset  int                     `json:"offset"`
+}
+
+// NewAuditLogHandler creates a new audit log handler
+func NewAuditLogHandler(auditLogUseCase *usecase.AuditLogUseCase) *AuditLogHandler {
+	return &AuditLogHandler{
+		auditLogUseCase: auditLogUseCase,
+	}
+}
+
+// GetMyAuditLog handles GET /me/audit-log
+func (h *AuditLogHandler) GetMyAuditLog(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req := &usecase.GetMyAuditLogRequest{UserID: userID}
+	req.Limit, req.Offset = parseModerationPage(r)
+
+	result, err := h.auditLogUseCase.GetMyAuditLog(r.Context(), req)
+	if err != nil {
+		h.writeAuditLogError(w, err, "audit_log_fetch_failed", "Failed to fetch audit log")
+		return
+	}
+
+	h.writeAuditLogResponse(w, result)
+}
+
+// ListAuditLog handles GET /admin/audit-log
+func (h *AuditLogHandler) ListAuditLog(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req := &usecase.ListAuditLogRequest{AdminID: adminID}
+	query := r.URL.Query()
+
+	uuidFilters := []struct {
+		param  string
+		target **uuid.UUID
+	}{
+		{"user_id", &req.Filter.AccountID},
+		{"actor_id", &req.Filter.ActorID},
+		{"target_id", &req.Filter.TargetID},
+	}
+	for _, filter := range uuidFilters {
+		if value := query.Get(filter.param); value != "" {
+			parsed, err := uuid.Parse(value)
+			if err != nil {
+				h.writeErrorResponse(w, http.StatusBadRequest, "invalid_"+filter.param, "Invalid "+filter.param)
+				return
+			}
+			*filter.target = &parsed
+		}
+	}
+
+	if action := query.Get("action"); action != "" {
+		auditAction := domain.AuditAction(action)
+		req.Filter.Action = &auditAction
+	}
+	if targetType := query.Get("target_type"); targetType != "" {
+		auditTargetType := domain.AuditTargetType(targetType)
+		req.Filter.TargetType = &auditTargetType
+	}
+	if requestID := query.Get("request_id"); requestID != "" {
+		req.Filter.RequestID = &requestID
+	}
+	req.Limit, req.Offset = parseModerationPage(r)
+
+	result, err := h.auditLogUseCase.ListAuditLog(r.Context(), req)
+	if err != nil {
+		h.writeAuditLogError(w, err, "audit_log_fetch_failed", "Failed to fetch audit log")
+		return
+	}
+
+	h.writeAuditLogResponse(w, result)
+}
+
+// writeAuditLogResponse writes a page of audit log entries
+func (h *AuditLogHandler) writeAuditLogResponse(w http.ResponseWriter, result *usecase.AuditLogResponse) {
+	entries := make([]AuditLogEntryResponse, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = *h.convertToAuditLogEntryResponse(entry)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(AuditLogListResponse{
+		Entries: entries,
+		Total:   len(entries),
+		Limit:   result.Limit,
+		Offset:  result.Offset,
+	})
+}
+
+// writeAuditLogError maps audit log use case errors to HTTP responses
+func (h *AuditLogHandler) writeAuditLogError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrPlatformAdminOnly:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Admin access required")
+	case domain.ErrInvalidAuditAction, domain.ErrInvalidAuditTargetType:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *AuditLogHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToAuditLogEntryResponse converts a domain audit log entry to the response format
+func (h *AuditLogHandler) convertToAuditLogEntryResponse(entry *domain.AuditLogEntry) *AuditLogEntryResponse {
+	response := &AuditLogEntryResponse{
+		ID:         entry.ID.String(),
+		Action:     string(entry.Action),
+		TargetType: string(entry.TargetType),
+		TargetID:   entry.TargetID.String(),
+		Before:     entry.Before,
+		After:      entry.After,
+		RequestID:  entry.RequestID,
+		CreatedAt:  entry.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if entry.ActorID != nil {
+		actorID := entry.ActorID.String()
+		response.ActorID = &actorID
+	}
+
+	if entry.SubjectUserID != nil {
+		subjectUserID := entry.SubjectUserID.String()
+		response.SubjectUserID = &subjectUserID
+	}
+
+	return response
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *AuditLogHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers audit log routes with the given router
+func (h *AuditLogHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	// Users can review the history of their own account
+	protected := router.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+
+	protected.HandleFunc("/me/audit-log", h.GetMyAuditLog).Methods("GET")
+
+	// Admins can query the full audit log
+	admin := router.PathPrefix("/admin").Subrouter()
+	admin.Use(authMiddleware.RequireAuth)
+	admin.Use(authMiddleware.RequireAdmin)
+
+	admin.HandleFunc("/audit-log", h.ListAuditLog).Methods("GET")
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -22,6 +24,7 @@ type AuthHandler struct {
 	}
 	jwtService interface {
 		GenerateTokenPair(userID, email string) (*service.TokenPair, error)
+		GenerateTokenPairWithRole(userID, email, role string) (*service.TokenPair, error)
 		ValidateAccessToken(token string) (*service.TokenClaims, error)
 		RefreshTokens(refreshToken string) (*service.TokenPair, error)
 		BlacklistToken(token string) error
@@ -38,7 +41,9 @@ type AuthHandler struct {
 	userRepo interface {
 		GetByEmail(ctx context.Context, email string) (*domain.User, error)
 		GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+		UpdateLastLogin(ctx context.Context, userID uuid.UUID, loginTime time.Time) error
 	}
+	auditRecorder usecase.AuditRecorder
 }
 
 // RegisterRequest represents the registration request payload
@@ -78,6 +83,7 @@ func NewAuthHandler(
 	},
 	jwtService interface {
 		GenerateTokenPair(userID, email string) (*service.TokenPair, error)
+		GenerateTokenPairWithRole(userID, email, role string) (*service.TokenPair, error)
 		ValidateAccessToken(token string) (*service.TokenClaims, error)
 		RefreshTokens(refreshToken string) (*service.TokenPair, error)
 		BlacklistToken(token string) error
@@ -94,7 +100,9 @@ func NewAuthHandler(
 	userRepo interface {
 		GetByEmail(ctx context.Context, email string) (*domain.User, error)
 		GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
+		UpdateLastLogin(ctx context.Context, userID uuid.UUID, loginTime time.Time) error
 	},
+	auditRecorder usecase.AuditRecorder,
 ) *AuthHandler {
 	return &AuthHandler{
 		registerUseCase: registerUseCase,
@@ -102,6 +110,7 @@ func NewAuthHandler(
 		oauthService:    oauthService,
 		passwordService: passwordService,
 		userRepo:        userRepo,
+		auditRecorder:   auditRecorder,
 	}
 }
 
@@ -188,6 +197,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 	// Check if user is active
 	if !user.IsActive {
+		h.recordLogin(r, user.ID, domain.AuditActionLoginFailed, map[string]interface{}{"reason": "account_disabled"})
 		h.writeErrorResponse(w, http.StatusUnauthorized, "account_disabled", "Account is disabled")
 		return
 	}
@@ -195,17 +205,21 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	// Verify password
 	valid, err := h.passwordService.VerifyPassword(req.Password, user.PasswordHash)
 	if err != nil || !valid {
+		h.recordLogin(r, user.ID, domain.AuditActionLoginFailed, map[string]interface{}{"reason": "invalid_password"})
 		h.writeErrorResponse(w, http.StatusUnauthorized, "invalid_credentials", "Invalid email or password")
 		return
 	}
 
 	// Generate JWT tokens
-	tokenPair, err := h.jwtService.GenerateTokenPair(user.ID.String(), user.Email)
+	tokenPair, err := h.jwtService.GenerateTokenPairWithRole(user.ID.String(), user.Email, string(user.PlatformRole))
 	if err != nil {
 		h.writeErrorResponse(w, http.StatusInternalServerError, "token_generation_failed", "Failed to generate authentication tokens")
 		return
 	}
 
+	h.recordLogin(r, user.ID, domain.AuditActionLogin, map[string]interface{}{"method": "password"})
+	h.updateLastLogin(r, user.ID)
+
 	// TODO: Get user profile for response
 	// For now, we'll create a minimal user info response
 	response := AuthResponse{
@@ -257,6 +271,11 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Staying signed in counts as activity for the inactive account retention policy
+	if userID, err := uuid.Parse(claims.UserID); err == nil {
+		h.updateLastLogin(r, userID)
+	}
+
 	response := AuthResponse{
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
@@ -387,13 +406,27 @@ func (h *AuthHandler) handleOAuthCallback(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// Existing accounts keep their platform role when signing in through OAuth
+	role := ""
+	parsedUserID, parseErr := uuid.Parse(userID)
+	if parseErr == nil {
+		if user, err := h.userRepo.GetByID(r.Context(), parsedUserID); err == nil && user != nil {
+			role = string(user.PlatformRole)
+		}
+	}
+
 	// Generate JWT tokens
-	tokenPair, err := h.jwtService.GenerateTokenPair(userID, userInfo.Email)
+	tokenPair, err := h.jwtService.GenerateTokenPairWithRole(userID, userInfo.Email, role)
 	if err != nil {
 		h.writeErrorResponse(w, http.StatusInternalServerError, "token_generation_failed", "Failed to generate authentication tokens")
 		return
 	}
 
+	if parseErr == nil {
+		h.recordLogin(r, parsedUserID, domain.AuditActionLogin, map[string]interface{}{"method": "oauth", "provider": string(provider)})
+		h.updateLastLogin(r, parsedUserID)
+	}
+
 	response := AuthResponse{
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
@@ -417,6 +450,37 @@ func (h *AuthHandler) handleOAuthCallback(w http.ResponseWriter, r *http.Request
 	json.NewEncoder(w).Encode(response)
 }
 
+// recordLogin writes a successful or failed sign-in to the audit log. Failed attempts have no actor
+// because the caller has not proven who they are; they are filed under the targeted account.
+func (h *AuthHandler) recordLogin(r *http.Request, userID uuid.UUID, action domain.AuditAction, details map[string]interface{}) {
+	if h.auditRecorder == nil {
+		return
+	}
+
+	entry := &domain.AuditLogEntry{
+		Action:        action,
+		TargetType:    domain.AuditTargetUser,
+		TargetID:      userID,
+		SubjectUserID: &userID,
+		After:         details,
+	}
+	if action == domain.AuditActionLogin {
+		entry.ActorID = &userID
+	}
+
+	if err := h.auditRecorder.Record(r.Context(), entry); err != nil {
+		log.Printf("Failed to record %s audit entry for user %s: %v", action, userID, err)
+	}
+}
+
+// updateLastLogin records when the user last signed in, which the inactive account retention policy
+// relies on. A failure is logged rather than failing the sign-in.
+func (h *AuthHandler) updateLastLogin(r *http.Request, userID uuid.UUID) {
+	if err := h.userRepo.UpdateLastLogin(r.Context(), userID, time.Now().UTC()); err != nil {
+		log.Printf("Failed to update last login of user %s: %v", userID, err)
+	}
+}
+
 // writeErrorResponse writes a standardized error response
 func (h *AuthHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// DataExportHandler handles data export archive HTTP requests
+type DataExportHandler struct {
+	dataExportUseCase *usecase.DataExportUseCase
+}
+
+// DataExportResponse represents a data export archive request
+type DataExportResponse struct {
+	ID          string  `json:"id"`
+	Status      string  `json:"status"`
+	FileSize    *int64  `json:"file_size,omitempty"`
+	Error       *string `json:"error,omitempty"`
+	DownloadURL *string `json:"download_url,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+	CompletedAt *string `json:"completed_at,omitempty"`
+	ExpiresAt   *string `json:"expires_at,omitempty"`
+}
+
+// NewDataExportHandler creates a new data export handler
+func NewDataExportHandler(dataExportUseCase *usecase.DataExportUseCase) *DataExportHandler {
+	return &DataExportHandler{
+		dataExportUseCase: dataExportUseCase,
+	}
+}
+
+// RequestExport handles POST /me/exports
+func (h *DataExportHandler) RequestExport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	export, err := h.dataExportUseCase.RequestExport(r.Context(), &usecase.RequestDataExportRequest{UserID: userID})
+	if err != nil {
+		h.writeDataExportError(w, err, "export_request_failed", "Failed to request data export")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(h.convertToDataExportResponse(export, nil))
+}
+
+// GetExport handles GET /me/exports/{id}
+func (h *DataExportHandler) GetExport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	exportID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_export_id", "Invalid export ID")
+		return
+	}
+
+	result, err := h.dataExportUseCase.GetExport(r.Context(), &usecase.GetDataExportRequest{
+		ExportID: exportID,
+		UserID:   userID,
+	})
+	if err != nil {
+		h.writeDataExportError(w, err, "export_fetch_failed", "Failed to fetch data export")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToDataExportResponse(result.Export, result.DownloadURL))
+}
+
+// DownloadExport handles GET /exports/{id}/download. Access is granted by the link signature rather than a session.
+func (h *DataExportHandler) DownloadExport(w http.ResponseWriter, r *http.Request) {
+	exportID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_export_id", "Invalid export ID")
+		return
+	}
+
+	query := r.URL.Query()
+	download, err := h.dataExportUseCase.Download(r.Context(), &usecase.DownloadDataExportRequest{
+		ExportID:  exportID,
+		Expires:   query.Get("expires"),
+		Signature: query.Get("signature"),
+	})
+	if err != nil {
+		h.writeDataExportError(w, err, "export_download_failed", "Failed to download data export")
+		return
+	}
+	defer download.Archive.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+download.FileName+"\"")
+	w.Header().Set("Cache-Control", "no-store")
+	if download.Size > 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(download.Size, 10))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, download.Archive); err != nil {
+		log.Printf("Failed to stream data export %s: %v", exportID, err)
+	}
+}
+
+// writeDataExportError maps data export use case errors to HTTP responses
+func (h *DataExportHandler) writeDataExportError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrDataExportRateLimited:
+		h.writeErrorResponse(w, http.StatusTooManyRequests, "rate_limited", err.Error())
+	case usecase.ErrDataExportNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "export_not_found", "Data export not found")
+	case usecase.ErrDataExportNotReady:
+		h.writeErrorResponse(w, http.StatusConflict, "export_not_ready", err.Error())
+	case usecase.ErrInvalidDownloadLink:
+		h.writeErrorResponse(w, http.StatusForbidden, "invalid_download_link", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *DataExportHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToDataExportResponse converts a domain data export to the response format
+func (h *DataExportHandler) convertToDataExportResponse(export *domain.DataExport, downloadURL *string) *DataExportResponse {
+	return &DataExportResponse{
+		ID:          export.ID.String(),
+		Status:      string(export.Status),
+		FileSize:    export.FileSize,
+		Error:       export.Error,
+		DownloadURL: downloadURL,
+		CreatedAt:   export.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		CompletedAt: formatOptionalTime(export.CompletedAt),
+		ExpiresAt:   formatOptionalTime(export.ExpiresAt),
+	}
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *DataExportHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers data export routes with the given router
+func (h *DataExportHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	// Users request and track exports of their own data; support sessions may not
+	protected := router.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+	protected.Use(authMiddleware.RejectImpersonation)
+
+	protected.HandleFunc("/me/exports", h.RequestExport).Methods("POST")
+	protected.HandleFunc("/me/exports/{id}", h.GetExport).Methods("GET")
+
+	// Downloads are authorized by the signed link sent by email
+	router.HandleFunc("/exports/{id}/download", h.DownloadExport).Methods("GET")
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// EventCheckInResponse represents an attendee's check-in state
+type EventCheckInResponse struct {
+	EventID     string  `json:"event_id"`
+	UserID      string  `json:"user_id"`
+	Status      string  `json:"status"`
+	CheckedInAt *string `json:"checked_in_at,omitempty"`
+}
+
+// CheckInAttendee handles POST /events/{id}/attendees/{userId}/check-in
+func (h *EventHandler) CheckInAttendee(w http.ResponseWriter, r *http.Request) {
+	h.setAttendeeCheckIn(w, r, true)
+}
+
+// UndoAttendeeCheckIn handles DELETE /events/{id}/attendees/{userId}/check-in
+func (h *EventHandler) UndoAttendeeCheckIn(w http.ResponseWriter, r *http.Request) {
+	h.setAttendeeCheckIn(w, r, false)
+}
+
+// setAttendeeCheckIn checks an attendee in or clears their check-in
+func (h *EventHandler) setAttendeeCheckIn(w http.ResponseWriter, r *http.Request, checkedIn bool) {
+	vars := mux.Vars(r)
+
+	eventID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	attendeeID, err := uuid.Parse(vars["userId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	rsvp, err := h.eventManagementUseCase.CheckInAttendee(r.Context(), &usecase.CheckInAttendeeRequest{
+		EventID:   eventID,
+		UserID:    attendeeID,
+		CheckerID: userUUID,
+		CheckedIn: checkedIn,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrEventNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "event_not_found", "Event not found")
+		case usecase.ErrAttendeeNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "attendee_not_found", "Attendee not found")
+		case usecase.ErrUnauthorizedAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only event organizers allowed to check in attendees can do this")
+		case usecase.ErrEventCancelled:
+			h.writeErrorResponse(w, http.StatusConflict, "event_cancelled", "Event has been cancelled")
+		case domain.ErrCheckInNotGoing:
+			h.writeErrorResponse(w, http.StatusConflict, "attendee_not_going", err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "check_in_failed", "Failed to update check-in")
+		}
+		return
+	}
+
+	response := EventCheckInResponse{
+		EventID: rsvp.EventID.String(),
+		UserID:  rsvp.UserID.String(),
+		Status:  string(rsvp.Status),
+	}
+	if rsvp.CheckedInAt != nil {
+		checkedInAt := rsvp.CheckedInAt.Format("2006-01-02T15:04:05Z07:00")
+		response.CheckedInAt = &checkedInAt
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,320 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// InviteEventCoHostRequest represents the co-host invitation request payload
+type InviteEventCoHostRequest struct {
+	UserID      string   `json:"user_id" validate:"required,uuid"`
+	Permissions []string `json:"permissions" validate:"required,min=1"`
+}
+
+// UpdateEventCoHostRequest represents the co-host permissions update payload
+type UpdateEventCoHostRequest struct {
+	Permissions []string `json:"permissions" validate:"required,min=1"`
+}
+
+// EventCoHostResponse represents an event co-host
+type EventCoHostResponse struct {
+	EventID     string   `json:"event_id"`
+	UserID      string   `json:"user_id"`
+	InvitedBy   string   `json:"invited_by"`
+	Permissions []string `json:"permissions"`
+	Status      string   `json:"status"`
+	CreatedAt   string   `json:"created_at"`
+	RespondedAt *string  `json:"responded_at,omitempty"`
+}
+
+// EventCoHostListResponse represents an event's co-hosts
+type EventCoHostListResponse struct {
+	CoHosts              []EventCoHostResponse `json:"co_hosts"`
+	DelegablePermissions []string              `json:"delegable_permissions"`
+}
+
+// ListEventCoHosts handles GET /events/{id}/cohosts
+func (h *EventHandler) ListEventCoHosts(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.eventManagementUseCase.ListEventCoHosts(r.Context(), &usecase.ListEventCoHostsRequest{
+		EventID:     eventID,
+		RequesterID: userUUID,
+	})
+	if err != nil {
+		h.writeEventCoHostError(w, err, "cohosts_fetch_failed", "Failed to fetch co-hosts")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(EventCoHostListResponse{
+		CoHosts:              h.convertToEventCoHostResponses(result.CoHosts),
+		DelegablePermissions: permissionsToStrings(domain.CoHostPermissions),
+	})
+}
+
+// InviteEventCoHost handles POST /events/{id}/cohosts
+func (h *EventHandler) InviteEventCoHost(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req InviteEventCoHostRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	invitedUserID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	result, err := h.eventManagementUseCase.InviteEventCoHost(r.Context(), &usecase.InviteEventCoHostRequest{
+		EventID:     eventID,
+		UserID:      invitedUserID,
+		InviterID:   userUUID,
+		Permissions: stringsToPermissions(req.Permissions),
+	})
+	if err != nil {
+		h.writeEventCoHostError(w, err, "cohost_invite_failed", "Failed to invite co-host")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToEventCoHostResponse(result))
+}
+
+// UpdateEventCoHost handles PUT /events/{id}/cohosts/{userId}
+func (h *EventHandler) UpdateEventCoHost(w http.ResponseWriter, r *http.Request) {
+	eventID, coHostID, ok := h.parseEventCoHostPath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateEventCoHostRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	result, err := h.eventManagementUseCase.UpdateEventCoHost(r.Context(), &usecase.UpdateEventCoHostRequest{
+		EventID:     eventID,
+		UserID:      coHostID,
+		UpdaterID:   userUUID,
+		Permissions: stringsToPermissions(req.Permissions),
+	})
+	if err != nil {
+		h.writeEventCoHostError(w, err, "cohost_update_failed", "Failed to update co-host")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToEventCoHostResponse(result))
+}
+
+// RemoveEventCoHost handles DELETE /events/{id}/cohosts/{userId}
+func (h *EventHandler) RemoveEventCoHost(w http.ResponseWriter, r *http.Request) {
+	eventID, coHostID, ok := h.parseEventCoHostPath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.eventManagementUseCase.RemoveEventCoHost(r.Context(), &usecase.RemoveEventCoHostRequest{
+		EventID:   eventID,
+		UserID:    coHostID,
+		RemoverID: userUUID,
+	})
+	if err != nil {
+		h.writeEventCoHostError(w, err, "cohost_removal_failed", "Failed to remove co-host")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Co-host removed successfully",
+	})
+}
+
+// AcceptEventCoHost handles POST /events/{id}/cohosts/accept
+func (h *EventHandler) AcceptEventCoHost(w http.ResponseWriter, r *http.Request) {
+	h.respondToEventCoHost(w, r, true)
+}
+
+// DeclineEventCoHost handles POST /events/{id}/cohosts/decline
+func (h *EventHandler) DeclineEventCoHost(w http.ResponseWriter, r *http.Request) {
+	h.respondToEventCoHost(w, r, false)
+}
+
+// GetUserCoHostInvitations handles GET /me/cohost-invitations
+func (h *EventHandler) GetUserCoHostInvitations(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.eventManagementUseCase.ListCoHostInvitations(r.Context(), &usecase.ListCoHostInvitationsRequest{
+		UserID: userUUID,
+	})
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusInternalServerError, "invitations_fetch_failed", "Failed to fetch co-host invitations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"invitations": h.convertToEventCoHostResponses(result),
+	})
+}
+
+// respondToEventCoHost records the authenticated user's answer to a co-host invitation
+func (h *EventHandler) respondToEventCoHost(w http.ResponseWriter, r *http.Request, accept bool) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.eventManagementUseCase.RespondEventCoHost(r.Context(), &usecase.RespondEventCoHostRequest{
+		EventID: eventID,
+		UserID:  userUUID,
+		Accept:  accept,
+	})
+	if err != nil {
+		h.writeEventCoHostError(w, err, "cohost_response_failed", "Failed to respond to co-host invitation")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToEventCoHostResponse(result))
+}
+
+// parseEventCoHostPath parses the event and co-host user IDs from the request path
+func (h *EventHandler) parseEventCoHostPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+
+	eventID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(vars["userId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return eventID, userID, true
+}
+
+// getAuthenticatedUserID extracts the authenticated user's ID, writing an error response if missing
+func (h *EventHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// writeEventCoHostError maps co-host use case errors to HTTP responses
+func (h *EventHandler) writeEventCoHostError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrEventNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "event_not_found", "Event not found")
+	case usecase.ErrCoHostNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "cohost_not_found", "Co-host invitation not found")
+	case usecase.ErrUserNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
+	case usecase.ErrUnauthorizedAccess:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only the event host can manage co-hosts")
+	case usecase.ErrAlreadyCoHost, usecase.ErrTooManyCoHosts, domain.ErrCoHostInvitationNotPending:
+		h.writeErrorResponse(w, http.StatusConflict, "cohost_conflict", err.Error())
+	case usecase.ErrCoHostIsHost, domain.ErrEmptyCoHostPermissions, domain.ErrInvalidCoHostPermission,
+		domain.ErrDuplicateCoHostPermission:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// convertToEventCoHostResponses converts domain co-hosts to response format
+func (h *EventHandler) convertToEventCoHostResponses(coHosts []*domain.EventCoHost) []EventCoHostResponse {
+	responses := make([]EventCoHostResponse, len(coHosts))
+	for i, coHost := range coHosts {
+		responses[i] = *h.convertToEventCoHostResponse(coHost)
+	}
+	return responses
+}
+
+// convertToEventCoHostResponse converts a domain co-host to response format
+func (h *EventHandler) convertToEventCoHostResponse(coHost *domain.EventCoHost) *EventCoHostResponse {
+	response := &EventCoHostResponse{
+		EventID:     coHost.EventID.String(),
+		UserID:      coHost.UserID.String(),
+		InvitedBy:   coHost.InvitedBy.String(),
+		Permissions: permissionsToStrings(coHost.Permissions),
+		Status:      string(coHost.Status),
+		CreatedAt:   coHost.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if coHost.RespondedAt != nil {
+		respondedAt := coHost.RespondedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.RespondedAt = &respondedAt
+	}
+
+	return response
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -38,6 +39,8 @@ type CreateEventRequest struct {
 	GroupID     *string   `json:"group_id,omitempty" validate:"omitempty,uuid"`
 	VenueID     *string   `json:"venue_id,omitempty" validate:"omitempty,uuid"`
 	Address     string    `json:"address,omitempty" validate:"max=500"`
+
+	AllowOutsideVenueHours bool `json:"allow_outside_venue_hours,omitempty"` // Create with a warning instead of rejecting
 }
 
 // UpdateEventRequest represents the event update request payload
@@ -72,33 +75,37 @@ type EventSearchRequest struct {
 
 // RSVPRequest represents the RSVP request payload
 type RSVPRequest struct {
-	Status string `json:"status" validate:"required,rsvp_status"`
+	Status      string `json:"status" validate:"required,rsvp_status"`
+	InviteToken string `json:"invite_token,omitempty"` // Invite link token for private events
 }
 
 // EventResponse represents the event response
 type EventResponse struct {
-	ID            string        `json:"id"`
-	Title         string        `json:"title"`
-	Description   string        `json:"description"`
-	Game          string        `json:"game"`
-	Format        string        `json:"format"`
-	Rules         string        `json:"rules"`
-	Visibility    string        `json:"visibility"`
-	Capacity      *int          `json:"capacity"`
-	AttendeeCount int           `json:"attendee_count"`
-	StartAt       string        `json:"start_at"`
-	EndAt         string        `json:"end_at"`
-	Timezone      string        `json:"timezone"`
-	Tags          []string      `json:"tags"`
-	EntryFee      *float64      `json:"entry_fee"`
-	Language      string        `json:"language"`
-	Host          *UserInfo     `json:"host"`
-	Group         *GroupInfo    `json:"group,omitempty"`
-	Venue         *VenueInfo    `json:"venue,omitempty"`
-	Location      *LocationInfo `json:"location,omitempty"`
-	RSVPStatus    string        `json:"rsvp_status,omitempty"`
-	CreatedAt     string        `json:"created_at"`
-	UpdatedAt     string        `json:"updated_at"`
+	ID            string                `json:"id"`
+	Title         string                `json:"title"`
+	Description   string                `json:"description"`
+	Game          string                `json:"game"`
+	Format        string                `json:"format"`
+	Rules         string                `json:"rules"`
+	Visibility    string                `json:"visibility"`
+	Capacity      *int                  `json:"capacity"`
+	AttendeeCount int                   `json:"attendee_count"`
+	StartAt       string                `json:"start_at"`
+	EndAt         string                `json:"end_at"`
+	Timezone      string                `json:"timezone"`
+	Tags          []string              `json:"tags"`
+	EntryFee      *float64              `json:"entry_fee"`
+	Language      string                `json:"language"`
+	Host          *UserInfo             `json:"host"`
+	CoHosts       []EventCoHostResponse `json:"co_hosts,omitempty"`
+	Group         *GroupInfo            `json:"group,omitempty"`
+	Venue         *VenueInfo            `json:"venue,omitempty"`
+	Location      *LocationInfo         `json:"location,omitempty"`
+	RSVPStatus    string                `json:"rsvp_status,omitempty"`
+	IsOfficial    bool                  `json:"is_official"` // Marked by the verified owner of the venue
+	CreatedAt     string                `json:"created_at"`
+	UpdatedAt     string                `json:"updated_at"`
+	Warnings      []string              `json:"warnings,omitempty"`
 }
 
 // VenueInfo represents venue information
@@ -110,6 +117,7 @@ type VenueInfo struct {
 	City        string       `json:"city"`
 	Country     string       `json:"country"`
 	Coordinates *Coordinates `json:"coordinates,omitempty"`
+	Verified    bool         `json:"verified"`
 }
 
 // LocationInfo represents location information for events without venues
@@ -128,9 +136,10 @@ type EventListResponse struct {
 
 // AttendeeResponse represents an event attendee
 type AttendeeResponse struct {
-	User   UserInfo `json:"user"`
-	Status string   `json:"status"`
-	RSVPAT string   `json:"rsvp_at"`
+	User        UserInfo `json:"user"`
+	Status      string   `json:"status"`
+	RSVPAT      string   `json:"rsvp_at"`
+	CheckedInAt *string  `json:"checked_in_at,omitempty"`
 }
 
 // AttendeesResponse represents the list of event attendees
@@ -281,6 +290,8 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 		GroupID:     groupID,
 		VenueID:     venueID,
 		Address:     &req.Address,
+
+		AllowOutsideVenueHours: req.AllowOutsideVenueHours,
 	}
 
 	// Convert rules string to map if provided
@@ -294,7 +305,12 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 	result, err := h.eventManagementUseCase.CreateEvent(r.Context(), createReq, userUUID)
 	if err != nil {
 		// Handle specific errors
-		h.writeErrorResponse(w, http.StatusInternalServerError, "event_creation_failed", "Failed to create event")
+		switch err {
+		case usecase.ErrEventOutsideVenueHours:
+			h.writeErrorResponse(w, http.StatusUnprocessableEntity, "outside_venue_hours", "Event falls outside the venue's opening hours; set allow_outside_venue_hours to create it anyway")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "event_creation_failed", "Failed to create event")
+		}
 		return
 	}
 
@@ -425,8 +441,8 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 		switch err {
 		case usecase.ErrEventNotFound:
 			h.writeErrorResponse(w, http.StatusNotFound, "event_not_found", "Event not found")
-		case usecase.ErrUnauthorized:
-			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only event host can update this event")
+		case usecase.ErrUnauthorized, usecase.ErrUnauthorizedAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only event organizers can update this event")
 		default:
 			h.writeErrorResponse(w, http.StatusInternalServerError, "event_update_failed", "Failed to update event")
 		}
@@ -494,9 +510,6 @@ func (h *EventHandler) DeleteEvent(w http.ResponseWriter, r *http.Request) {
 
 // SearchEvents handles GET /events
 func (h *EventHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
-	// Parse query parameters
-	query := r.URL.Query()
-
 	// Get requesting user ID (optional)
 	var requestingUserID *uuid.UUID
 	if userID, ok := middleware.GetUserID(r); ok {
@@ -505,18 +518,47 @@ func (h *EventHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Parse search parameters
-	searchReq := &usecase.SearchEventsRequest{
-		UserID: uuid.Nil, // Default to nil UUID for anonymous access
-		Limit:  20,       // default
-		Offset: 0,        // default
-	}
+	searchReq := parseSearchEventsRequest(r.URL.Query())
 
 	// Set user ID if authenticated
 	if requestingUserID != nil {
 		searchReq.UserID = *requestingUserID
 	}
 
+	// Execute search
+	result, err := h.eventManagementUseCase.SearchEvents(r.Context(), searchReq)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusInternalServerError, "search_failed", "Failed to search events")
+		return
+	}
+
+	// Convert to response format
+	events := make([]EventResponse, len(result.Events))
+	for i, eventResult := range result.Events {
+		events[i] = *h.convertToEventResponse(eventResult.Event, requestingUserID)
+	}
+
+	response := EventListResponse{
+		Events: events,
+		Total:  result.TotalCount,
+		Limit:  searchReq.Limit,
+		Offset: searchReq.Offset,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseSearchEventsRequest parses the event search filters shared by event search and exports; the
+// search is anonymous until the caller sets a user
+func parseSearchEventsRequest(query url.Values) *usecase.SearchEventsRequest {
+	searchReq := &usecase.SearchEventsRequest{
+		UserID: uuid.Nil, // Default to nil UUID for anonymous access
+		Limit:  20,       // default
+		Offset: 0,        // default
+	}
+
 	// Parse location parameters
 	if nearStr := query.Get("near"); nearStr != "" {
 		// Parse "lat,lon" format
@@ -590,29 +632,7 @@ func (h *EventHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Execute search
-	result, err := h.eventManagementUseCase.SearchEvents(r.Context(), searchReq)
-	if err != nil {
-		h.writeErrorResponse(w, http.StatusInternalServerError, "search_failed", "Failed to search events")
-		return
-	}
-
-	// Convert to response format
-	events := make([]EventResponse, len(result.Events))
-	for i, eventResult := range result.Events {
-		events[i] = *h.convertToEventResponse(eventResult.Event, requestingUserID)
-	}
-
-	response := EventListResponse{
-		Events: events,
-		Total:  result.TotalCount,
-		Limit:  searchReq.Limit,
-		Offset: searchReq.Offset,
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(response)
+	return searchReq
 }
 
 // RSVPToEvent handles POST /events/{id}/rsvp
@@ -652,9 +672,10 @@ func (h *EventHandler) RSVPToEvent(w http.ResponseWriter, r *http.Request) {
 
 	// Create RSVP request
 	rsvpReq := &usecase.RSVPToEventRequest{
-		EventID: eventID,
-		UserID:  userUUID,
-		Status:  stringToRSVPStatus(req.Status),
+		EventID:     eventID,
+		UserID:      userUUID,
+		Status:      stringToRSVPStatus(req.Status),
+		InviteToken: req.InviteToken,
 	}
 
 	// Execute RSVP
@@ -663,8 +684,18 @@ func (h *EventHandler) RSVPToEvent(w http.ResponseWriter, r *http.Request) {
 		switch err {
 		case usecase.ErrEventNotFound:
 			h.writeErrorResponse(w, http.StatusNotFound, "event_not_found", "Event not found")
-		case usecase.ErrUnauthorized:
+		case usecase.ErrUnauthorized, usecase.ErrUnauthorizedAccess:
 			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Access denied to this event")
+		case usecase.ErrUserBannedFromGroup:
+			h.writeErrorResponse(w, http.StatusForbidden, "banned_from_group", "You are banned from this event's group")
+		case usecase.ErrInvalidInviteToken:
+			h.writeErrorResponse(w, http.StatusNotFound, "invalid_invite_token", "Invalid invite token")
+		case usecase.ErrInviteExpired:
+			h.writeErrorResponse(w, http.StatusGone, "invite_expired", "Invite has expired")
+		case usecase.ErrInviteNoLongerValid:
+			h.writeErrorResponse(w, http.StatusGone, "invite_no_longer_valid", "Invite is no longer valid")
+		case usecase.ErrEventCancelled:
+			h.writeErrorResponse(w, http.StatusConflict, "event_cancelled", "Event has been cancelled")
 		default:
 			h.writeErrorResponse(w, http.StatusInternalServerError, "rsvp_failed", "Failed to RSVP to event")
 		}
@@ -742,6 +773,10 @@ func (h *EventHandler) GetEventAttendees(w http.ResponseWriter, r *http.Request)
 			Status: string(rsvp.Status), // Convert RSVPStatus to string
 			RSVPAT: rsvp.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
+		if rsvp.CheckedInAt != nil {
+			checkedInAt := rsvp.CheckedInAt.Format("2006-01-02T15:04:05Z07:00")
+			attendees[i].CheckedInAt = &checkedInAt
+		}
 	}
 
 	response := AttendeesResponse{
@@ -768,8 +803,10 @@ func (h *EventHandler) convertToEventResponse(event *domain.EventWithDetails, re
 		Tags:       event.Tags,
 		EntryFee:   event.EntryFee,
 		Language:   event.Language,
+		IsOfficial: event.IsOfficial,
 		CreatedAt:  event.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:  event.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Warnings:   event.Warnings,
 	}
 
 	// Handle optional string fields safely
@@ -805,6 +842,11 @@ func (h *EventHandler) convertToEventResponse(event *domain.EventWithDetails, re
 		}
 	}
 
+	// Add accepted co-hosts
+	for i := range event.CoHosts {
+		response.CoHosts = append(response.CoHosts, *h.convertToEventCoHostResponse(&event.CoHosts[i]))
+	}
+
 	// Add group information
 	if event.Group != nil {
 		response.Group = &GroupInfo{
@@ -816,12 +858,13 @@ func (h *EventHandler) convertToEventResponse(event *domain.EventWithDetails, re
 	// Add venue information
 	if event.Venue != nil {
 		response.Venue = &VenueInfo{
-			ID:      event.Venue.ID.String(),
-			Name:    event.Venue.Name,
-			Type:    string(event.Venue.Type), // Convert VenueType to string
-			Address: event.Venue.Address,
-			City:    event.Venue.City,
-			Country: event.Venue.Country,
+			ID:       event.Venue.ID.String(),
+			Name:     event.Venue.Name,
+			Type:     string(event.Venue.Type), // Convert VenueType to string
+			Address:  event.Venue.Address,
+			City:     event.Venue.City,
+			Country:  event.Venue.Country,
+			Verified: event.Venue.IsVerified(),
 		}
 
 		// Add coordinates (always available in domain.Venue)
@@ -859,6 +902,22 @@ func (h *EventHandler) RegisterRoutes(router *mux.Router, authMiddleware *middle
 	protected.HandleFunc("/events/{id}", h.UpdateEvent).Methods("PUT")
 	protected.HandleFunc("/events/{id}", h.DeleteEvent).Methods("DELETE")
 	protected.HandleFunc("/events/{id}/rsvp", h.RSVPToEvent).Methods("POST")
+	protected.HandleFunc("/events/{id}/attendees/{userId}/check-in", h.CheckInAttendee).Methods("POST")
+	protected.HandleFunc("/events/{id}/attendees/{userId}/check-in", h.UndoAttendeeCheckIn).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/cohosts", h.ListEventCoHosts).Methods("GET")
+	protected.HandleFunc("/events/{id}/cohosts", h.InviteEventCoHost).Methods("POST")
+	protected.HandleFunc("/events/{id}/cohosts/accept", h.AcceptEventCoHost).Methods("POST")
+	protected.HandleFunc("/events/{id}/cohosts/decline", h.DeclineEventCoHost).Methods("POST")
+	protected.HandleFunc("/events/{id}/cohosts/{userId}", h.UpdateEventCoHost).Methods("PUT")
+	protected.HandleFunc("/events/{id}/cohosts/{userId}", h.RemoveEventCoHost).Methods("DELETE")
+	protected.HandleFunc("/me/cohost-invitations", h.GetUserCoHostInvitations).Methods("GET")
+	protected.HandleFunc("/events/{id}/guests", h.ListEventGuests).Methods("GET")
+	protected.HandleFunc("/events/{id}/guests", h.AddEventGuest).Methods("POST")
+	protected.HandleFunc("/events/{id}/guests/{userId}", h.RemoveEventGuest).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/invite-links", h.ListEventInviteLinks).Methods("GET")
+	protected.HandleFunc("/events/{id}/invite-links", h.CreateEventInviteLink).Methods("POST")
+	protected.HandleFunc("/events/{id}/invite-links/redeem", h.RedeemEventInviteLink).Methods("POST")
+	protected.HandleFunc("/events/{id}/invite-links/{linkId}", h.RevokeEventInviteLink).Methods("DELETE")
 
 	// Public routes (optional authentication for personalization)
 	public := router.PathPrefix("").Subrouter()
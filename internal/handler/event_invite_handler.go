@@ -0,0 +1,376 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// AddEventGuestRequest represents the request payload for adding a user to a guest list
+type AddEventGuestRequest struct {
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
+
+// EventGuestResponse represents a guest of a private event
+type EventGuestResponse struct {
+	EventID      string `json:"event_id"`
+	UserID       string `json:"user_id"`
+	InvitedBy    string `json:"invited_by"`
+	InviteLinkID string `json:"invite_link_id,omitempty"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// EventInviteLinkResponse represents an event invite link
+type EventInviteLinkResponse struct {
+	ID        string  `json:"id"`
+	EventID   string  `json:"event_id"`
+	CreatedBy string  `json:"created_by"`
+	MaxUses   *int    `json:"max_uses,omitempty"`
+	UseCount  int     `json:"use_count"`
+	ExpiresAt string  `json:"expires_at"`
+	RevokedAt *string `json:"revoked_at,omitempty"`
+	Active    bool    `json:"active"`
+	CreatedAt string  `json:"created_at"`
+}
+
+// ListEventGuests handles GET /events/{id}/guests
+func (h *EventHandler) ListEventGuests(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.eventManagementUseCase.ListEventGuests(r.Context(), &usecase.ListEventGuestsRequest{
+		EventID:     eventID,
+		RequesterID: userUUID,
+	})
+	if err != nil {
+		h.writeEventGuestListError(w, err, "guests_fetch_failed", "Failed to fetch guest list")
+		return
+	}
+
+	guests := make([]EventGuestResponse, len(result.Guests))
+	for i, guest := range result.Guests {
+		guests[i] = *h.convertToEventGuestResponse(guest)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"guests": guests,
+		"total":  len(guests),
+	})
+}
+
+// AddEventGuest handles POST /events/{id}/guests
+func (h *EventHandler) AddEventGuest(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AddEventGuestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	guestID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	result, err := h.eventManagementUseCase.AddEventGuest(r.Context(), &usecase.AddEventGuestRequest{
+		EventID:   eventID,
+		UserID:    guestID,
+		InviterID: userUUID,
+	})
+	if err != nil {
+		h.writeEventGuestListError(w, err, "guest_add_failed", "Failed to add guest")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToEventGuestResponse(result))
+}
+
+// RemoveEventGuest handles DELETE /events/{id}/guests/{userId}
+func (h *EventHandler) RemoveEventGuest(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	eventID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	guestID, err := uuid.Parse(vars["userId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.eventManagementUseCase.RemoveEventGuest(r.Context(), &usecase.RemoveEventGuestRequest{
+		EventID:   eventID,
+		UserID:    guestID,
+		RemoverID: userUUID,
+	})
+	if err != nil {
+		h.writeEventGuestListError(w, err, "guest_removal_failed", "Failed to remove guest")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Guest removed successfully",
+	})
+}
+
+// ListEventInviteLinks handles GET /events/{id}/invite-links
+func (h *EventHandler) ListEventInviteLinks(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.eventManagementUseCase.ListEventInviteLinks(r.Context(), &usecase.ListEventInviteLinksRequest{
+		EventID: eventID,
+		UserID:  userUUID,
+	})
+	if err != nil {
+		h.writeEventGuestListError(w, err, "invite_links_fetch_failed", "Failed to fetch invite links")
+		return
+	}
+
+	now := time.Now()
+	links := make([]EventInviteLinkResponse, len(result.Links))
+	for i, link := range result.Links {
+		links[i] = *h.convertToEventInviteLinkResponse(link, now)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"links": links,
+		"total": len(links),
+	})
+}
+
+// CreateEventInviteLink handles POST /events/{id}/invite-links
+func (h *EventHandler) CreateEventInviteLink(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateInviteLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	linkReq := &usecase.CreateEventInviteLinkRequest{
+		EventID: eventID,
+		UserID:  userUUID,
+		MaxUses: req.MaxUses,
+	}
+	if req.ExpiresInHours != nil {
+		expiresIn := time.Duration(*req.ExpiresInHours) * time.Hour
+		linkReq.ExpiresIn = &expiresIn
+	}
+
+	result, err := h.eventManagementUseCase.CreateEventInviteLink(r.Context(), linkReq)
+	if err != nil {
+		h.writeEventGuestListError(w, err, "invite_link_failed", "Failed to create invite link")
+		return
+	}
+
+	// The token is only returned here; afterwards only its hash is known
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"link":  h.convertToEventInviteLinkResponse(result.Link, time.Now()),
+		"token": result.Token,
+	})
+}
+
+// RevokeEventInviteLink handles DELETE /events/{id}/invite-links/{linkId}
+func (h *EventHandler) RevokeEventInviteLink(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	eventID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	linkID, err := uuid.Parse(vars["linkId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_link_id", "Invalid invite link ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.eventManagementUseCase.RevokeEventInviteLink(r.Context(), &usecase.RevokeEventInviteLinkRequest{
+		EventID: eventID,
+		LinkID:  linkID,
+		UserID:  userUUID,
+	})
+	if err != nil {
+		h.writeEventGuestListError(w, err, "invite_link_revoke_failed", "Failed to revoke invite link")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Invite link revoked successfully",
+	})
+}
+
+// RedeemEventInviteLink handles POST /events/{id}/invite-links/redeem
+func (h *EventHandler) RedeemEventInviteLink(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AcceptInviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	if req.Token == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "Token is required")
+		return
+	}
+
+	result, err := h.eventManagementUseCase.RedeemEventInviteLink(r.Context(), &usecase.RedeemEventInviteLinkRequest{
+		EventID: eventID,
+		Token:   req.Token,
+		UserID:  userUUID,
+	})
+	if err != nil {
+		h.writeEventGuestListError(w, err, "invite_redeem_failed", "Failed to redeem invite link")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToEventGuestResponse(result))
+}
+
+// writeEventGuestListError maps guest list and invite link use case errors to HTTP responses
+func (h *EventHandler) writeEventGuestListError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrEventNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "event_not_found", "Event not found")
+	case usecase.ErrEventGuestNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "guest_not_found", "Guest not found")
+	case usecase.ErrEventInviteLinkNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "invite_link_not_found", "Invite link not found")
+	case usecase.ErrUserNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
+	case usecase.ErrInvalidInviteToken:
+		h.writeErrorResponse(w, http.StatusNotFound, "invalid_invite_token", "Invalid invite token")
+	case usecase.ErrUnauthorizedAccess:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only event organizers who manage attendees can manage the guest list")
+	case usecase.ErrInviteExpired:
+		h.writeErrorResponse(w, http.StatusGone, "invite_expired", "Invite has expired")
+	case usecase.ErrInviteNoLongerValid:
+		h.writeErrorResponse(w, http.StatusGone, "invite_no_longer_valid", "Invite is no longer valid")
+	case usecase.ErrAlreadyEventGuest:
+		h.writeErrorResponse(w, http.StatusConflict, "already_guest", err.Error())
+	case usecase.ErrEventOrganizerNotGuest, domain.ErrGuestListNotSupported,
+		domain.ErrInvalidInviteMaxUses, domain.ErrInvalidInviteExpiry:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// convertToEventGuestResponse converts a domain guest to response format
+func (h *EventHandler) convertToEventGuestResponse(guest *domain.EventGuest) *EventGuestResponse {
+	response := &EventGuestResponse{
+		EventID:   guest.EventID.String(),
+		UserID:    guest.UserID.String(),
+		InvitedBy: guest.InvitedBy.String(),
+		CreatedAt: guest.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if guest.InviteLinkID != nil {
+		response.InviteLinkID = guest.InviteLinkID.String()
+	}
+
+	return response
+}
+
+// convertToEventInviteLinkResponse converts a domain invite link to response format
+func (h *EventHandler) convertToEventInviteLinkResponse(link *domain.EventInviteLink, now time.Time) *EventInviteLinkResponse {
+	response := &EventInviteLinkResponse{
+		ID:        link.ID.String(),
+		EventID:   link.EventID.String(),
+		CreatedBy: link.CreatedBy.String(),
+		MaxUses:   link.MaxUses,
+		UseCount:  link.UseCount,
+		ExpiresAt: link.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		Active:    link.CanBeRedeemed(now),
+		CreatedAt: link.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if link.RevokedAt != nil {
+		revokedAt := link.RevokedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.RevokedAt = &revokedAt
+	}
+
+	return response
+}
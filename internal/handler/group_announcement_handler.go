@@ -0,0 +1,311 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// CreateAnnouncementRequest represents the announcement creation request payload
+type CreateAnnouncementRequest struct {
+	Title         string     `json:"title" validate:"required,max=200"`
+	Body          string     `json:"body" validate:"required,max=10000"`
+	Pinned        bool       `json:"pinned"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	NotifyMembers bool       `json:"notify_members"`
+}
+
+// UpdateAnnouncementRequest represents the announcement update request payload
+type UpdateAnnouncementRequest struct {
+	Title       *string    `json:"title,omitempty" validate:"omitempty,max=200"`
+	Body        *string    `json:"body,omitempty" validate:"omitempty,max=10000"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	ClearExpiry bool       `json:"clear_expiry"`
+}
+
+// AnnouncementResponse represents a group announcement
+type AnnouncementResponse struct {
+	ID           string  `json:"id"`
+	GroupID      string  `json:"group_id"`
+	AuthorUserID string  `json:"author_user_id"`
+	Title        string  `json:"title"`
+	Body         string  `json:"body"`
+	IsPinned     bool    `json:"is_pinned"`
+	PinnedAt     *string `json:"pinned_at,omitempty"`
+	ExpiresAt    *string `json:"expires_at,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+// AnnouncementListResponse represents a page of a group's announcement board
+type AnnouncementListResponse struct {
+	Announcements []AnnouncementResponse `json:"announcements"`
+	Total         int                    `json:"total"`
+	Limit         int                    `json:"limit"`
+	Offset        int                    `json:"offset"`
+}
+
+// ListAnnouncements handles GET /groups/{id}/announcements
+func (h *GroupHandler) ListAnnouncements(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	limit := 0
+	offset := 0
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	result, err := h.groupManagementUseCase.ListAnnouncements(r.Context(), &usecase.ListAnnouncementsRequest{
+		GroupID:        groupID,
+		RequesterID:    userUUID,
+		IncludeExpired: query.Get("include_expired") == "true",
+		Limit:          limit,
+		Offset:         offset,
+	})
+	if err != nil {
+		h.writeAnnouncementError(w, err, "announcements_fetch_failed", "Failed to fetch announcements")
+		return
+	}
+
+	announcements := make([]AnnouncementResponse, len(result.Announcements))
+	for i, announcement := range result.Announcements {
+		announcements[i] = *h.convertToAnnouncementResponse(announcement)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(AnnouncementListResponse{
+		Announcements: announcements,
+		Total:         len(announcements),
+		Limit:         result.Limit,
+		Offset:        result.Offset,
+	})
+}
+
+// CreateAnnouncement handles POST /groups/{id}/announcements
+func (h *GroupHandler) CreateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateAnnouncementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	result, err := h.groupManagementUseCase.CreateAnnouncement(r.Context(), &usecase.CreateAnnouncementRequest{
+		GroupID:       groupID,
+		UserID:        userUUID,
+		Title:         req.Title,
+		Body:          req.Body,
+		Pinned:        req.Pinned,
+		ExpiresAt:     req.ExpiresAt,
+		NotifyMembers: req.NotifyMembers,
+	})
+	if err != nil {
+		h.writeAnnouncementError(w, err, "announcement_creation_failed", "Failed to create announcement")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToAnnouncementResponse(result))
+}
+
+// UpdateAnnouncement handles PUT /groups/{id}/announcements/{announcementId}
+func (h *GroupHandler) UpdateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	groupID, announcementID, ok := h.parseAnnouncementPath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateAnnouncementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	result, err := h.groupManagementUseCase.UpdateAnnouncement(r.Context(), &usecase.UpdateAnnouncementRequest{
+		GroupID:        groupID,
+		AnnouncementID: announcementID,
+		UserID:         userUUID,
+		Title:          req.Title,
+		Body:           req.Body,
+		ExpiresAt:      req.ExpiresAt,
+		ClearExpiry:    req.ClearExpiry,
+	})
+	if err != nil {
+		h.writeAnnouncementError(w, err, "announcement_update_failed", "Failed to update announcement")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToAnnouncementResponse(result))
+}
+
+// DeleteAnnouncement handles DELETE /groups/{id}/announcements/{announcementId}
+func (h *GroupHandler) DeleteAnnouncement(w http.ResponseWriter, r *http.Request) {
+	groupID, announcementID, ok := h.parseAnnouncementPath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.groupManagementUseCase.DeleteAnnouncement(r.Context(), &usecase.DeleteAnnouncementRequest{
+		GroupID:        groupID,
+		AnnouncementID: announcementID,
+		UserID:         userUUID,
+	})
+	if err != nil {
+		h.writeAnnouncementError(w, err, "announcement_deletion_failed", "Failed to delete announcement")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Announcement deleted successfully",
+	})
+}
+
+// PinAnnouncement handles POST /groups/{id}/announcements/{announcementId}/pin
+func (h *GroupHandler) PinAnnouncement(w http.ResponseWriter, r *http.Request) {
+	h.setAnnouncementPinned(w, r, true)
+}
+
+// UnpinAnnouncement handles POST /groups/{id}/announcements/{announcementId}/unpin
+func (h *GroupHandler) UnpinAnnouncement(w http.ResponseWriter, r *http.Request) {
+	h.setAnnouncementPinned(w, r, false)
+}
+
+// setAnnouncementPinned pins or unpins the announcement in the request path
+func (h *GroupHandler) setAnnouncementPinned(w http.ResponseWriter, r *http.Request, pinned bool) {
+	groupID, announcementID, ok := h.parseAnnouncementPath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.SetAnnouncementPinned(r.Context(), &usecase.SetAnnouncementPinnedRequest{
+		GroupID:        groupID,
+		AnnouncementID: announcementID,
+		UserID:         userUUID,
+		Pinned:         pinned,
+	})
+	if err != nil {
+		h.writeAnnouncementError(w, err, "announcement_pin_failed", "Failed to update announcement pin")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToAnnouncementResponse(result))
+}
+
+// parseAnnouncementPath parses the group and announcement IDs from the request path
+func (h *GroupHandler) parseAnnouncementPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	announcementID, err := uuid.Parse(vars["announcementId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_announcement_id", "Invalid announcement ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return groupID, announcementID, true
+}
+
+// writeAnnouncementError maps announcement use case errors to HTTP responses
+func (h *GroupHandler) writeAnnouncementError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrGroupNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+	case usecase.ErrAnnouncementNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "announcement_not_found", "Announcement not found")
+	case usecase.ErrUnauthorizedGroupAccess:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Access denied to group announcements")
+	case domain.ErrEmptyAnnouncementTitle, domain.ErrAnnouncementTitleTooLong,
+		domain.ErrEmptyAnnouncementBody, domain.ErrAnnouncementBodyTooLong,
+		domain.ErrInvalidAnnouncementExpiry:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// convertToAnnouncementResponse converts a domain announcement to response format
+func (h *GroupHandler) convertToAnnouncementResponse(announcement *domain.GroupAnnouncement) *AnnouncementResponse {
+	response := &AnnouncementResponse{
+		ID:           announcement.ID.String(),
+		GroupID:      announcement.GroupID.String(),
+		AuthorUserID: announcement.AuthorUserID.String(),
+		Title:        announcement.Title,
+		Body:         announcement.Body,
+		IsPinned:     announcement.IsPinned,
+		CreatedAt:    announcement.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    announcement.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if announcement.PinnedAt != nil {
+		pinnedAt := announcement.PinnedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.PinnedAt = &pinnedAt
+	}
+	if announcement.ExpiresAt != nil {
+		expiresAt := announcement.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+		response.ExpiresAt = &expiresAt
+	}
+
+	return response
+}
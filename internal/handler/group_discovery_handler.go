@@ -0,0 +1,359 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// JoinGroupRequest represents the join request payload
+type JoinGroupRequest struct {
+	Message *string `json:"message,omitempty" validate:"omitempty,max=500"`
+}
+
+// GroupJoinRequestResponse represents a request to join a group
+type GroupJoinRequestResponse struct {
+	ID         string  `json:"id"`
+	GroupID    string  `json:"group_id"`
+	UserID     string  `json:"user_id"`
+	Message    *string `json:"message,omitempty"`
+	Status     string  `json:"status"`
+	ReviewedBy string  `json:"reviewed_by,omitempty"`
+	ReviewedAt string  `json:"reviewed_at,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+// GroupJoinRequestListResponse represents a list of join requests
+type GroupJoinRequestListResponse struct {
+	Requests []GroupJoinRequestResponse `json:"requests"`
+	Total    int                        `json:"total"`
+}
+
+// SearchGroups handles GET /groups
+func (h *GroupHandler) SearchGroups(w http.ResponseWriter, r *http.Request) {
+	if _, ok := h.getAuthenticatedUserID(w, r); !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	searchReq := &usecase.SearchGroupsRequest{
+		Limit:  usecase.DefaultGroupSearchLimit,
+		Offset: 0,
+	}
+
+	if q := strings.TrimSpace(query.Get("q")); q != "" {
+		searchReq.Query = &q
+	}
+
+	// Parse "lat,lon" format
+	if nearStr := query.Get("near"); nearStr != "" {
+		parts := strings.Split(nearStr, ",")
+		if len(parts) == 2 {
+			if lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64); err == nil {
+				if lon, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err == nil {
+					searchReq.Near = &domain.Coordinates{
+						Latitude:  lat,
+						Longitude: lon,
+					}
+				}
+			}
+		}
+		if searchReq.Near == nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "near must be in lat,lon format")
+			return
+		}
+	}
+
+	if radiusStr := query.Get("radius_km"); radiusStr != "" {
+		radius, err := strconv.Atoi(radiusStr)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "radius_km must be a number")
+			return
+		}
+		searchReq.RadiusKm = &radius
+	}
+
+	if gameStr := query.Get("game"); gameStr != "" {
+		game := domain.GameType(gameStr)
+		searchReq.Game = &game
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= usecase.MaxGroupSearchLimit {
+			searchReq.Limit = parsedLimit
+		}
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			searchReq.Offset = parsedOffset
+		}
+	}
+
+	result, err := h.groupManagementUseCase.SearchGroups(r.Context(), searchReq)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidGroupSearchRadius, usecase.ErrGroupSearchRadiusNeedsNear,
+			domain.ErrInvalidCoordinates, domain.ErrInvalidGameType:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "search_failed", "Failed to search groups")
+		}
+		return
+	}
+
+	groups := make([]GroupResponse, len(result.Groups))
+	for i, group := range result.Groups {
+		groups[i] = *h.convertToGroupResponse(group, nil, false)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GroupListResponse{
+		Groups: groups,
+		Total:  len(groups),
+	})
+}
+
+// RequestToJoinGroup handles POST /groups/{id}/join-requests
+func (h *GroupHandler) RequestToJoinGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	// The message is optional, so an empty body is accepted
+	var req JoinGroupRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+			return
+		}
+	}
+
+	result, err := h.groupManagementUseCase.RequestToJoinGroup(r.Context(), &usecase.RequestToJoinGroupRequest{
+		GroupID: groupID,
+		UserID:  userUUID,
+		Message: req.Message,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrGroupNotPublic:
+			h.writeErrorResponse(w, http.StatusForbidden, "group_not_public", "This group only accepts members by invitation")
+		case usecase.ErrAlreadyGroupMember:
+			h.writeErrorResponse(w, http.StatusConflict, "already_member", "You are already a member of this group")
+		case usecase.ErrUserBannedFromGroup:
+			h.writeErrorResponse(w, http.StatusForbidden, "banned_from_group", "You are banned from this group")
+		case usecase.ErrJoinRequestAlreadyPending:
+			h.writeErrorResponse(w, http.StatusConflict, "join_request_pending", "You already have a pending request for this group")
+		case domain.ErrJoinRequestMessageTooLong:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "join_request_failed", "Failed to request to join group")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToJoinRequestResponse(result))
+}
+
+// ListJoinRequests handles GET /groups/{id}/join-requests
+func (h *GroupHandler) ListJoinRequests(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.ListJoinRequests(r.Context(), &usecase.ListJoinRequestsRequest{
+		GroupID: groupID,
+		UserID:  userUUID,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can view join requests")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "join_requests_fetch_failed", "Failed to fetch join requests")
+		}
+		return
+	}
+
+	h.writeJoinRequestList(w, result.Requests)
+}
+
+// ApproveJoinRequest handles POST /groups/{id}/join-requests/{requestId}/approve
+func (h *GroupHandler) ApproveJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewJoinRequest(w, r, true)
+}
+
+// RejectJoinRequest handles POST /groups/{id}/join-requests/{requestId}/reject
+func (h *GroupHandler) RejectJoinRequest(w http.ResponseWriter, r *http.Request) {
+	h.reviewJoinRequest(w, r, false)
+}
+
+// reviewJoinRequest executes an approve or reject request and writes the response
+func (h *GroupHandler) reviewJoinRequest(w http.ResponseWriter, r *http.Request, approve bool) {
+	vars := mux.Vars(r)
+
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	requestID, err := uuid.Parse(vars["requestId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request_id", "Invalid join request ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.ReviewJoinRequest(r.Context(), &usecase.ReviewJoinRequestRequest{
+		GroupID:   groupID,
+		RequestID: requestID,
+		UserID:    userUUID,
+		Approve:   approve,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrJoinRequestNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "join_request_not_found", "Join request not found")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can review join requests")
+		case usecase.ErrJoinRequestNotPending:
+			h.writeErrorResponse(w, http.StatusConflict, "join_request_not_pending", "Join request has already been reviewed")
+		case usecase.ErrAlreadyGroupMember:
+			h.writeErrorResponse(w, http.StatusConflict, "already_member", "User is already a member of this group")
+		case usecase.ErrUserBannedFromGroup:
+			h.writeErrorResponse(w, http.StatusConflict, "user_banned", "User is banned from this group")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "join_request_review_failed", "Failed to review join request")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToJoinRequestResponse(result))
+}
+
+// CancelJoinRequest handles DELETE /join-requests/{requestId}
+func (h *GroupHandler) CancelJoinRequest(w http.ResponseWriter, r *http.Request) {
+	requestID, err := uuid.Parse(mux.Vars(r)["requestId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request_id", "Invalid join request ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.groupManagementUseCase.CancelJoinRequest(r.Context(), &usecase.CancelJoinRequestRequest{
+		RequestID: requestID,
+		UserID:    userUUID,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrJoinRequestNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "join_request_not_found", "Join request not found")
+		case usecase.ErrJoinRequestNotPending:
+			h.writeErrorResponse(w, http.StatusConflict, "join_request_not_pending", "Join request has already been reviewed")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "join_request_cancel_failed", "Failed to cancel join request")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Join request cancelled",
+	})
+}
+
+// GetUserJoinRequests handles GET /me/join-requests
+func (h *GroupHandler) GetUserJoinRequests(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.ListUserJoinRequests(r.Context(), &usecase.ListUserJoinRequestsRequest{
+		UserID: userUUID,
+	})
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusInternalServerError, "join_requests_fetch_failed", "Failed to fetch join requests")
+		return
+	}
+
+	h.writeJoinRequestList(w, result.Requests)
+}
+
+// writeJoinRequestList writes a list of join requests
+func (h *GroupHandler) writeJoinRequestList(w http.ResponseWriter, requests []*domain.GroupJoinRequest) {
+	responses := make([]GroupJoinRequestResponse, len(requests))
+	for i, request := range requests {
+		responses[i] = *h.convertToJoinRequestResponse(request)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GroupJoinRequestListResponse{
+		Requests: responses,
+		Total:    len(responses),
+	})
+}
+
+// convertToJoinRequestResponse converts a domain join request to response format
+func (h *GroupHandler) convertToJoinRequestResponse(request *domain.GroupJoinRequest) *GroupJoinRequestResponse {
+	response := &GroupJoinRequestResponse{
+		ID:        request.ID.String(),
+		GroupID:   request.GroupID.String(),
+		UserID:    request.UserID.String(),
+		Message:   request.Message,
+		Status:    string(request.Status),
+		CreatedAt: request.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if request.ReviewedBy != nil {
+		response.ReviewedBy = request.ReviewedBy.String()
+	}
+	if request.ReviewedAt != nil {
+		response.ReviewedAt = request.ReviewedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return response
+}
@@ -19,14 +19,26 @@ type GroupHandler struct {
 
 // CreateGroupRequest represents the group creation request payload
 type CreateGroupRequest struct {
-	Name        string `json:"name" validate:"required,min=1,max=100"`
-	Description string `json:"description,omitempty" validate:"max=1000"`
+	Name        string   `json:"name" validate:"required,min=1,max=100"`
+	Description string   `json:"description,omitempty" validate:"max=1000"`
+	Visibility  string   `json:"visibility,omitempty" validate:"omitempty,oneof=public private"`
+	City        *string  `json:"city,omitempty"`
+	Country     *string  `json:"country,omitempty" validate:"omitempty,len=2"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+	Games       []string `json:"games,omitempty"`
 }
 
 // UpdateGroupRequest represents the group update request payload
 type UpdateGroupRequest struct {
-	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Name        *string  `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Visibility  *string  `json:"visibility,omitempty" validate:"omitempty,oneof=public private"`
+	City        *string  `json:"city,omitempty"`
+	Country     *string  `json:"country,omitempty" validate:"omitempty,len=2"`
+	Latitude    *float64 `json:"latitude,omitempty"`
+	Longitude   *float64 `json:"longitude,omitempty"`
+	Games       []string `json:"games,omitempty"`
 }
 
 // AddMemberRequest represents the add member request payload
@@ -45,6 +57,13 @@ type GroupResponse struct {
 	ID          string                `json:"id"`
 	Name        string                `json:"name"`
 	Description string                `json:"description"`
+	Visibility  string                `json:"visibility"`
+	City        *string               `json:"city,omitempty"`
+	Country     *string               `json:"country,omitempty"`
+	Latitude    *float64              `json:"latitude,omitempty"`
+	Longitude   *float64              `json:"longitude,omitempty"`
+	Games       []string              `json:"games"`
+	DistanceKm  *float64              `json:"distance_km,omitempty"`
 	Owner       *UserInfo             `json:"owner"`
 	MemberCount int                   `json:"member_count"`
 	UserRole    string                `json:"user_role,omitempty"`
@@ -55,9 +74,11 @@ type GroupResponse struct {
 
 // GroupMemberResponse represents a group member
 type GroupMemberResponse struct {
-	User     UserInfo `json:"user"`
-	Role     string   `json:"role"`
-	JoinedAt string   `json:"joined_at"`
+	User         UserInfo `json:"user"`
+	Role         string   `json:"role"`
+	CustomRoleID *string  `json:"custom_role_id,omitempty"`
+	Permissions  []string `json:"permissions,omitempty"` // Granted by the custom role
+	JoinedAt     string   `json:"joined_at"`
 }
 
 // GroupListResponse represents a paginated list of groups
@@ -119,11 +140,21 @@ func (h *GroupHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 		OwnerUserID: userUUID,
 		Name:        req.Name,
 		Description: &req.Description,
+		Visibility:  domain.GroupVisibility(req.Visibility),
+		City:        req.City,
+		Country:     req.Country,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		Games:       stringsToGameTypes(req.Games),
 	}
 
 	// Execute group creation
 	result, err := h.groupManagementUseCase.CreateGroup(r.Context(), createReq)
 	if err != nil {
+		if isGroupValidationError(err) {
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+			return
+		}
 		h.writeErrorResponse(w, http.StatusInternalServerError, "group_creation_failed", "Failed to create group")
 		return
 	}
@@ -222,6 +253,15 @@ func (h *GroupHandler) UpdateGroup(w http.ResponseWriter, r *http.Request) {
 		UserID:      userUUID,
 		Name:        req.Name,
 		Description: req.Description,
+		City:        req.City,
+		Country:     req.Country,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		Games:       stringsToGameTypes(req.Games),
+	}
+	if req.Visibility != nil {
+		visibility := domain.GroupVisibility(*req.Visibility)
+		updateReq.Visibility = &visibility
 	}
 
 	// Execute group update
@@ -233,6 +273,10 @@ func (h *GroupHandler) UpdateGroup(w http.ResponseWriter, r *http.Request) {
 		case usecase.ErrUnauthorized:
 			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can update this group")
 		default:
+			if isGroupValidationError(err) {
+				h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+				return
+			}
 			h.writeErrorResponse(w, http.StatusInternalServerError, "group_update_failed", "Failed to update group")
 		}
 		return
@@ -351,26 +395,35 @@ func (h *GroupHandler) AddGroupMember(w http.ResponseWriter, r *http.Request) {
 		InviterID: requestingUserUUID,
 	}
 
-	// Execute add member
-	_, err = h.groupManagementUseCase.InviteGroupMember(r.Context(), addMemberReq)
+	// Execute invitation
+	result, err := h.groupManagementUseCase.InviteGroupMember(r.Context(), addMemberReq)
 	if err != nil {
 		switch err {
 		case usecase.ErrGroupNotFound:
 			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
 		case usecase.ErrUserNotFound:
 			h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
-		case usecase.ErrUnauthorized:
-			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can add members")
+		case usecase.ErrUnauthorized, usecase.ErrInsufficientPermissions:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can invite members")
+		case usecase.ErrAlreadyGroupMember:
+			h.writeErrorResponse(w, http.StatusConflict, "already_member", "User is already a member of the group")
+		case usecase.ErrUserBannedFromGroup:
+			h.writeErrorResponse(w, http.StatusConflict, "user_banned", "User is banned from the group")
+		case usecase.ErrUserBlocked:
+			h.writeErrorResponse(w, http.StatusForbidden, "user_blocked", "You cannot invite this user")
+		case domain.ErrInvalidInviteRole:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
 		default:
-			h.writeErrorResponse(w, http.StatusInternalServerError, "add_member_failed", "Failed to add group member")
+			h.writeErrorResponse(w, http.StatusInternalServerError, "invite_member_failed", "Failed to invite group member")
 		}
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]string{
-		"message": "Member successfully added to group",
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": "Invitation sent",
+		"invite":  h.convertToInviteResponse(result.Invite),
 	})
 }
 
@@ -411,6 +464,9 @@ func (h *GroupHandler) RemoveGroupMember(w http.ResponseWriter, r *http.Request)
 		RemoverID: requestingUserUUID,
 		UserID:    targetUserID,
 	}
+	if reason := r.URL.Query().Get("reason"); reason != "" {
+		removeMemberReq.Reason = &reason
+	}
 
 	// Execute remove member
 	err = h.groupManagementUseCase.RemoveGroupMember(r.Context(), removeMemberReq)
@@ -570,6 +626,7 @@ func (h *GroupHandler) convertToGroupResponse(group interface{}, requestingUserI
 			CreatedAt:   g.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   g.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
+		setGroupDiscoveryFields(response, &g.Group)
 
 		// Add owner information
 		if g.Owner != nil {
@@ -599,6 +656,11 @@ func (h *GroupHandler) convertToGroupResponse(group interface{}, requestingUserI
 					Role:     string(member.Role), // Convert GroupRole to string
 					JoinedAt: member.JoinedAt.Format("2006-01-02T15:04:05Z07:00"),
 				}
+				if member.CustomRoleID != nil {
+					customRoleID := member.CustomRoleID.String()
+					members[i].CustomRoleID = &customRoleID
+					members[i].Permissions = permissionsToStrings(member.Permissions)
+				}
 			}
 			response.Members = members
 		}
@@ -616,6 +678,7 @@ func (h *GroupHandler) convertToGroupResponse(group interface{}, requestingUserI
 			CreatedAt:   g.Group.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   g.Group.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
+		setGroupDiscoveryFields(response, g.Group)
 
 		// Set user as owner
 		if requestingUserID != nil {
@@ -639,6 +702,23 @@ func (h *GroupHandler) convertToGroupResponse(group interface{}, requestingUserI
 			CreatedAt:   g.Group.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			UpdatedAt:   g.Group.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
+		setGroupDiscoveryFields(response, g.Group)
+
+	case *domain.GroupSearchResult:
+		description := ""
+		if g.Description != nil {
+			description = *g.Description
+		}
+		response = &GroupResponse{
+			ID:          g.ID.String(),
+			Name:        g.Name,
+			Description: description,
+			MemberCount: g.MemberCount,
+			DistanceKm:  g.DistanceKm,
+			CreatedAt:   g.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:   g.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		setGroupDiscoveryFields(response, &g.Group)
 
 	default:
 		// Return empty response for unknown types
@@ -648,6 +728,48 @@ func (h *GroupHandler) convertToGroupResponse(group interface{}, requestingUserI
 	return response
 }
 
+// setGroupDiscoveryFields copies a group's visibility, location and games into the response
+func setGroupDiscoveryFields(response *GroupResponse, group *domain.Group) {
+	response.Visibility = string(domain.GroupVisibilityPrivate)
+	if group.Visibility != "" {
+		response.Visibility = string(group.Visibility)
+	}
+	response.City = group.City
+	response.Country = group.Country
+	response.Latitude = group.Latitude
+	response.Longitude = group.Longitude
+
+	response.Games = make([]string, len(group.Games))
+	for i, game := range group.Games {
+		response.Games[i] = string(game)
+	}
+}
+
+// stringsToGameTypes converts request game names to domain game types, keeping nil as nil
+func stringsToGameTypes(games []string) []domain.GameType {
+	if games == nil {
+		return nil
+	}
+
+	gameTypes := make([]domain.GameType, len(games))
+	for i, game := range games {
+		gameTypes[i] = domain.GameType(game)
+	}
+	return gameTypes
+}
+
+// isGroupValidationError reports whether err comes from group validation
+func isGroupValidationError(err error) bool {
+	switch err {
+	case domain.ErrEmptyGroupName, domain.ErrGroupNameTooLong, domain.ErrGroupDescriptionTooLong,
+		domain.ErrInvalidGroupVisibility, domain.ErrIncompleteGroupLocation, domain.ErrInvalidGroupCountry,
+		domain.ErrInvalidCoordinates, domain.ErrInvalidGameType:
+		return true
+	default:
+		return false
+	}
+}
+
 // writeErrorResponse writes a standardized error response
 func (h *GroupHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
 	w.Header().Set("Content-Type", "application/json")
@@ -665,6 +787,7 @@ func (h *GroupHandler) RegisterRoutes(router *mux.Router, authMiddleware *middle
 	protected.Use(authMiddleware.RequireAuth)
 
 	// Group CRUD operations
+	protected.HandleFunc("/groups", h.SearchGroups).Methods("GET")
 	protected.HandleFunc("/groups", h.CreateGroup).Methods("POST")
 	protected.HandleFunc("/groups/{id}", h.GetGroup).Methods("GET")
 	protected.HandleFunc("/groups/{id}", h.UpdateGroup).Methods("PUT")
@@ -675,6 +798,55 @@ func (h *GroupHandler) RegisterRoutes(router *mux.Router, authMiddleware *middle
 	protected.HandleFunc("/groups/{id}/members/{userId}", h.RemoveGroupMember).Methods("DELETE")
 	protected.HandleFunc("/groups/{id}/members/{userId}", h.UpdateMemberRole).Methods("PUT")
 
-	// User's groups
+	// Group invites
+	protected.HandleFunc("/groups/{id}/invite-links", h.CreateInviteLink).Methods("POST")
+	protected.HandleFunc("/groups/{id}/invites", h.ListGroupInvites).Methods("GET")
+	protected.HandleFunc("/groups/{id}/invites/{inviteId}", h.RevokeInvite).Methods("DELETE")
+	protected.HandleFunc("/invites/accept", h.AcceptInviteByToken).Methods("POST")
+	protected.HandleFunc("/invites/{inviteId}/accept", h.AcceptInvite).Methods("POST")
+	protected.HandleFunc("/invites/{inviteId}/decline", h.DeclineInvite).Methods("POST")
+
+	// Join requests for public groups
+	protected.HandleFunc("/groups/{id}/join-requests", h.RequestToJoinGroup).Methods("POST")
+	protected.HandleFunc("/groups/{id}/join-requests", h.ListJoinRequests).Methods("GET")
+	protected.HandleFunc("/groups/{id}/join-requests/{requestId}/approve", h.ApproveJoinRequest).Methods("POST")
+	protected.HandleFunc("/groups/{id}/join-requests/{requestId}/reject", h.RejectJoinRequest).Methods("POST")
+	protected.HandleFunc("/join-requests/{requestId}", h.CancelJoinRequest).Methods("DELETE")
+
+	// Group leagues
+	protected.HandleFunc("/groups/{id}/leagues", h.ListGroupLeagues).Methods("GET")
+	protected.HandleFunc("/groups/{id}/leagues", h.CreateLeague).Methods("POST")
+	protected.HandleFunc("/groups/{id}/leagues/{leagueId}", h.UpdateLeague).Methods("PUT")
+	protected.HandleFunc("/groups/{id}/leagues/{leagueId}", h.DeleteLeague).Methods("DELETE")
+	protected.HandleFunc("/groups/{id}/leagues/{leagueId}/standings", h.GetLeagueStandings).Methods("GET")
+	protected.HandleFunc("/groups/{id}/leagues/{leagueId}/events", h.GetLeagueEvents).Methods("GET")
+	protected.HandleFunc("/groups/{id}/leagues/{leagueId}/events", h.LinkLeagueEvent).Methods("POST")
+	protected.HandleFunc("/groups/{id}/leagues/{leagueId}/events/{eventId}", h.UnlinkLeagueEvent).Methods("DELETE")
+	protected.HandleFunc("/groups/{id}/leagues/{leagueId}/events/{eventId}/results", h.RecordLeagueResults).Methods("PUT")
+
+	// Group announcements
+	protected.HandleFunc("/groups/{id}/announcements", h.ListAnnouncements).Methods("GET")
+	protected.HandleFunc("/groups/{id}/announcements", h.CreateAnnouncement).Methods("POST")
+	protected.HandleFunc("/groups/{id}/announcements/{announcementId}", h.UpdateAnnouncement).Methods("PUT")
+	protected.HandleFunc("/groups/{id}/announcements/{announcementId}", h.DeleteAnnouncement).Methods("DELETE")
+	protected.HandleFunc("/groups/{id}/announcements/{announcementId}/pin", h.PinAnnouncement).Methods("POST")
+	protected.HandleFunc("/groups/{id}/announcements/{announcementId}/unpin", h.UnpinAnnouncement).Methods("POST")
+
+	// Group moderation
+	protected.HandleFunc("/groups/{id}/bans", h.ListGroupBans).Methods("GET")
+	protected.HandleFunc("/groups/{id}/bans", h.BanGroupMember).Methods("POST")
+	protected.HandleFunc("/groups/{id}/bans/{userId}", h.UnbanGroupMember).Methods("DELETE")
+	protected.HandleFunc("/groups/{id}/moderation-log", h.GetModerationLog).Methods("GET")
+
+	// Custom group roles
+	protected.HandleFunc("/groups/{id}/roles", h.ListGroupRoles).Methods("GET")
+	protected.HandleFunc("/groups/{id}/roles", h.CreateGroupRole).Methods("POST")
+	protected.HandleFunc("/groups/{id}/roles/{roleId}", h.UpdateGroupRole).Methods("PUT")
+	protected.HandleFunc("/groups/{id}/roles/{roleId}", h.DeleteGroupRole).Methods("DELETE")
+	protected.HandleFunc("/groups/{id}/members/{userId}/custom-role", h.AssignGroupRole).Methods("PUT")
+
+	// User's groups, invites and join requests
 	protected.HandleFunc("/me/groups", h.GetUserGroups).Methods("GET")
+	protected.HandleFunc("/me/invites", h.GetUserInvites).Methods("GET")
+	protected.HandleFunc("/me/join-requests", h.GetUserJoinRequests).Methods("GET")
 }
@@ -0,0 +1,372 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// CreateInviteLinkRequest represents the invite link creation request payload
+type CreateInviteLinkRequest struct {
+	MaxUses        *int `json:"max_uses,omitempty" validate:"omitempty,min=1"`
+	ExpiresInHours *int `json:"expires_in_hours,omitempty" validate:"omitempty,min=1"`
+}
+
+// AcceptInviteRequest represents the accept invite by token request payload
+type AcceptInviteRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// GroupInviteResponse represents a group invite
+type GroupInviteResponse struct {
+	ID            string `json:"id"`
+	GroupID       string `json:"group_id"`
+	GroupName     string `json:"group_name,omitempty"`
+	InvitedUserID string `json:"invited_user_id,omitempty"`
+	InviterUserID string `json:"inviter_user_id"`
+	Role          string `json:"role"`
+	Status        string `json:"status"`
+	IsLink        bool   `json:"is_link"`
+	MaxUses       *int   `json:"max_uses,omitempty"`
+	UseCount      int    `json:"use_count"`
+	ExpiresAt     string `json:"expires_at"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// GroupInviteListResponse represents a list of group invites
+type GroupInviteListResponse struct {
+	Invites []GroupInviteResponse `json:"invites"`
+	Total   int                   `json:"total"`
+}
+
+// CreateInviteLink handles POST /groups/{id}/invite-links
+func (h *GroupHandler) CreateInviteLink(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateInviteLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	linkReq := &usecase.CreateGroupInviteLinkRequest{
+		GroupID: groupID,
+		UserID:  userUUID,
+		MaxUses: req.MaxUses,
+	}
+	if req.ExpiresInHours != nil {
+		expiresIn := time.Duration(*req.ExpiresInHours) * time.Hour
+		linkReq.ExpiresIn = &expiresIn
+	}
+
+	result, err := h.groupManagementUseCase.CreateGroupInviteLink(r.Context(), linkReq)
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can create invite links")
+		case domain.ErrInvalidInviteMaxUses, domain.ErrInvalidInviteExpiry:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "invite_link_failed", "Failed to create invite link")
+		}
+		return
+	}
+
+	// The token is only returned here; afterwards only its hash is known
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"invite": h.convertToInviteResponse(result.Invite),
+		"token":  result.Token,
+	})
+}
+
+// ListGroupInvites handles GET /groups/{id}/invites
+func (h *GroupHandler) ListGroupInvites(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.ListGroupInvites(r.Context(), &usecase.ListGroupInvitesRequest{
+		GroupID: groupID,
+		UserID:  userUUID,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can view invites")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "invites_fetch_failed", "Failed to fetch group invites")
+		}
+		return
+	}
+
+	invites := make([]GroupInviteResponse, len(result.Invites))
+	for i, invite := range result.Invites {
+		invites[i] = *h.convertToInviteResponse(invite)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GroupInviteListResponse{
+		Invites: invites,
+		Total:   len(invites),
+	})
+}
+
+// RevokeInvite handles DELETE /groups/{id}/invites/{inviteId}
+func (h *GroupHandler) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	inviteID, err := uuid.Parse(vars["inviteId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_invite_id", "Invalid invite ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.groupManagementUseCase.RevokeGroupInvite(r.Context(), &usecase.RevokeGroupInviteRequest{
+		GroupID:  groupID,
+		InviteID: inviteID,
+		UserID:   userUUID,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrInviteNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "invite_not_found", "Invite not found")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can revoke invites")
+		case usecase.ErrInviteNoLongerValid:
+			h.writeErrorResponse(w, http.StatusConflict, "invite_not_pending", "Invite is no longer pending")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "invite_revoke_failed", "Failed to revoke invite")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Invite successfully revoked",
+	})
+}
+
+// AcceptInviteByToken handles POST /invites/accept
+func (h *GroupHandler) AcceptInviteByToken(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AcceptInviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+	if req.Token == "" {
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "Token is required")
+		return
+	}
+
+	h.acceptInvite(w, r, &usecase.AcceptGroupInviteRequest{
+		Token:  req.Token,
+		UserID: userUUID,
+	})
+}
+
+// AcceptInvite handles POST /invites/{inviteId}/accept
+func (h *GroupHandler) AcceptInvite(w http.ResponseWriter, r *http.Request) {
+	inviteID, err := uuid.Parse(mux.Vars(r)["inviteId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_invite_id", "Invalid invite ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	h.acceptInvite(w, r, &usecase.AcceptGroupInviteRequest{
+		InviteID: &inviteID,
+		UserID:   userUUID,
+	})
+}
+
+// acceptInvite executes an accept request and writes the response
+func (h *GroupHandler) acceptInvite(w http.ResponseWriter, r *http.Request, req *usecase.AcceptGroupInviteRequest) {
+	result, err := h.groupManagementUseCase.AcceptGroupInvite(r.Context(), req)
+	if err != nil {
+		h.writeInviteResponseError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message":  "Successfully joined group",
+		"group_id": result.GroupID.String(),
+		"role":     string(result.Role),
+	})
+}
+
+// DeclineInvite handles POST /invites/{inviteId}/decline
+func (h *GroupHandler) DeclineInvite(w http.ResponseWriter, r *http.Request) {
+	inviteID, err := uuid.Parse(mux.Vars(r)["inviteId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_invite_id", "Invalid invite ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.groupManagementUseCase.DeclineGroupInvite(r.Context(), &usecase.DeclineGroupInviteRequest{
+		InviteID: inviteID,
+		UserID:   userUUID,
+	})
+	if err != nil {
+		h.writeInviteResponseError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Invite declined",
+	})
+}
+
+// GetUserInvites handles GET /me/invites
+func (h *GroupHandler) GetUserInvites(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.ListUserInvites(r.Context(), &usecase.ListUserInvitesRequest{
+		UserID: userUUID,
+	})
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusInternalServerError, "invites_fetch_failed", "Failed to fetch invites")
+		return
+	}
+
+	invites := make([]GroupInviteResponse, len(result.Invites))
+	for i, invite := range result.Invites {
+		response := h.convertToInviteResponse(&invite.GroupInvite)
+		if invite.Group != nil {
+			response.GroupName = invite.Group.Name
+		}
+		invites[i] = *response
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GroupInviteListResponse{
+		Invites: invites,
+		Total:   len(invites),
+	})
+}
+
+// writeInviteResponseError maps errors from accepting or declining an invite
+func (h *GroupHandler) writeInviteResponseError(w http.ResponseWriter, err error) {
+	switch err {
+	case usecase.ErrInviteNotFound, usecase.ErrGroupNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "invite_not_found", "Invite not found")
+	case usecase.ErrInvalidInviteToken:
+		h.writeErrorResponse(w, http.StatusNotFound, "invalid_invite_token", "Invalid invite token")
+	case usecase.ErrInviteNotForUser:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "This invite was sent to another user")
+	case usecase.ErrInviteExpired:
+		h.writeErrorResponse(w, http.StatusGone, "invite_expired", "Invite has expired")
+	case usecase.ErrInviteNoLongerValid:
+		h.writeErrorResponse(w, http.StatusGone, "invite_no_longer_valid", "Invite is no longer valid")
+	case usecase.ErrAlreadyGroupMember:
+		h.writeErrorResponse(w, http.StatusConflict, "already_member", "You are already a member of this group")
+	case usecase.ErrUserBannedFromGroup:
+		h.writeErrorResponse(w, http.StatusForbidden, "banned_from_group", "You are banned from this group")
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, "invite_response_failed", "Failed to respond to invite")
+	}
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *GroupHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToInviteResponse converts a domain invite to response format
+func (h *GroupHandler) convertToInviteResponse(invite *domain.GroupInvite) *GroupInviteResponse {
+	response := &GroupInviteResponse{
+		ID:            invite.ID.String(),
+		GroupID:       invite.GroupID.String(),
+		InviterUserID: invite.InviterID.String(),
+		Role:          string(invite.Role),
+		Status:        string(invite.Status),
+		IsLink:        invite.IsLink(),
+		MaxUses:       invite.MaxUses,
+		UseCount:      invite.UseCount,
+		ExpiresAt:     invite.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:     invite.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if invite.InvitedUserID != nil {
+		response.InvitedUserID = invite.InvitedUserID.String()
+	}
+
+	return response
+}
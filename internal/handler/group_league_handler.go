@@ -0,0 +1,583 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// LeaguePointSchemeRequest represents a league point scheme payload
+type LeaguePointSchemeRequest struct {
+	PlacementPoints     []int `json:"placement_points" validate:"max=64,dive,min=0"`
+	ParticipationPoints int   `json:"participation_points" validate:"min=0"`
+}
+
+// CreateLeagueRequest represents the league creation request payload
+type CreateLeagueRequest struct {
+	Name        string                   `json:"name" validate:"required,max=100"`
+	Description *string                  `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SeasonStart time.Time                `json:"season_start" validate:"required"`
+	SeasonEnd   time.Time                `json:"season_end" validate:"required"`
+	PointScheme LeaguePointSchemeRequest `json:"point_scheme"`
+}
+
+// UpdateLeagueRequest represents the league update request payload
+type UpdateLeagueRequest struct {
+	Name        *string                   `json:"name,omitempty" validate:"omitempty,max=100"`
+	Description *string                   `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SeasonStart *time.Time                `json:"season_start,omitempty"`
+	SeasonEnd   *time.Time                `json:"season_end,omitempty"`
+	PointScheme *LeaguePointSchemeRequest `json:"point_scheme,omitempty"`
+}
+
+// LinkLeagueEventRequest represents the request payload to add an event to a league
+type LinkLeagueEventRequest struct {
+	EventID string `json:"event_id" validate:"required,uuid"`
+}
+
+// LeagueResultRequest represents one player's result in a results payload
+type LeagueResultRequest struct {
+	UserID    string `json:"user_id" validate:"required,uuid"`
+	Placement *int   `json:"placement,omitempty" validate:"omitempty,min=1"`
+}
+
+// RecordLeagueResultsRequest represents the league event results payload
+type RecordLeagueResultsRequest struct {
+	Results []LeagueResultRequest `json:"results" validate:"dive"`
+}
+
+// LeagueResponse represents a group league
+type LeagueResponse struct {
+	ID          string                   `json:"id"`
+	GroupID     string                   `json:"group_id"`
+	Name        string                   `json:"name"`
+	Description *string                  `json:"description,omitempty"`
+	SeasonStart string                   `json:"season_start"`
+	SeasonEnd   string                   `json:"season_end"`
+	PointScheme LeaguePointSchemeRequest `json:"point_scheme"`
+	CreatedBy   string                   `json:"created_by"`
+	CreatedAt   string                   `json:"created_at"`
+	UpdatedAt   string                   `json:"updated_at"`
+}
+
+// LeagueListResponse represents a list of group leagues
+type LeagueListResponse struct {
+	Leagues []LeagueResponse `json:"leagues"`
+	Total   int              `json:"total"`
+}
+
+// LeagueEventResponse represents an event counting towards a league
+type LeagueEventResponse struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Game    string `json:"game"`
+	Format  string `json:"format,omitempty"`
+	StartAt string `json:"start_at"`
+	EndAt   string `json:"end_at"`
+}
+
+// LeagueEventListResponse represents a list of league events
+type LeagueEventListResponse struct {
+	Events []LeagueEventResponse `json:"events"`
+	Total  int                   `json:"total"`
+}
+
+// LeagueResultResponse represents a player's recorded result
+type LeagueResultResponse struct {
+	UserID     string `json:"user_id"`
+	Placement  *int   `json:"placement,omitempty"`
+	RecordedBy string `json:"recorded_by"`
+	RecordedAt string `json:"recorded_at"`
+}
+
+// LeagueStandingResponse represents a row of a league table
+type LeagueStandingResponse struct {
+	Rank          int    `json:"rank"`
+	UserID        string `json:"user_id"`
+	Points        int    `json:"points"`
+	EventsPlayed  int    `json:"events_played"`
+	Wins          int    `json:"wins"`
+	BestPlacement *int   `json:"best_placement,omitempty"`
+}
+
+// LeagueStandingsResponse represents a league with its table
+type LeagueStandingsResponse struct {
+	League    LeagueResponse           `json:"league"`
+	Standings []LeagueStandingResponse `json:"standings"`
+}
+
+// CreateLeague handles POST /groups/{id}/leagues
+func (h *GroupHandler) CreateLeague(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateLeagueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	result, err := h.groupManagementUseCase.CreateLeague(r.Context(), &usecase.CreateLeagueRequest{
+		GroupID:     groupID,
+		UserID:      userUUID,
+		Name:        req.Name,
+		Description: req.Description,
+		SeasonStart: req.SeasonStart,
+		SeasonEnd:   req.SeasonEnd,
+		PointScheme: req.PointScheme.toDomain(),
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "league_creation_failed", "Failed to create league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToLeagueResponse(result))
+}
+
+// ListGroupLeagues handles GET /groups/{id}/leagues
+func (h *GroupHandler) ListGroupLeagues(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.ListGroupLeagues(r.Context(), &usecase.ListGroupLeaguesRequest{
+		GroupID:     groupID,
+		RequesterID: userUUID,
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "leagues_fetch_failed", "Failed to fetch leagues")
+		return
+	}
+
+	leagues := make([]LeagueResponse, len(result.Leagues))
+	for i, league := range result.Leagues {
+		leagues[i] = *h.convertToLeagueResponse(league)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(LeagueListResponse{
+		Leagues: leagues,
+		Total:   len(leagues),
+	})
+}
+
+// UpdateLeague handles PUT /groups/{id}/leagues/{leagueId}
+func (h *GroupHandler) UpdateLeague(w http.ResponseWriter, r *http.Request) {
+	groupID, leagueID, ok := h.parseLeaguePath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateLeagueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	updateReq := &usecase.UpdateLeagueRequest{
+		GroupID:     groupID,
+		LeagueID:    leagueID,
+		UserID:      userUUID,
+		Name:        req.Name,
+		Description: req.Description,
+		SeasonStart: req.SeasonStart,
+		SeasonEnd:   req.SeasonEnd,
+	}
+	if req.PointScheme != nil {
+		pointScheme := req.PointScheme.toDomain()
+		updateReq.PointScheme = &pointScheme
+	}
+
+	result, err := h.groupManagementUseCase.UpdateLeague(r.Context(), updateReq)
+	if err != nil {
+		h.writeLeagueError(w, err, "league_update_failed", "Failed to update league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToLeagueResponse(result))
+}
+
+// DeleteLeague handles DELETE /groups/{id}/leagues/{leagueId}
+func (h *GroupHandler) DeleteLeague(w http.ResponseWriter, r *http.Request) {
+	groupID, leagueID, ok := h.parseLeaguePath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.groupManagementUseCase.DeleteLeague(r.Context(), &usecase.DeleteLeagueRequest{
+		GroupID:  groupID,
+		LeagueID: leagueID,
+		UserID:   userUUID,
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "league_deletion_failed", "Failed to delete league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "League deleted successfully",
+	})
+}
+
+// GetLeagueStandings handles GET /groups/{id}/leagues/{leagueId}/standings
+func (h *GroupHandler) GetLeagueStandings(w http.ResponseWriter, r *http.Request) {
+	groupID, leagueID, ok := h.parseLeaguePath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.GetLeagueStandings(r.Context(), &usecase.GetLeagueStandingsRequest{
+		GroupID:     groupID,
+		LeagueID:    leagueID,
+		RequesterID: userUUID,
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "standings_fetch_failed", "Failed to fetch league standings")
+		return
+	}
+
+	standings := make([]LeagueStandingResponse, len(result.Standings))
+	for i, standing := range result.Standings {
+		standings[i] = LeagueStandingResponse{
+			Rank:          standing.Rank,
+			UserID:        standing.UserID.String(),
+			Points:        standing.Points,
+			EventsPlayed:  standing.EventsPlayed,
+			Wins:          standing.Wins,
+			BestPlacement: standing.BestPlacement,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(LeagueStandingsResponse{
+		League:    *h.convertToLeagueResponse(result.League),
+		Standings: standings,
+	})
+}
+
+// GetLeagueEvents handles GET /groups/{id}/leagues/{leagueId}/events
+func (h *GroupHandler) GetLeagueEvents(w http.ResponseWriter, r *http.Request) {
+	groupID, leagueID, ok := h.parseLeaguePath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	limit := 50
+	offset := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
+			limit = parsedLimit
+		}
+	}
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	result, err := h.groupManagementUseCase.GetLeagueEvents(r.Context(), &usecase.GetLeagueEventsRequest{
+		GroupID:     groupID,
+		LeagueID:    leagueID,
+		RequesterID: userUUID,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "league_events_fetch_failed", "Failed to fetch league events")
+		return
+	}
+
+	events := make([]LeagueEventResponse, len(result.Events))
+	for i, event := range result.Events {
+		events[i] = LeagueEventResponse{
+			ID:      event.ID.String(),
+			Title:   event.Title,
+			Game:    string(event.Game),
+			StartAt: event.StartAt.Format("2006-01-02T15:04:05Z07:00"),
+			EndAt:   event.EndAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+		if event.Format != nil {
+			events[i].Format = *event.Format
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(LeagueEventListResponse{
+		Events: events,
+		Total:  len(events),
+	})
+}
+
+// LinkLeagueEvent handles POST /groups/{id}/leagues/{leagueId}/events
+func (h *GroupHandler) LinkLeagueEvent(w http.ResponseWriter, r *http.Request) {
+	groupID, leagueID, ok := h.parseLeaguePath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req LinkLeagueEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	eventID, err := uuid.Parse(req.EventID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	err = h.groupManagementUseCase.LinkLeagueEvent(r.Context(), &usecase.LeagueEventRequest{
+		GroupID:  groupID,
+		LeagueID: leagueID,
+		EventID:  eventID,
+		UserID:   userUUID,
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "league_event_link_failed", "Failed to add event to league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Event added to league",
+	})
+}
+
+// UnlinkLeagueEvent handles DELETE /groups/{id}/leagues/{leagueId}/events/{eventId}
+func (h *GroupHandler) UnlinkLeagueEvent(w http.ResponseWriter, r *http.Request) {
+	groupID, leagueID, ok := h.parseLeaguePath(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, err := uuid.Parse(mux.Vars(r)["eventId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.groupManagementUseCase.UnlinkLeagueEvent(r.Context(), &usecase.LeagueEventRequest{
+		GroupID:  groupID,
+		LeagueID: leagueID,
+		EventID:  eventID,
+		UserID:   userUUID,
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "league_event_unlink_failed", "Failed to remove event from league")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Event removed from league",
+	})
+}
+
+// RecordLeagueResults handles PUT /groups/{id}/leagues/{leagueId}/events/{eventId}/results
+func (h *GroupHandler) RecordLeagueResults(w http.ResponseWriter, r *http.Request) {
+	groupID, leagueID, ok := h.parseLeaguePath(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, err := uuid.Parse(mux.Vars(r)["eventId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req RecordLeagueResultsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	playerResults := make([]usecase.LeaguePlayerResult, len(req.Results))
+	for i, result := range req.Results {
+		playerID, err := uuid.Parse(result.UserID)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid player user ID")
+			return
+		}
+		playerResults[i] = usecase.LeaguePlayerResult{
+			UserID:    playerID,
+			Placement: result.Placement,
+		}
+	}
+
+	result, err := h.groupManagementUseCase.RecordLeagueResults(r.Context(), &usecase.RecordLeagueResultsRequest{
+		GroupID:  groupID,
+		LeagueID: leagueID,
+		EventID:  eventID,
+		UserID:   userUUID,
+		Results:  playerResults,
+	})
+	if err != nil {
+		h.writeLeagueError(w, err, "league_results_failed", "Failed to record league results")
+		return
+	}
+
+	results := make([]LeagueResultResponse, len(result.Results))
+	for i, leagueResult := range result.Results {
+		results[i] = LeagueResultResponse{
+			UserID:     leagueResult.UserID.String(),
+			Placement:  leagueResult.Placement,
+			RecordedBy: leagueResult.RecordedBy.String(),
+			RecordedAt: leagueResult.RecordedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"results": results,
+		"total":   len(results),
+	})
+}
+
+// parseLeaguePath parses the group and league IDs from the request path
+func (h *GroupHandler) parseLeaguePath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	leagueID, err := uuid.Parse(vars["leagueId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_league_id", "Invalid league ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return groupID, leagueID, true
+}
+
+// writeLeagueError maps league use case errors to HTTP responses
+func (h *GroupHandler) writeLeagueError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrGroupNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+	case usecase.ErrLeagueNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "league_not_found", "League not found")
+	case usecase.ErrEventNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "event_not_found", "Event not found")
+	case usecase.ErrLeagueEventNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "league_event_not_found", "Event is not part of this league")
+	case usecase.ErrUnauthorizedGroupAccess:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Access denied to group leagues")
+	case usecase.ErrEventAlreadyInLeague:
+		h.writeErrorResponse(w, http.StatusConflict, "event_already_in_league", "Event already counts towards a league")
+	case usecase.ErrEventNotInGroup, usecase.ErrEventOutsideLeagueSeason,
+		usecase.ErrDuplicateLeaguePlayer, usecase.ErrDuplicateLeaguePlacement,
+		usecase.ErrLeaguePlayerNotAttending, usecase.ErrLeagueSeasonExcludesEvent,
+		domain.ErrEmptyLeagueName, domain.ErrLeagueNameTooLong, domain.ErrLeagueDescriptionTooLong,
+		domain.ErrInvalidLeagueSeason, domain.ErrInvalidLeaguePoints, domain.ErrTooManyPlacementPoints,
+		domain.ErrInvalidLeaguePlacement:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// convertToLeagueResponse converts a domain league to response format
+func (h *GroupHandler) convertToLeagueResponse(league *domain.GroupLeague) *LeagueResponse {
+	placementPoints := league.PointScheme.PlacementPoints
+	if placementPoints == nil {
+		placementPoints = []int{}
+	}
+
+	return &LeagueResponse{
+		ID:          league.ID.String(),
+		GroupID:     league.GroupID.String(),
+		Name:        league.Name,
+		Description: league.Description,
+		SeasonStart: league.SeasonStart.Format("2006-01-02T15:04:05Z07:00"),
+		SeasonEnd:   league.SeasonEnd.Format("2006-01-02T15:04:05Z07:00"),
+		PointScheme: LeaguePointSchemeRequest{
+			PlacementPoints:     placementPoints,
+			ParticipationPoints: league.PointScheme.ParticipationPoints,
+		},
+		CreatedBy: league.CreatedBy.String(),
+		CreatedAt: league.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: league.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// toDomain converts the point scheme payload to the domain type
+func (p LeaguePointSchemeRequest) toDomain() domain.LeaguePointScheme {
+	return domain.LeaguePointScheme{
+		PlacementPoints:     p.PlacementPoints,
+		ParticipationPoints: p.ParticipationPoints,
+	}
+}
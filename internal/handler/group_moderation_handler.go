@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// BanGroupMemberRequest represents the ban request payload
+type BanGroupMemberRequest struct {
+	UserID    string     `json:"user_id" validate:"required,uuid"`
+	Reason    *string    `json:"reason,omitempty" validate:"omitempty,max=500"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// GroupBanResponse represents an active group ban
+type GroupBanResponse struct {
+	ID        string  `json:"id"`
+	GroupID   string  `json:"group_id"`
+	UserID    string  `json:"user_id"`
+	BannedBy  string  `json:"banned_by"`
+	Reason    *string `json:"reason,omitempty"`
+	ExpiresAt *string `json:"expires_at,omitempty"`
+	CreatedAt string  `json:"created_at"`
+}
+
+// GroupBanListResponse represents a page of active group bans
+type GroupBanListResponse struct {
+	Bans   []GroupBanResponse `json:"bans"`
+	Total  int                `json:"total"`
+	Limit  int                `json:"limit"`
+	Offset int                `json:"offset"`
+}
+
+// ModerationActionResponse represents an entry in a group's moderation audit trail
+type ModerationActionResponse struct {
+	ID           string  `json:"id"`
+	ActorUserID  string  `json:"actor_user_id"`
+	TargetUserID string  `json:"target_user_id"`
+	Action       string  `json:"action"`
+	Reason       *string `json:"reason,omitempty"`
+	ExpiresAt    *string `json:"expires_at,omitempty"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+// ModerationLogResponse represents a page of a group's moderation audit trail
+type ModerationLogResponse struct {
+	Actions []ModerationActionResponse `json:"actions"`
+	Total   int                        `json:"total"`
+	Limit   int                        `json:"limit"`
+	Offset  int                        `json:"offset"`
+}
+
+// BanGroupMember handles POST /groups/{id}/bans
+func (h *GroupHandler) BanGroupMember(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req BanGroupMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	targetUserID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	result, err := h.groupManagementUseCase.BanGroupMember(r.Context(), &usecase.BanGroupMemberRequest{
+		GroupID:     groupID,
+		UserID:      targetUserID,
+		ModeratorID: userUUID,
+		Reason:      req.Reason,
+		ExpiresAt:   req.ExpiresAt,
+	})
+	if err != nil {
+		h.writeModerationError(w, err, "ban_failed", "Failed to ban user")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToGroupBanResponse(result))
+}
+
+// UnbanGroupMember handles DELETE /groups/{id}/bans/{userId}
+func (h *GroupHandler) UnbanGroupMember(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	targetUserID, err := uuid.Parse(vars["userId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.groupManagementUseCase.UnbanGroupMember(r.Context(), &usecase.UnbanGroupMemberRequest{
+		GroupID:     groupID,
+		UserID:      targetUserID,
+		ModeratorID: userUUID,
+	})
+	if err != nil {
+		h.writeModerationError(w, err, "unban_failed", "Failed to unban user")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Ban lifted",
+	})
+}
+
+// ListGroupBans handles GET /groups/{id}/bans
+func (h *GroupHandler) ListGroupBans(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parseModerationPage(r)
+	result, err := h.groupManagementUseCase.ListGroupBans(r.Context(), &usecase.ListGroupBansRequest{
+		GroupID:     groupID,
+		RequesterID: userUUID,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		h.writeModerationError(w, err, "bans_fetch_failed", "Failed to fetch bans")
+		return
+	}
+
+	bans := make([]GroupBanResponse, len(result.Bans))
+	for i, ban := range result.Bans {
+		bans[i] = *h.convertToGroupBanResponse(ban)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GroupBanListResponse{
+		Bans:   bans,
+		Total:  len(bans),
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	})
+}
+
+// GetModerationLog handles GET /groups/{id}/moderation-log
+func (h *GroupHandler) GetModerationLog(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parseModerationPage(r)
+	result, err := h.groupManagementUseCase.GetModerationLog(r.Context(), &usecase.GetModerationLogRequest{
+		GroupID:     groupID,
+		RequesterID: userUUID,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		h.writeModerationError(w, err, "moderation_log_fetch_failed", "Failed to fetch moderation log")
+		return
+	}
+
+	actions := make([]ModerationActionResponse, len(result.Actions))
+	for i, action := range result.Actions {
+		actions[i] = ModerationActionResponse{
+			ID:           action.ID.String(),
+			ActorUserID:  action.ActorUserID.String(),
+			TargetUserID: action.TargetUserID.String(),
+			Action:       string(action.Action),
+			Reason:       action.Reason,
+			ExpiresAt:    formatOptionalTime(action.ExpiresAt),
+			CreatedAt:    action.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ModerationLogResponse{
+		Actions: actions,
+		Total:   len(actions),
+		Limit:   result.Limit,
+		Offset:  result.Offset,
+	})
+}
+
+// writeModerationError maps moderation use case errors to HTTP responses
+func (h *GroupHandler) writeModerationError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrGroupNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+	case usecase.ErrUserNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
+	case usecase.ErrBanNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "ban_not_found", "User is not banned from this group")
+	case usecase.ErrInsufficientPermissions:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Insufficient permissions to moderate this user")
+	case usecase.ErrUnauthorizedGroupAccess:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can view moderation records")
+	case domain.ErrCannotBanSelf, domain.ErrBanReasonTooLong, domain.ErrInvalidBanExpiry:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// convertToGroupBanResponse converts a domain ban to response format
+func (h *GroupHandler) convertToGroupBanResponse(ban *domain.GroupBan) *GroupBanResponse {
+	return &GroupBanResponse{
+		ID:        ban.ID.String(),
+		GroupID:   ban.GroupID.String(),
+		UserID:    ban.UserID.String(),
+		BannedBy:  ban.BannedBy.String(),
+		Reason:    ban.Reason,
+		ExpiresAt: formatOptionalTime(ban.ExpiresAt),
+		CreatedAt: ban.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// parseModerationPage parses the limit and offset query parameters of a moderation listing
+func parseModerationPage(r *http.Request) (int, int) {
+	limit := 0
+	offset := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	return limit, offset
+}
+
+// formatOptionalTime formats a nullable timestamp for a response
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	formatted := t.Format("2006-01-02T15:04:05Z07:00")
+	return &formatted
+}
@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// CreateGroupRoleRequest represents the custom role creation request payload
+type CreateGroupRoleRequest struct {
+	Name        string   `json:"name" validate:"required,max=50"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=500"`
+	Permissions []string `json:"permissions" validate:"required,min=1"`
+}
+
+// UpdateGroupRoleRequest represents the custom role update request payload
+type UpdateGroupRoleRequest struct {
+	Name        *string  `json:"name,omitempty" validate:"omitempty,max=50"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=500"`
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// AssignGroupRoleRequest represents the member custom role assignment payload
+type AssignGroupRoleRequest struct {
+	RoleID *string `json:"role_id"` // Null clears the member's custom role
+}
+
+// GroupRoleResponse represents a custom group role
+type GroupRoleResponse struct {
+	ID          string   `json:"id"`
+	GroupID     string   `json:"group_id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+// GroupRoleListResponse represents a group's custom roles
+type GroupRoleListResponse struct {
+	Roles                []GroupRoleResponse `json:"roles"`
+	GrantablePermissions []string            `json:"grantable_permissions"`
+}
+
+// ListGroupRoles handles GET /groups/{id}/roles
+func (h *GroupHandler) ListGroupRoles(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupManagementUseCase.ListGroupRoles(r.Context(), &usecase.ListGroupRolesRequest{
+		GroupID:     groupID,
+		RequesterID: userUUID,
+	})
+	if err != nil {
+		h.writeGroupRoleError(w, err, "roles_fetch_failed", "Failed to fetch group roles")
+		return
+	}
+
+	roles := make([]GroupRoleResponse, len(result.Roles))
+	for i, role := range result.Roles {
+		roles[i] = *h.convertToGroupRoleResponse(role)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GroupRoleListResponse{
+		Roles:                roles,
+		GrantablePermissions: permissionsToStrings(domain.GrantablePermissions),
+	})
+}
+
+// CreateGroupRole handles POST /groups/{id}/roles
+func (h *GroupHandler) CreateGroupRole(w http.ResponseWriter, r *http.Request) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateGroupRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	result, err := h.groupManagementUseCase.CreateGroupRole(r.Context(), &usecase.CreateGroupRoleRequest{
+		GroupID:     groupID,
+		UserID:      userUUID,
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: stringsToPermissions(req.Permissions),
+	})
+	if err != nil {
+		h.writeGroupRoleError(w, err, "role_creation_failed", "Failed to create group role")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToGroupRoleResponse(result))
+}
+
+// UpdateGroupRole handles PUT /groups/{id}/roles/{roleId}
+func (h *GroupHandler) UpdateGroupRole(w http.ResponseWriter, r *http.Request) {
+	groupID, roleID, ok := h.parseGroupRolePath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateGroupRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	var permissions []domain.Permission
+	if req.Permissions != nil {
+		permissions = stringsToPermissions(req.Permissions)
+	}
+
+	result, err := h.groupManagementUseCase.UpdateGroupRole(r.Context(), &usecase.UpdateGroupRoleRequest{
+		GroupID:     groupID,
+		RoleID:      roleID,
+		UserID:      userUUID,
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: permissions,
+	})
+	if err != nil {
+		h.writeGroupRoleError(w, err, "role_update_failed", "Failed to update group role")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToGroupRoleResponse(result))
+}
+
+// DeleteGroupRole handles DELETE /groups/{id}/roles/{roleId}
+func (h *GroupHandler) DeleteGroupRole(w http.ResponseWriter, r *http.Request) {
+	groupID, roleID, ok := h.parseGroupRolePath(w, r)
+	if !ok {
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.groupManagementUseCase.DeleteGroupRole(r.Context(), &usecase.DeleteGroupRoleRequest{
+		GroupID: groupID,
+		RoleID:  roleID,
+		UserID:  userUUID,
+	})
+	if err != nil {
+		h.writeGroupRoleError(w, err, "role_deletion_failed", "Failed to delete group role")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Group role deleted successfully",
+	})
+}
+
+// AssignGroupRole handles PUT /groups/{id}/members/{userId}/custom-role
+func (h *GroupHandler) AssignGroupRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return
+	}
+
+	targetUserID, err := uuid.Parse(vars["userId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AssignGroupRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	var roleID *uuid.UUID
+	if req.RoleID != nil {
+		parsedRoleID, err := uuid.Parse(*req.RoleID)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_role_id", "Invalid role ID")
+			return
+		}
+		roleID = &parsedRoleID
+	}
+
+	err = h.groupManagementUseCase.AssignGroupRole(r.Context(), &usecase.AssignGroupRoleRequest{
+		GroupID:    groupID,
+		UserID:     targetUserID,
+		AssignerID: userUUID,
+		RoleID:     roleID,
+	})
+	if err != nil {
+		h.writeGroupRoleError(w, err, "role_assignment_failed", "Failed to assign group role")
+		return
+	}
+
+	message := "Group role assigned successfully"
+	if roleID == nil {
+		message = "Group role cleared successfully"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": message,
+	})
+}
+
+// parseGroupRolePath parses the group and role IDs from the request path
+func (h *GroupHandler) parseGroupRolePath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	vars := mux.Vars(r)
+
+	groupID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	roleID, err := uuid.Parse(vars["roleId"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_role_id", "Invalid role ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return groupID, roleID, true
+}
+
+// writeGroupRoleError maps custom role use case errors to HTTP responses
+func (h *GroupHandler) writeGroupRoleError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrGroupNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+	case usecase.ErrCustomRoleNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "role_not_found", "Group role not found")
+	case usecase.ErrUserNotGroupMember:
+		h.writeErrorResponse(w, http.StatusNotFound, "member_not_found", "User is not a member of this group")
+	case usecase.ErrUnauthorizedGroupAccess, usecase.ErrInsufficientPermissions:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can manage group roles")
+	case usecase.ErrCustomRoleNameTaken, usecase.ErrTooManyCustomRoles:
+		h.writeErrorResponse(w, http.StatusConflict, "role_conflict", err.Error())
+	case domain.ErrEmptyCustomRoleName, domain.ErrCustomRoleNameTooLong, domain.ErrReservedCustomRoleName,
+		domain.ErrCustomRoleDescriptionTooLong, domain.ErrEmptyCustomRolePermissions,
+		domain.ErrInvalidCustomRolePermission, domain.ErrDuplicateCustomRolePermission:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// convertToGroupRoleResponse converts a domain custom role to response format
+func (h *GroupHandler) convertToGroupRoleResponse(role *domain.GroupCustomRole) *GroupRoleResponse {
+	return &GroupRoleResponse{
+		ID:          role.ID.String(),
+		GroupID:     role.GroupID.String(),
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissionsToStrings(role.Permissions),
+		CreatedAt:   role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// stringsToPermissions converts requested permission names to domain permissions
+func stringsToPermissions(values []string) []domain.Permission {
+	permissions := make([]domain.Permission, len(values))
+	for i, value := range values {
+		permissions[i] = domain.Permission(value)
+	}
+	return permissions
+}
+
+// permissionsToStrings converts domain permissions to their names for a response
+func permissionsToStrings(permissions []domain.Permission) []string {
+	values := make([]string, len(permissions))
+	for i, permission := range permissions {
+		values[i] = string(permission)
+	}
+	return values
+}
@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// GroupWebhookHandler handles group Discord webhook HTTP requests
+type GroupWebhookHandler struct {
+	groupWebhookUseCase *usecase.GroupWebhookManagementUseCase
+}
+
+// SetGroupWebhookRequest represents the webhook registration request payload
+type SetGroupWebhookRequest struct {
+	URL      string `json:"url" validate:"required,url"`
+	Locale   string `json:"locale,omitempty" validate:"omitempty,oneof=en pt"`
+	Timezone string `json:"timezone,omitempty"`
+	IsActive *bool  `json:"is_active,omitempty"`
+}
+
+// GroupWebhookResponse represents a group's webhook configuration
+type GroupWebhookResponse struct {
+	GroupID         string `json:"group_id"`
+	URL             string `json:"url"`
+	Locale          string `json:"locale"`
+	Timezone        string `json:"timezone"`
+	IsActive        bool   `json:"is_active"`
+	LastDeliveredAt string `json:"last_delivered_at,omitempty"`
+	LastError       string `json:"last_error,omitempty"`
+	CreatedAt       string `json:"created_at"`
+	UpdatedAt       string `json:"updated_at"`
+}
+
+// NewGroupWebhookHandler creates a new group webhook handler
+func NewGroupWebhookHandler(groupWebhookUseCase *usecase.GroupWebhookManagementUseCase) *GroupWebhookHandler {
+	return &GroupWebhookHandler{
+		groupWebhookUseCase: groupWebhookUseCase,
+	}
+}
+
+// SetWebhook handles PUT /groups/{id}/discord-webhook
+func (h *GroupWebhookHandler) SetWebhook(w http.ResponseWriter, r *http.Request) {
+	groupID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var req SetGroupWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	result, err := h.groupWebhookUseCase.SetWebhook(r.Context(), &usecase.SetGroupWebhookRequest{
+		GroupID:  groupID,
+		UserID:   userID,
+		URL:      req.URL,
+		Locale:   req.Locale,
+		Timezone: req.Timezone,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can manage integrations")
+		case domain.ErrInvalidWebhookURL, domain.ErrInvalidLocale, domain.ErrInvalidWebhookTimezone:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "webhook_update_failed", "Failed to save webhook")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToWebhookResponse(result.Webhook))
+}
+
+// GetWebhook handles GET /groups/{id}/discord-webhook
+func (h *GroupWebhookHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	groupID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.groupWebhookUseCase.GetWebhook(r.Context(), &usecase.GetGroupWebhookRequest{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrGroupWebhookNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "webhook_not_found", "No webhook configured for this group")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can manage integrations")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "webhook_retrieval_failed", "Failed to get webhook")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToWebhookResponse(result.Webhook))
+}
+
+// DeleteWebhook handles DELETE /groups/{id}/discord-webhook
+func (h *GroupWebhookHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	groupID, userID, ok := h.parseRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.groupWebhookUseCase.DeleteWebhook(r.Context(), &usecase.DeleteGroupWebhookRequest{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		switch err {
+		case usecase.ErrGroupNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "group_not_found", "Group not found")
+		case usecase.ErrGroupWebhookNotFound:
+			h.writeErrorResponse(w, http.StatusNotFound, "webhook_not_found", "No webhook configured for this group")
+		case usecase.ErrUnauthorizedGroupAccess:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only group owner or admin can manage integrations")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "webhook_deletion_failed", "Failed to delete webhook")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]string{
+		"message": "Webhook successfully removed",
+	})
+}
+
+// parseRequest extracts the group ID and authenticated user ID from the request
+func (h *GroupWebhookHandler) parseRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	groupID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_group_id", "Invalid group ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return groupID, userUUID, true
+}
+
+// convertToWebhookResponse converts a domain webhook, masking its secret token
+func (h *GroupWebhookHandler) convertToWebhookResponse(webhook *domain.GroupWebhook) *GroupWebhookResponse {
+	response := &GroupWebhookResponse{
+		GroupID:   webhook.GroupID.String(),
+		URL:       webhook.MaskedURL(),
+		Locale:    webhook.Locale,
+		Timezone:  webhook.Timezone,
+		IsActive:  webhook.IsActive,
+		CreatedAt: webhook.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: webhook.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if webhook.LastDeliveredAt != nil {
+		response.LastDeliveredAt = webhook.LastDeliveredAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if webhook.LastError != nil {
+		response.LastError = *webhook.LastError
+	}
+
+	return response
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *GroupWebhookHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers group webhook routes with the given router
+func (h *GroupWebhookHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	protected := router.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+
+	protected.HandleFunc("/groups/{id}/discord-webhook", h.GetWebhook).Methods("GET")
+	protected.HandleFunc("/groups/{id}/discord-webhook", h.SetWebhook).Methods("PUT")
+	protected.HandleFunc("/groups/{id}/discord-webhook", h.DeleteWebhook).Methods("DELETE")
+}
@@ -41,6 +41,11 @@ func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 	return args.Get(0).(*domain.User), args.Error(1)
 }
 
+func (m *MockUserRepository) UpdateLastLogin(ctx context.Context, userID uuid.UUID, loginTime time.Time) error {
+	args := m.Called(ctx, userID, loginTime)
+	return args.Error(0)
+}
+
 // MockPasswordService is a mock implementation of PasswordService
 type MockPasswordService struct {
 	mock.Mock
@@ -74,6 +79,14 @@ func (m *MockJWTService) GenerateTokenPair(userID, email string) (*service.Token
 	return args.Get(0).(*service.TokenPair), args.Error(1)
 }
 
+func (m *MockJWTService) GenerateTokenPairWithRole(userID, email, role string) (*service.TokenPair, error) {
+	args := m.Called(userID, email, role)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.TokenPair), args.Error(1)
+}
+
 func (m *MockJWTService) ValidateAccessToken(token string) (*service.TokenClaims, error) {
 	args := m.Called(token)
 	if args.Get(0) == nil {
@@ -147,6 +160,16 @@ func (m *MockRegisterUserUseCase) Execute(ctx context.Context, req *usecase.Regi
 	return args.Get(0).(*usecase.RegisterUserResponse), args.Error(1)
 }
 
+// MockAuditRecorder is a mock implementation of AuditRecorder
+type MockAuditRecorder struct {
+	mock.Mock
+}
+
+func (m *MockAuditRecorder) Record(ctx context.Context, entry *domain.AuditLogEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
 // TestAuthHandlerIntegration tests the authentication handler endpoints
 func TestAuthHandlerIntegration(t *testing.T) {
 	// Setup mocks
@@ -155,6 +178,7 @@ func TestAuthHandlerIntegration(t *testing.T) {
 	mockJWTService := &MockJWTService{}
 	mockOAuthService := &MockOAuthService{}
 	mockRegisterUseCase := &MockRegisterUserUseCase{}
+	mockAuditRecorder := &MockAuditRecorder{}
 
 	// Create handler
 	authHandler := NewAuthHandler(
@@ -163,6 +187,7 @@ func TestAuthHandlerIntegration(t *testing.T) {
 		mockOAuthService,
 		mockPasswordService,
 		mockUserRepo,
+		mockAuditRecorder,
 	)
 
 	// Setup router
@@ -250,7 +275,11 @@ func TestAuthHandlerIntegration(t *testing.T) {
 			RefreshToken: "refresh_token",
 			ExpiresAt:    time.Now().Add(15 * time.Minute),
 		}
-		mockJWTService.On("GenerateTokenPair", user.ID.String(), user.Email).Return(tokenPair, nil)
+		mockJWTService.On("GenerateTokenPairWithRole", user.ID.String(), user.Email, string(user.PlatformRole)).Return(tokenPair, nil)
+		mockAuditRecorder.On("Record", mock.Anything, mock.MatchedBy(func(entry *domain.AuditLogEntry) bool {
+			return entry.Action == domain.AuditActionLogin && entry.TargetID == user.ID && *entry.ActorID == user.ID
+		})).Return(nil)
+		mockUserRepo.On("UpdateLastLogin", mock.Anything, user.ID, mock.AnythingOfType("time.Time")).Return(nil)
 
 		// Create request
 		reqBody := LoginRequest{
@@ -281,6 +310,42 @@ func TestAuthHandlerIntegration(t *testing.T) {
 		mockUserRepo.AssertExpectations(t)
 		mockPasswordService.AssertExpectations(t)
 		mockJWTService.AssertExpectations(t)
+		mockAuditRecorder.AssertExpectations(t)
+	})
+
+	t.Run("Login Wrong Password Is Audited", func(t *testing.T) {
+		// Reset mocks
+		mockUserRepo.ExpectedCalls = nil
+		mockPasswordService.ExpectedCalls = nil
+		mockAuditRecorder.ExpectedCalls = nil
+
+		user := &domain.User{
+			ID:           uuid.New(),
+			Email:        "test@example.com",
+			PasswordHash: "hashed_password",
+			IsActive:     true,
+		}
+
+		mockUserRepo.On("GetByEmail", mock.Anything, "test@example.com").Return(user, nil)
+		mockPasswordService.On("VerifyPassword", "WrongPass123!", "hashed_password").Return(false, nil)
+		mockAuditRecorder.On("Record", mock.Anything, mock.MatchedBy(func(entry *domain.AuditLogEntry) bool {
+			return entry.Action == domain.AuditActionLoginFailed && entry.ActorID == nil && *entry.SubjectUserID == user.ID
+		})).Return(nil)
+
+		reqBody := LoginRequest{
+			Email:    "test@example.com",
+			Password: "WrongPass123!",
+		}
+		body, _ := json.Marshal(reqBody)
+
+		req := httptest.NewRequest("POST", "/auth/login", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		mockAuditRecorder.AssertExpectations(t)
 	})
 
 	t.Run("Login Invalid Credentials", func(t *testing.T) {
@@ -361,6 +426,7 @@ func TestErrorHandling(t *testing.T) {
 		mockOAuthService,
 		mockPasswordService,
 		mockUserRepo,
+		nil,
 	)
 
 	// Setup router
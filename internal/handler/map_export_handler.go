@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// mapExportFormatKML picks KML over GeoJSON through the file extension of the export path
+const mapExportFormatKML = "kml"
+
+// MapExportHandler handles exporting venues and events for community maps
+type MapExportHandler struct {
+	mapExportUseCase *usecase.MapExportUseCase
+}
+
+// NewMapExportHandler creates a new map export handler
+func NewMapExportHandler(mapExportUseCase *usecase.MapExportUseCase) *MapExportHandler {
+	return &MapExportHandler{
+		mapExportUseCase: mapExportUseCase,
+	}
+}
+
+// ExportVenues handles GET /export/venues.geojson and /export/venues.kml with the venue search filters
+func (h *MapExportHandler) ExportVenues(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	searchReq := parseSearchVenuesRequest(query)
+	searchReq.Limit = parseMapExportLimit(query)
+
+	features, err := h.mapExportUseCase.ExportVenues(r.Context(), searchReq)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidVenueSort:
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_sort", err.Error())
+		case usecase.ErrInvalidSearchParams, usecase.ErrInvalidSearchRadius, domain.ErrInvalidCoordinates:
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_search", "Invalid search parameters")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "export_failed", "Failed to export venues")
+		}
+		return
+	}
+
+	h.writeExport(w, mux.Vars(r)["format"], "venues", features)
+}
+
+// ExportEvents handles GET /export/events.geojson and /export/events.kml with the event search filters.
+// Only upcoming public events are exported.
+func (h *MapExportHandler) ExportEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	searchReq := parseSearchEventsRequest(query)
+	searchReq.Limit = parseMapExportLimit(query)
+
+	features, err := h.mapExportUseCase.ExportEvents(r.Context(), searchReq)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidSearchParams, usecase.ErrInvalidSearchRadius, domain.ErrInvalidCoordinates:
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_search", "Invalid search parameters")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "export_failed", "Failed to export events")
+		}
+		return
+	}
+
+	h.writeExport(w, mux.Vars(r)["format"], "events", features)
+}
+
+// writeExport writes the features as a GeoJSON feature collection or a KML document
+func (h *MapExportHandler) writeExport(w http.ResponseWriter, format, name string, features []*usecase.MapExportFeature) {
+	fileName := "matchtcg-" + name + "." + format
+
+	switch format {
+	case mapExportFormatKML:
+		document := KMLDocument{
+			Xmlns: "http://www.opengis.net/kml/2.2",
+			Document: KMLContents{
+				Name:       "MatchTCG " + name,
+				Placemarks: make([]KMLPlacemark, len(features)),
+			},
+		}
+		for i, feature := range features {
+			document.Document.Placemarks[i] = h.convertToPlacemark(feature)
+		}
+
+		w.Header().Set("Content-Type", "application/vnd.google-earth.kml+xml")
+		w.Header().Set("Content-Disposition", "inline; filename=\""+fileName+"\"")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(xml.Header))
+		xml.NewEncoder(w).Encode(document)
+	default:
+		collection := GeoJSONFeatureCollection{
+			Type:     "FeatureCollection",
+			Features: make([]GeoJSONFeature, len(features)),
+		}
+		for i, feature := range features {
+			collection.Features[i] = GeoJSONFeature{
+				Type:       "Feature",
+				ID:         feature.ID.String(),
+				Geometry:   newGeoJSONPoint(feature.Coordinates.Latitude, feature.Coordinates.Longitude),
+				Properties: mapExportProperties(feature),
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/geo+json")
+		w.Header().Set("Content-Disposition", "inline; filename=\""+fileName+"\"")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(collection)
+	}
+}
+
+// convertToPlacemark converts an exported feature to a KML placemark, keeping its properties as
+// extended data
+func (h *MapExportHandler) convertToPlacemark(feature *usecase.MapExportFeature) KMLPlacemark {
+	properties := mapExportProperties(feature)
+
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	data := make([]KMLData, len(keys))
+	for i, key := range keys {
+		data[i] = KMLData{Name: key, Value: fmt.Sprint(properties[key])}
+	}
+
+	venue := feature.Venue
+	description := strings.Join([]string{venue.Address, venue.City, venue.Country}, ", ")
+	if feature.Event != nil {
+		description = feature.Event.StartAt.Format(time.RFC1123) + " at " + venue.Name + ", " + description
+	}
+
+	return KMLPlacemark{
+		ID:           fmt.Sprint(properties["kind"], "-", feature.ID), // XML ids can't start with a digit
+		Name:         feature.Name,
+		Description:  description,
+		ExtendedData: &KMLExtendedData{Data: data},
+		Point:        newKMLPoint(feature.Coordinates.Latitude, feature.Coordinates.Longitude),
+	}
+}
+
+// mapExportProperties lists the properties of an exported venue or event
+func mapExportProperties(feature *usecase.MapExportFeature) map[string]interface{} {
+	venue := feature.Venue
+	properties := map[string]interface{}{
+		"name":     feature.Name,
+		"address":  venue.Address,
+		"city":     venue.City,
+		"country":  venue.Country,
+		"verified": venue.IsVerified(),
+	}
+
+	if feature.Event == nil {
+		properties["kind"] = "venue"
+		properties["venue_type"] = string(venue.Type)
+		properties["rating_count"] = venue.RatingCount
+		if venue.RatingCount > 0 {
+			properties["rating_average"] = venue.RatingAverage
+		}
+		return properties
+	}
+
+	event := feature.Event
+	properties["kind"] = "event"
+	properties["game"] = string(event.Game)
+	properties["start_at"] = event.StartAt.Format(time.RFC3339)
+	properties["end_at"] = event.EndAt.Format(time.RFC3339)
+	properties["timezone"] = event.Timezone
+	properties["venue_id"] = venue.ID.String()
+	properties["venue_name"] = venue.Name
+	properties["is_official"] = event.IsOfficial
+	if event.Format != nil {
+		properties["format"] = *event.Format
+	}
+	return properties
+}
+
+// parseMapExportLimit parses the optional export size; without one the whole export is returned up to
+// the export cap
+func parseMapExportLimit(query url.Values) int {
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 || limit > usecase.MaxMapExportFeatures {
+		return usecase.MaxMapExportFeatures
+	}
+	return limit
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *MapExportHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers map export routes with the given router
+func (h *MapExportHandler) RegisterRoutes(router *mux.Router) {
+	// Exports are public; events are limited to public ones
+	router.HandleFunc("/export/venues.{format:geojson|kml}", h.ExportVenues).Methods("GET")
+	router.HandleFunc("/export/events.{format:geojson|kml}", h.ExportEvents).Methods("GET")
+}
@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// MapHandler handles map clustering of venues and events
+type MapHandler struct {
+	mapClustersUseCase *usecase.MapClustersUseCase
+}
+
+// NewMapHandler creates a new map handler
+func NewMapHandler(mapClustersUseCase *usecase.MapClustersUseCase) *MapHandler {
+	return &MapHandler{
+		mapClustersUseCase: mapClustersUseCase,
+	}
+}
+
+// GetMapClusters handles GET /map/clusters?bbox=west,south,east,north&zoom=7
+func (h *MapHandler) GetMapClusters(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	bounds, ok := parseBoundingBox(query.Get("bbox"))
+	if !ok {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_bbox", "bbox must be west,south,east,north")
+		return
+	}
+
+	zoom, err := strconv.Atoi(query.Get("zoom"))
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_zoom", "zoom must be a number between 0 and 20")
+		return
+	}
+
+	req := &usecase.GetMapClustersRequest{
+		Bounds: bounds,
+		Zoom:   zoom,
+	}
+
+	if game := query.Get("game"); game != "" {
+		gameType := domain.GameType(game)
+		req.Game = &gameType
+	}
+
+	if layers := query.Get("layers"); layers != "" {
+		for _, layer := range strings.Split(layers, ",") {
+			req.Layers = append(req.Layers, domain.MapClusterLayer(strings.TrimSpace(layer)))
+		}
+	}
+
+	result, err := h.mapClustersUseCase.GetMapClusters(r.Context(), req)
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidMapZoom, domain.ErrInvalidMapBounds, domain.ErrMapBoundsTooLarge,
+			domain.ErrInvalidMapLayer, domain.ErrInvalidCoordinates, domain.ErrInvalidGameType:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "map_clusters_failed", "Failed to cluster map")
+		}
+		return
+	}
+
+	features := make([]GeoJSONFeature, 0, len(result.Venues)+len(result.Events))
+	for _, cluster := range result.Venues {
+		features = append(features, h.convertToClusterFeature(cluster))
+	}
+	for _, cluster := range result.Events {
+		features = append(features, h.convertToClusterFeature(cluster))
+	}
+
+	w.Header().Set("Content-Type", "application/geo+json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: features,
+	})
+}
+
+// convertToClusterFeature converts a map cluster to a GeoJSON point feature
+func (h *MapHandler) convertToClusterFeature(cluster *domain.MapCluster) GeoJSONFeature {
+	games := make(map[string]int, len(cluster.Games))
+	for game, count := range cluster.Games {
+		games[string(game)] = count
+	}
+
+	return GeoJSONFeature{
+		Type: "Feature",
+		ID:   string(cluster.Layer) + "/" + cluster.ID,
+		BBox: []float64{
+			cluster.Bounds.SouthWest.Longitude,
+			cluster.Bounds.SouthWest.Latitude,
+			cluster.Bounds.NorthEast.Longitude,
+			cluster.Bounds.NorthEast.Latitude,
+		},
+		Geometry: newGeoJSONPoint(cluster.Latitude, cluster.Longitude),
+		Properties: map[string]interface{}{
+			"cluster_id": cluster.ID,
+			"layer":      cluster.Layer,
+			"count":      cluster.Count,
+			"games":      games,
+		},
+	}
+}
+
+// parseBoundingBox parses a "west,south,east,north" bounding box, the order GeoJSON uses
+func parseBoundingBox(bbox string) (domain.BoundingBox, bool) {
+	parts := strings.Split(bbox, ",")
+	if len(parts) != 4 {
+		return domain.BoundingBox{}, false
+	}
+
+	values := make([]float64, len(parts))
+	for i, part := range parts {
+		value, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return domain.BoundingBox{}, false
+		}
+		values[i] = value
+	}
+
+	return domain.BoundingBox{
+		SouthWest: domain.Coordinates{Latitude: values[1], Longitude: values[0]},
+		NorthEast: domain.Coordinates{Latitude: values[3], Longitude: values[2]},
+	}, true
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *MapHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers map routes with the given router
+func (h *MapHandler) RegisterRoutes(router *mux.Router) {
+	// Map clusters are public like venue and event search
+	router.HandleFunc("/map/clusters", h.GetMapClusters).Methods("GET")
+}
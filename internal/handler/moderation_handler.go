@@ -0,0 +1,381 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// ModerationHandler handles content reports and the platform moderation queue
+type ModerationHandler struct {
+	contentModerationUseCase *usecase.ContentModerationUseCase
+}
+
+// CreateReportRequest represents the report request payload
+type CreateReportRequest struct {
+	TargetType string  `json:"target_type" validate:"required,oneof=event group venue user"`
+	TargetID   string  `json:"target_id" validate:"required,uuid"`
+	Reason     string  `json:"reason" validate:"required"`
+	Details    *string `json:"details,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ResolveReportRequest represents a moderator's decision on a report
+type ResolveReportRequest struct {
+	Status string  `json:"status" validate:"required,oneof=resolved dismissed"`
+	Action *string `json:"action,omitempty"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ReportResponse represents a content report
+type ReportResponse struct {
+	ID             string  `json:"id"`
+	ReporterID     string  `json:"reporter_id"`
+	TargetType     string  `json:"target_type"`
+	TargetID       string  `json:"target_id"`
+	Reason         string  `json:"reason"`
+	Details        *string `json:"details,omitempty"`
+	Status         string  `json:"status"`
+	ResolvedBy     *string `json:"resolved_by,omitempty"`
+	ResolvedAt     *string `json:"resolved_at,omitempty"`
+	ResolutionNote *string `json:"resolution_note,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+// ReportListResponse represents a page of the moderation queue
+type ReportListResponse struct {
+	Reports []ReportResponse `json:"reports"`
+	Total   int              `json:"total"`
+	Limit   int              `json:"limit"`
+	Offset  int              `json:"offset"`
+}
+
+// ContentModerationActionResponse represents an entry in the platform moderation audit log
+type ContentModerationActionResponse struct {
+	ID          string  `json:"id"`
+	ActorUserID string  `json:"actor_user_id"`
+	Action      string  `json:"action"`
+	TargetType  string  `json:"target_type"`
+	TargetID    string  `json:"target_id"`
+	ReportID    *string `json:"report_id,omitempty"`
+	Note        *string `json:"note,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+// ContentModerationLogResponse represents a page of the platform moderation audit log
+type ContentModerationLogResponse struct {
+	Actions []ContentModerationActionResponse `json:"actions"`
+	Total   int                               `json:"total"`
+	Limit   int                               `json:"limit"`
+	Offset  int                               `json:"offset"`
+}
+
+// ResolveReportResponse represents a closed report and the action taken on it
+type ResolveReportResponse struct {
+	Report ReportResponse                   `json:"report"`
+	Action *ContentModerationActionResponse `json:"action,omitempty"`
+}
+
+// NewModerationHandler creates a new moderation handler
+func NewModerationHandler(contentModerationUseCase *usecase.ContentModerationUseCase) *ModerationHandler {
+	return &ModerationHandler{
+		contentModerationUseCase: contentModerationUseCase,
+	}
+}
+
+// CreateReport handles POST /reports
+func (h *ModerationHandler) CreateReport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	targetID, err := uuid.Parse(req.TargetID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_target_id", "Invalid target ID")
+		return
+	}
+
+	report, err := h.contentModerationUseCase.ReportContent(r.Context(), &usecase.ReportContentRequest{
+		ReporterID: userID,
+		TargetType: domain.ReportTargetType(req.TargetType),
+		TargetID:   targetID,
+		Reason:     domain.ReportReason(req.Reason),
+		Details:    req.Details,
+	})
+	if err != nil {
+		h.writeModerationError(w, err, "report_failed", "Failed to submit report")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToReportResponse(report))
+}
+
+// ListReports handles GET /admin/reports
+func (h *ModerationHandler) ListReports(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req := &usecase.ListReportsRequest{
+		ModeratorID: userID,
+		Status:      domain.ReportStatus(r.URL.Query().Get("status")),
+	}
+	if targetType := r.URL.Query().Get("target_type"); targetType != "" {
+		reportTarget := domain.ReportTargetType(targetType)
+		req.TargetType = &reportTarget
+	}
+	req.Limit, req.Offset = parseModerationPage(r)
+
+	result, err := h.contentModerationUseCase.ListReports(r.Context(), req)
+	if err != nil {
+		h.writeModerationError(w, err, "reports_fetch_failed", "Failed to fetch reports")
+		return
+	}
+
+	reports := make([]ReportResponse, len(result.Reports))
+	for i, report := range result.Reports {
+		reports[i] = *h.convertToReportResponse(report)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ReportListResponse{
+		Reports: reports,
+		Total:   len(reports),
+		Limit:   result.Limit,
+		Offset:  result.Offset,
+	})
+}
+
+// GetReport handles GET /admin/reports/{id}
+func (h *ModerationHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	reportID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_report_id", "Invalid report ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.contentModerationUseCase.GetReport(r.Context(), &usecase.GetReportRequest{
+		ReportID:    reportID,
+		ModeratorID: userID,
+	})
+	if err != nil {
+		h.writeModerationError(w, err, "report_fetch_failed", "Failed to fetch report")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToReportResponse(report))
+}
+
+// ResolveReport handles POST /admin/reports/{id}/resolve
+func (h *ModerationHandler) ResolveReport(w http.ResponseWriter, r *http.Request) {
+	reportID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_report_id", "Invalid report ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ResolveReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	resolveReq := &usecase.ResolveReportRequest{
+		ReportID:    reportID,
+		ModeratorID: userID,
+		Status:      domain.ReportStatus(req.Status),
+		Note:        req.Note,
+	}
+	if req.Action != nil {
+		action := domain.ContentModerationActionType(*req.Action)
+		resolveReq.Action = &action
+	}
+
+	result, err := h.contentModerationUseCase.ResolveReport(r.Context(), resolveReq)
+	if err != nil {
+		h.writeModerationError(w, err, "report_resolve_failed", "Failed to resolve report")
+		return
+	}
+
+	response := ResolveReportResponse{
+		Report: *h.convertToReportResponse(result.Report),
+	}
+	if result.Action != nil {
+		response.Action = h.convertToActionResponse(result.Action)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListModerationActions handles GET /admin/moderation-actions
+func (h *ModerationHandler) ListModerationActions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := parseModerationPage(r)
+	result, err := h.contentModerationUseCase.ListModerationActions(r.Context(), &usecase.ListModerationActionsRequest{
+		ModeratorID: userID,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		h.writeModerationError(w, err, "moderation_log_fetch_failed", "Failed to fetch moderation log")
+		return
+	}
+
+	actions := make([]ContentModerationActionResponse, len(result.Actions))
+	for i, action := range result.Actions {
+		actions[i] = *h.convertToActionResponse(action)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ContentModerationLogResponse{
+		Actions: actions,
+		Total:   len(actions),
+		Limit:   result.Limit,
+		Offset:  result.Offset,
+	})
+}
+
+// writeModerationError maps content moderation use case errors to HTTP responses
+func (h *ModerationHandler) writeModerationError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrPlatformModeratorOnly:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only platform moderators can review reports")
+	case usecase.ErrInsufficientPermissions:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Platform staff cannot be deactivated from the moderation queue")
+	case usecase.ErrReportNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "report_not_found", "Report not found")
+	case usecase.ErrReportTargetNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "target_not_found", "Reported content not found")
+	case usecase.ErrDuplicateReport:
+		h.writeErrorResponse(w, http.StatusConflict, "duplicate_report", err.Error())
+	case domain.ErrReportAlreadyClosed:
+		h.writeErrorResponse(w, http.StatusConflict, "report_closed", err.Error())
+	case domain.ErrInvalidReportTarget, domain.ErrInvalidReportReason, domain.ErrInvalidReportStatus,
+		domain.ErrReportDetailsTooLong, domain.ErrReportDetailsRequired, domain.ErrCannotReportSelf,
+		domain.ErrResolutionNoteTooLong, domain.ErrInvalidContentAction, domain.ErrContentActionTargetMismatch,
+		usecase.ErrModerationActionDismissed:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *ModerationHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToReportResponse converts a domain report to response format
+func (h *ModerationHandler) convertToReportResponse(report *domain.Report) *ReportResponse {
+	response := &ReportResponse{
+		ID:             report.ID.String(),
+		ReporterID:     report.ReporterID.String(),
+		TargetType:     string(report.TargetType),
+		TargetID:       report.TargetID.String(),
+		Reason:         string(report.Reason),
+		Details:        report.Details,
+		Status:         string(report.Status),
+		ResolvedAt:     formatOptionalTime(report.ResolvedAt),
+		ResolutionNote: report.ResolutionNote,
+		CreatedAt:      report.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if report.ResolvedBy != nil {
+		resolvedBy := report.ResolvedBy.String()
+		response.ResolvedBy = &resolvedBy
+	}
+
+	return response
+}
+
+// convertToActionResponse converts a domain moderation action to response format
+func (h *ModerationHandler) convertToActionResponse(action *domain.ContentModerationAction) *ContentModerationActionResponse {
+	response := &ContentModerationActionResponse{
+		ID:          action.ID.String(),
+		ActorUserID: action.ActorUserID.String(),
+		Action:      string(action.Action),
+		TargetType:  string(action.TargetType),
+		TargetID:    action.TargetID.String(),
+		Note:        action.Note,
+		CreatedAt:   action.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if action.ReportID != nil {
+		reportID := action.ReportID.String()
+		response.ReportID = &reportID
+	}
+
+	return response
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *ModerationHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers content reporting and moderation queue routes with the given router
+func (h *ModerationHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	protected := router.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+
+	// Content reports
+	protected.HandleFunc("/reports", h.CreateReport).Methods("POST")
+
+	// Platform moderation queue
+	protected.HandleFunc("/admin/reports", h.ListReports).Methods("GET")
+	protected.HandleFunc("/admin/reports/{id}", h.GetReport).Methods("GET")
+	protected.HandleFunc("/admin/reports/{id}/resolve", h.ResolveReport).Methods("POST")
+	protected.HandleFunc("/admin/moderation-actions", h.ListModerationActions).Methods("GET")
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// RetentionHandler handles data retention HTTP requests
+type RetentionHandler struct {
+	dataRetentionUseCase *usecase.DataRetentionUseCase
+}
+
+// RetentionResultResponse represents the outcome of one retention policy
+type RetentionResultResponse struct {
+	Entity   string  `json:"entity"`
+	Action   string  `json:"action"`
+	Cutoff   string  `json:"cutoff"`
+	Affected int     `json:"affected"`
+	Error    *string `json:"error,omitempty"`
+}
+
+// RetentionReportResponse represents a retention run report
+type RetentionReportResponse struct {
+	DryRun        bool                      `json:"dry_run"`
+	StartedAt     string                    `json:"started_at"`
+	CompletedAt   string                    `json:"completed_at"`
+	TotalAffected int                       `json:"total_affected"`
+	Results       []RetentionResultResponse `json:"results"`
+}
+
+// NewRetentionHandler creates a new data retention handler
+func NewRetentionHandler(dataRetentionUseCase *usecase.DataRetentionUseCase) *RetentionHandler {
+	return &RetentionHandler{
+		dataRetentionUseCase: dataRetentionUseCase,
+	}
+}
+
+// GetRetentionReport handles GET /admin/retention/report
+func (h *RetentionHandler) GetRetentionReport(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.dataRetentionUseCase.GetRetentionReport(r.Context(), &usecase.GetRetentionReportRequest{AdminID: adminID})
+	if err != nil {
+		switch err {
+		case usecase.ErrPlatformAdminOnly:
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Admin access required")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "retention_report_failed", "Failed to build retention report")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToRetentionReportResponse(report))
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *RetentionHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToRetentionReportResponse converts a domain retention report to the response format
+func (h *RetentionHandler) convertToRetentionReportResponse(report *domain.RetentionReport) *RetentionReportResponse {
+	results := make([]RetentionResultResponse, len(report.Results))
+	for i, result := range report.Results {
+		results[i] = RetentionResultResponse{
+			Entity:   string(result.Entity),
+			Action:   string(result.Action),
+			Cutoff:   result.Cutoff.Format("2006-01-02T15:04:05Z07:00"),
+			Affected: result.Affected,
+			Error:    result.Error,
+		}
+	}
+
+	return &RetentionReportResponse{
+		DryRun:        report.DryRun,
+		StartedAt:     report.StartedAt.Format("2006-01-02T15:04:05Z07:00"),
+		CompletedAt:   report.CompletedAt.Format("2006-01-02T15:04:05Z07:00"),
+		TotalAffected: report.TotalAffected(),
+		Results:       results,
+	}
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *RetentionHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers data retention routes with the given router
+func (h *RetentionHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	// Admins can preview what the retention job would remove
+	admin := router.PathPrefix("/admin").Subrouter()
+	admin.Use(authMiddleware.RequireAuth)
+	admin.Use(authMiddleware.RequireAdmin)
+
+	admin.HandleFunc("/retention/report", h.GetRetentionReport).Methods("GET")
+}
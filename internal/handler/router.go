@@ -15,19 +15,36 @@ import (
 // RouterConfig holds all dependencies needed to create the router
 type RouterConfig struct {
 	// Use cases
-	RegisterUserUseCase    *usecase.RegisterUserUseCase
-	UpdateProfileUseCase   *usecase.UpdateProfileUseCase
-	GetUserProfileUseCase  *usecase.GetUserProfileUseCase
-	GDPRComplianceUseCase  *usecase.GDPRComplianceUseCase
-	EventManagementUseCase *usecase.EventManagementUseCase
-	GroupManagementUseCase *usecase.GroupManagementUseCase
-	VenueManagementUseCase *usecase.VenueManagementUseCase
+	RegisterUserUseCase     *usecase.RegisterUserUseCase
+	UpdateProfileUseCase    *usecase.UpdateProfileUseCase
+	GetUserProfileUseCase   *usecase.GetUserProfileUseCase
+	GDPRComplianceUseCase   *usecase.GDPRComplianceUseCase
+	ChangePasswordUseCase   *usecase.ChangePasswordUseCase
+	BlockUserUseCase        *usecase.BlockUserUseCase
+	UnblockUserUseCase      *usecase.UnblockUserUseCase
+	ListBlockedUsersUseCase *usecase.ListBlockedUsersUseCase
+	EventManagementUseCase  *usecase.EventManagementUseCase
+	GroupManagementUseCase  *usecase.GroupManagementUseCase
+	VenueManagementUseCase  *usecase.VenueManagementUseCase
+
+	GroupWebhookManagementUseCase *usecase.GroupWebhookManagementUseCase
+	ContentModerationUseCase      *usecase.ContentModerationUseCase
+	PlatformAdminUseCase          *usecase.PlatformAdminUseCase
+	AuditLogUseCase               *usecase.AuditLogUseCase
+	DataExportUseCase             *usecase.DataExportUseCase
+	DataRetentionUseCase          *usecase.DataRetentionUseCase
+	VenueClaimsUseCase            *usecase.VenueClaimsUseCase
+	VenueDuplicatesUseCase        *usecase.VenueDuplicatesUseCase
+	VenueReviewsUseCase           *usecase.VenueReviewsUseCase
+	MapClustersUseCase            *usecase.MapClustersUseCase
+	MapExportUseCase              *usecase.MapExportUseCase
 
 	// Services
 	JWTService      *service.JWTService
 	OAuthService    *service.OAuthService
 	PasswordService *service.PasswordService
 	CalendarService *service.CalendarService
+	AuditService    *service.AuditService
 
 	// Repositories (for handlers that need direct access)
 	UserRepository  repository.UserRepository
@@ -48,6 +65,7 @@ func NewRouter(config RouterConfig) *mux.Router {
 	router := mux.NewRouter()
 
 	// Apply global middleware
+	router.Use(config.LoggingMiddleware.RequestID)
 	router.Use(config.LoggingMiddleware.Logging)
 	router.Use(config.SecurityMiddleware.SecurityHeaders)
 	router.Use(config.CORSMiddleware.CORS)
@@ -63,12 +81,17 @@ func NewRouter(config RouterConfig) *mux.Router {
 		config.OAuthService,
 		config.PasswordService,
 		config.UserRepository,
+		config.AuditService,
 	)
 
 	userHandler := NewUserHandler(
 		config.UpdateProfileUseCase,
 		config.GetUserProfileUseCase,
 		config.GDPRComplianceUseCase,
+		config.ChangePasswordUseCase,
+		config.BlockUserUseCase,
+		config.UnblockUserUseCase,
+		config.ListBlockedUsersUseCase,
 	)
 
 	eventHandler := NewEventHandler(
@@ -79,10 +102,54 @@ func NewRouter(config RouterConfig) *mux.Router {
 		config.GroupManagementUseCase,
 	)
 
+	groupWebhookHandler := NewGroupWebhookHandler(
+		config.GroupWebhookManagementUseCase,
+	)
+
 	venueHandler := NewVenueHandler(
 		config.VenueManagementUseCase,
 	)
 
+	moderationHandler := NewModerationHandler(
+		config.ContentModerationUseCase,
+	)
+
+	adminHandler := NewAdminHandler(
+		config.PlatformAdminUseCase,
+	)
+
+	auditLogHandler := NewAuditLogHandler(
+		config.AuditLogUseCase,
+	)
+
+	dataExportHandler := NewDataExportHandler(
+		config.DataExportUseCase,
+	)
+
+	retentionHandler := NewRetentionHandler(
+		config.DataRetentionUseCase,
+	)
+
+	venueClaimHandler := NewVenueClaimHandler(
+		config.VenueClaimsUseCase,
+	)
+
+	venueDuplicateHandler := NewVenueDuplicateHandler(
+		config.VenueDuplicatesUseCase,
+	)
+
+	venueReviewHandler := NewVenueReviewHandler(
+		config.VenueReviewsUseCase,
+	)
+
+	mapHandler := NewMapHandler(
+		config.MapClustersUseCase,
+	)
+
+	mapExportHandler := NewMapExportHandler(
+		config.MapExportUseCase,
+	)
+
 	calendarHandler := NewCalendarHandler(
 		config.EventRepository,
 		config.CalendarService,
@@ -93,8 +160,19 @@ func NewRouter(config RouterConfig) *mux.Router {
 	userHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
 	eventHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
 	groupHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	groupWebhookHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
 	venueHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
 	calendarHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	moderationHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	adminHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	auditLogHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	dataExportHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	retentionHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	venueClaimHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	venueDuplicateHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	venueReviewHandler.RegisterRoutes(apiV1, config.AuthMiddleware)
+	mapHandler.RegisterRoutes(apiV1)
+	mapExportHandler.RegisterRoutes(apiV1)
 
 	// Health check endpoint
 	router.HandleFunc("/health", healthCheckHandler).Methods("GET")
@@ -135,38 +213,109 @@ func apiDocsHandler(w http.ResponseWriter, r *http.Request) {
 				"GET  /api/v1/auth/oauth/apple":  "Apple OAuth",
 			},
 			"user_management": map[string]string{
-				"GET    /api/v1/me":         "Get current user profile",
-				"PUT    /api/v1/me":         "Update current user profile",
-				"DELETE /api/v1/me":         "Delete user account",
-				"GET    /api/v1/me/export":  "Export user data (GDPR)",
-				"GET    /api/v1/users/{id}": "Get public user profile",
+				"GET    /api/v1/me":                    "Get current user profile",
+				"PUT    /api/v1/me":                    "Update current user profile",
+				"DELETE /api/v1/me":                    "Schedule account deletion after a grace period",
+				"GET    /api/v1/me/deletion":           "Scheduled account deletion",
+				"DELETE /api/v1/me/deletion":           "Cancel scheduled account deletion",
+				"GET    /api/v1/me/export":             "Export user data (GDPR)",
+				"POST   /api/v1/me/exports":            "Request data export archive",
+				"GET    /api/v1/me/exports/{id}":       "Data export archive status",
+				"GET    /api/v1/exports/{id}/download": "Download data export archive (signed link)",
+				"PUT    /api/v1/me/password":           "Change password",
+				"GET    /api/v1/me/consents":           "Current consents",
+				"GET    /api/v1/me/consents/history":   "Consent history",
+				"PUT    /api/v1/me/consents/{type}":    "Grant or revoke consent",
+				"GET    /api/v1/me/audit-log":          "Audit history of own account",
+				"GET    /api/v1/me/blocks":             "List blocked users",
+				"GET    /api/v1/users/{id}":            "Get public user profile",
+				"POST   /api/v1/users/{id}/block":      "Block user",
+				"DELETE /api/v1/users/{id}/block":      "Unblock user",
 			},
 			"event_management": map[string]string{
-				"POST   /api/v1/events":                "Create event",
-				"GET    /api/v1/events":                "Search events",
-				"GET    /api/v1/events/{id}":           "Get event details",
-				"PUT    /api/v1/events/{id}":           "Update event",
-				"DELETE /api/v1/events/{id}":           "Delete event",
-				"POST   /api/v1/events/{id}/rsvp":      "RSVP to event",
-				"GET    /api/v1/events/{id}/attendees": "Get event attendees",
+				"POST   /api/v1/events":                                  "Create event",
+				"GET    /api/v1/events":                                  "Search events",
+				"GET    /api/v1/events/{id}":                             "Get event details",
+				"PUT    /api/v1/events/{id}":                             "Update event",
+				"DELETE /api/v1/events/{id}":                             "Delete event",
+				"POST   /api/v1/events/{id}/rsvp":                        "RSVP to event",
+				"GET    /api/v1/events/{id}/attendees":                   "Get event attendees",
+				"POST   /api/v1/events/{id}/attendees/{userId}/check-in": "Check attendee in",
+				"DELETE /api/v1/events/{id}/attendees/{userId}/check-in": "Undo attendee check-in",
 			},
 			"group_management": map[string]string{
-				"POST   /api/v1/groups":                       "Create group",
-				"GET    /api/v1/groups/{id}":                  "Get group details",
-				"PUT    /api/v1/groups/{id}":                  "Update group",
-				"DELETE /api/v1/groups/{id}":                  "Delete group",
-				"POST   /api/v1/groups/{id}/members":          "Add group member",
-				"DELETE /api/v1/groups/{id}/members/{userId}": "Remove group member",
-				"PUT    /api/v1/groups/{id}/members/{userId}": "Update member role",
-				"GET    /api/v1/me/groups":                    "Get user's groups",
+				"GET    /api/v1/groups":                                        "Search public groups",
+				"POST   /api/v1/groups":                                        "Create group",
+				"GET    /api/v1/groups/{id}":                                   "Get group details",
+				"PUT    /api/v1/groups/{id}":                                   "Update group",
+				"DELETE /api/v1/groups/{id}":                                   "Delete group",
+				"POST   /api/v1/groups/{id}/members":                           "Invite group member",
+				"DELETE /api/v1/groups/{id}/members/{userId}":                  "Remove group member",
+				"PUT    /api/v1/groups/{id}/members/{userId}":                  "Update member role",
+				"GET    /api/v1/groups/{id}/discord-webhook":                   "Get group Discord webhook",
+				"PUT    /api/v1/groups/{id}/discord-webhook":                   "Register group Discord webhook",
+				"DELETE /api/v1/groups/{id}/discord-webhook":                   "Remove group Discord webhook",
+				"POST   /api/v1/groups/{id}/invite-links":                      "Create shareable invite link",
+				"GET    /api/v1/groups/{id}/invites":                           "List pending group invites",
+				"DELETE /api/v1/groups/{id}/invites/{inviteId}":                "Revoke group invite",
+				"POST   /api/v1/invites/accept":                                "Accept invite by token",
+				"POST   /api/v1/invites/{inviteId}/accept":                     "Accept group invite",
+				"POST   /api/v1/invites/{inviteId}/decline":                    "Decline group invite",
+				"POST   /api/v1/groups/{id}/join-requests":                     "Request to join public group",
+				"GET    /api/v1/groups/{id}/join-requests":                     "List pending join requests",
+				"POST   /api/v1/groups/{id}/join-requests/{requestId}/approve": "Approve join request",
+				"POST   /api/v1/groups/{id}/join-requests/{requestId}/reject":  "Reject join request",
+				"DELETE /api/v1/join-requests/{requestId}":                     "Cancel join request",
+				"GET    /api/v1/me/groups":                                     "Get user's groups",
+				"GET    /api/v1/me/invites":                                    "Get user's pending group invites",
+				"GET    /api/v1/me/join-requests":                              "Get user's group join requests",
 			},
 			"venue_management": map[string]string{
 				"POST   /api/v1/venues":      "Create venue",
-				"GET    /api/v1/venues":      "Search venues",
+				"GET    /api/v1/venues":      "Search venues (sort=rating for best rated first; min_seats, min_tables, playmats, accessible, food and wifi filter by amenities)",
 				"GET    /api/v1/venues/{id}": "Get venue details",
 				"PUT    /api/v1/venues/{id}": "Update venue",
 				"DELETE /api/v1/venues/{id}": "Delete venue",
 			},
+			"venue_claims": map[string]string{
+				"POST /api/v1/venues/{id}/claims":             "Claim ownership of a store venue",
+				"PUT  /api/v1/events/{id}/official":           "Mark event at a verified store as official (venue owner)",
+				"GET  /api/v1/admin/venue-claims":             "List venue claims (platform admins)",
+				"POST /api/v1/admin/venue-claims/{id}/review": "Approve or reject venue claim (platform admins)",
+			},
+			"venue_duplicates": map[string]string{
+				"GET  /api/v1/venues/{id}/duplicates":  "Suggest venues that likely duplicate a venue",
+				"POST /api/v1/admin/venues/{id}/merge": "Merge duplicate venue into another, keeping a redirect (platform admins)",
+			},
+			"venue_reviews": map[string]string{
+				"GET    /api/v1/venues/{id}/reviews":    "List venue reviews with the venue's rating",
+				"PUT    /api/v1/venues/{id}/reviews/me": "Rate a venue you attended an event at, replacing your earlier review",
+				"DELETE /api/v1/venues/{id}/reviews/me": "Delete your venue review",
+			},
+			"map": map[string]string{
+				"GET /api/v1/map/clusters":                "GeoJSON clusters of venues and upcoming events (bbox=west,south,east,north, zoom, optional game and layers)",
+				"GET /api/v1/export/venues.{geojson|kml}": "Export venues as GeoJSON or KML (same filters as venue search, limit up to 1000)",
+				"GET /api/v1/export/events.{geojson|kml}": "Export upcoming public events as GeoJSON or KML (same filters as event search, limit up to 1000)",
+			},
+			"content_moderation": map[string]string{
+				"POST /api/v1/reports":                    "Report an event, group, venue or user",
+				"GET  /api/v1/admin/reports":              "List moderation queue (platform moderators)",
+				"GET  /api/v1/admin/reports/{id}":         "Get report details (platform moderators)",
+				"POST /api/v1/admin/reports/{id}/resolve": "Resolve or dismiss report (platform moderators)",
+				"GET  /api/v1/admin/moderation-actions":   "Platform moderation audit log (platform moderators)",
+			},
+			"platform_admin": map[string]string{
+				"GET  /api/v1/admin/users":                     "Search user accounts",
+				"POST /api/v1/admin/users/{id}/deactivate":     "Deactivate account",
+				"POST /api/v1/admin/users/{id}/reactivate":     "Reactivate account",
+				"PUT  /api/v1/admin/users/{id}/role":           "Change platform role",
+				"POST /api/v1/admin/users/{id}/impersonate":    "Start audited support session as user",
+				"GET  /api/v1/admin/notifications":             "Inspect notification queues",
+				"POST /api/v1/admin/notifications/{id}/resend": "Force resend failed notification",
+				"GET  /api/v1/admin/actions":                   "Admin audit trail",
+				"GET  /api/v1/admin/audit-log":                 "Query audit log of logins and data changes",
+				"GET  /api/v1/admin/retention/report":          "Dry-run report of data retention policies",
+			},
 			"calendar_integration": map[string]string{
 				"GET  /api/v1/events/{id}/calendar.ics":    "Download event ICS file",
 				"GET  /api/v1/events/{id}/google-calendar": "Get Google Calendar link",
@@ -1,5 +1,10 @@
 package handler
 
+import (
+	"encoding/xml"
+	"strconv"
+)
+
 // Common response types shared across handlers
 
 // ErrorResponse represents an error response
@@ -33,3 +38,77 @@ type GroupInfo struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
 }
+
+// GeoJSONFeatureCollection represents a GeoJSON (RFC 7946) feature collection
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"` // Always FeatureCollection
+	Features []GeoJSONFeature `json:"features"`
+}
+
+// GeoJSONFeature represents a GeoJSON feature
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"` // Always Feature
+	ID         string                 `json:"id,omitempty"`
+	BBox       []float64              `json:"bbox,omitempty"` // west, south, east, north
+	Geometry   GeoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// GeoJSONGeometry represents a GeoJSON point geometry
+type GeoJSONGeometry struct {
+	Type        string    `json:"type"`        // Always Point
+	Coordinates []float64 `json:"coordinates"` // longitude, latitude
+}
+
+// newGeoJSONPoint creates a GeoJSON point; GeoJSON puts longitude first
+func newGeoJSONPoint(latitude, longitude float64) GeoJSONGeometry {
+	return GeoJSONGeometry{
+		Type:        "Point",
+		Coordinates: []float64{longitude, latitude},
+	}
+}
+
+// KMLDocument represents a KML 2.2 document of placemarks
+type KMLDocument struct {
+	XMLName  xml.Name    `xml:"kml"`
+	Xmlns    string      `xml:"xmlns,attr"` // Always http://www.opengis.net/kml/2.2
+	Document KMLContents `xml:"Document"`
+}
+
+// KMLContents represents the named contents of a KML document
+type KMLContents struct {
+	Name       string         `xml:"name"`
+	Placemarks []KMLPlacemark `xml:"Placemark"`
+}
+
+// KMLPlacemark represents a KML placemark
+type KMLPlacemark struct {
+	ID           string           `xml:"id,attr,omitempty"`
+	Name         string           `xml:"name"`
+	Description  string           `xml:"description,omitempty"`
+	ExtendedData *KMLExtendedData `xml:"ExtendedData,omitempty"`
+	Point        KMLPoint         `xml:"Point"`
+}
+
+// KMLExtendedData represents the untyped data attached to a KML placemark
+type KMLExtendedData struct {
+	Data []KMLData `xml:"Data"`
+}
+
+// KMLData represents a single named value of KML extended data
+type KMLData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// KMLPoint represents a KML point
+type KMLPoint struct {
+	Coordinates string `xml:"coordinates"` // longitude,latitude
+}
+
+// newKMLPoint creates a KML point; like GeoJSON, KML puts longitude first
+func newKMLPoint(latitude, longitude float64) KMLPoint {
+	return KMLPoint{
+		Coordinates: strconv.FormatFloat(longitude, 'f', -1, 64) + "," + strconv.FormatFloat(latitude, 'f', -1, 64),
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// UserBlockResponse represents a block list entry
+type UserBlockResponse struct {
+	BlockedUserID string `json:"blocked_user_id"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// BlockUser handles POST /users/{id}/block
+func (h *UserHandler) BlockUser(w http.ResponseWriter, r *http.Request) {
+	userID, blockedID, ok := h.parseBlockRequest(w, r)
+	if !ok {
+		return
+	}
+
+	block, err := h.blockUserUseCase.Execute(r.Context(), &usecase.BlockUserRequest{
+		BlockerID: userID,
+		BlockedID: blockedID,
+	})
+	if err != nil {
+		h.writeUserBlockError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToUserBlockResponse(block))
+}
+
+// UnblockUser handles DELETE /users/{id}/block
+func (h *UserHandler) UnblockUser(w http.ResponseWriter, r *http.Request) {
+	userID, blockedID, ok := h.parseBlockRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.unblockUserUseCase.Execute(r.Context(), &usecase.UnblockUserRequest{
+		BlockerID: userID,
+		BlockedID: blockedID,
+	})
+	if err != nil {
+		h.writeUserBlockError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListBlockedUsers handles GET /me/blocks
+func (h *UserHandler) ListBlockedUsers(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return
+	}
+
+	blocks, err := h.listBlockedUsersUseCase.Execute(r.Context(), &usecase.ListBlockedUsersRequest{UserID: userUUID})
+	if err != nil {
+		h.writeUserBlockError(w, err)
+		return
+	}
+
+	response := make([]*UserBlockResponse, len(blocks))
+	for i, block := range blocks {
+		response[i] = h.convertToUserBlockResponse(block)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseBlockRequest extracts the authenticated user and the target user from a block request
+func (h *UserHandler) parseBlockRequest(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	targetID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userUUID, targetID, true
+}
+
+// writeUserBlockError maps block list errors to HTTP responses
+func (h *UserHandler) writeUserBlockError(w http.ResponseWriter, err error) {
+	switch err {
+	case domain.ErrCannotBlockSelf:
+		h.writeErrorResponse(w, http.StatusBadRequest, "cannot_block_self", "You cannot block yourself")
+	case usecase.ErrUserNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
+	case usecase.ErrUserNotBlocked:
+		h.writeErrorResponse(w, http.StatusNotFound, "user_not_blocked", "User is not blocked")
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, "block_list_failed", "Failed to update block list")
+	}
+}
+
+// convertToUserBlockResponse converts a domain block to its response format
+func (h *UserHandler) convertToUserBlockResponse(block *domain.UserBlock) *UserBlockResponse {
+	return &UserBlockResponse{
+		BlockedUserID: block.BlockedID.String(),
+		CreatedAt:     block.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
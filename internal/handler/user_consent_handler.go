@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// UpdateConsentRequest represents the consent update request payload
+type UpdateConsentRequest struct {
+	Granted       *bool                  `json:"granted" validate:"required"`
+	PolicyVersion string                 `json:"policy_version,omitempty" validate:"omitempty,max=50"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ConsentResponse represents a consent record
+type ConsentResponse struct {
+	ID            string                 `json:"id"`
+	ConsentType   string                 `json:"consent_type"`
+	Granted       bool                   `json:"granted"`
+	PolicyVersion string                 `json:"policy_version"`
+	IPAddress     *string                `json:"ip_address,omitempty"`
+	UserAgent     *string                `json:"user_agent,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt     string                 `json:"created_at"`
+}
+
+// GetMyConsents handles GET /me/consents
+func (h *UserHandler) GetMyConsents(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	consents, err := h.gdprUseCase.GetUserConsents(r.Context(), userUUID)
+	if err != nil {
+		h.writeConsentError(w, err)
+		return
+	}
+
+	h.writeConsentList(w, consents)
+}
+
+// GetMyConsentHistory handles GET /me/consents/history
+func (h *UserHandler) GetMyConsentHistory(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	consents, err := h.gdprUseCase.GetConsentHistory(r.Context(), userUUID)
+	if err != nil {
+		h.writeConsentError(w, err)
+		return
+	}
+
+	h.writeConsentList(w, consents)
+}
+
+// UpdateMyConsent handles PUT /me/consents/{type}
+func (h *UserHandler) UpdateMyConsent(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	validator := NewValidationHelper()
+
+	var req UpdateConsentRequest
+	if !validator.ValidateAndDecodeJSON(w, r, &req) {
+		return
+	}
+
+	consent, err := h.gdprUseCase.UpdateConsent(r.Context(), &usecase.ConsentUpdateRequest{
+		UserID:        userUUID,
+		ConsentType:   domain.ConsentType(mux.Vars(r)["type"]),
+		Granted:       *req.Granted,
+		PolicyVersion: req.PolicyVersion,
+		IPAddress:     middleware.ClientIP(r),
+		UserAgent:     r.UserAgent(),
+		Metadata:      req.Metadata,
+	})
+	if err != nil {
+		h.writeConsentError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToConsentResponse(consent))
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *UserHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// writeConsentList writes a list of consent records
+func (h *UserHandler) writeConsentList(w http.ResponseWriter, consents []*domain.ConsentRecord) {
+	response := make([]*ConsentResponse, len(consents))
+	for i, consent := range consents {
+		response[i] = h.convertToConsentResponse(consent)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeConsentError maps consent errors to HTTP responses
+func (h *UserHandler) writeConsentError(w http.ResponseWriter, err error) {
+	switch err {
+	case domain.ErrInvalidConsentType, domain.ErrConsentPolicyVersionRequired, domain.ErrConsentPolicyVersionTooLong:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, "consent_update_failed", "Failed to process consent")
+	}
+}
+
+// convertToConsentResponse converts a domain consent record to its response format
+func (h *UserHandler) convertToConsentResponse(consent *domain.ConsentRecord) *ConsentResponse {
+	return &ConsentResponse{
+		ID:            consent.ID.String(),
+		ConsentType:   string(consent.ConsentType),
+		Granted:       consent.Granted,
+		PolicyVersion: consent.PolicyVersion,
+		IPAddress:     consent.IPAddress,
+		UserAgent:     consent.UserAgent,
+		Metadata:      consent.Metadata,
+		CreatedAt:     consent.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
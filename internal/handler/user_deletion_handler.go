@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// AccountDeletionResponse represents a scheduled account deletion
+type AccountDeletionResponse struct {
+	ID           string  `json:"id"`
+	Status       string  `json:"status"`
+	RequestedAt  string  `json:"requested_at"`
+	ScheduledFor string  `json:"scheduled_for"`
+	CancelledAt  *string `json:"cancelled_at,omitempty"`
+}
+
+// DeleteMe handles DELETE /me. The account is deleted once the grace period has passed.
+func (h *UserHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := h.gdprUseCase.ScheduleAccountDeletion(r.Context(), userUUID)
+	if err != nil {
+		h.writeAccountDeletionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(h.convertToAccountDeletionResponse(deletion))
+}
+
+// GetMyDeletion handles GET /me/deletion
+func (h *UserHandler) GetMyDeletion(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := h.gdprUseCase.GetAccountDeletion(r.Context(), userUUID)
+	if err != nil {
+		h.writeAccountDeletionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToAccountDeletionResponse(deletion))
+}
+
+// CancelMyDeletion handles DELETE /me/deletion
+func (h *UserHandler) CancelMyDeletion(w http.ResponseWriter, r *http.Request) {
+	userUUID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := h.gdprUseCase.CancelAccountDeletion(r.Context(), userUUID)
+	if err != nil {
+		h.writeAccountDeletionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToAccountDeletionResponse(deletion))
+}
+
+// writeAccountDeletionError maps account deletion errors to HTTP responses
+func (h *UserHandler) writeAccountDeletionError(w http.ResponseWriter, err error) {
+	switch err {
+	case usecase.ErrUserNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
+	case usecase.ErrAccountDeletionNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "deletion_not_found", err.Error())
+	case domain.ErrAccountDeletionNotScheduled:
+		h.writeErrorResponse(w, http.StatusConflict, "deletion_not_scheduled", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, "deletion_failed", "Failed to process account deletion")
+	}
+}
+
+// convertToAccountDeletionResponse converts a domain account deletion to its response format
+func (h *UserHandler) convertToAccountDeletionResponse(deletion *domain.AccountDeletion) *AccountDeletionResponse {
+	return &AccountDeletionResponse{
+		ID:           deletion.ID.String(),
+		Status:       string(deletion.Status),
+		RequestedAt:  deletion.RequestedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ScheduledFor: deletion.ScheduledFor.Format("2006-01-02T15:04:05Z07:00"),
+		CancelledAt:  formatOptionalTime(deletion.CancelledAt),
+	}
+}
@@ -17,6 +17,11 @@ type UserHandler struct {
 	updateProfileUseCase  *usecase.UpdateProfileUseCase
 	getUserProfileUseCase *usecase.GetUserProfileUseCase
 	gdprUseCase           *usecase.GDPRComplianceUseCase
+	changePasswordUseCase *usecase.ChangePasswordUseCase
+
+	blockUserUseCase        *usecase.BlockUserUseCase
+	unblockUserUseCase      *usecase.UnblockUserUseCase
+	listBlockedUsersUseCase *usecase.ListBlockedUsersUseCase
 }
 
 // UpdateProfileRequest represents the profile update request payload
@@ -31,6 +36,12 @@ type UpdateProfileRequest struct {
 	VisibilitySettings       map[string]interface{} `json:"visibility_settings,omitempty"`
 }
 
+// ChangePasswordRequest represents the password change request payload
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=20"`
+}
+
 // UserProfileResponse represents the user profile response
 type UserProfileResponse struct {
 	ID                       string                 `json:"id"`
@@ -61,11 +72,19 @@ func NewUserHandler(
 	updateProfileUseCase *usecase.UpdateProfileUseCase,
 	getUserProfileUseCase *usecase.GetUserProfileUseCase,
 	gdprUseCase *usecase.GDPRComplianceUseCase,
+	changePasswordUseCase *usecase.ChangePasswordUseCase,
+	blockUserUseCase *usecase.BlockUserUseCase,
+	unblockUserUseCase *usecase.UnblockUserUseCase,
+	listBlockedUsersUseCase *usecase.ListBlockedUsersUseCase,
 ) *UserHandler {
 	return &UserHandler{
-		updateProfileUseCase:  updateProfileUseCase,
-		getUserProfileUseCase: getUserProfileUseCase,
-		gdprUseCase:           gdprUseCase,
+		updateProfileUseCase:    updateProfileUseCase,
+		getUserProfileUseCase:   getUserProfileUseCase,
+		gdprUseCase:             gdprUseCase,
+		changePasswordUseCase:   changePasswordUseCase,
+		blockUserUseCase:        blockUserUseCase,
+		unblockUserUseCase:      unblockUserUseCase,
+		listBlockedUsersUseCase: listBlockedUsersUseCase,
 	}
 }
 
@@ -168,8 +187,8 @@ func (h *UserHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
-// DeleteMe handles DELETE /me
-func (h *UserHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
+// ChangePassword handles PUT /me/password
+func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 	// Get user ID from authentication context
 	userID, ok := middleware.GetUserID(r)
 	if !ok {
@@ -183,14 +202,28 @@ func (h *UserHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Execute account deletion (GDPR compliance)
-	err = h.gdprUseCase.DeleteUserAccount(r.Context(), userUUID)
+	validator := NewValidationHelper()
+
+	var req ChangePasswordRequest
+	if !validator.ValidateAndDecodeJSON(w, r, &req) {
+		return
+	}
+
+	err = h.changePasswordUseCase.Execute(r.Context(), &usecase.ChangePasswordRequest{
+		UserID:          userUUID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
 	if err != nil {
 		switch err {
 		case usecase.ErrUserNotFound:
 			h.writeErrorResponse(w, http.StatusNotFound, "user_not_found", "User not found")
+		case usecase.ErrInvalidCurrentPassword:
+			h.writeErrorResponse(w, http.StatusUnauthorized, "invalid_credentials", "Current password is incorrect")
+		case usecase.ErrWeakPassword, usecase.ErrPasswordUnchanged:
+			h.writeErrorResponse(w, http.StatusBadRequest, "weak_password", err.Error())
 		default:
-			h.writeErrorResponse(w, http.StatusInternalServerError, "deletion_failed", "Failed to delete account")
+			h.writeErrorResponse(w, http.StatusInternalServerError, "password_change_failed", "Failed to change password")
 		}
 		return
 	}
@@ -198,7 +231,7 @@ func (h *UserHandler) DeleteMe(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]string{
-		"message": "Account successfully deleted",
+		"message": "Password successfully changed",
 	})
 }
 
@@ -364,8 +397,24 @@ func (h *UserHandler) RegisterRoutes(router *mux.Router, authMiddleware *middlew
 
 	protected.HandleFunc("/me", h.GetMe).Methods("GET")
 	protected.HandleFunc("/me", h.UpdateMe).Methods("PUT")
-	protected.HandleFunc("/me", h.DeleteMe).Methods("DELETE")
-	protected.HandleFunc("/me/export", h.ExportMe).Methods("GET")
+	protected.HandleFunc("/me/deletion", h.GetMyDeletion).Methods("GET")
+	protected.HandleFunc("/me/consents", h.GetMyConsents).Methods("GET")
+	protected.HandleFunc("/me/consents/history", h.GetMyConsentHistory).Methods("GET")
+
+	// Account security, consent and blocking decisions belong to the account owner, not to support
+	// staff impersonating them
+	owner := router.PathPrefix("").Subrouter()
+	owner.Use(authMiddleware.RequireAuth)
+	owner.Use(authMiddleware.RejectImpersonation)
+
+	owner.HandleFunc("/me", h.DeleteMe).Methods("DELETE")
+	owner.HandleFunc("/me/deletion", h.CancelMyDeletion).Methods("DELETE")
+	owner.HandleFunc("/me/export", h.ExportMe).Methods("GET")
+	owner.HandleFunc("/me/password", h.ChangePassword).Methods("PUT")
+	owner.HandleFunc("/me/consents/{type}", h.UpdateMyConsent).Methods("PUT")
+	owner.HandleFunc("/me/blocks", h.ListBlockedUsers).Methods("GET")
+	owner.HandleFunc("/users/{id}/block", h.BlockUser).Methods("POST")
+	owner.HandleFunc("/users/{id}/block", h.UnblockUser).Methods("DELETE")
 
 	// Public routes (optional authentication for privacy controls)
 	public := router.PathPrefix("").Subrouter()
@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// VenueClaimHandler handles venue ownership claims and official store events
+type VenueClaimHandler struct {
+	venueClaimsUseCase *usecase.VenueClaimsUseCase
+}
+
+// CreateVenueClaimRequest represents the venue claim request payload
+type CreateVenueClaimRequest struct {
+	Evidence string `json:"evidence" validate:"required,max=2000"`
+}
+
+// ReviewVenueClaimRequest represents an admin's decision on a venue claim
+type ReviewVenueClaimRequest struct {
+	Status string  `json:"status" validate:"required,oneof=approved rejected"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,max=1000"`
+}
+
+// SetEventOfficialRequest represents the official event request payload
+type SetEventOfficialRequest struct {
+	Official bool `json:"official"`
+}
+
+// VenueClaimResponse represents a venue ownership claim
+type VenueClaimResponse struct {
+	ID         string  `json:"id"`
+	VenueID    string  `json:"venue_id"`
+	ClaimantID string  `json:"claimant_id"`
+	Evidence   string  `json:"evidence"`
+	Status     string  `json:"status"`
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+	ReviewedAt *string `json:"reviewed_at,omitempty"`
+	ReviewNote *string `json:"review_note,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+// VenueClaimListResponse represents a page of the venue claim queue
+type VenueClaimListResponse struct {
+	Claims []VenueClaimResponse `json:"claims"`
+	Total  int                  `json:"total"`
+	Limit  int                  `json:"limit"`
+	Offset int                  `json:"offset"`
+}
+
+// EventOfficialResponse represents an event's official status
+type EventOfficialResponse struct {
+	EventID  string `json:"event_id"`
+	Official bool   `json:"official"`
+}
+
+// NewVenueClaimHandler creates a new venue claim handler
+func NewVenueClaimHandler(venueClaimsUseCase *usecase.VenueClaimsUseCase) *VenueClaimHandler {
+	return &VenueClaimHandler{
+		venueClaimsUseCase: venueClaimsUseCase,
+	}
+}
+
+// CreateVenueClaim handles POST /venues/{id}/claims
+func (h *VenueClaimHandler) CreateVenueClaim(w http.ResponseWriter, r *http.Request) {
+	venueID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_venue_id", "Invalid venue ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req CreateVenueClaimRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	claim, err := h.venueClaimsUseCase.ClaimVenue(r.Context(), &usecase.ClaimVenueRequest{
+		VenueID:    venueID,
+		ClaimantID: userID,
+		Evidence:   req.Evidence,
+	})
+	if err != nil {
+		h.writeVenueClaimError(w, err, "venue_claim_failed", "Failed to claim venue")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(h.convertToVenueClaimResponse(claim))
+}
+
+// ListVenueClaims handles GET /admin/venue-claims
+func (h *VenueClaimHandler) ListVenueClaims(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req := &usecase.ListVenueClaimsRequest{
+		AdminID: userID,
+		Status:  domain.VenueClaimStatus(r.URL.Query().Get("status")),
+	}
+	req.Limit, req.Offset = parseModerationPage(r)
+
+	result, err := h.venueClaimsUseCase.ListVenueClaims(r.Context(), req)
+	if err != nil {
+		h.writeVenueClaimError(w, err, "venue_claims_fetch_failed", "Failed to fetch venue claims")
+		return
+	}
+
+	claims := make([]VenueClaimResponse, len(result.Claims))
+	for i, claim := range result.Claims {
+		claims[i] = *h.convertToVenueClaimResponse(claim)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(VenueClaimListResponse{
+		Claims: claims,
+		Total:  len(claims),
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	})
+}
+
+// ReviewVenueClaim handles POST /admin/venue-claims/{id}/review
+func (h *VenueClaimHandler) ReviewVenueClaim(w http.ResponseWriter, r *http.Request) {
+	claimID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_claim_id", "Invalid venue claim ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ReviewVenueClaimRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	claim, err := h.venueClaimsUseCase.ReviewVenueClaim(r.Context(), &usecase.ReviewVenueClaimRequest{
+		ClaimID: claimID,
+		AdminID: userID,
+		Status:  domain.VenueClaimStatus(req.Status),
+		Note:    req.Note,
+	})
+	if err != nil {
+		h.writeVenueClaimError(w, err, "venue_claim_review_failed", "Failed to review venue claim")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToVenueClaimResponse(claim))
+}
+
+// SetEventOfficial handles PUT /events/{id}/official
+func (h *VenueClaimHandler) SetEventOfficial(w http.ResponseWriter, r *http.Request) {
+	eventID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_event_id", "Invalid event ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req SetEventOfficialRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	event, err := h.venueClaimsUseCase.SetEventOfficial(r.Context(), &usecase.SetEventOfficialRequest{
+		EventID:  eventID,
+		UserID:   userID,
+		Official: req.Official,
+	})
+	if err != nil {
+		h.writeVenueClaimError(w, err, "event_official_failed", "Failed to update official status")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(EventOfficialResponse{
+		EventID:  event.ID.String(),
+		Official: event.IsOfficial,
+	})
+}
+
+// writeVenueClaimError maps venue claim use case errors to HTTP responses
+func (h *VenueClaimHandler) writeVenueClaimError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrPlatformAdminOnly:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Admin access required")
+	case usecase.ErrNotVenueOwner:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", err.Error())
+	case usecase.ErrVenueNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "venue_not_found", "Venue not found")
+	case usecase.ErrVenueClaimNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "venue_claim_not_found", "Venue claim not found")
+	case usecase.ErrEventNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "event_not_found", "Event not found")
+	case usecase.ErrVenueAlreadyVerified, usecase.ErrDuplicateVenueClaim, domain.ErrVenueClaimAlreadyReviewed:
+		h.writeErrorResponse(w, http.StatusConflict, "venue_claim_conflict", err.Error())
+	case usecase.ErrVenueNotClaimable, domain.ErrInvalidVenueClaimStatus, domain.ErrVenueClaimEvidenceRequired,
+		domain.ErrVenueClaimEvidenceTooLong, domain.ErrVenueClaimNoteTooLong:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *VenueClaimHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToVenueClaimResponse converts a domain venue claim to the response format
+func (h *VenueClaimHandler) convertToVenueClaimResponse(claim *domain.VenueClaim) *VenueClaimResponse {
+	response := &VenueClaimResponse{
+		ID:         claim.ID.String(),
+		VenueID:    claim.VenueID.String(),
+		ClaimantID: claim.ClaimantID.String(),
+		Evidence:   claim.Evidence,
+		Status:     string(claim.Status),
+		ReviewNote: claim.ReviewNote,
+		CreatedAt:  claim.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if claim.ReviewedBy != nil {
+		reviewedBy := claim.ReviewedBy.String()
+		response.ReviewedBy = &reviewedBy
+	}
+	if claim.ReviewedAt != nil {
+		reviewedAt := claim.ReviewedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.ReviewedAt = &reviewedAt
+	}
+
+	return response
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *VenueClaimHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers venue claim and official event routes with the given router
+func (h *VenueClaimHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	protected := router.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+
+	// Store owners claim their venue and mark events there as official
+	protected.HandleFunc("/venues/{id}/claims", h.CreateVenueClaim).Methods("POST")
+	protected.HandleFunc("/events/{id}/official", h.SetEventOfficial).Methods("PUT")
+
+	// Platform admins review claims
+	admin := router.PathPrefix("/admin").Subrouter()
+	admin.Use(authMiddleware.RequireAuth)
+	admin.Use(authMiddleware.RequireAdmin)
+
+	admin.HandleFunc("/venue-claims", h.ListVenueClaims).Methods("GET")
+	admin.HandleFunc("/venue-claims/{id}/review", h.ReviewVenueClaim).Methods("POST")
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// VenueDuplicateHandler handles venue duplicate suggestions and admin merges
+type VenueDuplicateHandler struct {
+	venueDuplicatesUseCase *usecase.VenueDuplicatesUseCase
+	venueHandler           *VenueHandler // Renders venues the same way as the venue endpoints
+}
+
+// MergeVenueRequest represents an admin's request to merge a duplicate venue into another
+type MergeVenueRequest struct {
+	TargetID string `json:"target_id" validate:"required,uuid"`
+}
+
+// VenueDuplicateResponse represents a venue that likely duplicates another one
+type VenueDuplicateResponse struct {
+	Venue          VenueResponse `json:"venue"`
+	NameSimilarity float64       `json:"name_similarity"`
+	DistanceKm     *float64      `json:"distance_km,omitempty"`
+	SameAddress    bool          `json:"same_address"`
+}
+
+// VenueDuplicateListResponse represents the duplicate suggestions for a venue
+type VenueDuplicateListResponse struct {
+	VenueID    string                   `json:"venue_id"`
+	Duplicates []VenueDuplicateResponse `json:"duplicates"`
+}
+
+// NewVenueDuplicateHandler creates a new venue duplicate handler
+func NewVenueDuplicateHandler(venueDuplicatesUseCase *usecase.VenueDuplicatesUseCase) *VenueDuplicateHandler {
+	return &VenueDuplicateHandler{
+		venueDuplicatesUseCase: venueDuplicatesUseCase,
+		venueHandler:           &VenueHandler{},
+	}
+}
+
+// GetVenueDuplicates handles GET /venues/{id}/duplicates
+func (h *VenueDuplicateHandler) GetVenueDuplicates(w http.ResponseWriter, r *http.Request) {
+	venueID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_venue_id", "Invalid venue ID")
+		return
+	}
+
+	req := &usecase.FindVenueDuplicatesRequest{VenueID: venueID}
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if limit, err := strconv.Atoi(limitStr); err == nil {
+			req.Limit = limit
+		}
+	}
+
+	candidates, err := h.venueDuplicatesUseCase.FindVenueDuplicates(r.Context(), req)
+	if err != nil {
+		h.writeVenueDuplicateError(w, err, "venue_duplicates_fetch_failed", "Failed to fetch duplicate venues")
+		return
+	}
+
+	duplicates := make([]VenueDuplicateResponse, len(candidates))
+	for i, candidate := range candidates {
+		duplicates[i] = VenueDuplicateResponse{
+			Venue:          *h.venueHandler.convertToVenueResponse(candidate.Venue, false),
+			NameSimilarity: candidate.NameSimilarity,
+			DistanceKm:     candidate.DistanceKm,
+			SameAddress:    candidate.SameAddress,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(VenueDuplicateListResponse{
+		VenueID:    venueID.String(),
+		Duplicates: duplicates,
+	})
+}
+
+// MergeVenue handles POST /admin/venues/{id}/merge
+func (h *VenueDuplicateHandler) MergeVenue(w http.ResponseWriter, r *http.Request) {
+	sourceID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_venue_id", "Invalid venue ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req MergeVenueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	targetID, err := uuid.Parse(req.TargetID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_target_id", "Invalid target venue ID")
+		return
+	}
+
+	target, err := h.venueDuplicatesUseCase.MergeVenues(r.Context(), &usecase.MergeVenuesRequest{
+		SourceID: sourceID,
+		TargetID: targetID,
+		AdminID:  userID,
+	})
+	if err != nil {
+		h.writeVenueDuplicateError(w, err, "venue_merge_failed", "Failed to merge venues")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.venueHandler.convertToVenueResponse(target, true))
+}
+
+// writeVenueDuplicateError maps venue duplicate use case errors to HTTP responses
+func (h *VenueDuplicateHandler) writeVenueDuplicateError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrPlatformAdminOnly:
+		h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Admin access required")
+	case usecase.ErrVenueNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "venue_not_found", "Venue not found")
+	case usecase.ErrVerifiedVenueMerge:
+		h.writeErrorResponse(w, http.StatusConflict, "venue_merge_conflict", err.Error())
+	case domain.ErrVenueMergeIntoSelf:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *VenueDuplicateHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *VenueDuplicateHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers venue duplicate routes with the given router
+func (h *VenueDuplicateHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	protected := router.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+
+	// Suggest existing venues before adding another, and flag duplicates for admins
+	protected.HandleFunc("/venues/{id}/duplicates", h.GetVenueDuplicates).Methods("GET")
+
+	// Platform admins merge duplicates
+	admin := router.PathPrefix("/admin").Subrouter()
+	admin.Use(authMiddleware.RequireAuth)
+	admin.Use(authMiddleware.RequireAdmin)
+
+	admin.HandleFunc("/venues/{id}/merge", h.MergeVenue).Methods("POST")
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,11 @@ type CreateVenueRequest struct {
 	City     string                 `json:"city" validate:"required,min=1,max=100"`
 	Country  string                 `json:"country" validate:"required,min=1,max=100"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	Timezone     *string              `json:"timezone,omitempty"` // IANA name, required with opening hours
+	OpeningHours *domain.OpeningHours `json:"opening_hours,omitempty"`
+
+	Amenities *domain.VenueAmenities `json:"amenities,omitempty"`
 }
 
 // UpdateVenueRequest represents the venue update request payload
@@ -37,6 +43,11 @@ type UpdateVenueRequest struct {
 	City     *string                `json:"city,omitempty" validate:"omitempty,min=1,max=100"`
 	Country  *string                `json:"country,omitempty" validate:"omitempty,min=1,max=100"`
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	Timezone     *string              `json:"timezone,omitempty"`
+	OpeningHours *domain.OpeningHours `json:"opening_hours,omitempty"`
+
+	Amenities *domain.VenueAmenities `json:"amenities,omitempty"` // Replaces the existing amenities
 }
 
 // VenueSearchRequest represents the venue search request parameters
@@ -63,6 +74,17 @@ type VenueResponse struct {
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 	CreatedBy   *UserInfo              `json:"created_by,omitempty"`
 	CreatedAt   string                 `json:"created_at"`
+
+	Timezone     *string              `json:"timezone,omitempty"`
+	OpeningHours *domain.OpeningHours `json:"opening_hours,omitempty"`
+
+	Amenities *domain.VenueAmenities `json:"amenities,omitempty"`
+
+	Verified bool    `json:"verified"`           // Set when a store owner's claim has been approved
+	OwnerID  *string `json:"owner_id,omitempty"` // Verified owner
+
+	RatingAverage float64 `json:"rating_average"` // Average stars of the venue's reviews, 0 when unrated
+	RatingCount   int     `json:"rating_count"`
 }
 
 // VenueListResponse represents a paginated list of venues
@@ -124,6 +146,10 @@ func (h *VenueHandler) CreateVenue(w http.ResponseWriter, r *http.Request) {
 		City:      req.City,
 		Country:   req.Country,
 		Metadata:  req.Metadata,
+
+		Timezone:     req.Timezone,
+		OpeningHours: req.OpeningHours,
+		Amenities:    req.Amenities,
 	}
 
 	// Execute venue creation
@@ -148,6 +174,11 @@ func (h *VenueHandler) CreateVenue(w http.ResponseWriter, r *http.Request) {
 			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "Invalid venue type")
 		case domain.ErrInvalidCoordinates:
 			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "Invalid coordinates")
+		case domain.ErrInvalidVenueTimezone, domain.ErrVenueTimezoneRequired,
+			domain.ErrInvalidOpeningDay, domain.ErrInvalidOpeningTime, domain.ErrEmptyOpeningPeriod,
+			domain.ErrInvalidOpeningHoursException, domain.ErrDuplicateOpeningHoursException, domain.ErrTooManyOpeningHoursExceptions,
+			domain.ErrInvalidVenueTableCount, domain.ErrInvalidVenueSeats, domain.ErrVenueSeatsRequired:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
 		default:
 			h.writeErrorResponse(w, http.StatusInternalServerError, "venue_creation_failed", "Failed to create venue")
 		}
@@ -189,6 +220,15 @@ func (h *VenueHandler) GetVenue(w http.ResponseWriter, r *http.Request) {
 	response := h.convertToVenueResponse(result, true)
 
 	w.Header().Set("Content-Type", "application/json")
+
+	// A merged venue's ID resolves to the venue that replaced it; point clients at the canonical URL
+	if result.ID != venueID {
+		w.Header().Set("Location", strings.TrimSuffix(r.URL.Path, venueIDStr)+result.ID.String())
+		w.WriteHeader(http.StatusMovedPermanently)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(response)
 }
@@ -232,6 +272,10 @@ func (h *VenueHandler) UpdateVenue(w http.ResponseWriter, r *http.Request) {
 		City:     req.City,
 		Country:  req.Country,
 		Metadata: req.Metadata,
+
+		Timezone:     req.Timezone,
+		OpeningHours: req.OpeningHours,
+		Amenities:    req.Amenities,
 	}
 
 	// Convert type if provided
@@ -247,7 +291,7 @@ func (h *VenueHandler) UpdateVenue(w http.ResponseWriter, r *http.Request) {
 		case usecase.ErrVenueNotFound:
 			h.writeErrorResponse(w, http.StatusNotFound, "venue_not_found", "Venue not found")
 		case usecase.ErrUnauthorizedVenue:
-			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only venue creator can update this venue")
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only the venue creator or verified owner can update this venue")
 		case usecase.ErrGeocodingFailed:
 			h.writeErrorResponse(w, http.StatusBadRequest, "geocoding_failed", "Failed to geocode updated address")
 		case domain.ErrEmptyVenueName:
@@ -266,6 +310,11 @@ func (h *VenueHandler) UpdateVenue(w http.ResponseWriter, r *http.Request) {
 			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "Invalid venue type")
 		case domain.ErrInvalidCoordinates:
 			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", "Invalid coordinates")
+		case domain.ErrInvalidVenueTimezone, domain.ErrVenueTimezoneRequired,
+			domain.ErrInvalidOpeningDay, domain.ErrInvalidOpeningTime, domain.ErrEmptyOpeningPeriod,
+			domain.ErrInvalidOpeningHoursException, domain.ErrDuplicateOpeningHoursException, domain.ErrTooManyOpeningHoursExceptions,
+			domain.ErrInvalidVenueTableCount, domain.ErrInvalidVenueSeats, domain.ErrVenueSeatsRequired:
+			h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
 		default:
 			h.writeErrorResponse(w, http.StatusInternalServerError, "venue_update_failed", "Failed to update venue")
 		}
@@ -311,7 +360,7 @@ func (h *VenueHandler) DeleteVenue(w http.ResponseWriter, r *http.Request) {
 		case usecase.ErrVenueNotFound:
 			h.writeErrorResponse(w, http.StatusNotFound, "venue_not_found", "Venue not found")
 		case usecase.ErrUnauthorizedVenue:
-			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only venue creator can delete this venue")
+			h.writeErrorResponse(w, http.StatusForbidden, "access_denied", "Only the venue creator or verified owner can delete this venue")
 		default:
 			h.writeErrorResponse(w, http.StatusInternalServerError, "venue_deletion_failed", "Failed to delete venue")
 		}
@@ -327,10 +376,42 @@ func (h *VenueHandler) DeleteVenue(w http.ResponseWriter, r *http.Request) {
 
 // SearchVenues handles GET /venues
 func (h *VenueHandler) SearchVenues(w http.ResponseWriter, r *http.Request) {
-	// Parse query parameters
-	query := r.URL.Query()
+	searchReq := parseSearchVenuesRequest(r.URL.Query())
+
+	// Execute search
+	result, err := h.venueManagementUseCase.SearchVenues(r.Context(), searchReq)
+	if err != nil {
+		switch err {
+		case usecase.ErrInvalidVenueSort:
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_sort", err.Error())
+		case usecase.ErrInvalidSearchParams:
+			h.writeErrorResponse(w, http.StatusBadRequest, "invalid_search", "Invalid search parameters")
+		default:
+			h.writeErrorResponse(w, http.StatusInternalServerError, "search_failed", "Failed to search venues")
+		}
+		return
+	}
+
+	// Convert to response format
+	venues := make([]VenueResponse, len(result.Venues))
+	for i, venueWithDistance := range result.Venues {
+		venues[i] = *h.convertToVenueResponse(venueWithDistance.Venue, false) // Don't include creator info in search results
+	}
 
-	// Parse search parameters
+	response := VenueListResponse{
+		Venues: venues,
+		Total:  result.TotalCount,
+		Limit:  searchReq.Limit,
+		Offset: searchReq.Offset,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseSearchVenuesRequest parses the venue search filters shared by venue search and exports
+func parseSearchVenuesRequest(query url.Values) *usecase.SearchVenuesRequest {
 	searchReq := &usecase.SearchVenuesRequest{
 		Limit:  20, // default
 		Offset: 0,  // default
@@ -376,6 +457,26 @@ func (h *VenueHandler) SearchVenues(w http.ResponseWriter, r *http.Request) {
 		searchReq.Type = &venueType
 	}
 
+	// Parse amenity filters
+	if minSeatsStr := query.Get("min_seats"); minSeatsStr != "" {
+		if minSeats, err := strconv.Atoi(minSeatsStr); err == nil {
+			searchReq.Amenities.MinSeats = &minSeats
+		}
+	}
+
+	if minTablesStr := query.Get("min_tables"); minTablesStr != "" {
+		if minTables, err := strconv.Atoi(minTablesStr); err == nil {
+			searchReq.Amenities.MinTables = &minTables
+		}
+	}
+
+	searchReq.Amenities.PlaymatsProvided, _ = strconv.ParseBool(query.Get("playmats"))
+	searchReq.Amenities.WheelchairAccessible, _ = strconv.ParseBool(query.Get("accessible"))
+	searchReq.Amenities.FoodAndDrinks, _ = strconv.ParseBool(query.Get("food"))
+	searchReq.Amenities.WiFi, _ = strconv.ParseBool(query.Get("wifi"))
+
+	searchReq.SortBy = query.Get("sort")
+
 	// Parse pagination
 	if limitStr := query.Get("limit"); limitStr != "" {
 		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
@@ -389,29 +490,7 @@ func (h *VenueHandler) SearchVenues(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Execute search
-	result, err := h.venueManagementUseCase.SearchVenues(r.Context(), searchReq)
-	if err != nil {
-		h.writeErrorResponse(w, http.StatusInternalServerError, "search_failed", "Failed to search venues")
-		return
-	}
-
-	// Convert to response format
-	venues := make([]VenueResponse, len(result.Venues))
-	for i, venueWithDistance := range result.Venues {
-		venues[i] = *h.convertToVenueResponse(venueWithDistance.Venue, false) // Don't include creator info in search results
-	}
-
-	response := VenueListResponse{
-		Venues: venues,
-		Total:  result.TotalCount,
-		Limit:  searchReq.Limit,
-		Offset: searchReq.Offset,
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	json.NewEncoder(w).Encode(response)
+	return searchReq
 }
 
 // convertToVenueResponse converts domain venue to response format
@@ -425,6 +504,19 @@ func (h *VenueHandler) convertToVenueResponse(venue *domain.Venue, includeCreato
 		Country:   venue.Country,
 		Metadata:  venue.Metadata,
 		CreatedAt: venue.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+
+		Timezone:     venue.Timezone,
+		OpeningHours: venue.OpeningHours,
+		Amenities:    venue.Amenities,
+		Verified:     venue.IsVerified(),
+
+		RatingAverage: venue.RatingAverage,
+		RatingCount:   venue.RatingCount,
+	}
+
+	if venue.IsVerified() {
+		ownerID := venue.OwnerID.String()
+		response.OwnerID = &ownerID
 	}
 
 	// Add coordinates (always available in domain.Venue)
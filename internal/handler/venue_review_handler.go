@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/middleware"
+	"github.com/matchtcg/backend/internal/usecase"
+)
+
+// VenueReviewHandler handles venue reviews and ratings
+type VenueReviewHandler struct {
+	venueReviewsUseCase *usecase.VenueReviewsUseCase
+}
+
+// ReviewVenueRequest represents the venue review request payload
+type ReviewVenueRequest struct {
+	Rating int      `json:"rating" validate:"required,min=1,max=5"`
+	Text   *string  `json:"text,omitempty" validate:"omitempty,max=2000"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+// VenueReviewResponse represents a venue review
+type VenueReviewResponse struct {
+	ID        string   `json:"id"`
+	VenueID   string   `json:"venue_id"`
+	UserID    string   `json:"user_id"`
+	Rating    int      `json:"rating"`
+	Text      *string  `json:"text,omitempty"`
+	Tags      []string `json:"tags"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+}
+
+// VenueReviewListResponse represents a page of a venue's reviews with its rating
+type VenueReviewListResponse struct {
+	Reviews       []VenueReviewResponse `json:"reviews"`
+	RatingAverage float64               `json:"rating_average"`
+	RatingCount   int                   `json:"rating_count"`
+	Total         int                   `json:"total"`
+	Limit         int                   `json:"limit"`
+	Offset        int                   `json:"offset"`
+}
+
+// NewVenueReviewHandler creates a new venue review handler
+func NewVenueReviewHandler(venueReviewsUseCase *usecase.VenueReviewsUseCase) *VenueReviewHandler {
+	return &VenueReviewHandler{
+		venueReviewsUseCase: venueReviewsUseCase,
+	}
+}
+
+// ReviewVenue handles PUT /venues/{id}/reviews/me
+func (h *VenueReviewHandler) ReviewVenue(w http.ResponseWriter, r *http.Request) {
+	venueID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_venue_id", "Invalid venue ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ReviewVenueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_request", "Invalid request body")
+		return
+	}
+
+	tags := make([]domain.VenueReviewTag, len(req.Tags))
+	for i, tag := range req.Tags {
+		tags[i] = domain.VenueReviewTag(tag)
+	}
+
+	review, err := h.venueReviewsUseCase.ReviewVenue(r.Context(), &usecase.ReviewVenueRequest{
+		VenueID: venueID,
+		UserID:  userID,
+		Rating:  req.Rating,
+		Text:    req.Text,
+		Tags:    tags,
+	})
+	if err != nil {
+		h.writeVenueReviewError(w, err, "venue_review_failed", "Failed to review venue")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(h.convertToVenueReviewResponse(review))
+}
+
+// ListVenueReviews handles GET /venues/{id}/reviews
+func (h *VenueReviewHandler) ListVenueReviews(w http.ResponseWriter, r *http.Request) {
+	venueID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_venue_id", "Invalid venue ID")
+		return
+	}
+
+	req := &usecase.ListVenueReviewsRequest{VenueID: venueID}
+	req.Limit, req.Offset = parseModerationPage(r)
+
+	result, err := h.venueReviewsUseCase.ListVenueReviews(r.Context(), req)
+	if err != nil {
+		h.writeVenueReviewError(w, err, "venue_reviews_fetch_failed", "Failed to fetch venue reviews")
+		return
+	}
+
+	reviews := make([]VenueReviewResponse, len(result.Reviews))
+	for i, review := range result.Reviews {
+		reviews[i] = *h.convertToVenueReviewResponse(review)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(VenueReviewListResponse{
+		Reviews:       reviews,
+		RatingAverage: result.RatingAverage,
+		RatingCount:   result.RatingCount,
+		Total:         len(reviews),
+		Limit:         result.Limit,
+		Offset:        result.Offset,
+	})
+}
+
+// DeleteVenueReview handles DELETE /venues/{id}/reviews/me
+func (h *VenueReviewHandler) DeleteVenueReview(w http.ResponseWriter, r *http.Request) {
+	venueID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_venue_id", "Invalid venue ID")
+		return
+	}
+
+	userID, ok := h.getAuthenticatedUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err = h.venueReviewsUseCase.DeleteVenueReview(r.Context(), &usecase.DeleteVenueReviewRequest{
+		VenueID: venueID,
+		UserID:  userID,
+	})
+	if err != nil {
+		h.writeVenueReviewError(w, err, "venue_review_delete_failed", "Failed to delete venue review")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeVenueReviewError maps venue review use case errors to HTTP responses
+func (h *VenueReviewHandler) writeVenueReviewError(w http.ResponseWriter, err error, defaultCode, defaultMessage string) {
+	switch err {
+	case usecase.ErrVenueNotAttended:
+		h.writeErrorResponse(w, http.StatusForbidden, "venue_not_attended", err.Error())
+	case usecase.ErrOwnVenueReview:
+		h.writeErrorResponse(w, http.StatusForbidden, "own_venue", err.Error())
+	case usecase.ErrVenueNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "venue_not_found", "Venue not found")
+	case usecase.ErrVenueReviewNotFound:
+		h.writeErrorResponse(w, http.StatusNotFound, "venue_review_not_found", "Venue review not found")
+	case domain.ErrInvalidVenueRating, domain.ErrVenueReviewTextTooLong, domain.ErrInvalidVenueReviewTag:
+		h.writeErrorResponse(w, http.StatusBadRequest, "validation_error", err.Error())
+	default:
+		h.writeErrorResponse(w, http.StatusInternalServerError, defaultCode, defaultMessage)
+	}
+}
+
+// getAuthenticatedUserID extracts the authenticated user ID from the request
+func (h *VenueReviewHandler) getAuthenticatedUserID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(r)
+	if !ok {
+		h.writeErrorResponse(w, http.StatusUnauthorized, "unauthorized", "Authentication required")
+		return uuid.Nil, false
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "invalid_user_id", "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userUUID, true
+}
+
+// convertToVenueReviewResponse converts a domain venue review to the response format
+func (h *VenueReviewHandler) convertToVenueReviewResponse(review *domain.VenueReview) *VenueReviewResponse {
+	tags := make([]string, len(review.Tags))
+	for i, tag := range review.Tags {
+		tags[i] = string(tag)
+	}
+
+	return &VenueReviewResponse{
+		ID:        review.ID.String(),
+		VenueID:   review.VenueID.String(),
+		UserID:    review.UserID.String(),
+		Rating:    review.Rating,
+		Text:      review.Text,
+		Tags:      tags,
+		CreatedAt: review.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: review.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// writeErrorResponse writes a standardized error response
+func (h *VenueReviewHandler) writeErrorResponse(w http.ResponseWriter, statusCode int, errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   errorCode,
+		Message: message,
+	})
+}
+
+// RegisterRoutes registers venue review routes with the given router
+func (h *VenueReviewHandler) RegisterRoutes(router *mux.Router, authMiddleware *middleware.AuthMiddleware) {
+	// Players who attended an event at a venue manage their own review
+	protected := router.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+
+	protected.HandleFunc("/venues/{id}/reviews/me", h.ReviewVenue).Methods("PUT")
+	protected.HandleFunc("/venues/{id}/reviews/me", h.DeleteVenueReview).Methods("DELETE")
+
+	// Anyone can read reviews
+	public := router.PathPrefix("").Subrouter()
+
+	public.HandleFunc("/venues/{id}/reviews", h.ListVenueReviews).Methods("GET")
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
 	"github.com/matchtcg/backend/internal/service"
 )
 
@@ -67,16 +69,27 @@ func (m *AuthMiddleware) RequireAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		// Add claims to request context
-		ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, EmailKey, claims.Email)
-		ctx = context.WithValue(ctx, ClaimsKey, claims)
-
 		// Continue with authenticated request
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r.WithContext(contextWithClaims(r.Context(), claims)))
 	})
 }
 
+// contextWithClaims adds the token claims to the request context. Support sessions also carry the
+// impersonating admin so audit log entries name them.
+func contextWithClaims(ctx context.Context, claims *service.TokenClaims) context.Context {
+	ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+	ctx = context.WithValue(ctx, EmailKey, claims.Email)
+	ctx = context.WithValue(ctx, ClaimsKey, claims)
+
+	if claims.IsImpersonated() {
+		if impersonatorID, err := uuid.Parse(claims.ImpersonatorID); err == nil {
+			ctx = service.ContextWithImpersonatorID(ctx, impersonatorID)
+		}
+	}
+
+	return ctx
+}
+
 // OptionalAuth middleware that extracts user info if token is present but doesn't require it
 func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -88,11 +101,7 @@ func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 				// Validate token
 				claims, err := m.jwtService.ValidateAccessToken(parts[1])
 				if err == nil {
-					// Add claims to request context
-					ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
-					ctx = context.WithValue(ctx, EmailKey, claims.Email)
-					ctx = context.WithValue(ctx, ClaimsKey, claims)
-					r = r.WithContext(ctx)
+					r = r.WithContext(contextWithClaims(r.Context(), claims))
 				}
 			}
 		}
@@ -102,6 +111,44 @@ func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 	})
 }
 
+// RequireAdmin middleware that only lets platform admins through. It must run after RequireAuth.
+// Impersonation tokens are always rejected so support sessions cannot reach the admin API.
+func (m *AuthMiddleware) RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := GetClaims(r)
+		if !ok {
+			http.Error(w, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+
+		if claims.IsImpersonated() || claims.Role != string(domain.PlatformRoleAdmin) {
+			http.Error(w, "Admin access required", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RejectImpersonation middleware that keeps support sessions away from routes only the account owner may
+// use, such as changing the password, deleting the account or granting consent. It must run after RequireAuth.
+func (m *AuthMiddleware) RejectImpersonation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := GetClaims(r)
+		if !ok {
+			http.Error(w, "Authentication required", http.StatusUnauthorized)
+			return
+		}
+
+		if claims.IsImpersonated() {
+			http.Error(w, "Not allowed while impersonating a user", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (m *AuthMiddleware) AllowOnlyLocal(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ip, _, _ := net.SplitHostPort(r.RemoteAddr)
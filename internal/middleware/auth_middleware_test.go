@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/matchtcg/backend/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -207,6 +208,105 @@ func TestAuthMiddleware_OptionalAuth(t *testing.T) {
 	})
 }
 
+func TestAuthMiddleware_RequireAdmin(t *testing.T) {
+	jwtService, err := service.NewJWTService(service.JWTConfig{})
+	require.NoError(t, err)
+
+	authMiddleware := NewAuthMiddleware(jwtService)
+
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := authMiddleware.RequireAuth(authMiddleware.RequireAdmin(testHandler))
+
+	serve := func(token string) int {
+		req := httptest.NewRequest("GET", "/admin/users", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	t.Run("admin token", func(t *testing.T) {
+		tokenPair, err := jwtService.GenerateTokenPairWithRole("admin-user", "admin@example.com", "admin")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, serve(tokenPair.AccessToken))
+	})
+
+	t.Run("moderator token", func(t *testing.T) {
+		tokenPair, err := jwtService.GenerateTokenPairWithRole("mod-user", "mod@example.com", "moderator")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, serve(tokenPair.AccessToken))
+	})
+
+	t.Run("token without role", func(t *testing.T) {
+		tokenPair, err := jwtService.GenerateTokenPair("test-user", "test@example.com")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, serve(tokenPair.AccessToken))
+	})
+
+	t.Run("impersonation token", func(t *testing.T) {
+		token, _, err := jwtService.GenerateImpersonationToken("test-user", "test@example.com", "admin-user")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, serve(token))
+	})
+}
+
+func TestAuthMiddleware_RejectImpersonation(t *testing.T) {
+	jwtService, err := service.NewJWTService(service.JWTConfig{})
+	require.NoError(t, err)
+
+	authMiddleware := NewAuthMiddleware(jwtService)
+
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := authMiddleware.RequireAuth(authMiddleware.RejectImpersonation(testHandler))
+
+	serve := func(token string) int {
+		req := httptest.NewRequest("PUT", "/me/password", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	t.Run("user token", func(t *testing.T) {
+		tokenPair, err := jwtService.GenerateTokenPair("test-user", "test@example.com")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, serve(tokenPair.AccessToken))
+	})
+
+	t.Run("impersonation token", func(t *testing.T) {
+		token, _, err := jwtService.GenerateImpersonationToken("test-user", "test@example.com", "admin-user")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, serve(token))
+	})
+
+	t.Run("impersonating admin is passed on for the audit log", func(t *testing.T) {
+		adminID := uuid.New()
+		token, _, err := jwtService.GenerateImpersonationToken(uuid.NewString(), "test@example.com", adminID.String())
+		require.NoError(t, err)
+
+		var impersonatorID uuid.UUID
+		handler := authMiddleware.RequireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			impersonatorID, _ = service.ImpersonatorIDFromContext(r.Context())
+		}))
+
+		req := httptest.NewRequest("GET", "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.Equal(t, adminID, impersonatorID)
+	})
+}
+
 func TestContextHelpers(t *testing.T) {
 	t.Run("GetUserID", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/test", nil)
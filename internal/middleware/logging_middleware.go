@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/matchtcg/backend/internal/service"
 	"github.com/oklog/ulid/v2"
 )
 
@@ -94,7 +95,7 @@ func buildLogMessage(r *http.Request, rw *responseWriter, duration time.Duration
 		rw.statusCode,
 		rw.size,
 		duration,
-		getClientIP(r),
+		ClientIP(r),
 	)
 
 	// Add query parameters if present
@@ -131,12 +132,15 @@ func buildLogMessage(r *http.Request, rw *responseWriter, duration time.Duration
 	return msg
 }
 
+// maxRequestIDLength bounds client-supplied request IDs, which are stored with audit log entries
+const maxRequestIDLength = 64
+
 // RequestID middleware that adds a unique request ID to each request
 func (m *LoggingMiddleware) RequestID(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Check if request ID already exists
+		// Check if request ID already exists, replacing oversized client values
 		requestID := r.Header.Get("X-Request-ID")
-		if requestID == "" {
+		if requestID == "" || len(requestID) > maxRequestIDLength {
 			// Generate new request ID
 			requestID = generateRequestID()
 		}
@@ -147,8 +151,11 @@ func (m *LoggingMiddleware) RequestID(next http.Handler) http.Handler {
 		// Add request ID to request headers for downstream handlers
 		r.Header.Set("X-Request-ID", requestID)
 
+		// Expose request ID to use cases, e.g. for audit log entries
+		ctx := service.ContextWithRequestID(r.Context(), requestID)
+
 		// Continue with request
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
@@ -6,8 +6,10 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/matchtcg/backend/internal/service"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -259,6 +261,33 @@ func TestLoggingMiddleware_RequestID(t *testing.T) {
 		// Check that it was passed to handler
 		assert.Contains(t, rr.Body.String(), "request_id:existing-123")
 	})
+
+	t.Run("replaces oversized request ID", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("X-Request-ID", strings.Repeat("x", 65))
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		requestID := rr.Header().Get("X-Request-ID")
+		assert.NotEmpty(t, requestID)
+		assert.LessOrEqual(t, len(requestID), 64)
+	})
+
+	t.Run("stores request ID in context", func(t *testing.T) {
+		var contextID string
+		handler := middleware.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			contextID, _ = service.RequestIDFromContext(r.Context())
+		}))
+
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("X-Request-ID", "existing-123")
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, "existing-123", contextID)
+	})
 }
 
 func TestGenerateRequestID(t *testing.T) {
@@ -92,7 +92,7 @@ func NewRateLimitMiddleware(config RateLimitConfig) *RateLimitMiddleware {
 func (m *RateLimitMiddleware) RateLimit(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Get client IP
-		clientIP := getClientIP(r)
+		clientIP := ClientIP(r)
 
 		// Get or create bucket for this IP
 		bucket := m.getBucket(clientIP)
@@ -123,7 +123,7 @@ func (m *RateLimitMiddleware) RateLimitByUser(next http.Handler) http.Handler {
 		userID, hasUser := GetUserID(r)
 
 		// Fall back to IP if no user
-		key := getClientIP(r)
+		key := ClientIP(r)
 		if hasUser {
 			key = "user:" + userID
 		}
@@ -210,8 +210,8 @@ func (m *RateLimitMiddleware) Close() {
 	close(m.stopCh)
 }
 
-// getClientIP extracts the real client IP from the request
-func getClientIP(r *http.Request) string {
+// ClientIP extracts the real client IP from the request
+func ClientIP(r *http.Request) string {
 	// Check X-Forwarded-For header (from load balancers/proxies)
 	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
 		// Take the first IP in the chain
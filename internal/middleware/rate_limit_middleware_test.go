@@ -277,7 +277,7 @@ func TestGetClientIP(t *testing.T) {
 		req.Header.Set("X-Forwarded-For", "203.0.113.1, 198.51.100.1")
 		req.RemoteAddr = "192.168.1.1:12345"
 
-		ip := getClientIP(req)
+		ip := ClientIP(req)
 		assert.Equal(t, "203.0.113.1", ip)
 	})
 
@@ -286,7 +286,7 @@ func TestGetClientIP(t *testing.T) {
 		req.Header.Set("X-Real-IP", "203.0.113.2")
 		req.RemoteAddr = "192.168.1.1:12345"
 
-		ip := getClientIP(req)
+		ip := ClientIP(req)
 		assert.Equal(t, "203.0.113.2", ip)
 	})
 
@@ -294,7 +294,7 @@ func TestGetClientIP(t *testing.T) {
 		req := httptest.NewRequest("GET", "/test", nil)
 		req.RemoteAddr = "192.168.1.1:12345"
 
-		ip := getClientIP(req)
+		ip := ClientIP(req)
 		assert.Equal(t, "192.168.1.1", ip)
 	})
 
@@ -302,7 +302,7 @@ func TestGetClientIP(t *testing.T) {
 		req := httptest.NewRequest("GET", "/test", nil)
 		req.RemoteAddr = "192.168.1.1"
 
-		ip := getClientIP(req)
+		ip := ClientIP(req)
 		assert.Equal(t, "192.168.1.1", ip)
 	})
 }
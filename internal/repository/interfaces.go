@@ -80,6 +80,7 @@ type GroupRepository interface {
 	GetUserGroups(ctx context.Context, userID uuid.UUID) ([]*domain.Group, error)
 	GetUserGroupsWithMembers(ctx context.Context, userID uuid.UUID) ([]*domain.GroupWithMembers, error)
 	GetGroupsByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Group, error)
+	Search(ctx context.Context, params domain.GroupSearchParams) ([]*domain.GroupSearchResult, error)
 
 	// Member management
 	AddMember(ctx context.Context, member *domain.GroupMember) error
@@ -118,6 +119,7 @@ type VenueRepository interface {
 	GetByCreator(ctx context.Context, creatorID uuid.UUID, limit, offset int) ([]*domain.Venue, error)
 	GetByType(ctx context.Context, venueType domain.VenueType, limit, offset int) ([]*domain.Venue, error)
 	GetPopularVenues(ctx context.Context, limit, offset int) ([]*domain.Venue, error)
+	Search(ctx context.Context, params domain.VenueSearchParams) ([]*domain.Venue, error)
 
 	// Coordinate operations
 	GetVenuesInBounds(ctx context.Context, northLat, southLat, eastLon, westLon float64) ([]*domain.Venue, error)
@@ -141,6 +143,10 @@ type NotificationRepository interface {
 	GetPendingNotifications(ctx context.Context, limit int) ([]*domain.Notification, error)
 	GetFailedNotifications(ctx context.Context, limit int) ([]*domain.Notification, error)
 
+	// Queue inspection
+	GetNotificationsByStatus(ctx context.Context, status domain.NotificationStatus, limit, offset int) ([]*domain.Notification, error)
+	CountByStatus(ctx context.Context) (map[domain.NotificationStatus]int, error)
+
 	// Status management
 	MarkAsSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
 	MarkAsFailed(ctx context.Context, id uuid.UUID, errorMessage string) error
@@ -149,3 +155,329 @@ type NotificationRepository interface {
 	// Cleanup operations
 	DeleteOldNotifications(ctx context.Context, olderThan time.Time) error
 }
+
+// GroupWebhookRepository defines the interface for group webhook data operations
+type GroupWebhookRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, webhook *domain.GroupWebhook) error
+	GetByGroupID(ctx context.Context, groupID uuid.UUID) (*domain.GroupWebhook, error)
+	Update(ctx context.Context, webhook *domain.GroupWebhook) error
+	Delete(ctx context.Context, groupID uuid.UUID) error
+
+	// Delivery tracking
+	RecordDelivery(ctx context.Context, id uuid.UUID, deliveredAt time.Time, errorMessage *string) error
+}
+
+// GroupInviteRepository defines the interface for group invite data operations
+type GroupInviteRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, invite *domain.GroupInvite) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupInvite, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domain.GroupInvite, error)
+	Update(ctx context.Context, invite *domain.GroupInvite) error
+
+	// Invite queries (only invites that can still be redeemed)
+	GetPendingByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupInvite, error)
+	GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*domain.GroupInviteWithGroup, error)
+	GetPendingForUserInGroup(ctx context.Context, groupID, userID uuid.UUID) (*domain.GroupInvite, error)
+
+	// Redeem consumes one use of the invite and adds the user to the group atomically.
+	// It returns false when the invite is no longer redeemable.
+	Redeem(ctx context.Context, inviteID, userID uuid.UUID, joinedAt time.Time) (bool, error)
+}
+
+// GroupJoinRequestRepository defines the interface for group join request data operations
+type GroupJoinRequestRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, request *domain.GroupJoinRequest) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupJoinRequest, error)
+	Update(ctx context.Context, request *domain.GroupJoinRequest) error
+
+	// Join request queries
+	GetPendingByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupJoinRequest, error)
+	GetPendingForUserInGroup(ctx context.Context, groupID, userID uuid.UUID) (*domain.GroupJoinRequest, error)
+	GetUserRequests(ctx context.Context, userID uuid.UUID) ([]*domain.GroupJoinRequest, error)
+
+	// Approve marks the request as approved and adds the user as a member atomically
+	Approve(ctx context.Context, request *domain.GroupJoinRequest) error
+}
+
+// GroupLeagueRepository defines the interface for group league data operations
+type GroupLeagueRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, league *domain.GroupLeague) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupLeague, error)
+	Update(ctx context.Context, league *domain.GroupLeague) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupLeague, error)
+
+	// League event operations
+	LinkEvent(ctx context.Context, leagueID, eventID uuid.UUID, linkedAt time.Time) error
+	UnlinkEvent(ctx context.Context, leagueID, eventID uuid.UUID) error
+	IsEventLinked(ctx context.Context, leagueID, eventID uuid.UUID) (bool, error)
+	GetLeagueIDForEvent(ctx context.Context, eventID uuid.UUID) (*uuid.UUID, error)
+	GetLeagueEvents(ctx context.Context, leagueID uuid.UUID, limit, offset int) ([]*domain.Event, error)
+	// HasEventsOutsideSeason reports whether any linked event starts outside the given season
+	HasEventsOutsideSeason(ctx context.Context, leagueID uuid.UUID, seasonStart, seasonEnd time.Time) (bool, error)
+
+	// Result operations
+	// ReplaceEventResults replaces all results of an event atomically
+	ReplaceEventResults(ctx context.Context, leagueID, eventID uuid.UUID, results []*domain.LeagueResult) error
+	GetResults(ctx context.Context, leagueID uuid.UUID) ([]*domain.LeagueResult, error)
+	GetEventResults(ctx context.Context, leagueID, eventID uuid.UUID) ([]*domain.LeagueResult, error)
+}
+
+// GroupAnnouncementRepository defines the interface for group announcement data operations
+type GroupAnnouncementRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, announcement *domain.GroupAnnouncement) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupAnnouncement, error)
+	Update(ctx context.Context, announcement *domain.GroupAnnouncement) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetByGroup lists a group's board with pinned announcements first.
+	// Announcements expired at activeAt are skipped unless includeExpired is set.
+	GetByGroup(ctx context.Context, groupID uuid.UUID, activeAt time.Time, includeExpired bool, limit, offset int) ([]*domain.GroupAnnouncement, error)
+}
+
+// GroupModerationRepository defines the interface for group bans and the moderation audit trail
+type GroupModerationRepository interface {
+	// Ban operations
+	// Ban stores the ban, removes the user from the group, revokes their pending invites,
+	// rejects their pending join request and records the action, atomically
+	Ban(ctx context.Context, ban *domain.GroupBan, action *domain.GroupModerationAction) error
+	// Unban lifts the user's ban and records the action atomically
+	Unban(ctx context.Context, groupID, userID uuid.UUID, action *domain.GroupModerationAction) error
+	GetActiveBan(ctx context.Context, groupID, userID uuid.UUID, activeAt time.Time) (*domain.GroupBan, error)
+	GetActiveBans(ctx context.Context, groupID uuid.UUID, activeAt time.Time, limit, offset int) ([]*domain.GroupBan, error)
+
+	// Member removal
+	// RemoveMember removes a member and records the action atomically
+	RemoveMember(ctx context.Context, groupID, userID uuid.UUID, action *domain.GroupModerationAction) error
+
+	// Audit trail operations
+	GetActions(ctx context.Context, groupID uuid.UUID, limit, offset int) ([]*domain.GroupModerationAction, error)
+}
+
+// GroupCustomRoleRepository defines the interface for group custom role data operations
+type GroupCustomRoleRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, role *domain.GroupCustomRole) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupCustomRole, error)
+	Update(ctx context.Context, role *domain.GroupCustomRole) error
+	// Delete removes the role; members holding it keep their base role
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Role queries
+	GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupCustomRole, error)
+
+	// Member assignment
+	// AssignToMember sets or, with a nil roleID, clears a member's custom role
+	AssignToMember(ctx context.Context, groupID, userID uuid.UUID, roleID *uuid.UUID) error
+}
+
+// EventCoHostRepository defines the interface for event co-host data operations
+type EventCoHostRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, coHost *domain.EventCoHost) error
+	Get(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventCoHost, error)
+	// Update saves the co-host's permissions, status and response time
+	Update(ctx context.Context, coHost *domain.EventCoHost) error
+	Delete(ctx context.Context, eventID, userID uuid.UUID) error
+
+	// Co-host queries
+	// GetByEvent returns every co-host of an event whatever their invitation status
+	GetByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventCoHost, error)
+	GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*domain.EventCoHost, error)
+}
+
+// EventInviteRepository defines the interface for private event guest lists and invite links
+type EventInviteRepository interface {
+	// Guest list operations
+	AddGuest(ctx context.Context, guest *domain.EventGuest) error
+	GetGuest(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventGuest, error)
+	IsGuest(ctx context.Context, eventID, userID uuid.UUID) (bool, error)
+	RemoveGuest(ctx context.Context, eventID, userID uuid.UUID) error
+	GetGuestsByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventGuest, error)
+	// GetGuestEventIDs returns the events a user is on the guest list of
+	GetGuestEventIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+
+	// Invite link operations
+	CreateLink(ctx context.Context, link *domain.EventInviteLink) error
+	GetLinkByID(ctx context.Context, id uuid.UUID) (*domain.EventInviteLink, error)
+	GetLinkByTokenHash(ctx context.Context, tokenHash string) (*domain.EventInviteLink, error)
+	// UpdateLink saves the link's maximum uses, expiry and revocation time
+	UpdateLink(ctx context.Context, link *domain.EventInviteLink) error
+	GetLinksByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventInviteLink, error)
+	// RedeemLink consumes one use of the link and adds the guest in a single transaction.
+	// It returns false when the link was revoked, expired or used up in the meantime.
+	RedeemLink(ctx context.Context, linkID uuid.UUID, guest *domain.EventGuest) (bool, error)
+}
+
+// UserBlockRepository defines the interface for user block list data operations
+type UserBlockRepository interface {
+	// Basic CRUD operations
+	Create(ctx context.Context, block *domain.UserBlock) error
+	Delete(ctx context.Context, blockerID, blockedID uuid.UUID) error
+
+	// Block queries
+	IsBlocked(ctx context.Context, blockerID, blockedID uuid.UUID) (bool, error)
+	// IsBlockedEitherWay reports whether either user has blocked the other
+	IsBlockedEitherWay(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error)
+	GetBlockedByUser(ctx context.Context, blockerID uuid.UUID) ([]*domain.UserBlock, error)
+	// GetBlockedUserIDs returns the users a user has blocked or been blocked by
+	GetBlockedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// ContentModerationRepository defines the interface for content reports and platform moderation
+type ContentModerationRepository interface {
+	// Report operations
+	CreateReport(ctx context.Context, report *domain.Report) error
+	GetReport(ctx context.Context, id uuid.UUID) (*domain.Report, error)
+	HasOpenReport(ctx context.Context, reporterID uuid.UUID, targetType domain.ReportTargetType, targetID uuid.UUID) (bool, error)
+	// GetReports returns reports with the given status, oldest first, optionally limited to one kind of content
+	GetReports(ctx context.Context, status domain.ReportStatus, targetType *domain.ReportTargetType, limit, offset int) ([]*domain.Report, error)
+	// CloseReport saves a resolved or dismissed report, returning domain.ErrReportAlreadyClosed if it is
+	// no longer open. When an action is given it is applied to the reported content in the same
+	// transaction, recorded in the audit log, and every other open report about the same content is
+	// resolved with it.
+	CloseReport(ctx context.Context, report *domain.Report, action *domain.ContentModerationAction) error
+
+	// Moderation actions
+	// SetEventHidden hides an event from listings, or shows it again with a nil hiddenAt
+	SetEventHidden(ctx context.Context, eventID uuid.UUID, hiddenAt *time.Time) error
+	GetActions(ctx context.Context, limit, offset int) ([]*domain.ContentModerationAction, error)
+}
+
+// PlatformAdminRepository defines the interface for platform admin operations and their audit trail
+type PlatformAdminRepository interface {
+	// SearchUsers matches users by email or display name, newest first. An empty query lists all users.
+	SearchUsers(ctx context.Context, query string, limit, offset int) ([]*domain.User, error)
+
+	// Account operations record the given action in the same transaction
+	SetUserActive(ctx context.Context, userID uuid.UUID, active bool, action *domain.AdminAction) error
+	SetPlatformRole(ctx context.Context, userID uuid.UUID, role domain.PlatformRole, action *domain.AdminAction) error
+
+	// Audit trail
+	CreateAction(ctx context.Context, action *domain.AdminAction) error
+	// GetActions returns admin actions newest first, optionally limited to those on one user
+	GetActions(ctx context.Context, targetUserID *uuid.UUID, limit, offset int) ([]*domain.AdminAction, error)
+}
+
+// AuditLogRepository defines the interface for the append-only audit log
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *domain.AuditLogEntry) error
+	// List returns entries matching the filter, newest first
+	List(ctx context.Context, filter domain.AuditLogFilter, limit, offset int) ([]*domain.AuditLogEntry, error)
+}
+
+// ConsentRepository defines the interface for the append-only consent history
+type ConsentRepository interface {
+	Create(ctx context.Context, record *domain.ConsentRecord) error
+	// GetLatest returns the user's most recent record for a consent type, or nil if there is none
+	GetLatest(ctx context.Context, userID uuid.UUID, consentType domain.ConsentType) (*domain.ConsentRecord, error)
+	// GetCurrent returns the most recent record for each consent type the user has answered
+	GetCurrent(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error)
+	// GetHistory returns every record for the user, newest first
+	GetHistory(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error)
+}
+
+// DataExportRepository defines the interface for data export archive requests
+type DataExportRepository interface {
+	Create(ctx context.Context, export *domain.DataExport) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.DataExport, error)
+	Update(ctx context.Context, export *domain.DataExport) error
+	// CountSince counts the exports a user requested at or after the given time
+	CountSince(ctx context.Context, userID uuid.UUID, since time.Time) (int, error)
+	// GetActive returns the user's pending or processing export, or nil if there is none
+	GetActive(ctx context.Context, userID uuid.UUID) (*domain.DataExport, error)
+	// ClaimPending marks up to limit pending exports as processing and returns them, oldest first
+	ClaimPending(ctx context.Context, limit int) ([]*domain.DataExport, error)
+	// RecoverStale requeues exports left processing since before startedBefore, such as by a crashed
+	// worker, and fails those already claimed maxAttempts times. It returns how many were requeued and failed.
+	RecoverStale(ctx context.Context, startedBefore time.Time, maxAttempts int) (requeued, failed int, err error)
+}
+
+// AccountDeletionRepository defines the interface for scheduled account deletions
+type AccountDeletionRepository interface {
+	Create(ctx context.Context, deletion *domain.AccountDeletion) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.AccountDeletion, error)
+	// GetScheduled returns the user's deletion that is still in its grace period, or nil if there is none
+	GetScheduled(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error)
+	Update(ctx context.Context, deletion *domain.AccountDeletion) error
+	// GetDue returns scheduled deletions whose grace period ended at or before now, oldest first
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.AccountDeletion, error)
+	// Complete applies the deletion's report, removes the user's personal data, anonymizes the
+	// account and records the deletion as completed in a single transaction. It returns the storage
+	// keys of the user's export archives, whose files the caller deletes once the deletion is committed.
+	Complete(ctx context.Context, deletion *domain.AccountDeletion) ([]string, error)
+}
+
+// RetentionRepository defines the interface for enforcing data retention policies
+type RetentionRepository interface {
+	// CountExpired counts the records of an entity that fall outside a retention period ending at cutoff
+	CountExpired(ctx context.Context, entity domain.RetentionEntity, cutoff time.Time) (int, error)
+	// DeleteExpired deletes expired notifications, audit log entries or past RSVPs and returns how many were removed
+	DeleteExpired(ctx context.Context, entity domain.RetentionEntity, cutoff time.Time) (int, error)
+	// ListInactiveUsers returns up to limit accounts with no activity since cutoff that are neither
+	// anonymized nor already scheduled for deletion, least recently active first
+	ListInactiveUsers(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error)
+	// ListExpiredDataExports returns up to limit finished exports whose download window closed before cutoff
+	ListExpiredDataExports(ctx context.Context, cutoff time.Time, limit int) ([]*domain.DataExport, error)
+	// DeleteDataExports deletes export records by ID and returns how many were removed
+	DeleteDataExports(ctx context.Context, ids []uuid.UUID) (int, error)
+}
+
+// VenueClaimRepository defines the interface for venue ownership claims
+type VenueClaimRepository interface {
+	CreateClaim(ctx context.Context, claim *domain.VenueClaim) error
+	GetClaim(ctx context.Context, id uuid.UUID) (*domain.VenueClaim, error)
+	HasPendingClaim(ctx context.Context, venueID, claimantID uuid.UUID) (bool, error)
+	// GetClaims returns claims with the given status, oldest first
+	GetClaims(ctx context.Context, status domain.VenueClaimStatus, limit, offset int) ([]*domain.VenueClaim, error)
+	// ReviewClaim saves an admin's decision. Approving a claim makes the claimant the venue's verified
+	// owner and rejects the venue's other pending claims in the same transaction. It returns
+	// domain.ErrVenueClaimAlreadyReviewed when the claim is no longer pending.
+	ReviewClaim(ctx context.Context, claim *domain.VenueClaim) error
+
+	// SetEventOfficial marks an event as official, or clears the mark
+	SetEventOfficial(ctx context.Context, eventID uuid.UUID, official bool) error
+}
+
+// VenueDuplicateRepository defines the interface for venue duplicate detection and merging
+type VenueDuplicateRepository interface {
+	// FindSimilarVenues returns other venues within radiusKm of the venue, or in its city, whose name or
+	// address is trigram-similar to it, most similar name first
+	FindSimilarVenues(ctx context.Context, venue *domain.Venue, radiusKm float64, limit int) ([]*domain.Venue, error)
+	// MergeVenues moves the events and reviews of the redirect's source venue to its target, deletes the
+	// source and records the redirect, all in one transaction. Redirects that pointed at the source follow it.
+	MergeVenues(ctx context.Context, redirect *domain.VenueRedirect) error
+	GetRedirect(ctx context.Context, fromVenueID uuid.UUID) (*domain.VenueRedirect, error)
+}
+
+// VenueReviewRepository defines the interface for venue reviews and ratings
+type VenueReviewRepository interface {
+	// UpsertReview creates the user's review of a venue or replaces their earlier one, and refreshes the
+	// venue's rating aggregates in the same transaction
+	UpsertReview(ctx context.Context, review *domain.VenueReview) error
+	GetReview(ctx context.Context, venueID, userID uuid.UUID) (*domain.VenueReview, error)
+	// GetReviews returns a venue's reviews, newest first
+	GetReviews(ctx context.Context, venueID uuid.UUID, limit, offset int) ([]*domain.VenueReview, error)
+	// DeleteReview removes the user's review and refreshes the venue's rating aggregates
+	DeleteReview(ctx context.Context, venueID, userID uuid.UUID) error
+
+	// HasAttendedEventAt checks whether the user RSVPed going to an event at the venue that started
+	// before the given time and was not cancelled. If the event's organizers checked attendees in,
+	// the user must have been checked in.
+	HasAttendedEventAt(ctx context.Context, userID, venueID uuid.UUID, before time.Time) (bool, error)
+}
+
+// MapClusterRepository defines the interface for clustering venues and events on a map
+type MapClusterRepository interface {
+	// GetVenueClusters groups the venues within the bounds by grid cell. Each cluster's games count
+	// the venues hosting upcoming public events of that game; with a game filter only those venues
+	// are clustered.
+	GetVenueClusters(ctx context.Context, grid *domain.MapGrid, params domain.MapClusterParams) ([]*domain.MapCluster, error)
+	// GetEventClusters groups the upcoming public events at venues within the bounds by grid cell
+	GetEventClusters(ctx context.Context, grid *domain.MapGrid, params domain.MapClusterParams) ([]*domain.MapCluster, error)
+}
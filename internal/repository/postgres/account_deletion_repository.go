@@ -0,0 +1,328 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type accountDeletionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewAccountDeletionRepository creates a new PostgreSQL account deletion repository
+func NewAccountDeletionRepository(db *pgxpool.Pool) repository.AccountDeletionRepository {
+	return &accountDeletionRepository{db: db}
+}
+
+const accountDeletionColumns = `id, user_id, status, requested_at, scheduled_for, cancelled_at, completed_at, report`
+
+// personalDataDeletes removes rows that belong to a deleted user alone. Records the user created in
+// shared spaces, such as league results and moderation history, stay attached to the anonymized account.
+var personalDataDeletes = []struct {
+	name  string
+	query string
+}{
+	{"event co-host roles", `DELETE FROM event_cohosts WHERE user_id = $1`},
+	{"event guest entries", `DELETE FROM event_guests WHERE user_id = $1`},
+	{"group join requests", `DELETE FROM group_join_requests WHERE user_id = $1`},
+	{"group invites", `DELETE FROM group_invites WHERE invited_user_id = $1`},
+	{"user blocks", `DELETE FROM user_blocks WHERE blocker_id = $1 OR blocked_id = $1`},
+	{"notifications", `DELETE FROM notifications WHERE user_id = $1`},
+	{"consents", `DELETE FROM consents WHERE user_id = $1`},
+	{"profile", `DELETE FROM profiles WHERE user_id = $1`},
+	{"venue references", `UPDATE venues SET created_by = NULL WHERE created_by = $1`},
+}
+
+// Create schedules a new account deletion
+func (r *accountDeletionRepository) Create(ctx context.Context, deletion *domain.AccountDeletion) error {
+	reportJSON, err := marshalAccountDeletionReport(deletion.Report)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO account_deletions (` + accountDeletionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = r.db.Exec(ctx, query,
+		deletion.ID,
+		deletion.UserID,
+		deletion.Status,
+		deletion.RequestedAt,
+		deletion.ScheduledFor,
+		deletion.CancelledAt,
+		deletion.CompletedAt,
+		reportJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create account deletion: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an account deletion by ID
+func (r *accountDeletionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AccountDeletion, error) {
+	query := `SELECT ` + accountDeletionColumns + ` FROM account_deletions WHERE id = $1`
+
+	deletion, err := scanAccountDeletion(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get account deletion: %w", err)
+	}
+
+	return deletion, nil
+}
+
+// GetScheduled retrieves the user's deletion that is still in its grace period
+func (r *accountDeletionRepository) GetScheduled(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error) {
+	query := `
+		SELECT ` + accountDeletionColumns + `
+		FROM account_deletions
+		WHERE user_id = $1 AND status = 'scheduled'`
+
+	deletion, err := scanAccountDeletion(r.db.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get scheduled account deletion: %w", err)
+	}
+
+	return deletion, nil
+}
+
+// Update updates the state of an account deletion
+func (r *accountDeletionRepository) Update(ctx context.Context, deletion *domain.AccountDeletion) error {
+	reportJSON, err := marshalAccountDeletionReport(deletion.Report)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.Exec(ctx, updateAccountDeletionQuery,
+		deletion.ID,
+		deletion.Status,
+		deletion.CancelledAt,
+		deletion.CompletedAt,
+		reportJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update account deletion: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("account deletion not found")
+	}
+
+	return nil
+}
+
+// GetDue retrieves scheduled deletions whose grace period has ended
+func (r *accountDeletionRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	query := `
+		SELECT ` + accountDeletionColumns + `
+		FROM account_deletions
+		WHERE status = 'scheduled' AND scheduled_for <= $1
+		ORDER BY scheduled_for ASC
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due account deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var deletions []*domain.AccountDeletion
+	for rows.Next() {
+		deletion, err := scanAccountDeletion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan account deletion: %w", err)
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	return deletions, nil
+}
+
+// Complete carries out an account deletion marked completed in a single transaction
+func (r *accountDeletionRepository) Complete(ctx context.Context, deletion *domain.AccountDeletion) ([]string, error) {
+	if deletion.Report == nil || deletion.CompletedAt == nil {
+		return nil, fmt.Errorf("account deletion has not been marked completed")
+	}
+	report := deletion.Report
+	completedAt := *deletion.CompletedAt
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Hand future events over, dropping the new host's co-host role as they now run the event
+	for _, handover := range report.EventHandovers {
+		result, err := tx.Exec(ctx, `UPDATE events SET host_user_id = $2 WHERE id = $1 AND host_user_id = $3`,
+			handover.EventID, handover.NewHostUserID, deletion.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hand over event: %w", err)
+		}
+		if result.RowsAffected() == 0 {
+			return nil, fmt.Errorf("event %s is no longer hosted by the deleted user", handover.EventID)
+		}
+
+		if _, err := tx.Exec(ctx, `DELETE FROM event_cohosts WHERE event_id = $1 AND user_id = $2`,
+			handover.EventID, handover.NewHostUserID); err != nil {
+			return nil, fmt.Errorf("failed to remove new host's co-host role: %w", err)
+		}
+	}
+
+	if len(report.CancelledEventIDs) > 0 {
+		if _, err := tx.Exec(ctx, `UPDATE events SET cancelled_at = $2 WHERE id = ANY($1) AND cancelled_at IS NULL`,
+			report.CancelledEventIDs, completedAt); err != nil {
+			return nil, fmt.Errorf("failed to cancel events: %w", err)
+		}
+	}
+
+	for _, transfer := range report.GroupTransfers {
+		if _, err := tx.Exec(ctx, `UPDATE groups SET owner_user_id = $2 WHERE id = $1`,
+			transfer.GroupID, transfer.NewOwnerUserID); err != nil {
+			return nil, fmt.Errorf("failed to transfer group ownership: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, `UPDATE group_members SET role = 'owner' WHERE group_id = $1 AND user_id = $2`,
+			transfer.GroupID, transfer.NewOwnerUserID); err != nil {
+			return nil, fmt.Errorf("failed to promote new group owner: %w", err)
+		}
+	}
+
+	if len(report.DeletedGroupIDs) > 0 {
+		if _, err := tx.Exec(ctx, `DELETE FROM groups WHERE id = ANY($1)`, report.DeletedGroupIDs); err != nil {
+			return nil, fmt.Errorf("failed to delete groups: %w", err)
+		}
+	}
+
+	result, err := tx.Exec(ctx, `DELETE FROM event_rsvp WHERE user_id = $1`, deletion.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete RSVPs: %w", err)
+	}
+	report.RemovedRSVPs = int(result.RowsAffected())
+
+	result, err = tx.Exec(ctx, `DELETE FROM group_members WHERE user_id = $1`, deletion.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete group memberships: %w", err)
+	}
+	report.RemovedMemberships = int(result.RowsAffected())
+
+	// Export rows go with the account; their archives are deleted from storage once this commits
+	rows, err := tx.Query(ctx, `DELETE FROM data_exports WHERE user_id = $1 RETURNING storage_key`, deletion.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete data exports: %w", err)
+	}
+	var exportKeys []string
+	for rows.Next() {
+		var storageKey *string
+		if err := rows.Scan(&storageKey); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+		if storageKey != nil {
+			exportKeys = append(exportKeys, *storageKey)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to delete data exports: %w", err)
+	}
+
+	for _, step := range personalDataDeletes {
+		if _, err := tx.Exec(ctx, step.query, deletion.UserID); err != nil {
+			return nil, fmt.Errorf("failed to delete %s: %w", step.name, err)
+		}
+	}
+
+	// The account row is kept so past events keep their host, but nothing in it identifies the user
+	result, err = tx.Exec(ctx, `
+		UPDATE users
+		SET email = 'deleted-' || id || '@deleted.invalid', password_hash = '', is_active = false,
+			platform_role = 'user', last_login = NULL, anonymized_at = $2, updated_at = NOW()
+		WHERE id = $1`, deletion.UserID, completedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to anonymize user: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	reportJSON, err := marshalAccountDeletionReport(report)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, updateAccountDeletionQuery,
+		deletion.ID, deletion.Status, deletion.CancelledAt, deletion.CompletedAt, reportJSON); err != nil {
+		return nil, fmt.Errorf("failed to complete account deletion: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return exportKeys, nil
+}
+
+const updateAccountDeletionQuery = `
+	UPDATE account_deletions
+	SET status = $2, cancelled_at = $3, completed_at = $4, report = $5
+	WHERE id = $1`
+
+// marshalAccountDeletionReport encodes a report for the JSONB column, keeping NULL for missing reports
+func marshalAccountDeletionReport(report *domain.AccountDeletionReport) ([]byte, error) {
+	if report == nil {
+		return nil, nil
+	}
+
+	reportJSON, err := json.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal account deletion report: %w", err)
+	}
+
+	return reportJSON, nil
+}
+
+// scanAccountDeletion scans an account deletion row selected with accountDeletionColumns
+func scanAccountDeletion(row pgx.Row) (*domain.AccountDeletion, error) {
+	var deletion domain.AccountDeletion
+	var reportJSON []byte
+
+	err := row.Scan(
+		&deletion.ID,
+		&deletion.UserID,
+		&deletion.Status,
+		&deletion.RequestedAt,
+		&deletion.ScheduledFor,
+		&deletion.CancelledAt,
+		&deletion.CompletedAt,
+		&reportJSON,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if reportJSON != nil {
+		if err := json.Unmarshal(reportJSON, &deletion.Report); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal account deletion report: %w", err)
+		}
+	}
+
+	return &deletion, nil
+}
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountDeletionRepository_ScheduleAndCancel(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewAccountDeletionRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+
+	scheduled, err := repo.GetScheduled(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, scheduled)
+
+	deletion := domain.NewAccountDeletion(user.ID, now.Add(-time.Hour), time.Minute)
+	require.NoError(t, repo.Create(ctx, deletion))
+
+	// Only one deletion per user can be scheduled at a time
+	assert.Error(t, repo.Create(ctx, domain.NewAccountDeletion(user.ID, now, time.Minute)))
+
+	due, err := repo.GetDue(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, deletion.ID, due[0].ID)
+
+	require.NoError(t, deletion.Cancel(now))
+	require.NoError(t, repo.Update(ctx, deletion))
+
+	scheduled, err = repo.GetScheduled(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, scheduled)
+
+	stored, err := repo.GetByID(ctx, deletion.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, domain.AccountDeletionStatusCancelled, stored.Status)
+}
+
+func TestAccountDeletionRepository_Complete(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewAccountDeletionRepository(db)
+	eventRepo := NewEventRepository(db)
+	userRepo := NewUserRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	player := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+
+	newEvent := func(startAt time.Time) *domain.Event {
+		event := &domain.Event{
+			ID:         uuid.New(),
+			HostUserID: user.ID,
+			Title:      "Commander Night",
+			Game:       domain.GameTypeMTG,
+			Visibility: domain.EventVisibilityPublic,
+			StartAt:    startAt,
+			EndAt:      startAt.Add(3 * time.Hour),
+			Timezone:   "UTC",
+			Language:   "en",
+			Rules:      map[string]interface{}{},
+			Tags:       []string{},
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		require.NoError(t, eventRepo.Create(ctx, event))
+		return event
+	}
+
+	pastEvent := newEvent(now.Add(-48 * time.Hour))
+	handedOver := newEvent(now.Add(24 * time.Hour))
+	cancelled := newEvent(now.Add(48 * time.Hour))
+
+	// Other players' RSVPs survive, the deleted user's own do not
+	for _, rsvp := range []*domain.EventRSVP{
+		{EventID: cancelled.ID, UserID: player.ID, Status: domain.RSVPStatusGoing, CreatedAt: now, UpdatedAt: now},
+		{EventID: pastEvent.ID, UserID: user.ID, Status: domain.RSVPStatusGoing, CreatedAt: now, UpdatedAt: now},
+	} {
+		require.NoError(t, eventRepo.CreateRSVP(ctx, rsvp))
+	}
+
+	// The user's export rows go, and the keys of their archives come back so the files can be deleted
+	exportRepo := NewDataExportRepository(db)
+	storageKey := "exports/" + user.ID.String() + ".zip"
+	export := domain.NewDataExport(user.ID, now)
+	require.NoError(t, exportRepo.Create(ctx, export))
+	export.MarkReady(storageKey, 1024, now, now.Add(time.Hour))
+	require.NoError(t, exportRepo.Update(ctx, export))
+
+	deletion := domain.NewAccountDeletion(user.ID, now.Add(-time.Hour), 0)
+	require.NoError(t, repo.Create(ctx, deletion))
+
+	deletion.MarkCompleted(&domain.AccountDeletionReport{
+		EventHandovers:     []domain.EventHandover{{EventID: handedOver.ID, NewHostUserID: player.ID}},
+		CancelledEventIDs:  []uuid.UUID{cancelled.ID},
+		AnonymizedEventIDs: []uuid.UUID{pastEvent.ID},
+	}, now)
+	exportKeys, err := repo.Complete(ctx, deletion)
+	require.NoError(t, err)
+	assert.Equal(t, []string{storageKey}, exportKeys)
+
+	storedExport, err := exportRepo.GetByID(ctx, export.ID)
+	require.NoError(t, err)
+	assert.Nil(t, storedExport)
+
+	anonymized, err := userRepo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, anonymized)
+	assert.NotEqual(t, user.Email, anonymized.Email)
+	assert.False(t, anonymized.IsActive)
+
+	event, err := eventRepo.GetByID(ctx, handedOver.ID)
+	require.NoError(t, err)
+	assert.Equal(t, player.ID, event.HostUserID)
+
+	event, err = eventRepo.GetByID(ctx, cancelled.ID)
+	require.NoError(t, err)
+	assert.True(t, event.IsCancelled())
+
+	rsvps, err := eventRepo.GetEventRSVPs(ctx, cancelled.ID)
+	require.NoError(t, err)
+	assert.Len(t, rsvps, 1)
+
+	event, err = eventRepo.GetByID(ctx, pastEvent.ID)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+
+	stored, err := repo.GetByID(ctx, deletion.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AccountDeletionStatusCompleted, stored.Status)
+	require.NotNil(t, stored.Report)
+	assert.Equal(t, 1, stored.Report.RemovedRSVPs)
+	assert.Equal(t, []uuid.UUID{cancelled.ID}, stored.Report.CancelledEventIDs)
+}
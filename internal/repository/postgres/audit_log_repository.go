@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type auditLogRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewAuditLogRepository creates a new PostgreSQL audit log repository
+func NewAuditLogRepository(db *pgxpool.Pool) repository.AuditLogRepository {
+	return &auditLogRepository{db: db}
+}
+
+const auditLogColumns = `id, actor_id, impersonator_id, action, target_type, target_id, subject_user_id, before_state, after_state, request_id, created_at`
+
+// Create appends an entry to the audit log
+func (r *auditLogRepository) Create(ctx context.Context, entry *domain.AuditLogEntry) error {
+	beforeJSON, err := marshalAuditState(entry.Before)
+	if err != nil {
+		return err
+	}
+
+	afterJSON, err := marshalAuditState(entry.After)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO audit_log (` + auditLogColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err = r.db.Exec(ctx, query,
+		entry.ID,
+		entry.ActorID,
+		entry.ImpersonatorID,
+		entry.Action,
+		entry.TargetType,
+		entry.TargetID,
+		entry.SubjectUserID,
+		beforeJSON,
+		afterJSON,
+		entry.RequestID,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log entry: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves audit log entries matching the filter, newest first
+func (r *auditLogRepository) List(ctx context.Context, filter domain.AuditLogFilter, limit, offset int) ([]*domain.AuditLogEntry, error) {
+	query := `
+		SELECT ` + auditLogColumns + `
+		FROM audit_log
+		WHERE ($1::uuid IS NULL OR actor_id = $1 OR subject_user_id = $1)
+			AND ($2::uuid IS NULL OR actor_id = $2)
+			AND ($3::text IS NULL OR action = $3)
+			AND ($4::text IS NULL OR target_type = $4)
+			AND ($5::uuid IS NULL OR target_id = $5)
+			AND ($6::text IS NULL OR request_id = $6)
+		ORDER BY created_at DESC
+		LIMIT $7 OFFSET $8`
+
+	rows, err := r.db.Query(ctx, query,
+		filter.AccountID,
+		filter.ActorID,
+		filter.Action,
+		filter.TargetType,
+		filter.TargetID,
+		filter.RequestID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.AuditLogEntry
+	for rows.Next() {
+		var entry domain.AuditLogEntry
+		var beforeJSON, afterJSON []byte
+		err := rows.Scan(
+			&entry.ID,
+			&entry.ActorID,
+			&entry.ImpersonatorID,
+			&entry.Action,
+			&entry.TargetType,
+			&entry.TargetID,
+			&entry.SubjectUserID,
+			&beforeJSON,
+			&afterJSON,
+			&entry.RequestID,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit log entry: %w", err)
+		}
+
+		if beforeJSON != nil {
+			if err := json.Unmarshal(beforeJSON, &entry.Before); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal audit log before state: %w", err)
+			}
+		}
+
+		if afterJSON != nil {
+			if err := json.Unmarshal(afterJSON, &entry.After); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal audit log after state: %w", err)
+			}
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+// marshalAuditState encodes a before/after snapshot, keeping SQL NULL for absent state
+func marshalAuditState(state map[string]interface{}) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit log state: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogRepository_CreateAndList(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewAuditLogRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	admin := createTestUser(t, db)
+	eventID := uuid.New()
+	requestID := "01HZX3Q7E5N8J2K4M6P8R0T2V4"
+	now := time.Now().Truncate(time.Microsecond)
+
+	login := domain.NewAuditLogEntry(&user.ID, domain.AuditActionLogin, domain.AuditTargetUser, user.ID, &user.ID, now)
+	require.NoError(t, repo.Create(ctx, login))
+
+	update := domain.NewAuditLogEntry(&user.ID, domain.AuditActionEventUpdate, domain.AuditTargetEvent, eventID, &user.ID, now.Add(time.Second))
+	update.Before = map[string]interface{}{"title": "Friday Draft"}
+	update.After = map[string]interface{}{"title": "Friday Night Draft"}
+	update.RequestID = &requestID
+	require.NoError(t, repo.Create(ctx, update))
+
+	// An admin acting on the user shows up in the user's own history
+	roleChange := domain.NewAuditLogEntry(&admin.ID, domain.AuditActionRoleChange, domain.AuditTargetUser, user.ID, &user.ID, now.Add(2*time.Second))
+	roleChange.ImpersonatorID = &admin.ID
+	require.NoError(t, repo.Create(ctx, roleChange))
+
+	entries, err := repo.List(ctx, domain.AuditLogFilter{AccountID: &user.ID}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, domain.AuditActionRoleChange, entries[0].Action)
+	assert.Equal(t, admin.ID, *entries[0].ImpersonatorID)
+	assert.Nil(t, entries[1].ImpersonatorID)
+	assert.Equal(t, "Friday Night Draft", entries[1].After["title"])
+	assert.Equal(t, requestID, *entries[1].RequestID)
+	assert.Nil(t, entries[2].Before)
+
+	action := domain.AuditActionEventUpdate
+	entries, err = repo.List(ctx, domain.AuditLogFilter{Action: &action}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, eventID, entries[0].TargetID)
+
+	entries, err = repo.List(ctx, domain.AuditLogFilter{ActorID: &admin.ID}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, user.ID, *entries[0].SubjectUserID)
+
+	// Entries cannot be rewritten once recorded
+	_, err = db.Exec(ctx, `UPDATE audit_log SET action = 'login' WHERE id = $1`, update.ID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type consentRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewConsentRepository creates a new PostgreSQL consent repository
+func NewConsentRepository(db *pgxpool.Pool) repository.ConsentRepository {
+	return &consentRepository{db: db}
+}
+
+const consentColumns = `id, user_id, consent_type, granted, policy_version, ip_address, user_agent, metadata, created_at`
+
+// Create appends a consent record to the user's history
+func (r *consentRepository) Create(ctx context.Context, record *domain.ConsentRecord) error {
+	var metadataJSON []byte
+	if record.Metadata != nil {
+		data, err := json.Marshal(record.Metadata)
+		if err != nil {
+			return fmt.Errorf("failed to marshal consent metadata: %w", err)
+		}
+		metadataJSON = data
+	}
+
+	query := `
+		INSERT INTO consents (` + consentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.db.Exec(ctx, query,
+		record.ID,
+		record.UserID,
+		record.ConsentType,
+		record.Granted,
+		record.PolicyVersion,
+		record.IPAddress,
+		record.UserAgent,
+		metadataJSON,
+		record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consent record: %w", err)
+	}
+
+	return nil
+}
+
+// GetLatest retrieves the user's most recent record for a consent type
+func (r *consentRepository) GetLatest(ctx context.Context, userID uuid.UUID, consentType domain.ConsentType) (*domain.ConsentRecord, error) {
+	query := `
+		SELECT ` + consentColumns + `
+		FROM consents
+		WHERE user_id = $1 AND consent_type = $2
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	record, err := scanConsentRecord(r.db.QueryRow(ctx, query, userID, consentType))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get consent record: %w", err)
+	}
+
+	return record, nil
+}
+
+// GetCurrent retrieves the most recent record for each consent type the user has answered
+func (r *consentRepository) GetCurrent(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error) {
+	query := `
+		SELECT DISTINCT ON (consent_type) ` + consentColumns + `
+		FROM consents
+		WHERE user_id = $1
+		ORDER BY consent_type, created_at DESC`
+
+	return r.queryConsentRecords(ctx, query, userID)
+}
+
+// GetHistory retrieves every consent record for the user, newest first
+func (r *consentRepository) GetHistory(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error) {
+	query := `
+		SELECT ` + consentColumns + `
+		FROM consents
+		WHERE user_id = $1
+		ORDER BY created_at DESC`
+
+	return r.queryConsentRecords(ctx, query, userID)
+}
+
+// queryConsentRecords runs a query returning consent rows
+func (r *consentRepository) queryConsentRecords(ctx context.Context, query string, args ...interface{}) ([]*domain.ConsentRecord, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consent records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*domain.ConsentRecord
+	for rows.Next() {
+		record, err := scanConsentRecord(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan consent record: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// scanConsentRecord scans a single consent row
+func scanConsentRecord(row pgx.Row) (*domain.ConsentRecord, error) {
+	var record domain.ConsentRecord
+	var metadataJSON []byte
+	err := row.Scan(
+		&record.ID,
+		&record.UserID,
+		&record.ConsentType,
+		&record.Granted,
+		&record.PolicyVersion,
+		&record.IPAddress,
+		&record.UserAgent,
+		&metadataJSON,
+		&record.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadataJSON != nil {
+		if err := json.Unmarshal(metadataJSON, &record.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal consent metadata: %w", err)
+		}
+	}
+
+	return &record, nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsentRepository_History(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewConsentRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+
+	latest, err := repo.GetLatest(ctx, user.ID, domain.ConsentTypeMarketingEmails)
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	granted := domain.NewConsentRecord(user.ID, domain.ConsentTypeMarketingEmails, true, "2025-01", "203.0.113.7", "Mozilla/5.0", now)
+	granted.Metadata = map[string]interface{}{"source": "signup"}
+	require.NoError(t, repo.Create(ctx, granted))
+
+	analytics := domain.NewConsentRecord(user.ID, domain.ConsentTypeAnalytics, true, "2025-01", "", "", now.Add(time.Second))
+	require.NoError(t, repo.Create(ctx, analytics))
+
+	revoked := domain.NewConsentRecord(user.ID, domain.ConsentTypeMarketingEmails, false, "2025-10", "198.51.100.2", "Mozilla/5.0", now.Add(2*time.Second))
+	require.NoError(t, repo.Create(ctx, revoked))
+
+	latest, err = repo.GetLatest(ctx, user.ID, domain.ConsentTypeMarketingEmails)
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.False(t, latest.Granted)
+	assert.Equal(t, "2025-10", latest.PolicyVersion)
+	assert.Equal(t, "198.51.100.2", *latest.IPAddress)
+
+	current, err := repo.GetCurrent(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, current, 2)
+	for _, record := range current {
+		if record.ConsentType == domain.ConsentTypeMarketingEmails {
+			assert.Equal(t, revoked.ID, record.ID)
+		} else {
+			assert.Equal(t, analytics.ID, record.ID)
+		}
+	}
+
+	// Revoking keeps the earlier grant in the history
+	history, err := repo.GetHistory(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, revoked.ID, history[0].ID)
+	assert.Equal(t, granted.ID, history[2].ID)
+	assert.Equal(t, "signup", history[2].Metadata["source"])
+	assert.Nil(t, history[1].IPAddress)
+
+	// Records cannot be rewritten once recorded
+	_, err = db.Exec(ctx, `UPDATE consents SET granted = true WHERE id = $1`, revoked.ID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,294 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type contentModerationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewContentModerationRepository creates a new PostgreSQL content moderation repository
+func NewContentModerationRepository(db *pgxpool.Pool) repository.ContentModerationRepository {
+	return &contentModerationRepository{db: db}
+}
+
+const reportColumns = `id, reporter_id, target_type, target_id, reason, details, status, resolved_by, resolved_at,
+	resolution_note, created_at, updated_at`
+
+const contentModerationActionColumns = `id, actor_user_id, action, target_type, target_id, report_id, note, created_at`
+
+// CreateReport creates a new report
+func (r *contentModerationRepository) CreateReport(ctx context.Context, report *domain.Report) error {
+	query := `
+		INSERT INTO reports (id, reporter_id, target_type, target_id, reason, details, status, resolved_by,
+			resolved_at, resolution_note, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	_, err := r.db.Exec(ctx, query,
+		report.ID,
+		report.ReporterID,
+		report.TargetType,
+		report.TargetID,
+		report.Reason,
+		report.Details,
+		report.Status,
+		report.ResolvedBy,
+		report.ResolvedAt,
+		report.ResolutionNote,
+		report.CreatedAt,
+		report.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create report: %w", err)
+	}
+
+	return nil
+}
+
+// GetReport retrieves a report by ID
+func (r *contentModerationRepository) GetReport(ctx context.Context, id uuid.UUID) (*domain.Report, error) {
+	query := `SELECT ` + reportColumns + ` FROM reports WHERE id = $1`
+
+	report, err := scanReport(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return report, nil
+}
+
+// HasOpenReport checks whether a user already has an open report about the same content
+func (r *contentModerationRepository) HasOpenReport(ctx context.Context, reporterID uuid.UUID, targetType domain.ReportTargetType, targetID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM reports
+			WHERE reporter_id = $1 AND target_type = $2 AND target_id = $3 AND status = 'open'
+		)`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, reporterID, targetType, targetID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check open report: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetReports retrieves reports in a given status, oldest first
+func (r *contentModerationRepository) GetReports(ctx context.Context, status domain.ReportStatus, targetType *domain.ReportTargetType, limit, offset int) ([]*domain.Report, error) {
+	query := `
+		SELECT ` + reportColumns + `
+		FROM reports
+		WHERE status = $1 AND ($2::report_target_type IS NULL OR target_type = $2)
+		ORDER BY created_at ASC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.Query(ctx, query, status, targetType, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reports: %w", err)
+	}
+	defer rows.Close()
+
+	var reports []*domain.Report
+	for rows.Next() {
+		report, err := scanReport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan report: %w", err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// CloseReport claims an open report, carries out the moderation action taken, if any, and records
+// both in one transaction so two moderators closing the same report cannot both act on the content
+func (r *contentModerationRepository) CloseReport(ctx context.Context, report *domain.Report, action *domain.ContentModerationAction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE reports
+		SET status = $2, resolved_by = $3, resolved_at = $4, resolution_note = $5, updated_at = $6
+		WHERE id = $1 AND status = 'open'`
+
+	result, err := tx.Exec(ctx, query,
+		report.ID,
+		report.Status,
+		report.ResolvedBy,
+		report.ResolvedAt,
+		report.ResolutionNote,
+		report.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to close report: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return domain.ErrReportAlreadyClosed
+	}
+
+	if action != nil {
+		if err := applyContentModerationAction(ctx, tx, action); err != nil {
+			return err
+		}
+
+		// Other reports about the same content are settled by the same action
+		query = `
+			UPDATE reports
+			SET status = 'resolved', resolved_by = $3, resolved_at = $4, resolution_note = $5, updated_at = $4
+			WHERE target_type = $1 AND target_id = $2 AND status = 'open'`
+
+		_, err = tx.Exec(ctx, query, report.TargetType, report.TargetID, report.ResolvedBy, report.ResolvedAt, report.ResolutionNote)
+		if err != nil {
+			return fmt.Errorf("failed to resolve related reports: %w", err)
+		}
+
+		query = `
+			INSERT INTO content_moderation_actions (id, actor_user_id, action, target_type, target_id, report_id,
+				note, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+		_, err = tx.Exec(ctx, query,
+			action.ID,
+			action.ActorUserID,
+			action.Action,
+			action.TargetType,
+			action.TargetID,
+			action.ReportID,
+			action.Note,
+			action.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record moderation action: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// applyContentModerationAction carries out a moderation action on the reported content
+func applyContentModerationAction(ctx context.Context, tx pgx.Tx, action *domain.ContentModerationAction) error {
+	var query string
+	args := []interface{}{action.TargetID, action.CreatedAt}
+
+	switch action.Action {
+	case domain.ContentActionHideEvent:
+		query = `UPDATE events SET hidden_at = $2 WHERE id = $1`
+	case domain.ContentActionDeactivateGroup:
+		query = `UPDATE groups SET is_active = false, updated_at = $2 WHERE id = $1`
+	case domain.ContentActionDeactivateUser:
+		query = `UPDATE users SET is_active = false, updated_at = $2 WHERE id = $1`
+	case domain.ContentActionRemoveVenue:
+		query = `DELETE FROM venues WHERE id = $1`
+		args = args[:1]
+	default:
+		return domain.ErrInvalidContentAction
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to apply moderation action: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("reported content not found")
+	}
+
+	return nil
+}
+
+// SetEventHidden hides an event from listings or makes it visible again
+func (r *contentModerationRepository) SetEventHidden(ctx context.Context, eventID uuid.UUID, hiddenAt *time.Time) error {
+	result, err := r.db.Exec(ctx, `UPDATE events SET hidden_at = $2 WHERE id = $1`, eventID, hiddenAt)
+	if err != nil {
+		return fmt.Errorf("failed to set event hidden: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("event not found")
+	}
+
+	return nil
+}
+
+// GetActions retrieves the platform moderation audit log, newest first
+func (r *contentModerationRepository) GetActions(ctx context.Context, limit, offset int) ([]*domain.ContentModerationAction, error) {
+	query := `
+		SELECT ` + contentModerationActionColumns + `
+		FROM content_moderation_actions
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderation actions: %w", err)
+	}
+	defer rows.Close()
+
+	var actions []*domain.ContentModerationAction
+	for rows.Next() {
+		var action domain.ContentModerationAction
+		err := rows.Scan(
+			&action.ID,
+			&action.ActorUserID,
+			&action.Action,
+			&action.TargetType,
+			&action.TargetID,
+			&action.ReportID,
+			&action.Note,
+			&action.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan moderation action: %w", err)
+		}
+		actions = append(actions, &action)
+	}
+
+	return actions, nil
+}
+
+// scanReport scans a report row selected with reportColumns
+func scanReport(row pgx.Row) (*domain.Report, error) {
+	var report domain.Report
+
+	err := row.Scan(
+		&report.ID,
+		&report.ReporterID,
+		&report.TargetType,
+		&report.TargetID,
+		&report.Reason,
+		&report.Details,
+		&report.Status,
+		&report.ResolvedBy,
+		&report.ResolvedAt,
+		&report.ResolutionNote,
+		&report.CreatedAt,
+		&report.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentModerationRepository_ReportQueue(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewContentModerationRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+
+	host := createTestUser(t, db)
+	reporter := createTestUser(t, db)
+	otherReporter := createTestUser(t, db)
+	moderator := createTestUser(t, db)
+
+	now := time.Now().Truncate(time.Microsecond)
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: host.ID,
+		Title:      "Totally Real Prize Pool",
+		Game:       domain.GameTypeMTG,
+		Visibility: domain.EventVisibilityPublic,
+		StartAt:    now.Add(time.Hour),
+		EndAt:      now.Add(4 * time.Hour),
+		Timezone:   "UTC",
+		Language:   "en",
+		Rules:      map[string]interface{}{},
+		Tags:       []string{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	require.NoError(t, eventRepo.Create(ctx, event))
+
+	newReport := func(reporterID uuid.UUID) *domain.Report {
+		return &domain.Report{
+			ID:         uuid.New(),
+			ReporterID: reporterID,
+			TargetType: domain.ReportTargetEvent,
+			TargetID:   event.ID,
+			Reason:     domain.ReportReasonScam,
+			Status:     domain.ReportStatusOpen,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+	}
+
+	report := newReport(reporter.ID)
+	require.NoError(t, repo.CreateReport(ctx, report))
+
+	// A user has at most one open report about the same content
+	assert.Error(t, repo.CreateReport(ctx, newReport(reporter.ID)))
+
+	other := newReport(otherReporter.ID)
+	require.NoError(t, repo.CreateReport(ctx, other))
+
+	hasOpen, err := repo.HasOpenReport(ctx, reporter.ID, domain.ReportTargetEvent, event.ID)
+	require.NoError(t, err)
+	assert.True(t, hasOpen)
+
+	targetType := domain.ReportTargetEvent
+	queue, err := repo.GetReports(ctx, domain.ReportStatusOpen, &targetType, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, queue, 2)
+
+	userType := domain.ReportTargetUser
+	queue, err = repo.GetReports(ctx, domain.ReportStatusOpen, &userType, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+
+	// Closing the report with a hide action removes the event from searches
+	hiddenAt := now.Add(time.Minute)
+	require.NoError(t, report.Close(domain.ReportStatusResolved, moderator.ID, nil, hiddenAt))
+	action := domain.NewContentModerationAction(moderator.ID, domain.ContentActionHideEvent, event.ID, &report.ID, nil, hiddenAt)
+	require.NoError(t, repo.CloseReport(ctx, report, action))
+
+	stored, err := eventRepo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsHidden())
+
+	found, err := eventRepo.Search(ctx, domain.EventSearchParams{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	// A report can only be closed once, and a second moderator's action is not applied
+	require.NoError(t, repo.SetEventHidden(ctx, event.ID, nil))
+	again := domain.NewContentModerationAction(moderator.ID, domain.ContentActionHideEvent, event.ID, &report.ID, nil, hiddenAt)
+	assert.ErrorIs(t, repo.CloseReport(ctx, report, again), domain.ErrReportAlreadyClosed)
+
+	stored, err = eventRepo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.IsHidden())
+
+	// The action settles every report about the event
+	stored2, err := repo.GetReport(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReportStatusResolved, stored2.Status)
+	require.NotNil(t, stored2.ResolvedBy)
+	assert.Equal(t, moderator.ID, *stored2.ResolvedBy)
+
+	queue, err = repo.GetReports(ctx, domain.ReportStatusOpen, nil, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+
+	actions, err := repo.GetActions(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	assert.Equal(t, domain.ContentActionHideEvent, actions[0].Action)
+	assert.Equal(t, report.ID, *actions[0].ReportID)
+}
@@ -0,0 +1,231 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type dataExportRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewDataExportRepository creates a new PostgreSQL data export repository
+func NewDataExportRepository(db *pgxpool.Pool) repository.DataExportRepository {
+	return &dataExportRepository{db: db}
+}
+
+const dataExportColumns = `id, user_id, status, storage_key, file_size, error, created_at, started_at, attempts, completed_at, expires_at`
+
+// dataExportTimeoutError is recorded on exports that stayed in processing through every attempt
+const dataExportTimeoutError = "archive build did not finish in time"
+
+// Create creates a new data export request
+func (r *dataExportRepository) Create(ctx context.Context, export *domain.DataExport) error {
+	query := `
+		INSERT INTO data_exports (` + dataExportColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err := r.db.Exec(ctx, query,
+		export.ID,
+		export.UserID,
+		export.Status,
+		export.StorageKey,
+		export.FileSize,
+		export.Error,
+		export.CreatedAt,
+		export.StartedAt,
+		export.Attempts,
+		export.CompletedAt,
+		export.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a data export by ID
+func (r *dataExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.DataExport, error) {
+	query := `SELECT ` + dataExportColumns + ` FROM data_exports WHERE id = $1`
+
+	export, err := scanDataExport(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get data export: %w", err)
+	}
+
+	return export, nil
+}
+
+// Update updates the processing state of a data export
+func (r *dataExportRepository) Update(ctx context.Context, export *domain.DataExport) error {
+	query := `
+		UPDATE data_exports
+		SET status = $2, storage_key = $3, file_size = $4, error = $5, completed_at = $6, expires_at = $7
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		export.ID,
+		export.Status,
+		export.StorageKey,
+		export.FileSize,
+		export.Error,
+		export.CompletedAt,
+		export.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update data export: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("data export not found")
+	}
+
+	return nil
+}
+
+// CountSince counts the exports a user requested at or after the given time
+func (r *dataExportRepository) CountSince(ctx context.Context, userID uuid.UUID, since time.Time) (int, error) {
+	query := `SELECT COUNT(*) FROM data_exports WHERE user_id = $1 AND created_at >= $2`
+
+	var count int
+	if err := r.db.QueryRow(ctx, query, userID, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count data exports: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetActive retrieves the user's pending or processing export
+func (r *dataExportRepository) GetActive(ctx context.Context, userID uuid.UUID) (*domain.DataExport, error) {
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM data_exports
+		WHERE user_id = $1 AND status IN ('pending', 'processing')
+		ORDER BY created_at DESC
+		LIMIT 1`
+
+	export, err := scanDataExport(r.db.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get active data export: %w", err)
+	}
+
+	return export, nil
+}
+
+// ClaimPending marks pending exports as processing, skipping rows another worker has locked
+func (r *dataExportRepository) ClaimPending(ctx context.Context, limit int) ([]*domain.DataExport, error) {
+	query := `
+		UPDATE data_exports
+		SET status = 'processing', started_at = NOW(), attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM data_exports
+			WHERE status = 'pending'
+			ORDER BY created_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + dataExportColumns
+
+	rows, err := r.db.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending data exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*domain.DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim pending data exports: %w", err)
+	}
+
+	// RETURNING does not preserve the subquery order
+	sort.Slice(exports, func(i, j int) bool {
+		return exports[i].CreatedAt.Before(exports[j].CreatedAt)
+	})
+
+	return exports, nil
+}
+
+// RecoverStale puts exports that have been processing since before startedBefore back in the queue,
+// or fails them once they have been claimed maxAttempts times
+func (r *dataExportRepository) RecoverStale(ctx context.Context, startedBefore time.Time, maxAttempts int) (int, int, error) {
+	query := `
+		UPDATE data_exports
+		SET status = (CASE WHEN attempts < $2 THEN 'pending' ELSE 'failed' END)::data_export_status,
+			error = CASE WHEN attempts < $2 THEN NULL ELSE $3 END,
+			completed_at = CASE WHEN attempts < $2 THEN NULL ELSE NOW() END
+		WHERE status = 'processing' AND COALESCE(started_at, created_at) < $1
+		RETURNING status`
+
+	rows, err := r.db.Query(ctx, query, startedBefore, maxAttempts, dataExportTimeoutError)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to recover stale data exports: %w", err)
+	}
+	defer rows.Close()
+
+	var requeued, failed int
+	for rows.Next() {
+		var status domain.DataExportStatus
+		if err := rows.Scan(&status); err != nil {
+			return 0, 0, fmt.Errorf("failed to scan data export status: %w", err)
+		}
+		if status == domain.DataExportStatusPending {
+			requeued++
+		} else {
+			failed++
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, 0, fmt.Errorf("failed to recover stale data exports: %w", err)
+	}
+
+	return requeued, failed, nil
+}
+
+// scanDataExport scans a data export row selected with dataExportColumns
+func scanDataExport(row pgx.Row) (*domain.DataExport, error) {
+	var export domain.DataExport
+
+	err := row.Scan(
+		&export.ID,
+		&export.UserID,
+		&export.Status,
+		&export.StorageKey,
+		&export.FileSize,
+		&export.Error,
+		&export.CreatedAt,
+		&export.StartedAt,
+		&export.Attempts,
+		&export.CompletedAt,
+		&export.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export, nil
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataExportRepository_Lifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewDataExportRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+
+	active, err := repo.GetActive(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, active)
+
+	older := domain.NewDataExport(user.ID, now.Add(-2*time.Hour))
+	require.NoError(t, repo.Create(ctx, older))
+	newer := domain.NewDataExport(user.ID, now)
+	require.NoError(t, repo.Create(ctx, newer))
+
+	count, err := repo.CountSince(ctx, user.ID, now.Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	active, err = repo.GetActive(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, active)
+	assert.Equal(t, newer.ID, active.ID)
+
+	claimed, err := repo.ClaimPending(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, older.ID, claimed[0].ID)
+	assert.Equal(t, domain.DataExportStatusProcessing, claimed[0].Status)
+
+	// Claimed exports are not handed out twice
+	claimed, err = repo.ClaimPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, newer.ID, claimed[0].ID)
+
+	claimed[0].MarkReady("archive.zip", 2048, now, now.Add(24*time.Hour))
+	require.NoError(t, repo.Update(ctx, claimed[0]))
+
+	stored, err := repo.GetByID(ctx, newer.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, domain.DataExportStatusReady, stored.Status)
+	assert.Equal(t, "archive.zip", *stored.StorageKey)
+	assert.Equal(t, int64(2048), *stored.FileSize)
+	assert.True(t, stored.IsDownloadable(now))
+}
+
+func TestDataExportRepository_RecoverStale(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewDataExportRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+
+	// A worker crashed after claiming the export
+	lost := domain.NewDataExport(user.ID, now.Add(-2*time.Hour))
+	require.NoError(t, repo.Create(ctx, lost))
+	claimed, err := repo.ClaimPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.NotNil(t, claimed[0].StartedAt)
+	assert.Equal(t, 1, claimed[0].Attempts)
+
+	// Exports still within the timeout are left to their worker
+	requeued, failed, err := repo.RecoverStale(ctx, now.Add(-time.Hour), 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0, requeued+failed)
+
+	requeued, failed, err = repo.RecoverStale(ctx, time.Now().Add(time.Minute), 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requeued)
+	assert.Equal(t, 0, failed)
+
+	active, err := repo.GetActive(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, active)
+	assert.Equal(t, domain.DataExportStatusPending, active.Status)
+
+	// Once out of attempts the export is failed so the user can request a new one
+	claimed, err = repo.ClaimPending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, 2, claimed[0].Attempts)
+
+	requeued, failed, err = repo.RecoverStale(ctx, time.Now().Add(time.Minute), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, requeued)
+	assert.Equal(t, 1, failed)
+
+	stored, err := repo.GetByID(ctx, lost.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DataExportStatusFailed, stored.Status)
+	require.NotNil(t, stored.Error)
+	assert.NotNil(t, stored.CompletedAt)
+
+	active, err = repo.GetActive(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, active)
+}
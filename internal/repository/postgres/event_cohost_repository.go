@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type eventCoHostRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewEventCoHostRepository creates a new PostgreSQL event co-host repository
+func NewEventCoHostRepository(db *pgxpool.Pool) repository.EventCoHostRepository {
+	return &eventCoHostRepository{db: db}
+}
+
+const eventCoHostColumns = `event_id, user_id, invited_by, permissions, status, created_at, updated_at, responded_at`
+
+// Create creates a new co-host invitation
+func (r *eventCoHostRepository) Create(ctx context.Context, coHost *domain.EventCoHost) error {
+	query := `
+		INSERT INTO event_cohosts (event_id, user_id, invited_by, permissions, status, created_at, updated_at, responded_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := r.db.Exec(ctx, query,
+		coHost.EventID,
+		coHost.UserID,
+		coHost.InvitedBy,
+		permissionsToStrings(coHost.Permissions),
+		coHost.Status,
+		coHost.CreatedAt,
+		coHost.UpdatedAt,
+		coHost.RespondedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create event co-host: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves a user's co-host record for an event
+func (r *eventCoHostRepository) Get(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventCoHost, error) {
+	query := `SELECT ` + eventCoHostColumns + ` FROM event_cohosts WHERE event_id = $1 AND user_id = $2`
+
+	coHost, err := scanEventCoHost(r.db.QueryRow(ctx, query, eventID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get event co-host: %w", err)
+	}
+
+	return coHost, nil
+}
+
+// Update updates a co-host's invitation
+func (r *eventCoHostRepository) Update(ctx context.Context, coHost *domain.EventCoHost) error {
+	query := `
+		UPDATE event_cohosts
+		SET invited_by = $3, permissions = $4, status = $5, updated_at = $6, responded_at = $7
+		WHERE event_id = $1 AND user_id = $2`
+
+	result, err := r.db.Exec(ctx, query,
+		coHost.EventID,
+		coHost.UserID,
+		coHost.InvitedBy,
+		permissionsToStrings(coHost.Permissions),
+		coHost.Status,
+		coHost.UpdatedAt,
+		coHost.RespondedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update event co-host: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("event co-host not found")
+	}
+
+	return nil
+}
+
+// Delete removes a co-host from an event
+func (r *eventCoHostRepository) Delete(ctx context.Context, eventID, userID uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM event_cohosts WHERE event_id = $1 AND user_id = $2`, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete event co-host: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("event co-host not found")
+	}
+
+	return nil
+}
+
+// GetByEvent retrieves an event's co-hosts in invitation order
+func (r *eventCoHostRepository) GetByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventCoHost, error) {
+	query := `
+		SELECT ` + eventCoHostColumns + `
+		FROM event_cohosts
+		WHERE event_id = $1
+		ORDER BY created_at ASC`
+
+	return r.queryEventCoHosts(ctx, query, eventID)
+}
+
+// GetPendingByUser retrieves the co-host invitations a user has not answered yet
+func (r *eventCoHostRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*domain.EventCoHost, error) {
+	query := `
+		SELECT ` + eventCoHostColumns + `
+		FROM event_cohosts
+		WHERE user_id = $1 AND status = 'pending'
+		ORDER BY created_at DESC`
+
+	return r.queryEventCoHosts(ctx, query, userID)
+}
+
+func (r *eventCoHostRepository) queryEventCoHosts(ctx context.Context, query string, args ...interface{}) ([]*domain.EventCoHost, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event co-hosts: %w", err)
+	}
+	defer rows.Close()
+
+	var coHosts []*domain.EventCoHost
+	for rows.Next() {
+		coHost, err := scanEventCoHost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event co-host: %w", err)
+		}
+		coHosts = append(coHosts, coHost)
+	}
+
+	return coHosts, nil
+}
+
+// getAcceptedEventCoHosts loads the co-hosts whose delegated rights are in effect for an event
+func getAcceptedEventCoHosts(ctx context.Context, db *pgxpool.Pool, eventID uuid.UUID) ([]domain.EventCoHost, error) {
+	query := `
+		SELECT ` + eventCoHostColumns + `
+		FROM event_cohosts
+		WHERE event_id = $1 AND status = 'accepted'
+		ORDER BY created_at ASC`
+
+	rows, err := db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event co-hosts: %w", err)
+	}
+	defer rows.Close()
+
+	var coHosts []domain.EventCoHost
+	for rows.Next() {
+		coHost, err := scanEventCoHost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event co-host: %w", err)
+		}
+		coHosts = append(coHosts, *coHost)
+	}
+
+	return coHosts, nil
+}
+
+// scanEventCoHost scans a co-host row selected with eventCoHostColumns
+func scanEventCoHost(row pgx.Row) (*domain.EventCoHost, error) {
+	var coHost domain.EventCoHost
+	var permissions []string
+
+	err := row.Scan(
+		&coHost.EventID,
+		&coHost.UserID,
+		&coHost.InvitedBy,
+		&permissions,
+		&coHost.Status,
+		&coHost.CreatedAt,
+		&coHost.UpdatedAt,
+		&coHost.RespondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		coHost.Permissions = append(coHost.Permissions, domain.Permission(permission))
+	}
+
+	return &coHost, nil
+}
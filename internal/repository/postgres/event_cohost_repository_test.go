@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventCoHostRepository_InviteAndAccept(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewEventCoHostRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+
+	host := createTestUser(t, db)
+	staff := createTestUser(t, db)
+
+	now := time.Now().Truncate(time.Microsecond)
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: host.ID,
+		Title:      "Friday Night Magic",
+		Game:       domain.GameTypeMTG,
+		Visibility: domain.EventVisibilityPublic,
+		StartAt:    now.Add(time.Hour),
+		EndAt:      now.Add(4 * time.Hour),
+		Timezone:   "UTC",
+		Language:   "en",
+		Rules:      map[string]interface{}{},
+		Tags:       []string{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	require.NoError(t, eventRepo.Create(ctx, event))
+
+	coHost := &domain.EventCoHost{
+		EventID:     event.ID,
+		UserID:      staff.ID,
+		InvitedBy:   host.ID,
+		Permissions: []domain.Permission{domain.PermissionManageAttendees, domain.PermissionCheckIn},
+		Status:      domain.EventCoHostStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	require.NoError(t, repo.Create(ctx, coHost))
+
+	// A user is invited at most once per event
+	assert.Error(t, repo.Create(ctx, coHost))
+
+	pending, err := repo.GetPendingByUser(ctx, staff.ID)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	// Pending co-hosts are not loaded with the event
+	loaded, err := eventRepo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Empty(t, loaded.CoHosts)
+
+	require.NoError(t, coHost.Respond(true, now.Add(time.Minute)))
+	require.NoError(t, repo.Update(ctx, coHost))
+
+	stored, err := repo.Get(ctx, event.ID, staff.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.EventCoHostStatusAccepted, stored.Status)
+	assert.ElementsMatch(t, coHost.Permissions, stored.Permissions)
+	require.NotNil(t, stored.RespondedAt)
+
+	details, err := eventRepo.GetByIDWithDetails(ctx, event.ID)
+	require.NoError(t, err)
+	require.Len(t, details.CoHosts, 1)
+	assert.Equal(t, staff.ID, details.CoHosts[0].UserID)
+
+	pending, err = repo.GetPendingByUser(ctx, staff.ID)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	require.NoError(t, repo.Delete(ctx, event.ID, staff.ID))
+
+	stored, err = repo.Get(ctx, event.ID, staff.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+
+	assert.Error(t, repo.Delete(ctx, event.ID, staff.ID))
+}
@@ -0,0 +1,322 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type eventInviteRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewEventInviteRepository creates a new PostgreSQL event invite repository
+func NewEventInviteRepository(db *pgxpool.Pool) repository.EventInviteRepository {
+	return &eventInviteRepository{db: db}
+}
+
+const eventGuestColumns = `event_id, user_id, invited_by, invite_link_id, created_at`
+
+const eventInviteLinkColumns = `id, event_id, created_by, token_hash, max_uses, use_count, expires_at,
+		revoked_at, created_at, updated_at`
+
+// AddGuest adds a user to an event's guest list
+func (r *eventInviteRepository) AddGuest(ctx context.Context, guest *domain.EventGuest) error {
+	query := `
+		INSERT INTO event_guests (event_id, user_id, invited_by, invite_link_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	_, err := r.db.Exec(ctx, query,
+		guest.EventID,
+		guest.UserID,
+		guest.InvitedBy,
+		guest.InviteLinkID,
+		guest.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to add event guest: %w", err)
+	}
+
+	return nil
+}
+
+// GetGuest retrieves a user's guest list entry for an event
+func (r *eventInviteRepository) GetGuest(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventGuest, error) {
+	query := `SELECT ` + eventGuestColumns + ` FROM event_guests WHERE event_id = $1 AND user_id = $2`
+
+	guest, err := scanEventGuest(r.db.QueryRow(ctx, query, eventID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get event guest: %w", err)
+	}
+
+	return guest, nil
+}
+
+// IsGuest checks if a user is on an event's guest list
+func (r *eventInviteRepository) IsGuest(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM event_guests WHERE event_id = $1 AND user_id = $2)`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, eventID, userID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check event guest: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetGuestEventIDs retrieves the events a user is on the guest list of
+func (r *eventInviteRepository) GetGuestEventIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.db.Query(ctx, `SELECT event_id FROM event_guests WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guest event IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var eventIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan guest event ID: %w", err)
+		}
+		eventIDs = append(eventIDs, id)
+	}
+
+	return eventIDs, nil
+}
+
+// RemoveGuest removes a user from an event's guest list
+func (r *eventInviteRepository) RemoveGuest(ctx context.Context, eventID, userID uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM event_guests WHERE event_id = $1 AND user_id = $2`, eventID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove event guest: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("event guest not found")
+	}
+
+	return nil
+}
+
+// GetGuestsByEvent retrieves an event's guest list in the order guests were added
+func (r *eventInviteRepository) GetGuestsByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventGuest, error) {
+	query := `
+		SELECT ` + eventGuestColumns + `
+		FROM event_guests
+		WHERE event_id = $1
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event guests: %w", err)
+	}
+	defer rows.Close()
+
+	var guests []*domain.EventGuest
+	for rows.Next() {
+		guest, err := scanEventGuest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event guest: %w", err)
+		}
+		guests = append(guests, guest)
+	}
+
+	return guests, nil
+}
+
+// CreateLink creates a new event invite link
+func (r *eventInviteRepository) CreateLink(ctx context.Context, link *domain.EventInviteLink) error {
+	query := `
+		INSERT INTO event_invite_links (id, event_id, created_by, token_hash, max_uses, use_count, expires_at,
+			created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.db.Exec(ctx, query,
+		link.ID,
+		link.EventID,
+		link.CreatedBy,
+		link.TokenHash,
+		link.MaxUses,
+		link.UseCount,
+		link.ExpiresAt,
+		link.CreatedAt,
+		link.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create event invite link: %w", err)
+	}
+
+	return nil
+}
+
+// GetLinkByID retrieves an event invite link by ID
+func (r *eventInviteRepository) GetLinkByID(ctx context.Context, id uuid.UUID) (*domain.EventInviteLink, error) {
+	query := `SELECT ` + eventInviteLinkColumns + ` FROM event_invite_links WHERE id = $1`
+
+	link, err := scanEventInviteLink(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get event invite link: %w", err)
+	}
+
+	return link, nil
+}
+
+// GetLinkByTokenHash retrieves an event invite link by the hash of its token
+func (r *eventInviteRepository) GetLinkByTokenHash(ctx context.Context, tokenHash string) (*domain.EventInviteLink, error) {
+	query := `SELECT ` + eventInviteLinkColumns + ` FROM event_invite_links WHERE token_hash = $1`
+
+	link, err := scanEventInviteLink(r.db.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get event invite link by token: %w", err)
+	}
+
+	return link, nil
+}
+
+// UpdateLink updates the mutable fields of an event invite link
+func (r *eventInviteRepository) UpdateLink(ctx context.Context, link *domain.EventInviteLink) error {
+	query := `
+		UPDATE event_invite_links
+		SET max_uses = $2, expires_at = $3, revoked_at = $4, updated_at = $5
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		link.ID,
+		link.MaxUses,
+		link.ExpiresAt,
+		link.RevokedAt,
+		link.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update event invite link: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("event invite link not found")
+	}
+
+	return nil
+}
+
+// GetLinksByEvent retrieves every invite link of an event, newest first
+func (r *eventInviteRepository) GetLinksByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventInviteLink, error) {
+	query := `
+		SELECT ` + eventInviteLinkColumns + `
+		FROM event_invite_links
+		WHERE event_id = $1
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event invite links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*domain.EventInviteLink
+	for rows.Next() {
+		link, err := scanEventInviteLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event invite link: %w", err)
+		}
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+// RedeemLink consumes one use of the link and adds the guest in a single transaction
+func (r *eventInviteRepository) RedeemLink(ctx context.Context, linkID uuid.UUID, guest *domain.EventGuest) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Consume a use only while the link is still redeemable, so concurrent
+	// redemptions cannot exceed its maximum uses
+	redeemQuery := `
+		UPDATE event_invite_links
+		SET use_count = use_count + 1
+		WHERE id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		  AND (max_uses IS NULL OR use_count < max_uses)`
+
+	result, err := tx.Exec(ctx, redeemQuery, linkID)
+	if err != nil {
+		return false, fmt.Errorf("failed to redeem event invite link: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	guestQuery := `
+		INSERT INTO event_guests (event_id, user_id, invited_by, invite_link_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	_, err = tx.Exec(ctx, guestQuery, guest.EventID, guest.UserID, guest.InvitedBy, guest.InviteLinkID, guest.CreatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to add event guest: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// scanEventGuest scans a guest row selected with eventGuestColumns
+func scanEventGuest(row pgx.Row) (*domain.EventGuest, error) {
+	var guest domain.EventGuest
+	err := row.Scan(
+		&guest.EventID,
+		&guest.UserID,
+		&guest.InvitedBy,
+		&guest.InviteLinkID,
+		&guest.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &guest, nil
+}
+
+// scanEventInviteLink scans an invite link row selected with eventInviteLinkColumns
+func scanEventInviteLink(row pgx.Row) (*domain.EventInviteLink, error) {
+	var link domain.EventInviteLink
+	err := row.Scan(
+		&link.ID,
+		&link.EventID,
+		&link.CreatedBy,
+		&link.TokenHash,
+		&link.MaxUses,
+		&link.UseCount,
+		&link.ExpiresAt,
+		&link.RevokedAt,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &link, nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventInviteRepository_GuestListAndLinks(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewEventInviteRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+
+	host := createTestUser(t, db)
+	friend := createTestUser(t, db)
+	stranger := createTestUser(t, db)
+	late := createTestUser(t, db)
+
+	now := time.Now().Truncate(time.Microsecond)
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: host.ID,
+		Title:      "Cube Draft at Home",
+		Game:       domain.GameTypeMTG,
+		Visibility: domain.EventVisibilityPrivate,
+		StartAt:    now.Add(time.Hour),
+		EndAt:      now.Add(4 * time.Hour),
+		Timezone:   "UTC",
+		Language:   "en",
+		Rules:      map[string]interface{}{},
+		Tags:       []string{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	require.NoError(t, eventRepo.Create(ctx, event))
+
+	// Direct guest
+	require.NoError(t, repo.AddGuest(ctx, &domain.EventGuest{
+		EventID:   event.ID,
+		UserID:    friend.ID,
+		InvitedBy: host.ID,
+		CreatedAt: now,
+	}))
+
+	isGuest, err := repo.IsGuest(ctx, event.ID, friend.ID)
+	require.NoError(t, err)
+	assert.True(t, isGuest)
+
+	isGuest, err = repo.IsGuest(ctx, event.ID, stranger.ID)
+	require.NoError(t, err)
+	assert.False(t, isGuest)
+
+	guestEventIDs, err := repo.GetGuestEventIDs(ctx, friend.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{event.ID}, guestEventIDs)
+
+	guestEventIDs, err = repo.GetGuestEventIDs(ctx, stranger.ID)
+	require.NoError(t, err)
+	assert.Empty(t, guestEventIDs)
+
+	// Single-use link
+	maxUses := 1
+	link := &domain.EventInviteLink{
+		ID:        uuid.New(),
+		EventID:   event.ID,
+		CreatedBy: host.ID,
+		TokenHash: domain.HashInviteToken("event-token"),
+		MaxUses:   &maxUses,
+		ExpiresAt: now.Add(24 * time.Hour),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, repo.CreateLink(ctx, link))
+
+	found, err := repo.GetLinkByTokenHash(ctx, domain.HashInviteToken("event-token"))
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, link.ID, found.ID)
+
+	redeemed, err := repo.RedeemLink(ctx, link.ID, &domain.EventGuest{
+		EventID:      event.ID,
+		UserID:       stranger.ID,
+		InvitedBy:    host.ID,
+		InviteLinkID: &link.ID,
+		CreatedAt:    now,
+	})
+	require.NoError(t, err)
+	assert.True(t, redeemed)
+
+	// The link is used up
+	redeemed, err = repo.RedeemLink(ctx, link.ID, &domain.EventGuest{
+		EventID:      event.ID,
+		UserID:       late.ID,
+		InvitedBy:    host.ID,
+		InviteLinkID: &link.ID,
+		CreatedAt:    now,
+	})
+	require.NoError(t, err)
+	assert.False(t, redeemed)
+
+	guest, err := repo.GetGuest(ctx, event.ID, stranger.ID)
+	require.NoError(t, err)
+	require.NotNil(t, guest)
+	require.NotNil(t, guest.InviteLinkID)
+	assert.Equal(t, link.ID, *guest.InviteLinkID)
+
+	guests, err := repo.GetGuestsByEvent(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Len(t, guests, 2)
+
+	// Revocation
+	revokedAt := now.Add(time.Minute)
+	link.RevokedAt = &revokedAt
+	link.UpdatedAt = revokedAt
+	require.NoError(t, repo.UpdateLink(ctx, link))
+
+	links, err := repo.GetLinksByEvent(ctx, event.ID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.True(t, links[0].IsRevoked())
+	assert.Equal(t, 1, links[0].UseCount)
+
+	require.NoError(t, repo.RemoveGuest(ctx, event.ID, friend.ID))
+	assert.Error(t, repo.RemoveGuest(ctx, event.ID, friend.ID))
+
+	guest, err = repo.GetGuest(ctx, event.ID, friend.ID)
+	require.NoError(t, err)
+	assert.Nil(t, guest)
+}
@@ -73,11 +73,22 @@ func (r *eventRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Ev
 	query := `
 		SELECT id, host_user_id, group_id, venue_id, title, description, game, format,
 			rules, visibility, capacity, start_at, end_at, timezone, tags, entry_fee, language,
-			is_recurring, recurrence_rule, created_at, updated_at
+			is_recurring, recurrence_rule, created_at, updated_at, hidden_at, cancelled_at, is_official
 		FROM events
 		WHERE id = $1`
 
-	return r.scanEvent(r.db.QueryRow(ctx, query, id))
+	event, err := r.scanEvent(r.db.QueryRow(ctx, query, id))
+	if err != nil || event == nil {
+		return event, err
+	}
+
+	// Load accepted co-hosts so permission checks see their delegated rights
+	event.CoHosts, err = getAcceptedEventCoHosts(ctx, r.db, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
 }
 
 // GetByIDWithDetails retrieves an event with details by ID
@@ -85,10 +96,10 @@ func (r *eventRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUID)
 	query := `
 		SELECT e.id, e.host_user_id, e.group_id, e.venue_id, e.title, e.description, e.game, e.format,
 			e.rules, e.visibility, e.capacity, e.start_at, e.end_at, e.timezone, e.tags, e.entry_fee, e.language,
-			e.is_recurring, e.recurrence_rule, e.created_at, e.updated_at,
+			e.is_recurring, e.recurrence_rule, e.created_at, e.updated_at, e.hidden_at, e.cancelled_at, e.is_official,
 			u.id, u.email, u.password_hash, u.created_at, u.updated_at, u.is_active, u.last_login,
 			p.user_id, p.display_name, p.locale, p.timezone, p.country, p.city, p.preferred_games, p.communication_preferences, p.visibility_settings, p.updated_at,
-			v.id, v.name, v.type, v.address, v.city, v.country, v.latitude, v.longitude, v.metadata, v.created_by, v.created_at,
+			v.id, v.name, v.type, v.address, v.city, v.country, v.latitude, v.longitude, v.metadata, v.created_by, v.created_at, v.timezone, v.opening_hours, v.owner_id, v.verified_at,
 			g.id, g.name, g.description, g.owner_user_id, g.created_at, g.updated_at, g.is_active
 		FROM events e
 		LEFT JOIN users u ON e.host_user_id = u.id
@@ -103,7 +114,7 @@ func (r *eventRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUID)
 	var venue domain.Venue
 	var group domain.Group
 	var rulesJSON []byte
-	var venueMetadataJSON []byte
+	var venueMetadataJSON, venueOpeningHoursJSON []byte
 	var profileCommPrefsJSON, profileVisibilityJSON []byte
 
 	var hostID, venueID, groupID sql.NullString
@@ -117,8 +128,8 @@ func (r *eventRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUID)
 	var profileUpdatedAt sql.NullTime
 	var venueName, venueType, venueAddress, venueCity, venueCountry sql.NullString
 	var venueLatitude, venueLongitude sql.NullFloat64
-	var venueCreatedBy sql.NullString
-	var venueCreatedAt sql.NullTime
+	var venueCreatedBy, venueTimezone, venueOwnerID sql.NullString
+	var venueCreatedAt, venueVerifiedAt sql.NullTime
 	var groupName, groupDescription sql.NullString
 	var groupOwnerUserID sql.NullString
 	var groupCreatedAt, groupUpdatedAt sql.NullTime
@@ -128,12 +139,12 @@ func (r *eventRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUID)
 		&event.ID, &event.HostUserID, &event.GroupID, &event.VenueID, &event.Title, &event.Description,
 		&event.Game, &event.Format, &rulesJSON, &event.Visibility, &event.Capacity, &event.StartAt,
 		&event.EndAt, &event.Timezone, &event.Tags, &event.EntryFee, &event.Language,
-		&event.IsRecurring, &event.RecurrenceRule, &event.CreatedAt, &event.UpdatedAt,
+		&event.IsRecurring, &event.RecurrenceRule, &event.CreatedAt, &event.UpdatedAt, &event.HiddenAt, &event.CancelledAt, &event.IsOfficial,
 		&hostID, &hostEmail, &hostPasswordHash, &hostCreatedAt, &hostUpdatedAt, &hostIsActive, &hostLastLogin,
 		&profileUserID, &profileDisplayName, &profileLocale, &profileTimezone, &profileCountry, &profileCity,
 		&profilePreferredGames, &profileCommPrefsJSON, &profileVisibilityJSON, &profileUpdatedAt,
 		&venueID, &venueName, &venueType, &venueAddress, &venueCity, &venueCountry, &venueLatitude, &venueLongitude,
-		&venueMetadataJSON, &venueCreatedBy, &venueCreatedAt,
+		&venueMetadataJSON, &venueCreatedBy, &venueCreatedAt, &venueTimezone, &venueOpeningHoursJSON, &venueOwnerID, &venueVerifiedAt,
 		&groupID, &groupName, &groupDescription, &groupOwnerUserID, &groupCreatedAt, &groupUpdatedAt, &groupIsActive,
 	)
 
@@ -149,6 +160,12 @@ func (r *eventRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUID)
 		return nil, fmt.Errorf("failed to unmarshal rules: %w", err)
 	}
 
+	// Load accepted co-hosts for display and permission checks
+	event.CoHosts, err = getAcceptedEventCoHosts(ctx, r.db, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	eventWithDetails := &domain.EventWithDetails{Event: event}
 
 	// Set host if exists
@@ -217,12 +234,27 @@ func (r *eventRepository) GetByIDWithDetails(ctx context.Context, id uuid.UUID)
 			venue.CreatedBy = &createdBy
 		}
 		venue.CreatedAt = venueCreatedAt.Time
+		if venueTimezone.Valid {
+			venue.Timezone = &venueTimezone.String
+		}
+		if venueOwnerID.Valid {
+			ownerID := uuid.MustParse(venueOwnerID.String)
+			venue.OwnerID = &ownerID
+		}
+		if venueVerifiedAt.Valid {
+			venue.VerifiedAt = &venueVerifiedAt.Time
+		}
 
 		if venueMetadataJSON != nil {
 			if err := json.Unmarshal(venueMetadataJSON, &venue.Metadata); err != nil {
 				return nil, fmt.Errorf("failed to unmarshal venue metadata: %w", err)
 			}
 		}
+		if venueOpeningHoursJSON != nil {
+			if err := json.Unmarshal(venueOpeningHoursJSON, &venue.OpeningHours); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal venue opening hours: %w", err)
+			}
+		}
 		eventWithDetails.Venue = &venue
 	}
 
@@ -255,14 +287,14 @@ func (r *eventRepository) Update(ctx context.Context, event *domain.Event) error
 		SET host_user_id = $2, group_id = $3, venue_id = $4, title = $5, description = $6,
 			game = $7, format = $8, rules = $9, visibility = $10, capacity = $11,
 			start_at = $12, end_at = $13, timezone = $14, tags = $15, entry_fee = $16,
-			language = $17, is_recurring = $18, recurrence_rule = $19, updated_at = $20
+			language = $17, is_recurring = $18, recurrence_rule = $19, updated_at = $20, is_official = $21
 		WHERE id = $1`
 
 	result, err := r.db.Exec(ctx, query,
 		event.ID, event.HostUserID, event.GroupID, event.VenueID, event.Title, event.Description,
 		event.Game, event.Format, rulesJSON, event.Visibility, event.Capacity,
 		event.StartAt, event.EndAt, event.Timezone, event.Tags, event.EntryFee,
-		event.Language, event.IsRecurring, event.RecurrenceRule, event.UpdatedAt,
+		event.Language, event.IsRecurring, event.RecurrenceRule, event.UpdatedAt, event.IsOfficial,
 	)
 
 	if err != nil {
@@ -378,7 +410,7 @@ func (r *eventRepository) GetUserEvents(ctx context.Context, userID uuid.UUID, l
 	query := `
 		SELECT id, host_user_id, group_id, venue_id, title, description, game, format,
 			rules, visibility, capacity, start_at, end_at, timezone, tags, entry_fee, language,
-			is_recurring, recurrence_rule, created_at, updated_at
+			is_recurring, recurrence_rule, created_at, updated_at, hidden_at, cancelled_at, is_official
 		FROM events
 		WHERE host_user_id = $1
 		ORDER BY start_at DESC
@@ -407,9 +439,9 @@ func (r *eventRepository) GetGroupEvents(ctx context.Context, groupID uuid.UUID,
 	query := `
 		SELECT id, host_user_id, group_id, venue_id, title, description, game, format,
 			rules, visibility, capacity, start_at, end_at, timezone, tags, entry_fee, language,
-			is_recurring, recurrence_rule, created_at, updated_at
+			is_recurring, recurrence_rule, created_at, updated_at, hidden_at, cancelled_at, is_official
 		FROM events
-		WHERE group_id = $1
+		WHERE group_id = $1 AND hidden_at IS NULL
 		ORDER BY start_at DESC
 		LIMIT $2 OFFSET $3`
 
@@ -436,9 +468,9 @@ func (r *eventRepository) GetUpcomingEvents(ctx context.Context, limit, offset i
 	query := `
 		SELECT id, host_user_id, group_id, venue_id, title, description, game, format,
 			rules, visibility, capacity, start_at, end_at, timezone, tags, entry_fee, language,
-			is_recurring, recurrence_rule, created_at, updated_at
+			is_recurring, recurrence_rule, created_at, updated_at, hidden_at, cancelled_at, is_official
 		FROM events
-		WHERE start_at > NOW() AND visibility = 'public'
+		WHERE start_at > NOW() AND visibility = 'public' AND hidden_at IS NULL AND cancelled_at IS NULL
 		ORDER BY start_at ASC
 		LIMIT $1 OFFSET $2`
 
@@ -463,13 +495,14 @@ func (r *eventRepository) GetUpcomingEvents(ctx context.Context, limit, offset i
 // CreateRSVP creates a new event RSVP
 func (r *eventRepository) CreateRSVP(ctx context.Context, rsvp *domain.EventRSVP) error {
 	query := `
-		INSERT INTO event_rsvp (event_id, user_id, status, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)`
+		INSERT INTO event_rsvp (event_id, user_id, status, checked_in_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
 
 	_, err := r.db.Exec(ctx, query,
 		rsvp.EventID,
 		rsvp.UserID,
 		rsvp.Status,
+		rsvp.CheckedInAt,
 		rsvp.CreatedAt,
 		rsvp.UpdatedAt,
 	)
@@ -484,7 +517,7 @@ func (r *eventRepository) CreateRSVP(ctx context.Context, rsvp *domain.EventRSVP
 // GetRSVP retrieves an RSVP for a specific event and user
 func (r *eventRepository) GetRSVP(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventRSVP, error) {
 	query := `
-		SELECT event_id, user_id, status, created_at, updated_at
+		SELECT event_id, user_id, status, checked_in_at, created_at, updated_at
 		FROM event_rsvp
 		WHERE event_id = $1 AND user_id = $2`
 
@@ -493,6 +526,7 @@ func (r *eventRepository) GetRSVP(ctx context.Context, eventID, userID uuid.UUID
 		&rsvp.EventID,
 		&rsvp.UserID,
 		&rsvp.Status,
+		&rsvp.CheckedInAt,
 		&rsvp.CreatedAt,
 		&rsvp.UpdatedAt,
 	)
@@ -511,13 +545,14 @@ func (r *eventRepository) GetRSVP(ctx context.Context, eventID, userID uuid.UUID
 func (r *eventRepository) UpdateRSVP(ctx context.Context, rsvp *domain.EventRSVP) error {
 	query := `
 		UPDATE event_rsvp
-		SET status = $3, updated_at = $4
+		SET status = $3, checked_in_at = $4, updated_at = $5
 		WHERE event_id = $1 AND user_id = $2`
 
 	result, err := r.db.Exec(ctx, query,
 		rsvp.EventID,
 		rsvp.UserID,
 		rsvp.Status,
+		rsvp.CheckedInAt,
 		rsvp.UpdatedAt,
 	)
 
@@ -551,7 +586,7 @@ func (r *eventRepository) DeleteRSVP(ctx context.Context, eventID, userID uuid.U
 // GetEventRSVPs retrieves all RSVPs for an event
 func (r *eventRepository) GetEventRSVPs(ctx context.Context, eventID uuid.UUID) ([]*domain.EventRSVP, error) {
 	query := `
-		SELECT event_id, user_id, status, created_at, updated_at
+		SELECT event_id, user_id, status, checked_in_at, created_at, updated_at
 		FROM event_rsvp
 		WHERE event_id = $1
 		ORDER BY created_at ASC`
@@ -565,7 +600,7 @@ func (r *eventRepository) GetEventRSVPs(ctx context.Context, eventID uuid.UUID)
 	var rsvps []*domain.EventRSVP
 	for rows.Next() {
 		var rsvp domain.EventRSVP
-		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
+		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CheckedInAt, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan RSVP: %w", err)
 		}
 		rsvps = append(rsvps, &rsvp)
@@ -577,7 +612,7 @@ func (r *eventRepository) GetEventRSVPs(ctx context.Context, eventID uuid.UUID)
 // GetUserRSVPs retrieves all RSVPs for a user
 func (r *eventRepository) GetUserRSVPs(ctx context.Context, userID uuid.UUID) ([]*domain.EventRSVP, error) {
 	query := `
-		SELECT event_id, user_id, status, created_at, updated_at
+		SELECT event_id, user_id, status, checked_in_at, created_at, updated_at
 		FROM event_rsvp
 		WHERE user_id = $1
 		ORDER BY created_at DESC`
@@ -591,7 +626,7 @@ func (r *eventRepository) GetUserRSVPs(ctx context.Context, userID uuid.UUID) ([
 	var rsvps []*domain.EventRSVP
 	for rows.Next() {
 		var rsvp domain.EventRSVP
-		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
+		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CheckedInAt, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan RSVP: %w", err)
 		}
 		rsvps = append(rsvps, &rsvp)
@@ -616,7 +651,7 @@ func (r *eventRepository) CountRSVPsByStatus(ctx context.Context, eventID uuid.U
 // GetWaitlistedRSVPs retrieves waitlisted RSVPs for an event
 func (r *eventRepository) GetWaitlistedRSVPs(ctx context.Context, eventID uuid.UUID) ([]*domain.EventRSVP, error) {
 	query := `
-		SELECT event_id, user_id, status, created_at, updated_at
+		SELECT event_id, user_id, status, checked_in_at, created_at, updated_at
 		FROM event_rsvp
 		WHERE event_id = $1 AND status = 'waitlisted'
 		ORDER BY created_at ASC`
@@ -630,7 +665,7 @@ func (r *eventRepository) GetWaitlistedRSVPs(ctx context.Context, eventID uuid.U
 	var rsvps []*domain.EventRSVP
 	for rows.Next() {
 		var rsvp domain.EventRSVP
-		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
+		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CheckedInAt, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan RSVP: %w", err)
 		}
 		rsvps = append(rsvps, &rsvp)
@@ -684,6 +719,9 @@ func (r *eventRepository) scanEvent(row pgx.Row) (*domain.Event, error) {
 		&event.RecurrenceRule,
 		&event.CreatedAt,
 		&event.UpdatedAt,
+		&event.HiddenAt,
+		&event.CancelledAt,
+		&event.IsOfficial,
 	)
 
 	if err != nil {
@@ -710,9 +748,12 @@ func (r *eventRepository) buildSearchQuery(params domain.EventSearchParams, with
 	baseQuery := `
 		SELECT id, host_user_id, group_id, venue_id, title, description, game, format,
 			rules, visibility, capacity, start_at, end_at, timezone, tags, entry_fee, language,
-			is_recurring, recurrence_rule, created_at, updated_at
+			is_recurring, recurrence_rule, created_at, updated_at, hidden_at, cancelled_at, is_official
 		FROM events`
 
+	// Events hidden by platform moderators never show up in searches
+	conditions = append(conditions, "hidden_at IS NULL", "cancelled_at IS NULL")
+
 	// Add WHERE conditions
 	if params.StartFrom != nil {
 		conditions = append(conditions, fmt.Sprintf("start_at >= $%d", argIndex))
@@ -774,9 +815,11 @@ func (r *eventRepository) buildNearbySearchQuery(lat, lon float64, radiusKm int,
 	baseQuery := `
 		SELECT e.id, e.host_user_id, e.group_id, e.venue_id, e.title, e.description, e.game, e.format,
 			e.rules, e.visibility, e.capacity, e.start_at, e.end_at, e.timezone, e.tags, e.entry_fee, e.language,
-			e.is_recurring, e.recurrence_rule, e.created_at, e.updated_at
+			e.is_recurring, e.recurrence_rule, e.created_at, e.updated_at, e.hidden_at, e.cancelled_at, e.is_official
 		FROM events e
 		WHERE e.location IS NOT NULL 
+		AND e.hidden_at IS NULL
+		AND e.cancelled_at IS NULL
 		AND ST_DWithin(e.location, ST_Point($1, $2)::geography, $3)`
 
 	args = append(args, lon, lat, radiusKm*1000) // Convert km to meters
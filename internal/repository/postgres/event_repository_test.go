@@ -149,9 +149,23 @@ func TestEventRepository_RSVP(t *testing.T) {
 	goingCount, err := repo.GetEventGoingCount(ctx, event.ID)
 	require.NoError(t, err)
 	assert.Equal(t, 1, goingCount)
+	assert.Nil(t, retrieved.CheckedInAt)
+
+	// Check the attendee in
+	checkedInAt := time.Now().UTC().Truncate(time.Microsecond)
+	rsvp.CheckedInAt = &checkedInAt
+
+	err = repo.UpdateRSVP(ctx, rsvp)
+	require.NoError(t, err)
+
+	checkedIn, err := repo.GetRSVP(ctx, event.ID, attendee.ID)
+	require.NoError(t, err)
+	require.NotNil(t, checkedIn.CheckedInAt)
+	assert.True(t, checkedInAt.Equal(*checkedIn.CheckedInAt))
 
 	// Update RSVP
 	rsvp.Status = domain.RSVPStatusDeclined
+	rsvp.CheckedInAt = nil
 	rsvp.UpdatedAt = time.Now()
 
 	err = repo.UpdateRSVP(ctx, rsvp)
@@ -162,6 +176,7 @@ func TestEventRepository_RSVP(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, updated)
 	assert.Equal(t, domain.RSVPStatusDeclined, updated.Status)
+	assert.Nil(t, updated.CheckedInAt)
 
 	// Delete RSVP
 	err = repo.DeleteRSVP(ctx, event.ID, attendee.ID)
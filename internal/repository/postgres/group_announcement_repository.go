@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type groupAnnouncementRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGroupAnnouncementRepository creates a new PostgreSQL group announcement repository
+func NewGroupAnnouncementRepository(db *pgxpool.Pool) repository.GroupAnnouncementRepository {
+	return &groupAnnouncementRepository{db: db}
+}
+
+const groupAnnouncementColumns = `id, group_id, author_user_id, title, body, is_pinned, pinned_at,
+	expires_at, created_at, updated_at`
+
+// Create creates a new announcement
+func (r *groupAnnouncementRepository) Create(ctx context.Context, announcement *domain.GroupAnnouncement) error {
+	query := `
+		INSERT INTO group_announcements (id, group_id, author_user_id, title, body, is_pinned, pinned_at,
+			expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	_, err := r.db.Exec(ctx, query,
+		announcement.ID,
+		announcement.GroupID,
+		announcement.AuthorUserID,
+		announcement.Title,
+		announcement.Body,
+		announcement.IsPinned,
+		announcement.PinnedAt,
+		announcement.ExpiresAt,
+		announcement.CreatedAt,
+		announcement.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create announcement: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an announcement by ID
+func (r *groupAnnouncementRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupAnnouncement, error) {
+	query := `SELECT ` + groupAnnouncementColumns + ` FROM group_announcements WHERE id = $1`
+
+	announcement, err := scanGroupAnnouncement(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get announcement: %w", err)
+	}
+
+	return announcement, nil
+}
+
+// Update updates an announcement's content, pin state and expiry
+func (r *groupAnnouncementRepository) Update(ctx context.Context, announcement *domain.GroupAnnouncement) error {
+	query := `
+		UPDATE group_announcements
+		SET title = $2, body = $3, is_pinned = $4, pinned_at = $5, expires_at = $6, updated_at = $7
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		announcement.ID,
+		announcement.Title,
+		announcement.Body,
+		announcement.IsPinned,
+		announcement.PinnedAt,
+		announcement.ExpiresAt,
+		announcement.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update announcement: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("announcement not found")
+	}
+
+	return nil
+}
+
+// Delete deletes an announcement
+func (r *groupAnnouncementRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM group_announcements WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete announcement: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("announcement not found")
+	}
+
+	return nil
+}
+
+// GetByGroup retrieves a group's announcements, pinned first and then newest first
+func (r *groupAnnouncementRepository) GetByGroup(ctx context.Context, groupID uuid.UUID, activeAt time.Time, includeExpired bool, limit, offset int) ([]*domain.GroupAnnouncement, error) {
+	query := `
+		SELECT ` + groupAnnouncementColumns + `
+		FROM group_announcements
+		WHERE group_id = $1 AND ($3 OR expires_at IS NULL OR expires_at > $2)
+		ORDER BY is_pinned DESC, pinned_at DESC NULLS LAST, created_at DESC
+		LIMIT $4 OFFSET $5`
+
+	rows, err := r.db.Query(ctx, query, groupID, activeAt, includeExpired, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group announcements: %w", err)
+	}
+	defer rows.Close()
+
+	var announcements []*domain.GroupAnnouncement
+	for rows.Next() {
+		announcement, err := scanGroupAnnouncement(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan announcement: %w", err)
+		}
+		announcements = append(announcements, announcement)
+	}
+
+	return announcements, nil
+}
+
+// scanGroupAnnouncement scans an announcement row selected with groupAnnouncementColumns
+func scanGroupAnnouncement(row pgx.Row) (*domain.GroupAnnouncement, error) {
+	var announcement domain.GroupAnnouncement
+	err := row.Scan(
+		&announcement.ID,
+		&announcement.GroupID,
+		&announcement.AuthorUserID,
+		&announcement.Title,
+		&announcement.Body,
+		&announcement.IsPinned,
+		&announcement.PinnedAt,
+		&announcement.ExpiresAt,
+		&announcement.CreatedAt,
+		&announcement.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &announcement, nil
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupAnnouncementRepository_Board(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupAnnouncementRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now().Truncate(time.Microsecond)
+	newAnnouncement := func(title string, createdAt time.Time, expiresAt *time.Time) *domain.GroupAnnouncement {
+		return &domain.GroupAnnouncement{
+			ID:           uuid.New(),
+			GroupID:      group.ID,
+			AuthorUserID: owner.ID,
+			Title:        title,
+			Body:         "Details for " + title,
+			ExpiresAt:    expiresAt,
+			CreatedAt:    createdAt,
+			UpdatedAt:    createdAt,
+		}
+	}
+
+	expiresSoon := now.Add(time.Minute)
+	older := newAnnouncement("Older", now.Add(-2*time.Hour), nil)
+	newer := newAnnouncement("Newer", now.Add(-time.Hour), nil)
+	expiring := newAnnouncement("Expiring", now.Add(-30*time.Minute), &expiresSoon)
+
+	for _, announcement := range []*domain.GroupAnnouncement{older, newer, expiring} {
+		require.NoError(t, repo.Create(ctx, announcement))
+	}
+
+	// Pinning moves an older announcement to the top
+	older.Pin(now)
+	require.NoError(t, repo.Update(ctx, older))
+
+	board, err := repo.GetByGroup(ctx, group.ID, now, false, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, board, 3)
+	assert.Equal(t, older.ID, board[0].ID)
+	assert.True(t, board[0].IsPinned)
+	assert.Equal(t, expiring.ID, board[1].ID)
+	assert.Equal(t, newer.ID, board[2].ID)
+
+	// Expired announcements are hidden unless requested
+	afterExpiry := expiresSoon.Add(time.Second)
+	board, err = repo.GetByGroup(ctx, group.ID, afterExpiry, false, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, board, 2)
+
+	board, err = repo.GetByGroup(ctx, group.ID, afterExpiry, true, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, board, 3)
+
+	require.NoError(t, repo.Delete(ctx, newer.ID))
+
+	deleted, err := repo.GetByID(ctx, newer.ID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
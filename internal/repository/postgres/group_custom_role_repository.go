@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type groupCustomRoleRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGroupCustomRoleRepository creates a new PostgreSQL group custom role repository
+func NewGroupCustomRoleRepository(db *pgxpool.Pool) repository.GroupCustomRoleRepository {
+	return &groupCustomRoleRepository{db: db}
+}
+
+const groupCustomRoleColumns = `id, group_id, name, description, permissions, created_at, updated_at`
+
+// Create creates a new custom role
+func (r *groupCustomRoleRepository) Create(ctx context.Context, role *domain.GroupCustomRole) error {
+	query := `
+		INSERT INTO group_custom_roles (id, group_id, name, description, permissions, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.Exec(ctx, query,
+		role.ID,
+		role.GroupID,
+		role.Name,
+		role.Description,
+		permissionsToStrings(role.Permissions),
+		role.CreatedAt,
+		role.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create custom role: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a custom role by ID
+func (r *groupCustomRoleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupCustomRole, error) {
+	query := `SELECT ` + groupCustomRoleColumns + ` FROM group_custom_roles WHERE id = $1`
+
+	role, err := scanGroupCustomRole(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get custom role: %w", err)
+	}
+
+	return role, nil
+}
+
+// Update updates a custom role's name, description and permissions
+func (r *groupCustomRoleRepository) Update(ctx context.Context, role *domain.GroupCustomRole) error {
+	query := `
+		UPDATE group_custom_roles
+		SET name = $2, description = $3, permissions = $4, updated_at = $5
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		role.ID,
+		role.Name,
+		role.Description,
+		permissionsToStrings(role.Permissions),
+		role.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update custom role: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("custom role not found")
+	}
+
+	return nil
+}
+
+// Delete deletes a custom role, unassigning it from any members
+func (r *groupCustomRoleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM group_custom_roles WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete custom role: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("custom role not found")
+	}
+
+	return nil
+}
+
+// GetByGroup retrieves a group's custom roles ordered by name
+func (r *groupCustomRoleRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupCustomRole, error) {
+	query := `
+		SELECT ` + groupCustomRoleColumns + `
+		FROM group_custom_roles
+		WHERE group_id = $1
+		ORDER BY name ASC`
+
+	rows, err := r.db.Query(ctx, query, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group custom roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []*domain.GroupCustomRole
+	for rows.Next() {
+		role, err := scanGroupCustomRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan custom role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// AssignToMember sets or clears a member's custom role
+func (r *groupCustomRoleRepository) AssignToMember(ctx context.Context, groupID, userID uuid.UUID, roleID *uuid.UUID) error {
+	query := `
+		UPDATE group_members
+		SET custom_role_id = $3
+		WHERE group_id = $1 AND user_id = $2`
+
+	result, err := r.db.Exec(ctx, query, groupID, userID, roleID)
+	if err != nil {
+		return fmt.Errorf("failed to assign custom role: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group member not found")
+	}
+
+	return nil
+}
+
+// scanGroupCustomRole scans a custom role row selected with groupCustomRoleColumns
+func scanGroupCustomRole(row pgx.Row) (*domain.GroupCustomRole, error) {
+	var role domain.GroupCustomRole
+	var permissions []string
+
+	err := row.Scan(
+		&role.ID,
+		&role.GroupID,
+		&role.Name,
+		&role.Description,
+		&permissions,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		role.Permissions = append(role.Permissions, domain.Permission(permission))
+	}
+
+	return &role, nil
+}
+
+// permissionsToStrings converts permissions for storage in a text array
+func permissionsToStrings(permissions []domain.Permission) []string {
+	values := make([]string, len(permissions))
+	for i, permission := range permissions {
+		values[i] = string(permission)
+	}
+	return values
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupCustomRoleRepository_AssignAndDelete(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupCustomRoleRepository(db)
+	groupRepo := NewGroupRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	member := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now().Truncate(time.Microsecond)
+	require.NoError(t, groupRepo.AddMember(ctx, &domain.GroupMember{
+		GroupID:  group.ID,
+		UserID:   member.ID,
+		Role:     domain.GroupRoleMember,
+		JoinedAt: now,
+	}))
+
+	role := &domain.GroupCustomRole{
+		ID:          uuid.New(),
+		GroupID:     group.ID,
+		Name:        "Judge",
+		Permissions: []domain.Permission{domain.PermissionCreateEvent, domain.PermissionCheckIn},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	require.NoError(t, repo.Create(ctx, role))
+
+	// Role names are unique within a group
+	duplicate := *role
+	duplicate.ID = uuid.New()
+	assert.Error(t, repo.Create(ctx, &duplicate))
+
+	require.NoError(t, repo.AssignToMember(ctx, group.ID, member.ID, &role.ID))
+
+	// Members are loaded with the permissions their custom role grants
+	loaded, err := groupRepo.GetMember(ctx, group.ID, member.ID)
+	require.NoError(t, err)
+	require.NotNil(t, loaded.CustomRoleID)
+	assert.Equal(t, role.ID, *loaded.CustomRoleID)
+	assert.ElementsMatch(t, role.Permissions, loaded.Permissions)
+
+	role.Permissions = []domain.Permission{domain.PermissionEditEvent}
+	role.UpdatedAt = now.Add(time.Minute)
+	require.NoError(t, repo.Update(ctx, role))
+
+	loaded, err = groupRepo.GetMember(ctx, group.ID, member.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Permission{domain.PermissionEditEvent}, loaded.Permissions)
+
+	roles, err := repo.GetByGroup(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Len(t, roles, 1)
+
+	// Deleting the role unassigns it but keeps the membership
+	require.NoError(t, repo.Delete(ctx, role.ID))
+
+	loaded, err = groupRepo.GetMember(ctx, group.ID, member.ID)
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Nil(t, loaded.CustomRoleID)
+	assert.Empty(t, loaded.Permissions)
+
+	// Assigning to a non-member fails
+	assert.Error(t, repo.AssignToMember(ctx, group.ID, uuid.New(), nil))
+}
@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type groupInviteRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGroupInviteRepository creates a new PostgreSQL group invite repository
+func NewGroupInviteRepository(db *pgxpool.Pool) repository.GroupInviteRepository {
+	return &groupInviteRepository{db: db}
+}
+
+const groupInviteColumns = `id, group_id, invited_user_id, inviter_user_id, role, token_hash, status,
+		max_uses, use_count, expires_at, responded_at, created_at, updated_at`
+
+// redeemableInviteCondition filters invites that can still be used
+const redeemableInviteCondition = `status = 'pending' AND expires_at > NOW()
+		AND (max_uses IS NULL OR use_count < max_uses)`
+
+// Create creates a new group invite
+func (r *groupInviteRepository) Create(ctx context.Context, invite *domain.GroupInvite) error {
+	query := `
+		INSERT INTO group_invites (id, group_id, invited_user_id, inviter_user_id, role, token_hash, status,
+			max_uses, use_count, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+
+	_, err := r.db.Exec(ctx, query,
+		invite.ID,
+		invite.GroupID,
+		invite.InvitedUserID,
+		invite.InviterID,
+		invite.Role,
+		invite.TokenHash,
+		invite.Status,
+		invite.MaxUses,
+		invite.UseCount,
+		invite.ExpiresAt,
+		invite.CreatedAt,
+		invite.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create group invite: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a group invite by ID
+func (r *groupInviteRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupInvite, error) {
+	query := `SELECT ` + groupInviteColumns + ` FROM group_invites WHERE id = $1`
+
+	invite, err := scanGroupInvite(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get group invite: %w", err)
+	}
+
+	return invite, nil
+}
+
+// GetByTokenHash retrieves a group invite by the hash of its token
+func (r *groupInviteRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.GroupInvite, error) {
+	query := `SELECT ` + groupInviteColumns + ` FROM group_invites WHERE token_hash = $1`
+
+	invite, err := scanGroupInvite(r.db.QueryRow(ctx, query, tokenHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get group invite by token: %w", err)
+	}
+
+	return invite, nil
+}
+
+// Update updates the mutable fields of a group invite
+func (r *groupInviteRepository) Update(ctx context.Context, invite *domain.GroupInvite) error {
+	query := `
+		UPDATE group_invites
+		SET status = $2, max_uses = $3, expires_at = $4, responded_at = $5, updated_at = $6
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		invite.ID,
+		invite.Status,
+		invite.MaxUses,
+		invite.ExpiresAt,
+		invite.RespondedAt,
+		invite.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update group invite: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group invite not found")
+	}
+
+	return nil
+}
+
+// GetPendingByGroup retrieves the redeemable invites of a group
+func (r *groupInviteRepository) GetPendingByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupInvite, error) {
+	query := `
+		SELECT ` + groupInviteColumns + `
+		FROM group_invites
+		WHERE group_id = $1 AND ` + redeemableInviteCondition + `
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending group invites: %w", err)
+	}
+	defer rows.Close()
+
+	var invites []*domain.GroupInvite
+	for rows.Next() {
+		invite, err := scanGroupInvite(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group invite: %w", err)
+		}
+		invites = append(invites, invite)
+	}
+
+	return invites, nil
+}
+
+// GetPendingByUser retrieves the redeemable direct invites addressed to a user
+func (r *groupInviteRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*domain.GroupInviteWithGroup, error) {
+	query := `
+		SELECT gi.id, gi.group_id, gi.invited_user_id, gi.inviter_user_id, gi.role, gi.token_hash, gi.status,
+			   gi.max_uses, gi.use_count, gi.expires_at, gi.responded_at, gi.created_at, gi.updated_at,
+			   g.id, g.name, g.description, g.owner_user_id, g.created_at, g.updated_at, g.is_active
+		FROM group_invites gi
+		INNER JOIN groups g ON gi.group_id = g.id
+		WHERE gi.invited_user_id = $1 AND g.is_active = true
+		  AND gi.status = 'pending' AND gi.expires_at > NOW()
+		ORDER BY gi.created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user invites: %w", err)
+	}
+	defer rows.Close()
+
+	var invites []*domain.GroupInviteWithGroup
+	for rows.Next() {
+		var invite domain.GroupInviteWithGroup
+		var group domain.Group
+		if err := rows.Scan(
+			&invite.ID,
+			&invite.GroupID,
+			&invite.InvitedUserID,
+			&invite.InviterID,
+			&invite.Role,
+			&invite.TokenHash,
+			&invite.Status,
+			&invite.MaxUses,
+			&invite.UseCount,
+			&invite.ExpiresAt,
+			&invite.RespondedAt,
+			&invite.CreatedAt,
+			&invite.UpdatedAt,
+			&group.ID,
+			&group.Name,
+			&group.Description,
+			&group.OwnerUserID,
+			&group.CreatedAt,
+			&group.UpdatedAt,
+			&group.IsActive,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user invite: %w", err)
+		}
+		invite.Group = &group
+		invites = append(invites, &invite)
+	}
+
+	return invites, nil
+}
+
+// GetPendingForUserInGroup retrieves the redeemable direct invite for a user in a group
+func (r *groupInviteRepository) GetPendingForUserInGroup(ctx context.Context, groupID, userID uuid.UUID) (*domain.GroupInvite, error) {
+	query := `
+		SELECT ` + groupInviteColumns + `
+		FROM group_invites
+		WHERE group_id = $1 AND invited_user_id = $2 AND status = 'pending'`
+
+	invite, err := scanGroupInvite(r.db.QueryRow(ctx, query, groupID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pending invite: %w", err)
+	}
+
+	return invite, nil
+}
+
+// Redeem consumes one use of the invite and adds the user to the group in a single transaction
+func (r *groupInviteRepository) Redeem(ctx context.Context, inviteID, userID uuid.UUID, joinedAt time.Time) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Consume a use only while the invite is still redeemable, so concurrent
+	// redemptions of a link cannot exceed its maximum uses
+	redeemQuery := `
+		UPDATE group_invites
+		SET use_count = use_count + 1,
+			status = CASE WHEN invited_user_id IS NULL THEN status ELSE 'accepted' END,
+			responded_at = CASE WHEN invited_user_id IS NULL THEN responded_at ELSE $2 END
+		WHERE id = $1 AND ` + redeemableInviteCondition + `
+		RETURNING group_id, role`
+
+	var groupID uuid.UUID
+	var role domain.GroupRole
+	err = tx.QueryRow(ctx, redeemQuery, inviteID, joinedAt).Scan(&groupID, &role)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to redeem group invite: %w", err)
+	}
+
+	memberQuery := `
+		INSERT INTO group_members (group_id, user_id, role, joined_at)
+		VALUES ($1, $2, $3, $4)`
+
+	_, err = tx.Exec(ctx, memberQuery, groupID, userID, role, joinedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to add group member: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// scanGroupInvite scans a group invite row selected with groupInviteColumns
+func scanGroupInvite(row pgx.Row) (*domain.GroupInvite, error) {
+	var invite domain.GroupInvite
+	err := row.Scan(
+		&invite.ID,
+		&invite.GroupID,
+		&invite.InvitedUserID,
+		&invite.InviterID,
+		&invite.Role,
+		&invite.TokenHash,
+		&invite.Status,
+		&invite.MaxUses,
+		&invite.UseCount,
+		&invite.ExpiresAt,
+		&invite.RespondedAt,
+		&invite.CreatedAt,
+		&invite.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &invite, nil
+}
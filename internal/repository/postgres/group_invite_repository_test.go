@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupInviteRepository_DirectInvite(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupInviteRepository(db)
+	groupRepo := NewGroupRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	invitee := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now()
+	invite := &domain.GroupInvite{
+		ID:            uuid.New(),
+		GroupID:       group.ID,
+		InvitedUserID: &invitee.ID,
+		InviterID:     owner.ID,
+		Role:          domain.GroupRoleAdmin,
+		TokenHash:     domain.HashInviteToken("direct-token"),
+		Status:        domain.GroupInviteStatusPending,
+		ExpiresAt:     now.Add(24 * time.Hour),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err := repo.Create(ctx, invite)
+	require.NoError(t, err)
+
+	retrieved, err := repo.GetByTokenHash(ctx, invite.TokenHash)
+	require.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, invite.ID, retrieved.ID)
+
+	userInvites, err := repo.GetPendingByUser(ctx, invitee.ID)
+	require.NoError(t, err)
+	require.Len(t, userInvites, 1)
+	assert.Equal(t, group.Name, userInvites[0].Group.Name)
+
+	redeemed, err := repo.Redeem(ctx, invite.ID, invitee.ID, time.Now())
+	require.NoError(t, err)
+	assert.True(t, redeemed)
+
+	role, err := groupRepo.GetMemberRole(ctx, group.ID, invitee.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.GroupRoleAdmin, role)
+
+	retrieved, err = repo.GetByID(ctx, invite.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.GroupInviteStatusAccepted, retrieved.Status)
+	assert.NotNil(t, retrieved.RespondedAt)
+
+	// An accepted invite cannot be redeemed again
+	redeemed, err = repo.Redeem(ctx, invite.ID, invitee.ID, time.Now())
+	require.NoError(t, err)
+	assert.False(t, redeemed)
+}
+
+func TestGroupInviteRepository_LinkMaxUses(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupInviteRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	first := createTestUser(t, db)
+	second := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now()
+	maxUses := 1
+	link := &domain.GroupInvite{
+		ID:        uuid.New(),
+		GroupID:   group.ID,
+		InviterID: owner.ID,
+		Role:      domain.GroupRoleMember,
+		TokenHash: domain.HashInviteToken("link-token"),
+		Status:    domain.GroupInviteStatusPending,
+		MaxUses:   &maxUses,
+		ExpiresAt: now.Add(time.Hour),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := repo.Create(ctx, link)
+	require.NoError(t, err)
+
+	pending, err := repo.GetPendingByGroup(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Len(t, pending, 1)
+
+	redeemed, err := repo.Redeem(ctx, link.ID, first.ID, time.Now())
+	require.NoError(t, err)
+	assert.True(t, redeemed)
+
+	redeemed, err = repo.Redeem(ctx, link.ID, second.ID, time.Now())
+	require.NoError(t, err)
+	assert.False(t, redeemed)
+
+	// Exhausted links are no longer listed as pending
+	pending, err = repo.GetPendingByGroup(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// Revoke
+	retrieved, err := repo.GetByID(ctx, link.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, retrieved.UseCount)
+	assert.Equal(t, domain.GroupInviteStatusPending, retrieved.Status)
+
+	retrieved.Status = domain.GroupInviteStatusRevoked
+	retrieved.UpdatedAt = time.Now()
+	err = repo.Update(ctx, retrieved)
+	require.NoError(t, err)
+
+	retrieved, err = repo.GetByID(ctx, link.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.GroupInviteStatusRevoked, retrieved.Status)
+}
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type groupJoinRequestRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGroupJoinRequestRepository creates a new PostgreSQL group join request repository
+func NewGroupJoinRequestRepository(db *pgxpool.Pool) repository.GroupJoinRequestRepository {
+	return &groupJoinRequestRepository{db: db}
+}
+
+const groupJoinRequestColumns = `id, group_id, user_id, message, status, reviewed_by, reviewed_at, created_at, updated_at`
+
+// Create creates a new join request
+func (r *groupJoinRequestRepository) Create(ctx context.Context, request *domain.GroupJoinRequest) error {
+	query := `
+		INSERT INTO group_join_requests (id, group_id, user_id, message, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.Exec(ctx, query,
+		request.ID,
+		request.GroupID,
+		request.UserID,
+		request.Message,
+		request.Status,
+		request.CreatedAt,
+		request.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create join request: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a join request by ID
+func (r *groupJoinRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupJoinRequest, error) {
+	query := `SELECT ` + groupJoinRequestColumns + ` FROM group_join_requests WHERE id = $1`
+
+	request, err := scanGroupJoinRequest(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get join request: %w", err)
+	}
+
+	return request, nil
+}
+
+// Update updates the status of a join request
+func (r *groupJoinRequestRepository) Update(ctx context.Context, request *domain.GroupJoinRequest) error {
+	query := `
+		UPDATE group_join_requests
+		SET status = $2, reviewed_by = $3, reviewed_at = $4, updated_at = $5
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		request.ID,
+		request.Status,
+		request.ReviewedBy,
+		request.ReviewedAt,
+		request.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update join request: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("join request not found")
+	}
+
+	return nil
+}
+
+// GetPendingByGroup retrieves the pending join requests of a group, oldest first
+func (r *groupJoinRequestRepository) GetPendingByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupJoinRequest, error) {
+	query := `
+		SELECT ` + groupJoinRequestColumns + `
+		FROM group_join_requests
+		WHERE group_id = $1 AND status = 'pending'
+		ORDER BY created_at ASC`
+
+	return r.queryJoinRequests(ctx, query, groupID)
+}
+
+// GetPendingForUserInGroup retrieves a user's pending join request for a group
+func (r *groupJoinRequestRepository) GetPendingForUserInGroup(ctx context.Context, groupID, userID uuid.UUID) (*domain.GroupJoinRequest, error) {
+	query := `
+		SELECT ` + groupJoinRequestColumns + `
+		FROM group_join_requests
+		WHERE group_id = $1 AND user_id = $2 AND status = 'pending'`
+
+	request, err := scanGroupJoinRequest(r.db.QueryRow(ctx, query, groupID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get pending join request: %w", err)
+	}
+
+	return request, nil
+}
+
+// GetUserRequests retrieves all join requests made by a user, newest first
+func (r *groupJoinRequestRepository) GetUserRequests(ctx context.Context, userID uuid.UUID) ([]*domain.GroupJoinRequest, error) {
+	query := `
+		SELECT ` + groupJoinRequestColumns + `
+		FROM group_join_requests
+		WHERE user_id = $1
+		ORDER BY created_at DESC`
+
+	return r.queryJoinRequests(ctx, query, userID)
+}
+
+// Approve marks the request as approved and adds the requester as a member in a single transaction
+func (r *groupJoinRequestRepository) Approve(ctx context.Context, request *domain.GroupJoinRequest) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	updateQuery := `
+		UPDATE group_join_requests
+		SET status = 'approved', reviewed_by = $2, reviewed_at = $3, updated_at = $3
+		WHERE id = $1 AND status = 'pending'`
+
+	result, err := tx.Exec(ctx, updateQuery, request.ID, request.ReviewedBy, request.ReviewedAt)
+	if err != nil {
+		return fmt.Errorf("failed to approve join request: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("pending join request not found")
+	}
+
+	memberQuery := `
+		INSERT INTO group_members (group_id, user_id, role, joined_at)
+		VALUES ($1, $2, $3, $4)`
+
+	_, err = tx.Exec(ctx, memberQuery, request.GroupID, request.UserID, domain.GroupRoleMember, request.ReviewedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add group member: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	request.Status = domain.GroupJoinRequestStatusApproved
+
+	return nil
+}
+
+// queryJoinRequests runs a query returning join request rows
+func (r *groupJoinRequestRepository) queryJoinRequests(ctx context.Context, query string, args ...interface{}) ([]*domain.GroupJoinRequest, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get join requests: %w", err)
+	}
+	defer rows.Close()
+
+	var requests []*domain.GroupJoinRequest
+	for rows.Next() {
+		request, err := scanGroupJoinRequest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan join request: %w", err)
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+// scanGroupJoinRequest scans a join request row selected with groupJoinRequestColumns
+func scanGroupJoinRequest(row pgx.Row) (*domain.GroupJoinRequest, error) {
+	var request domain.GroupJoinRequest
+	err := row.Scan(
+		&request.ID,
+		&request.GroupID,
+		&request.UserID,
+		&request.Message,
+		&request.Status,
+		&request.ReviewedBy,
+		&request.ReviewedAt,
+		&request.CreatedAt,
+		&request.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
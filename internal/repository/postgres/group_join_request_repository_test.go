@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupJoinRequestRepository_Approve(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupJoinRequestRepository(db)
+	groupRepo := NewGroupRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	requester := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now()
+	message := "We play every Friday, can I join?"
+	request := &domain.GroupJoinRequest{
+		ID:        uuid.New(),
+		GroupID:   group.ID,
+		UserID:    requester.ID,
+		Message:   &message,
+		Status:    domain.GroupJoinRequestStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := repo.Create(ctx, request)
+	require.NoError(t, err)
+
+	pending, err := repo.GetPendingByGroup(ctx, group.ID)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, message, *pending[0].Message)
+
+	existing, err := repo.GetPendingForUserInGroup(ctx, group.ID, requester.ID)
+	require.NoError(t, err)
+	require.NotNil(t, existing)
+	assert.Equal(t, request.ID, existing.ID)
+
+	reviewedAt := time.Now()
+	request.ReviewedBy = &owner.ID
+	request.ReviewedAt = &reviewedAt
+	err = repo.Approve(ctx, request)
+	require.NoError(t, err)
+
+	role, err := groupRepo.GetMemberRole(ctx, group.ID, requester.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.GroupRoleMember, role)
+
+	retrieved, err := repo.GetByID(ctx, request.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.GroupJoinRequestStatusApproved, retrieved.Status)
+	assert.Equal(t, owner.ID, *retrieved.ReviewedBy)
+
+	// An approved request cannot be approved twice
+	err = repo.Approve(ctx, request)
+	assert.Error(t, err)
+
+	userRequests, err := repo.GetUserRequests(ctx, requester.ID)
+	require.NoError(t, err)
+	assert.Len(t, userRequests, 1)
+}
+
+func TestGroupJoinRequestRepository_Reject(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupJoinRequestRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	requester := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now()
+	request := &domain.GroupJoinRequest{
+		ID:        uuid.New(),
+		GroupID:   group.ID,
+		UserID:    requester.ID,
+		Status:    domain.GroupJoinRequestStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, repo.Create(ctx, request))
+
+	reviewedAt := time.Now()
+	request.Status = domain.GroupJoinRequestStatusRejected
+	request.ReviewedBy = &owner.ID
+	request.ReviewedAt = &reviewedAt
+	request.UpdatedAt = reviewedAt
+	require.NoError(t, repo.Update(ctx, request))
+
+	existing, err := repo.GetPendingForUserInGroup(ctx, group.ID, requester.ID)
+	require.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// A new request may be made once the previous one is no longer pending
+	again := &domain.GroupJoinRequest{
+		ID:        uuid.New(),
+		GroupID:   group.ID,
+		UserID:    requester.ID,
+		Status:    domain.GroupJoinRequestStatusPending,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	require.NoError(t, repo.Create(ctx, again))
+}
@@ -0,0 +1,370 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type groupLeagueRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGroupLeagueRepository creates a new PostgreSQL group league repository
+func NewGroupLeagueRepository(db *pgxpool.Pool) repository.GroupLeagueRepository {
+	return &groupLeagueRepository{db: db}
+}
+
+const groupLeagueColumns = `id, group_id, name, description, season_start, season_end,
+	placement_points, participation_points, created_by, created_at, updated_at`
+
+const leagueResultColumns = `league_id, event_id, user_id, placement, recorded_by, recorded_at`
+
+// Create creates a new league
+func (r *groupLeagueRepository) Create(ctx context.Context, league *domain.GroupLeague) error {
+	query := `
+		INSERT INTO group_leagues (id, group_id, name, description, season_start, season_end,
+			placement_points, participation_points, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err := r.db.Exec(ctx, query,
+		league.ID,
+		league.GroupID,
+		league.Name,
+		league.Description,
+		league.SeasonStart,
+		league.SeasonEnd,
+		placementPointsOrEmpty(league.PointScheme.PlacementPoints),
+		league.PointScheme.ParticipationPoints,
+		league.CreatedBy,
+		league.CreatedAt,
+		league.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create league: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a league by ID
+func (r *groupLeagueRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupLeague, error) {
+	query := `SELECT ` + groupLeagueColumns + ` FROM group_leagues WHERE id = $1`
+
+	league, err := scanGroupLeague(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get league: %w", err)
+	}
+
+	return league, nil
+}
+
+// Update updates a league's details, season and point scheme
+func (r *groupLeagueRepository) Update(ctx context.Context, league *domain.GroupLeague) error {
+	query := `
+		UPDATE group_leagues
+		SET name = $2, description = $3, season_start = $4, season_end = $5,
+			placement_points = $6, participation_points = $7, updated_at = $8
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		league.ID,
+		league.Name,
+		league.Description,
+		league.SeasonStart,
+		league.SeasonEnd,
+		placementPointsOrEmpty(league.PointScheme.PlacementPoints),
+		league.PointScheme.ParticipationPoints,
+		league.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update league: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("league not found")
+	}
+
+	return nil
+}
+
+// Delete deletes a league together with its linked events and results
+func (r *groupLeagueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM group_leagues WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete league: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("league not found")
+	}
+
+	return nil
+}
+
+// GetByGroup retrieves the leagues of a group, most recent season first
+func (r *groupLeagueRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupLeague, error) {
+	query := `
+		SELECT ` + groupLeagueColumns + `
+		FROM group_leagues
+		WHERE group_id = $1
+		ORDER BY season_start DESC`
+
+	rows, err := r.db.Query(ctx, query, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group leagues: %w", err)
+	}
+	defer rows.Close()
+
+	var leagues []*domain.GroupLeague
+	for rows.Next() {
+		league, err := scanGroupLeague(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan league: %w", err)
+		}
+		leagues = append(leagues, league)
+	}
+
+	return leagues, nil
+}
+
+// LinkEvent links an event to a league
+func (r *groupLeagueRepository) LinkEvent(ctx context.Context, leagueID, eventID uuid.UUID, linkedAt time.Time) error {
+	query := `
+		INSERT INTO league_events (league_id, event_id, linked_at)
+		VALUES ($1, $2, $3)`
+
+	_, err := r.db.Exec(ctx, query, leagueID, eventID, linkedAt)
+	if err != nil {
+		return fmt.Errorf("failed to link league event: %w", err)
+	}
+
+	return nil
+}
+
+// UnlinkEvent removes an event and its results from a league
+func (r *groupLeagueRepository) UnlinkEvent(ctx context.Context, leagueID, eventID uuid.UUID) error {
+	query := `DELETE FROM league_events WHERE league_id = $1 AND event_id = $2`
+
+	result, err := r.db.Exec(ctx, query, leagueID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to unlink league event: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("league event not found")
+	}
+
+	return nil
+}
+
+// IsEventLinked checks if an event is linked to the league
+func (r *groupLeagueRepository) IsEventLinked(ctx context.Context, leagueID, eventID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM league_events WHERE league_id = $1 AND event_id = $2)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, leagueID, eventID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check league event: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetLeagueIDForEvent retrieves the league an event counts towards, if any
+func (r *groupLeagueRepository) GetLeagueIDForEvent(ctx context.Context, eventID uuid.UUID) (*uuid.UUID, error) {
+	query := `SELECT league_id FROM league_events WHERE event_id = $1`
+
+	var leagueID uuid.UUID
+	err := r.db.QueryRow(ctx, query, eventID).Scan(&leagueID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get league for event: %w", err)
+	}
+
+	return &leagueID, nil
+}
+
+// HasEventsOutsideSeason checks if any event linked to the league starts outside the season
+func (r *groupLeagueRepository) HasEventsOutsideSeason(ctx context.Context, leagueID uuid.UUID, seasonStart, seasonEnd time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM league_events le
+			INNER JOIN events e ON e.id = le.event_id
+			WHERE le.league_id = $1 AND (e.start_at < $2 OR e.start_at > $3)
+		)`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, leagueID, seasonStart, seasonEnd).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check league events against season: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetLeagueEvents retrieves the events linked to a league in chronological order
+func (r *groupLeagueRepository) GetLeagueEvents(ctx context.Context, leagueID uuid.UUID, limit, offset int) ([]*domain.Event, error) {
+	query := `
+		SELECT e.id, e.host_user_id, e.group_id, e.venue_id, e.title, e.description, e.game, e.format,
+			e.rules, e.visibility, e.capacity, e.start_at, e.end_at, e.timezone, e.tags, e.entry_fee, e.language,
+			e.is_recurring, e.recurrence_rule, e.created_at, e.updated_at, e.hidden_at, e.cancelled_at, e.is_official
+		FROM events e
+		INNER JOIN league_events le ON le.event_id = e.id
+		WHERE le.league_id = $1
+		ORDER BY e.start_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, leagueID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league events: %w", err)
+	}
+	defer rows.Close()
+
+	// Events are scanned the same way as in the event repository
+	eventRepo := &eventRepository{db: r.db}
+
+	var events []*domain.Event
+	for rows.Next() {
+		event, err := eventRepo.scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// ReplaceEventResults replaces all recorded results of a league event in a single transaction
+func (r *groupLeagueRepository) ReplaceEventResults(ctx context.Context, leagueID, eventID uuid.UUID, results []*domain.LeagueResult) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `DELETE FROM league_results WHERE league_id = $1 AND event_id = $2`, leagueID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to clear league results: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO league_results (league_id, event_id, user_id, placement, recorded_by, recorded_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	for _, result := range results {
+		_, err = tx.Exec(ctx, insertQuery,
+			leagueID,
+			eventID,
+			result.UserID,
+			result.Placement,
+			result.RecordedBy,
+			result.RecordedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to record league result: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetResults retrieves all results recorded in a league
+func (r *groupLeagueRepository) GetResults(ctx context.Context, leagueID uuid.UUID) ([]*domain.LeagueResult, error) {
+	query := `
+		SELECT ` + leagueResultColumns + `
+		FROM league_results
+		WHERE league_id = $1
+		ORDER BY event_id, placement NULLS LAST`
+
+	return r.queryResults(ctx, query, leagueID)
+}
+
+// GetEventResults retrieves the results recorded for one league event
+func (r *groupLeagueRepository) GetEventResults(ctx context.Context, leagueID, eventID uuid.UUID) ([]*domain.LeagueResult, error) {
+	query := `
+		SELECT ` + leagueResultColumns + `
+		FROM league_results
+		WHERE league_id = $1 AND event_id = $2
+		ORDER BY placement NULLS LAST`
+
+	return r.queryResults(ctx, query, leagueID, eventID)
+}
+
+// queryResults runs a query returning league result rows
+func (r *groupLeagueRepository) queryResults(ctx context.Context, query string, args ...interface{}) ([]*domain.LeagueResult, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*domain.LeagueResult
+	for rows.Next() {
+		var result domain.LeagueResult
+		err := rows.Scan(
+			&result.LeagueID,
+			&result.EventID,
+			&result.UserID,
+			&result.Placement,
+			&result.RecordedBy,
+			&result.RecordedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan league result: %w", err)
+		}
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+// scanGroupLeague scans a league row selected with groupLeagueColumns
+func scanGroupLeague(row pgx.Row) (*domain.GroupLeague, error) {
+	var league domain.GroupLeague
+	err := row.Scan(
+		&league.ID,
+		&league.GroupID,
+		&league.Name,
+		&league.Description,
+		&league.SeasonStart,
+		&league.SeasonEnd,
+		&league.PointScheme.PlacementPoints,
+		&league.PointScheme.ParticipationPoints,
+		&league.CreatedBy,
+		&league.CreatedAt,
+		&league.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &league, nil
+}
+
+// placementPointsOrEmpty avoids writing NULL into the NOT NULL placement_points column
+func placementPointsOrEmpty(points []int) []int {
+	if points == nil {
+		return []int{}
+	}
+	return points
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupLeagueRepository_EventsAndResults(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupLeagueRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	player := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now()
+	league := &domain.GroupLeague{
+		ID:          uuid.New(),
+		GroupID:     group.ID,
+		Name:        "Monthly Modern League",
+		SeasonStart: now.Add(-24 * time.Hour),
+		SeasonEnd:   now.Add(30 * 24 * time.Hour),
+		PointScheme: domain.LeaguePointScheme{
+			PlacementPoints:     []int{5, 3, 1},
+			ParticipationPoints: 1,
+		},
+		CreatedBy: owner.ID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := repo.Create(ctx, league)
+	require.NoError(t, err)
+
+	retrieved, err := repo.GetByID(ctx, league.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, []int{5, 3, 1}, retrieved.PointScheme.PlacementPoints)
+
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: owner.ID,
+		GroupID:    &group.ID,
+		Title:      "League Night",
+		Game:       domain.GameTypeMTG,
+		Visibility: domain.EventVisibilityGroupOnly,
+		StartAt:    now.Add(time.Hour),
+		EndAt:      now.Add(4 * time.Hour),
+		Timezone:   "UTC",
+		Language:   "en",
+		Rules:      map[string]interface{}{},
+		Tags:       []string{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	require.NoError(t, eventRepo.Create(ctx, event))
+
+	err = repo.LinkEvent(ctx, league.ID, event.ID, now)
+	require.NoError(t, err)
+
+	linked, err := repo.IsEventLinked(ctx, league.ID, event.ID)
+	require.NoError(t, err)
+	assert.True(t, linked)
+
+	leagueID, err := repo.GetLeagueIDForEvent(ctx, event.ID)
+	require.NoError(t, err)
+	require.NotNil(t, leagueID)
+	assert.Equal(t, league.ID, *leagueID)
+
+	outside, err := repo.HasEventsOutsideSeason(ctx, league.ID, league.SeasonStart, league.SeasonEnd)
+	require.NoError(t, err)
+	assert.False(t, outside)
+
+	outside, err = repo.HasEventsOutsideSeason(ctx, league.ID, now.Add(2*time.Hour), league.SeasonEnd)
+	require.NoError(t, err)
+	assert.True(t, outside)
+
+	events, err := repo.GetLeagueEvents(ctx, league.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, event.ID, events[0].ID)
+
+	first := 1
+	results := []*domain.LeagueResult{
+		{UserID: owner.ID, Placement: &first, RecordedBy: owner.ID, RecordedAt: now},
+		{UserID: player.ID, RecordedBy: owner.ID, RecordedAt: now},
+	}
+	require.NoError(t, repo.ReplaceEventResults(ctx, league.ID, event.ID, results))
+
+	// Recording again replaces the previous results
+	require.NoError(t, repo.ReplaceEventResults(ctx, league.ID, event.ID, results[:1]))
+
+	stored, err := repo.GetResults(ctx, league.ID)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	assert.Equal(t, owner.ID, stored[0].UserID)
+	assert.Equal(t, 1, *stored[0].Placement)
+
+	// Unlinking the event removes its results
+	require.NoError(t, repo.UnlinkEvent(ctx, league.ID, event.ID))
+
+	stored, err = repo.GetResults(ctx, league.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+}
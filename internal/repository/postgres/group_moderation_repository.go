@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type groupModerationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGroupModerationRepository creates a new PostgreSQL group moderation repository
+func NewGroupModerationRepository(db *pgxpool.Pool) repository.GroupModerationRepository {
+	return &groupModerationRepository{db: db}
+}
+
+const groupBanColumns = `id, group_id, user_id, banned_by, reason, expires_at, created_at`
+
+const groupModerationActionColumns = `id, group_id, actor_user_id, target_user_id, action, reason,
+	expires_at, created_at`
+
+// Ban bans a user from a group, replacing any earlier ban of the same user
+func (r *groupModerationRepository) Ban(ctx context.Context, ban *domain.GroupBan, action *domain.GroupModerationAction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	banQuery := `
+		INSERT INTO group_bans (id, group_id, user_id, banned_by, reason, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (group_id, user_id) DO UPDATE
+		SET banned_by = EXCLUDED.banned_by, reason = EXCLUDED.reason,
+			expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at
+		RETURNING id`
+
+	err = tx.QueryRow(ctx, banQuery,
+		ban.ID,
+		ban.GroupID,
+		ban.UserID,
+		ban.BannedBy,
+		ban.Reason,
+		ban.ExpiresAt,
+		ban.CreatedAt,
+	).Scan(&ban.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create group ban: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM group_members WHERE group_id = $1 AND user_id = $2`, ban.GroupID, ban.UserID); err != nil {
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+
+	inviteQuery := `
+		UPDATE group_invites
+		SET status = 'revoked', updated_at = $3
+		WHERE group_id = $1 AND invited_user_id = $2 AND status = 'pending'`
+
+	if _, err := tx.Exec(ctx, inviteQuery, ban.GroupID, ban.UserID, ban.CreatedAt); err != nil {
+		return fmt.Errorf("failed to revoke group invites: %w", err)
+	}
+
+	joinRequestQuery := `
+		UPDATE group_join_requests
+		SET status = 'rejected', reviewed_by = $3, reviewed_at = $4, updated_at = $4
+		WHERE group_id = $1 AND user_id = $2 AND status = 'pending'`
+
+	if _, err := tx.Exec(ctx, joinRequestQuery, ban.GroupID, ban.UserID, ban.BannedBy, ban.CreatedAt); err != nil {
+		return fmt.Errorf("failed to reject join requests: %w", err)
+	}
+
+	if err := insertModerationAction(ctx, tx, action); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Unban lifts a user's ban from a group
+func (r *groupModerationRepository) Unban(ctx context.Context, groupID, userID uuid.UUID, action *domain.GroupModerationAction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM group_bans WHERE group_id = $1 AND user_id = $2`, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group ban: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group ban not found")
+	}
+
+	if err := insertModerationAction(ctx, tx, action); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetActiveBan retrieves a user's ban from a group if it is in effect at activeAt
+func (r *groupModerationRepository) GetActiveBan(ctx context.Context, groupID, userID uuid.UUID, activeAt time.Time) (*domain.GroupBan, error) {
+	query := `
+		SELECT ` + groupBanColumns + `
+		FROM group_bans
+		WHERE group_id = $1 AND user_id = $2 AND (expires_at IS NULL OR expires_at > $3)`
+
+	ban, err := scanGroupBan(r.db.QueryRow(ctx, query, groupID, userID, activeAt))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get group ban: %w", err)
+	}
+
+	return ban, nil
+}
+
+// GetActiveBans retrieves the bans of a group in effect at activeAt, newest first
+func (r *groupModerationRepository) GetActiveBans(ctx context.Context, groupID uuid.UUID, activeAt time.Time, limit, offset int) ([]*domain.GroupBan, error) {
+	query := `
+		SELECT ` + groupBanColumns + `
+		FROM group_bans
+		WHERE group_id = $1 AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY created_at DESC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.Query(ctx, query, groupID, activeAt, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group bans: %w", err)
+	}
+	defer rows.Close()
+
+	var bans []*domain.GroupBan
+	for rows.Next() {
+		ban, err := scanGroupBan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group ban: %w", err)
+		}
+		bans = append(bans, ban)
+	}
+
+	return bans, nil
+}
+
+// RemoveMember removes a member from a group and records who removed them
+func (r *groupModerationRepository) RemoveMember(ctx context.Context, groupID, userID uuid.UUID, action *domain.GroupModerationAction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM group_members WHERE group_id = $1 AND user_id = $2`, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove group member: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group member not found")
+	}
+
+	if err := insertModerationAction(ctx, tx, action); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetActions retrieves a group's moderation audit trail, newest first
+func (r *groupModerationRepository) GetActions(ctx context.Context, groupID uuid.UUID, limit, offset int) ([]*domain.GroupModerationAction, error) {
+	query := `
+		SELECT ` + groupModerationActionColumns + `
+		FROM group_moderation_actions
+		WHERE group_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, groupID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderation actions: %w", err)
+	}
+	defer rows.Close()
+
+	var actions []*domain.GroupModerationAction
+	for rows.Next() {
+		var action domain.GroupModerationAction
+		err := rows.Scan(
+			&action.ID,
+			&action.GroupID,
+			&action.ActorUserID,
+			&action.TargetUserID,
+			&action.Action,
+			&action.Reason,
+			&action.ExpiresAt,
+			&action.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan moderation action: %w", err)
+		}
+		actions = append(actions, &action)
+	}
+
+	return actions, nil
+}
+
+// insertModerationAction appends an entry to the moderation audit trail within a transaction
+func insertModerationAction(ctx context.Context, tx pgx.Tx, action *domain.GroupModerationAction) error {
+	query := `
+		INSERT INTO group_moderation_actions (id, group_id, actor_user_id, target_user_id, action, reason,
+			expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := tx.Exec(ctx, query,
+		action.ID,
+		action.GroupID,
+		action.ActorUserID,
+		action.TargetUserID,
+		action.Action,
+		action.Reason,
+		action.ExpiresAt,
+		action.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record moderation action: %w", err)
+	}
+
+	return nil
+}
+
+// scanGroupBan scans a ban row selected with groupBanColumns
+func scanGroupBan(row pgx.Row) (*domain.GroupBan, error) {
+	var ban domain.GroupBan
+	err := row.Scan(
+		&ban.ID,
+		&ban.GroupID,
+		&ban.UserID,
+		&ban.BannedBy,
+		&ban.Reason,
+		&ban.ExpiresAt,
+		&ban.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ban, nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupModerationRepository_BanLifecycle(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupModerationRepository(db)
+	groupRepo := NewGroupRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	member := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	now := time.Now().Truncate(time.Microsecond)
+	require.NoError(t, groupRepo.AddMember(ctx, &domain.GroupMember{
+		GroupID:  group.ID,
+		UserID:   member.ID,
+		Role:     domain.GroupRoleMember,
+		JoinedAt: now,
+	}))
+
+	reason := "Repeated no-shows"
+	expiresAt := now.Add(7 * 24 * time.Hour)
+	ban := &domain.GroupBan{
+		ID:        uuid.New(),
+		GroupID:   group.ID,
+		UserID:    member.ID,
+		BannedBy:  owner.ID,
+		Reason:    &reason,
+		ExpiresAt: &expiresAt,
+		CreatedAt: now,
+	}
+	action := domain.NewGroupModerationAction(group.ID, owner.ID, member.ID, domain.GroupModerationActionBan, &reason, now)
+	action.ExpiresAt = &expiresAt
+
+	require.NoError(t, repo.Ban(ctx, ban, action))
+
+	// Banning removes the membership
+	isMember, err := groupRepo.IsMember(ctx, group.ID, member.ID)
+	require.NoError(t, err)
+	assert.False(t, isMember)
+
+	active, err := repo.GetActiveBan(ctx, group.ID, member.ID, now)
+	require.NoError(t, err)
+	require.NotNil(t, active)
+	assert.Equal(t, reason, *active.Reason)
+
+	// Temporary bans lapse on their own
+	lapsed, err := repo.GetActiveBan(ctx, group.ID, member.ID, expiresAt.Add(time.Second))
+	require.NoError(t, err)
+	assert.Nil(t, lapsed)
+
+	bans, err := repo.GetActiveBans(ctx, group.ID, now, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, bans, 1)
+
+	later := now.Add(time.Minute)
+	require.NoError(t, repo.Unban(ctx, group.ID, member.ID,
+		domain.NewGroupModerationAction(group.ID, owner.ID, member.ID, domain.GroupModerationActionUnban, nil, later)))
+
+	active, err = repo.GetActiveBan(ctx, group.ID, member.ID, later)
+	require.NoError(t, err)
+	assert.Nil(t, active)
+
+	// Unbanning twice fails
+	err = repo.Unban(ctx, group.ID, member.ID,
+		domain.NewGroupModerationAction(group.ID, owner.ID, member.ID, domain.GroupModerationActionUnban, nil, later))
+	assert.Error(t, err)
+
+	actions, err := repo.GetActions(ctx, group.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	assert.Equal(t, domain.GroupModerationActionUnban, actions[0].Action)
+	assert.Equal(t, domain.GroupModerationActionBan, actions[1].Action)
+}
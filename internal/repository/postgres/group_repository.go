@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -21,6 +22,15 @@ func NewGroupRepository(db *pgxpool.Pool) repository.GroupRepository {
 	return &groupRepository{db: db}
 }
 
+const groupColumns = `id, name, description, owner_user_id, created_at, updated_at, is_active,
+		visibility, city, country, latitude, longitude, games`
+
+// groupMemberSelect selects members together with the permissions granted by their custom role
+const groupMemberSelect = `
+		SELECT gm.group_id, gm.user_id, gm.role, gm.joined_at, gm.custom_role_id, cr.permissions
+		FROM group_members gm
+		LEFT JOIN group_custom_roles cr ON cr.id = gm.custom_role_id`
+
 // Create creates a new group
 func (r *groupRepository) Create(ctx context.Context, group *domain.Group) error {
 	tx, err := r.db.Begin(ctx)
@@ -31,8 +41,9 @@ func (r *groupRepository) Create(ctx context.Context, group *domain.Group) error
 
 	// Create the group
 	query := `
-		INSERT INTO groups (id, name, description, owner_user_id, created_at, updated_at, is_active)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+		INSERT INTO groups (id, name, description, owner_user_id, created_at, updated_at, is_active,
+			visibility, city, country, latitude, longitude, games)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
 
 	_, err = tx.Exec(ctx, query,
 		group.ID,
@@ -42,6 +53,12 @@ func (r *groupRepository) Create(ctx context.Context, group *domain.Group) error
 		group.CreatedAt,
 		group.UpdatedAt,
 		group.IsActive,
+		groupVisibilityOrDefault(group.Visibility),
+		group.City,
+		group.Country,
+		group.Latitude,
+		group.Longitude,
+		gamesToStrings(group.Games),
 	)
 
 	if err != nil {
@@ -69,22 +86,9 @@ func (r *groupRepository) Create(ctx context.Context, group *domain.Group) error
 
 // GetByID retrieves a group by ID
 func (r *groupRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Group, error) {
-	query := `
-		SELECT id, name, description, owner_user_id, created_at, updated_at, is_active
-		FROM groups
-		WHERE id = $1`
-
-	var group domain.Group
-	err := r.db.QueryRow(ctx, query, id).Scan(
-		&group.ID,
-		&group.Name,
-		&group.Description,
-		&group.OwnerUserID,
-		&group.CreatedAt,
-		&group.UpdatedAt,
-		&group.IsActive,
-	)
+	query := `SELECT ` + groupColumns + ` FROM groups WHERE id = $1`
 
+	group, err := scanGroup(r.db.QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -92,7 +96,7 @@ func (r *groupRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Gr
 		return nil, fmt.Errorf("failed to get group by ID: %w", err)
 	}
 
-	return &group, nil
+	return group, nil
 }
 
 // GetByIDWithMembers retrieves a group with its members by ID
@@ -125,7 +129,8 @@ func (r *groupRepository) GetByIDWithMembers(ctx context.Context, id uuid.UUID)
 func (r *groupRepository) Update(ctx context.Context, group *domain.Group) error {
 	query := `
 		UPDATE groups
-		SET name = $2, description = $3, owner_user_id = $4, updated_at = $5, is_active = $6
+		SET name = $2, description = $3, owner_user_id = $4, updated_at = $5, is_active = $6,
+			visibility = $7, city = $8, country = $9, latitude = $10, longitude = $11, games = $12
 		WHERE id = $1`
 
 	result, err := r.db.Exec(ctx, query,
@@ -135,6 +140,12 @@ func (r *groupRepository) Update(ctx context.Context, group *domain.Group) error
 		group.OwnerUserID,
 		group.UpdatedAt,
 		group.IsActive,
+		groupVisibilityOrDefault(group.Visibility),
+		group.City,
+		group.Country,
+		group.Latitude,
+		group.Longitude,
+		gamesToStrings(group.Games),
 	)
 
 	if err != nil {
@@ -167,7 +178,8 @@ func (r *groupRepository) Delete(ctx context.Context, id uuid.UUID) error {
 // GetUserGroups retrieves groups for a specific user
 func (r *groupRepository) GetUserGroups(ctx context.Context, userID uuid.UUID) ([]*domain.Group, error) {
 	query := `
-		SELECT g.id, g.name, g.description, g.owner_user_id, g.created_at, g.updated_at, g.is_active
+		SELECT g.id, g.name, g.description, g.owner_user_id, g.created_at, g.updated_at, g.is_active,
+			   g.visibility, g.city, g.country, g.latitude, g.longitude, g.games
 		FROM groups g
 		INNER JOIN group_members gm ON g.id = gm.group_id
 		WHERE gm.user_id = $1 AND g.is_active = true
@@ -181,12 +193,11 @@ func (r *groupRepository) GetUserGroups(ctx context.Context, userID uuid.UUID) (
 
 	var groups []*domain.Group
 	for rows.Next() {
-		var group domain.Group
-		if err := rows.Scan(&group.ID, &group.Name, &group.Description, &group.OwnerUserID,
-			&group.CreatedAt, &group.UpdatedAt, &group.IsActive); err != nil {
+		group, err := scanGroup(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan group: %w", err)
 		}
-		groups = append(groups, &group)
+		groups = append(groups, group)
 	}
 
 	return groups, nil
@@ -214,7 +225,7 @@ func (r *groupRepository) GetUserGroupsWithMembers(ctx context.Context, userID u
 // GetGroupsByOwner retrieves groups owned by a specific user
 func (r *groupRepository) GetGroupsByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Group, error) {
 	query := `
-		SELECT id, name, description, owner_user_id, created_at, updated_at, is_active
+		SELECT ` + groupColumns + `
 		FROM groups
 		WHERE owner_user_id = $1 AND is_active = true
 		ORDER BY created_at DESC`
@@ -227,12 +238,11 @@ func (r *groupRepository) GetGroupsByOwner(ctx context.Context, ownerID uuid.UUI
 
 	var groups []*domain.Group
 	for rows.Next() {
-		var group domain.Group
-		if err := rows.Scan(&group.ID, &group.Name, &group.Description, &group.OwnerUserID,
-			&group.CreatedAt, &group.UpdatedAt, &group.IsActive); err != nil {
+		group, err := scanGroup(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan group: %w", err)
 		}
-		groups = append(groups, &group)
+		groups = append(groups, group)
 	}
 
 	return groups, nil
@@ -260,19 +270,10 @@ func (r *groupRepository) AddMember(ctx context.Context, member *domain.GroupMem
 
 // GetMember retrieves a specific group member
 func (r *groupRepository) GetMember(ctx context.Context, groupID, userID uuid.UUID) (*domain.GroupMember, error) {
-	query := `
-		SELECT group_id, user_id, role, joined_at
-		FROM group_members
-		WHERE group_id = $1 AND user_id = $2`
-
-	var member domain.GroupMember
-	err := r.db.QueryRow(ctx, query, groupID, userID).Scan(
-		&member.GroupID,
-		&member.UserID,
-		&member.Role,
-		&member.JoinedAt,
-	)
+	query := groupMemberSelect + `
+		WHERE gm.group_id = $1 AND gm.user_id = $2`
 
+	member, err := scanGroupMember(r.db.QueryRow(ctx, query, groupID, userID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -280,7 +281,7 @@ func (r *groupRepository) GetMember(ctx context.Context, groupID, userID uuid.UU
 		return nil, fmt.Errorf("failed to get group member: %w", err)
 	}
 
-	return &member, nil
+	return member, nil
 }
 
 // UpdateMemberRole updates a member's role in a group
@@ -320,11 +321,9 @@ func (r *groupRepository) RemoveMember(ctx context.Context, groupID, userID uuid
 
 // GetGroupMembers retrieves all members of a group
 func (r *groupRepository) GetGroupMembers(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupMember, error) {
-	query := `
-		SELECT group_id, user_id, role, joined_at
-		FROM group_members
-		WHERE group_id = $1
-		ORDER BY joined_at ASC`
+	query := groupMemberSelect + `
+		WHERE gm.group_id = $1
+		ORDER BY gm.joined_at ASC`
 
 	rows, err := r.db.Query(ctx, query, groupID)
 	if err != nil {
@@ -334,11 +333,11 @@ func (r *groupRepository) GetGroupMembers(ctx context.Context, groupID uuid.UUID
 
 	var members []*domain.GroupMember
 	for rows.Next() {
-		var member domain.GroupMember
-		if err := rows.Scan(&member.GroupID, &member.UserID, &member.Role, &member.JoinedAt); err != nil {
+		member, err := scanGroupMember(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan group member: %w", err)
 		}
-		members = append(members, &member)
+		members = append(members, member)
 	}
 
 	return members, nil
@@ -346,11 +345,9 @@ func (r *groupRepository) GetGroupMembers(ctx context.Context, groupID uuid.UUID
 
 // GetMembersByRole retrieves members of a group with a specific role
 func (r *groupRepository) GetMembersByRole(ctx context.Context, groupID uuid.UUID, role domain.GroupRole) ([]*domain.GroupMember, error) {
-	query := `
-		SELECT group_id, user_id, role, joined_at
-		FROM group_members
-		WHERE group_id = $1 AND role = $2
-		ORDER BY joined_at ASC`
+	query := groupMemberSelect + `
+		WHERE gm.group_id = $1 AND gm.role = $2
+		ORDER BY gm.joined_at ASC`
 
 	rows, err := r.db.Query(ctx, query, groupID, role)
 	if err != nil {
@@ -360,11 +357,11 @@ func (r *groupRepository) GetMembersByRole(ctx context.Context, groupID uuid.UUI
 
 	var members []*domain.GroupMember
 	for rows.Next() {
-		var member domain.GroupMember
-		if err := rows.Scan(&member.GroupID, &member.UserID, &member.Role, &member.JoinedAt); err != nil {
+		member, err := scanGroupMember(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan group member: %w", err)
 		}
-		members = append(members, &member)
+		members = append(members, member)
 	}
 
 	return members, nil
@@ -443,3 +440,154 @@ func (r *groupRepository) GetMemberCount(ctx context.Context, groupID uuid.UUID)
 
 	return count, nil
 }
+
+// Search finds active public groups by name similarity, distance and game
+func (r *groupRepository) Search(ctx context.Context, params domain.GroupSearchParams) ([]*domain.GroupSearchResult, error) {
+	conditions := []string{"g.is_active = true", "g.visibility = 'public'"}
+	var orderBy []string
+	var args []interface{}
+	argIndex := 1
+
+	distanceExpr := "NULL::float8"
+
+	if params.Query != nil && strings.TrimSpace(*params.Query) != "" {
+		// Trigram similarity (backed by idx_groups_name_trgm), plus substring matches for short queries
+		conditions = append(conditions, fmt.Sprintf("(g.name %% $%d OR g.name ILIKE '%%' || $%d::text || '%%')", argIndex, argIndex))
+		orderBy = append(orderBy, fmt.Sprintf("similarity(g.name, $%d) DESC", argIndex))
+		args = append(args, strings.TrimSpace(*params.Query))
+		argIndex++
+	}
+
+	if params.Near != nil {
+		groupPoint := "ST_MakePoint(g.longitude, g.latitude)::geography"
+		searchPoint := fmt.Sprintf("ST_MakePoint($%d, $%d)::geography", argIndex, argIndex+1)
+		args = append(args, params.Near.Longitude, params.Near.Latitude)
+		argIndex += 2
+
+		distanceExpr = fmt.Sprintf("ST_Distance(%s, %s) / 1000", groupPoint, searchPoint)
+		conditions = append(conditions, "g.latitude IS NOT NULL AND g.longitude IS NOT NULL")
+
+		if params.RadiusKm != nil {
+			conditions = append(conditions, fmt.Sprintf("ST_DWithin(%s, %s, $%d)", groupPoint, searchPoint, argIndex))
+			args = append(args, *params.RadiusKm*1000) // Convert km to meters
+			argIndex++
+		}
+
+		orderBy = append(orderBy, "distance_km ASC")
+	}
+
+	if params.Game != nil {
+		conditions = append(conditions, fmt.Sprintf("$%d = ANY(g.games)", argIndex))
+		args = append(args, string(*params.Game))
+		argIndex++
+	}
+
+	orderBy = append(orderBy, "member_count DESC", "g.created_at DESC")
+
+	query := `
+		SELECT g.id, g.name, g.description, g.owner_user_id, g.created_at, g.updated_at, g.is_active,
+			   g.visibility, g.city, g.country, g.latitude, g.longitude, g.games,
+			   (SELECT COUNT(*) FROM group_members gm WHERE gm.group_id = g.id) AS member_count,
+			   ` + distanceExpr + ` AS distance_km
+		FROM groups g
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY ` + strings.Join(orderBy, ", ") +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, params.Limit, params.Offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search groups: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*domain.GroupSearchResult
+	for rows.Next() {
+		var result domain.GroupSearchResult
+		group, err := scanGroup(rows, &result.MemberCount, &result.DistanceKm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan group: %w", err)
+		}
+		result.Group = *group
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+// scanGroup scans a group row selected with groupColumns, followed by any extra columns
+func scanGroup(row pgx.Row, extra ...interface{}) (*domain.Group, error) {
+	var group domain.Group
+	var visibility *string
+	var games []string
+
+	dest := []interface{}{
+		&group.ID,
+		&group.Name,
+		&group.Description,
+		&group.OwnerUserID,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+		&group.IsActive,
+		&visibility,
+		&group.City,
+		&group.Country,
+		&group.Latitude,
+		&group.Longitude,
+		&games,
+	}
+
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if visibility != nil {
+		group.Visibility = domain.GroupVisibility(*visibility)
+	}
+	for _, game := range games {
+		group.Games = append(group.Games, domain.GameType(game))
+	}
+
+	return &group, nil
+}
+
+// scanGroupMember scans a member row selected with groupMemberSelect
+func scanGroupMember(row pgx.Row) (*domain.GroupMember, error) {
+	var member domain.GroupMember
+	var permissions []string
+
+	err := row.Scan(
+		&member.GroupID,
+		&member.UserID,
+		&member.Role,
+		&member.JoinedAt,
+		&member.CustomRoleID,
+		&permissions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		member.Permissions = append(member.Permissions, domain.Permission(permission))
+	}
+
+	return &member, nil
+}
+
+// groupVisibilityOrDefault returns the visibility to store, defaulting to private
+func groupVisibilityOrDefault(visibility domain.GroupVisibility) string {
+	if visibility == "" {
+		return string(domain.GroupVisibilityPrivate)
+	}
+	return string(visibility)
+}
+
+// gamesToStrings converts game types for storage in a text array
+func gamesToStrings(games []domain.GameType) []string {
+	result := make([]string, len(games))
+	for i, game := range games {
+		result[i] = string(game)
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type groupWebhookRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewGroupWebhookRepository creates a new PostgreSQL group webhook repository
+func NewGroupWebhookRepository(db *pgxpool.Pool) repository.GroupWebhookRepository {
+	return &groupWebhookRepository{db: db}
+}
+
+// Create creates a new group webhook
+func (r *groupWebhookRepository) Create(ctx context.Context, webhook *domain.GroupWebhook) error {
+	query := `
+		INSERT INTO group_webhooks (id, group_id, url, locale, timezone, is_active, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.db.Exec(ctx, query,
+		webhook.ID,
+		webhook.GroupID,
+		webhook.URL,
+		webhook.Locale,
+		webhook.Timezone,
+		webhook.IsActive,
+		webhook.CreatedBy,
+		webhook.CreatedAt,
+		webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create group webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetByGroupID retrieves the webhook registered for a group
+func (r *groupWebhookRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) (*domain.GroupWebhook, error) {
+	query := `
+		SELECT id, group_id, url, locale, timezone, is_active, created_by,
+			   last_delivered_at, last_error, created_at, updated_at
+		FROM group_webhooks
+		WHERE group_id = $1`
+
+	var webhook domain.GroupWebhook
+	err := r.db.QueryRow(ctx, query, groupID).Scan(
+		&webhook.ID,
+		&webhook.GroupID,
+		&webhook.URL,
+		&webhook.Locale,
+		&webhook.Timezone,
+		&webhook.IsActive,
+		&webhook.CreatedBy,
+		&webhook.LastDeliveredAt,
+		&webhook.LastError,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get group webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// Update updates a group webhook
+func (r *groupWebhookRepository) Update(ctx context.Context, webhook *domain.GroupWebhook) error {
+	query := `
+		UPDATE group_webhooks
+		SET url = $2, locale = $3, timezone = $4, is_active = $5, last_error = $6, updated_at = $7
+		WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query,
+		webhook.ID,
+		webhook.URL,
+		webhook.Locale,
+		webhook.Timezone,
+		webhook.IsActive,
+		webhook.LastError,
+		webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update group webhook: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group webhook not found")
+	}
+
+	return nil
+}
+
+// Delete removes the webhook registered for a group
+func (r *groupWebhookRepository) Delete(ctx context.Context, groupID uuid.UUID) error {
+	query := `DELETE FROM group_webhooks WHERE group_id = $1`
+
+	result, err := r.db.Exec(ctx, query, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to delete group webhook: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("group webhook not found")
+	}
+
+	return nil
+}
+
+// RecordDelivery stores the outcome of the latest delivery attempt
+func (r *groupWebhookRepository) RecordDelivery(ctx context.Context, id uuid.UUID, deliveredAt time.Time, errorMessage *string) error {
+	var query string
+	if errorMessage == nil {
+		query = `UPDATE group_webhooks SET last_delivered_at = $2, last_error = NULL WHERE id = $1`
+		_, err := r.db.Exec(ctx, query, id, deliveredAt)
+		if err != nil {
+			return fmt.Errorf("failed to record webhook delivery: %w", err)
+		}
+		return nil
+	}
+
+	query = `UPDATE group_webhooks SET last_error = $2 WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, id, *errorMessage)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupWebhookRepository_CRUD(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewGroupWebhookRepository(db)
+	ctx := context.Background()
+
+	owner := createTestUser(t, db)
+	group := createTestGroup(t, db, owner.ID)
+
+	webhook := &domain.GroupWebhook{
+		ID:        uuid.New(),
+		GroupID:   group.ID,
+		URL:       "https://discord.com/api/webhooks/123/token",
+		Locale:    "pt",
+		Timezone:  "Europe/Lisbon",
+		IsActive:  true,
+		CreatedBy: &owner.ID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	err := repo.Create(ctx, webhook)
+	require.NoError(t, err)
+
+	retrieved, err := repo.GetByGroupID(ctx, group.ID)
+	require.NoError(t, err)
+	require.NotNil(t, retrieved)
+	assert.Equal(t, webhook.URL, retrieved.URL)
+	assert.Equal(t, "Europe/Lisbon", retrieved.Timezone)
+
+	// Update
+	webhook.Locale = "en"
+	webhook.UpdatedAt = time.Now()
+	err = repo.Update(ctx, webhook)
+	require.NoError(t, err)
+
+	// Record a failed and then a successful delivery
+	errMsg := "HTTP 404"
+	err = repo.RecordDelivery(ctx, webhook.ID, time.Now(), &errMsg)
+	require.NoError(t, err)
+
+	retrieved, err = repo.GetByGroupID(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "en", retrieved.Locale)
+	require.NotNil(t, retrieved.LastError)
+	assert.Nil(t, retrieved.LastDeliveredAt)
+
+	err = repo.RecordDelivery(ctx, webhook.ID, time.Now(), nil)
+	require.NoError(t, err)
+
+	retrieved, err = repo.GetByGroupID(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved.LastError)
+	assert.NotNil(t, retrieved.LastDeliveredAt)
+
+	// Delete
+	err = repo.Delete(ctx, group.ID)
+	require.NoError(t, err)
+
+	retrieved, err = repo.GetByGroupID(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Nil(t, retrieved)
+}
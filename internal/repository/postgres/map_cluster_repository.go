@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type mapClusterRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewMapClusterRepository creates a new PostgreSQL map cluster repository
+func NewMapClusterRepository(db *pgxpool.Pool) repository.MapClusterRepository {
+	return &mapClusterRepository{db: db}
+}
+
+// Every map cluster query takes the same arguments: $1-$4 the bounds (south, north, west, east), $5 the
+// grid cell size in degrees, $6 the start of upcoming events and $7 an optional game filter.
+const (
+	mapClusterCells = `FLOOR(v.longitude / $5)::int, FLOOR(v.latitude / $5)::int`
+
+	mapClusterInBounds = `v.latitude BETWEEN $1 AND $2 AND v.longitude BETWEEN $3 AND $4`
+
+	mapClusterUpcomingEvent = `e.start_at >= $6 AND e.visibility = 'public'
+		AND e.hidden_at IS NULL AND e.cancelled_at IS NULL
+		AND ($7::text IS NULL OR e.game::text = $7)`
+)
+
+// GetVenueClusters groups the venues within the bounds by grid cell
+func (r *mapClusterRepository) GetVenueClusters(ctx context.Context, grid *domain.MapGrid, params domain.MapClusterParams) ([]*domain.MapCluster, error) {
+	args := mapClusterArgs(grid, params)
+
+	totalsQuery := `
+		SELECT ` + mapClusterCells + `, NULL::text, COUNT(*), SUM(v.latitude), SUM(v.longitude)
+		FROM venues v
+		WHERE ` + mapClusterInBounds + `
+		  AND ($7::text IS NULL OR EXISTS(
+			SELECT 1 FROM events e WHERE e.venue_id = v.id AND ` + mapClusterUpcomingEvent + `
+		  ))
+		GROUP BY 1, 2`
+
+	totals, err := r.queryBuckets(ctx, totalsQuery, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cluster venues: %w", err)
+	}
+
+	gamesQuery := `
+		SELECT ` + mapClusterCells + `, e.game::text, COUNT(DISTINCT v.id), 0::float8, 0::float8
+		FROM venues v
+		JOIN events e ON e.venue_id = v.id
+		WHERE ` + mapClusterInBounds + ` AND ` + mapClusterUpcomingEvent + `
+		GROUP BY 1, 2, 3`
+
+	games, err := r.queryBuckets(ctx, gamesQuery, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count venue games: %w", err)
+	}
+
+	return grid.BuildClusters(domain.MapClusterLayerVenues, totals, games), nil
+}
+
+// GetEventClusters groups the upcoming public events at venues within the bounds by grid cell
+func (r *mapClusterRepository) GetEventClusters(ctx context.Context, grid *domain.MapGrid, params domain.MapClusterParams) ([]*domain.MapCluster, error) {
+	query := `
+		SELECT ` + mapClusterCells + `, e.game::text, COUNT(*), SUM(v.latitude), SUM(v.longitude)
+		FROM events e
+		JOIN venues v ON v.id = e.venue_id
+		WHERE ` + mapClusterInBounds + ` AND ` + mapClusterUpcomingEvent + `
+		GROUP BY 1, 2, 3`
+
+	buckets, err := r.queryBuckets(ctx, query, mapClusterArgs(grid, params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to cluster events: %w", err)
+	}
+
+	// Each bucket counts one game's events, so the buckets are both the totals and the breakdown
+	return grid.BuildClusters(domain.MapClusterLayerEvents, buckets, buckets), nil
+}
+
+// queryBuckets runs a map cluster query returning cell, game, count and coordinate sums
+func (r *mapClusterRepository) queryBuckets(ctx context.Context, query string, args []interface{}) ([]domain.MapClusterBucket, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buckets []domain.MapClusterBucket
+	for rows.Next() {
+		bucket, err := scanMapClusterBucket(rows)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, rows.Err()
+}
+
+// mapClusterArgs builds the arguments shared by the map cluster queries
+func mapClusterArgs(grid *domain.MapGrid, params domain.MapClusterParams) []interface{} {
+	var game *string
+	if params.Game != nil {
+		value := string(*params.Game)
+		game = &value
+	}
+
+	return []interface{}{
+		params.Bounds.SouthWest.Latitude,
+		params.Bounds.NorthEast.Latitude,
+		params.Bounds.SouthWest.Longitude,
+		params.Bounds.NorthEast.Longitude,
+		grid.CellSize,
+		params.From,
+		game,
+	}
+}
+
+// scanMapClusterBucket scans a map cluster bucket row
+func scanMapClusterBucket(row pgx.Row) (domain.MapClusterBucket, error) {
+	var bucket domain.MapClusterBucket
+	var game *string
+
+	err := row.Scan(
+		&bucket.CellX,
+		&bucket.CellY,
+		&game,
+		&bucket.Count,
+		&bucket.LatitudeSum,
+		&bucket.LongitudeSum,
+	)
+	if err != nil {
+		return bucket, fmt.Errorf("failed to scan map cluster: %w", err)
+	}
+
+	if game != nil {
+		gameType := domain.GameType(*game)
+		bucket.Game = &gameType
+	}
+
+	return bucket, nil
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapClusterRepository_Clusters(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewMapClusterRepository(db)
+	venueRepo := NewVenueRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	user := createTestUser(t, db)
+
+	newVenue := func(name string, lat, lon float64) *domain.Venue {
+		venue := &domain.Venue{
+			ID:        uuid.New(),
+			Name:      name,
+			Type:      domain.VenueTypeStore,
+			Address:   name + " Street",
+			City:      "Porto",
+			Country:   "PT",
+			Latitude:  lat,
+			Longitude: lon,
+			Metadata:  map[string]interface{}{},
+			CreatedBy: &user.ID,
+			CreatedAt: now,
+		}
+		require.NoError(t, venueRepo.Create(ctx, venue))
+		return venue
+	}
+
+	newEvent := func(venue *domain.Venue, game domain.GameType, visibility domain.EventVisibility, startAt time.Time) {
+		event := &domain.Event{
+			ID:         uuid.New(),
+			HostUserID: user.ID,
+			VenueID:    &venue.ID,
+			Title:      "Weekly Tournament",
+			Game:       game,
+			Visibility: visibility,
+			StartAt:    startAt,
+			EndAt:      startAt.Add(3 * time.Hour),
+			Timezone:   "UTC",
+			Language:   "pt",
+			Rules:      map[string]interface{}{},
+			Tags:       []string{},
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		require.NoError(t, eventRepo.Create(ctx, event))
+	}
+
+	porto1 := newVenue("Arena Porto", 41.1466, -8.6060)
+	porto2 := newVenue("Dragon's Den", 41.1580, -8.6290)
+	lisbon := newVenue("Lisbon Games", 38.7223, -9.1393)
+	newVenue("Madrid Cards", 40.4168, -3.7038) // Outside the bounds
+
+	tomorrow := now.Add(24 * time.Hour)
+	newEvent(porto1, domain.GameTypeMTG, domain.EventVisibilityPublic, tomorrow)
+	newEvent(porto1, domain.GameTypeMTG, domain.EventVisibilityPublic, tomorrow.Add(7*24*time.Hour))
+	newEvent(porto2, domain.GameTypePokemon, domain.EventVisibilityPublic, tomorrow)
+	newEvent(porto2, domain.GameTypeMTG, domain.EventVisibilityPrivate, tomorrow)                  // Not public
+	newEvent(lisbon, domain.GameTypeLorcana, domain.EventVisibilityPublic, now.Add(-48*time.Hour)) // Past
+
+	grid, err := domain.NewMapGrid(6) // About 1.4 degree cells, so Porto and Lisbon split
+	require.NoError(t, err)
+	params := domain.MapClusterParams{
+		Bounds: domain.BoundingBox{
+			NorthEast: domain.Coordinates{Latitude: 42.2, Longitude: -6.1},
+			SouthWest: domain.Coordinates{Latitude: 36.9, Longitude: -9.6},
+		},
+		Zoom: 6,
+		From: now,
+	}
+
+	venues, err := repo.GetVenueClusters(ctx, grid, params)
+	require.NoError(t, err)
+	require.Len(t, venues, 2)
+	assert.Equal(t, 2, venues[0].Count, "Porto venues cluster together")
+	assert.Equal(t, map[domain.GameType]int{domain.GameTypeMTG: 1, domain.GameTypePokemon: 1}, venues[0].Games)
+	assert.InDelta(t, (41.1466+41.1580)/2, venues[0].Latitude, 0.0001)
+	assert.Equal(t, 1, venues[1].Count)
+	assert.Empty(t, venues[1].Games, "past events don't count")
+
+	events, err := repo.GetEventClusters(ctx, grid, params)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, 3, events[0].Count)
+	assert.Equal(t, map[domain.GameType]int{domain.GameTypeMTG: 2, domain.GameTypePokemon: 1}, events[0].Games)
+
+	// Filtering by game keeps only venues hosting that game
+	pokemon := domain.GameTypePokemon
+	params.Game = &pokemon
+
+	venues, err = repo.GetVenueClusters(ctx, grid, params)
+	require.NoError(t, err)
+	require.Len(t, venues, 1)
+	assert.Equal(t, 1, venues[0].Count)
+
+	events, err = repo.GetEventClusters(ctx, grid, params)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, 1, events[0].Count)
+}
@@ -197,6 +197,56 @@ func (r *notificationRepository) GetFailedNotifications(ctx context.Context, lim
 	return notifications, nil
 }
 
+// GetNotificationsByStatus retrieves notifications in a given status, in the order they were scheduled
+func (r *notificationRepository) GetNotificationsByStatus(ctx context.Context, status domain.NotificationStatus, limit, offset int) ([]*domain.Notification, error) {
+	query := `
+		SELECT id, user_id, type, payload, status, scheduled_at, sent_at, retry_count, error_message, created_at
+		FROM notifications
+		WHERE status = $1
+		ORDER BY scheduled_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications by status: %w", err)
+	}
+	defer rows.Close()
+
+	var notifications []*domain.Notification
+	for rows.Next() {
+		notification, err := r.scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+// CountByStatus counts notifications in each status
+func (r *notificationRepository) CountByStatus(ctx context.Context) (map[domain.NotificationStatus]int, error) {
+	query := `SELECT status, COUNT(*) FROM notifications GROUP BY status`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count notifications: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[domain.NotificationStatus]int)
+	for rows.Next() {
+		var status domain.NotificationStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan notification count: %w", err)
+		}
+		counts[status] = count
+	}
+
+	return counts, nil
+}
+
 // MarkAsSent marks a notification as sent
 func (r *notificationRepository) MarkAsSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
 	query := `
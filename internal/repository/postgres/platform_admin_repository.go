@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type platformAdminRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPlatformAdminRepository creates a new PostgreSQL platform admin repository
+func NewPlatformAdminRepository(db *pgxpool.Pool) repository.PlatformAdminRepository {
+	return &platformAdminRepository{db: db}
+}
+
+const adminActionColumns = `id, admin_id, action, target_user_id, reason, metadata, created_at`
+
+// SearchUsers matches users by email or profile display name
+func (r *platformAdminRepository) SearchUsers(ctx context.Context, query string, limit, offset int) ([]*domain.User, error) {
+	sqlQuery := `
+		SELECT u.id, u.email, u.password_hash, u.created_at, u.updated_at, u.is_active, u.last_login, u.platform_role
+		FROM users u
+		LEFT JOIN profiles p ON p.user_id = u.id
+		WHERE $1 = '' OR u.email ILIKE '%' || $1 || '%' OR p.display_name ILIKE '%' || $1 || '%'
+		ORDER BY u.created_at DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, sqlQuery, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*domain.User
+	for rows.Next() {
+		var user domain.User
+		err := rows.Scan(
+			&user.ID,
+			&user.Email,
+			&user.PasswordHash,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+			&user.IsActive,
+			&user.LastLogin,
+			&user.PlatformRole,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, &user)
+	}
+
+	return users, nil
+}
+
+// SetUserActive activates or deactivates an account and records the admin action
+func (r *platformAdminRepository) SetUserActive(ctx context.Context, userID uuid.UUID, active bool, action *domain.AdminAction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `UPDATE users SET is_active = $2, updated_at = NOW() WHERE id = $1`, userID, active)
+	if err != nil {
+		return fmt.Errorf("failed to set user active status: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	if err := insertAdminAction(ctx, tx, action); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetPlatformRole changes a user's platform role and records the admin action
+func (r *platformAdminRepository) SetPlatformRole(ctx context.Context, userID uuid.UUID, role domain.PlatformRole, action *domain.AdminAction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `UPDATE users SET platform_role = $2, updated_at = NOW() WHERE id = $1`, userID, role)
+	if err != nil {
+		return fmt.Errorf("failed to set platform role: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	if err := insertAdminAction(ctx, tx, action); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateAction records an admin action in the audit trail
+func (r *platformAdminRepository) CreateAction(ctx context.Context, action *domain.AdminAction) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertAdminAction(ctx, tx, action); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetActions retrieves the admin audit trail, newest first
+func (r *platformAdminRepository) GetActions(ctx context.Context, targetUserID *uuid.UUID, limit, offset int) ([]*domain.AdminAction, error) {
+	query := `
+		SELECT ` + adminActionColumns + `
+		FROM admin_actions
+		WHERE $1::uuid IS NULL OR target_user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, targetUserID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin actions: %w", err)
+	}
+	defer rows.Close()
+
+	var actions []*domain.AdminAction
+	for rows.Next() {
+		var action domain.AdminAction
+		var metadataJSON []byte
+		err := rows.Scan(
+			&action.ID,
+			&action.AdminID,
+			&action.Action,
+			&action.TargetUserID,
+			&action.Reason,
+			&metadataJSON,
+			&action.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan admin action: %w", err)
+		}
+
+		if metadataJSON != nil {
+			if err := json.Unmarshal(metadataJSON, &action.Metadata); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal admin action metadata: %w", err)
+			}
+		}
+
+		actions = append(actions, &action)
+	}
+
+	return actions, nil
+}
+
+// insertAdminAction writes an admin action inside the caller's transaction
+func insertAdminAction(ctx context.Context, tx pgx.Tx, action *domain.AdminAction) error {
+	var metadataJSON []byte
+	if action.Metadata != nil {
+		var err error
+		metadataJSON, err = json.Marshal(action.Metadata)
+		if err != nil {
+			return fmt.Errorf("failed to marshal admin action metadata: %w", err)
+		}
+	}
+
+	query := `
+		INSERT INTO admin_actions (` + adminActionColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := tx.Exec(ctx, query,
+		action.ID,
+		action.AdminID,
+		action.Action,
+		action.TargetUserID,
+		action.Reason,
+		metadataJSON,
+		action.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record admin action: %w", err)
+	}
+
+	return nil
+}
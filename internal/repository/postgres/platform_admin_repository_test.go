@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformAdminRepository_AccountOperations(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewPlatformAdminRepository(db)
+	userRepo := NewUserRepository(db)
+	ctx := context.Background()
+
+	admin := createTestUser(t, db)
+	target := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+
+	users, err := repo.SearchUsers(ctx, target.Email, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, target.ID, users[0].ID)
+
+	// Deactivation and its audit entry are written together
+	reason := "Chargeback fraud"
+	deactivate := domain.NewAdminAction(admin.ID, domain.AdminActionDeactivateUser, target.ID, &reason, nil, now)
+	require.NoError(t, repo.SetUserActive(ctx, target.ID, false, deactivate))
+
+	stored, err := userRepo.GetByID(ctx, target.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.IsActive)
+
+	changeRole := domain.NewAdminAction(admin.ID, domain.AdminActionChangeRole, target.ID, nil,
+		map[string]interface{}{"from": "user", "to": "moderator"}, now.Add(time.Second))
+	require.NoError(t, repo.SetPlatformRole(ctx, target.ID, domain.PlatformRoleModerator, changeRole))
+
+	stored, err = userRepo.GetByID(ctx, target.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PlatformRoleModerator, stored.PlatformRole)
+
+	actions, err := repo.GetActions(ctx, &target.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, actions, 2)
+	assert.Equal(t, domain.AdminActionChangeRole, actions[0].Action)
+	assert.Equal(t, "moderator", actions[0].Metadata["to"])
+	assert.Equal(t, domain.AdminActionDeactivateUser, actions[1].Action)
+
+	otherActions, err := repo.GetActions(ctx, &admin.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, otherActions)
+}
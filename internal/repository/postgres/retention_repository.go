@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type retentionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewRetentionRepository creates a new PostgreSQL retention repository
+func NewRetentionRepository(db *pgxpool.Pool) repository.RetentionRepository {
+	return &retentionRepository{db: db}
+}
+
+// retentionScopes selects the records of each entity that are older than the cutoff in $1.
+// Pending notifications are kept so scheduled reminders still go out, and exports still being
+// built are kept until they finish. Of past RSVPs only replies that never became attendance are
+// removed: going and checked-in RSVPs are the attendance record that venue reviews, league results
+// and data exports rely on, and go only with the account itself.
+var retentionScopes = map[domain.RetentionEntity]string{
+	domain.RetentionEntityNotifications: `
+		FROM notifications
+		WHERE status <> 'pending' AND created_at < $1`,
+	domain.RetentionEntityAuditLog: `
+		FROM audit_log
+		WHERE created_at < $1`,
+	domain.RetentionEntityPastRSVPs: `
+		FROM event_rsvp
+		WHERE status <> 'going' AND checked_in_at IS NULL
+			AND event_id IN (SELECT id FROM events WHERE end_at < $1)`,
+	domain.RetentionEntityInactiveAccounts: `
+		FROM users u
+		WHERE u.anonymized_at IS NULL
+			AND u.platform_role = 'user'
+			AND COALESCE(u.last_login, u.created_at) < $1
+			AND NOT EXISTS (
+				SELECT 1 FROM account_deletions d
+				WHERE d.user_id = u.id AND d.status = 'scheduled'
+			)`,
+	domain.RetentionEntityExportArchives: `
+		FROM data_exports
+		WHERE status IN ('ready', 'failed') AND COALESCE(expires_at, completed_at, created_at) < $1`,
+}
+
+// CountExpired counts the records of an entity that fall outside the retention period
+func (r *retentionRepository) CountExpired(ctx context.Context, entity domain.RetentionEntity, cutoff time.Time) (int, error) {
+	scope, ok := retentionScopes[entity]
+	if !ok {
+		return 0, domain.ErrInvalidRetentionEntity
+	}
+
+	var count int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) `+scope, cutoff).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count expired %s: %w", entity, err)
+	}
+
+	return count, nil
+}
+
+// DeleteExpired deletes expired records of entities that are removed outright. Accounts and
+// export archives need more than a row deletion and have their own methods.
+func (r *retentionRepository) DeleteExpired(ctx context.Context, entity domain.RetentionEntity, cutoff time.Time) (int, error) {
+	switch entity {
+	case domain.RetentionEntityNotifications, domain.RetentionEntityAuditLog, domain.RetentionEntityPastRSVPs:
+	default:
+		return 0, domain.ErrInvalidRetentionEntity
+	}
+
+	result, err := r.db.Exec(ctx, `DELETE `+retentionScopes[entity], cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired %s: %w", entity, err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// ListInactiveUsers returns accounts with no activity since the cutoff, least recently active first
+func (r *retentionRepository) ListInactiveUsers(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	query := `
+		SELECT u.id ` + retentionScopes[domain.RetentionEntityInactiveAccounts] + `
+		ORDER BY COALESCE(u.last_login, u.created_at) ASC
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inactive users: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan inactive user: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list inactive users: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// ListExpiredDataExports returns finished exports whose download window closed before the cutoff, oldest first
+func (r *retentionRepository) ListExpiredDataExports(ctx context.Context, cutoff time.Time, limit int) ([]*domain.DataExport, error) {
+	query := `
+		SELECT ` + dataExportColumns + retentionScopes[domain.RetentionEntityExportArchives] + `
+		ORDER BY created_at ASC
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired data exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*domain.DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list expired data exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// DeleteDataExports deletes export records by ID
+func (r *retentionRepository) DeleteDataExports(ctx context.Context, ids []uuid.UUID) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result, err := r.db.Exec(ctx, `DELETE FROM data_exports WHERE id = ANY($1)`, ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete data exports: %w", err)
+	}
+
+	return int(result.RowsAffected()), nil
+}
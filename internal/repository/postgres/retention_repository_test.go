@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionRepository_DeleteExpired(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewRetentionRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	other := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+	cutoff := now.Add(-30 * 24 * time.Hour)
+	old := cutoff.Add(-time.Hour)
+
+	// Sent and failed notifications expire, pending ones are kept for delivery
+	for _, notification := range []struct {
+		status    string
+		createdAt time.Time
+	}{
+		{"sent", old},
+		{"failed", old},
+		{"pending", old},
+		{"sent", now},
+	} {
+		_, err := db.Exec(ctx, `INSERT INTO notifications (user_id, type, status, created_at) VALUES ($1, 'event_reminder', $2, $3)`,
+			user.ID, notification.status, notification.createdAt)
+		require.NoError(t, err)
+	}
+
+	for _, createdAt := range []time.Time{old, now} {
+		_, err := db.Exec(ctx, `INSERT INTO audit_log (actor_id, action, target_type, target_id, created_at) VALUES ($1, 'login', 'user', $1, $2)`,
+			user.ID, createdAt)
+		require.NoError(t, err)
+	}
+
+	newEvent := func(startAt time.Time) *domain.Event {
+		event := &domain.Event{
+			ID:         uuid.New(),
+			HostUserID: user.ID,
+			Title:      "Modern League",
+			Game:       domain.GameTypeMTG,
+			Visibility: domain.EventVisibilityPublic,
+			StartAt:    startAt,
+			EndAt:      startAt.Add(3 * time.Hour),
+			Timezone:   "UTC",
+			Language:   "en",
+			Rules:      map[string]interface{}{},
+			Tags:       []string{},
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		require.NoError(t, eventRepo.Create(ctx, event))
+		require.NoError(t, eventRepo.CreateRSVP(ctx, &domain.EventRSVP{
+			EventID: event.ID, UserID: user.ID, Status: domain.RSVPStatusGoing, CreatedAt: now, UpdatedAt: now,
+		}))
+		require.NoError(t, eventRepo.CreateRSVP(ctx, &domain.EventRSVP{
+			EventID: event.ID, UserID: other.ID, Status: domain.RSVPStatusDeclined, CreatedAt: now, UpdatedAt: now,
+		}))
+		return event
+	}
+	longPast := newEvent(old.Add(-24 * time.Hour))
+	recent := newEvent(now.Add(-24 * time.Hour))
+
+	expected := map[domain.RetentionEntity]int{
+		domain.RetentionEntityNotifications: 2,
+		domain.RetentionEntityAuditLog:      1,
+		domain.RetentionEntityPastRSVPs:     1,
+	}
+
+	for entity, want := range expected {
+		count, err := repo.CountExpired(ctx, entity, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, want, count, "count %s", entity)
+
+		deleted, err := repo.DeleteExpired(ctx, entity, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, want, deleted, "delete %s", entity)
+
+		count, err = repo.CountExpired(ctx, entity, cutoff)
+		require.NoError(t, err)
+		assert.Zero(t, count, "count %s after delete", entity)
+	}
+
+	// Attendance of past events is kept, only the declined reply goes
+	rsvps, err := eventRepo.GetEventRSVPs(ctx, longPast.ID)
+	require.NoError(t, err)
+	require.Len(t, rsvps, 1)
+	assert.Equal(t, user.ID, rsvps[0].UserID)
+
+	rsvps, err = eventRepo.GetEventRSVPs(ctx, recent.ID)
+	require.NoError(t, err)
+	assert.Len(t, rsvps, 2)
+
+	// Accounts and export archives are never deleted row by row
+	_, err = repo.DeleteExpired(ctx, domain.RetentionEntityInactiveAccounts, cutoff)
+	assert.Equal(t, domain.ErrInvalidRetentionEntity, err)
+}
+
+func TestRetentionRepository_ListInactiveUsers(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewRetentionRepository(db)
+	deletionRepo := NewAccountDeletionRepository(db)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Microsecond)
+	cutoff := now.Add(-365 * 24 * time.Hour)
+
+	dormant := createTestUser(t, db)
+	neverLoggedIn := createTestUser(t, db)
+	active := createTestUser(t, db)
+	admin := createTestUser(t, db)
+	scheduled := createTestUser(t, db)
+
+	_, err := db.Exec(ctx, `UPDATE users SET last_login = $2 WHERE id = ANY($1)`,
+		[]uuid.UUID{dormant.ID, admin.ID, scheduled.ID}, cutoff.Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE users SET created_at = $2 WHERE id = $1`, neverLoggedIn.ID, cutoff.Add(-2*time.Hour))
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE users SET last_login = $2, created_at = $3 WHERE id = $1`, active.ID, now, cutoff.Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE users SET platform_role = 'admin' WHERE id = $1`, admin.ID)
+	require.NoError(t, err)
+	require.NoError(t, deletionRepo.Create(ctx, domain.NewAccountDeletion(scheduled.ID, now, time.Hour)))
+
+	count, err := repo.CountExpired(ctx, domain.RetentionEntityInactiveAccounts, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	userIDs, err := repo.ListInactiveUsers(ctx, cutoff, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{neverLoggedIn.ID, dormant.ID}, userIDs)
+
+	userIDs, err = repo.ListInactiveUsers(ctx, cutoff, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{neverLoggedIn.ID}, userIDs)
+}
+
+func TestRetentionRepository_ExpiredDataExports(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewRetentionRepository(db)
+	exportRepo := NewDataExportRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	now := time.Now().Truncate(time.Microsecond)
+	cutoff := now.Add(-7 * 24 * time.Hour)
+
+	expired := domain.NewDataExport(user.ID, cutoff.Add(-48*time.Hour))
+	expired.MarkReady("expired.zip", 10, cutoff.Add(-48*time.Hour), cutoff.Add(-time.Hour))
+	downloadable := domain.NewDataExport(user.ID, now)
+	downloadable.MarkReady("downloadable.zip", 10, now, now.Add(time.Hour))
+	pending := domain.NewDataExport(user.ID, cutoff.Add(-48*time.Hour))
+
+	for _, export := range []*domain.DataExport{expired, downloadable, pending} {
+		require.NoError(t, exportRepo.Create(ctx, export))
+	}
+
+	count, err := repo.CountExpired(ctx, domain.RetentionEntityExportArchives, cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	exports, err := repo.ListExpiredDataExports(ctx, cutoff, 10)
+	require.NoError(t, err)
+	require.Len(t, exports, 1)
+	assert.Equal(t, expired.ID, exports[0].ID)
+	assert.Equal(t, "expired.zip", *exports[0].StorageKey)
+
+	deleted, err := repo.DeleteDataExports(ctx, []uuid.UUID{expired.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	stored, err := exportRepo.GetByID(ctx, expired.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+
+	stored, err = exportRepo.GetByID(ctx, downloadable.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored)
+}
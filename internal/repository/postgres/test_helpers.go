@@ -45,12 +45,33 @@ func cleanupTestDB(t *testing.T, db *pgxpool.Pool) {
 
 	// Clean up test data in reverse order of dependencies
 	tables := []string{
+		"audit_log",
+		"account_deletions",
+		"consents",
+		"data_exports",
+		"admin_actions",
+		"content_moderation_actions",
+		"reports",
 		"notifications",
+		"group_moderation_actions",
+		"group_bans",
+		"group_announcements",
+		"league_results",
+		"league_events",
+		"group_leagues",
+		"event_guests",
+		"event_invite_links",
+		"event_cohosts",
 		"event_rsvp",
 		"events",
 		"venues",
+		"group_join_requests",
+		"group_invites",
+		"group_webhooks",
 		"group_members",
+		"group_custom_roles",
 		"groups",
+		"user_blocks",
 		"profiles",
 		"users",
 	}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type userBlockRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewUserBlockRepository creates a new PostgreSQL user block repository
+func NewUserBlockRepository(db *pgxpool.Pool) repository.UserBlockRepository {
+	return &userBlockRepository{db: db}
+}
+
+// Create records a block; blocking an already blocked user is a no-op
+func (r *userBlockRepository) Create(ctx context.Context, block *domain.UserBlock) error {
+	query := `
+		INSERT INTO user_blocks (blocker_id, blocked_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING`
+
+	_, err := r.db.Exec(ctx, query, block.BlockerID, block.BlockedID, block.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create user block: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a block
+func (r *userBlockRepository) Delete(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM user_blocks WHERE blocker_id = $1 AND blocked_id = $2`, blockerID, blockedID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user block: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("user block not found")
+	}
+
+	return nil
+}
+
+// IsBlocked checks whether blockerID has blocked blockedID
+func (r *userBlockRepository) IsBlocked(ctx context.Context, blockerID, blockedID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM user_blocks WHERE blocker_id = $1 AND blocked_id = $2)`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, blockerID, blockedID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check user block: %w", err)
+	}
+
+	return exists, nil
+}
+
+// IsBlockedEitherWay checks whether either user has blocked the other
+func (r *userBlockRepository) IsBlockedEitherWay(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM user_blocks
+			WHERE (blocker_id = $1 AND blocked_id = $2) OR (blocker_id = $2 AND blocked_id = $1)
+		)`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, userID, otherUserID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check user block: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetBlockedByUser retrieves the blocks a user has made, most recent first
+func (r *userBlockRepository) GetBlockedByUser(ctx context.Context, blockerID uuid.UUID) ([]*domain.UserBlock, error) {
+	query := `
+		SELECT blocker_id, blocked_id, created_at
+		FROM user_blocks
+		WHERE blocker_id = $1
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.Query(ctx, query, blockerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user blocks: %w", err)
+	}
+	defer rows.Close()
+
+	var blocks []*domain.UserBlock
+	for rows.Next() {
+		var block domain.UserBlock
+		if err := rows.Scan(&block.BlockerID, &block.BlockedID, &block.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan user block: %w", err)
+		}
+		blocks = append(blocks, &block)
+	}
+
+	return blocks, nil
+}
+
+// GetBlockedUserIDs retrieves the users a user has blocked or been blocked by
+func (r *userBlockRepository) GetBlockedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	query := `
+		SELECT blocked_id FROM user_blocks WHERE blocker_id = $1
+		UNION
+		SELECT blocker_id FROM user_blocks WHERE blocked_id = $1`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocked user IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan blocked user ID: %w", err)
+		}
+		userIDs = append(userIDs, id)
+	}
+
+	return userIDs, nil
+}
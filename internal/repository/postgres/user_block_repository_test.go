@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserBlockRepository_BlockAndUnblock(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewUserBlockRepository(db)
+	ctx := context.Background()
+
+	blocker := createTestUser(t, db)
+	blocked := createTestUser(t, db)
+	bystander := createTestUser(t, db)
+
+	block := &domain.UserBlock{
+		BlockerID: blocker.ID,
+		BlockedID: blocked.ID,
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, repo.Create(ctx, block))
+
+	// Blocking twice is idempotent
+	require.NoError(t, repo.Create(ctx, block))
+
+	isBlocked, err := repo.IsBlocked(ctx, blocker.ID, blocked.ID)
+	require.NoError(t, err)
+	assert.True(t, isBlocked)
+
+	// Blocks are directional...
+	isBlocked, err = repo.IsBlocked(ctx, blocked.ID, blocker.ID)
+	require.NoError(t, err)
+	assert.False(t, isBlocked)
+
+	// ...but hide the users from each other
+	isBlocked, err = repo.IsBlockedEitherWay(ctx, blocked.ID, blocker.ID)
+	require.NoError(t, err)
+	assert.True(t, isBlocked)
+
+	isBlocked, err = repo.IsBlockedEitherWay(ctx, blocker.ID, bystander.ID)
+	require.NoError(t, err)
+	assert.False(t, isBlocked)
+
+	blocks, err := repo.GetBlockedByUser(ctx, blocker.ID)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	assert.Equal(t, blocked.ID, blocks[0].BlockedID)
+
+	ids, err := repo.GetBlockedUserIDs(ctx, blocked.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{blocker.ID}, ids)
+
+	require.NoError(t, repo.Delete(ctx, blocker.ID, blocked.ID))
+	assert.Error(t, repo.Delete(ctx, blocker.ID, blocked.ID))
+
+	isBlocked, err = repo.IsBlockedEitherWay(ctx, blocker.ID, blocked.ID)
+	require.NoError(t, err)
+	assert.False(t, isBlocked)
+}
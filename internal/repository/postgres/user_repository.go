@@ -23,7 +23,7 @@ func NewUserRepository(db *pgxpool.Pool) repository.UserRepository {
 	return &userRepository{db: db}
 }
 
-// Create creates a new user
+// Create creates a new user. New users always start with the user platform role.
 func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
 		INSERT INTO users (id, email, password_hash, created_at, updated_at, is_active, last_login)
@@ -49,7 +49,7 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 // GetByID retrieves a user by ID
 func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, created_at, updated_at, is_active, last_login
+		SELECT id, email, password_hash, created_at, updated_at, is_active, last_login, platform_role
 		FROM users
 		WHERE id = $1`
 
@@ -62,6 +62,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Use
 		&user.UpdatedAt,
 		&user.IsActive,
 		&user.LastLogin,
+		&user.PlatformRole,
 	)
 
 	if err != nil {
@@ -77,7 +78,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Use
 // GetByEmail retrieves a user by email
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	query := `
-		SELECT id, email, password_hash, created_at, updated_at, is_active, last_login
+		SELECT id, email, password_hash, created_at, updated_at, is_active, last_login, platform_role
 		FROM users
 		WHERE email = $1`
 
@@ -90,6 +91,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 		&user.UpdatedAt,
 		&user.IsActive,
 		&user.LastLogin,
+		&user.PlatformRole,
 	)
 
 	if err != nil {
@@ -350,7 +352,7 @@ func (r *userRepository) ExportUserData(ctx context.Context, userID uuid.UUID) (
 
 	// Get event RSVPs
 	var rsvps []domain.EventRSVP
-	rsvpQuery := `SELECT event_id, user_id, status, created_at, updated_at FROM event_rsvp WHERE user_id = $1`
+	rsvpQuery := `SELECT event_id, user_id, status, checked_in_at, created_at, updated_at FROM event_rsvp WHERE user_id = $1`
 	rows, err = tx.Query(ctx, rsvpQuery, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get RSVPs: %w", err)
@@ -359,7 +361,7 @@ func (r *userRepository) ExportUserData(ctx context.Context, userID uuid.UUID) (
 
 	for rows.Next() {
 		var rsvp domain.EventRSVP
-		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
+		if err := rows.Scan(&rsvp.EventID, &rsvp.UserID, &rsvp.Status, &rsvp.CheckedInAt, &rsvp.CreatedAt, &rsvp.UpdatedAt); err != nil {
 			return nil, fmt.Errorf("failed to scan RSVP: %w", err)
 		}
 		rsvps = append(rsvps, rsvp)
@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type venueClaimRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewVenueClaimRepository creates a new PostgreSQL venue claim repository
+func NewVenueClaimRepository(db *pgxpool.Pool) repository.VenueClaimRepository {
+	return &venueClaimRepository{db: db}
+}
+
+const venueClaimColumns = `id, venue_id, claimant_id, evidence, status, reviewed_by, reviewed_at, review_note,
+	created_at, updated_at`
+
+// CreateClaim creates a new venue claim
+func (r *venueClaimRepository) CreateClaim(ctx context.Context, claim *domain.VenueClaim) error {
+	query := `
+		INSERT INTO venue_claims (` + venueClaimColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	_, err := r.db.Exec(ctx, query,
+		claim.ID,
+		claim.VenueID,
+		claim.ClaimantID,
+		claim.Evidence,
+		claim.Status,
+		claim.ReviewedBy,
+		claim.ReviewedAt,
+		claim.ReviewNote,
+		claim.CreatedAt,
+		claim.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create venue claim: %w", err)
+	}
+
+	return nil
+}
+
+// GetClaim retrieves a venue claim by ID
+func (r *venueClaimRepository) GetClaim(ctx context.Context, id uuid.UUID) (*domain.VenueClaim, error) {
+	query := `SELECT ` + venueClaimColumns + ` FROM venue_claims WHERE id = $1`
+
+	claim, err := scanVenueClaim(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get venue claim: %w", err)
+	}
+
+	return claim, nil
+}
+
+// HasPendingClaim checks whether a user already has a pending claim on a venue
+func (r *venueClaimRepository) HasPendingClaim(ctx context.Context, venueID, claimantID uuid.UUID) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM venue_claims
+			WHERE venue_id = $1 AND claimant_id = $2 AND status = 'pending'
+		)`
+
+	var exists bool
+	if err := r.db.QueryRow(ctx, query, venueID, claimantID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check pending venue claim: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetClaims retrieves venue claims in a given status, oldest first
+func (r *venueClaimRepository) GetClaims(ctx context.Context, status domain.VenueClaimStatus, limit, offset int) ([]*domain.VenueClaim, error) {
+	query := `
+		SELECT ` + venueClaimColumns + `
+		FROM venue_claims
+		WHERE status = $1
+		ORDER BY created_at ASC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue claims: %w", err)
+	}
+	defer rows.Close()
+
+	var claims []*domain.VenueClaim
+	for rows.Next() {
+		claim, err := scanVenueClaim(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan venue claim: %w", err)
+		}
+		claims = append(claims, claim)
+	}
+
+	return claims, nil
+}
+
+// ReviewClaim saves a claim decision and transfers the venue to the claimant when it is approved
+func (r *venueClaimRepository) ReviewClaim(ctx context.Context, claim *domain.VenueClaim) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE venue_claims
+		SET status = $2, reviewed_by = $3, reviewed_at = $4, review_note = $5, updated_at = $6
+		WHERE id = $1 AND status = 'pending'`
+
+	result, err := tx.Exec(ctx, query,
+		claim.ID,
+		claim.Status,
+		claim.ReviewedBy,
+		claim.ReviewedAt,
+		claim.ReviewNote,
+		claim.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to review venue claim: %w", err)
+	}
+
+	// Another admin reviewed the claim after it was loaded
+	if result.RowsAffected() == 0 {
+		return domain.ErrVenueClaimAlreadyReviewed
+	}
+
+	if claim.Status == domain.VenueClaimStatusApproved {
+		_, err = tx.Exec(ctx, `UPDATE venues SET owner_id = $2, verified_at = $3 WHERE id = $1`,
+			claim.VenueID, claim.ClaimantID, claim.ReviewedAt)
+		if err != nil {
+			return fmt.Errorf("failed to set venue owner: %w", err)
+		}
+
+		// Competing claims on the same venue are settled by the approval
+		query = `
+			UPDATE venue_claims
+			SET status = 'rejected', reviewed_by = $2, reviewed_at = $3, updated_at = $3
+			WHERE venue_id = $1 AND status = 'pending'`
+
+		_, err = tx.Exec(ctx, query, claim.VenueID, claim.ReviewedBy, claim.ReviewedAt)
+		if err != nil {
+			return fmt.Errorf("failed to reject competing venue claims: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetEventOfficial marks an event as official or clears the mark
+func (r *venueClaimRepository) SetEventOfficial(ctx context.Context, eventID uuid.UUID, official bool) error {
+	result, err := r.db.Exec(ctx, `UPDATE events SET is_official = $2 WHERE id = $1`, eventID, official)
+	if err != nil {
+		return fmt.Errorf("failed to set event official: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("event not found")
+	}
+
+	return nil
+}
+
+// scanVenueClaim scans a venue claim row
+func scanVenueClaim(row pgx.Row) (*domain.VenueClaim, error) {
+	var claim domain.VenueClaim
+
+	err := row.Scan(
+		&claim.ID,
+		&claim.VenueID,
+		&claim.ClaimantID,
+		&claim.Evidence,
+		&claim.Status,
+		&claim.ReviewedBy,
+		&claim.ReviewedAt,
+		&claim.ReviewNote,
+		&claim.CreatedAt,
+		&claim.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &claim, nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVenueClaimRepository_ClaimWorkflow(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewVenueClaimRepository(db)
+	venueRepo := NewVenueRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+
+	creator := createTestUser(t, db)
+	owner := createTestUser(t, db)
+	competitor := createTestUser(t, db)
+	admin := createTestUser(t, db)
+	venue := createTestVenue(t, db, &creator.ID)
+
+	now := time.Now().Truncate(time.Microsecond)
+	claim := domain.NewVenueClaim(venue.ID, owner.ID, "Business registration 12345", now)
+	require.NoError(t, repo.CreateClaim(ctx, claim))
+
+	// A claimant has at most one pending claim per venue
+	assert.Error(t, repo.CreateClaim(ctx, domain.NewVenueClaim(venue.ID, owner.ID, "Again", now)))
+
+	competing := domain.NewVenueClaim(venue.ID, competitor.ID, "I work there", now.Add(time.Second))
+	require.NoError(t, repo.CreateClaim(ctx, competing))
+
+	pending, err := repo.HasPendingClaim(ctx, venue.ID, owner.ID)
+	require.NoError(t, err)
+	assert.True(t, pending)
+
+	queue, err := repo.GetClaims(ctx, domain.VenueClaimStatusPending, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, queue, 2)
+	assert.Equal(t, claim.ID, queue[0].ID, "oldest claim first")
+
+	require.NoError(t, claim.Review(domain.VenueClaimStatusApproved, admin.ID, nil, now))
+	require.NoError(t, repo.ReviewClaim(ctx, claim))
+
+	// Reviewing again fails because the claim is no longer pending
+	assert.ErrorIs(t, repo.ReviewClaim(ctx, claim), domain.ErrVenueClaimAlreadyReviewed)
+
+	stored, err := venueRepo.GetByID(ctx, venue.ID)
+	require.NoError(t, err)
+	require.True(t, stored.IsVerified())
+	assert.Equal(t, owner.ID, *stored.OwnerID)
+
+	rejected, err := repo.GetClaim(ctx, competing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.VenueClaimStatusRejected, rejected.Status)
+
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: owner.ID,
+		VenueID:    &venue.ID,
+		Title:      "Store Championship",
+		Game:       domain.GameTypeMTG,
+		Visibility: domain.EventVisibilityPublic,
+		StartAt:    now.Add(time.Hour),
+		EndAt:      now.Add(4 * time.Hour),
+		Timezone:   "UTC",
+		Language:   "en",
+		Rules:      map[string]interface{}{},
+		Tags:       []string{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	require.NoError(t, eventRepo.Create(ctx, event))
+	require.NoError(t, repo.SetEventOfficial(ctx, event.ID, true))
+
+	storedEvent, err := eventRepo.GetByIDWithDetails(ctx, event.ID)
+	require.NoError(t, err)
+	assert.True(t, storedEvent.IsOfficial)
+	require.NotNil(t, storedEvent.Venue)
+	assert.True(t, storedEvent.Venue.IsOwnedBy(owner.ID))
+
+	assert.Error(t, repo.SetEventOfficial(ctx, uuid.New(), true))
+}
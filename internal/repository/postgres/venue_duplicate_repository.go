@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// NewVenueDuplicateRepository creates a new PostgreSQL venue duplicate repository
+func NewVenueDuplicateRepository(db *pgxpool.Pool) repository.VenueDuplicateRepository {
+	return &venueRepository{db: db}
+}
+
+// FindSimilarVenues finds venues near the given venue or in its city with a similar name or address.
+// The thresholds are deliberately loose; callers rank the results with domain.FindVenueDuplicates.
+func (r *venueRepository) FindSimilarVenues(ctx context.Context, venue *domain.Venue, radiusKm float64, limit int) ([]*domain.Venue, error) {
+	query := `
+		SELECT ` + venueColumns + `
+		FROM venues
+		WHERE id <> $1
+		  AND (
+			(ST_DWithin(coordinates, ST_Point($2, $3)::geography, $4) AND similarity(name, $5) >= $6)
+			OR (LOWER(city) = LOWER($7) AND (similarity(name, $5) >= $6 OR similarity(address, $8) >= 0.5))
+		  )
+		ORDER BY similarity(name, $5) DESC, created_at ASC
+		LIMIT $9`
+
+	rows, err := r.db.Query(ctx, query,
+		venue.ID,
+		venue.Longitude,
+		venue.Latitude,
+		radiusKm*1000, // Convert km to meters
+		venue.Name,
+		domain.VenueDuplicateAddressNameSimilarity,
+		venue.City,
+		venue.Address,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar venues: %w", err)
+	}
+	defer rows.Close()
+
+	var venues []*domain.Venue
+	for rows.Next() {
+		similar, err := r.scanVenue(rows)
+		if err != nil {
+			return nil, err
+		}
+		venues = append(venues, similar)
+	}
+
+	return venues, nil
+}
+
+// MergeVenues re-points the source venue's events and redirects to the target and deletes the source
+func (r *venueRepository) MergeVenues(ctx context.Context, redirect *domain.VenueRedirect) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `UPDATE events SET venue_id = $2 WHERE venue_id = $1`, redirect.FromVenueID, redirect.ToVenueID)
+	if err != nil {
+		return fmt.Errorf("failed to move venue events: %w", err)
+	}
+
+	// Reviews follow the events, except from players who already reviewed the target
+	query := `
+		UPDATE venue_reviews
+		SET venue_id = $2
+		WHERE venue_id = $1
+		  AND user_id NOT IN (SELECT user_id FROM venue_reviews WHERE venue_id = $2)`
+
+	if _, err := tx.Exec(ctx, query, redirect.FromVenueID, redirect.ToVenueID); err != nil {
+		return fmt.Errorf("failed to move venue reviews: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, refreshVenueRatingQuery, redirect.ToVenueID); err != nil {
+		return fmt.Errorf("failed to refresh venue rating: %w", err)
+	}
+
+	// Venues merged into the source earlier now resolve straight to the target
+	_, err = tx.Exec(ctx, `UPDATE venue_redirects SET to_venue_id = $2 WHERE to_venue_id = $1`, redirect.FromVenueID, redirect.ToVenueID)
+	if err != nil {
+		return fmt.Errorf("failed to update venue redirects: %w", err)
+	}
+
+	query = `
+		INSERT INTO venue_redirects (from_venue_id, to_venue_id, merged_by, merged_at)
+		VALUES ($1, $2, $3, $4)`
+
+	_, err = tx.Exec(ctx, query, redirect.FromVenueID, redirect.ToVenueID, redirect.MergedBy, redirect.MergedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create venue redirect: %w", err)
+	}
+
+	result, err := tx.Exec(ctx, `DELETE FROM venues WHERE id = $1`, redirect.FromVenueID)
+	if err != nil {
+		return fmt.Errorf("failed to delete merged venue: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("venue not found")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetRedirect retrieves the redirect left by a merged venue
+func (r *venueRepository) GetRedirect(ctx context.Context, fromVenueID uuid.UUID) (*domain.VenueRedirect, error) {
+	query := `
+		SELECT from_venue_id, to_venue_id, merged_by, merged_at
+		FROM venue_redirects
+		WHERE from_venue_id = $1`
+
+	var redirect domain.VenueRedirect
+	err := r.db.QueryRow(ctx, query, fromVenueID).Scan(
+		&redirect.FromVenueID,
+		&redirect.ToVenueID,
+		&redirect.MergedBy,
+		&redirect.MergedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get venue redirect: %w", err)
+	}
+
+	return &redirect, nil
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVenueDuplicateRepository_FindAndMerge(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewVenueDuplicateRepository(db)
+	venueRepo := NewVenueRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+	admin := createTestUser(t, db)
+
+	newVenue := func(name, address string, lat, lon float64) *domain.Venue {
+		venue := &domain.Venue{
+			ID:        uuid.New(),
+			Name:      name,
+			Type:      domain.VenueTypeStore,
+			Address:   address,
+			City:      "Porto",
+			Country:   "PT",
+			Latitude:  lat,
+			Longitude: lon,
+			Metadata:  map[string]interface{}{},
+			CreatedBy: &user.ID,
+			CreatedAt: time.Now(),
+		}
+		require.NoError(t, venueRepo.Create(ctx, venue))
+		return venue
+	}
+
+	original := newVenue("Arena Porto", "Rua de Santa Catarina 120", 41.1466, -8.6060)
+	duplicate := newVenue("Arena Porto TCG", "R. de Santa Catarina, 120", 41.1467, -8.6061)
+	older := newVenue("arena porto", "Rua de Santa Catarina 120", 41.1468, -8.6060)
+	newVenue("Dragon's Den", "Rua de Passos Manuel 8", 41.1467, -8.6061)
+
+	similar, err := repo.FindSimilarVenues(ctx, original, domain.VenueDuplicateRadiusKm, 10)
+	require.NoError(t, err)
+	require.Len(t, similar, 2)
+	assert.Equal(t, older.ID, similar[0].ID, "most similar name first")
+
+	// An earlier merge into the duplicate follows it to the original
+	require.NoError(t, repo.MergeVenues(ctx, domain.NewVenueRedirect(older.ID, duplicate.ID, admin.ID, time.Now())))
+
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: user.ID,
+		VenueID:    &duplicate.ID,
+		Title:      "Friday Night Magic",
+		Game:       domain.GameTypeMTG,
+		Visibility: domain.EventVisibilityPublic,
+		StartAt:    time.Now().Add(time.Hour),
+		EndAt:      time.Now().Add(4 * time.Hour),
+		Timezone:   "UTC",
+		Language:   "en",
+		Rules:      map[string]interface{}{},
+		Tags:       []string{},
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	require.NoError(t, eventRepo.Create(ctx, event))
+
+	require.NoError(t, repo.MergeVenues(ctx, domain.NewVenueRedirect(duplicate.ID, original.ID, admin.ID, time.Now())))
+
+	storedEvent, err := eventRepo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, original.ID, *storedEvent.VenueID)
+
+	merged, err := venueRepo.GetByID(ctx, duplicate.ID)
+	require.NoError(t, err)
+	assert.Nil(t, merged)
+
+	for _, venueID := range []uuid.UUID{duplicate.ID, older.ID} {
+		redirect, err := repo.GetRedirect(ctx, venueID)
+		require.NoError(t, err)
+		require.NotNil(t, redirect)
+		assert.Equal(t, original.ID, redirect.ToVenueID)
+	}
+
+	redirect, err := repo.GetRedirect(ctx, original.ID)
+	require.NoError(t, err)
+	assert.Nil(t, redirect)
+
+	// The source venue is gone, so merging it again fails
+	assert.Error(t, repo.MergeVenues(ctx, domain.NewVenueRedirect(duplicate.ID, original.ID, admin.ID, time.Now())))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -22,6 +23,13 @@ func NewVenueRepository(db *pgxpool.Pool) repository.VenueRepository {
 	return &venueRepository{db: db}
 }
 
+const venueColumns = `id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at, timezone, opening_hours,
+	owner_id, verified_at, rating_average, rating_count, amenities`
+
+// venueWeightedRating ranks venues by domain.Venue.WeightedRating
+var venueWeightedRating = fmt.Sprintf("(rating_average * rating_count + %v) / (rating_count + %d)",
+	domain.VenueRatingPriorMean*domain.VenueRatingPriorCount, domain.VenueRatingPriorCount)
+
 // Create creates a new venue
 func (r *venueRepository) Create(ctx context.Context, venue *domain.Venue) error {
 	metadataJSON, err := json.Marshal(venue.Metadata)
@@ -29,9 +37,19 @@ func (r *venueRepository) Create(ctx context.Context, venue *domain.Venue) error
 		return fmt.Errorf("failed to marshal metadata: %w", err)
 	}
 
+	openingHoursJSON, err := marshalOpeningHours(venue.OpeningHours)
+	if err != nil {
+		return err
+	}
+
+	amenitiesJSON, err := marshalVenueAmenities(venue.Amenities)
+	if err != nil {
+		return err
+	}
+
 	query := `
-		INSERT INTO venues (id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+		INSERT INTO venues (` + venueColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)`
 
 	_, err = r.db.Exec(ctx, query,
 		venue.ID,
@@ -45,6 +63,13 @@ func (r *venueRepository) Create(ctx context.Context, venue *domain.Venue) error
 		metadataJSON,
 		venue.CreatedBy,
 		venue.CreatedAt,
+		venue.Timezone,
+		openingHoursJSON,
+		venue.OwnerID,
+		venue.VerifiedAt,
+		venue.RatingAverage,
+		venue.RatingCount,
+		amenitiesJSON,
 	)
 
 	if err != nil {
@@ -57,7 +82,7 @@ func (r *venueRepository) Create(ctx context.Context, venue *domain.Venue) error
 // GetByID retrieves a venue by ID
 func (r *venueRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE id = $1`
 
@@ -71,10 +96,20 @@ func (r *venueRepository) Update(ctx context.Context, venue *domain.Venue) error
 		return fmt.Errorf("failed to marshal metadata: %w", err)
 	}
 
+	openingHoursJSON, err := marshalOpeningHours(venue.OpeningHours)
+	if err != nil {
+		return err
+	}
+
+	amenitiesJSON, err := marshalVenueAmenities(venue.Amenities)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE venues
 		SET name = $2, type = $3, address = $4, city = $5, country = $6,
-			latitude = $7, longitude = $8, metadata = $9
+			latitude = $7, longitude = $8, metadata = $9, timezone = $10, opening_hours = $11, amenities = $12
 		WHERE id = $1`
 
 	result, err := r.db.Exec(ctx, query,
@@ -87,6 +122,9 @@ func (r *venueRepository) Update(ctx context.Context, venue *domain.Venue) error
 		venue.Latitude,
 		venue.Longitude,
 		metadataJSON,
+		venue.Timezone,
+		openingHoursJSON,
+		amenitiesJSON,
 	)
 
 	if err != nil {
@@ -119,7 +157,7 @@ func (r *venueRepository) Delete(ctx context.Context, id uuid.UUID) error {
 // SearchNearby searches for venues near a location using PostGIS ST_DWithin
 func (r *venueRepository) SearchNearby(ctx context.Context, lat, lon float64, radiusKm int, limit, offset int) ([]*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE ST_DWithin(coordinates, ST_Point($1, $2)::geography, $3)
 		ORDER BY ST_Distance(coordinates, ST_Point($1, $2)::geography)
@@ -146,7 +184,7 @@ func (r *venueRepository) SearchNearby(ctx context.Context, lat, lon float64, ra
 // SearchByCity searches for venues in a specific city
 func (r *venueRepository) SearchByCity(ctx context.Context, city string, limit, offset int) ([]*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE LOWER(city) = LOWER($1)
 		ORDER BY name
@@ -173,7 +211,7 @@ func (r *venueRepository) SearchByCity(ctx context.Context, city string, limit,
 // SearchByCountry searches for venues in a specific country
 func (r *venueRepository) SearchByCountry(ctx context.Context, country string, limit, offset int) ([]*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE LOWER(country) = LOWER($1)
 		ORDER BY city, name
@@ -200,7 +238,7 @@ func (r *venueRepository) SearchByCountry(ctx context.Context, country string, l
 // SearchByName searches for venues by name (case-insensitive partial match)
 func (r *venueRepository) SearchByName(ctx context.Context, name string, limit, offset int) ([]*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE LOWER(name) LIKE LOWER($1)
 		ORDER BY name
@@ -227,7 +265,7 @@ func (r *venueRepository) SearchByName(ctx context.Context, name string, limit,
 // GetByCreator retrieves venues created by a specific user
 func (r *venueRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID, limit, offset int) ([]*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE created_by = $1
 		ORDER BY created_at DESC
@@ -254,7 +292,7 @@ func (r *venueRepository) GetByCreator(ctx context.Context, creatorID uuid.UUID,
 // GetByType retrieves venues of a specific type
 func (r *venueRepository) GetByType(ctx context.Context, venueType domain.VenueType, limit, offset int) ([]*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE type = $1
 		ORDER BY name
@@ -278,14 +316,12 @@ func (r *venueRepository) GetByType(ctx context.Context, venueType domain.VenueT
 	return venues, nil
 }
 
-// GetPopularVenues retrieves popular venues (based on event count)
+// GetPopularVenues retrieves the best rated venues, ranked by their weighted rating
 func (r *venueRepository) GetPopularVenues(ctx context.Context, limit, offset int) ([]*domain.Venue, error) {
 	query := `
-		SELECT v.id, v.name, v.type, v.address, v.city, v.country, v.latitude, v.longitude, v.metadata, v.created_by, v.created_at
-		FROM venues v
-		LEFT JOIN events e ON v.id = e.venue_id
-		GROUP BY v.id, v.name, v.type, v.address, v.city, v.country, v.latitude, v.longitude, v.metadata, v.created_by, v.created_at
-		ORDER BY COUNT(e.id) DESC, v.name
+		SELECT ` + venueColumns + `
+		FROM venues
+		ORDER BY ` + venueWeightedRating + ` DESC, rating_count DESC, name
 		LIMIT $1 OFFSET $2`
 
 	rows, err := r.db.Query(ctx, query, limit, offset)
@@ -306,10 +342,122 @@ func (r *venueRepository) GetPopularVenues(ctx context.Context, limit, offset in
 	return venues, nil
 }
 
+// Search searches for venues matching every given filter, by name or best rated first
+func (r *venueRepository) Search(ctx context.Context, params domain.VenueSearchParams) ([]*domain.Venue, error) {
+	var conditions []string
+	var args []interface{}
+	argIndex := 1
+
+	query := `
+		SELECT ` + venueColumns + `
+		FROM venues`
+
+	if params.Near != nil && params.RadiusKm != nil {
+		conditions = append(conditions, fmt.Sprintf("ST_DWithin(coordinates, ST_Point($%d, $%d)::geography, $%d)", argIndex, argIndex+1, argIndex+2))
+		args = append(args, params.Near.Longitude, params.Near.Latitude, *params.RadiusKm*1000) // Convert km to meters
+		argIndex += 3
+	}
+
+	if params.Name != nil {
+		conditions = append(conditions, fmt.Sprintf("LOWER(name) LIKE LOWER($%d)", argIndex))
+		args = append(args, "%"+*params.Name+"%")
+		argIndex++
+	}
+
+	if params.City != nil {
+		conditions = append(conditions, fmt.Sprintf("LOWER(city) = LOWER($%d)", argIndex))
+		args = append(args, *params.City)
+		argIndex++
+	}
+
+	if params.Country != nil {
+		conditions = append(conditions, fmt.Sprintf("LOWER(country) = LOWER($%d)", argIndex))
+		args = append(args, *params.Country)
+		argIndex++
+	}
+
+	if params.Type != nil {
+		conditions = append(conditions, fmt.Sprintf("type = $%d", argIndex))
+		args = append(args, *params.Type)
+		argIndex++
+	}
+
+	if params.CreatedBy != nil {
+		conditions = append(conditions, fmt.Sprintf("created_by = $%d", argIndex))
+		args = append(args, *params.CreatedBy)
+		argIndex++
+	}
+
+	if params.Amenities.MinTables != nil {
+		conditions = append(conditions, fmt.Sprintf("(amenities->>'table_count')::int >= $%d", argIndex))
+		args = append(args, *params.Amenities.MinTables)
+		argIndex++
+	}
+
+	if params.Amenities.MinSeats != nil {
+		conditions = append(conditions, fmt.Sprintf("(amenities->>'seats')::int >= $%d", argIndex))
+		args = append(args, *params.Amenities.MinSeats)
+		argIndex++
+	}
+
+	// Required facilities are matched by JSON containment, e.g. {"wifi": true}
+	facilities := map[string]bool{}
+	if params.Amenities.PlaymatsProvided {
+		facilities["playmats_provided"] = true
+	}
+	if params.Amenities.WheelchairAccessible {
+		facilities["wheelchair_accessible"] = true
+	}
+	if params.Amenities.FoodAndDrinks {
+		facilities["food_and_drinks"] = true
+	}
+	if params.Amenities.WiFi {
+		facilities["wifi"] = true
+	}
+	if len(facilities) > 0 {
+		facilitiesJSON, err := json.Marshal(facilities)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal amenity filter: %w", err)
+		}
+		conditions = append(conditions, fmt.Sprintf("amenities @> $%d", argIndex))
+		args = append(args, facilitiesJSON)
+		argIndex++
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	if params.SortByRating {
+		query += " ORDER BY " + venueWeightedRating + " DESC, rating_count DESC, name"
+	} else {
+		query += " ORDER BY name"
+	}
+	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, params.Limit, params.Offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search venues: %w", err)
+	}
+	defer rows.Close()
+
+	var venues []*domain.Venue
+	for rows.Next() {
+		venue, err := r.scanVenue(rows)
+		if err != nil {
+			return nil, err
+		}
+		venues = append(venues, venue)
+	}
+
+	return venues, nil
+}
+
 // GetVenuesInBounds retrieves venues within geographic bounds
 func (r *venueRepository) GetVenuesInBounds(ctx context.Context, northLat, southLat, eastLon, westLon float64) ([]*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		WHERE latitude BETWEEN $1 AND $2
 		AND longitude BETWEEN $3 AND $4
@@ -336,7 +484,7 @@ func (r *venueRepository) GetVenuesInBounds(ctx context.Context, northLat, south
 // FindNearestVenue finds the nearest venue to a location using PostGIS KNN
 func (r *venueRepository) FindNearestVenue(ctx context.Context, lat, lon float64) (*domain.Venue, error) {
 	query := `
-		SELECT id, name, type, address, city, country, latitude, longitude, metadata, created_by, created_at
+		SELECT ` + venueColumns + `
 		FROM venues
 		ORDER BY coordinates <-> ST_Point($1, $2)::geography
 		LIMIT 1`
@@ -373,7 +521,7 @@ func (r *venueRepository) CountVenuesByCountry(ctx context.Context, country stri
 // Helper function to scan a venue from a row
 func (r *venueRepository) scanVenue(row pgx.Row) (*domain.Venue, error) {
 	var venue domain.Venue
-	var metadataJSON []byte
+	var metadataJSON, openingHoursJSON, amenitiesJSON []byte
 
 	err := row.Scan(
 		&venue.ID,
@@ -387,6 +535,13 @@ func (r *venueRepository) scanVenue(row pgx.Row) (*domain.Venue, error) {
 		&metadataJSON,
 		&venue.CreatedBy,
 		&venue.CreatedAt,
+		&venue.Timezone,
+		&openingHoursJSON,
+		&venue.OwnerID,
+		&venue.VerifiedAt,
+		&venue.RatingAverage,
+		&venue.RatingCount,
+		&amenitiesJSON,
 	)
 
 	if err != nil {
@@ -403,5 +558,45 @@ func (r *venueRepository) scanVenue(row pgx.Row) (*domain.Venue, error) {
 		}
 	}
 
+	if openingHoursJSON != nil {
+		if err := json.Unmarshal(openingHoursJSON, &venue.OpeningHours); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal opening hours: %w", err)
+		}
+	}
+
+	if amenitiesJSON != nil {
+		if err := json.Unmarshal(amenitiesJSON, &venue.Amenities); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal amenities: %w", err)
+		}
+	}
+
 	return &venue, nil
 }
+
+// marshalOpeningHours encodes opening hours for storage, keeping NULL for venues without them
+func marshalOpeningHours(hours *domain.OpeningHours) ([]byte, error) {
+	if hours == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(hours)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal opening hours: %w", err)
+	}
+
+	return data, nil
+}
+
+// marshalVenueAmenities encodes amenities for storage, keeping NULL for venues without them
+func marshalVenueAmenities(amenities *domain.VenueAmenities) ([]byte, error) {
+	if amenities == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(amenities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal amenities: %w", err)
+	}
+
+	return data, nil
+}
@@ -342,3 +342,67 @@ func TestVenueRepository_GetByType(t *testing.T) {
 	assert.Len(t, homeVenues, 1)
 	assert.Equal(t, homeVenue.ID, homeVenues[0].ID)
 }
+
+func TestVenueRepository_SearchByAmenities(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewVenueRepository(db)
+	ctx := context.Background()
+
+	user := createTestUser(t, db)
+
+	newVenue := func(name string, amenities *domain.VenueAmenities) *domain.Venue {
+		venue := &domain.Venue{
+			ID:        uuid.New(),
+			Name:      name,
+			Type:      domain.VenueTypeStore,
+			Address:   name + " Street",
+			City:      "Lisbon",
+			Country:   "PT",
+			Latitude:  38.7223,
+			Longitude: -9.1393,
+			Metadata:  map[string]interface{}{},
+			CreatedBy: &user.ID,
+			CreatedAt: time.Now(),
+			Amenities: amenities,
+		}
+		require.NoError(t, repo.Create(ctx, venue))
+		return venue
+	}
+
+	tables, seats := 16, 64
+	large := newVenue("Arena", &domain.VenueAmenities{TableCount: &tables, Seats: &seats, PlaymatsProvided: true, WiFi: true})
+	smallSeats := 12
+	small := newVenue("Corner Shop", &domain.VenueAmenities{Seats: &smallSeats, WiFi: true, FoodAndDrinks: true})
+	newVenue("Unlisted", nil)
+
+	retrieved, err := repo.GetByID(ctx, large.ID)
+	require.NoError(t, err)
+	assert.Equal(t, large.Amenities, retrieved.Amenities)
+
+	minSeats := 32
+	tests := []struct {
+		name    string
+		filter  domain.VenueAmenityFilter
+		wantIDs []uuid.UUID
+	}{
+		{"enough seats", domain.VenueAmenityFilter{MinSeats: &minSeats}, []uuid.UUID{large.ID}},
+		{"wifi", domain.VenueAmenityFilter{WiFi: true}, []uuid.UUID{large.ID, small.ID}},
+		{"wifi and food", domain.VenueAmenityFilter{WiFi: true, FoodAndDrinks: true}, []uuid.UUID{small.ID}},
+		{"accessible", domain.VenueAmenityFilter{WheelchairAccessible: true}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			venues, err := repo.Search(ctx, domain.VenueSearchParams{Amenities: tt.filter, Limit: 10})
+			require.NoError(t, err)
+
+			var ids []uuid.UUID
+			for _, venue := range venues {
+				ids = append(ids, venue.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+type venueReviewRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewVenueReviewRepository creates a new PostgreSQL venue review repository
+func NewVenueReviewRepository(db *pgxpool.Pool) repository.VenueReviewRepository {
+	return &venueReviewRepository{db: db}
+}
+
+const venueReviewColumns = `id, venue_id, user_id, rating, text, tags, created_at, updated_at`
+
+// refreshVenueRatingQuery recomputes a venue's rating aggregates from its reviews
+const refreshVenueRatingQuery = `
+	UPDATE venues
+	SET rating_average = COALESCE((SELECT ROUND(AVG(rating), 2) FROM venue_reviews WHERE venue_id = $1), 0),
+		rating_count = (SELECT COUNT(*) FROM venue_reviews WHERE venue_id = $1)
+	WHERE id = $1`
+
+// UpsertReview creates or replaces a user's venue review and refreshes the venue's rating
+func (r *venueReviewRepository) UpsertReview(ctx context.Context, review *domain.VenueReview) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO venue_reviews (` + venueReviewColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (venue_id, user_id) DO UPDATE
+		SET rating = EXCLUDED.rating, text = EXCLUDED.text, tags = EXCLUDED.tags, updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at`
+
+	err = tx.QueryRow(ctx, query,
+		review.ID,
+		review.VenueID,
+		review.UserID,
+		review.Rating,
+		review.Text,
+		venueReviewTagStrings(review.Tags),
+		review.CreatedAt,
+		review.UpdatedAt,
+	).Scan(&review.ID, &review.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save venue review: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, refreshVenueRatingQuery, review.VenueID); err != nil {
+		return fmt.Errorf("failed to refresh venue rating: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetReview retrieves a user's review of a venue
+func (r *venueReviewRepository) GetReview(ctx context.Context, venueID, userID uuid.UUID) (*domain.VenueReview, error) {
+	query := `SELECT ` + venueReviewColumns + ` FROM venue_reviews WHERE venue_id = $1 AND user_id = $2`
+
+	review, err := scanVenueReview(r.db.QueryRow(ctx, query, venueID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get venue review: %w", err)
+	}
+
+	return review, nil
+}
+
+// GetReviews retrieves a venue's reviews, newest first
+func (r *venueReviewRepository) GetReviews(ctx context.Context, venueID uuid.UUID, limit, offset int) ([]*domain.VenueReview, error) {
+	query := `
+		SELECT ` + venueReviewColumns + `
+		FROM venue_reviews
+		WHERE venue_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3`
+
+	rows, err := r.db.Query(ctx, query, venueID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*domain.VenueReview
+	for rows.Next() {
+		review, err := scanVenueReview(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan venue review: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	return reviews, nil
+}
+
+// DeleteReview deletes a user's venue review and refreshes the venue's rating
+func (r *venueReviewRepository) DeleteReview(ctx context.Context, venueID, userID uuid.UUID) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `DELETE FROM venue_reviews WHERE venue_id = $1 AND user_id = $2`, venueID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete venue review: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("venue review not found")
+	}
+
+	if _, err := tx.Exec(ctx, refreshVenueRatingQuery, venueID); err != nil {
+		return fmt.Errorf("failed to refresh venue rating: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// HasAttendedEventAt checks whether the user went to an event at the venue. Hosting doesn't count,
+// and at events where organizers checked attendees in only checked-in players count.
+func (r *venueReviewRepository) HasAttendedEventAt(ctx context.Context, userID, venueID uuid.UUID, before time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM events e
+			JOIN event_rsvp rsvp ON rsvp.event_id = e.id
+			WHERE e.venue_id = $2 AND e.start_at <= $3 AND e.cancelled_at IS NULL
+			  AND rsvp.user_id = $1 AND rsvp.status = 'going'
+			  AND (
+				rsvp.checked_in_at IS NOT NULL
+				OR NOT EXISTS(SELECT 1 FROM event_rsvp checked WHERE checked.event_id = e.id AND checked.checked_in_at IS NOT NULL)
+			  )
+		)`
+
+	var attended bool
+	if err := r.db.QueryRow(ctx, query, userID, venueID, before).Scan(&attended); err != nil {
+		return false, fmt.Errorf("failed to check venue attendance: %w", err)
+	}
+
+	return attended, nil
+}
+
+// venueReviewTagStrings converts review tags for storage in a TEXT[] column
+func venueReviewTagStrings(tags []domain.VenueReviewTag) []string {
+	values := make([]string, len(tags))
+	for i, tag := range tags {
+		values[i] = string(tag)
+	}
+	return values
+}
+
+// scanVenueReview scans a venue review row
+func scanVenueReview(row pgx.Row) (*domain.VenueReview, error) {
+	var review domain.VenueReview
+	var tags []string
+
+	err := row.Scan(
+		&review.ID,
+		&review.VenueID,
+		&review.UserID,
+		&review.Rating,
+		&review.Text,
+		&tags,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Tags = make([]domain.VenueReviewTag, len(tags))
+	for i, tag := range tags {
+		review.Tags[i] = domain.VenueReviewTag(tag)
+	}
+
+	return &review, nil
+}
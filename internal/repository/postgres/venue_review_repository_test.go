@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVenueReviewRepository_ReviewsAndRatings(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewVenueReviewRepository(db)
+	venueRepo := NewVenueRepository(db)
+	eventRepo := NewEventRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	host := createTestUser(t, db)
+	player := createTestUser(t, db)
+	stranger := createTestUser(t, db)
+	venue := createTestVenue(t, db, &host.ID)
+	unrated := createTestVenue(t, db, &host.ID)
+
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: host.ID,
+		VenueID:    &venue.ID,
+		Title:      "Friday Night Magic",
+		Game:       domain.GameTypeMTG,
+		Visibility: domain.EventVisibilityPublic,
+		StartAt:    now.Add(-4 * time.Hour),
+		EndAt:      now.Add(-time.Hour),
+		Timezone:   "UTC",
+		Language:   "en",
+		Rules:      map[string]interface{}{},
+		Tags:       []string{},
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	require.NoError(t, eventRepo.Create(ctx, event))
+	require.NoError(t, eventRepo.CreateRSVP(ctx, &domain.EventRSVP{
+		EventID:   event.ID,
+		UserID:    player.ID,
+		Status:    domain.RSVPStatusGoing,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}))
+
+	// At an event where attendees were checked in, going without checking in doesn't count
+	checkedIn := createTestUser(t, db)
+	noShow := createTestUser(t, db)
+	checkInEvent := *event
+	checkInEvent.ID = uuid.New()
+	checkInEvent.VenueID = &unrated.ID
+	require.NoError(t, eventRepo.Create(ctx, &checkInEvent))
+	checkedInAt := now.Add(-4 * time.Hour)
+	for _, rsvp := range []*domain.EventRSVP{
+		{EventID: checkInEvent.ID, UserID: checkedIn.ID, Status: domain.RSVPStatusGoing, CheckedInAt: &checkedInAt, CreatedAt: now, UpdatedAt: now},
+		{EventID: checkInEvent.ID, UserID: noShow.ID, Status: domain.RSVPStatusGoing, CreatedAt: now, UpdatedAt: now},
+	} {
+		require.NoError(t, eventRepo.CreateRSVP(ctx, rsvp))
+	}
+
+	for _, tt := range []struct {
+		name    string
+		userID  uuid.UUID
+		venueID uuid.UUID
+		before  time.Time
+		want    bool
+	}{
+		{"host", host.ID, venue.ID, now, false},
+		{"going player", player.ID, venue.ID, now, true},
+		{"stranger", stranger.ID, venue.ID, now, false},
+		{"before the event started", player.ID, venue.ID, now.Add(-5 * time.Hour), false},
+		{"checked-in player", checkedIn.ID, unrated.ID, now, true},
+		{"going player who never checked in", noShow.ID, unrated.ID, now, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			attended, err := repo.HasAttendedEventAt(ctx, tt.userID, tt.venueID, tt.before)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, attended)
+		})
+	}
+
+	text := "Great tables and lighting"
+	review := domain.NewVenueReview(venue.ID, player.ID, 5, &text, []domain.VenueReviewTag{domain.VenueReviewTagParking}, now)
+	require.NoError(t, repo.UpsertReview(ctx, review))
+	require.NoError(t, repo.UpsertReview(ctx, domain.NewVenueReview(venue.ID, host.ID, 4, nil, nil, now)))
+
+	// Posting again replaces the earlier review, keeping its ID
+	replacement := domain.NewVenueReview(venue.ID, player.ID, 3, nil, []domain.VenueReviewTag{domain.VenueReviewTagAccessible}, now.Add(time.Minute))
+	require.NoError(t, repo.UpsertReview(ctx, replacement))
+	assert.Equal(t, review.ID, replacement.ID)
+
+	stored, err := repo.GetReview(ctx, venue.ID, player.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, 3, stored.Rating)
+	assert.Nil(t, stored.Text)
+	assert.Equal(t, []domain.VenueReviewTag{domain.VenueReviewTagAccessible}, stored.Tags)
+
+	reviews, err := repo.GetReviews(ctx, venue.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, reviews, 2)
+
+	rated, err := venueRepo.GetByID(ctx, venue.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3.5, rated.RatingAverage)
+	assert.Equal(t, 2, rated.RatingCount)
+
+	popular, err := venueRepo.GetPopularVenues(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, popular, 2)
+	assert.Equal(t, venue.ID, popular[0].ID, "rated venue ranks above unrated one")
+	assert.Equal(t, unrated.ID, popular[1].ID)
+
+	searched, err := venueRepo.Search(ctx, domain.VenueSearchParams{City: &venue.City, SortByRating: true, Limit: 10})
+	require.NoError(t, err)
+	require.NotEmpty(t, searched)
+	assert.Equal(t, venue.ID, searched[0].ID)
+
+	require.NoError(t, repo.DeleteReview(ctx, venue.ID, player.ID))
+	assert.Error(t, repo.DeleteReview(ctx, venue.ID, player.ID))
+
+	rated, err = venueRepo.GetByID(ctx, venue.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, rated.RatingAverage)
+	assert.Equal(t, 1, rated.RatingCount)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// requestIDContextKey carries the request ID assigned by the logging middleware
+type requestIDContextKey struct{}
+
+// ContextWithRequestID returns a context carrying the given request ID
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in the context, if any
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDContextKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// impersonatorIDContextKey carries the admin behind an impersonation token
+type impersonatorIDContextKey struct{}
+
+// ContextWithImpersonatorID returns a context carrying the admin who is acting as the authenticated user
+func ContextWithImpersonatorID(ctx context.Context, impersonatorID uuid.UUID) context.Context {
+	return context.WithValue(ctx, impersonatorIDContextKey{}, impersonatorID)
+}
+
+// ImpersonatorIDFromContext returns the impersonating admin stored in the context, if any
+func ImpersonatorIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	impersonatorID, ok := ctx.Value(impersonatorIDContextKey{}).(uuid.UUID)
+	return impersonatorID, ok && impersonatorID != uuid.Nil
+}
+
+// AuditService records entries in the audit log
+type AuditService struct {
+	auditRepo repository.AuditLogRepository
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(auditRepo repository.AuditLogRepository) *AuditService {
+	return &AuditService{
+		auditRepo: auditRepo,
+	}
+}
+
+// Record validates and stores an audit log entry, filling in the ID, timestamp, request ID and
+// impersonating admin when unset
+func (s *AuditService) Record(ctx context.Context, entry *domain.AuditLogEntry) error {
+	if entry.ID == uuid.Nil {
+		entry.ID = uuid.New()
+	}
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now().UTC()
+	}
+
+	if entry.RequestID == nil {
+		if requestID, ok := RequestIDFromContext(ctx); ok {
+			entry.RequestID = &requestID
+		}
+	}
+
+	if entry.ImpersonatorID == nil {
+		if impersonatorID, ok := ImpersonatorIDFromContext(ctx); ok {
+			entry.ImpersonatorID = &impersonatorID
+		}
+	}
+
+	if err := entry.Validate(); err != nil {
+		return fmt.Errorf("invalid audit log entry: %w", err)
+	}
+
+	if err := s.auditRepo.Create(ctx, entry); err != nil {
+		return fmt.Errorf("failed to record audit log entry: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+)
+
+type mockAuditLogRepository struct {
+	entries []*domain.AuditLogEntry
+}
+
+func (m *mockAuditLogRepository) Create(ctx context.Context, entry *domain.AuditLogEntry) error {
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+func (m *mockAuditLogRepository) List(ctx context.Context, filter domain.AuditLogFilter, limit, offset int) ([]*domain.AuditLogEntry, error) {
+	return m.entries, nil
+}
+
+func TestAuditService_Record(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("fills request ID from context", func(t *testing.T) {
+		repo := &mockAuditLogRepository{}
+		service := NewAuditService(repo)
+		ctx := ContextWithRequestID(context.Background(), "01HZX3Q7E5N8J2K4M6P8R0T2V4")
+
+		entry := &domain.AuditLogEntry{Action: domain.AuditActionLogin, TargetType: domain.AuditTargetUser, TargetID: userID}
+		if err := service.Record(ctx, entry); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+
+		if len(repo.entries) != 1 {
+			t.Fatalf("expected 1 stored entry, got %d", len(repo.entries))
+		}
+		stored := repo.entries[0]
+		if stored.RequestID == nil || *stored.RequestID != "01HZX3Q7E5N8J2K4M6P8R0T2V4" {
+			t.Errorf("RequestID = %v, want request ID from context", stored.RequestID)
+		}
+		if stored.ID == uuid.Nil || stored.CreatedAt.IsZero() {
+			t.Errorf("expected ID and CreatedAt to be set")
+		}
+	})
+
+	t.Run("fills impersonating admin from context", func(t *testing.T) {
+		repo := &mockAuditLogRepository{}
+		service := NewAuditService(repo)
+		adminID := uuid.New()
+		ctx := ContextWithImpersonatorID(context.Background(), adminID)
+
+		entry := &domain.AuditLogEntry{ActorID: &userID, Action: domain.AuditActionRSVPChange, TargetType: domain.AuditTargetEvent, TargetID: uuid.New()}
+		if err := service.Record(ctx, entry); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+
+		stored := repo.entries[0]
+		if stored.ImpersonatorID == nil || *stored.ImpersonatorID != adminID {
+			t.Errorf("ImpersonatorID = %v, want admin from context", stored.ImpersonatorID)
+		}
+		if *stored.ActorID != userID {
+			t.Errorf("ActorID = %v, want the impersonated user", *stored.ActorID)
+		}
+	})
+
+	t.Run("no request ID outside a request", func(t *testing.T) {
+		repo := &mockAuditLogRepository{}
+		service := NewAuditService(repo)
+
+		entry := &domain.AuditLogEntry{Action: domain.AuditActionLogin, TargetType: domain.AuditTargetUser, TargetID: userID}
+		if err := service.Record(context.Background(), entry); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+
+		if repo.entries[0].RequestID != nil {
+			t.Errorf("RequestID = %v, want nil", *repo.entries[0].RequestID)
+		}
+		if repo.entries[0].ImpersonatorID != nil {
+			t.Errorf("ImpersonatorID = %v, want nil", *repo.entries[0].ImpersonatorID)
+		}
+	})
+
+	t.Run("rejects invalid entries", func(t *testing.T) {
+		repo := &mockAuditLogRepository{}
+		service := NewAuditService(repo)
+
+		entry := &domain.AuditLogEntry{Action: "export", TargetType: domain.AuditTargetUser, TargetID: userID}
+		if err := service.Record(context.Background(), entry); err == nil {
+			t.Error("expected error for invalid action")
+		}
+
+		if len(repo.entries) != 0 {
+			t.Errorf("invalid entry should not be stored")
+		}
+	})
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Data export storage errors
+var (
+	ErrInvalidExportStorageKey = fmt.Errorf("invalid export storage key")
+)
+
+// LocalExportStorage stores data export archives on the local filesystem
+type LocalExportStorage struct {
+	dir string
+}
+
+// NewLocalExportStorage creates the storage directory if needed and returns a storage rooted there
+func NewLocalExportStorage(dir string) (*LocalExportStorage, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create export storage directory: %w", err)
+	}
+
+	return &LocalExportStorage{dir: dir}, nil
+}
+
+// Save writes an archive under the given key
+func (s *LocalExportStorage) Save(ctx context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write export archive: %w", err)
+	}
+
+	return nil
+}
+
+// Open opens the archive stored under the given key
+func (s *LocalExportStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open export archive: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete removes the archive stored under the given key. Missing archives are not an error.
+func (s *LocalExportStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete export archive: %w", err)
+	}
+
+	return nil
+}
+
+// path resolves a key inside the storage directory, rejecting keys that would escape it
+func (s *LocalExportStorage) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || key == "." || key == ".." {
+		return "", ErrInvalidExportStorageKey
+	}
+
+	return filepath.Join(s.dir, key), nil
+}
+
+// DataExportLinkSigner creates and verifies expiring download links for data export archives
+type DataExportLinkSigner struct {
+	secret  []byte
+	baseURL string
+}
+
+// NewDataExportLinkSigner creates a link signer. baseURL is the API root the download route is served under.
+func NewDataExportLinkSigner(secret, baseURL string) *DataExportLinkSigner {
+	return &DataExportLinkSigner{
+		secret:  []byte(secret),
+		baseURL: baseURL,
+	}
+}
+
+// SignedURL returns a download link for the export that stops working at expiresAt
+func (s *DataExportLinkSigner) SignedURL(exportID uuid.UUID, expiresAt time.Time) string {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(exportID, expires))
+
+	return fmt.Sprintf("%s/exports/%s/download?%s", s.baseURL, exportID.String(), query.Encode())
+}
+
+// Verify checks a link's signature and that it has not expired
+func (s *DataExportLinkSigner) Verify(exportID uuid.UUID, expires, signature string, now time.Time) bool {
+	expiresUnix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	if !now.Before(time.Unix(expiresUnix, 0)) {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(s.sign(exportID, expires)))
+}
+
+// sign computes the link signature over the export ID and expiry
+func (s *DataExportLinkSigner) sign(exportID uuid.UUID, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(exportID.String() + ":" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// BuildDataExportArchive writes each entity of an export into a ZIP archive as both
+// <entity>.json and <entity>.csv. Nested values are stored as JSON inside CSV cells.
+func BuildDataExportArchive(data map[string]interface{}) ([]byte, error) {
+	entities := make([]string, 0, len(data))
+	for entity := range data {
+		entities = append(entities, entity)
+	}
+	sort.Strings(entities)
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	for _, entity := range entities {
+		jsonData, err := json.MarshalIndent(data[entity], "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", entity, err)
+		}
+
+		jsonFile, err := archive.Create(entity + ".json")
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s.json: %w", entity, err)
+		}
+		if _, err := jsonFile.Write(jsonData); err != nil {
+			return nil, fmt.Errorf("failed to write %s.json: %w", entity, err)
+		}
+
+		csvFile, err := archive.Create(entity + ".csv")
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s.csv: %w", entity, err)
+		}
+		if err := writeExportCSV(csvFile, jsonData); err != nil {
+			return nil, fmt.Errorf("failed to write %s.csv: %w", entity, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish export archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeExportCSV flattens a JSON-encoded entity into CSV rows. Lists become one row per item,
+// objects a single row, and scalar values a single "value" column.
+func writeExportCSV(w io.Writer, jsonData []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(jsonData, &value); err != nil {
+		return err
+	}
+
+	var items []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		items = v
+	case nil:
+		items = nil
+	default:
+		items = []interface{}{v}
+	}
+
+	rows := make([]map[string]interface{}, len(items))
+	columnSet := make(map[string]bool)
+	for i, item := range items {
+		row, ok := item.(map[string]interface{})
+		if !ok {
+			row = map[string]interface{}{"value": item}
+		}
+		for column := range row {
+			columnSet[column] = true
+		}
+		rows[i] = row
+	}
+
+	columns := make([]string, 0, len(columnSet))
+	for column := range columnSet {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	writer := csv.NewWriter(w)
+	if len(columns) > 0 {
+		if err := writer.Write(columns); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			cell, err := formatExportCSVCell(row[column])
+			if err != nil {
+				return err
+			}
+			record[i] = cell
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// formatExportCSVCell renders a JSON value as a CSV cell
+func formatExportCSVCell(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(encoded), nil
+	}
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalExportStorage(t *testing.T) {
+	ctx := context.Background()
+	storage, err := NewLocalExportStorage(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, storage.Save(ctx, "export.zip", []byte("archive")))
+
+	reader, err := storage.Open(ctx, "export.zip")
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(data))
+
+	require.NoError(t, storage.Delete(ctx, "export.zip"))
+	require.NoError(t, storage.Delete(ctx, "export.zip"))
+
+	_, err = storage.Open(ctx, "export.zip")
+	assert.Error(t, err)
+
+	for _, key := range []string{"", "..", "../secret.zip", "nested/export.zip"} {
+		assert.Equal(t, ErrInvalidExportStorageKey, storage.Save(ctx, key, []byte("x")), "key %q", key)
+	}
+}
+
+func TestDataExportLinkSigner(t *testing.T) {
+	signer := NewDataExportLinkSigner("test-secret", "https://api.matchtcg.com/api/v1")
+	exportID := uuid.New()
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+
+	link := signer.SignedURL(exportID, expiresAt)
+	assert.True(t, strings.HasPrefix(link, "https://api.matchtcg.com/api/v1/exports/"+exportID.String()+"/download?"))
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	expires := parsed.Query().Get("expires")
+	signature := parsed.Query().Get("signature")
+
+	assert.True(t, signer.Verify(exportID, expires, signature, now))
+	assert.False(t, signer.Verify(exportID, expires, signature, expiresAt.Add(time.Second)), "expired link")
+	assert.False(t, signer.Verify(uuid.New(), expires, signature, now), "signature for another export")
+	assert.False(t, signer.Verify(exportID, "9999999999", signature, now), "tampered expiry")
+	assert.False(t, NewDataExportLinkSigner("other-secret", "").Verify(exportID, expires, signature, now), "other secret")
+}
+
+func TestBuildDataExportArchive(t *testing.T) {
+	data := map[string]interface{}{
+		"account": map[string]interface{}{"email": "player@example.com", "is_active": true},
+		"event_rsvps": []map[string]interface{}{
+			{"event_id": "e1", "status": "going"},
+			{"event_id": "e2", "status": "interested", "note": map[string]interface{}{"seat": 4}},
+		},
+		"group_memberships": []map[string]interface{}{},
+	}
+
+	archiveData, err := BuildDataExportArchive(data)
+	require.NoError(t, err)
+
+	archive, err := zip.NewReader(bytes.NewReader(archiveData), int64(len(archiveData)))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		require.NoError(t, err)
+		files[file.Name] = string(content)
+	}
+
+	assert.Len(t, files, 6)
+	assert.Contains(t, files["account.json"], `"email": "player@example.com"`)
+
+	records, err := csv.NewReader(strings.NewReader(files["event_rsvps.csv"])).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"event_id", "note", "status"}, records[0])
+	assert.Equal(t, []string{"e1", "", "going"}, records[1])
+	assert.Equal(t, []string{"e2", `{"seat":4}`, "interested"}, records[2])
+
+	records, err = csv.NewReader(strings.NewReader(files["account.csv"])).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"email", "is_active"}, {"player@example.com", "true"}}, records)
+
+	assert.Empty(t, files["group_memberships.csv"])
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrWebhookDeliveryFailed = errors.New("webhook delivery failed")
+	ErrDiscordRateLimited    = errors.New("discord webhook rate limited")
+)
+
+// Discord embed limits
+const (
+	discordMaxTitleLength       = 256
+	discordMaxDescriptionLength = 4096
+	discordMaxFieldValueLength  = 1024
+
+	discordColorNewEvent     = 0x2ECC71
+	discordColorUpdatedEvent = 0x3498DB
+)
+
+// GroupEventAnnouncer publishes group event announcements to external channels
+type GroupEventAnnouncer interface {
+	AnnounceNewGroupEvent(ctx context.Context, event *domain.EventWithDetails, group *domain.Group) error
+	AnnounceEventUpdate(ctx context.Context, event *domain.EventWithDetails, updateMessage string) error
+}
+
+// DiscordConfig holds configuration for the Discord announcer
+type DiscordConfig struct {
+	BaseURL  string
+	Username string
+	Timeout  time.Duration
+}
+
+// DiscordAnnouncer posts group event announcements to Discord webhooks
+type DiscordAnnouncer struct {
+	webhookRepo repository.GroupWebhookRepository
+	eventRepo   repository.EventRepository
+	i18nService *I18nService
+	httpClient  *http.Client
+	baseURL     string
+	username    string
+}
+
+// NewDiscordAnnouncer creates a new Discord announcer
+func NewDiscordAnnouncer(
+	webhookRepo repository.GroupWebhookRepository,
+	eventRepo repository.EventRepository,
+	i18nService *I18nService,
+	config DiscordConfig,
+) *DiscordAnnouncer {
+	if config.Username == "" {
+		config.Username = "MatchTCG"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &DiscordAnnouncer{
+		webhookRepo: webhookRepo,
+		eventRepo:   eventRepo,
+		i18nService: i18nService,
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+		},
+		baseURL:  config.BaseURL,
+		username: config.Username,
+	}
+}
+
+// discordWebhookPayload represents the body of a Discord webhook execution
+type discordWebhookPayload struct {
+	Username string         `json:"username,omitempty"`
+	Content  string         `json:"content,omitempty"`
+	Embeds   []discordEmbed `json:"embeds"`
+}
+
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Color       int                 `json:"color"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Footer      *discordEmbedFooter `json:"footer,omitempty"`
+}
+
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// discordStrings holds the localized labels used in Discord announcements
+type discordStrings struct {
+	NewEventContent     string
+	UpdatedEventContent string
+	When                string
+	Venue               string
+	Game                string
+	SeatsLeft           string
+	SeatsOf             string
+	SeatsUnlimited      string
+	WhatChanged         string
+	DetailsUpdated      string
+	RSVP                string
+	RSVPLink            string
+}
+
+// AnnounceNewGroupEvent posts a new event announcement to the group's webhook
+func (a *DiscordAnnouncer) AnnounceNewGroupEvent(ctx context.Context, event *domain.EventWithDetails, group *domain.Group) error {
+	webhook, err := a.webhookRepo.GetByGroupID(ctx, group.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get group webhook: %w", err)
+	}
+	if webhook == nil || !webhook.IsActive {
+		return nil
+	}
+
+	strs := getDiscordStrings(SupportedLocale(webhook.Locale))
+	embed := a.buildEventEmbed(ctx, event, webhook, discordColorNewEvent)
+
+	payload := discordWebhookPayload{
+		Username: a.username,
+		Content:  fmt.Sprintf(strs.NewEventContent, group.Name),
+		Embeds:   []discordEmbed{embed},
+	}
+
+	return a.deliver(ctx, webhook, payload)
+}
+
+// AnnounceEventUpdate posts an event update announcement to the event group's webhook
+func (a *DiscordAnnouncer) AnnounceEventUpdate(ctx context.Context, event *domain.EventWithDetails, updateMessage string) error {
+	if event.GroupID == nil {
+		return nil
+	}
+
+	webhook, err := a.webhookRepo.GetByGroupID(ctx, *event.GroupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group webhook: %w", err)
+	}
+	if webhook == nil || !webhook.IsActive {
+		return nil
+	}
+
+	strs := getDiscordStrings(SupportedLocale(webhook.Locale))
+	embed := a.buildEventEmbed(ctx, event, webhook, discordColorUpdatedEvent)
+	if updateMessage == "" {
+		updateMessage = strs.DetailsUpdated
+	}
+	embed.Fields = append([]discordEmbedField{{
+		Name:  strs.WhatChanged,
+		Value: truncateText(updateMessage, discordMaxFieldValueLength),
+	}}, embed.Fields...)
+
+	groupName := ""
+	if event.Group != nil {
+		groupName = event.Group.Name
+	}
+
+	payload := discordWebhookPayload{
+		Username: a.username,
+		Content:  fmt.Sprintf(strs.UpdatedEventContent, groupName),
+		Embeds:   []discordEmbed{embed},
+	}
+
+	return a.deliver(ctx, webhook, payload)
+}
+
+// buildEventEmbed builds the rich embed describing an event
+func (a *DiscordAnnouncer) buildEventEmbed(ctx context.Context, event *domain.EventWithDetails, webhook *domain.GroupWebhook, color int) discordEmbed {
+	locale := SupportedLocale(webhook.Locale)
+	strs := getDiscordStrings(locale)
+	eventURL := fmt.Sprintf("%s/events/%s", a.baseURL, event.ID)
+
+	embed := discordEmbed{
+		Title: truncateText(event.Title, discordMaxTitleLength),
+		URL:   eventURL,
+		Color: color,
+	}
+	if event.Description != nil {
+		embed.Description = truncateText(*event.Description, discordMaxDescriptionLength)
+	}
+
+	embed.Fields = append(embed.Fields,
+		discordEmbedField{Name: strs.When, Value: a.formatEventTime(ctx, locale, event, webhook.Timezone), Inline: true},
+		discordEmbedField{Name: strs.Game, Value: string(event.Game), Inline: true},
+	)
+
+	if event.Venue != nil {
+		venue := event.Venue.Name
+		if event.Venue.Address != "" {
+			venue = fmt.Sprintf("%s\n%s", event.Venue.Name, event.Venue.Address)
+		}
+		if event.Venue.City != "" {
+			venue = fmt.Sprintf("%s, %s", venue, event.Venue.City)
+		}
+		embed.Fields = append(embed.Fields, discordEmbedField{
+			Name:  strs.Venue,
+			Value: truncateText(venue, discordMaxFieldValueLength),
+		})
+	}
+
+	embed.Fields = append(embed.Fields,
+		discordEmbedField{Name: strs.SeatsLeft, Value: a.formatSeatsLeft(ctx, event, strs), Inline: true},
+		discordEmbedField{Name: strs.RSVP, Value: fmt.Sprintf("[%s](%s)", strs.RSVPLink, eventURL), Inline: true},
+	)
+
+	if event.Host != nil && event.Host.Profile != nil && event.Host.Profile.DisplayName != nil {
+		embed.Footer = &discordEmbedFooter{Text: *event.Host.Profile.DisplayName}
+	}
+
+	return embed
+}
+
+// formatEventTime formats the event start time in the group's timezone
+func (a *DiscordAnnouncer) formatEventTime(ctx context.Context, locale SupportedLocale, event *domain.EventWithDetails, timezone string) string {
+	if timezone == "" {
+		timezone = event.Timezone
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	formatted, err := a.i18nService.FormatDateTime(ctx, locale, event.StartAt, timezone)
+	if err != nil {
+		timezone = "UTC"
+		formatted, _ = a.i18nService.FormatDateTime(ctx, locale, event.StartAt, timezone)
+	}
+
+	return fmt.Sprintf("%s (%s)", formatted, timezone)
+}
+
+// formatSeatsLeft describes the remaining capacity of an event
+func (a *DiscordAnnouncer) formatSeatsLeft(ctx context.Context, event *domain.EventWithDetails, strs discordStrings) string {
+	if event.Capacity == nil {
+		return strs.SeatsUnlimited
+	}
+
+	going, err := a.eventRepo.GetEventGoingCount(ctx, event.ID)
+	if err != nil {
+		going = 0
+	}
+
+	left := *event.Capacity - going
+	if left < 0 {
+		left = 0
+	}
+
+	return fmt.Sprintf(strs.SeatsOf, left, *event.Capacity)
+}
+
+// deliver posts the payload to the webhook and records the outcome
+func (a *DiscordAnnouncer) deliver(ctx context.Context, webhook *domain.GroupWebhook, payload discordWebhookPayload) error {
+	err := a.post(ctx, webhook.URL, payload)
+
+	var errMsg *string
+	if err != nil {
+		msg := err.Error()
+		errMsg = &msg
+	}
+	if recordErr := a.webhookRepo.RecordDelivery(ctx, webhook.ID, time.Now().UTC(), errMsg); recordErr != nil {
+		log.Printf("Failed to record delivery for webhook %s: %v", webhook.ID, recordErr)
+	}
+
+	return err
+}
+
+// post sends a JSON payload to a Discord webhook URL
+func (a *DiscordAnnouncer) post(ctx context.Context, webhookURL string, payload discordWebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrWebhookDeliveryFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return ErrDiscordRateLimited
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: HTTP %d", ErrWebhookDeliveryFailed, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// getDiscordStrings returns the Discord announcement labels for a locale
+func getDiscordStrings(locale SupportedLocale) discordStrings {
+	switch locale {
+	case LocaleEnglish:
+		return discordStrings{
+			NewEventContent:     "📅 New event in **%s**",
+			UpdatedEventContent: "✏️ Event updated in **%s**",
+			When:                "When",
+			Venue:               "Venue",
+			Game:                "Game",
+			SeatsLeft:           "Seats left",
+			SeatsOf:             "%d of %d",
+			SeatsUnlimited:      "Unlimited",
+			WhatChanged:         "What changed",
+			DetailsUpdated:      "The event details have been updated.",
+			RSVP:                "RSVP",
+			RSVPLink:            "Reserve your seat",
+		}
+	default:
+		return discordStrings{
+			NewEventContent:     "📅 Novo evento em **%s**",
+			UpdatedEventContent: "✏️ Evento atualizado em **%s**",
+			When:                "Quando",
+			Venue:               "Local",
+			Game:                "Jogo",
+			SeatsLeft:           "Lugares disponíveis",
+			SeatsOf:             "%d de %d",
+			SeatsUnlimited:      "Sem limite",
+			WhatChanged:         "O que mudou",
+			DetailsUpdated:      "Os detalhes do evento foram atualizados.",
+			RSVP:                "Inscrição",
+			RSVPLink:            "Reservar lugar",
+		}
+	}
+}
+
+// truncateText shortens a string to the given number of runes
+func truncateText(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
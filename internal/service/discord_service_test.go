@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+)
+
+type mockGroupWebhookRepository struct {
+	webhooks   map[uuid.UUID]*domain.GroupWebhook
+	deliveries map[uuid.UUID]*string
+}
+
+func newMockGroupWebhookRepository() *mockGroupWebhookRepository {
+	return &mockGroupWebhookRepository{
+		webhooks:   make(map[uuid.UUID]*domain.GroupWebhook),
+		deliveries: make(map[uuid.UUID]*string),
+	}
+}
+
+func (m *mockGroupWebhookRepository) Create(ctx context.Context, webhook *domain.GroupWebhook) error {
+	m.webhooks[webhook.GroupID] = webhook
+	return nil
+}
+
+func (m *mockGroupWebhookRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) (*domain.GroupWebhook, error) {
+	webhook, exists := m.webhooks[groupID]
+	if !exists {
+		return nil, nil
+	}
+	return webhook, nil
+}
+
+func (m *mockGroupWebhookRepository) Update(ctx context.Context, webhook *domain.GroupWebhook) error {
+	m.webhooks[webhook.GroupID] = webhook
+	return nil
+}
+
+func (m *mockGroupWebhookRepository) Delete(ctx context.Context, groupID uuid.UUID) error {
+	delete(m.webhooks, groupID)
+	return nil
+}
+
+func (m *mockGroupWebhookRepository) RecordDelivery(ctx context.Context, id uuid.UUID, deliveredAt time.Time, errorMessage *string) error {
+	m.deliveries[id] = errorMessage
+	return nil
+}
+
+// discordStandIn is a local HTTP server that records Discord webhook executions
+type discordStandIn struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	payloads []discordWebhookPayload
+	status   int
+}
+
+func newDiscordStandIn(t *testing.T) *discordStandIn {
+	standIn := &discordStandIn{status: http.StatusNoContent}
+	standIn.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var payload discordWebhookPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		standIn.mu.Lock()
+		standIn.payloads = append(standIn.payloads, payload)
+		status := standIn.status
+		standIn.mu.Unlock()
+
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(standIn.server.Close)
+	return standIn
+}
+
+func (s *discordStandIn) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.payloads)
+}
+
+func (s *discordStandIn) last() discordWebhookPayload {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.payloads[len(s.payloads)-1]
+}
+
+func embedField(embed discordEmbed, name string) (string, bool) {
+	for _, field := range embed.Fields {
+		if field.Name == name {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+func TestDiscordAnnouncer(t *testing.T) {
+	ctx := context.Background()
+	standIn := newDiscordStandIn(t)
+
+	webhookRepo := newMockGroupWebhookRepository()
+	eventRepo := newMockEventRepository()
+	announcer := NewDiscordAnnouncer(webhookRepo, eventRepo, NewI18nService(), DiscordConfig{
+		BaseURL: "https://test.matchtcg.com",
+	})
+
+	groupID := uuid.New()
+	capacity := 16
+	group := &domain.Group{ID: groupID, Name: "Lisbon Pauper"}
+	event := &domain.EventWithDetails{
+		Event: domain.Event{
+			ID:       uuid.New(),
+			GroupID:  &groupID,
+			Title:    "Friday Night Magic",
+			Game:     domain.GameTypeMTG,
+			Capacity: &capacity,
+			StartAt:  time.Date(2025, 10, 3, 18, 30, 0, 0, time.UTC),
+			Timezone: "UTC",
+		},
+		Group: group,
+		Venue: &domain.Venue{
+			Name:    "Game Store",
+			Address: "Rua Augusta 1",
+			City:    "Lisboa",
+		},
+	}
+
+	webhook := &domain.GroupWebhook{
+		ID:       uuid.New(),
+		GroupID:  groupID,
+		URL:      standIn.server.URL + "/api/webhooks/1/token",
+		Locale:   "pt",
+		Timezone: "Europe/Lisbon",
+		IsActive: true,
+	}
+	webhookRepo.webhooks[groupID] = webhook
+
+	t.Run("AnnounceNewGroupEvent posts localized embed", func(t *testing.T) {
+		err := announcer.AnnounceNewGroupEvent(ctx, event, group)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if standIn.count() != 1 {
+			t.Fatalf("Expected 1 webhook execution, got %d", standIn.count())
+		}
+
+		payload := standIn.last()
+		if !strings.Contains(payload.Content, "Lisbon Pauper") {
+			t.Errorf("Expected content to mention the group, got '%s'", payload.Content)
+		}
+		if len(payload.Embeds) != 1 {
+			t.Fatalf("Expected 1 embed, got %d", len(payload.Embeds))
+		}
+
+		embed := payload.Embeds[0]
+		if embed.Title != "Friday Night Magic" {
+			t.Errorf("Expected title 'Friday Night Magic', got '%s'", embed.Title)
+		}
+		expectedURL := "https://test.matchtcg.com/events/" + event.ID.String()
+		if embed.URL != expectedURL {
+			t.Errorf("Expected URL '%s', got '%s'", expectedURL, embed.URL)
+		}
+
+		// 18:30 UTC is 19:30 in Lisbon during summer time
+		when, ok := embedField(embed, "Quando")
+		if !ok || when != "03/10/2025 19:30 (Europe/Lisbon)" {
+			t.Errorf("Expected time in group timezone, got '%s'", when)
+		}
+
+		seats, ok := embedField(embed, "Lugares disponíveis")
+		if !ok || seats != "16 de 16" {
+			t.Errorf("Expected '16 de 16' seats left, got '%s'", seats)
+		}
+
+		venue, ok := embedField(embed, "Local")
+		if !ok || !strings.Contains(venue, "Game Store") {
+			t.Errorf("Expected venue field, got '%s'", venue)
+		}
+
+		rsvp, ok := embedField(embed, "Inscrição")
+		if !ok || !strings.Contains(rsvp, expectedURL) {
+			t.Errorf("Expected RSVP link, got '%s'", rsvp)
+		}
+
+		if msg, recorded := webhookRepo.deliveries[webhook.ID]; !recorded || msg != nil {
+			t.Errorf("Expected successful delivery to be recorded")
+		}
+	})
+
+	t.Run("AnnounceEventUpdate uses English template", func(t *testing.T) {
+		webhook.Locale = "en"
+		defer func() { webhook.Locale = "pt" }()
+
+		err := announcer.AnnounceEventUpdate(ctx, event, "Moved to 20:00")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		embed := standIn.last().Embeds[0]
+		changed, ok := embedField(embed, "What changed")
+		if !ok || changed != "Moved to 20:00" {
+			t.Errorf("Expected update message field, got '%s'", changed)
+		}
+		when, _ := embedField(embed, "When")
+		if when != "10/03/2025 7:30 PM (Europe/Lisbon)" {
+			t.Errorf("Expected English time format, got '%s'", when)
+		}
+	})
+
+	t.Run("AnnounceEventUpdate localizes the default update message", func(t *testing.T) {
+		err := announcer.AnnounceEventUpdate(ctx, event, "")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		embed := standIn.last().Embeds[0]
+		changed, ok := embedField(embed, "O que mudou")
+		if !ok || changed != "Os detalhes do evento foram atualizados." {
+			t.Errorf("Expected Portuguese update message field, got '%s'", changed)
+		}
+	})
+
+	t.Run("Rate limited delivery is reported", func(t *testing.T) {
+		standIn.mu.Lock()
+		standIn.status = http.StatusTooManyRequests
+		standIn.mu.Unlock()
+		defer func() {
+			standIn.mu.Lock()
+			standIn.status = http.StatusNoContent
+			standIn.mu.Unlock()
+		}()
+
+		err := announcer.AnnounceNewGroupEvent(ctx, event, group)
+		if !errors.Is(err, ErrDiscordRateLimited) {
+			t.Errorf("Expected ErrDiscordRateLimited, got %v", err)
+		}
+	})
+
+	t.Run("Inactive webhook is skipped", func(t *testing.T) {
+		webhook.IsActive = false
+		defer func() { webhook.IsActive = true }()
+
+		before := standIn.count()
+		if err := announcer.AnnounceNewGroupEvent(ctx, event, group); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if standIn.count() != before {
+			t.Error("Expected no webhook execution for inactive webhook")
+		}
+	})
+
+	t.Run("Failed delivery is reported and recorded", func(t *testing.T) {
+		standIn.mu.Lock()
+		standIn.status = http.StatusNotFound
+		standIn.mu.Unlock()
+		defer func() {
+			standIn.mu.Lock()
+			standIn.status = http.StatusNoContent
+			standIn.mu.Unlock()
+		}()
+
+		err := announcer.AnnounceNewGroupEvent(ctx, event, group)
+		if err == nil {
+			t.Fatal("Expected delivery error, got nil")
+		}
+
+		if msg := webhookRepo.deliveries[webhook.ID]; msg == nil {
+			t.Error("Expected delivery error to be recorded")
+		}
+	})
+
+	t.Run("NotificationTriggerService announces new group events", func(t *testing.T) {
+		userRepo := newMockUserRepository()
+		groupRepo := newMockGroupRepository()
+		notificationService := NewNotificationService(newMockNotificationRepository(), userRepo,
+			NewEmailService(NewMockEmailProvider(), "test@matchtcg.com", "MatchTCG Test"),
+			NewNotificationTemplateManager("https://test.matchtcg.com"))
+
+		eventRepo.events[event.ID] = event
+		groupRepo.groups[groupID] = &domain.GroupWithMembers{Group: *group}
+
+		triggerService := NewNotificationTriggerService(notificationService, eventRepo, groupRepo, userRepo)
+		triggerService.SetGroupEventAnnouncer(announcer)
+
+		before := standIn.count()
+		if err := triggerService.OnNewGroupEvent(ctx, event.ID, groupID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if standIn.count() != before+1 {
+			t.Errorf("Expected the event to be announced on Discord")
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ChainedProvider is a geocoding provider within a provider chain
+type ChainedProvider struct {
+	Provider  GeocodingProvider
+	RateLimit time.Duration // Minimum time between calls to the provider; zero for no limit
+}
+
+// ChainConfig holds configuration for a geocoding provider chain
+type ChainConfig struct {
+	FailureThreshold int           // Consecutive failures that open a provider's circuit
+	Cooldown         time.Duration // How long an open circuit skips its provider before a trial call
+}
+
+// ChainProvider implements the GeocodingProvider interface by trying providers in order. Providers
+// whose rate limit would be exceeded or whose circuit is open are skipped, so a slow or broken service
+// falls back to the next one instead of failing the lookup. When no provider has answered and some were
+// only skipped for their rate limit, the lookup waits for the first free slot within the caller's context.
+type ChainProvider struct {
+	links  []*chainLink
+	config ChainConfig
+}
+
+// chainLink tracks the rate limit and circuit breaker of one chained provider
+type chainLink struct {
+	provider GeocodingProvider
+	limiter  *rateLimiter // nil when the provider is not rate limited
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+// NewChainProvider creates a geocoding provider chain trying the providers in the given order
+func NewChainProvider(config ChainConfig, providers ...ChainedProvider) *ChainProvider {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = 3
+	}
+	if config.Cooldown == 0 {
+		config.Cooldown = time.Minute
+	}
+
+	links := make([]*chainLink, len(providers))
+	for i, chained := range providers {
+		links[i] = &chainLink{provider: chained.Provider}
+		if chained.RateLimit > 0 {
+			links[i].limiter = newRateLimiter(1, chained.RateLimit)
+		}
+	}
+
+	return &ChainProvider{
+		links:  links,
+		config: config,
+	}
+}
+
+// GetName returns the provider name, listing the chained providers in order
+func (c *ChainProvider) GetName() string {
+	names := make([]string, len(c.links))
+	for i, link := range c.links {
+		names[i] = link.provider.GetName()
+	}
+	return "Chain(" + strings.Join(names, ", ") + ")"
+}
+
+// Geocode converts an address to coordinates using the first provider that can answer
+func (c *ChainProvider) Geocode(ctx context.Context, address string) (*GeocodingResult, error) {
+	return c.try(ctx, func(provider GeocodingProvider) (*GeocodingResult, error) {
+		return provider.Geocode(ctx, address)
+	})
+}
+
+// ReverseGeocode converts coordinates to an address using the first provider that can answer
+func (c *ChainProvider) ReverseGeocode(ctx context.Context, lat, lon float64) (*GeocodingResult, error) {
+	return c.try(ctx, func(provider GeocodingProvider) (*GeocodingResult, error) {
+		return provider.ReverseGeocode(ctx, lat, lon)
+	})
+}
+
+// try calls the available providers in order until one returns a result. A provider that doesn't know
+// the address is no reason to open its circuit, but the next provider may still know it.
+func (c *ChainProvider) try(ctx context.Context, call func(GeocodingProvider) (*GeocodingResult, error)) (*GeocodingResult, error) {
+	notFound := false
+	var lastErr error
+
+	pending := c.links
+	for len(pending) > 0 {
+		var limited []*chainLink
+		var nextSlot time.Time
+
+		for _, link := range pending {
+			ok, freeAt := link.acquire(time.Now(), c.config.Cooldown)
+			if !ok {
+				if !freeAt.IsZero() {
+					limited = append(limited, link)
+					if nextSlot.IsZero() || freeAt.Before(nextSlot) {
+						nextSlot = freeAt
+					}
+				}
+				continue
+			}
+
+			result, err := call(link.provider)
+			if ctx.Err() != nil {
+				// The caller gave up, which says nothing about the provider
+				return nil, chainContextError(ctx)
+			}
+
+			switch {
+			case err == nil:
+				link.record(true, c.config)
+				return result, nil
+			case errors.Is(err, ErrAddressNotFound) || errors.Is(err, ErrInvalidAddress):
+				link.record(true, c.config)
+				notFound = true
+			default:
+				link.record(false, c.config)
+				lastErr = fmt.Errorf("%s: %w", link.provider.GetName(), err)
+			}
+		}
+
+		if len(limited) == 0 {
+			break
+		}
+
+		// Rather than fail while providers are only busy, wait for the first of them to free up
+		timer := time.NewTimer(time.Until(nextSlot))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, chainContextError(ctx)
+		case <-timer.C:
+		}
+		pending = limited
+	}
+
+	if notFound {
+		return nil, ErrAddressNotFound
+	}
+	if lastErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProviderUnavailable, lastErr)
+	}
+	return nil, ErrProviderUnavailable
+}
+
+// chainContextError converts the error of a context the caller cancelled or let expire
+func chainContextError(ctx context.Context) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrGeocodingTimeout
+	}
+	return ctx.Err()
+}
+
+// acquire reports whether the provider may be called now, taking a rate limit slot if so. A provider
+// skipped for its rate limit also reports when its next slot frees up. Once an open circuit cools down
+// a single trial call is let through; its outcome closes or reopens the circuit.
+func (l *chainLink) acquire(now time.Time, cooldown time.Duration) (bool, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.openUntil) {
+		return false, time.Time{}
+	}
+	if l.limiter != nil {
+		if ok, freeAt := l.limiter.allow(); !ok {
+			return false, freeAt
+		}
+	}
+
+	if !l.openUntil.IsZero() {
+		// Keep the circuit open for everyone else while the trial call runs
+		l.openUntil = now.Add(cooldown)
+	}
+	return true, time.Time{}
+}
+
+// record updates the circuit breaker with the outcome of a call
+func (l *chainLink) record(ok bool, config ChainConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if ok {
+		l.failures = 0
+		l.openUntil = time.Time{}
+		return
+	}
+
+	l.failures++
+	if l.failures >= config.FailureThreshold {
+		l.openUntil = time.Now().Add(config.Cooldown)
+	}
+}
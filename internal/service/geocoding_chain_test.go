@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matchtcg/backend/internal/domain"
+)
+
+// countingProvider returns a fixed error, or a result when the error is nil, and counts its calls
+func countingProvider(name string, err error, calls *int) *MockGeocodingProvider {
+	answer := func() (*GeocodingResult, error) {
+		*calls++
+		if err != nil {
+			return nil, err
+		}
+		return &GeocodingResult{
+			Coordinates: domain.Coordinates{Latitude: 41.1496, Longitude: -8.6110},
+			Source:      name,
+		}, nil
+	}
+
+	return &MockGeocodingProvider{
+		Name: name,
+		GeocodeFunc: func(ctx context.Context, address string) (*GeocodingResult, error) {
+			return answer()
+		},
+		ReverseGeocodeFunc: func(ctx context.Context, lat, lon float64) (*GeocodingResult, error) {
+			return answer()
+		},
+	}
+}
+
+func TestChainProvider_Fallback(t *testing.T) {
+	tests := []struct {
+		name           string
+		firstError     error
+		secondError    error
+		expectedError  error
+		expectedSource string
+	}{
+		{
+			name:           "first provider answers",
+			expectedSource: "First",
+		},
+		{
+			name:           "falls back when the first provider fails",
+			firstError:     ErrProviderUnavailable,
+			expectedSource: "Second",
+		},
+		{
+			name:           "falls back when the first provider doesn't know the address",
+			firstError:     ErrAddressNotFound,
+			expectedSource: "Second",
+		},
+		{
+			name:          "not found when no provider knows the address",
+			firstError:    ErrProviderUnavailable,
+			secondError:   ErrAddressNotFound,
+			expectedError: ErrAddressNotFound,
+		},
+		{
+			name:          "unavailable when every provider fails",
+			firstError:    ErrRateLimitExceeded,
+			secondError:   ErrGeocodingTimeout,
+			expectedError: ErrProviderUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var firstCalls, secondCalls int
+			chain := NewChainProvider(ChainConfig{},
+				ChainedProvider{Provider: countingProvider("First", tt.firstError, &firstCalls)},
+				ChainedProvider{Provider: countingProvider("Second", tt.secondError, &secondCalls)},
+			)
+
+			result, err := chain.Geocode(context.Background(), "Rua de Santa Catarina, Porto")
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Source != tt.expectedSource {
+				t.Errorf("Expected result from %s, got %s", tt.expectedSource, result.Source)
+			}
+			if tt.expectedSource == "First" && secondCalls != 0 {
+				t.Errorf("Expected the second provider not to be called, got %d calls", secondCalls)
+			}
+		})
+	}
+}
+
+func TestChainProvider_RateLimit(t *testing.T) {
+	var firstCalls, secondCalls int
+	chain := NewChainProvider(ChainConfig{},
+		ChainedProvider{Provider: countingProvider("First", nil, &firstCalls), RateLimit: time.Hour},
+		ChainedProvider{Provider: countingProvider("Second", nil, &secondCalls)},
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := chain.ReverseGeocode(context.Background(), 41.1496, -8.6110); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	if firstCalls != 1 {
+		t.Errorf("Expected the rate limited provider to be called once, got %d", firstCalls)
+	}
+	if secondCalls != 2 {
+		t.Errorf("Expected the next provider to take the other 2 lookups, got %d", secondCalls)
+	}
+}
+
+func TestChainProvider_WaitsWhenEveryProviderIsRateLimited(t *testing.T) {
+	var firstCalls, secondCalls int
+	chain := NewChainProvider(ChainConfig{},
+		ChainedProvider{Provider: countingProvider("First", nil, &firstCalls), RateLimit: 50 * time.Millisecond},
+		ChainedProvider{Provider: countingProvider("Second", nil, &secondCalls), RateLimit: 50 * time.Millisecond},
+	)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := chain.Geocode(context.Background(), "Porto"); err != nil {
+			t.Fatalf("Lookup %d: unexpected error: %v", i+1, err)
+		}
+	}
+
+	// The third lookup finds both providers busy and waits for the first one to free up
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("Expected the third lookup to wait for a free slot, took %v", elapsed)
+	}
+	if firstCalls != 2 || secondCalls != 1 {
+		t.Errorf("Expected 2 calls to the first provider and 1 to the second, got %d and %d", firstCalls, secondCalls)
+	}
+
+	// A caller that can't wait for the next slot times out instead
+	firstCalls, secondCalls = 0, 0
+	busy := NewChainProvider(ChainConfig{},
+		ChainedProvider{Provider: countingProvider("First", nil, &firstCalls), RateLimit: time.Hour},
+		ChainedProvider{Provider: countingProvider("Second", nil, &secondCalls), RateLimit: time.Hour},
+	)
+	for i := 0; i < 2; i++ {
+		if _, err := busy.Geocode(context.Background(), "Porto"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := busy.Geocode(ctx, "Porto"); err != ErrGeocodingTimeout {
+		t.Errorf("Expected ErrGeocodingTimeout, got %v", err)
+	}
+	if firstCalls != 1 || secondCalls != 1 {
+		t.Errorf("Expected no calls past the rate limits, got %d and %d", firstCalls, secondCalls)
+	}
+}
+
+func TestChainProvider_CircuitBreaker(t *testing.T) {
+	firstError := ErrProviderUnavailable
+	var firstCalls, secondCalls int
+	first := &MockGeocodingProvider{
+		Name: "First",
+		GeocodeFunc: func(ctx context.Context, address string) (*GeocodingResult, error) {
+			firstCalls++
+			if firstError != nil {
+				return nil, firstError
+			}
+			return &GeocodingResult{Source: "First"}, nil
+		},
+	}
+
+	chain := NewChainProvider(ChainConfig{FailureThreshold: 2, Cooldown: 50 * time.Millisecond},
+		ChainedProvider{Provider: first},
+		ChainedProvider{Provider: countingProvider("Second", nil, &secondCalls)},
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if _, err := chain.Geocode(ctx, "Porto"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if firstCalls != 2 {
+		t.Errorf("Expected the circuit to open after 2 failures, got %d calls", firstCalls)
+	}
+	if secondCalls != 5 {
+		t.Errorf("Expected every lookup to fall back, got %d", secondCalls)
+	}
+
+	// After the cooldown a trial call goes through and closes the circuit again
+	time.Sleep(60 * time.Millisecond)
+	firstError = nil
+
+	result, err := chain.Geocode(ctx, "Porto")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Source != "First" {
+		t.Errorf("Expected the trial call to answer, got %s", result.Source)
+	}
+
+	if _, err := chain.Geocode(ctx, "Porto"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if firstCalls != 4 {
+		t.Errorf("Expected the closed circuit to call the provider again, got %d calls", firstCalls)
+	}
+}
+
+func TestChainProvider_ContextCancellation(t *testing.T) {
+	var secondCalls int
+	first := &MockGeocodingProvider{
+		GeocodeFunc: func(ctx context.Context, address string) (*GeocodingResult, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+
+	chain := NewChainProvider(ChainConfig{},
+		ChainedProvider{Provider: first},
+		ChainedProvider{Provider: countingProvider("Second", nil, &secondCalls)},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := chain.Geocode(ctx, "Porto"); err != ErrGeocodingTimeout {
+		t.Errorf("Expected ErrGeocodingTimeout, got %v", err)
+	}
+	if secondCalls != 0 {
+		t.Errorf("Expected no fallback once the caller gave up, got %d calls", secondCalls)
+	}
+}
+
+func TestChainProvider_GetName(t *testing.T) {
+	chain := NewChainProvider(ChainConfig{},
+		ChainedProvider{Provider: NewNominatimProvider(NominatimConfig{})},
+		ChainedProvider{Provider: NewPhotonProvider(PhotonConfig{})},
+	)
+
+	if name := chain.GetName(); name != "Chain(Nominatim, Photon)" {
+		t.Errorf("Expected Chain(Nominatim, Photon), got %s", name)
+	}
+}
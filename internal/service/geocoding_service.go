@@ -479,3 +479,18 @@ func (rl *rateLimiter) wait(ctx context.Context) error {
 	rl.lastCall = now
 	return nil
 }
+
+// allow takes a call slot without waiting. When the last call was too recent it reports false and
+// when the next slot frees up.
+func (rl *rateLimiter) allow() (bool, time.Time) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if next := rl.lastCall.Add(rl.interval); now.Before(next) {
+		return false, next
+	}
+
+	rl.lastCall = now
+	return true, time.Time{}
+}
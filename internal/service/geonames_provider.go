@@ -0,0 +1,236 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/matchtcg/backend/internal/domain"
+)
+
+var (
+	ErrInvalidGeoNamesData = errors.New("invalid GeoNames city data")
+)
+
+// geoNamesConfidence is the confidence of offline lookups, which only ever resolve to a city
+const geoNamesConfidence = 40
+
+// GeoNamesProvider implements the GeocodingProvider interface offline using a GeoNames city dataset,
+// such as cities15000.txt from https://download.geonames.org/export/dump/. It only resolves addresses
+// to cities, which is enough to place venues roughly while external services are unreachable.
+type GeoNamesProvider struct {
+	cities     []geoNamesCity
+	byName     map[string][]int // Normalized names, ASCII names and alternate names to city indexes
+	geospatial *domain.GeospatialService
+}
+
+type geoNamesCity struct {
+	name        string
+	countryCode string
+	latitude    float64
+	longitude   float64
+	population  int64
+}
+
+// LoadGeoNamesProvider creates an offline geocoding provider from a GeoNames city dataset file
+func LoadGeoNamesProvider(path string) (*GeoNamesProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open GeoNames cities: %w", err)
+	}
+	defer file.Close()
+
+	return NewGeoNamesProvider(file)
+}
+
+// NewGeoNamesProvider creates an offline geocoding provider from tab-separated GeoNames city rows
+func NewGeoNamesProvider(r io.Reader) (*GeoNamesProvider, error) {
+	provider := &GeoNamesProvider{
+		byName:     make(map[string][]int),
+		geospatial: domain.NewGeospatialService(),
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024) // Alternate names make for long rows
+
+	for line := 1; scanner.Scan(); line++ {
+		row := scanner.Text()
+		if strings.TrimSpace(row) == "" || strings.HasPrefix(row, "#") {
+			continue
+		}
+
+		city, names, err := parseGeoNamesRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidGeoNamesData, line, err)
+		}
+		provider.add(city, names)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read GeoNames cities: %w", err)
+	}
+
+	if len(provider.cities) == 0 {
+		return nil, fmt.Errorf("%w: no cities", ErrInvalidGeoNamesData)
+	}
+
+	return provider, nil
+}
+
+// GetName returns the provider name
+func (p *GeoNamesProvider) GetName() string {
+	return "GeoNames"
+}
+
+// Geocode resolves the city of an address. Address parts are matched from last to first, so the city
+// wins over a street named after another city, and a two-letter country code in the address picks
+// between cities sharing a name before population does.
+func (p *GeoNamesProvider) Geocode(ctx context.Context, address string) (*GeocodingResult, error) {
+	parts := strings.Split(address, ",")
+
+	countryCodes := make(map[string]bool)
+	for _, part := range parts {
+		if code := strings.TrimSpace(part); len(code) == 2 {
+			countryCodes[strings.ToUpper(code)] = true
+		}
+	}
+
+	for i := len(parts) - 1; i >= 0; i-- {
+		candidates := p.byName[geoNamesKey(parts[i])]
+		if len(candidates) == 0 {
+			continue
+		}
+
+		best := p.cities[candidates[0]]
+		for _, index := range candidates[1:] {
+			city := p.cities[index]
+			if countryCodes[city.countryCode] != countryCodes[best.countryCode] {
+				if countryCodes[city.countryCode] {
+					best = city
+				}
+				continue
+			}
+			if city.population > best.population {
+				best = city
+			}
+		}
+
+		return best.toResult(best.latitude, best.longitude), nil
+	}
+
+	return nil, ErrAddressNotFound
+}
+
+// ReverseGeocode resolves coordinates to the nearest city
+func (p *GeoNamesProvider) ReverseGeocode(ctx context.Context, lat, lon float64) (*GeocodingResult, error) {
+	point := domain.Coordinates{Latitude: lat, Longitude: lon}
+
+	nearest := -1
+	var nearestKm float64
+	for i, city := range p.cities {
+		distance := p.geospatial.CalculateDistance(point, domain.Coordinates{Latitude: city.latitude, Longitude: city.longitude})
+		if nearest < 0 || distance < nearestKm {
+			nearest = i
+			nearestKm = distance
+		}
+	}
+
+	if nearest < 0 {
+		return nil, ErrAddressNotFound
+	}
+
+	return p.cities[nearest].toResult(lat, lon), nil
+}
+
+// add indexes a city under each of its names
+func (p *GeoNamesProvider) add(city geoNamesCity, names []string) {
+	index := len(p.cities)
+	p.cities = append(p.cities, city)
+
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		key := geoNamesKey(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		p.byName[key] = append(p.byName[key], index)
+	}
+}
+
+// toResult converts a city to a geocoding result at the given coordinates
+func (c geoNamesCity) toResult(lat, lon float64) *GeocodingResult {
+	return &GeocodingResult{
+		Coordinates: domain.Coordinates{
+			Latitude:  lat,
+			Longitude: lon,
+		},
+		Address: domain.Address{
+			City:      c.name,
+			Country:   c.countryCode,
+			Formatted: c.name + ", " + c.countryCode,
+		},
+		Confidence: geoNamesConfidence,
+		Source:     "GeoNames",
+	}
+}
+
+// parseGeoNamesRow parses a row of the GeoNames geoname table: id, name, ASCII name, alternate names,
+// latitude, longitude, feature class and code, country code, cc2, four admin codes, population and more
+func parseGeoNamesRow(row string) (geoNamesCity, []string, error) {
+	fields := strings.Split(row, "\t")
+	if len(fields) < 15 {
+		return geoNamesCity{}, nil, fmt.Errorf("expected at least 15 columns, got %d", len(fields))
+	}
+
+	latitude, err := strconv.ParseFloat(fields[4], 64)
+	if err != nil {
+		return geoNamesCity{}, nil, fmt.Errorf("invalid latitude %q", fields[4])
+	}
+	longitude, err := strconv.ParseFloat(fields[5], 64)
+	if err != nil {
+		return geoNamesCity{}, nil, fmt.Errorf("invalid longitude %q", fields[5])
+	}
+	if !domain.IsValidCoordinates(latitude, longitude) {
+		return geoNamesCity{}, nil, domain.ErrInvalidCoordinates
+	}
+
+	var population int64
+	if fields[14] != "" {
+		if population, err = strconv.ParseInt(fields[14], 10, 64); err != nil {
+			return geoNamesCity{}, nil, fmt.Errorf("invalid population %q", fields[14])
+		}
+	}
+
+	city := geoNamesCity{
+		name:        fields[1],
+		countryCode: strings.ToUpper(fields[8]),
+		latitude:    latitude,
+		longitude:   longitude,
+		population:  population,
+	}
+
+	names := []string{fields[1], fields[2]}
+	if fields[3] != "" {
+		names = append(names, strings.Split(fields[3], ",")...)
+	}
+
+	return city, names, nil
+}
+
+// geoNamesKey normalizes a place name for lookups, dropping words with digits such as postal codes
+func geoNamesKey(name string) string {
+	words := strings.Fields(domain.NormalizeVenueName(name))
+
+	kept := words[:0]
+	for _, word := range words {
+		if !strings.ContainsAny(word, "0123456789") {
+			kept = append(kept, word)
+		}
+	}
+	return strings.Join(kept, " ")
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// geoNamesTestCities holds rows in the GeoNames dump format, trimmed to the columns the provider reads
+var geoNamesTestCities = strings.Join([]string{
+	"# geonameid\tname\tasciiname\talternatenames\tlatitude\tlongitude\tclass\tcode\tcountry\tcc2\tadmin1\tadmin2\tadmin3\tadmin4\tpopulation\televation\tdem\ttimezone\tmodified",
+	"2735943\tPorto\tPorto\tOporto,Portus Cale\t41.14961\t-8.61099\tP\tPPLA\tPT\t\t17\t\t\t\t249633\t\t94\tEurope/Lisbon\t2022-04-12",
+	"2267057\tLisbon\tLisbon\tLisboa,Lissabon\t38.72509\t-9.1498\tP\tPPLC\tPT\t\t14\t\t\t\t517802\t\t45\tEurope/Lisbon\t2022-03-08",
+	"3117735\tMadrid\tMadrid\t\t40.4165\t-3.70256\tP\tPPLC\tES\t\t29\tM\t28079\t\t3255944\t\t665\tEurope/Madrid\t2022-03-08",
+	"3451190\tPorto Alegre\tPorto Alegre\t\t-30.03283\t-51.23019\tP\tPPLA\tBR\t\t23\t\t\t\t1372741\t\t10\tAmerica/Sao_Paulo\t2022-03-08",
+	"4166638\tOrlando\tOrlando\t\t28.53834\t-81.37924\tP\tPPLA2\tUS\t\tFL\t095\t\t\t307573\t\t32\tAmerica/New_York\t2022-03-08",
+	"3173435\tOrlando\tOrlando\t\t44.2\t10.9\tP\tPPL\tIT\t\t05\t\t\t\t1200\t\t32\tEurope/Rome\t2022-03-08",
+	"",
+}, "\n")
+
+func TestGeoNamesProvider_Geocode(t *testing.T) {
+	provider, err := NewGeoNamesProvider(strings.NewReader(geoNamesTestCities))
+	if err != nil {
+		t.Fatalf("Failed to load cities: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		address       string
+		expectedCity  string
+		expectedError error
+	}{
+		{"city name", "Porto", "Porto", nil},
+		{"full address with postal code", "Rua de Santa Catarina 123, 4000-447 Porto, Portugal", "Porto", nil},
+		{"alternate name with accents and case", "Praça do Comércio, LISBOA", "Lisbon", nil},
+		{"most populous city shares the name", "Orlando", "Orlando", nil},
+		{"country code picks between same names", "Via Roma 1, Orlando, IT", "Orlando", nil},
+		{"unknown place", "Somewhere Else", "", ErrAddressNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := provider.Geocode(context.Background(), tt.address)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Address.City != tt.expectedCity {
+				t.Errorf("Expected %s, got %s", tt.expectedCity, result.Address.City)
+			}
+			if result.Source != "GeoNames" || result.Confidence != geoNamesConfidence {
+				t.Errorf("Expected a city-level GeoNames result, got confidence %v from %s", result.Confidence, result.Source)
+			}
+		})
+	}
+
+	result, _ := provider.Geocode(context.Background(), "Orlando")
+	if result.Address.Country != "US" {
+		t.Errorf("Expected the more populous Orlando, US, got %s", result.Address.Country)
+	}
+	result, _ = provider.Geocode(context.Background(), "Orlando, IT")
+	if result.Address.Country != "IT" {
+		t.Errorf("Expected Orlando, IT, got %s", result.Address.Country)
+	}
+}
+
+func TestGeoNamesProvider_ReverseGeocode(t *testing.T) {
+	provider, err := NewGeoNamesProvider(strings.NewReader(geoNamesTestCities))
+	if err != nil {
+		t.Fatalf("Failed to load cities: %v", err)
+	}
+
+	result, err := provider.ReverseGeocode(context.Background(), 41.1579, -8.6291)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Address.Formatted != "Porto, PT" {
+		t.Errorf("Expected Porto, PT, got %s", result.Address.Formatted)
+	}
+	if result.Coordinates.Latitude != 41.1579 || result.Coordinates.Longitude != -8.6291 {
+		t.Errorf("Expected the looked up coordinates to be kept, got %v", result.Coordinates)
+	}
+}
+
+func TestLoadGeoNamesProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cities15000.txt")
+	if err := os.WriteFile(path, []byte(geoNamesTestCities), 0o600); err != nil {
+		t.Fatalf("Failed to write cities: %v", err)
+	}
+
+	provider, err := LoadGeoNamesProvider(path)
+	if err != nil {
+		t.Fatalf("Failed to load cities: %v", err)
+	}
+	if len(provider.cities) != 6 {
+		t.Errorf("Expected 6 cities, got %d", len(provider.cities))
+	}
+
+	if _, err := LoadGeoNamesProvider(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+
+	invalid := []string{
+		"",
+		"2735943\tPorto\tPorto",
+		"2735943\tPorto\tPorto\t\tnorth\t-8.61099\tP\tPPLA\tPT\t\t17\t\t\t\t249633",
+		"2735943\tPorto\tPorto\t\t141.1\t-8.61099\tP\tPPLA\tPT\t\t17\t\t\t\t249633",
+	}
+	for _, data := range invalid {
+		if _, err := NewGeoNamesProvider(strings.NewReader(data)); !errors.Is(err, ErrInvalidGeoNamesData) {
+			t.Errorf("Expected ErrInvalidGeoNamesData for %q, got %v", data, err)
+		}
+	}
+}
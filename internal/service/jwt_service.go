@@ -23,9 +23,18 @@ var (
 type TokenClaims struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email"`
+	// Role is the user's platform role when the token was issued
+	Role string `json:"role,omitempty"`
+	// ImpersonatorID is set on support tokens an admin obtained to act as the user
+	ImpersonatorID string `json:"impersonator_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IsImpersonated checks if the token was issued to an admin acting as the user
+func (c *TokenClaims) IsImpersonated() bool {
+	return c.ImpersonatorID != ""
+}
+
 // TokenPair represents access and refresh tokens
 type TokenPair struct {
 	AccessToken  string    `json:"access_token"`
@@ -104,6 +113,11 @@ func NewJWTService(config JWTConfig) (*JWTService, error) {
 
 // GenerateTokenPair creates a new access and refresh token pair
 func (j *JWTService) GenerateTokenPair(userID, email string) (*TokenPair, error) {
+	return j.GenerateTokenPairWithRole(userID, email, "")
+}
+
+// GenerateTokenPairWithRole creates a new access and refresh token pair carrying the user's platform role
+func (j *JWTService) GenerateTokenPairWithRole(userID, email, role string) (*TokenPair, error) {
 	now := time.Now()
 	accessTokenID := uuid.New().String()
 	refreshTokenID := uuid.New().String()
@@ -112,6 +126,7 @@ func (j *JWTService) GenerateTokenPair(userID, email string) (*TokenPair, error)
 	accessClaims := TokenClaims{
 		UserID: userID,
 		Email:  email,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        accessTokenID,
 			Subject:   userID,
@@ -133,6 +148,7 @@ func (j *JWTService) GenerateTokenPair(userID, email string) (*TokenPair, error)
 	refreshClaims := TokenClaims{
 		UserID: userID,
 		Email:  email,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        refreshTokenID,
 			Subject:   userID,
@@ -184,7 +200,37 @@ func (j *JWTService) RefreshTokens(refreshTokenString string) (*TokenPair, error
 	}
 
 	// Generate new token pair
-	return j.GenerateTokenPair(claims.UserID, claims.Email)
+	return j.GenerateTokenPairWithRole(claims.UserID, claims.Email, claims.Role)
+}
+
+// GenerateImpersonationToken creates a short-lived access token that lets an admin act as a user.
+// No refresh token is issued, so the session ends when the access token expires.
+func (j *JWTService) GenerateImpersonationToken(userID, email, impersonatorID string) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(j.accessTTL)
+
+	claims := TokenClaims{
+		UserID:         userID,
+		Email:          email,
+		ImpersonatorID: impersonatorID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    "matchtcg-backend",
+			Audience:  []string{"matchtcg-app"},
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tokenString, err := token.SignedString(j.privateKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign impersonation token: %w", err)
+	}
+
+	return tokenString, expiresAt, nil
 }
 
 // BlacklistToken adds a token to the blacklist
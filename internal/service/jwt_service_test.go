@@ -124,6 +124,47 @@ func TestJWTService_RefreshTokens(t *testing.T) {
 	assert.ErrorIs(t, err, ErrTokenBlacklisted)
 }
 
+func TestJWTService_RoleClaims(t *testing.T) {
+	jwtService, err := NewJWTService(JWTConfig{})
+	require.NoError(t, err)
+
+	tokenPair, err := jwtService.GenerateTokenPairWithRole("admin-id", "admin@example.com", "admin")
+	require.NoError(t, err)
+
+	claims, err := jwtService.ValidateAccessToken(tokenPair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", claims.Role)
+	assert.False(t, claims.IsImpersonated())
+
+	// The role survives a refresh
+	refreshed, err := jwtService.RefreshTokens(tokenPair.RefreshToken)
+	require.NoError(t, err)
+
+	claims, err = jwtService.ValidateAccessToken(refreshed.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", claims.Role)
+}
+
+func TestJWTService_GenerateImpersonationToken(t *testing.T) {
+	jwtService, err := NewJWTService(JWTConfig{})
+	require.NoError(t, err)
+
+	token, expiresAt, err := jwtService.GenerateImpersonationToken("user-id", "user@example.com", "admin-id")
+	require.NoError(t, err)
+	assert.True(t, expiresAt.After(time.Now()))
+
+	claims, err := jwtService.ValidateAccessToken(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", claims.UserID)
+	assert.Equal(t, "admin-id", claims.ImpersonatorID)
+	assert.Empty(t, claims.Role)
+	assert.True(t, claims.IsImpersonated())
+
+	// Impersonation tokens cannot be used to mint long-lived sessions
+	_, err = jwtService.ValidateRefreshToken(token)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
 func TestJWTService_BlacklistToken(t *testing.T) {
 	blacklistStore := NewInMemoryBlacklistStore(time.Hour)
 	defer blacklistStore.Close()
@@ -17,6 +17,12 @@ type NotificationService struct {
 	userRepo         repository.UserRepository
 	emailService     *EmailService
 	templateManager  *NotificationTemplateManager
+	consentChecker   ConsentChecker
+}
+
+// ConsentChecker reports whether a user currently grants a type of consent
+type ConsentChecker interface {
+	HasValidConsent(ctx context.Context, userID uuid.UUID, consentType domain.ConsentType) (bool, error)
 }
 
 // NewNotificationService creates a new notification service
@@ -34,6 +40,11 @@ func NewNotificationService(
 	}
 }
 
+// SetConsentChecker enables consent checks for notifications that require consent, such as marketing digests
+func (s *NotificationService) SetConsentChecker(checker ConsentChecker) {
+	s.consentChecker = checker
+}
+
 // CreateNotification creates a new notification
 func (s *NotificationService) CreateNotification(ctx context.Context, userID uuid.UUID, notificationType domain.NotificationType, payload map[string]interface{}, scheduledAt time.Time) (*domain.Notification, error) {
 	notification := &domain.Notification{
@@ -82,6 +93,16 @@ func (s *NotificationService) SendNotification(ctx context.Context, notification
 		return s.notificationRepo.Update(ctx, notification)
 	}
 
+	// Check that the user has consented to notifications that need it
+	hasConsent, err := s.hasRequiredConsent(ctx, notification)
+	if err != nil {
+		return fmt.Errorf("failed to check consent: %w", err)
+	}
+	if !hasConsent {
+		notification.MarkAsCancelled()
+		return s.notificationRepo.Update(ctx, notification)
+	}
+
 	// Render email template
 	subject, htmlBody, textBody, err := s.templateManager.RenderTemplate(notification.Type, notification.Payload)
 	if err != nil {
@@ -190,6 +211,21 @@ func (s *NotificationService) CleanupOldNotifications(ctx context.Context, older
 	return s.notificationRepo.DeleteOldNotifications(ctx, cutoffTime)
 }
 
+// hasRequiredConsent checks the consent a notification requires. Without a configured checker,
+// notifications that require consent are never sent.
+func (s *NotificationService) hasRequiredConsent(ctx context.Context, notification *domain.Notification) (bool, error) {
+	consentType, required := notification.RequiredConsent()
+	if !required {
+		return true, nil
+	}
+
+	if s.consentChecker == nil {
+		return false, nil
+	}
+
+	return s.consentChecker.HasValidConsent(ctx, notification.UserID, consentType)
+}
+
 // shouldSendNotification checks if a user wants to receive a specific type of notification
 func (s *NotificationService) shouldSendNotification(profile *domain.Profile, notificationType domain.NotificationType) bool {
 	if profile == nil || profile.CommunicationPreferences == nil {
@@ -231,6 +267,30 @@ func (s *NotificationService) shouldSendNotification(profile *domain.Profile, no
 				return enabled
 			}
 		}
+	case domain.NotificationTypeGroupJoinRequest, domain.NotificationTypeGroupJoinRequestReviewed:
+		if val, exists := prefs["group_join_requests"]; exists {
+			if enabled, ok := val.(bool); ok {
+				return enabled
+			}
+		}
+	case domain.NotificationTypeGroupAnnouncement:
+		if val, exists := prefs["group_announcements"]; exists {
+			if enabled, ok := val.(bool); ok {
+				return enabled
+			}
+		}
+	case domain.NotificationTypeEventCoHostInvite, domain.NotificationTypeEventCoHostResponse:
+		if val, exists := prefs["event_cohosts"]; exists {
+			if enabled, ok := val.(bool); ok {
+				return enabled
+			}
+		}
+	case domain.NotificationTypeEventInvite:
+		if val, exists := prefs["event_invites"]; exists {
+			if enabled, ok := val.(bool); ok {
+				return enabled
+			}
+		}
 	}
 
 	// Default to enabled if preference not found
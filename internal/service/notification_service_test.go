@@ -91,6 +91,31 @@ func (m *mockNotificationRepository) GetFailedNotifications(ctx context.Context,
 	return result, nil
 }
 
+func (m *mockNotificationRepository) GetNotificationsByStatus(ctx context.Context, status domain.NotificationStatus, limit, offset int) ([]*domain.Notification, error) {
+	var result []*domain.Notification
+	for _, notification := range m.notifications {
+		if notification.Status == status {
+			result = append(result, notification)
+		}
+	}
+	if offset >= len(result) {
+		return nil, nil
+	}
+	result = result[offset:]
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *mockNotificationRepository) CountByStatus(ctx context.Context) (map[domain.NotificationStatus]int, error) {
+	counts := make(map[domain.NotificationStatus]int)
+	for _, notification := range m.notifications {
+		counts[notification.Status]++
+	}
+	return counts, nil
+}
+
 func (m *mockNotificationRepository) MarkAsSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
 	if notification, exists := m.notifications[id]; exists {
 		notification.MarkAsSent()
@@ -448,6 +473,62 @@ func TestNotificationService(t *testing.T) {
 			t.Error("Expected notification to be marked as cancelled")
 		}
 	})
+
+	t.Run("MarketingDigestRequiresConsent", func(t *testing.T) {
+		payload := map[string]interface{}{
+			"UserName":      "Test User",
+			"DigestTitle":   "This week on MatchTCG",
+			"DigestContent": "New events near you",
+		}
+
+		checker := &mockConsentChecker{consents: map[uuid.UUID]bool{}}
+		service.SetConsentChecker(checker)
+		defer service.SetConsentChecker(nil)
+
+		notification, err := service.CreateNotification(ctx, userID, domain.NotificationTypeMarketingDigest, payload, time.Now())
+		if err != nil {
+			t.Fatalf("Expected no error creating notification, got %v", err)
+		}
+
+		emailProvider.Reset()
+		if err := service.SendNotification(ctx, notification); err != nil {
+			t.Fatalf("Expected no error sending notification, got %v", err)
+		}
+
+		if emailProvider.GetEmailCount() != 0 {
+			t.Errorf("Expected no digest without marketing consent, got %d emails", emailProvider.GetEmailCount())
+		}
+		if !notification.IsCancelled() {
+			t.Error("Expected digest without consent to be cancelled")
+		}
+
+		checker.consents[userID] = true
+		notification, err = service.CreateNotification(ctx, userID, domain.NotificationTypeMarketingDigest, payload, time.Now())
+		if err != nil {
+			t.Fatalf("Expected no error creating notification, got %v", err)
+		}
+
+		if err := service.SendNotification(ctx, notification); err != nil {
+			t.Fatalf("Expected no error sending notification, got %v", err)
+		}
+
+		if emailProvider.GetEmailCount() != 1 {
+			t.Errorf("Expected digest to be sent after consent, got %d emails", emailProvider.GetEmailCount())
+		}
+		if checker.lastConsentType != domain.ConsentTypeMarketingEmails {
+			t.Errorf("Expected marketing email consent to be checked, got %q", checker.lastConsentType)
+		}
+	})
+}
+
+type mockConsentChecker struct {
+	consents        map[uuid.UUID]bool
+	lastConsentType domain.ConsentType
+}
+
+func (m *mockConsentChecker) HasValidConsent(ctx context.Context, userID uuid.UUID, consentType domain.ConsentType) (bool, error) {
+	m.lastConsentType = consentType
+	return m.consents[userID], nil
 }
 
 func TestNotificationScheduler(t *testing.T) {
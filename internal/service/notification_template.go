@@ -134,6 +134,76 @@ func (m *NotificationTemplateManager) initializeTemplates() {
 		TextBody: groupEventTextTemplate,
 	}
 
+	// Group Join Request Template
+	m.templates[domain.NotificationTypeGroupJoinRequest] = &NotificationTemplate{
+		Subject:  "{{.RequesterName}} wants to join {{.GroupName}}",
+		HTMLBody: groupJoinRequestHTMLTemplate,
+		TextBody: groupJoinRequestTextTemplate,
+	}
+
+	// Group Join Request Reviewed Template
+	m.templates[domain.NotificationTypeGroupJoinRequestReviewed] = &NotificationTemplate{
+		Subject:  "Your request to join {{.GroupName}} was {{.Decision}}",
+		HTMLBody: groupJoinRequestReviewedHTMLTemplate,
+		TextBody: groupJoinRequestReviewedTextTemplate,
+	}
+
+	// Group Announcement Template
+	m.templates[domain.NotificationTypeGroupAnnouncement] = &NotificationTemplate{
+		Subject:  "[{{.GroupName}}] {{.AnnouncementTitle}}",
+		HTMLBody: groupAnnouncementHTMLTemplate,
+		TextBody: groupAnnouncementTextTemplate,
+	}
+
+	// Event Co-Host Invite Template
+	m.templates[domain.NotificationTypeEventCoHostInvite] = &NotificationTemplate{
+		Subject:  "{{.InviterName}} invited you to co-host {{.EventTitle}}",
+		HTMLBody: eventCoHostInviteHTMLTemplate,
+		TextBody: eventCoHostInviteTextTemplate,
+	}
+
+	// Event Co-Host Response Template
+	m.templates[domain.NotificationTypeEventCoHostResponse] = &NotificationTemplate{
+		Subject:  "{{.CoHostName}} {{.Decision}} the invitation to co-host {{.EventTitle}}",
+		HTMLBody: eventCoHostResponseHTMLTemplate,
+		TextBody: eventCoHostResponseTextTemplate,
+	}
+
+	// Private Event Invite Template
+	m.templates[domain.NotificationTypeEventInvite] = &NotificationTemplate{
+		Subject:  "{{.InviterName}} invited you to {{.EventTitle}}",
+		HTMLBody: eventInviteHTMLTemplate,
+		TextBody: eventInviteTextTemplate,
+	}
+
+	// Marketing Digest Template
+	m.templates[domain.NotificationTypeMarketingDigest] = &NotificationTemplate{
+		Subject:  "{{.DigestTitle}}",
+		HTMLBody: marketingDigestHTMLTemplate,
+		TextBody: marketingDigestTextTemplate,
+	}
+
+	// Data Export Ready Template
+	m.templates[domain.NotificationTypeDataExportReady] = &NotificationTemplate{
+		Subject:  "Your MatchTCG data export is ready",
+		HTMLBody: dataExportReadyHTMLTemplate,
+		TextBody: dataExportReadyTextTemplate,
+	}
+
+	// Event Cancelled Template
+	m.templates[domain.NotificationTypeEventCancelled] = &NotificationTemplate{
+		Subject:  "Event Cancelled: {{.EventTitle}}",
+		HTMLBody: eventCancelledHTMLTemplate,
+		TextBody: eventCancelledTextTemplate,
+	}
+
+	// Account Deletion Scheduled Template
+	m.templates[domain.NotificationTypeAccountDeletionScheduled] = &NotificationTemplate{
+		Subject:  "Your MatchTCG account will be deleted on {{.ScheduledFor}}",
+		HTMLBody: accountDeletionScheduledHTMLTemplate,
+		TextBody: accountDeletionScheduledTextTemplate,
+	}
+
 	// Compile templates
 	for _, tmpl := range m.templates {
 		if tmpl.HTMLBody != "" {
@@ -230,10 +300,10 @@ const eventUpdateHTMLTemplate = `
         
         <p>The event <strong>{{.EventTitle}}</strong> that you're attending has been updated.</p>
         
-        <div style="background-color: #fff3cd; padding: 20px; border-radius: 5px; margin: 20px 0; border-left: 4px solid #ffc107;">
+        {{if .UpdateMessage}}<div style="background-color: #fff3cd; padding: 20px; border-radius: 5px; margin: 20px 0; border-left: 4px solid #ffc107;">
             <h3 style="margin-top: 0;">What Changed</h3>
             <p>{{.UpdateMessage}}</p>
-        </div>
+        </div>{{end}}
         
         <div style="background-color: #f8f9fa; padding: 20px; border-radius: 5px; margin: 20px 0;">
             <h3 style="margin-top: 0;">Current Event Details</h3>
@@ -264,10 +334,10 @@ Event Updated
 Hi {{.UserName}},
 
 The event {{.EventTitle}} that you're attending has been updated.
-
+{{if .UpdateMessage}}
 What Changed:
 {{.UpdateMessage}}
-
+{{end}}
 Current Event Details:
 - Event: {{.EventTitle}}
 - Date: {{.EventDate}}
@@ -466,3 +536,464 @@ Don't miss out on this group event!
 This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
 you can update your preferences in your account settings.
 `
+
+const groupJoinRequestHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>New Join Request</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #8e44ad;">New Join Request</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p><strong>{{.RequesterName}}</strong> has asked to join your group <strong>{{.GroupName}}</strong>.</p>
+        
+        {{if .RequestMessage}}<div style="background-color: #f8f9fa; padding: 20px; border-radius: 5px; margin: 20px 0;">
+            <h3 style="margin-top: 0;">Message</h3>
+            <p>{{.RequestMessage}}</p>
+        </div>{{end}}
+        
+        <p><a href="{{.BaseURL}}/groups/{{.GroupID}}/join-requests" style="background-color: #8e44ad; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">Review Request</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+            you can update your preferences in your account settings.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const groupJoinRequestTextTemplate = `
+New Join Request
+
+Hi {{.UserName}},
+
+{{.RequesterName}} has asked to join your group {{.GroupName}}.
+{{if .RequestMessage}}
+Message: {{.RequestMessage}}
+{{end}}
+Review Request: {{.BaseURL}}/groups/{{.GroupID}}/join-requests
+
+---
+This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+you can update your preferences in your account settings.
+`
+
+const groupJoinRequestReviewedHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Join Request Update</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #8e44ad;">Join Request Update</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        {{if .Approved}}<p>Your request to join <strong>{{.GroupName}}</strong> has been approved. Welcome to the group!</p>
+        
+        <p><a href="{{.BaseURL}}/groups/{{.GroupID}}" style="background-color: #8e44ad; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">View Group</a></p>{{else}}<p>Your request to join <strong>{{.GroupName}}</strong> was not approved this time.</p>
+        
+        <p>There are plenty of other groups to discover on MatchTCG!</p>{{end}}
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+            you can update your preferences in your account settings.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const groupJoinRequestReviewedTextTemplate = `
+Join Request Update
+
+Hi {{.UserName}},
+{{if .Approved}}
+Your request to join {{.GroupName}} has been approved. Welcome to the group!
+
+View Group: {{.BaseURL}}/groups/{{.GroupID}}
+{{else}}
+Your request to join {{.GroupName}} was not approved this time.
+
+There are plenty of other groups to discover on MatchTCG!
+{{end}}
+---
+This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+you can update your preferences in your account settings.
+`
+
+const groupAnnouncementHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Group Announcement</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #8e44ad;">{{.AnnouncementTitle}}</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p><strong>{{.AuthorName}}</strong> posted an announcement in <strong>{{.GroupName}}</strong>:</p>
+        
+        <div style="background-color: #f8f9fa; padding: 20px; border-radius: 5px; margin: 20px 0; white-space: pre-wrap;">{{.AnnouncementBody}}</div>
+        
+        <p><a href="{{.BaseURL}}/groups/{{.GroupID}}/announcements" style="background-color: #8e44ad; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">View Announcements</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+            you can update your preferences in your account settings.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const groupAnnouncementTextTemplate = `
+{{.AnnouncementTitle}}
+
+Hi {{.UserName}},
+
+{{.AuthorName}} posted an announcement in {{.GroupName}}:
+
+{{.AnnouncementBody}}
+
+View Announcements: {{.BaseURL}}/groups/{{.GroupID}}/announcements
+
+---
+This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+you can update your preferences in your account settings.
+`
+
+const eventCoHostInviteHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Co-Host Invitation</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #8e44ad;">You're invited to co-host!</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p><strong>{{.InviterName}}</strong> would like you to help run <strong>{{.EventTitle}}</strong>.</p>
+        
+        <div style="background-color: #f8f9fa; padding: 20px; border-radius: 5px; margin: 20px 0;">
+            <p><strong>Date:</strong> {{.EventDate}} at {{.EventTime}}</p>
+            <p><strong>You will be able to:</strong> {{.Permissions}}</p>
+        </div>
+        
+        <p><a href="{{.BaseURL}}/events/{{.EventID}}" style="background-color: #8e44ad; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">Respond to Invitation</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+            you can update your preferences in your account settings.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const eventCoHostInviteTextTemplate = `
+You're invited to co-host!
+
+Hi {{.UserName}},
+
+{{.InviterName}} would like you to help run {{.EventTitle}}.
+
+Date: {{.EventDate}} at {{.EventTime}}
+You will be able to: {{.Permissions}}
+
+Respond to Invitation: {{.BaseURL}}/events/{{.EventID}}
+
+---
+This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+you can update your preferences in your account settings.
+`
+
+const eventCoHostResponseHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Co-Host Invitation {{.Decision}}</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #8e44ad;">Co-host invitation {{.Decision}}</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p><strong>{{.CoHostName}}</strong> {{.Decision}} the invitation to co-host <strong>{{.EventTitle}}</strong>.</p>
+        
+        <p><a href="{{.BaseURL}}/events/{{.EventID}}" style="background-color: #8e44ad; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">View Event</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+            you can update your preferences in your account settings.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const eventCoHostResponseTextTemplate = `
+Co-host invitation {{.Decision}}
+
+Hi {{.UserName}},
+
+{{.CoHostName}} {{.Decision}} the invitation to co-host {{.EventTitle}}.
+
+View Event: {{.BaseURL}}/events/{{.EventID}}
+
+---
+This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+you can update your preferences in your account settings.
+`
+
+const eventInviteHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Event Invitation</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #8e44ad;">You're on the guest list!</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p><strong>{{.InviterName}}</strong> invited you to the private event <strong>{{.EventTitle}}</strong>.</p>
+        
+        <div style="background-color: #f8f9fa; padding: 20px; border-radius: 5px; margin: 20px 0;">
+            <p><strong>Date:</strong> {{.EventDate}} at {{.EventTime}}</p>
+            {{if .VenueName}}<p><strong>Location:</strong> {{.VenueName}}<br>{{.VenueAddress}}</p>{{end}}
+        </div>
+        
+        <p><a href="{{.BaseURL}}/events/{{.EventID}}" style="background-color: #8e44ad; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">RSVP Now</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+            you can update your preferences in your account settings.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const eventInviteTextTemplate = `
+You're on the guest list!
+
+Hi {{.UserName}},
+
+{{.InviterName}} invited you to the private event {{.EventTitle}}.
+
+Date: {{.EventDate}} at {{.EventTime}}
+{{if .VenueName}}Location: {{.VenueName}}, {{.VenueAddress}}{{end}}
+
+RSVP Now: {{.BaseURL}}/events/{{.EventID}}
+
+---
+This email was sent by MatchTCG. If you no longer wish to receive these notifications, 
+you can update your preferences in your account settings.
+`
+
+const marketingDigestHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>{{.DigestTitle}}</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #2c3e50;">{{.DigestTitle}}</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <div style="background-color: #f8f9fa; padding: 20px; border-radius: 5px; margin: 20px 0;">
+            <p>{{.DigestContent}}</p>
+        </div>
+        
+        <p><a href="{{.BaseURL}}/events" style="background-color: #2c3e50; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">Find Events</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            You are receiving this email because you opted in to marketing emails from MatchTCG. 
+            You can withdraw your consent at any time in your account settings.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const marketingDigestTextTemplate = `
+{{.DigestTitle}}
+
+Hi {{.UserName}},
+
+{{.DigestContent}}
+
+Find Events: {{.BaseURL}}/events
+
+---
+You are receiving this email because you opted in to marketing emails from MatchTCG. 
+You can withdraw your consent at any time in your account settings.
+`
+
+const dataExportReadyHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Your Data Export Is Ready</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #2c3e50;">Your data export is ready</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p>The archive of your MatchTCG data you requested is ready to download. It contains your profile, 
+        events, RSVPs, group memberships, notifications, consents and account history as JSON and CSV files.</p>
+        
+        <p><a href="{{.DownloadURL}}" style="background-color: #2c3e50; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">Download Archive</a></p>
+        
+        <p>This link expires on {{.ExpiresAt}}. After that you can request a new export from your account settings.</p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG because a data export was requested for your account. 
+            If you did not request it, please change your password.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const dataExportReadyTextTemplate = `
+Your data export is ready
+
+Hi {{.UserName}},
+
+The archive of your MatchTCG data you requested is ready to download. It contains your profile, 
+events, RSVPs, group memberships, notifications, consents and account history as JSON and CSV files.
+
+Download Archive: {{.DownloadURL}}
+
+This link expires on {{.ExpiresAt}}. After that you can request a new export from your account settings.
+
+---
+This email was sent by MatchTCG because a data export was requested for your account. 
+If you did not request it, please change your password.
+`
+
+const eventCancelledHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Event Cancelled</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #c0392b;">Event Cancelled</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p>Unfortunately <strong>{{.EventTitle}}</strong> on {{.EventDate}} at {{.EventTime}} has been cancelled.</p>
+        
+        {{if .Reason}}<div style="background-color: #f8f9fa; padding: 20px; border-radius: 5px; margin: 20px 0;">
+            <p style="margin: 0;">{{.Reason}}</p>
+        </div>{{end}}
+        
+        <p><a href="{{.BaseURL}}/events" style="background-color: #3498db; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">Find Another Event</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG because you RSVPed to this event.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const eventCancelledTextTemplate = `
+Event Cancelled
+
+Hi {{.UserName}},
+
+Unfortunately {{.EventTitle}} on {{.EventDate}} at {{.EventTime}} has been cancelled.
+{{if .Reason}}
+{{.Reason}}
+{{end}}
+Find Another Event: {{.BaseURL}}/events
+
+---
+This email was sent by MatchTCG because you RSVPed to this event.
+`
+
+const accountDeletionScheduledHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Account Deletion Scheduled</title>
+</head>
+<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
+    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
+        <h1 style="color: #c0392b;">Your account is scheduled for deletion</h1>
+        
+        <p>Hi {{.UserName}},</p>
+        
+        <p>You haven't signed in to MatchTCG for a long time, so under our data retention policy your account 
+        and personal data will be deleted on <strong>{{.ScheduledFor}}</strong>.</p>
+        
+        <p>If you want to keep your account, sign in and cancel the deletion before then.</p>
+        
+        <p><a href="{{.BaseURL}}/account/deletion" style="background-color: #2c3e50; color: white; padding: 10px 20px; text-decoration: none; border-radius: 5px;">Keep My Account</a></p>
+        
+        <hr style="margin: 30px 0; border: none; border-top: 1px solid #eee;">
+        <p style="font-size: 12px; color: #666;">
+            This email was sent by MatchTCG because your account has been inactive. 
+            If you do nothing, your account will be deleted and this is the last email you will receive from us.
+        </p>
+    </div>
+</body>
+</html>
+`
+
+const accountDeletionScheduledTextTemplate = `
+Your account is scheduled for deletion
+
+Hi {{.UserName}},
+
+You haven't signed in to MatchTCG for a long time, so under our data retention policy your account 
+and personal data will be deleted on {{.ScheduledFor}}.
+
+If you want to keep your account, sign in and cancel the deletion before then.
+
+Keep My Account: {{.BaseURL}}/account/deletion
+
+---
+This email was sent by MatchTCG because your account has been inactive. 
+If you do nothing, your account will be deleted and this is the last email you will receive from us.
+`
@@ -266,6 +266,35 @@ func TestNotificationTemplateManager(t *testing.T) {
 		}
 	})
 
+	t.Run("RenderGroupJoinRequestReviewedTemplate", func(t *testing.T) {
+		data := map[string]interface{}{
+			"UserName":  "Rita Sousa",
+			"GroupName": "Lisbon Lorcana League",
+			"GroupID":   "abc45678-e89b-12d3-a456-426614174005",
+			"Decision":  "approved",
+			"Approved":  true,
+		}
+
+		subject, htmlBody, textBody, err := manager.RenderTemplate(domain.NotificationTypeGroupJoinRequestReviewed, data)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expectedSubject := "Your request to join Lisbon Lorcana League was approved"
+		if subject != expectedSubject {
+			t.Errorf("Expected subject: %s, got %s", expectedSubject, subject)
+		}
+
+		for _, part := range []string{"Rita Sousa", "Lisbon Lorcana League", "Welcome to the group"} {
+			if !strings.Contains(htmlBody, part) {
+				t.Errorf("Expected HTML body to contain '%s', but it didn't", part)
+			}
+			if !strings.Contains(textBody, part) {
+				t.Errorf("Expected text body to contain '%s', but it didn't", part)
+			}
+		}
+	})
+
 	t.Run("RenderWithMissingData", func(t *testing.T) {
 		// Test with minimal data to ensure templates handle missing fields gracefully
 		data := map[string]interface{}{
@@ -300,6 +329,16 @@ func TestNotificationTemplateManager(t *testing.T) {
 			domain.NotificationTypeEventReminder,
 			domain.NotificationTypeGroupInvite,
 			domain.NotificationTypeGroupEvent,
+			domain.NotificationTypeGroupJoinRequest,
+			domain.NotificationTypeGroupJoinRequestReviewed,
+			domain.NotificationTypeGroupAnnouncement,
+			domain.NotificationTypeEventCoHostInvite,
+			domain.NotificationTypeEventCoHostResponse,
+			domain.NotificationTypeEventInvite,
+			domain.NotificationTypeMarketingDigest,
+			domain.NotificationTypeDataExportReady,
+			domain.NotificationTypeEventCancelled,
+			domain.NotificationTypeAccountDeletionScheduled,
 		}
 
 		for _, notType := range notificationTypes {
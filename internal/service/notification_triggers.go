@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/matchtcg/backend/internal/domain"
@@ -15,6 +18,7 @@ type NotificationTriggerService struct {
 	eventRepo           repository.EventRepository
 	groupRepo           repository.GroupRepository
 	userRepo            repository.UserRepository
+	announcer           GroupEventAnnouncer
 }
 
 // NewNotificationTriggerService creates a new notification trigger service
@@ -32,6 +36,11 @@ func NewNotificationTriggerService(
 	}
 }
 
+// SetGroupEventAnnouncer configures where group event announcements are published
+func (s *NotificationTriggerService) SetGroupEventAnnouncer(announcer GroupEventAnnouncer) {
+	s.announcer = announcer
+}
+
 // OnRSVPConfirmation triggers notifications when a user RSVPs to an event
 func (s *NotificationTriggerService) OnRSVPConfirmation(ctx context.Context, eventID, userID uuid.UUID, status domain.RSVPStatus) error {
 	// Get event details
@@ -119,6 +128,13 @@ func (s *NotificationTriggerService) OnEventUpdate(ctx context.Context, eventID
 		}
 	}
 
+	// Announce the update on the group's channels
+	if s.announcer != nil && event.GroupID != nil {
+		if err := s.announcer.AnnounceEventUpdate(ctx, event, updateMessage); err != nil {
+			log.Printf("Failed to announce update of event %s: %v", eventID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -142,10 +158,10 @@ func (s *NotificationTriggerService) OnNewGroupEvent(ctx context.Context, eventI
 		return fmt.Errorf("group not found")
 	}
 
-	// Send notifications to all group members except the host
+	// Send notifications to all group members except the host and co-hosts
 	for _, member := range group.Members {
-		// Skip the event host
-		if member.UserID == event.HostUserID {
+		// Skip the event's organizers
+		if event.IsOrganizer(member.UserID) {
 			continue
 		}
 
@@ -166,6 +182,13 @@ func (s *NotificationTriggerService) OnNewGroupEvent(ctx context.Context, eventI
 		}
 	}
 
+	// Announce the event on the group's channels
+	if s.announcer != nil {
+		if err := s.announcer.AnnounceNewGroupEvent(ctx, event, &group.Group); err != nil {
+			log.Printf("Failed to announce event %s for group %s: %v", eventID, groupID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -210,6 +233,398 @@ func (s *NotificationTriggerService) OnGroupInvite(ctx context.Context, groupID,
 	return nil
 }
 
+// OnGroupJoinRequest notifies the group's owners and admins that a user asked to join
+func (s *NotificationTriggerService) OnGroupJoinRequest(ctx context.Context, groupID, requesterUserID uuid.UUID, message *string) error {
+	// Get group details with members
+	group, err := s.groupRepo.GetByIDWithMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group details: %w", err)
+	}
+	if group == nil {
+		return fmt.Errorf("group not found")
+	}
+
+	// Get requester details
+	requester, err := s.userRepo.GetUserWithProfile(ctx, requesterUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get requester details: %w", err)
+	}
+	if requester == nil {
+		return fmt.Errorf("requester not found")
+	}
+
+	// Send notifications to every member who can approve the request
+	for _, member := range group.Members {
+		if !member.CanManageMembers() {
+			continue
+		}
+
+		// Get user details
+		user, err := s.userRepo.GetUserWithProfile(ctx, member.UserID)
+		if err != nil || user == nil {
+			continue // Skip this user if we can't get their details
+		}
+
+		payload := map[string]interface{}{
+			"UserName":      s.getUserDisplayName(user),
+			"RequesterName": s.getUserDisplayName(requester),
+			"GroupName":     group.Name,
+			"GroupID":       group.ID.String(),
+		}
+		if message != nil {
+			payload["RequestMessage"] = *message
+		}
+
+		err = s.notificationService.CreateImmediateNotification(ctx, member.UserID, domain.NotificationTypeGroupJoinRequest, payload)
+		if err != nil {
+			// Log error but continue with other admins
+			continue
+		}
+	}
+
+	return nil
+}
+
+// OnGroupJoinRequestReviewed notifies a user that their join request was approved or rejected
+func (s *NotificationTriggerService) OnGroupJoinRequestReviewed(ctx context.Context, groupID, requesterUserID uuid.UUID, approved bool) error {
+	// Get group details
+	group, err := s.groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group details: %w", err)
+	}
+	if group == nil {
+		return fmt.Errorf("group not found")
+	}
+
+	// Get requester details
+	requester, err := s.userRepo.GetUserWithProfile(ctx, requesterUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get requester details: %w", err)
+	}
+	if requester == nil {
+		return fmt.Errorf("requester not found")
+	}
+
+	decision := "declined"
+	if approved {
+		decision = "approved"
+	}
+
+	payload := map[string]interface{}{
+		"UserName":  s.getUserDisplayName(requester),
+		"GroupName": group.Name,
+		"GroupID":   group.ID.String(),
+		"Decision":  decision,
+		"Approved":  approved,
+	}
+
+	err = s.notificationService.CreateImmediateNotification(ctx, requesterUserID, domain.NotificationTypeGroupJoinRequestReviewed, payload)
+	if err != nil {
+		return fmt.Errorf("failed to send join request notification: %w", err)
+	}
+
+	return nil
+}
+
+// OnGroupAnnouncement notifies every group member except the author about a new announcement
+func (s *NotificationTriggerService) OnGroupAnnouncement(ctx context.Context, announcement *domain.GroupAnnouncement) error {
+	// Get group details with members
+	group, err := s.groupRepo.GetByIDWithMembers(ctx, announcement.GroupID)
+	if err != nil {
+		return fmt.Errorf("failed to get group details: %w", err)
+	}
+	if group == nil {
+		return fmt.Errorf("group not found")
+	}
+
+	// Get author details
+	author, err := s.userRepo.GetUserWithProfile(ctx, announcement.AuthorUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get author details: %w", err)
+	}
+	if author == nil {
+		return fmt.Errorf("author not found")
+	}
+
+	for _, member := range group.Members {
+		// Skip the author
+		if member.UserID == announcement.AuthorUserID {
+			continue
+		}
+
+		// Get user details
+		user, err := s.userRepo.GetUserWithProfile(ctx, member.UserID)
+		if err != nil || user == nil {
+			continue // Skip this user if we can't get their details
+		}
+
+		payload := map[string]interface{}{
+			"UserName":          s.getUserDisplayName(user),
+			"AuthorName":        s.getUserDisplayName(author),
+			"GroupName":         group.Name,
+			"GroupID":           group.ID.String(),
+			"AnnouncementID":    announcement.ID.String(),
+			"AnnouncementTitle": announcement.Title,
+			"AnnouncementBody":  announcement.Body,
+		}
+
+		// The notification is stored for the in-app feed and emailed when the member's preferences allow it
+		err = s.notificationService.CreateImmediateNotification(ctx, member.UserID, domain.NotificationTypeGroupAnnouncement, payload)
+		if err != nil {
+			// Log error but continue with other members
+			continue
+		}
+	}
+
+	return nil
+}
+
+// OnEventCoHostInvite notifies a user that the host invited them to co-host an event
+func (s *NotificationTriggerService) OnEventCoHostInvite(ctx context.Context, eventID, invitedUserID, inviterUserID uuid.UUID, permissions []domain.Permission) error {
+	// Get event details
+	event, err := s.eventRepo.GetByIDWithDetails(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event details: %w", err)
+	}
+	if event == nil {
+		return fmt.Errorf("event not found")
+	}
+
+	// Get invited user details
+	invitedUser, err := s.userRepo.GetUserWithProfile(ctx, invitedUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get invited user details: %w", err)
+	}
+	if invitedUser == nil {
+		return fmt.Errorf("invited user not found")
+	}
+
+	// Get inviter user details
+	inviterUser, err := s.userRepo.GetUserWithProfile(ctx, inviterUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get inviter user details: %w", err)
+	}
+	if inviterUser == nil {
+		return fmt.Errorf("inviter user not found")
+	}
+
+	payload := map[string]interface{}{
+		"UserName":    s.getUserDisplayName(invitedUser),
+		"InviterName": s.getUserDisplayName(inviterUser),
+		"EventTitle":  event.Title,
+		"EventID":     event.ID.String(),
+		"EventDate":   event.StartAt.Format("2006-01-02"),
+		"EventTime":   event.StartAt.Format("15:04"),
+		"Permissions": s.formatCoHostPermissions(permissions),
+	}
+
+	err = s.notificationService.CreateImmediateNotification(ctx, invitedUserID, domain.NotificationTypeEventCoHostInvite, payload)
+	if err != nil {
+		return fmt.Errorf("failed to send co-host invite notification: %w", err)
+	}
+
+	return nil
+}
+
+// OnEventCoHostResponse notifies the host and the other co-hosts that an invitee accepted or declined
+func (s *NotificationTriggerService) OnEventCoHostResponse(ctx context.Context, eventID, coHostUserID uuid.UUID, accepted bool) error {
+	// Get event details
+	event, err := s.eventRepo.GetByIDWithDetails(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event details: %w", err)
+	}
+	if event == nil {
+		return fmt.Errorf("event not found")
+	}
+
+	// Get co-host details
+	coHost, err := s.userRepo.GetUserWithProfile(ctx, coHostUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get co-host details: %w", err)
+	}
+	if coHost == nil {
+		return fmt.Errorf("co-host not found")
+	}
+
+	decision := "declined"
+	if accepted {
+		decision = "accepted"
+	}
+
+	for _, organizerID := range event.OrganizerUserIDs() {
+		// Skip the co-host who responded
+		if organizerID == coHostUserID {
+			continue
+		}
+
+		// Get organizer details
+		organizer, err := s.userRepo.GetUserWithProfile(ctx, organizerID)
+		if err != nil || organizer == nil {
+			continue // Skip this user if we can't get their details
+		}
+
+		payload := map[string]interface{}{
+			"UserName":   s.getUserDisplayName(organizer),
+			"CoHostName": s.getUserDisplayName(coHost),
+			"EventTitle": event.Title,
+			"EventID":    event.ID.String(),
+			"Decision":   decision,
+			"Accepted":   accepted,
+		}
+
+		err = s.notificationService.CreateImmediateNotification(ctx, organizerID, domain.NotificationTypeEventCoHostResponse, payload)
+		if err != nil {
+			// Log error but continue with other organizers
+			continue
+		}
+	}
+
+	return nil
+}
+
+// OnEventGuestInvite notifies a user who was added to a private event's guest list
+func (s *NotificationTriggerService) OnEventGuestInvite(ctx context.Context, eventID, guestUserID, inviterUserID uuid.UUID) error {
+	// Get event details
+	event, err := s.eventRepo.GetByIDWithDetails(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event details: %w", err)
+	}
+	if event == nil {
+		return fmt.Errorf("event not found")
+	}
+
+	// Get guest details
+	guest, err := s.userRepo.GetUserWithProfile(ctx, guestUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get guest details: %w", err)
+	}
+	if guest == nil {
+		return fmt.Errorf("guest not found")
+	}
+
+	// Get inviter user details
+	inviterUser, err := s.userRepo.GetUserWithProfile(ctx, inviterUserID)
+	if err != nil {
+		return fmt.Errorf("failed to get inviter user details: %w", err)
+	}
+	if inviterUser == nil {
+		return fmt.Errorf("inviter user not found")
+	}
+
+	payload := map[string]interface{}{
+		"UserName":    s.getUserDisplayName(guest),
+		"InviterName": s.getUserDisplayName(inviterUser),
+		"EventTitle":  event.Title,
+		"EventID":     event.ID.String(),
+		"EventDate":   event.StartAt.Format("2006-01-02"),
+		"EventTime":   event.StartAt.Format("15:04"),
+	}
+
+	// Add venue information if available
+	if event.Venue != nil {
+		payload["VenueName"] = event.Venue.Name
+		payload["VenueAddress"] = event.Venue.Address
+	}
+
+	err = s.notificationService.CreateImmediateNotification(ctx, guestUserID, domain.NotificationTypeEventInvite, payload)
+	if err != nil {
+		return fmt.Errorf("failed to send event invite notification: %w", err)
+	}
+
+	return nil
+}
+
+// OnDataExportReady emails a user the download link for their data export archive
+func (s *NotificationTriggerService) OnDataExportReady(ctx context.Context, userID uuid.UUID, downloadURL string, expiresAt time.Time) error {
+	user, err := s.userRepo.GetUserWithProfile(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user details: %w", err)
+	}
+	if user == nil {
+		return fmt.Errorf("user not found")
+	}
+
+	payload := map[string]interface{}{
+		"UserName":    s.getUserDisplayName(user),
+		"DownloadURL": downloadURL,
+		"ExpiresAt":   expiresAt.UTC().Format("2006-01-02 15:04 UTC"),
+	}
+
+	err = s.notificationService.CreateImmediateNotification(ctx, userID, domain.NotificationTypeDataExportReady, payload)
+	if err != nil {
+		return fmt.Errorf("failed to send data export notification: %w", err)
+	}
+
+	return nil
+}
+
+// OnInactiveAccountDeletionScheduled warns a user that the retention job scheduled their inactive account for deletion
+func (s *NotificationTriggerService) OnInactiveAccountDeletionScheduled(ctx context.Context, userID uuid.UUID, scheduledFor time.Time) error {
+	user, err := s.userRepo.GetUserWithProfile(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user details: %w", err)
+	}
+	if user == nil {
+		return fmt.Errorf("user not found")
+	}
+
+	payload := map[string]interface{}{
+		"UserName":     s.getUserDisplayName(user),
+		"ScheduledFor": scheduledFor.UTC().Format("2006-01-02"),
+	}
+
+	err = s.notificationService.CreateImmediateNotification(ctx, userID, domain.NotificationTypeAccountDeletionScheduled, payload)
+	if err != nil {
+		return fmt.Errorf("failed to send account deletion notification: %w", err)
+	}
+
+	return nil
+}
+
+// OnEventCancelled notifies everyone who RSVPed to an event that it has been cancelled
+func (s *NotificationTriggerService) OnEventCancelled(ctx context.Context, eventID uuid.UUID, reason string) error {
+	event, err := s.eventRepo.GetByIDWithDetails(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event details: %w", err)
+	}
+	if event == nil {
+		return fmt.Errorf("event not found")
+	}
+
+	rsvps, err := s.eventRepo.GetEventRSVPs(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to get event RSVPs: %w", err)
+	}
+
+	for _, rsvp := range rsvps {
+		// Skip declined RSVPs
+		if rsvp.Status == domain.RSVPStatusDeclined {
+			continue
+		}
+
+		user, err := s.userRepo.GetUserWithProfile(ctx, rsvp.UserID)
+		if err != nil || user == nil {
+			continue // Skip this user if we can't get their details
+		}
+
+		payload := map[string]interface{}{
+			"UserName":   s.getUserDisplayName(user),
+			"EventTitle": event.Title,
+			"EventID":    event.ID.String(),
+			"EventDate":  event.StartAt.Format("2006-01-02"),
+			"EventTime":  event.StartAt.Format("15:04"),
+			"Reason":     reason,
+		}
+
+		if err := s.notificationService.CreateImmediateNotification(ctx, rsvp.UserID, domain.NotificationTypeEventCancelled, payload); err != nil {
+			// Log error but continue with other users
+			log.Printf("Failed to notify user %s of cancelled event %s: %v", rsvp.UserID, eventID, err)
+		}
+	}
+
+	return nil
+}
+
 // buildRSVPConfirmationPayload builds the payload for RSVP confirmation notifications
 func (s *NotificationTriggerService) buildRSVPConfirmationPayload(event *domain.EventWithDetails, user *domain.UserWithProfile, status domain.RSVPStatus) map[string]interface{} {
 	payload := map[string]interface{}{
@@ -356,6 +771,24 @@ func (s *NotificationTriggerService) formatGroupRole(role domain.GroupRole) stri
 	}
 }
 
+// formatCoHostPermissions formats the rights delegated to a co-host for display
+func (s *NotificationTriggerService) formatCoHostPermissions(permissions []domain.Permission) string {
+	labels := make([]string, len(permissions))
+	for i, permission := range permissions {
+		switch permission {
+		case domain.PermissionEditEvent:
+			labels[i] = "edit the event"
+		case domain.PermissionManageAttendees:
+			labels[i] = "manage attendees"
+		case domain.PermissionCheckIn:
+			labels[i] = "check in attendees"
+		default:
+			labels[i] = string(permission)
+		}
+	}
+	return strings.Join(labels, ", ")
+}
+
 // EventNotificationManager provides a higher-level interface for handling event-driven notifications
 type EventNotificationManager struct {
 	triggerService *NotificationTriggerService
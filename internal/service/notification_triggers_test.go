@@ -133,6 +133,9 @@ func (m *mockGroupRepository) GetUserGroupsWithMembers(ctx context.Context, user
 func (m *mockGroupRepository) GetGroupsByOwner(ctx context.Context, ownerID uuid.UUID) ([]*domain.Group, error) {
 	return nil, nil
 }
+func (m *mockGroupRepository) Search(ctx context.Context, params domain.GroupSearchParams) ([]*domain.GroupSearchResult, error) {
+	return nil, nil
+}
 func (m *mockGroupRepository) AddMember(ctx context.Context, member *domain.GroupMember) error {
 	return nil
 }
@@ -394,6 +397,229 @@ func TestNotificationTriggerService(t *testing.T) {
 		}
 	})
 
+	t.Run("OnGroupJoinRequest", func(t *testing.T) {
+		emailProvider.Reset()
+
+		requesterID := uuid.New()
+		userRepo.users[requesterID] = &domain.UserWithProfile{
+			User: domain.User{
+				ID:    requesterID,
+				Email: "requester@example.com",
+			},
+			Profile: &domain.Profile{
+				UserID:      requesterID,
+				DisplayName: stringPtr("Requesting User"),
+			},
+		}
+
+		err := triggerService.OnGroupJoinRequest(ctx, groupID, requesterID, stringPtr("Can I join?"))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// Only the owner can approve requests, so regular members are not notified
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		expectedSubject := "Requesting User wants to join Test Group"
+		if lastEmail.Subject != expectedSubject {
+			t.Errorf("Expected subject '%s', got '%s'", expectedSubject, lastEmail.Subject)
+		}
+
+		emailProvider.Reset()
+
+		err = triggerService.OnGroupJoinRequestReviewed(ctx, groupID, requesterID, false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail = emailProvider.GetLastEmail()
+		expectedSubject = "Your request to join Test Group was declined"
+		if lastEmail.Subject != expectedSubject {
+			t.Errorf("Expected subject '%s', got '%s'", expectedSubject, lastEmail.Subject)
+		}
+	})
+
+	t.Run("OnGroupAnnouncement", func(t *testing.T) {
+		emailProvider.Reset()
+
+		announcement := &domain.GroupAnnouncement{
+			ID:           uuid.New(),
+			GroupID:      groupID,
+			AuthorUserID: hostID,
+			Title:        "Prerelease schedule",
+			Body:         "Prerelease pods start at 10:00 on Saturday.",
+		}
+
+		err := triggerService.OnGroupAnnouncement(ctx, announcement)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// The author is not notified about their own announcement
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		expectedSubject := "[Test Group] Prerelease schedule"
+		if lastEmail.Subject != expectedSubject {
+			t.Errorf("Expected subject '%s', got '%s'", expectedSubject, lastEmail.Subject)
+		}
+		if !contains(lastEmail.TextBody, announcement.Body) {
+			t.Error("Expected email to contain the announcement body")
+		}
+	})
+
+	t.Run("OnEventCoHostInvite", func(t *testing.T) {
+		emailProvider.Reset()
+
+		permissions := []domain.Permission{domain.PermissionManageAttendees, domain.PermissionCheckIn}
+		err := triggerService.OnEventCoHostInvite(ctx, eventID, userID, hostID, permissions)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		expectedSubject := "Event Host invited you to co-host Test Event"
+		if lastEmail.Subject != expectedSubject {
+			t.Errorf("Expected subject '%s', got '%s'", expectedSubject, lastEmail.Subject)
+		}
+		if lastEmail.To[0] != "test@example.com" {
+			t.Errorf("Expected email to be sent to test@example.com, got %s", lastEmail.To[0])
+		}
+		if !contains(lastEmail.TextBody, "manage attendees, check in attendees") {
+			t.Error("Expected email to describe the delegated permissions")
+		}
+	})
+
+	t.Run("OnEventCoHostResponse", func(t *testing.T) {
+		emailProvider.Reset()
+
+		err := triggerService.OnEventCoHostResponse(ctx, eventID, userID, true)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// Only the host is told; the responding co-host is not notified about their own answer
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		expectedSubject := "Test User accepted the invitation to co-host Test Event"
+		if lastEmail.Subject != expectedSubject {
+			t.Errorf("Expected subject '%s', got '%s'", expectedSubject, lastEmail.Subject)
+		}
+		if lastEmail.To[0] != "host@example.com" {
+			t.Errorf("Expected email to be sent to host@example.com, got %s", lastEmail.To[0])
+		}
+	})
+
+	t.Run("OnEventGuestInvite", func(t *testing.T) {
+		emailProvider.Reset()
+
+		err := triggerService.OnEventGuestInvite(ctx, eventID, userID, hostID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		expectedSubject := "Event Host invited you to Test Event"
+		if lastEmail.Subject != expectedSubject {
+			t.Errorf("Expected subject '%s', got '%s'", expectedSubject, lastEmail.Subject)
+		}
+		if lastEmail.To[0] != "test@example.com" {
+			t.Errorf("Expected email to be sent to test@example.com, got %s", lastEmail.To[0])
+		}
+	})
+
+	t.Run("OnDataExportReady", func(t *testing.T) {
+		emailProvider.Reset()
+
+		downloadURL := "https://api.matchtcg.com/api/v1/exports/abc/download?expires=1&signature=sig"
+		err := triggerService.OnDataExportReady(ctx, userID, downloadURL, time.Now().Add(72*time.Hour))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		if lastEmail.Subject != "Your MatchTCG data export is ready" {
+			t.Errorf("Expected data export subject, got '%s'", lastEmail.Subject)
+		}
+		if !contains(lastEmail.TextBody, downloadURL) {
+			t.Errorf("Expected email to contain the download link")
+		}
+	})
+
+	t.Run("OnInactiveAccountDeletionScheduled", func(t *testing.T) {
+		emailProvider.Reset()
+
+		scheduledFor := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+		err := triggerService.OnInactiveAccountDeletionScheduled(ctx, userID, scheduledFor)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		if lastEmail.Subject != "Your MatchTCG account will be deleted on 2026-03-14" {
+			t.Errorf("Expected account deletion subject, got '%s'", lastEmail.Subject)
+		}
+		if !contains(lastEmail.TextBody, "/account/deletion") {
+			t.Errorf("Expected email to contain the link to cancel the deletion")
+		}
+	})
+
+	t.Run("OnEventCancelled", func(t *testing.T) {
+		emailProvider.Reset()
+
+		eventRepo.rsvps[eventID] = []*domain.EventRSVP{
+			{EventID: eventID, UserID: userID, Status: domain.RSVPStatusGoing},
+			{EventID: eventID, UserID: hostID, Status: domain.RSVPStatusDeclined},
+		}
+
+		reason := "The host has closed their account and nobody could take the event over."
+		err := triggerService.OnEventCancelled(ctx, eventID, reason)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		// Declined attendees are not notified
+		if emailProvider.GetEmailCount() != 1 {
+			t.Fatalf("Expected 1 email to be sent, got %d", emailProvider.GetEmailCount())
+		}
+
+		lastEmail := emailProvider.GetLastEmail()
+		if lastEmail.Subject != "Event Cancelled: Test Event" {
+			t.Errorf("Expected subject 'Event Cancelled: Test Event', got '%s'", lastEmail.Subject)
+		}
+		if !contains(lastEmail.TextBody, reason) {
+			t.Errorf("Expected email to contain the cancellation reason")
+		}
+	})
+
 	t.Run("FormatRSVPStatus", func(t *testing.T) {
 		testCases := []struct {
 			status   domain.RSVPStatus
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matchtcg/backend/internal/domain"
+)
+
+// PhotonProvider implements the GeocodingProvider interface using Komoot's Photon service. Photon has no
+// rate limiter of its own; chain it with a rate limit when using the public instance.
+type PhotonProvider struct {
+	baseURL    string
+	userAgent  string
+	language   string
+	httpClient *http.Client
+}
+
+// PhotonConfig holds configuration for the Photon provider
+type PhotonConfig struct {
+	BaseURL   string
+	UserAgent string
+	Language  string // Preferred language of the returned names, such as "en"; empty for local names
+	Timeout   time.Duration
+}
+
+// NewPhotonProvider creates a new Photon geocoding provider
+func NewPhotonProvider(config PhotonConfig) *PhotonProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = "https://photon.komoot.io"
+	}
+	if config.UserAgent == "" {
+		config.UserAgent = "MatchTCG/1.0"
+	}
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &PhotonProvider{
+		baseURL:   strings.TrimRight(config.BaseURL, "/"),
+		userAgent: config.UserAgent,
+		language:  config.Language,
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+		},
+	}
+}
+
+// GetName returns the provider name
+func (p *PhotonProvider) GetName() string {
+	return "Photon"
+}
+
+// Geocode converts an address to coordinates using Photon
+func (p *PhotonProvider) Geocode(ctx context.Context, address string) (*GeocodingResult, error) {
+	params := url.Values{}
+	params.Set("q", address)
+
+	feature, err := p.search(ctx, "/api", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return feature.toResult(feature.Geometry.Coordinates[1], feature.Geometry.Coordinates[0]), nil
+}
+
+// ReverseGeocode converts coordinates to an address using Photon
+func (p *PhotonProvider) ReverseGeocode(ctx context.Context, lat, lon float64) (*GeocodingResult, error) {
+	params := url.Values{}
+	params.Set("lat", strconv.FormatFloat(lat, 'f', 6, 64))
+	params.Set("lon", strconv.FormatFloat(lon, 'f', 6, 64))
+
+	feature, err := p.search(ctx, "/reverse", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return feature.toResult(lat, lon), nil
+}
+
+// search queries a Photon endpoint and returns the best matching feature
+func (p *PhotonProvider) search(ctx context.Context, path string, params url.Values) (*photonFeature, error) {
+	params.Set("limit", "1")
+	if p.language != "" {
+		params.Set("lang", p.language)
+	}
+
+	reqURL := fmt.Sprintf("%s%s?%s", p.baseURL, path, params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", p.userAgent)
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, ErrGeocodingTimeout
+		}
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, ErrRateLimitExceeded
+		}
+		return nil, fmt.Errorf("HTTP %d: %w", resp.StatusCode, ErrProviderUnavailable)
+	}
+
+	var collection photonFeatureCollection
+	if err := json.NewDecoder(resp.Body).Decode(&collection); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if len(collection.Features) == 0 || len(collection.Features[0].Geometry.Coordinates) != 2 {
+		return nil, ErrAddressNotFound
+	}
+
+	return &collection.Features[0], nil
+}
+
+// photonFeatureCollection represents the GeoJSON response structure from the Photon API
+type photonFeatureCollection struct {
+	Features []photonFeature `json:"features"`
+}
+
+type photonFeature struct {
+	Geometry struct {
+		Coordinates []float64 `json:"coordinates"` // longitude, latitude
+	} `json:"geometry"`
+	Properties photonProperties `json:"properties"`
+}
+
+type photonProperties struct {
+	Name        string `json:"name"`
+	Street      string `json:"street"`
+	HouseNumber string `json:"housenumber"`
+	Postcode    string `json:"postcode"`
+	City        string `json:"city"`
+	District    string `json:"district"`
+	State       string `json:"state"`
+	Country     string `json:"country"`
+	CountryCode string `json:"countrycode"`
+	Type        string `json:"type"` // house, street, district, city, county, state or country
+}
+
+// toResult converts a Photon feature to a geocoding result at the given coordinates
+func (f *photonFeature) toResult(lat, lon float64) *GeocodingResult {
+	props := f.Properties
+
+	street := strings.TrimSpace(props.Street + " " + props.HouseNumber)
+	city := props.City
+	if city == "" && props.Type == "city" {
+		city = props.Name
+	}
+
+	var parts []string
+	for _, part := range []string{props.Name, street, strings.TrimSpace(props.Postcode + " " + city), props.State, props.Country} {
+		if part != "" && (len(parts) == 0 || parts[len(parts)-1] != part) {
+			parts = append(parts, part)
+		}
+	}
+
+	return &GeocodingResult{
+		Coordinates: domain.Coordinates{
+			Latitude:  lat,
+			Longitude: lon,
+		},
+		Address: domain.Address{
+			Street:     street,
+			City:       city,
+			State:      props.State,
+			PostalCode: props.Postcode,
+			Country:    props.Country,
+			Formatted:  strings.Join(parts, ", "),
+		},
+		Confidence: photonConfidence(props.Type),
+		Source:     "Photon",
+	}
+}
+
+// photonConfidence estimates a 0-100 confidence from how precise the matched place is, since Photon
+// doesn't score its results
+func photonConfidence(placeType string) float64 {
+	switch placeType {
+	case "house":
+		return 90
+	case "street":
+		return 75
+	case "locality", "district":
+		return 65
+	case "city":
+		return 60
+	case "county", "state":
+		return 40
+	case "country":
+		return 20
+	default:
+		return 50
+	}
+}
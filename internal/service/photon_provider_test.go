@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const photonPortoResponse = `{"type":"FeatureCollection","features":[{"type":"Feature",
+	"geometry":{"type":"Point","coordinates":[-8.6058,41.1466]},
+	"properties":{"name":"Arena Porto","street":"Rua de Santa Catarina","housenumber":"123","postcode":"4000-447",
+	"city":"Porto","state":"Porto","country":"Portugal","countrycode":"PT","type":"house"}}]}`
+
+func TestPhotonProvider_Geocode(t *testing.T) {
+	// Create a test server that mimics the Photon API
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.URL.Query().Get("q") {
+		case "rate_limit_test":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "server_error":
+			w.WriteHeader(http.StatusBadGateway)
+		case "not_found":
+			fmt.Fprint(w, `{"type":"FeatureCollection","features":[]}`)
+		default:
+			if r.URL.Query().Get("lang") != "en" || r.URL.Query().Get("limit") != "1" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, photonPortoResponse)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewPhotonProvider(PhotonConfig{
+		BaseURL:  server.URL + "/",
+		Language: "en",
+		Timeout:  5 * time.Second,
+	})
+
+	result, err := provider.Geocode(context.Background(), "Rua de Santa Catarina 123, Porto")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Coordinates.Latitude != 41.1466 || result.Coordinates.Longitude != -8.6058 {
+		t.Errorf("Expected coordinates 41.1466,-8.6058, got %v", result.Coordinates)
+	}
+	if result.Address.Street != "Rua de Santa Catarina 123" {
+		t.Errorf("Expected street with house number, got %q", result.Address.Street)
+	}
+	if result.Address.Formatted != "Arena Porto, Rua de Santa Catarina 123, 4000-447 Porto, Porto, Portugal" {
+		t.Errorf("Unexpected formatted address %q", result.Address.Formatted)
+	}
+	if result.Confidence != 90 || result.Source != "Photon" {
+		t.Errorf("Expected a house-level Photon result, got confidence %v from %s", result.Confidence, result.Source)
+	}
+
+	errorTests := []struct {
+		address       string
+		expectedError error
+	}{
+		{"not_found", ErrAddressNotFound},
+		{"rate_limit_test", ErrRateLimitExceeded},
+		{"server_error", ErrProviderUnavailable},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.address, func(t *testing.T) {
+			if _, err := provider.Geocode(context.Background(), tt.address); !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestPhotonProvider_ReverseGeocode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reverse" || r.URL.Query().Get("lat") != "41.146600" || r.URL.Query().Get("lon") != "-8.605800" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, photonPortoResponse)
+	}))
+	defer server.Close()
+
+	provider := NewPhotonProvider(PhotonConfig{BaseURL: server.URL})
+
+	result, err := provider.ReverseGeocode(context.Background(), 41.1466, -8.6058)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Address.City != "Porto" || result.Address.PostalCode != "4000-447" {
+		t.Errorf("Expected Porto 4000-447, got %q %q", result.Address.City, result.Address.PostalCode)
+	}
+}
+
+func TestPhotonConfidence(t *testing.T) {
+	if photonConfidence("house") <= photonConfidence("street") || photonConfidence("city") <= photonConfidence("country") {
+		t.Error("Expected more precise places to be more confident")
+	}
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// ErrAccountDeletionNotFound is returned when the user has no scheduled account deletion
+var ErrAccountDeletionNotFound = errors.New("no account deletion is scheduled")
+
+const (
+	// AccountDeletionGracePeriod is how long a user has to change their mind after asking to delete their account
+	AccountDeletionGracePeriod = 14 * 24 * time.Hour
+	// DefaultAccountDeletionBatchSize is how many due deletions one processing run carries out
+	DefaultAccountDeletionBatchSize = 10
+
+	// accountDeletionPageSize is how many hosted events are loaded at a time while planning a deletion
+	accountDeletionPageSize = 1000
+	// accountDeletionCancellationReason is sent to attendees of events cancelled by a deletion
+	accountDeletionCancellationReason = "The host closed their MatchTCG account and no co-host or group admin was available to take the event over."
+)
+
+// AccountDeletionNotifier tells attendees that an event was cancelled because its host left
+type AccountDeletionNotifier interface {
+	OnEventCancelled(ctx context.Context, eventID uuid.UUID, reason string) error
+}
+
+// AccountDeletionRequest represents a user's request concerning the deletion of their own account
+type AccountDeletionRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ScheduleAccountDeletionUseCase schedules an account for deletion after the grace period
+type ScheduleAccountDeletionUseCase struct {
+	userRepo     repository.UserRepository
+	deletionRepo repository.AccountDeletionRepository
+}
+
+// NewScheduleAccountDeletionUseCase creates a new ScheduleAccountDeletionUseCase
+func NewScheduleAccountDeletionUseCase(userRepo repository.UserRepository, deletionRepo repository.AccountDeletionRepository) *ScheduleAccountDeletionUseCase {
+	return &ScheduleAccountDeletionUseCase{
+		userRepo:     userRepo,
+		deletionRepo: deletionRepo,
+	}
+}
+
+// Execute schedules the deletion, returning the already scheduled one if the user asked before
+func (uc *ScheduleAccountDeletionUseCase) Execute(ctx context.Context, req *AccountDeletionRequest) (*domain.AccountDeletion, error) {
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil || user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	scheduled, err := uc.deletionRepo.GetScheduled(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if scheduled != nil {
+		return scheduled, nil
+	}
+
+	deletion := domain.NewAccountDeletion(req.UserID, time.Now().UTC(), AccountDeletionGracePeriod)
+	if err := deletion.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.deletionRepo.Create(ctx, deletion); err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+// CancelAccountDeletionUseCase cancels a deletion during its grace period
+type CancelAccountDeletionUseCase struct {
+	deletionRepo repository.AccountDeletionRepository
+}
+
+// NewCancelAccountDeletionUseCase creates a new CancelAccountDeletionUseCase
+func NewCancelAccountDeletionUseCase(deletionRepo repository.AccountDeletionRepository) *CancelAccountDeletionUseCase {
+	return &CancelAccountDeletionUseCase{
+		deletionRepo: deletionRepo,
+	}
+}
+
+// Execute cancels the user's scheduled deletion
+func (uc *CancelAccountDeletionUseCase) Execute(ctx context.Context, req *AccountDeletionRequest) (*domain.AccountDeletion, error) {
+	deletion, err := uc.deletionRepo.GetScheduled(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion == nil {
+		return nil, ErrAccountDeletionNotFound
+	}
+
+	if err := deletion.Cancel(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.deletionRepo.Update(ctx, deletion); err != nil {
+		return nil, err
+	}
+
+	return deletion, nil
+}
+
+// GetAccountDeletionUseCase retrieves a user's scheduled deletion
+type GetAccountDeletionUseCase struct {
+	deletionRepo repository.AccountDeletionRepository
+}
+
+// NewGetAccountDeletionUseCase creates a new GetAccountDeletionUseCase
+func NewGetAccountDeletionUseCase(deletionRepo repository.AccountDeletionRepository) *GetAccountDeletionUseCase {
+	return &GetAccountDeletionUseCase{
+		deletionRepo: deletionRepo,
+	}
+}
+
+// Execute returns the user's scheduled deletion
+func (uc *GetAccountDeletionUseCase) Execute(ctx context.Context, req *AccountDeletionRequest) (*domain.AccountDeletion, error) {
+	deletion, err := uc.deletionRepo.GetScheduled(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion == nil {
+		return nil, ErrAccountDeletionNotFound
+	}
+
+	return deletion, nil
+}
+
+// ProcessAccountDeletionsUseCase carries out deletions whose grace period has ended
+type ProcessAccountDeletionsUseCase struct {
+	deletionRepo  repository.AccountDeletionRepository
+	deleteUseCase *DeleteUserAccountUseCase
+}
+
+// NewProcessAccountDeletionsUseCase creates a new ProcessAccountDeletionsUseCase
+func NewProcessAccountDeletionsUseCase(deletionRepo repository.AccountDeletionRepository, deleteUseCase *DeleteUserAccountUseCase) *ProcessAccountDeletionsUseCase {
+	return &ProcessAccountDeletionsUseCase{
+		deletionRepo:  deletionRepo,
+		deleteUseCase: deleteUseCase,
+	}
+}
+
+// Execute carries out up to batchSize due deletions and returns how many completed. A deletion that
+// fails is rolled back and stays scheduled, so the next run retries it.
+func (uc *ProcessAccountDeletionsUseCase) Execute(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultAccountDeletionBatchSize
+	}
+
+	deletions, err := uc.deletionRepo.GetDue(ctx, time.Now().UTC(), batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, deletion := range deletions {
+		if _, err := uc.deleteUseCase.Execute(ctx, &DeleteUserAccountRequest{Deletion: deletion}); err != nil {
+			log.Printf("Failed to delete account %s: %v", deletion.UserID, err)
+			continue
+		}
+		completed++
+	}
+
+	return completed, nil
+}
+
+// AccountDeletionScheduler periodically carries out due account deletions
+type AccountDeletionScheduler struct {
+	processUseCase *ProcessAccountDeletionsUseCase
+	batchSize      int
+	interval       time.Duration
+}
+
+// NewAccountDeletionScheduler creates a new account deletion scheduler
+func NewAccountDeletionScheduler(processUseCase *ProcessAccountDeletionsUseCase, batchSize int, interval time.Duration) *AccountDeletionScheduler {
+	return &AccountDeletionScheduler{
+		processUseCase: processUseCase,
+		batchSize:      batchSize,
+		interval:       interval,
+	}
+}
+
+// Start carries out due deletions until the context is cancelled
+func (s *AccountDeletionScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.processUseCase.Execute(ctx, s.batchSize); err != nil {
+				log.Printf("Error processing account deletions: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockAccountDeletionRepository is a mock implementation of AccountDeletionRepository
+type MockAccountDeletionRepository struct {
+	mock.Mock
+}
+
+func (m *MockAccountDeletionRepository) Create(ctx context.Context, deletion *domain.AccountDeletion) error {
+	args := m.Called(ctx, deletion)
+	return args.Error(0)
+}
+
+func (m *MockAccountDeletionRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AccountDeletion, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AccountDeletion), args.Error(1)
+}
+
+func (m *MockAccountDeletionRepository) GetScheduled(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AccountDeletion), args.Error(1)
+}
+
+func (m *MockAccountDeletionRepository) Update(ctx context.Context, deletion *domain.AccountDeletion) error {
+	args := m.Called(ctx, deletion)
+	return args.Error(0)
+}
+
+func (m *MockAccountDeletionRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.AccountDeletion, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*domain.AccountDeletion), args.Error(1)
+}
+
+func (m *MockAccountDeletionRepository) Complete(ctx context.Context, deletion *domain.AccountDeletion) ([]string, error) {
+	args := m.Called(ctx, deletion)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// MockAccountDeletionNotifier is a mock implementation of AccountDeletionNotifier
+type MockAccountDeletionNotifier struct {
+	mock.Mock
+}
+
+func (m *MockAccountDeletionNotifier) OnEventCancelled(ctx context.Context, eventID uuid.UUID, reason string) error {
+	args := m.Called(ctx, eventID, reason)
+	return args.Error(0)
+}
+
+func TestScheduleAccountDeletionUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	user := &domain.User{ID: userID, Email: "test@example.com"}
+
+	t.Run("schedules the deletion after the grace period", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		deletionRepo := new(MockAccountDeletionRepository)
+		userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+		deletionRepo.On("GetScheduled", mock.Anything, userID).Return(nil, nil)
+		deletionRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.AccountDeletion")).Return(nil)
+
+		deletion, err := NewScheduleAccountDeletionUseCase(userRepo, deletionRepo).Execute(context.Background(), &AccountDeletionRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.AccountDeletionStatusScheduled, deletion.Status)
+		assert.Equal(t, AccountDeletionGracePeriod, deletion.ScheduledFor.Sub(deletion.RequestedAt))
+		deletionRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns the deletion already scheduled", func(t *testing.T) {
+		scheduled := domain.NewAccountDeletion(userID, time.Now(), AccountDeletionGracePeriod)
+		userRepo := new(MockUserRepository)
+		deletionRepo := new(MockAccountDeletionRepository)
+		userRepo.On("GetByID", mock.Anything, userID).Return(user, nil)
+		deletionRepo.On("GetScheduled", mock.Anything, userID).Return(scheduled, nil)
+
+		deletion, err := NewScheduleAccountDeletionUseCase(userRepo, deletionRepo).Execute(context.Background(), &AccountDeletionRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, scheduled.ID, deletion.ID)
+		deletionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCancelAccountDeletionUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("cancels during the grace period", func(t *testing.T) {
+		scheduled := domain.NewAccountDeletion(userID, time.Now(), AccountDeletionGracePeriod)
+		deletionRepo := new(MockAccountDeletionRepository)
+		deletionRepo.On("GetScheduled", mock.Anything, userID).Return(scheduled, nil)
+		deletionRepo.On("Update", mock.Anything, scheduled).Return(nil)
+
+		deletion, err := NewCancelAccountDeletionUseCase(deletionRepo).Execute(context.Background(), &AccountDeletionRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.AccountDeletionStatusCancelled, deletion.Status)
+		deletionRepo.AssertExpectations(t)
+	})
+
+	t.Run("nothing to cancel", func(t *testing.T) {
+		deletionRepo := new(MockAccountDeletionRepository)
+		deletionRepo.On("GetScheduled", mock.Anything, userID).Return(nil, nil)
+
+		_, err := NewCancelAccountDeletionUseCase(deletionRepo).Execute(context.Background(), &AccountDeletionRequest{UserID: userID})
+
+		assert.Equal(t, ErrAccountDeletionNotFound, err)
+	})
+}
+
+func TestDeleteUserAccountUseCase_Execute_HostedEvents(t *testing.T) {
+	userID := uuid.New()
+	coHostID := uuid.New()
+	groupAdminID := uuid.New()
+	groupID := uuid.New()
+	now := time.Now()
+
+	newEvent := func(startAt time.Time, groupID *uuid.UUID) *domain.Event {
+		return &domain.Event{ID: uuid.New(), HostUserID: userID, GroupID: groupID, StartAt: startAt, EndAt: startAt.Add(3 * time.Hour)}
+	}
+
+	pastEvent := newEvent(now.Add(-24*time.Hour), nil)
+	coHostedEvent := newEvent(now.Add(24*time.Hour), nil)
+	groupEvent := newEvent(now.Add(48*time.Hour), &groupID)
+	soloEvent := newEvent(now.Add(72*time.Hour), nil)
+
+	userRepo := new(MockUserRepository)
+	eventRepo := new(MockEventRepository)
+	groupRepo := new(MockGroupRepository)
+	coHostRepo := new(MockEventCoHostRepository)
+	deletionRepo := new(MockAccountDeletionRepository)
+	notifier := new(MockAccountDeletionNotifier)
+
+	userRepo.On("GetByID", mock.Anything, userID).Return(&domain.User{ID: userID}, nil)
+	groupRepo.On("GetGroupsByOwner", mock.Anything, userID).Return([]*domain.Group{}, nil)
+	eventRepo.On("GetUserEvents", mock.Anything, userID, 1000, 0).Return([]*domain.Event{pastEvent, coHostedEvent, groupEvent, soloEvent}, nil)
+	coHostRepo.On("GetByEvent", mock.Anything, coHostedEvent.ID).Return([]*domain.EventCoHost{
+		{EventID: coHostedEvent.ID, UserID: uuid.New(), Status: domain.EventCoHostStatusPending},
+		{EventID: coHostedEvent.ID, UserID: coHostID, Status: domain.EventCoHostStatusAccepted},
+	}, nil)
+	coHostRepo.On("GetByEvent", mock.Anything, groupEvent.ID).Return([]*domain.EventCoHost{}, nil)
+	coHostRepo.On("GetByEvent", mock.Anything, soloEvent.ID).Return([]*domain.EventCoHost{}, nil)
+	groupRepo.On("GetGroupMembers", mock.Anything, groupID).Return([]*domain.GroupMember{
+		{GroupID: groupID, UserID: userID, Role: domain.GroupRoleMember},
+		{GroupID: groupID, UserID: groupAdminID, Role: domain.GroupRoleAdmin},
+	}, nil)
+	deletionRepo.On("Complete", mock.Anything, mock.AnythingOfType("*domain.AccountDeletion")).Return(nil, nil)
+	notifier.On("OnEventCancelled", mock.Anything, soloEvent.ID, accountDeletionCancellationReason).Return(nil)
+
+	useCase := NewDeleteUserAccountUseCase(userRepo, eventRepo, groupRepo, coHostRepo, deletionRepo)
+	useCase.notifier = notifier
+
+	deletion := domain.NewAccountDeletion(userID, now.Add(-AccountDeletionGracePeriod), AccountDeletionGracePeriod)
+	result, err := useCase.Execute(context.Background(), &DeleteUserAccountRequest{Deletion: deletion})
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.EventHandover{
+		{EventID: coHostedEvent.ID, NewHostUserID: coHostID},
+		{EventID: groupEvent.ID, NewHostUserID: groupAdminID},
+	}, result.Report.EventHandovers)
+	assert.Equal(t, []uuid.UUID{soloEvent.ID}, result.Report.CancelledEventIDs)
+	assert.Equal(t, []uuid.UUID{pastEvent.ID}, result.Report.AnonymizedEventIDs)
+	eventRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	notifier.AssertExpectations(t)
+}
+
+func TestDeleteUserAccountUseCase_Execute_FailedDeletionNotifiesNobody(t *testing.T) {
+	userID := uuid.New()
+	soloEvent := &domain.Event{ID: uuid.New(), HostUserID: userID, StartAt: time.Now().Add(24 * time.Hour)}
+
+	userRepo := new(MockUserRepository)
+	eventRepo := new(MockEventRepository)
+	groupRepo := new(MockGroupRepository)
+	coHostRepo := new(MockEventCoHostRepository)
+	deletionRepo := new(MockAccountDeletionRepository)
+	notifier := new(MockAccountDeletionNotifier)
+
+	userRepo.On("GetByID", mock.Anything, userID).Return(&domain.User{ID: userID}, nil)
+	groupRepo.On("GetGroupsByOwner", mock.Anything, userID).Return([]*domain.Group{}, nil)
+	eventRepo.On("GetUserEvents", mock.Anything, userID, 1000, 0).Return([]*domain.Event{soloEvent}, nil)
+	coHostRepo.On("GetByEvent", mock.Anything, soloEvent.ID).Return([]*domain.EventCoHost{}, nil)
+	deletionRepo.On("Complete", mock.Anything, mock.AnythingOfType("*domain.AccountDeletion")).Return(nil, errors.New("connection lost"))
+
+	useCase := NewDeleteUserAccountUseCase(userRepo, eventRepo, groupRepo, coHostRepo, deletionRepo)
+	useCase.notifier = notifier
+
+	deletion := domain.NewAccountDeletion(userID, time.Now(), 0)
+	_, err := useCase.Execute(context.Background(), &DeleteUserAccountRequest{Deletion: deletion})
+
+	assert.Error(t, err)
+	notifier.AssertNotCalled(t, "OnEventCancelled", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProcessAccountDeletionsUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	missingUserID := uuid.New()
+	due := domain.NewAccountDeletion(userID, time.Now().Add(-AccountDeletionGracePeriod), AccountDeletionGracePeriod)
+	orphaned := domain.NewAccountDeletion(missingUserID, time.Now().Add(-AccountDeletionGracePeriod), AccountDeletionGracePeriod)
+
+	userRepo := new(MockUserRepository)
+	eventRepo := new(MockEventRepository)
+	groupRepo := new(MockGroupRepository)
+	deletionRepo := new(MockAccountDeletionRepository)
+
+	userRepo.On("GetByID", mock.Anything, userID).Return(&domain.User{ID: userID}, nil)
+	userRepo.On("GetByID", mock.Anything, missingUserID).Return(nil, nil)
+	groupRepo.On("GetGroupsByOwner", mock.Anything, userID).Return([]*domain.Group{}, nil)
+	eventRepo.On("GetUserEvents", mock.Anything, userID, 1000, 0).Return([]*domain.Event{}, nil)
+	deletionRepo.On("GetDue", mock.Anything, mock.AnythingOfType("time.Time"), DefaultAccountDeletionBatchSize).Return([]*domain.AccountDeletion{orphaned, due}, nil)
+	deletionRepo.On("Complete", mock.Anything, due).Return(nil, nil)
+
+	deleteUseCase := NewDeleteUserAccountUseCase(userRepo, eventRepo, groupRepo, new(MockEventCoHostRepository), deletionRepo)
+	completed, err := NewProcessAccountDeletionsUseCase(deletionRepo, deleteUseCase).Execute(context.Background(), 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, completed)
+	assert.Equal(t, domain.AccountDeletionStatusScheduled, orphaned.Status)
+	deletionRepo.AssertExpectations(t)
+}
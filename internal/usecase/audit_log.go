@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// AuditRecorder appends entries to the audit log
+type AuditRecorder interface {
+	Record(ctx context.Context, entry *domain.AuditLogEntry) error
+}
+
+// recordAudit writes an audit entry when a recorder is configured. Failures are logged rather than
+// returned so that an unavailable audit log never undoes a change that has already been committed.
+func recordAudit(ctx context.Context, recorder AuditRecorder, entry *domain.AuditLogEntry) {
+	if recorder == nil {
+		return
+	}
+
+	if err := recorder.Record(ctx, entry); err != nil {
+		log.Printf("Failed to record %s audit entry for %s %s: %v", entry.Action, entry.TargetType, entry.TargetID, err)
+	}
+}
+
+// auditChange builds an audit entry carrying the fields that differ between before and after
+func auditChange(actorID uuid.UUID, action domain.AuditAction, targetType domain.AuditTargetType, targetID uuid.UUID, subjectUserID *uuid.UUID, before, after interface{}) *domain.AuditLogEntry {
+	entry := &domain.AuditLogEntry{
+		ActorID:       &actorID,
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+		SubjectUserID: subjectUserID,
+	}
+
+	if err := entry.SetChanges(before, after); err != nil {
+		log.Printf("Failed to snapshot %s %s for audit log: %v", targetType, targetID, err)
+	}
+
+	return entry
+}
+
+// GetMyAuditLogRequest represents a user's request for the audit history of their own account
+type GetMyAuditLogRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"` // User making the request
+	Limit  int       `json:"limit"`
+	Offset int       `json:"offset"`
+}
+
+// ListAuditLogRequest represents an admin query over the audit log
+type ListAuditLogRequest struct {
+	AdminID uuid.UUID             `json:"admin_id" validate:"required"` // User making the request
+	Filter  domain.AuditLogFilter `json:"filter"`
+	Limit   int                   `json:"limit"`
+	Offset  int                   `json:"offset"`
+}
+
+// AuditLogResponse represents a page of audit log entries
+type AuditLogResponse struct {
+	Entries []*domain.AuditLogEntry `json:"entries"`
+	Limit   int                     `json:"limit"`
+	Offset  int                     `json:"offset"`
+}
+
+// GetMyAuditLogUseCase handles users viewing changes made by or to their account
+type GetMyAuditLogUseCase struct {
+	auditRepo repository.AuditLogRepository
+}
+
+// NewGetMyAuditLogUseCase creates a new GetMyAuditLogUseCase
+func NewGetMyAuditLogUseCase(auditRepo repository.AuditLogRepository) *GetMyAuditLogUseCase {
+	return &GetMyAuditLogUseCase{
+		auditRepo: auditRepo,
+	}
+}
+
+// Execute lists audit entries the user performed or that concern their account, newest first
+func (uc *GetMyAuditLogUseCase) Execute(ctx context.Context, req *GetMyAuditLogRequest) (*AuditLogResponse, error) {
+	limit, offset := moderationPage(req.Limit, req.Offset)
+
+	entries, err := uc.auditRepo.List(ctx, domain.AuditLogFilter{AccountID: &req.UserID}, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLogResponse{
+		Entries: entries,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// ListAuditLogUseCase handles platform admins querying the audit log
+type ListAuditLogUseCase struct {
+	auditRepo repository.AuditLogRepository
+	userRepo  repository.UserRepository
+}
+
+// NewListAuditLogUseCase creates a new ListAuditLogUseCase
+func NewListAuditLogUseCase(auditRepo repository.AuditLogRepository, userRepo repository.UserRepository) *ListAuditLogUseCase {
+	return &ListAuditLogUseCase{
+		auditRepo: auditRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute lists audit entries matching the filter, newest first
+func (uc *ListAuditLogUseCase) Execute(ctx context.Context, req *ListAuditLogRequest) (*AuditLogResponse, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	if req.Filter.Action != nil && !domain.IsValidAuditAction(*req.Filter.Action) {
+		return nil, domain.ErrInvalidAuditAction
+	}
+
+	if req.Filter.TargetType != nil && !domain.IsValidAuditTargetType(*req.Filter.TargetType) {
+		return nil, domain.ErrInvalidAuditTargetType
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+
+	entries, err := uc.auditRepo.List(ctx, req.Filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLogResponse{
+		Entries: entries,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// AuditLogUseCase combines audit log queries for users and admins
+type AuditLogUseCase struct {
+	getMyAuditLogUseCase *GetMyAuditLogUseCase
+	listAuditLogUseCase  *ListAuditLogUseCase
+}
+
+// NewAuditLogUseCase creates a new AuditLogUseCase
+func NewAuditLogUseCase(auditRepo repository.AuditLogRepository, userRepo repository.UserRepository) *AuditLogUseCase {
+	return &AuditLogUseCase{
+		getMyAuditLogUseCase: NewGetMyAuditLogUseCase(auditRepo),
+		listAuditLogUseCase:  NewListAuditLogUseCase(auditRepo, userRepo),
+	}
+}
+
+// GetMyAuditLog lists the audit history of the requesting user's account
+func (uc *AuditLogUseCase) GetMyAuditLog(ctx context.Context, req *GetMyAuditLogRequest) (*AuditLogResponse, error) {
+	return uc.getMyAuditLogUseCase.Execute(ctx, req)
+}
+
+// ListAuditLog queries the audit log as a platform admin
+func (uc *AuditLogUseCase) ListAuditLog(ctx context.Context, req *ListAuditLogRequest) (*AuditLogResponse, error) {
+	return uc.listAuditLogUseCase.Execute(ctx, req)
+}
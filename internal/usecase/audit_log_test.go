@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAuditLogRepository is a mock implementation of AuditLogRepository
+type MockAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditLogRepository) Create(ctx context.Context, entry *domain.AuditLogEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockAuditLogRepository) List(ctx context.Context, filter domain.AuditLogFilter, limit, offset int) ([]*domain.AuditLogEntry, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	return args.Get(0).([]*domain.AuditLogEntry), args.Error(1)
+}
+
+// MockAuditRecorder is a mock implementation of AuditRecorder
+type MockAuditRecorder struct {
+	mock.Mock
+}
+
+func (m *MockAuditRecorder) Record(ctx context.Context, entry *domain.AuditLogEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func TestGetMyAuditLogUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	mockAuditRepo := new(MockAuditLogRepository)
+	useCase := NewGetMyAuditLogUseCase(mockAuditRepo)
+
+	userID := uuid.New()
+	entries := []*domain.AuditLogEntry{{ID: uuid.New(), Action: domain.AuditActionLogin}}
+
+	mockAuditRepo.On("List", ctx, domain.AuditLogFilter{AccountID: &userID}, DefaultModerationLimit, 0).Return(entries, nil)
+
+	result, err := useCase.Execute(ctx, &GetMyAuditLogRequest{UserID: userID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entries, result.Entries)
+	assert.Equal(t, DefaultModerationLimit, result.Limit)
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestListAuditLogUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+
+	t.Run("admin filters by action", func(t *testing.T) {
+		mockAuditRepo := new(MockAuditLogRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewListAuditLogUseCase(mockAuditRepo, mockUserRepo)
+
+		action := domain.AuditActionEventDelete
+		filter := domain.AuditLogFilter{Action: &action}
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}, nil)
+		mockAuditRepo.On("List", ctx, filter, 20, 40).Return([]*domain.AuditLogEntry{}, nil)
+
+		result, err := useCase.Execute(ctx, &ListAuditLogRequest{AdminID: adminID, Filter: filter, Limit: 20, Offset: 40})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 40, result.Offset)
+		mockAuditRepo.AssertExpectations(t)
+	})
+
+	t.Run("moderators cannot query the audit log", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewListAuditLogUseCase(new(MockAuditLogRepository), mockUserRepo)
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleModerator}, nil)
+
+		_, err := useCase.Execute(ctx, &ListAuditLogRequest{AdminID: adminID})
+
+		assert.Equal(t, ErrPlatformAdminOnly, err)
+	})
+
+	t.Run("invalid action filter", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewListAuditLogUseCase(new(MockAuditLogRepository), mockUserRepo)
+
+		action := domain.AuditAction("event_view")
+		mockUserRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}, nil)
+
+		_, err := useCase.Execute(ctx, &ListAuditLogRequest{AdminID: adminID, Filter: domain.AuditLogFilter{Action: &action}})
+
+		assert.Equal(t, domain.ErrInvalidAuditAction, err)
+	})
+}
+
+func TestAuditRecording(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("group deletion keeps the deleted group", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockRecorder := new(MockAuditRecorder)
+		useCase := NewDeleteGroupUseCase(mockGroupRepo)
+		useCase.auditRecorder = mockRecorder
+
+		groupID := uuid.New()
+		ownerID := uuid.New()
+		group := &domain.Group{ID: groupID, Name: "Lisbon Commander", OwnerUserID: ownerID, IsActive: true}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(group, nil)
+		mockGroupRepo.On("IsGroupOwner", ctx, groupID, ownerID).Return(true, nil)
+		mockGroupRepo.On("Delete", ctx, groupID).Return(nil)
+		mockRecorder.On("Record", ctx, mock.MatchedBy(func(entry *domain.AuditLogEntry) bool {
+			return entry.Action == domain.AuditActionGroupDelete && *entry.ActorID == ownerID &&
+				entry.TargetID == groupID && entry.Before["name"] == "Lisbon Commander" && entry.After == nil
+		})).Return(nil)
+
+		err := useCase.Execute(ctx, &DeleteGroupRequest{GroupID: groupID, UserID: ownerID})
+
+		assert.NoError(t, err)
+		mockRecorder.AssertExpectations(t)
+	})
+
+	t.Run("member role change records before and after", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockRecorder := new(MockAuditRecorder)
+		useCase := NewUpdateMemberRoleUseCase(mockGroupRepo)
+		useCase.auditRecorder = mockRecorder
+
+		groupID := uuid.New()
+		ownerID := uuid.New()
+		memberID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, OwnerUserID: ownerID, IsActive: true}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, memberID).Return(&domain.GroupMember{GroupID: groupID, UserID: memberID, Role: domain.GroupRoleMember}, nil)
+		mockGroupRepo.On("GetMemberRole", ctx, groupID, ownerID).Return(domain.GroupRoleOwner, nil)
+		mockGroupRepo.On("UpdateMemberRole", ctx, groupID, memberID, domain.GroupRoleAdmin).Return(nil)
+		mockRecorder.On("Record", ctx, mock.MatchedBy(func(entry *domain.AuditLogEntry) bool {
+			return entry.Action == domain.AuditActionRoleChange && *entry.SubjectUserID == memberID &&
+				entry.Before["role"] == "member" && entry.After["role"] == "admin"
+		})).Return(nil)
+
+		_, err := useCase.Execute(ctx, &UpdateMemberRoleRequest{GroupID: groupID, UserID: memberID, UpdaterID: ownerID, NewRole: domain.GroupRoleAdmin})
+
+		assert.NoError(t, err)
+		mockRecorder.AssertExpectations(t)
+	})
+
+	t.Run("recorder failure does not fail the change", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockRecorder := new(MockAuditRecorder)
+		useCase := NewDeleteGroupUseCase(mockGroupRepo)
+		useCase.auditRecorder = mockRecorder
+
+		groupID := uuid.New()
+		ownerID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, OwnerUserID: ownerID, IsActive: true}, nil)
+		mockGroupRepo.On("IsGroupOwner", ctx, groupID, ownerID).Return(true, nil)
+		mockGroupRepo.On("Delete", ctx, groupID).Return(nil)
+		mockRecorder.On("Record", ctx, mock.Anything).Return(errors.New("connection refused"))
+
+		err := useCase.Execute(ctx, &DeleteGroupRequest{GroupID: groupID, UserID: ownerID})
+
+		assert.NoError(t, err)
+	})
+}
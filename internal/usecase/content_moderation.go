@@ -0,0 +1,470 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrReportNotFound            = errors.New("report not found")
+	ErrReportTargetNotFound      = errors.New("reported content not found")
+	ErrDuplicateReport           = errors.New("you already have an open report about this content")
+	ErrPlatformModeratorOnly     = errors.New("only platform moderators can review reports")
+	ErrModerationActionDismissed = errors.New("dismissed reports cannot carry a moderation action")
+)
+
+// ReportContentRequest represents the request to report an event, group, venue or user
+type ReportContentRequest struct {
+	ReporterID uuid.UUID               `json:"reporter_id" validate:"required"` // User making the request
+	TargetType domain.ReportTargetType `json:"target_type" validate:"required"`
+	TargetID   uuid.UUID               `json:"target_id" validate:"required"`
+	Reason     domain.ReportReason     `json:"reason" validate:"required"`
+	Details    *string                 `json:"details,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ListReportsRequest represents the request to read the moderation queue
+type ListReportsRequest struct {
+	ModeratorID uuid.UUID                `json:"moderator_id" validate:"required"` // User making the request
+	Status      domain.ReportStatus      `json:"status,omitempty"`                 // Defaults to open reports
+	TargetType  *domain.ReportTargetType `json:"target_type,omitempty"`
+	Limit       int                      `json:"limit"`
+	Offset      int                      `json:"offset"`
+}
+
+// ListReportsResponse represents a page of the moderation queue
+type ListReportsResponse struct {
+	Reports []*domain.Report `json:"reports"`
+	Limit   int              `json:"limit"`
+	Offset  int              `json:"offset"`
+}
+
+// GetReportRequest represents the request to read a single report
+type GetReportRequest struct {
+	ReportID    uuid.UUID `json:"report_id" validate:"required"`
+	ModeratorID uuid.UUID `json:"moderator_id" validate:"required"` // User making the request
+}
+
+// ResolveReportRequest represents a moderator's decision on a report
+type ResolveReportRequest struct {
+	ReportID    uuid.UUID                           `json:"report_id" validate:"required"`
+	ModeratorID uuid.UUID                           `json:"moderator_id" validate:"required"` // User making the request
+	Status      domain.ReportStatus                 `json:"status" validate:"required"`       // Resolved or dismissed
+	Action      *domain.ContentModerationActionType `json:"action,omitempty"`                 // Applied to the reported content
+	Note        *string                             `json:"note,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ResolveReportResponse represents a closed report and the action taken on it, if any
+type ResolveReportResponse struct {
+	Report *domain.Report                  `json:"report"`
+	Action *domain.ContentModerationAction `json:"action,omitempty"`
+}
+
+// ListModerationActionsRequest represents the request to read the platform moderation audit log
+type ListModerationActionsRequest struct {
+	ModeratorID uuid.UUID `json:"moderator_id" validate:"required"` // User making the request
+	Limit       int       `json:"limit"`
+	Offset      int       `json:"offset"`
+}
+
+// ListModerationActionsResponse represents a page of the platform moderation audit log
+type ListModerationActionsResponse struct {
+	Actions []*domain.ContentModerationAction `json:"actions"`
+	Limit   int                               `json:"limit"`
+	Offset  int                               `json:"offset"`
+}
+
+// ReportContentUseCase handles users reporting abusive content
+type ReportContentUseCase struct {
+	reportRepo repository.ContentModerationRepository
+	eventRepo  repository.EventRepository
+	groupRepo  repository.GroupRepository
+	venueRepo  repository.VenueRepository
+	userRepo   repository.UserRepository
+}
+
+// NewReportContentUseCase creates a new ReportContentUseCase
+func NewReportContentUseCase(
+	reportRepo repository.ContentModerationRepository,
+	eventRepo repository.EventRepository,
+	groupRepo repository.GroupRepository,
+	venueRepo repository.VenueRepository,
+	userRepo repository.UserRepository,
+) *ReportContentUseCase {
+	return &ReportContentUseCase{
+		reportRepo: reportRepo,
+		eventRepo:  eventRepo,
+		groupRepo:  groupRepo,
+		venueRepo:  venueRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute files a report into the moderation queue
+func (uc *ReportContentUseCase) Execute(ctx context.Context, req *ReportContentRequest) (*domain.Report, error) {
+	now := time.Now().UTC()
+	report := &domain.Report{
+		ID:         uuid.New(),
+		ReporterID: req.ReporterID,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Reason:     req.Reason,
+		Details:    req.Details,
+		Status:     domain.ReportStatusOpen,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := report.Validate(); err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.targetExists(ctx, req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrReportTargetNotFound
+	}
+
+	duplicate, err := uc.reportRepo.HasOpenReport(ctx, req.ReporterID, req.TargetType, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate {
+		return nil, ErrDuplicateReport
+	}
+
+	if err := uc.reportRepo.CreateReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// targetExists checks that the reported content exists
+func (uc *ReportContentUseCase) targetExists(ctx context.Context, targetType domain.ReportTargetType, targetID uuid.UUID) (bool, error) {
+	switch targetType {
+	case domain.ReportTargetEvent:
+		event, err := uc.eventRepo.GetByID(ctx, targetID)
+		return event != nil, err
+	case domain.ReportTargetGroup:
+		group, err := uc.groupRepo.GetByID(ctx, targetID)
+		return group != nil, err
+	case domain.ReportTargetVenue:
+		venue, err := uc.venueRepo.GetByID(ctx, targetID)
+		return venue != nil, err
+	case domain.ReportTargetUser:
+		user, err := uc.userRepo.GetByID(ctx, targetID)
+		return user != nil, err
+	default:
+		return false, domain.ErrInvalidReportTarget
+	}
+}
+
+// ListReportsUseCase handles reading the moderation queue
+type ListReportsUseCase struct {
+	reportRepo repository.ContentModerationRepository
+	userRepo   repository.UserRepository
+}
+
+// NewListReportsUseCase creates a new ListReportsUseCase
+func NewListReportsUseCase(reportRepo repository.ContentModerationRepository, userRepo repository.UserRepository) *ListReportsUseCase {
+	return &ListReportsUseCase{
+		reportRepo: reportRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute returns reports to platform moderators, oldest first
+func (uc *ListReportsUseCase) Execute(ctx context.Context, req *ListReportsRequest) (*ListReportsResponse, error) {
+	if err := requireContentModerator(ctx, uc.userRepo, req.ModeratorID); err != nil {
+		return nil, err
+	}
+
+	status := req.Status
+	if status == "" {
+		status = domain.ReportStatusOpen
+	}
+	if !domain.IsValidReportStatus(status) {
+		return nil, domain.ErrInvalidReportStatus
+	}
+	if req.TargetType != nil && !domain.IsValidReportTargetType(*req.TargetType) {
+		return nil, domain.ErrInvalidReportTarget
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	reports, err := uc.reportRepo.GetReports(ctx, status, req.TargetType, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListReportsResponse{
+		Reports: reports,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// GetReportUseCase handles reading a single report
+type GetReportUseCase struct {
+	reportRepo repository.ContentModerationRepository
+	userRepo   repository.UserRepository
+}
+
+// NewGetReportUseCase creates a new GetReportUseCase
+func NewGetReportUseCase(reportRepo repository.ContentModerationRepository, userRepo repository.UserRepository) *GetReportUseCase {
+	return &GetReportUseCase{
+		reportRepo: reportRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute returns a report to platform moderators
+func (uc *GetReportUseCase) Execute(ctx context.Context, req *GetReportRequest) (*domain.Report, error) {
+	if err := requireContentModerator(ctx, uc.userRepo, req.ModeratorID); err != nil {
+		return nil, err
+	}
+
+	return getReport(ctx, uc.reportRepo, req.ReportID)
+}
+
+// ResolveReportUseCase handles moderators closing reports and acting on reported content
+type ResolveReportUseCase struct {
+	reportRepo repository.ContentModerationRepository
+	eventRepo  repository.EventRepository
+	groupRepo  repository.GroupRepository
+	venueRepo  repository.VenueRepository
+	userRepo   repository.UserRepository
+}
+
+// NewResolveReportUseCase creates a new ResolveReportUseCase
+func NewResolveReportUseCase(
+	reportRepo repository.ContentModerationRepository,
+	eventRepo repository.EventRepository,
+	groupRepo repository.GroupRepository,
+	venueRepo repository.VenueRepository,
+	userRepo repository.UserRepository,
+) *ResolveReportUseCase {
+	return &ResolveReportUseCase{
+		reportRepo: reportRepo,
+		eventRepo:  eventRepo,
+		groupRepo:  groupRepo,
+		venueRepo:  venueRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute resolves or dismisses a report. An action, when given, is applied to the
+// reported content and recorded in the moderation audit log.
+func (uc *ResolveReportUseCase) Execute(ctx context.Context, req *ResolveReportRequest) (*ResolveReportResponse, error) {
+	if err := requireContentModerator(ctx, uc.userRepo, req.ModeratorID); err != nil {
+		return nil, err
+	}
+
+	report, err := getReport(ctx, uc.reportRepo, req.ReportID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Action != nil && req.Status != domain.ReportStatusResolved {
+		return nil, ErrModerationActionDismissed
+	}
+
+	now := time.Now().UTC()
+	if err := report.Close(req.Status, req.ModeratorID, req.Note, now); err != nil {
+		return nil, err
+	}
+
+	var action *domain.ContentModerationAction
+	if req.Action != nil {
+		action = domain.NewContentModerationAction(req.ModeratorID, *req.Action, report.TargetID, &report.ID, req.Note, now)
+		if err := action.Validate(); err != nil {
+			return nil, err
+		}
+		if action.TargetType != report.TargetType {
+			return nil, domain.ErrContentActionTargetMismatch
+		}
+
+		if err := uc.checkActionTarget(ctx, action); err != nil {
+			return nil, err
+		}
+	}
+
+	// The report is claimed and the action applied in one transaction, so a report closed
+	// concurrently by another moderator fails here without touching the content
+	if err := uc.reportRepo.CloseReport(ctx, report, action); err != nil {
+		return nil, err
+	}
+
+	return &ResolveReportResponse{
+		Report: report,
+		Action: action,
+	}, nil
+}
+
+// checkActionTarget makes sure the reported content can be acted on before the action is applied
+func (uc *ResolveReportUseCase) checkActionTarget(ctx context.Context, action *domain.ContentModerationAction) error {
+	switch action.Action {
+	case domain.ContentActionHideEvent:
+		event, err := uc.eventRepo.GetByID(ctx, action.TargetID)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			return ErrReportTargetNotFound
+		}
+		return nil
+
+	case domain.ContentActionDeactivateGroup:
+		group, err := uc.groupRepo.GetByID(ctx, action.TargetID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return ErrReportTargetNotFound
+		}
+		return nil
+
+	case domain.ContentActionRemoveVenue:
+		venue, err := uc.venueRepo.GetByID(ctx, action.TargetID)
+		if err != nil {
+			return err
+		}
+		if venue == nil {
+			return ErrReportTargetNotFound
+		}
+		return nil
+
+	case domain.ContentActionDeactivateUser:
+		user, err := uc.userRepo.GetByID(ctx, action.TargetID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return ErrReportTargetNotFound
+		}
+		// Platform staff cannot be deactivated through the moderation queue
+		if user.PlatformRole.CanModerateContent() {
+			return ErrInsufficientPermissions
+		}
+		return nil
+
+	default:
+		return domain.ErrInvalidContentAction
+	}
+}
+
+// ListModerationActionsUseCase handles reading the platform moderation audit log
+type ListModerationActionsUseCase struct {
+	reportRepo repository.ContentModerationRepository
+	userRepo   repository.UserRepository
+}
+
+// NewListModerationActionsUseCase creates a new ListModerationActionsUseCase
+func NewListModerationActionsUseCase(reportRepo repository.ContentModerationRepository, userRepo repository.UserRepository) *ListModerationActionsUseCase {
+	return &ListModerationActionsUseCase{
+		reportRepo: reportRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// Execute returns the moderation actions taken by platform moderators, newest first
+func (uc *ListModerationActionsUseCase) Execute(ctx context.Context, req *ListModerationActionsRequest) (*ListModerationActionsResponse, error) {
+	if err := requireContentModerator(ctx, uc.userRepo, req.ModeratorID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	actions, err := uc.reportRepo.GetActions(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListModerationActionsResponse{
+		Actions: actions,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// ContentModerationUseCase combines content reporting and the platform moderation queue
+type ContentModerationUseCase struct {
+	reportContentUseCase         *ReportContentUseCase
+	listReportsUseCase           *ListReportsUseCase
+	getReportUseCase             *GetReportUseCase
+	resolveReportUseCase         *ResolveReportUseCase
+	listModerationActionsUseCase *ListModerationActionsUseCase
+}
+
+// NewContentModerationUseCase creates a new unified content moderation use case
+func NewContentModerationUseCase(
+	reportRepo repository.ContentModerationRepository,
+	eventRepo repository.EventRepository,
+	groupRepo repository.GroupRepository,
+	venueRepo repository.VenueRepository,
+	userRepo repository.UserRepository,
+) *ContentModerationUseCase {
+	return &ContentModerationUseCase{
+		reportContentUseCase:         NewReportContentUseCase(reportRepo, eventRepo, groupRepo, venueRepo, userRepo),
+		listReportsUseCase:           NewListReportsUseCase(reportRepo, userRepo),
+		getReportUseCase:             NewGetReportUseCase(reportRepo, userRepo),
+		resolveReportUseCase:         NewResolveReportUseCase(reportRepo, eventRepo, groupRepo, venueRepo, userRepo),
+		listModerationActionsUseCase: NewListModerationActionsUseCase(reportRepo, userRepo),
+	}
+}
+
+// ReportContent files a report about an event, group, venue or user
+func (uc *ContentModerationUseCase) ReportContent(ctx context.Context, req *ReportContentRequest) (*domain.Report, error) {
+	return uc.reportContentUseCase.Execute(ctx, req)
+}
+
+// ListReports returns a page of the moderation queue
+func (uc *ContentModerationUseCase) ListReports(ctx context.Context, req *ListReportsRequest) (*ListReportsResponse, error) {
+	return uc.listReportsUseCase.Execute(ctx, req)
+}
+
+// GetReport returns a single report
+func (uc *ContentModerationUseCase) GetReport(ctx context.Context, req *GetReportRequest) (*domain.Report, error) {
+	return uc.getReportUseCase.Execute(ctx, req)
+}
+
+// ResolveReport closes a report, optionally acting on the reported content
+func (uc *ContentModerationUseCase) ResolveReport(ctx context.Context, req *ResolveReportRequest) (*ResolveReportResponse, error) {
+	return uc.resolveReportUseCase.Execute(ctx, req)
+}
+
+// ListModerationActions returns a page of the platform moderation audit log
+func (uc *ContentModerationUseCase) ListModerationActions(ctx context.Context, req *ListModerationActionsRequest) (*ListModerationActionsResponse, error) {
+	return uc.listModerationActionsUseCase.Execute(ctx, req)
+}
+
+// requireContentModerator returns ErrPlatformModeratorOnly unless the user is platform staff
+func requireContentModerator(ctx context.Context, userRepo repository.UserRepository, userID uuid.UUID) error {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsActive || !user.PlatformRole.CanModerateContent() {
+		return ErrPlatformModeratorOnly
+	}
+
+	return nil
+}
+
+// getReport loads a report, returning ErrReportNotFound if it does not exist
+func getReport(ctx context.Context, reportRepo repository.ContentModerationRepository, reportID uuid.UUID) (*domain.Report, error) {
+	report, err := reportRepo.GetReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, ErrReportNotFound
+	}
+
+	return report, nil
+}
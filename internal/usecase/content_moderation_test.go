@@ -0,0 +1,386 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockContentModerationRepository is a mock implementation of ContentModerationRepository
+type MockContentModerationRepository struct {
+	mock.Mock
+}
+
+func (m *MockContentModerationRepository) CreateReport(ctx context.Context, report *domain.Report) error {
+	args := m.Called(ctx, report)
+	return args.Error(0)
+}
+
+func (m *MockContentModerationRepository) GetReport(ctx context.Context, id uuid.UUID) (*domain.Report, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Report), args.Error(1)
+}
+
+func (m *MockContentModerationRepository) HasOpenReport(ctx context.Context, reporterID uuid.UUID, targetType domain.ReportTargetType, targetID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, reporterID, targetType, targetID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockContentModerationRepository) GetReports(ctx context.Context, status domain.ReportStatus, targetType *domain.ReportTargetType, limit, offset int) ([]*domain.Report, error) {
+	args := m.Called(ctx, status, targetType, limit, offset)
+	return args.Get(0).([]*domain.Report), args.Error(1)
+}
+
+func (m *MockContentModerationRepository) CloseReport(ctx context.Context, report *domain.Report, action *domain.ContentModerationAction) error {
+	args := m.Called(ctx, report, action)
+	return args.Error(0)
+}
+
+func (m *MockContentModerationRepository) SetEventHidden(ctx context.Context, eventID uuid.UUID, hiddenAt *time.Time) error {
+	args := m.Called(ctx, eventID, hiddenAt)
+	return args.Error(0)
+}
+
+func (m *MockContentModerationRepository) GetActions(ctx context.Context, limit, offset int) ([]*domain.ContentModerationAction, error) {
+	args := m.Called(ctx, limit, offset)
+	return args.Get(0).([]*domain.ContentModerationAction), args.Error(1)
+}
+
+func newOpenReport(targetType domain.ReportTargetType, targetID uuid.UUID) *domain.Report {
+	now := time.Now().UTC()
+	return &domain.Report{
+		ID:         uuid.New(),
+		ReporterID: uuid.New(),
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     domain.ReportReasonSpam,
+		Status:     domain.ReportStatusOpen,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func TestReportContentUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("user reports a spam event", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockEventRepo := new(MockEventRepository)
+		useCase := NewReportContentUseCase(mockReportRepo, mockEventRepo, new(MockGroupRepository), NewMockVenueRepository(), new(MockUserRepository))
+
+		reporterID := uuid.New()
+		eventID := uuid.New()
+
+		mockEventRepo.On("GetByID", ctx, eventID).Return(&domain.Event{ID: eventID}, nil)
+		mockReportRepo.On("HasOpenReport", ctx, reporterID, domain.ReportTargetEvent, eventID).Return(false, nil)
+		mockReportRepo.On("CreateReport", ctx, mock.AnythingOfType("*domain.Report")).Return(nil)
+
+		report, err := useCase.Execute(ctx, &ReportContentRequest{
+			ReporterID: reporterID,
+			TargetType: domain.ReportTargetEvent,
+			TargetID:   eventID,
+			Reason:     domain.ReportReasonSpam,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReportStatusOpen, report.Status)
+		assert.Equal(t, eventID, report.TargetID)
+		mockReportRepo.AssertExpectations(t)
+	})
+
+	t.Run("reported content does not exist", func(t *testing.T) {
+		useCase := NewReportContentUseCase(new(MockContentModerationRepository), new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), new(MockUserRepository))
+		venueID := uuid.New()
+
+		_, err := useCase.Execute(ctx, &ReportContentRequest{
+			ReporterID: uuid.New(),
+			TargetType: domain.ReportTargetVenue,
+			TargetID:   venueID,
+			Reason:     domain.ReportReasonMisleadingInformation,
+		})
+
+		assert.Equal(t, ErrReportTargetNotFound, err)
+	})
+
+	t.Run("duplicate open report", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewReportContentUseCase(mockReportRepo, new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		reporterID := uuid.New()
+		userID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID}, nil)
+		mockReportRepo.On("HasOpenReport", ctx, reporterID, domain.ReportTargetUser, userID).Return(true, nil)
+
+		_, err := useCase.Execute(ctx, &ReportContentRequest{
+			ReporterID: reporterID,
+			TargetType: domain.ReportTargetUser,
+			TargetID:   userID,
+			Reason:     domain.ReportReasonHarassment,
+		})
+
+		assert.Equal(t, ErrDuplicateReport, err)
+		mockReportRepo.AssertNotCalled(t, "CreateReport", mock.Anything, mock.Anything)
+	})
+
+	t.Run("other reason requires details", func(t *testing.T) {
+		useCase := NewReportContentUseCase(new(MockContentModerationRepository), new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), new(MockUserRepository))
+
+		_, err := useCase.Execute(ctx, &ReportContentRequest{
+			ReporterID: uuid.New(),
+			TargetType: domain.ReportTargetGroup,
+			TargetID:   uuid.New(),
+			Reason:     domain.ReportReasonOther,
+		})
+
+		assert.Equal(t, domain.ErrReportDetailsRequired, err)
+	})
+}
+
+func TestListReportsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moderator reads the open queue", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewListReportsUseCase(mockReportRepo, mockUserRepo)
+		moderatorID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(&domain.User{ID: moderatorID, IsActive: true, PlatformRole: domain.PlatformRoleModerator}, nil)
+		mockReportRepo.On("GetReports", ctx, domain.ReportStatusOpen, (*domain.ReportTargetType)(nil), DefaultModerationLimit, 0).
+			Return([]*domain.Report{newOpenReport(domain.ReportTargetEvent, uuid.New())}, nil)
+
+		resp, err := useCase.Execute(ctx, &ListReportsRequest{ModeratorID: moderatorID})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Reports, 1)
+		assert.Equal(t, DefaultModerationLimit, resp.Limit)
+	})
+
+	t.Run("regular users cannot read the queue", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewListReportsUseCase(new(MockContentModerationRepository), mockUserRepo)
+		userID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, IsActive: true, PlatformRole: domain.PlatformRoleUser}, nil)
+
+		_, err := useCase.Execute(ctx, &ListReportsRequest{ModeratorID: userID})
+
+		assert.Equal(t, ErrPlatformModeratorOnly, err)
+	})
+}
+
+func TestResolveReportUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	moderatorID := uuid.New()
+	moderator := &domain.User{ID: moderatorID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}
+
+	t.Run("hides a reported event", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		mockEventRepo := new(MockEventRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, mockEventRepo, new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		eventID := uuid.New()
+		report := newOpenReport(domain.ReportTargetEvent, eventID)
+		action := domain.ContentActionHideEvent
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockEventRepo.On("GetByID", ctx, eventID).Return(&domain.Event{ID: eventID}, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+		mockReportRepo.On("CloseReport", ctx, report, mock.MatchedBy(func(a *domain.ContentModerationAction) bool {
+			return a.Action == domain.ContentActionHideEvent && a.TargetID == eventID
+		})).Return(nil)
+
+		resp, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusResolved,
+			Action:      &action,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReportStatusResolved, resp.Report.Status)
+		assert.Equal(t, &moderatorID, resp.Report.ResolvedBy)
+		assert.Equal(t, eventID, resp.Action.TargetID)
+		assert.Equal(t, &report.ID, resp.Action.ReportID)
+		mockReportRepo.AssertExpectations(t)
+	})
+
+	t.Run("hiding an event that no longer exists", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		mockEventRepo := new(MockEventRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, mockEventRepo, new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		eventID := uuid.New()
+		report := newOpenReport(domain.ReportTargetEvent, eventID)
+		action := domain.ContentActionHideEvent
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockEventRepo.On("GetByID", ctx, eventID).Return(nil, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+
+		_, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusResolved,
+			Action:      &action,
+		})
+
+		assert.Equal(t, ErrReportTargetNotFound, err)
+		mockReportRepo.AssertNotCalled(t, "CloseReport", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("deactivates a reported user", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		userID := uuid.New()
+		report := newOpenReport(domain.ReportTargetUser, userID)
+		action := domain.ContentActionDeactivateUser
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, IsActive: true, PlatformRole: domain.PlatformRoleUser}, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+		mockReportRepo.On("CloseReport", ctx, report, mock.MatchedBy(func(a *domain.ContentModerationAction) bool {
+			return a.Action == domain.ContentActionDeactivateUser && a.TargetID == userID
+		})).Return(nil)
+
+		_, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusResolved,
+			Action:      &action,
+		})
+
+		assert.NoError(t, err)
+		mockUserRepo.AssertExpectations(t)
+		mockReportRepo.AssertExpectations(t)
+	})
+
+	t.Run("report closed concurrently by another moderator", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		userID := uuid.New()
+		report := newOpenReport(domain.ReportTargetUser, userID)
+		action := domain.ContentActionDeactivateUser
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, IsActive: true, PlatformRole: domain.PlatformRoleUser}, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+		mockReportRepo.On("CloseReport", ctx, report, mock.AnythingOfType("*domain.ContentModerationAction")).Return(domain.ErrReportAlreadyClosed)
+
+		resp, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusResolved,
+			Action:      &action,
+		})
+
+		assert.Equal(t, domain.ErrReportAlreadyClosed, err)
+		assert.Nil(t, resp)
+		mockUserRepo.AssertNotCalled(t, "SetActive", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("platform staff cannot be deactivated from the queue", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		staffID := uuid.New()
+		report := newOpenReport(domain.ReportTargetUser, staffID)
+		action := domain.ContentActionDeactivateUser
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockUserRepo.On("GetByID", ctx, staffID).Return(&domain.User{ID: staffID, IsActive: true, PlatformRole: domain.PlatformRoleModerator}, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+
+		_, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusResolved,
+			Action:      &action,
+		})
+
+		assert.Equal(t, ErrInsufficientPermissions, err)
+		mockUserRepo.AssertNotCalled(t, "SetActive", mock.Anything, mock.Anything, mock.Anything)
+		mockReportRepo.AssertNotCalled(t, "CloseReport", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("action must match the reported content", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		report := newOpenReport(domain.ReportTargetGroup, uuid.New())
+		action := domain.ContentActionRemoveVenue
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+
+		_, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusResolved,
+			Action:      &action,
+		})
+
+		assert.Equal(t, domain.ErrContentActionTargetMismatch, err)
+	})
+
+	t.Run("dismiss without action", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		report := newOpenReport(domain.ReportTargetVenue, uuid.New())
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+		mockReportRepo.On("CloseReport", ctx, report, (*domain.ContentModerationAction)(nil)).Return(nil)
+
+		resp, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusDismissed,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReportStatusDismissed, resp.Report.Status)
+		assert.Nil(t, resp.Action)
+	})
+
+	t.Run("dismissed reports cannot carry an action", func(t *testing.T) {
+		mockReportRepo := new(MockContentModerationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewResolveReportUseCase(mockReportRepo, new(MockEventRepository), new(MockGroupRepository), NewMockVenueRepository(), mockUserRepo)
+
+		report := newOpenReport(domain.ReportTargetEvent, uuid.New())
+		action := domain.ContentActionHideEvent
+
+		mockUserRepo.On("GetByID", ctx, moderatorID).Return(moderator, nil)
+		mockReportRepo.On("GetReport", ctx, report.ID).Return(report, nil)
+
+		_, err := useCase.Execute(ctx, &ResolveReportRequest{
+			ReportID:    report.ID,
+			ModeratorID: moderatorID,
+			Status:      domain.ReportStatusDismissed,
+			Action:      &action,
+		})
+
+		assert.Equal(t, ErrModerationActionDismissed, err)
+	})
+}
@@ -0,0 +1,403 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrDataExportNotFound    = errors.New("data export not found")
+	ErrDataExportRateLimited = errors.New("too many data exports requested, try again later")
+	ErrDataExportNotReady    = errors.New("data export is not ready for download")
+	ErrInvalidDownloadLink   = errors.New("download link is invalid or has expired")
+)
+
+const (
+	// MaxDataExportsPerDay caps how many archives a user can request in a rolling 24 hours
+	MaxDataExportsPerDay = 3
+	// DataExportLinkTTL is how long a finished archive can be downloaded
+	DataExportLinkTTL = 72 * time.Hour
+	// DefaultDataExportBatchSize is how many pending exports one processing run builds
+	DefaultDataExportBatchSize = 5
+	// DataExportProcessingTimeout is how long an export may stay processing before it is assumed lost
+	DataExportProcessingTimeout = 30 * time.Minute
+	// DataExportMaxAttempts is how many times an export is claimed before a lost one is marked failed
+	DataExportMaxAttempts = 3
+)
+
+// UserDataExporter gathers everything stored about a user
+type UserDataExporter interface {
+	ExportUserData(ctx context.Context, userID uuid.UUID) (map[string]interface{}, error)
+}
+
+// DataExportStorage stores finished export archives
+type DataExportStorage interface {
+	Save(ctx context.Context, key string, data []byte) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// DataExportLinkSigner creates and verifies expiring download links
+type DataExportLinkSigner interface {
+	SignedURL(exportID uuid.UUID, expiresAt time.Time) string
+	Verify(exportID uuid.UUID, expires, signature string, now time.Time) bool
+}
+
+// DataExportNotifier tells users their archive is ready
+type DataExportNotifier interface {
+	OnDataExportReady(ctx context.Context, userID uuid.UUID, downloadURL string, expiresAt time.Time) error
+}
+
+// RequestDataExportRequest represents a user's request for an archive of their data
+type RequestDataExportRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// GetDataExportRequest represents a user's request for the state of one of their exports
+type GetDataExportRequest struct {
+	ExportID uuid.UUID `json:"export_id" validate:"required"`
+	UserID   uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// DownloadDataExportRequest represents a download through a signed link
+type DownloadDataExportRequest struct {
+	ExportID  uuid.UUID `json:"export_id" validate:"required"`
+	Expires   string    `json:"expires" validate:"required"`
+	Signature string    `json:"signature" validate:"required"`
+}
+
+// DataExportResponse represents an export and, once it is ready, its download link
+type DataExportResponse struct {
+	Export      *domain.DataExport `json:"export"`
+	DownloadURL *string            `json:"download_url,omitempty"`
+}
+
+// DataExportDownload is an open archive ready to stream to the user
+type DataExportDownload struct {
+	Archive  io.ReadCloser
+	FileName string
+	Size     int64
+}
+
+// RequestDataExportUseCase handles users requesting an archive of their data
+type RequestDataExportUseCase struct {
+	exportRepo repository.DataExportRepository
+}
+
+// NewRequestDataExportUseCase creates a new RequestDataExportUseCase
+func NewRequestDataExportUseCase(exportRepo repository.DataExportRepository) *RequestDataExportUseCase {
+	return &RequestDataExportUseCase{
+		exportRepo: exportRepo,
+	}
+}
+
+// Execute queues an export. An export that is already queued or being built is returned instead of a new one.
+func (uc *RequestDataExportUseCase) Execute(ctx context.Context, req *RequestDataExportRequest) (*domain.DataExport, error) {
+	active, err := uc.exportRepo.GetActive(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return active, nil
+	}
+
+	now := time.Now().UTC()
+
+	count, err := uc.exportRepo.CountSince(ctx, req.UserID, now.Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxDataExportsPerDay {
+		return nil, ErrDataExportRateLimited
+	}
+
+	export := domain.NewDataExport(req.UserID, now)
+	if err := export.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.exportRepo.Create(ctx, export); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// GetDataExportUseCase handles users checking on their exports
+type GetDataExportUseCase struct {
+	exportRepo repository.DataExportRepository
+	signer     DataExportLinkSigner
+}
+
+// NewGetDataExportUseCase creates a new GetDataExportUseCase
+func NewGetDataExportUseCase(exportRepo repository.DataExportRepository, signer DataExportLinkSigner) *GetDataExportUseCase {
+	return &GetDataExportUseCase{
+		exportRepo: exportRepo,
+		signer:     signer,
+	}
+}
+
+// Execute returns the export with a fresh download link while it can still be downloaded
+func (uc *GetDataExportUseCase) Execute(ctx context.Context, req *GetDataExportRequest) (*DataExportResponse, error) {
+	export, err := uc.exportRepo.GetByID(ctx, req.ExportID)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil || export.UserID != req.UserID {
+		return nil, ErrDataExportNotFound
+	}
+
+	response := &DataExportResponse{Export: export}
+	if export.IsDownloadable(time.Now()) {
+		downloadURL := uc.signer.SignedURL(export.ID, *export.ExpiresAt)
+		response.DownloadURL = &downloadURL
+	}
+
+	return response, nil
+}
+
+// DownloadDataExportUseCase handles downloads through signed links
+type DownloadDataExportUseCase struct {
+	exportRepo repository.DataExportRepository
+	storage    DataExportStorage
+	signer     DataExportLinkSigner
+}
+
+// NewDownloadDataExportUseCase creates a new DownloadDataExportUseCase
+func NewDownloadDataExportUseCase(exportRepo repository.DataExportRepository, storage DataExportStorage, signer DataExportLinkSigner) *DownloadDataExportUseCase {
+	return &DownloadDataExportUseCase{
+		exportRepo: exportRepo,
+		storage:    storage,
+		signer:     signer,
+	}
+}
+
+// Execute verifies the link and opens the archive. The caller must close the returned archive.
+func (uc *DownloadDataExportUseCase) Execute(ctx context.Context, req *DownloadDataExportRequest) (*DataExportDownload, error) {
+	now := time.Now()
+
+	if !uc.signer.Verify(req.ExportID, req.Expires, req.Signature, now) {
+		return nil, ErrInvalidDownloadLink
+	}
+
+	export, err := uc.exportRepo.GetByID(ctx, req.ExportID)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil {
+		return nil, ErrDataExportNotFound
+	}
+	if !export.IsDownloadable(now) {
+		return nil, ErrDataExportNotReady
+	}
+
+	archive, err := uc.storage.Open(ctx, *export.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+
+	download := &DataExportDownload{
+		Archive:  archive,
+		FileName: fmt.Sprintf("matchtcg-data-export-%s.zip", export.CreatedAt.Format("2006-01-02")),
+	}
+	if export.FileSize != nil {
+		download.Size = *export.FileSize
+	}
+
+	return download, nil
+}
+
+// ProcessDataExportsUseCase builds queued export archives in the background
+type ProcessDataExportsUseCase struct {
+	exportRepo   repository.DataExportRepository
+	exporter     UserDataExporter
+	storage      DataExportStorage
+	signer       DataExportLinkSigner
+	buildArchive func(data map[string]interface{}) ([]byte, error)
+	notifier     DataExportNotifier
+}
+
+// NewProcessDataExportsUseCase creates a new ProcessDataExportsUseCase. buildArchive turns the
+// gathered data into the downloadable file.
+func NewProcessDataExportsUseCase(
+	exportRepo repository.DataExportRepository,
+	exporter UserDataExporter,
+	storage DataExportStorage,
+	signer DataExportLinkSigner,
+	buildArchive func(data map[string]interface{}) ([]byte, error),
+) *ProcessDataExportsUseCase {
+	return &ProcessDataExportsUseCase{
+		exportRepo:   exportRepo,
+		exporter:     exporter,
+		storage:      storage,
+		signer:       signer,
+		buildArchive: buildArchive,
+	}
+}
+
+// Execute builds up to batchSize pending exports and returns how many became ready. Exports a
+// crashed worker left processing are put back in the queue first.
+func (uc *ProcessDataExportsUseCase) Execute(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultDataExportBatchSize
+	}
+
+	startedBefore := time.Now().UTC().Add(-DataExportProcessingTimeout)
+	requeued, failed, err := uc.exportRepo.RecoverStale(ctx, startedBefore, DataExportMaxAttempts)
+	if err != nil {
+		log.Printf("Failed to recover stale data exports: %v", err)
+	} else if requeued > 0 || failed > 0 {
+		log.Printf("Recovered stale data exports: %d requeued, %d failed", requeued, failed)
+	}
+
+	exports, err := uc.exportRepo.ClaimPending(ctx, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	ready := 0
+	for _, export := range exports {
+		if err := uc.process(ctx, export); err != nil {
+			log.Printf("Failed to build data export %s: %v", export.ID, err)
+
+			export.MarkFailed(err.Error(), time.Now().UTC())
+			if err := uc.exportRepo.Update(ctx, export); err != nil {
+				log.Printf("Failed to mark data export %s as failed: %v", export.ID, err)
+			}
+			continue
+		}
+		ready++
+	}
+
+	return ready, nil
+}
+
+// process builds and stores one archive, then tells the user where to download it
+func (uc *ProcessDataExportsUseCase) process(ctx context.Context, export *domain.DataExport) error {
+	export.MarkProcessing()
+
+	data, err := uc.exporter.ExportUserData(ctx, export.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to gather user data: %w", err)
+	}
+
+	archive, err := uc.buildArchive(data)
+	if err != nil {
+		return fmt.Errorf("failed to build archive: %w", err)
+	}
+
+	storageKey := export.ID.String() + ".zip"
+	if err := uc.storage.Save(ctx, storageKey, archive); err != nil {
+		return fmt.Errorf("failed to store archive: %w", err)
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(DataExportLinkTTL)
+	export.MarkReady(storageKey, int64(len(archive)), now, expiresAt)
+
+	if err := uc.exportRepo.Update(ctx, export); err != nil {
+		if deleteErr := uc.storage.Delete(ctx, storageKey); deleteErr != nil {
+			log.Printf("Failed to remove orphaned data export archive %s: %v", storageKey, deleteErr)
+		}
+		return fmt.Errorf("failed to save export state: %w", err)
+	}
+
+	if uc.notifier != nil {
+		downloadURL := uc.signer.SignedURL(export.ID, expiresAt)
+		if err := uc.notifier.OnDataExportReady(ctx, export.UserID, downloadURL, expiresAt); err != nil {
+			log.Printf("Failed to notify user %s that data export %s is ready: %v", export.UserID, export.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// DataExportScheduler periodically builds queued export archives
+type DataExportScheduler struct {
+	processUseCase *ProcessDataExportsUseCase
+	batchSize      int
+	interval       time.Duration
+}
+
+// NewDataExportScheduler creates a new data export scheduler
+func NewDataExportScheduler(processUseCase *ProcessDataExportsUseCase, batchSize int, interval time.Duration) *DataExportScheduler {
+	return &DataExportScheduler{
+		processUseCase: processUseCase,
+		batchSize:      batchSize,
+		interval:       interval,
+	}
+}
+
+// Start processes queued exports until the context is cancelled
+func (s *DataExportScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.processUseCase.Execute(ctx, s.batchSize); err != nil {
+				log.Printf("Error processing data exports: %v", err)
+			}
+		}
+	}
+}
+
+// DataExportUseCase combines requesting, tracking, building and downloading data export archives
+type DataExportUseCase struct {
+	requestDataExportUseCase  *RequestDataExportUseCase
+	getDataExportUseCase      *GetDataExportUseCase
+	downloadDataExportUseCase *DownloadDataExportUseCase
+	processDataExportsUseCase *ProcessDataExportsUseCase
+}
+
+// NewDataExportUseCase creates a new DataExportUseCase
+func NewDataExportUseCase(
+	exportRepo repository.DataExportRepository,
+	exporter UserDataExporter,
+	storage DataExportStorage,
+	signer DataExportLinkSigner,
+	buildArchive func(data map[string]interface{}) ([]byte, error),
+) *DataExportUseCase {
+	return &DataExportUseCase{
+		requestDataExportUseCase:  NewRequestDataExportUseCase(exportRepo),
+		getDataExportUseCase:      NewGetDataExportUseCase(exportRepo, signer),
+		downloadDataExportUseCase: NewDownloadDataExportUseCase(exportRepo, storage, signer),
+		processDataExportsUseCase: NewProcessDataExportsUseCase(exportRepo, exporter, storage, signer, buildArchive),
+	}
+}
+
+// SetNotifier enables emailing users when their archive is ready
+func (uc *DataExportUseCase) SetNotifier(notifier DataExportNotifier) {
+	uc.processDataExportsUseCase.notifier = notifier
+}
+
+// RequestExport queues an archive of the user's data
+func (uc *DataExportUseCase) RequestExport(ctx context.Context, req *RequestDataExportRequest) (*domain.DataExport, error) {
+	return uc.requestDataExportUseCase.Execute(ctx, req)
+}
+
+// GetExport returns one of the user's exports
+func (uc *DataExportUseCase) GetExport(ctx context.Context, req *GetDataExportRequest) (*DataExportResponse, error) {
+	return uc.getDataExportUseCase.Execute(ctx, req)
+}
+
+// Download opens an archive through a signed link
+func (uc *DataExportUseCase) Download(ctx context.Context, req *DownloadDataExportRequest) (*DataExportDownload, error) {
+	return uc.downloadDataExportUseCase.Execute(ctx, req)
+}
+
+// Scheduler returns a scheduler that builds queued archives in the background
+func (uc *DataExportUseCase) Scheduler(batchSize int, interval time.Duration) *DataExportScheduler {
+	return NewDataExportScheduler(uc.processDataExportsUseCase, batchSize, interval)
+}
@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDataExportRepository is a mock implementation of DataExportRepository
+type MockDataExportRepository struct {
+	mock.Mock
+}
+
+func (m *MockDataExportRepository) Create(ctx context.Context, export *domain.DataExport) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+func (m *MockDataExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.DataExport, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DataExport), args.Error(1)
+}
+
+func (m *MockDataExportRepository) Update(ctx context.Context, export *domain.DataExport) error {
+	args := m.Called(ctx, export)
+	return args.Error(0)
+}
+
+func (m *MockDataExportRepository) CountSince(ctx context.Context, userID uuid.UUID, since time.Time) (int, error) {
+	args := m.Called(ctx, userID, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDataExportRepository) GetActive(ctx context.Context, userID uuid.UUID) (*domain.DataExport, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DataExport), args.Error(1)
+}
+
+func (m *MockDataExportRepository) ClaimPending(ctx context.Context, limit int) ([]*domain.DataExport, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*domain.DataExport), args.Error(1)
+}
+
+func (m *MockDataExportRepository) RecoverStale(ctx context.Context, startedBefore time.Time, maxAttempts int) (int, int, error) {
+	args := m.Called(ctx, startedBefore, maxAttempts)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+// memoryExportStorage keeps archives in memory
+type memoryExportStorage struct {
+	files map[string][]byte
+}
+
+func (s *memoryExportStorage) Save(ctx context.Context, key string, data []byte) error {
+	s.files[key] = data
+	return nil
+}
+
+func (s *memoryExportStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, ok := s.files[key]
+	if !ok {
+		return nil, errors.New("archive not found")
+	}
+	return io.NopCloser(strings.NewReader(string(data))), nil
+}
+
+func (s *memoryExportStorage) Delete(ctx context.Context, key string) error {
+	delete(s.files, key)
+	return nil
+}
+
+// stubLinkSigner accepts the signature "valid" for any unexpired link
+type stubLinkSigner struct{}
+
+func (stubLinkSigner) SignedURL(exportID uuid.UUID, expiresAt time.Time) string {
+	return "https://api.example.com/exports/" + exportID.String() + "/download"
+}
+
+func (stubLinkSigner) Verify(exportID uuid.UUID, expires, signature string, now time.Time) bool {
+	return signature == "valid"
+}
+
+// stubUserDataExporter returns fixed data or an error
+type stubUserDataExporter struct {
+	data map[string]interface{}
+	err  error
+}
+
+func (e *stubUserDataExporter) ExportUserData(ctx context.Context, userID uuid.UUID) (map[string]interface{}, error) {
+	return e.data, e.err
+}
+
+// MockDataExportNotifier is a mock implementation of DataExportNotifier
+type MockDataExportNotifier struct {
+	mock.Mock
+}
+
+func (m *MockDataExportNotifier) OnDataExportReady(ctx context.Context, userID uuid.UUID, downloadURL string, expiresAt time.Time) error {
+	args := m.Called(ctx, userID, downloadURL, expiresAt)
+	return args.Error(0)
+}
+
+func TestRequestDataExportUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("queues a new export", func(t *testing.T) {
+		exportRepo := new(MockDataExportRepository)
+		exportRepo.On("GetActive", mock.Anything, userID).Return(nil, nil)
+		exportRepo.On("CountSince", mock.Anything, userID, mock.AnythingOfType("time.Time")).Return(1, nil)
+		exportRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.DataExport")).Return(nil)
+
+		export, err := NewRequestDataExportUseCase(exportRepo).Execute(context.Background(), &RequestDataExportRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.DataExportStatusPending, export.Status)
+		assert.Equal(t, userID, export.UserID)
+		exportRepo.AssertExpectations(t)
+	})
+
+	t.Run("returns the export already in progress", func(t *testing.T) {
+		active := domain.NewDataExport(userID, time.Now())
+		exportRepo := new(MockDataExportRepository)
+		exportRepo.On("GetActive", mock.Anything, userID).Return(active, nil)
+
+		export, err := NewRequestDataExportUseCase(exportRepo).Execute(context.Background(), &RequestDataExportRequest{UserID: userID})
+
+		require.NoError(t, err)
+		assert.Equal(t, active.ID, export.ID)
+		exportRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rate limits repeated requests", func(t *testing.T) {
+		exportRepo := new(MockDataExportRepository)
+		exportRepo.On("GetActive", mock.Anything, userID).Return(nil, nil)
+		exportRepo.On("CountSince", mock.Anything, userID, mock.AnythingOfType("time.Time")).Return(MaxDataExportsPerDay, nil)
+
+		export, err := NewRequestDataExportUseCase(exportRepo).Execute(context.Background(), &RequestDataExportRequest{UserID: userID})
+
+		assert.Equal(t, ErrDataExportRateLimited, err)
+		assert.Nil(t, export)
+		exportRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetDataExportUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+
+	ready := domain.NewDataExport(userID, now)
+	ready.MarkReady("archive.zip", 10, now, now.Add(time.Hour))
+
+	exportRepo := new(MockDataExportRepository)
+	exportRepo.On("GetByID", mock.Anything, ready.ID).Return(ready, nil)
+	useCase := NewGetDataExportUseCase(exportRepo, stubLinkSigner{})
+
+	result, err := useCase.Execute(context.Background(), &GetDataExportRequest{ExportID: ready.ID, UserID: userID})
+	require.NoError(t, err)
+	require.NotNil(t, result.DownloadURL)
+	assert.Contains(t, *result.DownloadURL, ready.ID.String())
+
+	// Other users cannot see the export
+	_, err = useCase.Execute(context.Background(), &GetDataExportRequest{ExportID: ready.ID, UserID: uuid.New()})
+	assert.Equal(t, ErrDataExportNotFound, err)
+}
+
+func TestDownloadDataExportUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	now := time.Now()
+	storage := &memoryExportStorage{files: map[string][]byte{"archive.zip": []byte("zip-bytes")}}
+
+	ready := domain.NewDataExport(userID, now)
+	ready.MarkReady("archive.zip", 9, now, now.Add(time.Hour))
+
+	pending := domain.NewDataExport(userID, now)
+
+	exportRepo := new(MockDataExportRepository)
+	exportRepo.On("GetByID", mock.Anything, ready.ID).Return(ready, nil)
+	exportRepo.On("GetByID", mock.Anything, pending.ID).Return(pending, nil)
+	useCase := NewDownloadDataExportUseCase(exportRepo, storage, stubLinkSigner{})
+
+	download, err := useCase.Execute(context.Background(), &DownloadDataExportRequest{ExportID: ready.ID, Expires: "1", Signature: "valid"})
+	require.NoError(t, err)
+	data, _ := io.ReadAll(download.Archive)
+	download.Archive.Close()
+	assert.Equal(t, "zip-bytes", string(data))
+	assert.Equal(t, int64(9), download.Size)
+
+	_, err = useCase.Execute(context.Background(), &DownloadDataExportRequest{ExportID: ready.ID, Expires: "1", Signature: "forged"})
+	assert.Equal(t, ErrInvalidDownloadLink, err)
+
+	_, err = useCase.Execute(context.Background(), &DownloadDataExportRequest{ExportID: pending.ID, Expires: "1", Signature: "valid"})
+	assert.Equal(t, ErrDataExportNotReady, err)
+}
+
+func TestProcessDataExportsUseCase_Execute(t *testing.T) {
+	userID := uuid.New()
+	buildArchive := func(data map[string]interface{}) ([]byte, error) {
+		return []byte("archive"), nil
+	}
+
+	t.Run("stores the archive and notifies the user", func(t *testing.T) {
+		export := domain.NewDataExport(userID, time.Now())
+		export.Status = domain.DataExportStatusProcessing
+		storage := &memoryExportStorage{files: map[string][]byte{}}
+		notifier := new(MockDataExportNotifier)
+
+		exportRepo := new(MockDataExportRepository)
+		exportRepo.On("RecoverStale", mock.Anything, mock.AnythingOfType("time.Time"), DataExportMaxAttempts).Return(0, 0, nil)
+		exportRepo.On("ClaimPending", mock.Anything, 5).Return([]*domain.DataExport{export}, nil)
+		exportRepo.On("Update", mock.Anything, export).Return(nil)
+		notifier.On("OnDataExportReady", mock.Anything, userID, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
+
+		useCase := NewProcessDataExportsUseCase(exportRepo, &stubUserDataExporter{data: map[string]interface{}{"account": "a"}}, storage, stubLinkSigner{}, buildArchive)
+		useCase.notifier = notifier
+
+		ready, err := useCase.Execute(context.Background(), 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, ready)
+		assert.Equal(t, domain.DataExportStatusReady, export.Status)
+		assert.Equal(t, []byte("archive"), storage.files[*export.StorageKey])
+		assert.WithinDuration(t, time.Now().Add(DataExportLinkTTL), *export.ExpiresAt, time.Minute)
+		exportRepo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("marks the export failed when data cannot be gathered", func(t *testing.T) {
+		export := domain.NewDataExport(userID, time.Now())
+		storage := &memoryExportStorage{files: map[string][]byte{}}
+
+		exportRepo := new(MockDataExportRepository)
+		exportRepo.On("RecoverStale", mock.Anything, mock.AnythingOfType("time.Time"), DataExportMaxAttempts).Return(0, 0, nil)
+		exportRepo.On("ClaimPending", mock.Anything, DefaultDataExportBatchSize).Return([]*domain.DataExport{export}, nil)
+		exportRepo.On("Update", mock.Anything, export).Return(nil)
+
+		useCase := NewProcessDataExportsUseCase(exportRepo, &stubUserDataExporter{err: ErrUserNotFound}, storage, stubLinkSigner{}, buildArchive)
+
+		ready, err := useCase.Execute(context.Background(), 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, ready)
+		assert.Equal(t, domain.DataExportStatusFailed, export.Status)
+		assert.Empty(t, storage.files)
+	})
+
+	t.Run("requeues exports stuck processing before claiming", func(t *testing.T) {
+		stuck := domain.NewDataExport(userID, time.Now().Add(-time.Hour))
+		stuck.Status = domain.DataExportStatusProcessing
+		storage := &memoryExportStorage{files: map[string][]byte{}}
+
+		exportRepo := new(MockDataExportRepository)
+		recovered := exportRepo.On("RecoverStale", mock.Anything, mock.MatchedBy(func(startedBefore time.Time) bool {
+			return startedBefore.Before(time.Now().Add(-DataExportProcessingTimeout + time.Minute))
+		}), DataExportMaxAttempts).Return(1, 0, nil)
+		exportRepo.On("ClaimPending", mock.Anything, DefaultDataExportBatchSize).Return([]*domain.DataExport{stuck}, nil).NotBefore(recovered)
+		exportRepo.On("Update", mock.Anything, stuck).Return(nil)
+
+		useCase := NewProcessDataExportsUseCase(exportRepo, &stubUserDataExporter{data: map[string]interface{}{"account": "a"}}, storage, stubLinkSigner{}, buildArchive)
+
+		ready, err := useCase.Execute(context.Background(), 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, ready)
+		assert.Equal(t, domain.DataExportStatusReady, stuck.Status)
+		exportRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// DefaultRetentionBatchSize is how many inactive accounts or export archives one run handles per policy
+const DefaultRetentionBatchSize = 100
+
+// InactiveAccountNotifier warns users that their inactive account has been scheduled for deletion
+type InactiveAccountNotifier interface {
+	OnInactiveAccountDeletionScheduled(ctx context.Context, userID uuid.UUID, scheduledFor time.Time) error
+}
+
+// ApplyRetentionPoliciesRequest represents a retention run
+type ApplyRetentionPoliciesRequest struct {
+	DryRun bool `json:"dry_run"` // Count affected records without changing them
+}
+
+// GetRetentionReportRequest represents an admin's request for a dry-run retention report
+type GetRetentionReportRequest struct {
+	AdminID uuid.UUID `json:"admin_id" validate:"required"` // User making the request
+}
+
+// ApplyRetentionPoliciesUseCase deletes or anonymizes records that have outlived their retention period
+type ApplyRetentionPoliciesUseCase struct {
+	retentionRepo repository.RetentionRepository
+	deletionRepo  repository.AccountDeletionRepository
+	storage       DataExportStorage
+	notifier      InactiveAccountNotifier
+	policies      []domain.RetentionPolicy
+	batchSize     int
+}
+
+// NewApplyRetentionPoliciesUseCase creates a new ApplyRetentionPoliciesUseCase
+func NewApplyRetentionPoliciesUseCase(retentionRepo repository.RetentionRepository, deletionRepo repository.AccountDeletionRepository, storage DataExportStorage, policies []domain.RetentionPolicy, batchSize int) *ApplyRetentionPoliciesUseCase {
+	if batchSize <= 0 {
+		batchSize = DefaultRetentionBatchSize
+	}
+
+	return &ApplyRetentionPoliciesUseCase{
+		retentionRepo: retentionRepo,
+		deletionRepo:  deletionRepo,
+		storage:       storage,
+		policies:      policies,
+		batchSize:     batchSize,
+	}
+}
+
+// Execute applies every enabled policy and reports what was, or in a dry run would be, affected.
+// A failing policy is recorded in its result and does not stop the others.
+func (uc *ApplyRetentionPoliciesUseCase) Execute(ctx context.Context, req *ApplyRetentionPoliciesRequest) (*domain.RetentionReport, error) {
+	for _, policy := range uc.policies {
+		if err := policy.Validate(); err != nil {
+			return nil, fmt.Errorf("%s retention policy: %w", policy.Entity, err)
+		}
+	}
+
+	now := time.Now().UTC()
+	report := &domain.RetentionReport{
+		DryRun:    req.DryRun,
+		StartedAt: now,
+		Results:   []domain.RetentionResult{},
+	}
+
+	for _, policy := range uc.policies {
+		if !policy.IsEnabled() {
+			continue
+		}
+
+		result := domain.RetentionResult{
+			Entity: policy.Entity,
+			Action: policy.Action(),
+			Cutoff: policy.Cutoff(now),
+		}
+
+		var err error
+		if req.DryRun {
+			result.Affected, err = uc.retentionRepo.CountExpired(ctx, policy.Entity, result.Cutoff)
+		} else {
+			result.Affected, err = uc.apply(ctx, policy, result.Cutoff, now)
+		}
+		if err != nil {
+			log.Printf("Failed to apply %s retention policy: %v", policy.Entity, err)
+			message := err.Error()
+			result.Error = &message
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	report.CompletedAt = time.Now().UTC()
+	return report, nil
+}
+
+// apply enforces one policy and returns how many records it affected
+func (uc *ApplyRetentionPoliciesUseCase) apply(ctx context.Context, policy domain.RetentionPolicy, cutoff, now time.Time) (int, error) {
+	switch policy.Entity {
+	case domain.RetentionEntityInactiveAccounts:
+		return uc.scheduleInactiveAccountDeletions(ctx, cutoff, now)
+	case domain.RetentionEntityExportArchives:
+		return uc.deleteExpiredExportArchives(ctx, cutoff)
+	default:
+		return uc.retentionRepo.DeleteExpired(ctx, policy.Entity, cutoff)
+	}
+}
+
+// scheduleInactiveAccountDeletions schedules inactive accounts for deletion. They go through the
+// same grace period, handover and anonymization as a deletion the user asked for, and the user is
+// emailed so they can sign in and cancel it.
+func (uc *ApplyRetentionPoliciesUseCase) scheduleInactiveAccountDeletions(ctx context.Context, cutoff, now time.Time) (int, error) {
+	userIDs, err := uc.retentionRepo.ListInactiveUsers(ctx, cutoff, uc.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	scheduled := 0
+	for _, userID := range userIDs {
+		deletion := domain.NewAccountDeletion(userID, now, AccountDeletionGracePeriod)
+		if err := uc.deletionRepo.Create(ctx, deletion); err != nil {
+			log.Printf("Failed to schedule deletion of inactive account %s: %v", userID, err)
+			continue
+		}
+		scheduled++
+
+		if uc.notifier != nil {
+			if err := uc.notifier.OnInactiveAccountDeletionScheduled(ctx, userID, deletion.ScheduledFor); err != nil {
+				log.Printf("Failed to notify user %s of the scheduled deletion of their inactive account: %v", userID, err)
+			}
+		}
+	}
+
+	return scheduled, nil
+}
+
+// deleteExpiredExportArchives removes expired archives from storage and then their records. A record
+// whose archive could not be removed is kept so the next run retries it.
+func (uc *ApplyRetentionPoliciesUseCase) deleteExpiredExportArchives(ctx context.Context, cutoff time.Time) (int, error) {
+	exports, err := uc.retentionRepo.ListExpiredDataExports(ctx, cutoff, uc.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := make([]uuid.UUID, 0, len(exports))
+	for _, export := range exports {
+		if export.StorageKey != nil {
+			if err := uc.storage.Delete(ctx, *export.StorageKey); err != nil {
+				log.Printf("Failed to delete archive of data export %s: %v", export.ID, err)
+				continue
+			}
+		}
+		removed = append(removed, export.ID)
+	}
+
+	return uc.retentionRepo.DeleteDataExports(ctx, removed)
+}
+
+// GetRetentionReportUseCase handles platform admins previewing what the retention job would do
+type GetRetentionReportUseCase struct {
+	applyUseCase *ApplyRetentionPoliciesUseCase
+	userRepo     repository.UserRepository
+}
+
+// NewGetRetentionReportUseCase creates a new GetRetentionReportUseCase
+func NewGetRetentionReportUseCase(applyUseCase *ApplyRetentionPoliciesUseCase, userRepo repository.UserRepository) *GetRetentionReportUseCase {
+	return &GetRetentionReportUseCase{
+		applyUseCase: applyUseCase,
+		userRepo:     userRepo,
+	}
+}
+
+// Execute returns a dry-run report of the configured policies
+func (uc *GetRetentionReportUseCase) Execute(ctx context.Context, req *GetRetentionReportRequest) (*domain.RetentionReport, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	return uc.applyUseCase.Execute(ctx, &ApplyRetentionPoliciesRequest{DryRun: true})
+}
+
+// RetentionScheduler periodically applies the retention policies
+type RetentionScheduler struct {
+	applyUseCase *ApplyRetentionPoliciesUseCase
+	interval     time.Duration
+	dryRun       bool
+}
+
+// NewRetentionScheduler creates a new retention scheduler. In dry-run mode it only logs what it would do.
+func NewRetentionScheduler(applyUseCase *ApplyRetentionPoliciesUseCase, interval time.Duration, dryRun bool) *RetentionScheduler {
+	return &RetentionScheduler{
+		applyUseCase: applyUseCase,
+		interval:     interval,
+		dryRun:       dryRun,
+	}
+}
+
+// Start applies the policies on every tick until the context is cancelled
+func (s *RetentionScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := s.applyUseCase.Execute(ctx, &ApplyRetentionPoliciesRequest{DryRun: s.dryRun})
+			if err != nil {
+				log.Printf("Error applying retention policies: %v", err)
+				continue
+			}
+			for _, result := range report.Results {
+				log.Printf("Retention %s (dry run: %t): %s %d record(s) older than %s",
+					result.Entity, report.DryRun, result.Action, result.Affected, result.Cutoff.Format(time.RFC3339))
+			}
+		}
+	}
+}
+
+// DataRetentionUseCase combines retention enforcement and reporting
+type DataRetentionUseCase struct {
+	applyRetentionPoliciesUseCase *ApplyRetentionPoliciesUseCase
+	getRetentionReportUseCase     *GetRetentionReportUseCase
+}
+
+// NewDataRetentionUseCase creates a new DataRetentionUseCase
+func NewDataRetentionUseCase(retentionRepo repository.RetentionRepository, deletionRepo repository.AccountDeletionRepository, userRepo repository.UserRepository, storage DataExportStorage, policies []domain.RetentionPolicy) *DataRetentionUseCase {
+	applyUseCase := NewApplyRetentionPoliciesUseCase(retentionRepo, deletionRepo, storage, policies, DefaultRetentionBatchSize)
+
+	return &DataRetentionUseCase{
+		applyRetentionPoliciesUseCase: applyUseCase,
+		getRetentionReportUseCase:     NewGetRetentionReportUseCase(applyUseCase, userRepo),
+	}
+}
+
+// SetNotifier enables emailing users whose inactive account is scheduled for deletion
+func (uc *DataRetentionUseCase) SetNotifier(notifier InactiveAccountNotifier) {
+	uc.applyRetentionPoliciesUseCase.notifier = notifier
+}
+
+// ApplyRetentionPolicies runs the retention policies once
+func (uc *DataRetentionUseCase) ApplyRetentionPolicies(ctx context.Context, req *ApplyRetentionPoliciesRequest) (*domain.RetentionReport, error) {
+	return uc.applyRetentionPoliciesUseCase.Execute(ctx, req)
+}
+
+// GetRetentionReport returns a dry-run report for platform admins
+func (uc *DataRetentionUseCase) GetRetentionReport(ctx context.Context, req *GetRetentionReportRequest) (*domain.RetentionReport, error) {
+	return uc.getRetentionReportUseCase.Execute(ctx, req)
+}
+
+// Scheduler returns a scheduler that applies the policies every interval
+func (uc *DataRetentionUseCase) Scheduler(interval time.Duration, dryRun bool) *RetentionScheduler {
+	return NewRetentionScheduler(uc.applyRetentionPoliciesUseCase, interval, dryRun)
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRetentionRepository is a mock implementation of RetentionRepository
+type MockRetentionRepository struct {
+	mock.Mock
+}
+
+func (m *MockRetentionRepository) CountExpired(ctx context.Context, entity domain.RetentionEntity, cutoff time.Time) (int, error) {
+	args := m.Called(ctx, entity, cutoff)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRetentionRepository) DeleteExpired(ctx context.Context, entity domain.RetentionEntity, cutoff time.Time) (int, error) {
+	args := m.Called(ctx, entity, cutoff)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRetentionRepository) ListInactiveUsers(ctx context.Context, cutoff time.Time, limit int) ([]uuid.UUID, error) {
+	args := m.Called(ctx, cutoff, limit)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockRetentionRepository) ListExpiredDataExports(ctx context.Context, cutoff time.Time, limit int) ([]*domain.DataExport, error) {
+	args := m.Called(ctx, cutoff, limit)
+	return args.Get(0).([]*domain.DataExport), args.Error(1)
+}
+
+func (m *MockRetentionRepository) DeleteDataExports(ctx context.Context, ids []uuid.UUID) (int, error) {
+	args := m.Called(ctx, ids)
+	return args.Int(0), args.Error(1)
+}
+
+// MockInactiveAccountNotifier is a mock implementation of InactiveAccountNotifier
+type MockInactiveAccountNotifier struct {
+	mock.Mock
+}
+
+func (m *MockInactiveAccountNotifier) OnInactiveAccountDeletionScheduled(ctx context.Context, userID uuid.UUID, scheduledFor time.Time) error {
+	args := m.Called(ctx, userID, scheduledFor)
+	return args.Error(0)
+}
+
+// failingExportStorage refuses to delete one archive
+type failingExportStorage struct {
+	memoryExportStorage
+	failKey string
+}
+
+func (s *failingExportStorage) Delete(ctx context.Context, key string) error {
+	if key == s.failKey {
+		return errors.New("storage unavailable")
+	}
+	return s.memoryExportStorage.Delete(ctx, key)
+}
+
+func TestApplyRetentionPoliciesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	day := 24 * time.Hour
+	anyCutoff := mock.AnythingOfType("time.Time")
+
+	policies := []domain.RetentionPolicy{
+		{Entity: domain.RetentionEntityNotifications, MaxAge: 90 * day},
+		{Entity: domain.RetentionEntityAuditLog, MaxAge: 0},
+		{Entity: domain.RetentionEntityInactiveAccounts, MaxAge: 3 * 365 * day},
+		{Entity: domain.RetentionEntityPastRSVPs, MaxAge: 2 * 365 * day},
+		{Entity: domain.RetentionEntityExportArchives, MaxAge: 7 * day},
+	}
+
+	t.Run("dry run only counts expired records", func(t *testing.T) {
+		retentionRepo := new(MockRetentionRepository)
+		deletionRepo := new(MockAccountDeletionRepository)
+		retentionRepo.On("CountExpired", ctx, domain.RetentionEntityNotifications, anyCutoff).Return(12, nil)
+		retentionRepo.On("CountExpired", ctx, domain.RetentionEntityInactiveAccounts, anyCutoff).Return(2, nil)
+		retentionRepo.On("CountExpired", ctx, domain.RetentionEntityPastRSVPs, anyCutoff).Return(0, errors.New("connection reset"))
+		retentionRepo.On("CountExpired", ctx, domain.RetentionEntityExportArchives, anyCutoff).Return(1, nil)
+
+		useCase := NewApplyRetentionPoliciesUseCase(retentionRepo, deletionRepo, &memoryExportStorage{files: map[string][]byte{}}, policies, 0)
+		report, err := useCase.Execute(ctx, &ApplyRetentionPoliciesRequest{DryRun: true})
+
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		require.Len(t, report.Results, 4, "disabled policies are skipped")
+		assert.Equal(t, domain.RetentionEntityNotifications, report.Results[0].Entity)
+		assert.Equal(t, domain.RetentionActionDelete, report.Results[0].Action)
+		assert.WithinDuration(t, time.Now().Add(-90*day), report.Results[0].Cutoff, time.Minute)
+		assert.Equal(t, domain.RetentionActionAnonymize, report.Results[1].Action)
+		require.NotNil(t, report.Results[2].Error, "failing policy is reported")
+		assert.Equal(t, 15, report.TotalAffected())
+
+		retentionRepo.AssertNotCalled(t, "DeleteExpired", mock.Anything, mock.Anything, mock.Anything)
+		deletionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deletes, schedules anonymization and removes archives", func(t *testing.T) {
+		userID := uuid.New()
+		now := time.Now()
+
+		removable := domain.NewDataExport(userID, now)
+		removable.MarkReady("removable.zip", 10, now, now)
+		stuck := domain.NewDataExport(userID, now)
+		stuck.MarkReady("stuck.zip", 10, now, now)
+		failed := domain.NewDataExport(userID, now)
+		failed.MarkFailed("archive too large", now)
+
+		storage := &failingExportStorage{
+			memoryExportStorage: memoryExportStorage{files: map[string][]byte{"removable.zip": []byte("a"), "stuck.zip": []byte("b")}},
+			failKey:             "stuck.zip",
+		}
+
+		retentionRepo := new(MockRetentionRepository)
+		deletionRepo := new(MockAccountDeletionRepository)
+		inactiveID := uuid.New()
+		retentionRepo.On("DeleteExpired", ctx, domain.RetentionEntityNotifications, anyCutoff).Return(12, nil)
+		retentionRepo.On("DeleteExpired", ctx, domain.RetentionEntityPastRSVPs, anyCutoff).Return(30, nil)
+		retentionRepo.On("ListInactiveUsers", ctx, anyCutoff, 5).Return([]uuid.UUID{inactiveID}, nil)
+		deletionRepo.On("Create", ctx, mock.MatchedBy(func(deletion *domain.AccountDeletion) bool {
+			return deletion.UserID == inactiveID && deletion.IsScheduled() &&
+				deletion.ScheduledFor.Sub(deletion.RequestedAt) == AccountDeletionGracePeriod
+		})).Return(nil)
+		retentionRepo.On("ListExpiredDataExports", ctx, anyCutoff, 5).Return([]*domain.DataExport{removable, stuck, failed}, nil)
+		retentionRepo.On("DeleteDataExports", ctx, []uuid.UUID{removable.ID, failed.ID}).Return(2, nil)
+
+		useCase := NewApplyRetentionPoliciesUseCase(retentionRepo, deletionRepo, storage, policies, 5)
+		report, err := useCase.Execute(ctx, &ApplyRetentionPoliciesRequest{})
+
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		assert.Equal(t, 45, report.TotalAffected())
+		assert.NotContains(t, storage.files, "removable.zip")
+		assert.Contains(t, storage.files, "stuck.zip", "archive that could not be removed is retried later")
+		retentionRepo.AssertExpectations(t)
+		deletionRepo.AssertExpectations(t)
+		retentionRepo.AssertNotCalled(t, "CountExpired", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("emails users whose inactive account was scheduled for deletion", func(t *testing.T) {
+		retentionRepo := new(MockRetentionRepository)
+		deletionRepo := new(MockAccountDeletionRepository)
+		notifier := new(MockInactiveAccountNotifier)
+		scheduledID, failedID := uuid.New(), uuid.New()
+		inactiveOnly := []domain.RetentionPolicy{{Entity: domain.RetentionEntityInactiveAccounts, MaxAge: 3 * 365 * day}}
+
+		retentionRepo.On("ListInactiveUsers", ctx, anyCutoff, DefaultRetentionBatchSize).Return([]uuid.UUID{scheduledID, failedID}, nil)
+		deletionRepo.On("Create", ctx, mock.MatchedBy(func(deletion *domain.AccountDeletion) bool {
+			return deletion.UserID == scheduledID
+		})).Return(nil)
+		deletionRepo.On("Create", ctx, mock.MatchedBy(func(deletion *domain.AccountDeletion) bool {
+			return deletion.UserID == failedID
+		})).Return(errors.New("connection reset"))
+		notifier.On("OnInactiveAccountDeletionScheduled", ctx, scheduledID, mock.MatchedBy(func(scheduledFor time.Time) bool {
+			return scheduledFor.Sub(time.Now()) > AccountDeletionGracePeriod-time.Minute
+		})).Return(nil)
+
+		useCase := NewApplyRetentionPoliciesUseCase(retentionRepo, deletionRepo, &memoryExportStorage{}, inactiveOnly, 0)
+		useCase.notifier = notifier
+		report, err := useCase.Execute(ctx, &ApplyRetentionPoliciesRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.TotalAffected())
+		notifier.AssertExpectations(t)
+		notifier.AssertNotCalled(t, "OnInactiveAccountDeletionScheduled", ctx, failedID, mock.Anything)
+	})
+
+	t.Run("rejects invalid policies", func(t *testing.T) {
+		retentionRepo := new(MockRetentionRepository)
+		invalid := []domain.RetentionPolicy{{Entity: domain.RetentionEntityAuditLog, MaxAge: time.Minute}}
+
+		useCase := NewApplyRetentionPoliciesUseCase(retentionRepo, new(MockAccountDeletionRepository), &memoryExportStorage{}, invalid, 0)
+		report, err := useCase.Execute(ctx, &ApplyRetentionPoliciesRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrRetentionPeriodTooShort)
+		assert.Nil(t, report)
+	})
+}
+
+func TestGetRetentionReportUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	policies := []domain.RetentionPolicy{{Entity: domain.RetentionEntityAuditLog, MaxAge: 365 * 24 * time.Hour}}
+
+	t.Run("returns a dry-run report to admins", func(t *testing.T) {
+		retentionRepo := new(MockRetentionRepository)
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}, nil)
+		retentionRepo.On("CountExpired", ctx, domain.RetentionEntityAuditLog, mock.AnythingOfType("time.Time")).Return(40, nil)
+
+		useCase := NewDataRetentionUseCase(retentionRepo, new(MockAccountDeletionRepository), userRepo, &memoryExportStorage{}, policies)
+		report, err := useCase.GetRetentionReport(ctx, &GetRetentionReportRequest{AdminID: adminID})
+
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, 40, report.TotalAffected())
+		retentionRepo.AssertNotCalled(t, "DeleteExpired", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects non-admins", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true}, nil)
+
+		useCase := NewDataRetentionUseCase(new(MockRetentionRepository), new(MockAccountDeletionRepository), userRepo, &memoryExportStorage{}, policies)
+		report, err := useCase.GetRetentionReport(ctx, &GetRetentionReportRequest{AdminID: adminID})
+
+		assert.Equal(t, ErrPlatformAdminOnly, err)
+		assert.Nil(t, report)
+	})
+}
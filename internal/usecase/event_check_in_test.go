@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckInAttendeeUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	newGroupEvent := func() *domain.Event {
+		groupID := uuid.New()
+		return &domain.Event{
+			ID:         uuid.New(),
+			HostUserID: uuid.New(),
+			GroupID:    &groupID,
+			Visibility: domain.EventVisibilityGroupOnly,
+			StartAt:    time.Now(),
+			EndAt:      time.Now().Add(4 * time.Hour),
+		}
+	}
+
+	setup := func(event *domain.Event) (*MockEventRepository, *MockGroupRepository, *CheckInAttendeeUseCase) {
+		mockEventRepo := new(MockEventRepository)
+		mockGroupRepo := new(MockGroupRepository)
+		useCase := NewCheckInAttendeeUseCase(mockEventRepo, mockGroupRepo)
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+
+		return mockEventRepo, mockGroupRepo, useCase
+	}
+
+	t.Run("host checks a going attendee in", func(t *testing.T) {
+		event := newGroupEvent()
+		attendeeID := uuid.New()
+		mockEventRepo, _, useCase := setup(event)
+
+		mockEventRepo.On("GetRSVP", ctx, event.ID, attendeeID).Return(&domain.EventRSVP{
+			EventID: event.ID,
+			UserID:  attendeeID,
+			Status:  domain.RSVPStatusGoing,
+		}, nil)
+		mockEventRepo.On("UpdateRSVP", ctx, mock.MatchedBy(func(rsvp *domain.EventRSVP) bool {
+			return rsvp.UserID == attendeeID && rsvp.IsCheckedIn()
+		})).Return(nil)
+
+		rsvp, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    attendeeID,
+			CheckerID: event.HostUserID,
+			CheckedIn: true,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, rsvp.IsCheckedIn())
+		mockEventRepo.AssertExpectations(t)
+	})
+
+	t.Run("co-host granted check-in undoes a check-in", func(t *testing.T) {
+		event := newGroupEvent()
+		coHostID := uuid.New()
+		event.CoHosts = []domain.EventCoHost{{
+			UserID:      coHostID,
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+			Status:      domain.EventCoHostStatusAccepted,
+		}}
+		attendeeID := uuid.New()
+		checkedInAt := time.Now().Add(-time.Hour)
+		mockEventRepo, mockGroupRepo, useCase := setup(event)
+
+		mockGroupRepo.On("GetMember", ctx, *event.GroupID, coHostID).Return(nil, nil)
+		mockEventRepo.On("GetRSVP", ctx, event.ID, attendeeID).Return(&domain.EventRSVP{
+			EventID:     event.ID,
+			UserID:      attendeeID,
+			Status:      domain.RSVPStatusGoing,
+			CheckedInAt: &checkedInAt,
+		}, nil)
+		mockEventRepo.On("UpdateRSVP", ctx, mock.MatchedBy(func(rsvp *domain.EventRSVP) bool {
+			return !rsvp.IsCheckedIn()
+		})).Return(nil)
+
+		rsvp, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    attendeeID,
+			CheckerID: coHostID,
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, rsvp.IsCheckedIn())
+		mockEventRepo.AssertExpectations(t)
+	})
+
+	t.Run("group member whose custom role grants check-in", func(t *testing.T) {
+		event := newGroupEvent()
+		judgeID := uuid.New()
+		attendeeID := uuid.New()
+		mockEventRepo, mockGroupRepo, useCase := setup(event)
+
+		mockGroupRepo.On("GetMember", ctx, *event.GroupID, judgeID).Return(&domain.GroupMember{
+			GroupID:     *event.GroupID,
+			UserID:      judgeID,
+			Role:        domain.GroupRoleMember,
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+		}, nil)
+		mockEventRepo.On("GetRSVP", ctx, event.ID, attendeeID).Return(&domain.EventRSVP{
+			EventID: event.ID,
+			UserID:  attendeeID,
+			Status:  domain.RSVPStatusGoing,
+		}, nil)
+		mockEventRepo.On("UpdateRSVP", ctx, mock.AnythingOfType("*domain.EventRSVP")).Return(nil)
+
+		rsvp, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    attendeeID,
+			CheckerID: judgeID,
+			CheckedIn: true,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, rsvp.IsCheckedIn())
+	})
+
+	t.Run("plain group member cannot check attendees in", func(t *testing.T) {
+		event := newGroupEvent()
+		memberID := uuid.New()
+		_, mockGroupRepo, useCase := setup(event)
+
+		mockGroupRepo.On("GetMember", ctx, *event.GroupID, memberID).Return(&domain.GroupMember{
+			GroupID: *event.GroupID,
+			UserID:  memberID,
+			Role:    domain.GroupRoleMember,
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    uuid.New(),
+			CheckerID: memberID,
+			CheckedIn: true,
+		})
+
+		assert.Equal(t, ErrUnauthorizedAccess, err)
+	})
+
+	t.Run("attendee who is not going", func(t *testing.T) {
+		event := newGroupEvent()
+		attendeeID := uuid.New()
+		mockEventRepo, _, useCase := setup(event)
+
+		mockEventRepo.On("GetRSVP", ctx, event.ID, attendeeID).Return(&domain.EventRSVP{
+			EventID: event.ID,
+			UserID:  attendeeID,
+			Status:  domain.RSVPStatusWaitlisted,
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    attendeeID,
+			CheckerID: event.HostUserID,
+			CheckedIn: true,
+		})
+
+		assert.Equal(t, domain.ErrCheckInNotGoing, err)
+		mockEventRepo.AssertNotCalled(t, "UpdateRSVP", mock.Anything, mock.Anything)
+	})
+
+	t.Run("user without an RSVP", func(t *testing.T) {
+		event := newGroupEvent()
+		attendeeID := uuid.New()
+		mockEventRepo, _, useCase := setup(event)
+
+		mockEventRepo.On("GetRSVP", ctx, event.ID, attendeeID).Return(nil, nil)
+
+		_, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    attendeeID,
+			CheckerID: event.HostUserID,
+			CheckedIn: true,
+		})
+
+		assert.Equal(t, ErrAttendeeNotFound, err)
+	})
+
+	t.Run("checking in twice keeps the first check-in", func(t *testing.T) {
+		event := newGroupEvent()
+		attendeeID := uuid.New()
+		checkedInAt := time.Now().Add(-time.Hour)
+		mockEventRepo, _, useCase := setup(event)
+
+		mockEventRepo.On("GetRSVP", ctx, event.ID, attendeeID).Return(&domain.EventRSVP{
+			EventID:     event.ID,
+			UserID:      attendeeID,
+			Status:      domain.RSVPStatusGoing,
+			CheckedInAt: &checkedInAt,
+		}, nil)
+
+		rsvp, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    attendeeID,
+			CheckerID: event.HostUserID,
+			CheckedIn: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, checkedInAt, *rsvp.CheckedInAt)
+		mockEventRepo.AssertNotCalled(t, "UpdateRSVP", mock.Anything, mock.Anything)
+	})
+
+	t.Run("cancelled event", func(t *testing.T) {
+		event := newGroupEvent()
+		cancelledAt := time.Now()
+		event.CancelledAt = &cancelledAt
+		_, _, useCase := setup(event)
+
+		_, err := useCase.Execute(ctx, &CheckInAttendeeRequest{
+			EventID:   event.ID,
+			UserID:    uuid.New(),
+			CheckerID: event.HostUserID,
+			CheckedIn: true,
+		})
+
+		assert.Equal(t, ErrEventCancelled, err)
+	})
+}
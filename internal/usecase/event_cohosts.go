@@ -0,0 +1,367 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// MaxCoHostsPerEvent is the number of co-hosts an event may have, counting pending invitations
+const MaxCoHostsPerEvent = 10
+
+var (
+	ErrCoHostNotFound = errors.New("co-host not found")
+	ErrCoHostIsHost   = errors.New("the event host cannot be invited as a co-host")
+	ErrAlreadyCoHost  = errors.New("user is already a co-host of this event or has a pending invitation")
+	ErrTooManyCoHosts = errors.New("event has reached the maximum number of co-hosts")
+)
+
+// EventCoHostNotifier defines the interface for co-host invitation notifications
+type EventCoHostNotifier interface {
+	OnEventCoHostInvite(ctx context.Context, eventID, invitedUserID, inviterUserID uuid.UUID, permissions []domain.Permission) error
+	OnEventCoHostResponse(ctx context.Context, eventID, coHostUserID uuid.UUID, accepted bool) error
+}
+
+// InviteEventCoHostRequest represents the request to invite a co-host to an event
+type InviteEventCoHostRequest struct {
+	EventID     uuid.UUID           `json:"event_id" validate:"required"`
+	UserID      uuid.UUID           `json:"user_id" validate:"required"`    // User being invited
+	InviterID   uuid.UUID           `json:"inviter_id" validate:"required"` // User making the request
+	Permissions []domain.Permission `json:"permissions" validate:"required,min=1"`
+}
+
+// RespondEventCoHostRequest represents the invitee's answer to a co-host invitation
+type RespondEventCoHostRequest struct {
+	EventID uuid.UUID `json:"event_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+	Accept  bool      `json:"accept"`
+}
+
+// UpdateEventCoHostRequest represents the request to change a co-host's delegated rights
+type UpdateEventCoHostRequest struct {
+	EventID     uuid.UUID           `json:"event_id" validate:"required"`
+	UserID      uuid.UUID           `json:"user_id" validate:"required"`    // Co-host being updated
+	UpdaterID   uuid.UUID           `json:"updater_id" validate:"required"` // User making the request
+	Permissions []domain.Permission `json:"permissions" validate:"required,min=1"`
+}
+
+// RemoveEventCoHostRequest represents the request to remove a co-host or step down as one
+type RemoveEventCoHostRequest struct {
+	EventID   uuid.UUID `json:"event_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`    // Co-host being removed
+	RemoverID uuid.UUID `json:"remover_id" validate:"required"` // User making the request
+}
+
+// ListEventCoHostsRequest represents the request to list an event's co-hosts
+type ListEventCoHostsRequest struct {
+	EventID     uuid.UUID `json:"event_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+}
+
+// ListEventCoHostsResponse represents an event's co-hosts, including pending and declined invitations
+type ListEventCoHostsResponse struct {
+	CoHosts []*domain.EventCoHost `json:"co_hosts"`
+}
+
+// ListCoHostInvitationsRequest represents the request to list a user's pending co-host invitations
+type ListCoHostInvitationsRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// InviteEventCoHostUseCase handles inviting co-hosts to an event
+type InviteEventCoHostUseCase struct {
+	eventRepo         repository.EventRepository
+	coHostRepo        repository.EventCoHostRepository
+	userRepo          repository.UserRepository
+	permissionService *domain.PermissionService
+	notifier          EventCoHostNotifier
+}
+
+// NewInviteEventCoHostUseCase creates a new InviteEventCoHostUseCase
+func NewInviteEventCoHostUseCase(eventRepo repository.EventRepository, coHostRepo repository.EventCoHostRepository, userRepo repository.UserRepository) *InviteEventCoHostUseCase {
+	return &InviteEventCoHostUseCase{
+		eventRepo:         eventRepo,
+		coHostRepo:        coHostRepo,
+		userRepo:          userRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute invites a user to co-host an event. A previously declined invitation is reissued.
+func (uc *InviteEventCoHostUseCase) Execute(ctx context.Context, req *InviteEventCoHostRequest) (*domain.EventCoHost, error) {
+	event, err := getEvent(ctx, uc.eventRepo, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uc.permissionService.CanManageCoHosts(event, req.InviterID) {
+		return nil, ErrUnauthorizedAccess
+	}
+
+	if req.UserID == event.HostUserID {
+		return nil, ErrCoHostIsHost
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	coHosts, err := uc.coHostRepo.GetByEvent(ctx, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing *domain.EventCoHost
+	active := 0
+	for _, coHost := range coHosts {
+		if coHost.UserID == req.UserID {
+			existing = coHost
+		}
+		if coHost.Status != domain.EventCoHostStatusDeclined {
+			active++
+		}
+	}
+
+	if existing != nil && existing.Status != domain.EventCoHostStatusDeclined {
+		return nil, ErrAlreadyCoHost
+	}
+
+	if active >= MaxCoHostsPerEvent {
+		return nil, ErrTooManyCoHosts
+	}
+
+	now := time.Now().UTC()
+	coHost := existing
+	if coHost == nil {
+		coHost = &domain.EventCoHost{
+			EventID:   req.EventID,
+			UserID:    req.UserID,
+			CreatedAt: now,
+		}
+	}
+	coHost.InvitedBy = req.InviterID
+	coHost.Permissions = req.Permissions
+	coHost.Status = domain.EventCoHostStatusPending
+	coHost.RespondedAt = nil
+	coHost.UpdatedAt = now
+
+	if err := coHost.Validate(); err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		err = uc.coHostRepo.Update(ctx, coHost)
+	} else {
+		err = uc.coHostRepo.Create(ctx, coHost)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.notifier != nil {
+		if err := uc.notifier.OnEventCoHostInvite(ctx, req.EventID, req.UserID, req.InviterID, coHost.Permissions); err != nil {
+			log.Printf("Failed to notify user %s about co-host invitation for event %s: %v", req.UserID, req.EventID, err)
+		}
+	}
+
+	return coHost, nil
+}
+
+// RespondEventCoHostUseCase handles accepting or declining a co-host invitation
+type RespondEventCoHostUseCase struct {
+	coHostRepo repository.EventCoHostRepository
+	notifier   EventCoHostNotifier
+}
+
+// NewRespondEventCoHostUseCase creates a new RespondEventCoHostUseCase
+func NewRespondEventCoHostUseCase(coHostRepo repository.EventCoHostRepository) *RespondEventCoHostUseCase {
+	return &RespondEventCoHostUseCase{
+		coHostRepo: coHostRepo,
+	}
+}
+
+// Execute records the invitee's answer and lets the event's organizers know
+func (uc *RespondEventCoHostUseCase) Execute(ctx context.Context, req *RespondEventCoHostRequest) (*domain.EventCoHost, error) {
+	coHost, err := uc.coHostRepo.Get(ctx, req.EventID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if coHost == nil {
+		return nil, ErrCoHostNotFound
+	}
+
+	if err := coHost.Respond(req.Accept, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.coHostRepo.Update(ctx, coHost); err != nil {
+		return nil, err
+	}
+
+	if uc.notifier != nil {
+		if err := uc.notifier.OnEventCoHostResponse(ctx, req.EventID, req.UserID, req.Accept); err != nil {
+			log.Printf("Failed to notify organizers of event %s about co-host response: %v", req.EventID, err)
+		}
+	}
+
+	return coHost, nil
+}
+
+// UpdateEventCoHostUseCase handles changing a co-host's delegated rights
+type UpdateEventCoHostUseCase struct {
+	eventRepo         repository.EventRepository
+	coHostRepo        repository.EventCoHostRepository
+	permissionService *domain.PermissionService
+}
+
+// NewUpdateEventCoHostUseCase creates a new UpdateEventCoHostUseCase
+func NewUpdateEventCoHostUseCase(eventRepo repository.EventRepository, coHostRepo repository.EventCoHostRepository) *UpdateEventCoHostUseCase {
+	return &UpdateEventCoHostUseCase{
+		eventRepo:         eventRepo,
+		coHostRepo:        coHostRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute replaces a co-host's permissions; the invitation status is kept
+func (uc *UpdateEventCoHostUseCase) Execute(ctx context.Context, req *UpdateEventCoHostRequest) (*domain.EventCoHost, error) {
+	event, err := getEvent(ctx, uc.eventRepo, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uc.permissionService.CanManageCoHosts(event, req.UpdaterID) {
+		return nil, ErrUnauthorizedAccess
+	}
+
+	coHost, err := uc.coHostRepo.Get(ctx, req.EventID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if coHost == nil {
+		return nil, ErrCoHostNotFound
+	}
+
+	coHost.Permissions = req.Permissions
+	coHost.UpdatedAt = time.Now().UTC()
+
+	if err := coHost.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.coHostRepo.Update(ctx, coHost); err != nil {
+		return nil, err
+	}
+
+	return coHost, nil
+}
+
+// RemoveEventCoHostUseCase handles removing co-hosts from an event
+type RemoveEventCoHostUseCase struct {
+	eventRepo         repository.EventRepository
+	coHostRepo        repository.EventCoHostRepository
+	permissionService *domain.PermissionService
+}
+
+// NewRemoveEventCoHostUseCase creates a new RemoveEventCoHostUseCase
+func NewRemoveEventCoHostUseCase(eventRepo repository.EventRepository, coHostRepo repository.EventCoHostRepository) *RemoveEventCoHostUseCase {
+	return &RemoveEventCoHostUseCase{
+		eventRepo:         eventRepo,
+		coHostRepo:        coHostRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute removes a co-host. The host can remove anyone; co-hosts can only step down themselves.
+func (uc *RemoveEventCoHostUseCase) Execute(ctx context.Context, req *RemoveEventCoHostRequest) error {
+	event, err := getEvent(ctx, uc.eventRepo, req.EventID)
+	if err != nil {
+		return err
+	}
+
+	if req.RemoverID != req.UserID && !uc.permissionService.CanManageCoHosts(event, req.RemoverID) {
+		return ErrUnauthorizedAccess
+	}
+
+	coHost, err := uc.coHostRepo.Get(ctx, req.EventID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if coHost == nil {
+		return ErrCoHostNotFound
+	}
+
+	return uc.coHostRepo.Delete(ctx, req.EventID, req.UserID)
+}
+
+// ListEventCoHostsUseCase handles listing an event's co-hosts
+type ListEventCoHostsUseCase struct {
+	eventRepo  repository.EventRepository
+	coHostRepo repository.EventCoHostRepository
+}
+
+// NewListEventCoHostsUseCase creates a new ListEventCoHostsUseCase
+func NewListEventCoHostsUseCase(eventRepo repository.EventRepository, coHostRepo repository.EventCoHostRepository) *ListEventCoHostsUseCase {
+	return &ListEventCoHostsUseCase{
+		eventRepo:  eventRepo,
+		coHostRepo: coHostRepo,
+	}
+}
+
+// Execute lists every co-host invitation for an event. Only the event's organizers can see
+// pending and declined invitations; accepted co-hosts are shown to everyone on the event itself.
+func (uc *ListEventCoHostsUseCase) Execute(ctx context.Context, req *ListEventCoHostsRequest) (*ListEventCoHostsResponse, error) {
+	event, err := getEvent(ctx, uc.eventRepo, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !event.IsOrganizer(req.RequesterID) {
+		return nil, ErrUnauthorizedAccess
+	}
+
+	coHosts, err := uc.coHostRepo.GetByEvent(ctx, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListEventCoHostsResponse{CoHosts: coHosts}, nil
+}
+
+// ListCoHostInvitationsUseCase handles listing the co-host invitations awaiting a user's answer
+type ListCoHostInvitationsUseCase struct {
+	coHostRepo repository.EventCoHostRepository
+}
+
+// NewListCoHostInvitationsUseCase creates a new ListCoHostInvitationsUseCase
+func NewListCoHostInvitationsUseCase(coHostRepo repository.EventCoHostRepository) *ListCoHostInvitationsUseCase {
+	return &ListCoHostInvitationsUseCase{
+		coHostRepo: coHostRepo,
+	}
+}
+
+// Execute lists the user's pending co-host invitations
+func (uc *ListCoHostInvitationsUseCase) Execute(ctx context.Context, req *ListCoHostInvitationsRequest) ([]*domain.EventCoHost, error) {
+	return uc.coHostRepo.GetPendingByUser(ctx, req.UserID)
+}
+
+// getEvent loads an event with its accepted co-hosts, returning ErrEventNotFound if it does not exist
+func getEvent(ctx context.Context, eventRepo repository.EventRepository, eventID uuid.UUID) (*domain.Event, error) {
+	event, err := eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	return event, nil
+}
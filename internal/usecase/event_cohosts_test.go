@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEventCoHostRepository is a mock implementation of EventCoHostRepository
+type MockEventCoHostRepository struct {
+	mock.Mock
+}
+
+func (m *MockEventCoHostRepository) Create(ctx context.Context, coHost *domain.EventCoHost) error {
+	args := m.Called(ctx, coHost)
+	return args.Error(0)
+}
+
+func (m *MockEventCoHostRepository) Get(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventCoHost, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventCoHost), args.Error(1)
+}
+
+func (m *MockEventCoHostRepository) Update(ctx context.Context, coHost *domain.EventCoHost) error {
+	args := m.Called(ctx, coHost)
+	return args.Error(0)
+}
+
+func (m *MockEventCoHostRepository) Delete(ctx context.Context, eventID, userID uuid.UUID) error {
+	args := m.Called(ctx, eventID, userID)
+	return args.Error(0)
+}
+
+func (m *MockEventCoHostRepository) GetByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventCoHost, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]*domain.EventCoHost), args.Error(1)
+}
+
+func (m *MockEventCoHostRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*domain.EventCoHost, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*domain.EventCoHost), args.Error(1)
+}
+
+// MockEventCoHostNotifier is a mock implementation of EventCoHostNotifier
+type MockEventCoHostNotifier struct {
+	mock.Mock
+}
+
+func (m *MockEventCoHostNotifier) OnEventCoHostInvite(ctx context.Context, eventID, invitedUserID, inviterUserID uuid.UUID, permissions []domain.Permission) error {
+	args := m.Called(ctx, eventID, invitedUserID, inviterUserID, permissions)
+	return args.Error(0)
+}
+
+func (m *MockEventCoHostNotifier) OnEventCoHostResponse(ctx context.Context, eventID, coHostUserID uuid.UUID, accepted bool) error {
+	args := m.Called(ctx, eventID, coHostUserID, accepted)
+	return args.Error(0)
+}
+
+func TestInviteEventCoHostUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(event *domain.Event) (*MockEventCoHostRepository, *MockUserRepository, *InviteEventCoHostUseCase) {
+		mockEventRepo := new(MockEventRepository)
+		mockCoHostRepo := new(MockEventCoHostRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewInviteEventCoHostUseCase(mockEventRepo, mockCoHostRepo, mockUserRepo)
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+
+		return mockCoHostRepo, mockUserRepo, useCase
+	}
+
+	t.Run("host invites store staff", func(t *testing.T) {
+		event := &domain.Event{ID: uuid.New(), HostUserID: uuid.New()}
+		staffID := uuid.New()
+		mockCoHostRepo, mockUserRepo, useCase := setup(event)
+
+		notifier := new(MockEventCoHostNotifier)
+		useCase.notifier = notifier
+
+		permissions := []domain.Permission{domain.PermissionManageAttendees, domain.PermissionCheckIn}
+		mockUserRepo.On("GetByID", ctx, staffID).Return(&domain.User{ID: staffID}, nil)
+		mockCoHostRepo.On("GetByEvent", ctx, event.ID).Return([]*domain.EventCoHost{}, nil)
+		mockCoHostRepo.On("Create", ctx, mock.AnythingOfType("*domain.EventCoHost")).Return(nil)
+		notifier.On("OnEventCoHostInvite", ctx, event.ID, staffID, event.HostUserID, permissions).Return(nil)
+
+		coHost, err := useCase.Execute(ctx, &InviteEventCoHostRequest{
+			EventID:     event.ID,
+			UserID:      staffID,
+			InviterID:   event.HostUserID,
+			Permissions: permissions,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EventCoHostStatusPending, coHost.Status)
+		assert.Equal(t, event.HostUserID, coHost.InvitedBy)
+		mockCoHostRepo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("declined invitation is reissued", func(t *testing.T) {
+		event := &domain.Event{ID: uuid.New(), HostUserID: uuid.New()}
+		staffID := uuid.New()
+		mockCoHostRepo, mockUserRepo, useCase := setup(event)
+
+		declined := &domain.EventCoHost{
+			EventID:     event.ID,
+			UserID:      staffID,
+			Permissions: []domain.Permission{domain.PermissionEditEvent},
+			Status:      domain.EventCoHostStatusDeclined,
+		}
+		mockUserRepo.On("GetByID", ctx, staffID).Return(&domain.User{ID: staffID}, nil)
+		mockCoHostRepo.On("GetByEvent", ctx, event.ID).Return([]*domain.EventCoHost{declined}, nil)
+		mockCoHostRepo.On("Update", ctx, declined).Return(nil)
+
+		coHost, err := useCase.Execute(ctx, &InviteEventCoHostRequest{
+			EventID:     event.ID,
+			UserID:      staffID,
+			InviterID:   event.HostUserID,
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EventCoHostStatusPending, coHost.Status)
+		assert.Nil(t, coHost.RespondedAt)
+		mockCoHostRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("user already invited", func(t *testing.T) {
+		event := &domain.Event{ID: uuid.New(), HostUserID: uuid.New()}
+		staffID := uuid.New()
+		mockCoHostRepo, mockUserRepo, useCase := setup(event)
+
+		mockUserRepo.On("GetByID", ctx, staffID).Return(&domain.User{ID: staffID}, nil)
+		mockCoHostRepo.On("GetByEvent", ctx, event.ID).Return([]*domain.EventCoHost{
+			{EventID: event.ID, UserID: staffID, Status: domain.EventCoHostStatusPending},
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &InviteEventCoHostRequest{
+			EventID:     event.ID,
+			UserID:      staffID,
+			InviterID:   event.HostUserID,
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+		})
+
+		assert.Equal(t, ErrAlreadyCoHost, err)
+	})
+
+	t.Run("co-host cannot invite further co-hosts", func(t *testing.T) {
+		coHostID := uuid.New()
+		event := &domain.Event{
+			ID:         uuid.New(),
+			HostUserID: uuid.New(),
+			CoHosts: []domain.EventCoHost{
+				{UserID: coHostID, Permissions: []domain.Permission{domain.PermissionEditEvent}, Status: domain.EventCoHostStatusAccepted},
+			},
+		}
+		mockCoHostRepo, _, useCase := setup(event)
+
+		_, err := useCase.Execute(ctx, &InviteEventCoHostRequest{
+			EventID:     event.ID,
+			UserID:      uuid.New(),
+			InviterID:   coHostID,
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+		})
+
+		assert.Equal(t, ErrUnauthorizedAccess, err)
+		mockCoHostRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("host cannot invite themselves", func(t *testing.T) {
+		event := &domain.Event{ID: uuid.New(), HostUserID: uuid.New()}
+		_, _, useCase := setup(event)
+
+		_, err := useCase.Execute(ctx, &InviteEventCoHostRequest{
+			EventID:     event.ID,
+			UserID:      event.HostUserID,
+			InviterID:   event.HostUserID,
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+		})
+
+		assert.Equal(t, ErrCoHostIsHost, err)
+	})
+}
+
+func TestRespondEventCoHostUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invitee accepts", func(t *testing.T) {
+		mockCoHostRepo := new(MockEventCoHostRepository)
+		notifier := new(MockEventCoHostNotifier)
+		useCase := NewRespondEventCoHostUseCase(mockCoHostRepo)
+		useCase.notifier = notifier
+
+		pending := &domain.EventCoHost{
+			EventID:     uuid.New(),
+			UserID:      uuid.New(),
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+			Status:      domain.EventCoHostStatusPending,
+		}
+		mockCoHostRepo.On("Get", ctx, pending.EventID, pending.UserID).Return(pending, nil)
+		mockCoHostRepo.On("Update", ctx, pending).Return(nil)
+		notifier.On("OnEventCoHostResponse", ctx, pending.EventID, pending.UserID, true).Return(nil)
+
+		coHost, err := useCase.Execute(ctx, &RespondEventCoHostRequest{
+			EventID: pending.EventID,
+			UserID:  pending.UserID,
+			Accept:  true,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, coHost.IsAccepted())
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("no invitation", func(t *testing.T) {
+		mockCoHostRepo := new(MockEventCoHostRepository)
+		useCase := NewRespondEventCoHostUseCase(mockCoHostRepo)
+
+		eventID := uuid.New()
+		userID := uuid.New()
+		mockCoHostRepo.On("Get", ctx, eventID, userID).Return(nil, nil)
+
+		_, err := useCase.Execute(ctx, &RespondEventCoHostRequest{EventID: eventID, UserID: userID, Accept: true})
+
+		assert.Equal(t, ErrCoHostNotFound, err)
+	})
+}
+
+func TestRemoveEventCoHostUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	coHostID := uuid.New()
+	otherCoHostID := uuid.New()
+	event := &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: uuid.New(),
+		CoHosts: []domain.EventCoHost{
+			{UserID: coHostID, Permissions: []domain.Permission{domain.PermissionEditEvent}, Status: domain.EventCoHostStatusAccepted},
+			{UserID: otherCoHostID, Permissions: []domain.Permission{domain.PermissionEditEvent}, Status: domain.EventCoHostStatusAccepted},
+		},
+	}
+
+	setup := func() (*MockEventCoHostRepository, *RemoveEventCoHostUseCase) {
+		mockEventRepo := new(MockEventRepository)
+		mockCoHostRepo := new(MockEventCoHostRepository)
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+		return mockCoHostRepo, NewRemoveEventCoHostUseCase(mockEventRepo, mockCoHostRepo)
+	}
+
+	t.Run("co-host steps down", func(t *testing.T) {
+		mockCoHostRepo, useCase := setup()
+		mockCoHostRepo.On("Get", ctx, event.ID, coHostID).Return(&event.CoHosts[0], nil)
+		mockCoHostRepo.On("Delete", ctx, event.ID, coHostID).Return(nil)
+
+		err := useCase.Execute(ctx, &RemoveEventCoHostRequest{EventID: event.ID, UserID: coHostID, RemoverID: coHostID})
+
+		assert.NoError(t, err)
+		mockCoHostRepo.AssertExpectations(t)
+	})
+
+	t.Run("co-host cannot remove another co-host", func(t *testing.T) {
+		mockCoHostRepo, useCase := setup()
+
+		err := useCase.Execute(ctx, &RemoveEventCoHostRequest{EventID: event.ID, UserID: otherCoHostID, RemoverID: coHostID})
+
+		assert.Equal(t, ErrUnauthorizedAccess, err)
+		mockCoHostRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestListEventCoHostsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	event := &domain.Event{ID: uuid.New(), HostUserID: uuid.New()}
+	mockEventRepo := new(MockEventRepository)
+	mockCoHostRepo := new(MockEventCoHostRepository)
+	useCase := NewListEventCoHostsUseCase(mockEventRepo, mockCoHostRepo)
+
+	mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+	mockCoHostRepo.On("GetByEvent", ctx, event.ID).Return([]*domain.EventCoHost{
+		{EventID: event.ID, UserID: uuid.New(), Status: domain.EventCoHostStatusPending},
+	}, nil)
+
+	resp, err := useCase.Execute(ctx, &ListEventCoHostsRequest{EventID: event.ID, RequesterID: event.HostUserID})
+	assert.NoError(t, err)
+	assert.Len(t, resp.CoHosts, 1)
+
+	// Pending invitations are hidden from users who do not organize the event
+	_, err = useCase.Execute(ctx, &ListEventCoHostsRequest{EventID: event.ID, RequesterID: uuid.New()})
+	assert.Equal(t, ErrUnauthorizedAccess, err)
+}
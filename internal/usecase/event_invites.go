@@ -0,0 +1,529 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrAlreadyEventGuest       = errors.New("user is already on the event's guest list")
+	ErrEventGuestNotFound      = errors.New("guest not found")
+	ErrEventOrganizerNotGuest  = errors.New("event organizers cannot be added to the guest list")
+	ErrEventInviteLinkNotFound = errors.New("invite link not found")
+)
+
+// EventGuestNotifier defines the interface for private event invitation notifications
+type EventGuestNotifier interface {
+	OnEventGuestInvite(ctx context.Context, eventID, guestUserID, inviterUserID uuid.UUID) error
+}
+
+// AddEventGuestRequest represents the request to invite a user to a private event
+type AddEventGuestRequest struct {
+	EventID   uuid.UUID `json:"event_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`    // User being invited
+	InviterID uuid.UUID `json:"inviter_id" validate:"required"` // User making the request
+}
+
+// RemoveEventGuestRequest represents the request to remove a guest or leave a guest list
+type RemoveEventGuestRequest struct {
+	EventID   uuid.UUID `json:"event_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"`    // Guest being removed
+	RemoverID uuid.UUID `json:"remover_id" validate:"required"` // User making the request
+}
+
+// ListEventGuestsRequest represents the request to list a private event's guests
+type ListEventGuestsRequest struct {
+	EventID     uuid.UUID `json:"event_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+}
+
+// ListEventGuestsResponse represents a private event's guest list
+type ListEventGuestsResponse struct {
+	Guests []*domain.EventGuest `json:"guests"`
+}
+
+// CreateEventInviteLinkRequest represents the request to create a shareable invite link for a private event
+type CreateEventInviteLinkRequest struct {
+	EventID   uuid.UUID      `json:"event_id" validate:"required"`
+	UserID    uuid.UUID      `json:"user_id" validate:"required"` // User making the request
+	MaxUses   *int           `json:"max_uses,omitempty" validate:"omitempty,min=1"`
+	ExpiresIn *time.Duration `json:"expires_in,omitempty"`
+}
+
+// CreateEventInviteLinkResponse represents the response after creating an event invite link
+type CreateEventInviteLinkResponse struct {
+	Link  *domain.EventInviteLink `json:"link"`
+	Token string                  `json:"token"` // Only returned once, never stored
+}
+
+// RevokeEventInviteLinkRequest represents the request to revoke an event invite link
+type RevokeEventInviteLinkRequest struct {
+	EventID uuid.UUID `json:"event_id" validate:"required"`
+	LinkID  uuid.UUID `json:"link_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListEventInviteLinksRequest represents the request to list an event's invite links
+type ListEventInviteLinksRequest struct {
+	EventID uuid.UUID `json:"event_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListEventInviteLinksResponse represents an event's invite links, including revoked and expired ones
+type ListEventInviteLinksResponse struct {
+	Links []*domain.EventInviteLink `json:"links"`
+}
+
+// RedeemEventInviteLinkRequest represents the request to join a private event's guest list with a link token
+type RedeemEventInviteLinkRequest struct {
+	EventID uuid.UUID `json:"event_id" validate:"required"`
+	Token   string    `json:"token" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User redeeming the link
+}
+
+// AddEventGuestUseCase handles adding users to a private event's guest list
+type AddEventGuestUseCase struct {
+	eventRepo         repository.EventRepository
+	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
+	userRepo          repository.UserRepository
+	permissionService *domain.PermissionService
+	notifier          EventGuestNotifier
+}
+
+// NewAddEventGuestUseCase creates a new AddEventGuestUseCase
+func NewAddEventGuestUseCase(eventRepo repository.EventRepository, groupRepo repository.GroupRepository, inviteRepo repository.EventInviteRepository, userRepo repository.UserRepository) *AddEventGuestUseCase {
+	return &AddEventGuestUseCase{
+		eventRepo:         eventRepo,
+		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
+		userRepo:          userRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute adds a user to the guest list and lets them know they were invited
+func (uc *AddEventGuestUseCase) Execute(ctx context.Context, req *AddEventGuestRequest) (*domain.EventGuest, error) {
+	event, err := getGuestListEvent(ctx, uc.eventRepo, uc.groupRepo, uc.permissionService, req.EventID, req.InviterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.IsOrganizer(req.UserID) {
+		return nil, ErrEventOrganizerNotGuest
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	isGuest, err := uc.inviteRepo.IsGuest(ctx, req.EventID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if isGuest {
+		return nil, ErrAlreadyEventGuest
+	}
+
+	guest := &domain.EventGuest{
+		EventID:   req.EventID,
+		UserID:    req.UserID,
+		InvitedBy: req.InviterID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := uc.inviteRepo.AddGuest(ctx, guest); err != nil {
+		return nil, err
+	}
+
+	if uc.notifier != nil {
+		if err := uc.notifier.OnEventGuestInvite(ctx, req.EventID, req.UserID, req.InviterID); err != nil {
+			log.Printf("Failed to notify user %s about invitation to event %s: %v", req.UserID, req.EventID, err)
+		}
+	}
+
+	return guest, nil
+}
+
+// RemoveEventGuestUseCase handles removing guests from a private event
+type RemoveEventGuestUseCase struct {
+	eventRepo         repository.EventRepository
+	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
+	permissionService *domain.PermissionService
+}
+
+// NewRemoveEventGuestUseCase creates a new RemoveEventGuestUseCase
+func NewRemoveEventGuestUseCase(eventRepo repository.EventRepository, groupRepo repository.GroupRepository, inviteRepo repository.EventInviteRepository) *RemoveEventGuestUseCase {
+	return &RemoveEventGuestUseCase{
+		eventRepo:         eventRepo,
+		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute revokes a guest's access to the event. Guests may also remove themselves.
+// The guest's RSVP is withdrawn since they can no longer attend.
+func (uc *RemoveEventGuestUseCase) Execute(ctx context.Context, req *RemoveEventGuestRequest) error {
+	var event *domain.Event
+	var err error
+	if req.RemoverID == req.UserID {
+		event, err = getEvent(ctx, uc.eventRepo, req.EventID)
+	} else {
+		event, err = getGuestListEvent(ctx, uc.eventRepo, uc.groupRepo, uc.permissionService, req.EventID, req.RemoverID)
+	}
+	if err != nil {
+		return err
+	}
+
+	guest, err := uc.inviteRepo.GetGuest(ctx, event.ID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if guest == nil {
+		return ErrEventGuestNotFound
+	}
+
+	if err := uc.inviteRepo.RemoveGuest(ctx, event.ID, req.UserID); err != nil {
+		return err
+	}
+
+	rsvp, err := uc.eventRepo.GetRSVP(ctx, event.ID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if rsvp != nil {
+		return uc.eventRepo.DeleteRSVP(ctx, event.ID, req.UserID)
+	}
+
+	return nil
+}
+
+// ListEventGuestsUseCase handles listing a private event's guests
+type ListEventGuestsUseCase struct {
+	eventRepo         repository.EventRepository
+	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
+	permissionService *domain.PermissionService
+}
+
+// NewListEventGuestsUseCase creates a new ListEventGuestsUseCase
+func NewListEventGuestsUseCase(eventRepo repository.EventRepository, groupRepo repository.GroupRepository, inviteRepo repository.EventInviteRepository) *ListEventGuestsUseCase {
+	return &ListEventGuestsUseCase{
+		eventRepo:         eventRepo,
+		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute lists the guests of an event to the users who manage its attendees
+func (uc *ListEventGuestsUseCase) Execute(ctx context.Context, req *ListEventGuestsRequest) (*ListEventGuestsResponse, error) {
+	if _, err := getGuestListEvent(ctx, uc.eventRepo, uc.groupRepo, uc.permissionService, req.EventID, req.RequesterID); err != nil {
+		return nil, err
+	}
+
+	guests, err := uc.inviteRepo.GetGuestsByEvent(ctx, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListEventGuestsResponse{
+		Guests: guests,
+	}, nil
+}
+
+// CreateEventInviteLinkUseCase handles creating shareable invite links for private events
+type CreateEventInviteLinkUseCase struct {
+	eventRepo         repository.EventRepository
+	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
+	permissionService *domain.PermissionService
+}
+
+// NewCreateEventInviteLinkUseCase creates a new CreateEventInviteLinkUseCase
+func NewCreateEventInviteLinkUseCase(eventRepo repository.EventRepository, groupRepo repository.GroupRepository, inviteRepo repository.EventInviteRepository) *CreateEventInviteLinkUseCase {
+	return &CreateEventInviteLinkUseCase{
+		eventRepo:         eventRepo,
+		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute creates an invite link that can be used up to MaxUses times.
+// Links never outlive the event they grant access to.
+func (uc *CreateEventInviteLinkUseCase) Execute(ctx context.Context, req *CreateEventInviteLinkRequest) (*CreateEventInviteLinkResponse, error) {
+	event, err := getGuestListEvent(ctx, uc.eventRepo, uc.groupRepo, uc.permissionService, req.EventID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(inviteExpiry(req.ExpiresIn))
+	if event.EndAt.Before(expiresAt) {
+		expiresAt = event.EndAt
+	}
+
+	link := &domain.EventInviteLink{
+		ID:        uuid.New(),
+		EventID:   req.EventID,
+		CreatedBy: req.UserID,
+		TokenHash: domain.HashInviteToken(token),
+		MaxUses:   req.MaxUses,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := link.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.inviteRepo.CreateLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return &CreateEventInviteLinkResponse{
+		Link:  link,
+		Token: token,
+	}, nil
+}
+
+// RevokeEventInviteLinkUseCase handles revoking event invite links
+type RevokeEventInviteLinkUseCase struct {
+	eventRepo         repository.EventRepository
+	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
+	permissionService *domain.PermissionService
+}
+
+// NewRevokeEventInviteLinkUseCase creates a new RevokeEventInviteLinkUseCase
+func NewRevokeEventInviteLinkUseCase(eventRepo repository.EventRepository, groupRepo repository.GroupRepository, inviteRepo repository.EventInviteRepository) *RevokeEventInviteLinkUseCase {
+	return &RevokeEventInviteLinkUseCase{
+		eventRepo:         eventRepo,
+		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute revokes a link so its token can no longer be redeemed.
+// Guests who already joined through the link stay on the guest list.
+func (uc *RevokeEventInviteLinkUseCase) Execute(ctx context.Context, req *RevokeEventInviteLinkRequest) error {
+	if _, err := getGuestListEvent(ctx, uc.eventRepo, uc.groupRepo, uc.permissionService, req.EventID, req.UserID); err != nil {
+		return err
+	}
+
+	link, err := uc.inviteRepo.GetLinkByID(ctx, req.LinkID)
+	if err != nil {
+		return err
+	}
+	if link == nil || link.EventID != req.EventID {
+		return ErrEventInviteLinkNotFound
+	}
+	if link.IsRevoked() {
+		return ErrInviteNoLongerValid
+	}
+
+	now := time.Now().UTC()
+	link.RevokedAt = &now
+	link.UpdatedAt = now
+
+	return uc.inviteRepo.UpdateLink(ctx, link)
+}
+
+// ListEventInviteLinksUseCase handles listing an event's invite links
+type ListEventInviteLinksUseCase struct {
+	eventRepo         repository.EventRepository
+	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
+	permissionService *domain.PermissionService
+}
+
+// NewListEventInviteLinksUseCase creates a new ListEventInviteLinksUseCase
+func NewListEventInviteLinksUseCase(eventRepo repository.EventRepository, groupRepo repository.GroupRepository, inviteRepo repository.EventInviteRepository) *ListEventInviteLinksUseCase {
+	return &ListEventInviteLinksUseCase{
+		eventRepo:         eventRepo,
+		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute lists every invite link of an event
+func (uc *ListEventInviteLinksUseCase) Execute(ctx context.Context, req *ListEventInviteLinksRequest) (*ListEventInviteLinksResponse, error) {
+	if _, err := getGuestListEvent(ctx, uc.eventRepo, uc.groupRepo, uc.permissionService, req.EventID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	links, err := uc.inviteRepo.GetLinksByEvent(ctx, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListEventInviteLinksResponse{
+		Links: links,
+	}, nil
+}
+
+// RedeemEventInviteLinkUseCase handles joining a private event's guest list with an invite link
+type RedeemEventInviteLinkUseCase struct {
+	eventRepo  repository.EventRepository
+	inviteRepo repository.EventInviteRepository
+}
+
+// NewRedeemEventInviteLinkUseCase creates a new RedeemEventInviteLinkUseCase
+func NewRedeemEventInviteLinkUseCase(eventRepo repository.EventRepository, inviteRepo repository.EventInviteRepository) *RedeemEventInviteLinkUseCase {
+	return &RedeemEventInviteLinkUseCase{
+		eventRepo:  eventRepo,
+		inviteRepo: inviteRepo,
+	}
+}
+
+// Execute adds the user to the guest list if the link is still valid.
+// Users already on the guest list keep their entry and do not use up the link.
+func (uc *RedeemEventInviteLinkUseCase) Execute(ctx context.Context, req *RedeemEventInviteLinkRequest) (*domain.EventGuest, error) {
+	event, err := getEvent(ctx, uc.eventRepo, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.SupportsGuestList() {
+		return nil, domain.ErrGuestListNotSupported
+	}
+	if event.IsOrganizer(req.UserID) {
+		return nil, ErrEventOrganizerNotGuest
+	}
+
+	guest, err := uc.inviteRepo.GetGuest(ctx, req.EventID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if guest != nil {
+		return guest, nil
+	}
+
+	return redeemEventInviteLink(ctx, uc.inviteRepo, event, req.Token, req.UserID)
+}
+
+// redeemEventInviteLink consumes one use of the link matching the token and adds the user to the guest list
+func redeemEventInviteLink(ctx context.Context, inviteRepo repository.EventInviteRepository, event *domain.Event, token string, userID uuid.UUID) (*domain.EventGuest, error) {
+	if token == "" {
+		return nil, ErrInvalidInviteToken
+	}
+
+	link, err := inviteRepo.GetLinkByTokenHash(ctx, domain.HashInviteToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if link == nil || link.EventID != event.ID {
+		return nil, ErrInvalidInviteToken
+	}
+
+	now := time.Now().UTC()
+	if link.IsExpired(now) {
+		return nil, ErrInviteExpired
+	}
+	if !link.CanBeRedeemed(now) {
+		return nil, ErrInviteNoLongerValid
+	}
+
+	guest := &domain.EventGuest{
+		EventID:      event.ID,
+		UserID:       userID,
+		InvitedBy:    link.CreatedBy,
+		InviteLinkID: &link.ID,
+		CreatedAt:    now,
+	}
+
+	redeemed, err := inviteRepo.RedeemLink(ctx, link.ID, guest)
+	if err != nil {
+		return nil, err
+	}
+	if !redeemed {
+		// Another request used up or revoked the link in the meantime
+		return nil, ErrInviteNoLongerValid
+	}
+
+	return guest, nil
+}
+
+// canViewPrivateEvent checks if the user organizes the private event or is on its guest list
+func canViewPrivateEvent(ctx context.Context, inviteRepo repository.EventInviteRepository, event *domain.Event, userID uuid.UUID) (bool, error) {
+	if event.IsOrganizer(userID) {
+		return true, nil
+	}
+	return inviteRepo.IsGuest(ctx, event.ID, userID)
+}
+
+// getGuestEventIDs loads the private events among the search results that the user is a guest of,
+// so that search results are checked with a single guest list query
+func getGuestEventIDs(ctx context.Context, inviteRepo repository.EventInviteRepository, events []*domain.EventWithDetails, userID uuid.UUID) (map[uuid.UUID]bool, error) {
+	needsGuestList := false
+	for _, event := range events {
+		if event.Visibility == domain.EventVisibilityPrivate && !event.IsOrganizer(userID) {
+			needsGuestList = true
+			break
+		}
+	}
+	if !needsGuestList {
+		return nil, nil
+	}
+
+	eventIDs, err := inviteRepo.GetGuestEventIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	guestEventIDs := make(map[uuid.UUID]bool, len(eventIDs))
+	for _, id := range eventIDs {
+		guestEventIDs[id] = true
+	}
+
+	return guestEventIDs, nil
+}
+
+// getGuestListEvent loads a private event and checks that the user may manage its guest list
+func getGuestListEvent(ctx context.Context, eventRepo repository.EventRepository, groupRepo repository.GroupRepository, permissionService *domain.PermissionService, eventID, userID uuid.UUID) (*domain.Event, error) {
+	event, err := getEvent(ctx, eventRepo, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var userGroups []domain.GroupMember
+	if event.HostUserID != userID && event.GroupID != nil {
+		member, err := groupRepo.GetMember(ctx, *event.GroupID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if member != nil {
+			userGroups = append(userGroups, *member)
+		}
+	}
+
+	if !permissionService.CanManageAttendees(event, userID, userGroups) {
+		return nil, ErrUnauthorizedAccess
+	}
+
+	if !event.SupportsGuestList() {
+		return nil, domain.ErrGuestListNotSupported
+	}
+
+	return event, nil
+}
@@ -0,0 +1,421 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEventInviteRepository is a mock implementation of EventInviteRepository
+type MockEventInviteRepository struct {
+	mock.Mock
+}
+
+func (m *MockEventInviteRepository) AddGuest(ctx context.Context, guest *domain.EventGuest) error {
+	args := m.Called(ctx, guest)
+	return args.Error(0)
+}
+
+func (m *MockEventInviteRepository) GetGuest(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventGuest, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventGuest), args.Error(1)
+}
+
+func (m *MockEventInviteRepository) GetGuestEventIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockEventInviteRepository) IsGuest(ctx context.Context, eventID, userID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, eventID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventInviteRepository) RemoveGuest(ctx context.Context, eventID, userID uuid.UUID) error {
+	args := m.Called(ctx, eventID, userID)
+	return args.Error(0)
+}
+
+func (m *MockEventInviteRepository) GetGuestsByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventGuest, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]*domain.EventGuest), args.Error(1)
+}
+
+func (m *MockEventInviteRepository) CreateLink(ctx context.Context, link *domain.EventInviteLink) error {
+	args := m.Called(ctx, link)
+	return args.Error(0)
+}
+
+func (m *MockEventInviteRepository) GetLinkByID(ctx context.Context, id uuid.UUID) (*domain.EventInviteLink, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventInviteLink), args.Error(1)
+}
+
+func (m *MockEventInviteRepository) GetLinkByTokenHash(ctx context.Context, tokenHash string) (*domain.EventInviteLink, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventInviteLink), args.Error(1)
+}
+
+func (m *MockEventInviteRepository) UpdateLink(ctx context.Context, link *domain.EventInviteLink) error {
+	args := m.Called(ctx, link)
+	return args.Error(0)
+}
+
+func (m *MockEventInviteRepository) GetLinksByEvent(ctx context.Context, eventID uuid.UUID) ([]*domain.EventInviteLink, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).([]*domain.EventInviteLink), args.Error(1)
+}
+
+func (m *MockEventInviteRepository) RedeemLink(ctx context.Context, linkID uuid.UUID, guest *domain.EventGuest) (bool, error) {
+	args := m.Called(ctx, linkID, guest)
+	return args.Bool(0), args.Error(1)
+}
+
+// MockEventGuestNotifier is a mock implementation of EventGuestNotifier
+type MockEventGuestNotifier struct {
+	mock.Mock
+}
+
+func (m *MockEventGuestNotifier) OnEventGuestInvite(ctx context.Context, eventID, guestUserID, inviterUserID uuid.UUID) error {
+	args := m.Called(ctx, eventID, guestUserID, inviterUserID)
+	return args.Error(0)
+}
+
+func newPrivateEvent() *domain.Event {
+	return &domain.Event{
+		ID:         uuid.New(),
+		HostUserID: uuid.New(),
+		Visibility: domain.EventVisibilityPrivate,
+		EndAt:      time.Now().Add(48 * time.Hour),
+	}
+}
+
+func TestAddEventGuestUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(event *domain.Event) (*MockEventInviteRepository, *MockUserRepository, *AddEventGuestUseCase) {
+		mockEventRepo := new(MockEventRepository)
+		mockInviteRepo := new(MockEventInviteRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewAddEventGuestUseCase(mockEventRepo, new(MockGroupRepository), mockInviteRepo, mockUserRepo)
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+
+		return mockInviteRepo, mockUserRepo, useCase
+	}
+
+	t.Run("host invites a friend", func(t *testing.T) {
+		event := newPrivateEvent()
+		friendID := uuid.New()
+		mockInviteRepo, mockUserRepo, useCase := setup(event)
+
+		notifier := new(MockEventGuestNotifier)
+		useCase.notifier = notifier
+
+		mockUserRepo.On("GetByID", ctx, friendID).Return(&domain.User{ID: friendID}, nil)
+		mockInviteRepo.On("IsGuest", ctx, event.ID, friendID).Return(false, nil)
+		mockInviteRepo.On("AddGuest", ctx, mock.AnythingOfType("*domain.EventGuest")).Return(nil)
+		notifier.On("OnEventGuestInvite", ctx, event.ID, friendID, event.HostUserID).Return(nil)
+
+		guest, err := useCase.Execute(ctx, &AddEventGuestRequest{
+			EventID:   event.ID,
+			UserID:    friendID,
+			InviterID: event.HostUserID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, event.HostUserID, guest.InvitedBy)
+		assert.Nil(t, guest.InviteLinkID)
+		mockInviteRepo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("co-host managing attendees invites a friend", func(t *testing.T) {
+		event := newPrivateEvent()
+		coHostID := uuid.New()
+		event.CoHosts = []domain.EventCoHost{{
+			UserID:      coHostID,
+			Permissions: []domain.Permission{domain.PermissionManageAttendees},
+			Status:      domain.EventCoHostStatusAccepted,
+		}}
+		friendID := uuid.New()
+		mockInviteRepo, mockUserRepo, useCase := setup(event)
+
+		mockUserRepo.On("GetByID", ctx, friendID).Return(&domain.User{ID: friendID}, nil)
+		mockInviteRepo.On("IsGuest", ctx, event.ID, friendID).Return(false, nil)
+		mockInviteRepo.On("AddGuest", ctx, mock.AnythingOfType("*domain.EventGuest")).Return(nil)
+
+		guest, err := useCase.Execute(ctx, &AddEventGuestRequest{
+			EventID:   event.ID,
+			UserID:    friendID,
+			InviterID: coHostID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, coHostID, guest.InvitedBy)
+	})
+
+	t.Run("guest cannot invite others", func(t *testing.T) {
+		event := newPrivateEvent()
+		_, _, useCase := setup(event)
+
+		_, err := useCase.Execute(ctx, &AddEventGuestRequest{
+			EventID:   event.ID,
+			UserID:    uuid.New(),
+			InviterID: uuid.New(),
+		})
+
+		assert.Equal(t, ErrUnauthorizedAccess, err)
+	})
+
+	t.Run("public events have no guest list", func(t *testing.T) {
+		event := newPrivateEvent()
+		event.Visibility = domain.EventVisibilityPublic
+		_, _, useCase := setup(event)
+
+		_, err := useCase.Execute(ctx, &AddEventGuestRequest{
+			EventID:   event.ID,
+			UserID:    uuid.New(),
+			InviterID: event.HostUserID,
+		})
+
+		assert.Equal(t, domain.ErrGuestListNotSupported, err)
+	})
+
+	t.Run("user already on the guest list", func(t *testing.T) {
+		event := newPrivateEvent()
+		friendID := uuid.New()
+		mockInviteRepo, mockUserRepo, useCase := setup(event)
+
+		mockUserRepo.On("GetByID", ctx, friendID).Return(&domain.User{ID: friendID}, nil)
+		mockInviteRepo.On("IsGuest", ctx, event.ID, friendID).Return(true, nil)
+
+		_, err := useCase.Execute(ctx, &AddEventGuestRequest{
+			EventID:   event.ID,
+			UserID:    friendID,
+			InviterID: event.HostUserID,
+		})
+
+		assert.Equal(t, ErrAlreadyEventGuest, err)
+		mockInviteRepo.AssertNotCalled(t, "AddGuest", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRedeemEventInviteLinkUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	token := "shared-token"
+
+	setup := func(event *domain.Event) (*MockEventInviteRepository, *RedeemEventInviteLinkUseCase) {
+		mockEventRepo := new(MockEventRepository)
+		mockInviteRepo := new(MockEventInviteRepository)
+		useCase := NewRedeemEventInviteLinkUseCase(mockEventRepo, mockInviteRepo)
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+
+		return mockInviteRepo, useCase
+	}
+
+	newLink := func(event *domain.Event) *domain.EventInviteLink {
+		return &domain.EventInviteLink{
+			ID:        uuid.New(),
+			EventID:   event.ID,
+			CreatedBy: event.HostUserID,
+			TokenHash: domain.HashInviteToken(token),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	}
+
+	t.Run("valid link adds the user to the guest list", func(t *testing.T) {
+		event := newPrivateEvent()
+		userID := uuid.New()
+		link := newLink(event)
+		mockInviteRepo, useCase := setup(event)
+
+		mockInviteRepo.On("GetGuest", ctx, event.ID, userID).Return(nil, nil)
+		mockInviteRepo.On("GetLinkByTokenHash", ctx, domain.HashInviteToken(token)).Return(link, nil)
+		mockInviteRepo.On("RedeemLink", ctx, link.ID, mock.AnythingOfType("*domain.EventGuest")).Return(true, nil)
+
+		guest, err := useCase.Execute(ctx, &RedeemEventInviteLinkRequest{
+			EventID: event.ID,
+			Token:   token,
+			UserID:  userID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, userID, guest.UserID)
+		assert.Equal(t, event.HostUserID, guest.InvitedBy)
+		assert.Equal(t, &link.ID, guest.InviteLinkID)
+	})
+
+	t.Run("existing guest does not use up the link", func(t *testing.T) {
+		event := newPrivateEvent()
+		userID := uuid.New()
+		existing := &domain.EventGuest{EventID: event.ID, UserID: userID}
+		mockInviteRepo, useCase := setup(event)
+
+		mockInviteRepo.On("GetGuest", ctx, event.ID, userID).Return(existing, nil)
+
+		guest, err := useCase.Execute(ctx, &RedeemEventInviteLinkRequest{
+			EventID: event.ID,
+			Token:   token,
+			UserID:  userID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, existing, guest)
+		mockInviteRepo.AssertNotCalled(t, "RedeemLink", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("revoked link", func(t *testing.T) {
+		event := newPrivateEvent()
+		userID := uuid.New()
+		link := newLink(event)
+		revokedAt := time.Now().Add(-time.Minute)
+		link.RevokedAt = &revokedAt
+		mockInviteRepo, useCase := setup(event)
+
+		mockInviteRepo.On("GetGuest", ctx, event.ID, userID).Return(nil, nil)
+		mockInviteRepo.On("GetLinkByTokenHash", ctx, domain.HashInviteToken(token)).Return(link, nil)
+
+		_, err := useCase.Execute(ctx, &RedeemEventInviteLinkRequest{
+			EventID: event.ID,
+			Token:   token,
+			UserID:  userID,
+		})
+
+		assert.Equal(t, ErrInviteNoLongerValid, err)
+	})
+
+	t.Run("link for another event", func(t *testing.T) {
+		event := newPrivateEvent()
+		userID := uuid.New()
+		link := newLink(newPrivateEvent())
+		mockInviteRepo, useCase := setup(event)
+
+		mockInviteRepo.On("GetGuest", ctx, event.ID, userID).Return(nil, nil)
+		mockInviteRepo.On("GetLinkByTokenHash", ctx, domain.HashInviteToken(token)).Return(link, nil)
+
+		_, err := useCase.Execute(ctx, &RedeemEventInviteLinkRequest{
+			EventID: event.ID,
+			Token:   token,
+			UserID:  userID,
+		})
+
+		assert.Equal(t, ErrInvalidInviteToken, err)
+	})
+}
+
+func TestRSVPToEventUseCase_InviteToken(t *testing.T) {
+	ctx := context.Background()
+	token := "shared-token"
+
+	event := newPrivateEvent()
+	userID := uuid.New()
+	link := &domain.EventInviteLink{
+		ID:        uuid.New(),
+		EventID:   event.ID,
+		CreatedBy: event.HostUserID,
+		TokenHash: domain.HashInviteToken(token),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	mockEventRepo := new(MockEventRepository)
+	mockInviteRepo := new(MockEventInviteRepository)
+	mockBlockRepo := new(MockUserBlockRepository)
+	useCase := NewRSVPToEventUseCase(mockEventRepo, new(MockGroupRepository), new(MockGroupModerationRepository), mockInviteRepo, mockBlockRepo, nil)
+
+	mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+	mockBlockRepo.On("IsBlocked", ctx, event.HostUserID, userID).Return(false, nil)
+	mockInviteRepo.On("IsGuest", ctx, event.ID, userID).Return(false, nil)
+	mockInviteRepo.On("GetLinkByTokenHash", ctx, domain.HashInviteToken(token)).Return(link, nil)
+	mockInviteRepo.On("RedeemLink", ctx, link.ID, mock.AnythingOfType("*domain.EventGuest")).Return(true, nil)
+	mockEventRepo.On("GetRSVP", ctx, event.ID, userID).Return(nil, nil)
+	mockEventRepo.On("GetEventGoingCount", ctx, event.ID).Return(0, nil)
+	mockEventRepo.On("CreateRSVP", ctx, mock.AnythingOfType("*domain.EventRSVP")).Return(nil)
+
+	t.Run("without a token the private event stays hidden", func(t *testing.T) {
+		_, err := useCase.Execute(ctx, &RSVPToEventRequest{
+			EventID: event.ID,
+			UserID:  userID,
+			Status:  domain.RSVPStatusGoing,
+		})
+
+		assert.Equal(t, ErrUnauthorizedAccess, err)
+	})
+
+	t.Run("invite token grants access", func(t *testing.T) {
+		rsvp, err := useCase.Execute(ctx, &RSVPToEventRequest{
+			EventID:     event.ID,
+			UserID:      userID,
+			Status:      domain.RSVPStatusGoing,
+			InviteToken: token,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.RSVPStatusGoing, rsvp.Status)
+		mockInviteRepo.AssertCalled(t, "RedeemLink", ctx, link.ID, mock.AnythingOfType("*domain.EventGuest"))
+	})
+}
+
+func TestRemoveEventGuestUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("guest leaves and their RSVP is withdrawn", func(t *testing.T) {
+		event := newPrivateEvent()
+		guestID := uuid.New()
+
+		mockEventRepo := new(MockEventRepository)
+		mockInviteRepo := new(MockEventInviteRepository)
+		useCase := NewRemoveEventGuestUseCase(mockEventRepo, new(MockGroupRepository), mockInviteRepo)
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+		mockInviteRepo.On("GetGuest", ctx, event.ID, guestID).Return(&domain.EventGuest{EventID: event.ID, UserID: guestID}, nil)
+		mockInviteRepo.On("RemoveGuest", ctx, event.ID, guestID).Return(nil)
+		mockEventRepo.On("GetRSVP", ctx, event.ID, guestID).Return(&domain.EventRSVP{EventID: event.ID, UserID: guestID}, nil)
+		mockEventRepo.On("DeleteRSVP", ctx, event.ID, guestID).Return(nil)
+
+		err := useCase.Execute(ctx, &RemoveEventGuestRequest{
+			EventID:   event.ID,
+			UserID:    guestID,
+			RemoverID: guestID,
+		})
+
+		assert.NoError(t, err)
+		mockInviteRepo.AssertExpectations(t)
+		mockEventRepo.AssertExpectations(t)
+	})
+
+	t.Run("other guests cannot remove someone", func(t *testing.T) {
+		event := newPrivateEvent()
+
+		mockEventRepo := new(MockEventRepository)
+		useCase := NewRemoveEventGuestUseCase(mockEventRepo, new(MockGroupRepository), new(MockEventInviteRepository))
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+
+		err := useCase.Execute(ctx, &RemoveEventGuestRequest{
+			EventID:   event.ID,
+			UserID:    uuid.New(),
+			RemoverID: uuid.New(),
+		})
+
+		assert.Equal(t, ErrUnauthorizedAccess, err)
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,12 +19,16 @@ var (
 	ErrInvalidEventData   = errors.New("invalid event data")
 	ErrGeocodingFailed    = errors.New("failed to geocode address")
 	ErrNotificationFailed = errors.New("failed to send notifications")
+	ErrEventCancelled     = errors.New("event has been cancelled")
+
+	ErrEventOutsideVenueHours = errors.New("event falls outside the venue's opening hours")
+	ErrEventExceedsVenueSeats = errors.New("event capacity exceeds the venue's seats")
 )
 
 // GeocodingService defines the interface for geocoding operations
 type GeocodingService interface {
-	Geocode(ctx context.Context, address string) (*domain.Coordinates, error)
-	ReverseGeocode(ctx context.Context, lat, lon float64) (*domain.Address, error)
+	Geocode(ctx context.Context, address string) (*service.GeocodingResult, error)
+	ReverseGeocode(ctx context.Context, lat, lon float64) (*service.GeocodingResult, error)
 }
 
 // NotificationService defines the interface for notification operations
@@ -53,6 +58,9 @@ type CreateEventRequest struct {
 	GroupID        *uuid.UUID             `json:"group_id,omitempty"`
 	VenueID        *uuid.UUID             `json:"venue_id,omitempty"`
 	Address        *string                `json:"address,omitempty"`
+
+	// AllowOutsideVenueHours creates the event with a warning when it falls outside a store's opening hours
+	AllowOutsideVenueHours bool `json:"allow_outside_venue_hours,omitempty"`
 }
 
 // UpdateEventRequest represents the request to update an event
@@ -95,8 +103,11 @@ type CreateEventUseCase struct {
 	eventRepo           repository.EventRepository
 	venueRepo           repository.VenueRepository
 	groupRepo           repository.GroupRepository
-	geocodingService    *service.GeocodingService
+	geocodingService    GeocodingService
 	notificationService *service.NotificationService
+	permissionService   *domain.PermissionService
+
+	notificationTriggers *service.NotificationTriggerService
 }
 
 // NewCreateEventUseCase creates a new CreateEventUseCase
@@ -104,7 +115,7 @@ func NewCreateEventUseCase(
 	eventRepo repository.EventRepository,
 	venueRepo repository.VenueRepository,
 	groupRepo repository.GroupRepository,
-	geocodingService *service.GeocodingService,
+	geocodingService GeocodingService,
 	notificationService *service.NotificationService,
 ) *CreateEventUseCase {
 	return &CreateEventUseCase{
@@ -113,6 +124,7 @@ func NewCreateEventUseCase(
 		groupRepo:           groupRepo,
 		geocodingService:    geocodingService,
 		notificationService: notificationService,
+		permissionService:   domain.NewPermissionService(),
 	}
 }
 
@@ -148,17 +160,38 @@ func (uc *CreateEventUseCase) Execute(ctx context.Context, req *CreateEventReque
 		return nil, err
 	}
 
-	// Validate group access if group-only event
+	// Validate that the host may create events in the group
 	if req.GroupID != nil {
-		canAccess, err := uc.groupRepo.CanUserAccessGroup(ctx, *req.GroupID, hostUserID)
+		member, err := uc.groupRepo.GetMember(ctx, *req.GroupID, hostUserID)
 		if err != nil {
 			return nil, err
 		}
-		if !canAccess {
+		if member == nil || !uc.permissionService.CanCreateGroupEvent(*req.GroupID, hostUserID, []domain.GroupMember{*member}) {
 			return nil, ErrUnauthorizedAccess
 		}
 	}
 
+	var warnings []string
+	if req.VenueID != nil {
+		venue, err := uc.venueRepo.GetByID(ctx, *req.VenueID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Stores publish opening hours, so events there must fit them unless the host accepts a warning
+		if !isVenueOpenDuring(venue, event.StartAt, event.EndAt) {
+			if !req.AllowOutsideVenueHours {
+				return nil, ErrEventOutsideVenueHours
+			}
+			warnings = append(warnings, ErrEventOutsideVenueHours.Error())
+		}
+
+		// Hosts may bring extra tables, so too few listed seats only warrants a warning
+		if venue != nil && event.Capacity != nil && venue.ExceedsSeats(*event.Capacity) {
+			warnings = append(warnings, ErrEventExceedsVenueSeats.Error())
+		}
+	}
+
 	// Handle geocoding if address is provided and no venue
 	if req.Address != nil && req.VenueID == nil {
 		_, err := uc.geocodingService.Geocode(ctx, *req.Address)
@@ -182,6 +215,9 @@ func (uc *CreateEventUseCase) Execute(ctx context.Context, req *CreateEventReque
 	if err != nil {
 		return nil, err
 	}
+	if eventWithDetails != nil {
+		eventWithDetails.Warnings = warnings
+	}
 
 	// Send notifications to group members if it's a group event
 	// TODO TMA
@@ -208,16 +244,47 @@ func (uc *CreateEventUseCase) Execute(ctx context.Context, req *CreateEventReque
 		}
 	*/
 
+	// Notify group members and announce on the group's channels
+	if uc.notificationTriggers != nil && event.GroupID != nil {
+		groupID := *event.GroupID
+		go func() {
+			if err := uc.notificationTriggers.OnNewGroupEvent(context.Background(), event.ID, groupID); err != nil {
+				log.Printf("Failed to trigger group event notifications for event %s: %v", event.ID, err)
+			}
+		}()
+	}
+
 	return eventWithDetails, nil
 }
 
+// isVenueOpenDuring reports whether a store venue is open for the whole event. Other venue types,
+// unknown venues and venues without opening hours are not checked.
+func isVenueOpenDuring(venue *domain.Venue, start, end time.Time) bool {
+	if venue == nil || venue.Type != domain.VenueTypeStore {
+		return true
+	}
+
+	open, err := venue.IsOpenDuring(start, end)
+	if err != nil {
+		// Stored hours were validated on save; don't block events over a venue misconfiguration
+		log.Printf("Failed to check opening hours of venue %s: %v", venue.ID, err)
+		return true
+	}
+
+	return open
+}
+
 // UpdateEventUseCase handles event updates
 type UpdateEventUseCase struct {
 	eventRepo           repository.EventRepository
 	venueRepo           repository.VenueRepository
 	groupRepo           repository.GroupRepository
-	geocodingService    *service.GeocodingService
+	geocodingService    GeocodingService
 	notificationService *service.NotificationService
+	permissionService   *domain.PermissionService
+
+	notificationTriggers *service.NotificationTriggerService
+	auditRecorder        AuditRecorder
 }
 
 // NewUpdateEventUseCase creates a new UpdateEventUseCase
@@ -225,7 +292,7 @@ func NewUpdateEventUseCase(
 	eventRepo repository.EventRepository,
 	venueRepo repository.VenueRepository,
 	groupRepo repository.GroupRepository,
-	geocodingService *service.GeocodingService,
+	geocodingService GeocodingService,
 	notificationService *service.NotificationService,
 ) *UpdateEventUseCase {
 	return &UpdateEventUseCase{
@@ -234,6 +301,7 @@ func NewUpdateEventUseCase(
 		groupRepo:           groupRepo,
 		geocodingService:    geocodingService,
 		notificationService: notificationService,
+		permissionService:   domain.NewPermissionService(),
 	}
 }
 
@@ -248,20 +316,25 @@ func (uc *UpdateEventUseCase) Execute(ctx context.Context, req *UpdateEventReque
 		return nil, ErrEventNotFound
 	}
 
-	// Check if user can update this event (must be host or group admin)
-	canUpdate := existingEvent.HostUserID == userID
-	if !canUpdate && existingEvent.GroupID != nil {
-		canManage, err := uc.groupRepo.CanUserManageGroup(ctx, *existingEvent.GroupID, userID)
+	// Check if user can update this event (must be host, group admin or granted edit_event by a custom role)
+	var userGroups []domain.GroupMember
+	if existingEvent.HostUserID != userID && existingEvent.GroupID != nil {
+		member, err := uc.groupRepo.GetMember(ctx, *existingEvent.GroupID, userID)
 		if err != nil {
 			return nil, err
 		}
-		canUpdate = canManage
+		if member != nil {
+			userGroups = append(userGroups, *member)
+		}
 	}
 
-	if !canUpdate {
+	if !uc.permissionService.CanEditEvent(existingEvent, userID, userGroups) {
 		return nil, ErrUnauthorizedAccess
 	}
 
+	// Keep the original for the audit log before applying changes
+	original := *existingEvent
+
 	// Update fields if provided
 	if req.Title != nil {
 		existingEvent.Title = *req.Title
@@ -312,6 +385,10 @@ func (uc *UpdateEventUseCase) Execute(ctx context.Context, req *UpdateEventReque
 		existingEvent.GroupID = req.GroupID
 	}
 	if req.VenueID != nil {
+		// Only the owner of the new venue can vouch for the event there
+		if existingEvent.VenueID == nil || *existingEvent.VenueID != *req.VenueID {
+			existingEvent.IsOfficial = false
+		}
 		existingEvent.VenueID = req.VenueID
 	}
 
@@ -338,6 +415,11 @@ func (uc *UpdateEventUseCase) Execute(ctx context.Context, req *UpdateEventReque
 		return nil, err
 	}
 
+	audit := auditChange(userID, domain.AuditActionEventUpdate, domain.AuditTargetEvent, existingEvent.ID, &existingEvent.HostUserID, &original, existingEvent)
+	if audit.HasChanges() {
+		recordAudit(ctx, uc.auditRecorder, audit)
+	}
+
 	// Get updated event with details
 	eventWithDetails, err := uc.eventRepo.GetByIDWithDetails(ctx, existingEvent.ID)
 	if err != nil {
@@ -367,6 +449,16 @@ func (uc *UpdateEventUseCase) Execute(ctx context.Context, req *UpdateEventReque
 		}
 	*/
 
+	// Notify attendees and announce on the group's channels
+	if uc.notificationTriggers != nil {
+		eventID := existingEvent.ID
+		go func() {
+			if err := uc.notificationTriggers.OnEventUpdate(context.Background(), eventID, ""); err != nil {
+				log.Printf("Failed to trigger update notifications for event %s: %v", eventID, err)
+			}
+		}()
+	}
+
 	return eventWithDetails, nil
 }
 
@@ -375,6 +467,7 @@ type DeleteEventUseCase struct {
 	eventRepo           repository.EventRepository
 	groupRepo           repository.GroupRepository
 	notificationService *service.NotificationService
+	auditRecorder       AuditRecorder
 }
 
 // NewDeleteEventUseCase creates a new DeleteEventUseCase
@@ -437,6 +530,8 @@ func (uc *DeleteEventUseCase) Execute(ctx context.Context, req *DeleteEventReque
 		return err
 	}
 
+	recordAudit(ctx, uc.auditRecorder, auditChange(req.UserID, domain.AuditActionEventDelete, domain.AuditTargetEvent, existingEvent.ID, &existingEvent.HostUserID, existingEvent, nil))
+
 	// Send notifications to attendees
 	// TODO TMA
 	/*
@@ -464,18 +559,21 @@ func (uc *DeleteEventUseCase) Execute(ctx context.Context, req *DeleteEventReque
 
 // GetEventUseCase handles event retrieval with privacy and permission checks
 type GetEventUseCase struct {
-	eventRepo repository.EventRepository
-	groupRepo repository.GroupRepository
+	eventRepo  repository.EventRepository
+	groupRepo  repository.GroupRepository
+	inviteRepo repository.EventInviteRepository
 }
 
 // NewGetEventUseCase creates a new GetEventUseCase
 func NewGetEventUseCase(
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
+	inviteRepo repository.EventInviteRepository,
 ) *GetEventUseCase {
 	return &GetEventUseCase{
-		eventRepo: eventRepo,
-		groupRepo: groupRepo,
+		eventRepo:  eventRepo,
+		groupRepo:  groupRepo,
+		inviteRepo: inviteRepo,
 	}
 }
 
@@ -492,6 +590,11 @@ func (uc *GetEventUseCase) Execute(ctx context.Context, req *GetEventRequest) (*
 
 	event := &eventWithDetails.Event
 
+	// Events hidden by platform moderators remain visible to their organizers only
+	if event.IsHidden() && !event.IsOrganizer(req.UserID) {
+		return nil, ErrEventNotFound
+	}
+
 	// Check visibility and permissions
 	canView := false
 
@@ -499,8 +602,11 @@ func (uc *GetEventUseCase) Execute(ctx context.Context, req *GetEventRequest) (*
 	case domain.EventVisibilityPublic:
 		canView = true
 	case domain.EventVisibilityPrivate:
-		// Only host can view private events
-		canView = event.HostUserID == req.UserID
+		// Only organizers and invited guests can view private events
+		canView, err = canViewPrivateEvent(ctx, uc.inviteRepo, event, req.UserID)
+		if err != nil {
+			return nil, err
+		}
 	case domain.EventVisibilityGroupOnly:
 		// Only group members can view group-only events
 		if event.GroupID != nil {
@@ -585,6 +691,7 @@ type EventSearchResponse struct {
 type SearchEventsUseCase struct {
 	eventRepo         repository.EventRepository
 	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
 	geospatialService *domain.GeospatialService
 }
 
@@ -592,11 +699,13 @@ type SearchEventsUseCase struct {
 func NewSearchEventsUseCase(
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
+	inviteRepo repository.EventInviteRepository,
 	geospatialService *domain.GeospatialService,
 ) *SearchEventsUseCase {
 	return &SearchEventsUseCase{
 		eventRepo:         eventRepo,
 		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
 		geospatialService: geospatialService,
 	}
 }
@@ -629,10 +738,15 @@ func (uc *SearchEventsUseCase) Execute(ctx context.Context, req *SearchEventsReq
 		return nil, err
 	}
 
+	guestEventIDs, err := getGuestEventIDs(ctx, uc.inviteRepo, events, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Filter events based on user permissions
 	var filteredEvents []*domain.EventWithDetails
 	for _, event := range events {
-		canView, err := uc.canUserViewEvent(ctx, event, req.UserID)
+		canView, err := uc.canUserViewEvent(ctx, event, req.UserID, guestEventIDs)
 		if err != nil {
 			continue // Skip events we can't check permissions for
 		}
@@ -682,6 +796,7 @@ func (uc *SearchEventsUseCase) Execute(ctx context.Context, req *SearchEventsReq
 type SearchNearbyEventsUseCase struct {
 	eventRepo         repository.EventRepository
 	groupRepo         repository.GroupRepository
+	inviteRepo        repository.EventInviteRepository
 	geospatialService *domain.GeospatialService
 }
 
@@ -689,11 +804,13 @@ type SearchNearbyEventsUseCase struct {
 func NewSearchNearbyEventsUseCase(
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
+	inviteRepo repository.EventInviteRepository,
 	geospatialService *domain.GeospatialService,
 ) *SearchNearbyEventsUseCase {
 	return &SearchNearbyEventsUseCase{
 		eventRepo:         eventRepo,
 		groupRepo:         groupRepo,
+		inviteRepo:        inviteRepo,
 		geospatialService: geospatialService,
 	}
 }
@@ -729,10 +846,15 @@ func (uc *SearchNearbyEventsUseCase) Execute(ctx context.Context, req *SearchNea
 		return nil, err
 	}
 
+	guestEventIDs, err := getGuestEventIDs(ctx, uc.inviteRepo, events, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Filter events based on user permissions
 	var filteredEvents []*domain.EventWithDetails
 	for _, event := range events {
-		canView, err := uc.canUserViewEvent(ctx, event, req.UserID)
+		canView, err := uc.canUserViewEvent(ctx, event, req.UserID, guestEventIDs)
 		if err != nil {
 			continue // Skip events we can't check permissions for
 		}
@@ -785,12 +907,12 @@ func (uc *SearchNearbyEventsUseCase) Execute(ctx context.Context, req *SearchNea
 
 // Helper methods for permission checking and scoring
 
-func (uc *SearchEventsUseCase) canUserViewEvent(ctx context.Context, event *domain.EventWithDetails, userID uuid.UUID) (bool, error) {
+func (uc *SearchEventsUseCase) canUserViewEvent(ctx context.Context, event *domain.EventWithDetails, userID uuid.UUID, guestEventIDs map[uuid.UUID]bool) (bool, error) {
 	switch event.Visibility {
 	case domain.EventVisibilityPublic:
 		return true, nil
 	case domain.EventVisibilityPrivate:
-		return event.HostUserID == userID, nil
+		return event.IsOrganizer(userID) || guestEventIDs[event.ID], nil
 	case domain.EventVisibilityGroupOnly:
 		if event.GroupID == nil {
 			return false, nil
@@ -801,12 +923,12 @@ func (uc *SearchEventsUseCase) canUserViewEvent(ctx context.Context, event *doma
 	}
 }
 
-func (uc *SearchNearbyEventsUseCase) canUserViewEvent(ctx context.Context, event *domain.EventWithDetails, userID uuid.UUID) (bool, error) {
+func (uc *SearchNearbyEventsUseCase) canUserViewEvent(ctx context.Context, event *domain.EventWithDetails, userID uuid.UUID, guestEventIDs map[uuid.UUID]bool) (bool, error) {
 	switch event.Visibility {
 	case domain.EventVisibilityPublic:
 		return true, nil
 	case domain.EventVisibilityPrivate:
-		return event.HostUserID == userID, nil
+		return event.IsOrganizer(userID) || guestEventIDs[event.ID], nil
 	case domain.EventVisibilityGroupOnly:
 		if event.GroupID == nil {
 			return false, nil
@@ -920,9 +1042,10 @@ func (uc *SearchNearbyEventsUseCase) sortNearbyEventsByDistanceAndScore(results
 
 // RSVPToEventRequest represents the request to RSVP to an event
 type RSVPToEventRequest struct {
-	EventID uuid.UUID         `json:"event_id" validate:"required"`
-	UserID  uuid.UUID         `json:"user_id" validate:"required"`
-	Status  domain.RSVPStatus `json:"status" validate:"required"`
+	EventID     uuid.UUID         `json:"event_id" validate:"required"`
+	UserID      uuid.UUID         `json:"user_id" validate:"required"`
+	Status      domain.RSVPStatus `json:"status" validate:"required"`
+	InviteToken string            `json:"invite_token,omitempty"` // Invite link token for private events
 }
 
 // GetEventAttendeesRequest represents the request to get event attendees
@@ -944,18 +1067,28 @@ type EventAttendeesResponse struct {
 type RSVPToEventUseCase struct {
 	eventRepo           repository.EventRepository
 	groupRepo           repository.GroupRepository
+	moderationRepo      repository.GroupModerationRepository
+	inviteRepo          repository.EventInviteRepository
+	blockRepo           repository.UserBlockRepository
 	notificationService *service.NotificationService
+	auditRecorder       AuditRecorder
 }
 
 // NewRSVPToEventUseCase creates a new RSVPToEventUseCase
 func NewRSVPToEventUseCase(
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
+	moderationRepo repository.GroupModerationRepository,
+	inviteRepo repository.EventInviteRepository,
+	blockRepo repository.UserBlockRepository,
 	notificationService *service.NotificationService,
 ) *RSVPToEventUseCase {
 	return &RSVPToEventUseCase{
 		eventRepo:           eventRepo,
 		groupRepo:           groupRepo,
+		moderationRepo:      moderationRepo,
+		inviteRepo:          inviteRepo,
+		blockRepo:           blockRepo,
 		notificationService: notificationService,
 	}
 }
@@ -967,19 +1100,47 @@ func (uc *RSVPToEventUseCase) Execute(ctx context.Context, req *RSVPToEventReque
 	if err != nil {
 		return nil, err
 	}
-	if event == nil {
+	if event == nil || event.IsHidden() {
 		return nil, ErrEventNotFound
 	}
+	if event.IsCancelled() {
+		return nil, ErrEventCancelled
+	}
+
+	// Hosts of private and group-only events can keep users they blocked out
+	if event.Visibility != domain.EventVisibilityPublic {
+		blocked, err := uc.blockRepo.IsBlocked(ctx, event.HostUserID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, ErrUnauthorizedAccess
+		}
+	}
 
 	// Check if user can view/access this event
 	canView, err := uc.canUserViewEvent(ctx, event, req.UserID)
 	if err != nil {
 		return nil, err
 	}
+	if !canView && event.SupportsGuestList() && req.InviteToken != "" {
+		// Users holding an invite link join the guest list as they RSVP
+		if _, err := redeemEventInviteLink(ctx, uc.inviteRepo, event, req.InviteToken, req.UserID); err != nil {
+			return nil, err
+		}
+		canView = true
+	}
 	if !canView {
 		return nil, ErrUnauthorizedAccess
 	}
 
+	// Users banned from the group cannot take part in its group-only events
+	if event.Visibility == domain.EventVisibilityGroupOnly && event.GroupID != nil {
+		if err := checkNotBanned(ctx, uc.moderationRepo, *event.GroupID, req.UserID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check if user already has an RSVP
 	existingRSVP, err := uc.eventRepo.GetRSVP(ctx, req.EventID, req.UserID)
 	if err != nil {
@@ -1009,8 +1170,13 @@ func (uc *RSVPToEventUseCase) Execute(ctx context.Context, req *RSVPToEventReque
 
 	if existingRSVP != nil {
 		// Update existing RSVP
+		original := *existingRSVP
 		existingRSVP.Status = req.Status
 		existingRSVP.UpdatedAt = now
+		if !existingRSVP.IsGoing() {
+			// Attendees who are no longer going are no longer checked in either
+			existingRSVP.CheckedInAt = nil
+		}
 
 		if err := existingRSVP.Validate(); err != nil {
 			return nil, err
@@ -1020,6 +1186,10 @@ func (uc *RSVPToEventUseCase) Execute(ctx context.Context, req *RSVPToEventReque
 			return nil, err
 		}
 
+		if original.Status != existingRSVP.Status {
+			recordAudit(ctx, uc.auditRecorder, auditChange(req.UserID, domain.AuditActionRSVPChange, domain.AuditTargetEvent, event.ID, &req.UserID, &original, existingRSVP))
+		}
+
 		return existingRSVP, nil
 	} else {
 		// Create new RSVP
@@ -1039,6 +1209,8 @@ func (uc *RSVPToEventUseCase) Execute(ctx context.Context, req *RSVPToEventReque
 			return nil, err
 		}
 
+		recordAudit(ctx, uc.auditRecorder, auditChange(req.UserID, domain.AuditActionRSVPChange, domain.AuditTargetEvent, event.ID, &req.UserID, nil, newRSVP))
+
 		return newRSVP, nil
 	}
 }
@@ -1048,7 +1220,7 @@ func (uc *RSVPToEventUseCase) canUserViewEvent(ctx context.Context, event *domai
 	case domain.EventVisibilityPublic:
 		return true, nil
 	case domain.EventVisibilityPrivate:
-		return event.HostUserID == userID, nil
+		return canViewPrivateEvent(ctx, uc.inviteRepo, event, userID)
 	case domain.EventVisibilityGroupOnly:
 		if event.GroupID == nil {
 			return false, nil
@@ -1172,18 +1344,24 @@ func (s *ManageWaitlistService) PromoteFromWaitlist(ctx context.Context, eventID
 
 // GetEventAttendeesUseCase handles retrieving event attendees with privacy filtering
 type GetEventAttendeesUseCase struct {
-	eventRepo repository.EventRepository
-	groupRepo repository.GroupRepository
+	eventRepo  repository.EventRepository
+	groupRepo  repository.GroupRepository
+	inviteRepo repository.EventInviteRepository
+	blockRepo  repository.UserBlockRepository
 }
 
 // NewGetEventAttendeesUseCase creates a new GetEventAttendeesUseCase
 func NewGetEventAttendeesUseCase(
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
+	inviteRepo repository.EventInviteRepository,
+	blockRepo repository.UserBlockRepository,
 ) *GetEventAttendeesUseCase {
 	return &GetEventAttendeesUseCase{
-		eventRepo: eventRepo,
-		groupRepo: groupRepo,
+		eventRepo:  eventRepo,
+		groupRepo:  groupRepo,
+		inviteRepo: inviteRepo,
+		blockRepo:  blockRepo,
 	}
 }
 
@@ -1213,10 +1391,28 @@ func (uc *GetEventAttendeesUseCase) Execute(ctx context.Context, req *GetEventAt
 		return nil, err
 	}
 
+	// Users who blocked or were blocked by the requester are hidden from the lists
+	blockedIDs, err := uc.blockRepo.GetBlockedUserIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[uuid.UUID]bool, len(blockedIDs))
+	for _, id := range blockedIDs {
+		blocked[id] = true
+	}
+
 	// Separate RSVPs by status
 	var going, interested, waitlisted []*domain.EventRSVP
+	goingCount := 0
 
 	for _, rsvp := range allRSVPs {
+		if rsvp.Status == domain.RSVPStatusGoing {
+			goingCount++
+		}
+		if blocked[rsvp.UserID] {
+			continue
+		}
+
 		switch rsvp.Status {
 		case domain.RSVPStatusGoing:
 			going = append(going, rsvp)
@@ -1238,7 +1434,7 @@ func (uc *GetEventAttendeesUseCase) Execute(ctx context.Context, req *GetEventAt
 		Going:      filteredGoing,
 		Interested: filteredInterested,
 		Waitlisted: filteredWaitlisted,
-		GoingCount: len(going),
+		GoingCount: goingCount,
 		TotalCount: len(allRSVPs),
 	}, nil
 }
@@ -1248,7 +1444,7 @@ func (uc *GetEventAttendeesUseCase) canUserViewEvent(ctx context.Context, event
 	case domain.EventVisibilityPublic:
 		return true, nil
 	case domain.EventVisibilityPrivate:
-		return event.HostUserID == userID, nil
+		return canViewPrivateEvent(ctx, uc.inviteRepo, event, userID)
 	case domain.EventVisibilityGroupOnly:
 		if event.GroupID == nil {
 			return false, nil
@@ -1274,8 +1470,8 @@ func (uc *GetEventAttendeesUseCase) filterRSVPsForPrivacy(rsvps []*domain.EventR
 			continue
 		}
 
-		// Always show RSVPs to event host
-		if event.HostUserID == requestingUserID {
+		// Always show RSVPs to the event host and co-hosts
+		if event.IsOrganizer(requestingUserID) {
 			filtered = append(filtered, rsvp)
 			continue
 		}
@@ -1304,6 +1500,23 @@ type EventManagementUseCase struct {
 	searchNearbyEventsUseCase *SearchNearbyEventsUseCase
 	rsvpToEventUseCase        *RSVPToEventUseCase
 	getEventAttendeesUseCase  *GetEventAttendeesUseCase
+
+	inviteEventCoHostUseCase     *InviteEventCoHostUseCase
+	respondEventCoHostUseCase    *RespondEventCoHostUseCase
+	updateEventCoHostUseCase     *UpdateEventCoHostUseCase
+	removeEventCoHostUseCase     *RemoveEventCoHostUseCase
+	listEventCoHostsUseCase      *ListEventCoHostsUseCase
+	listCoHostInvitationsUseCase *ListCoHostInvitationsUseCase
+
+	addEventGuestUseCase         *AddEventGuestUseCase
+	removeEventGuestUseCase      *RemoveEventGuestUseCase
+	listEventGuestsUseCase       *ListEventGuestsUseCase
+	createEventInviteLinkUseCase *CreateEventInviteLinkUseCase
+	revokeEventInviteLinkUseCase *RevokeEventInviteLinkUseCase
+	listEventInviteLinksUseCase  *ListEventInviteLinksUseCase
+	redeemEventInviteLinkUseCase *RedeemEventInviteLinkUseCase
+
+	checkInAttendeeUseCase *CheckInAttendeeUseCase
 }
 
 // NewEventManagementUseCase creates a new unified event management use case
@@ -1311,22 +1524,60 @@ func NewEventManagementUseCase(
 	eventRepo repository.EventRepository,
 	venueRepo repository.VenueRepository,
 	groupRepo repository.GroupRepository,
-	geocodingService *service.GeocodingService,
+	moderationRepo repository.GroupModerationRepository,
+	geocodingService GeocodingService,
 	notificationService *service.NotificationService,
 	geospatialService *domain.GeospatialService,
+	userRepo repository.UserRepository,
+	coHostRepo repository.EventCoHostRepository,
+	inviteRepo repository.EventInviteRepository,
+	blockRepo repository.UserBlockRepository,
 ) *EventManagementUseCase {
 	return &EventManagementUseCase{
 		createEventUseCase:        NewCreateEventUseCase(eventRepo, venueRepo, groupRepo, geocodingService, notificationService),
 		updateEventUseCase:        NewUpdateEventUseCase(eventRepo, venueRepo, groupRepo, geocodingService, notificationService),
 		deleteEventUseCase:        NewDeleteEventUseCase(eventRepo, groupRepo, notificationService),
-		getEventUseCase:           NewGetEventUseCase(eventRepo, groupRepo),
-		searchEventsUseCase:       NewSearchEventsUseCase(eventRepo, groupRepo, geospatialService),
-		searchNearbyEventsUseCase: NewSearchNearbyEventsUseCase(eventRepo, groupRepo, geospatialService),
-		rsvpToEventUseCase:        NewRSVPToEventUseCase(eventRepo, groupRepo, notificationService),
-		getEventAttendeesUseCase:  NewGetEventAttendeesUseCase(eventRepo, groupRepo),
+		getEventUseCase:           NewGetEventUseCase(eventRepo, groupRepo, inviteRepo),
+		searchEventsUseCase:       NewSearchEventsUseCase(eventRepo, groupRepo, inviteRepo, geospatialService),
+		searchNearbyEventsUseCase: NewSearchNearbyEventsUseCase(eventRepo, groupRepo, inviteRepo, geospatialService),
+		rsvpToEventUseCase:        NewRSVPToEventUseCase(eventRepo, groupRepo, moderationRepo, inviteRepo, blockRepo, notificationService),
+		getEventAttendeesUseCase:  NewGetEventAttendeesUseCase(eventRepo, groupRepo, inviteRepo, blockRepo),
+
+		inviteEventCoHostUseCase:     NewInviteEventCoHostUseCase(eventRepo, coHostRepo, userRepo),
+		respondEventCoHostUseCase:    NewRespondEventCoHostUseCase(coHostRepo),
+		updateEventCoHostUseCase:     NewUpdateEventCoHostUseCase(eventRepo, coHostRepo),
+		removeEventCoHostUseCase:     NewRemoveEventCoHostUseCase(eventRepo, coHostRepo),
+		listEventCoHostsUseCase:      NewListEventCoHostsUseCase(eventRepo, coHostRepo),
+		listCoHostInvitationsUseCase: NewListCoHostInvitationsUseCase(coHostRepo),
+
+		addEventGuestUseCase:         NewAddEventGuestUseCase(eventRepo, groupRepo, inviteRepo, userRepo),
+		removeEventGuestUseCase:      NewRemoveEventGuestUseCase(eventRepo, groupRepo, inviteRepo),
+		listEventGuestsUseCase:       NewListEventGuestsUseCase(eventRepo, groupRepo, inviteRepo),
+		createEventInviteLinkUseCase: NewCreateEventInviteLinkUseCase(eventRepo, groupRepo, inviteRepo),
+		revokeEventInviteLinkUseCase: NewRevokeEventInviteLinkUseCase(eventRepo, groupRepo, inviteRepo),
+		listEventInviteLinksUseCase:  NewListEventInviteLinksUseCase(eventRepo, groupRepo, inviteRepo),
+		redeemEventInviteLinkUseCase: NewRedeemEventInviteLinkUseCase(eventRepo, inviteRepo),
+
+		checkInAttendeeUseCase: NewCheckInAttendeeUseCase(eventRepo, groupRepo),
 	}
 }
 
+// SetNotificationTriggers enables event-driven notifications for event creation, updates, co-host and guest invitations
+func (uc *EventManagementUseCase) SetNotificationTriggers(triggers *service.NotificationTriggerService) {
+	uc.createEventUseCase.notificationTriggers = triggers
+	uc.updateEventUseCase.notificationTriggers = triggers
+	uc.inviteEventCoHostUseCase.notifier = triggers
+	uc.respondEventCoHostUseCase.notifier = triggers
+	uc.addEventGuestUseCase.notifier = triggers
+}
+
+// SetAuditRecorder enables audit logging of event edits, deletions and RSVP changes
+func (uc *EventManagementUseCase) SetAuditRecorder(recorder AuditRecorder) {
+	uc.updateEventUseCase.auditRecorder = recorder
+	uc.deleteEventUseCase.auditRecorder = recorder
+	uc.rsvpToEventUseCase.auditRecorder = recorder
+}
+
 // CreateEvent creates a new event
 func (uc *EventManagementUseCase) CreateEvent(ctx context.Context, req *CreateEventRequest, hostUserID uuid.UUID) (*domain.EventWithDetails, error) {
 	return uc.createEventUseCase.Execute(ctx, req, hostUserID)
@@ -1366,3 +1617,73 @@ func (uc *EventManagementUseCase) RSVPToEvent(ctx context.Context, req *RSVPToEv
 func (uc *EventManagementUseCase) GetEventAttendees(ctx context.Context, req *GetEventAttendeesRequest) (*EventAttendeesResponse, error) {
 	return uc.getEventAttendeesUseCase.Execute(ctx, req)
 }
+
+// InviteEventCoHost invites a user to co-host an event
+func (uc *EventManagementUseCase) InviteEventCoHost(ctx context.Context, req *InviteEventCoHostRequest) (*domain.EventCoHost, error) {
+	return uc.inviteEventCoHostUseCase.Execute(ctx, req)
+}
+
+// RespondEventCoHost accepts or declines a co-host invitation
+func (uc *EventManagementUseCase) RespondEventCoHost(ctx context.Context, req *RespondEventCoHostRequest) (*domain.EventCoHost, error) {
+	return uc.respondEventCoHostUseCase.Execute(ctx, req)
+}
+
+// UpdateEventCoHost changes a co-host's delegated rights
+func (uc *EventManagementUseCase) UpdateEventCoHost(ctx context.Context, req *UpdateEventCoHostRequest) (*domain.EventCoHost, error) {
+	return uc.updateEventCoHostUseCase.Execute(ctx, req)
+}
+
+// RemoveEventCoHost removes a co-host from an event
+func (uc *EventManagementUseCase) RemoveEventCoHost(ctx context.Context, req *RemoveEventCoHostRequest) error {
+	return uc.removeEventCoHostUseCase.Execute(ctx, req)
+}
+
+// ListEventCoHosts lists an event's co-hosts
+func (uc *EventManagementUseCase) ListEventCoHosts(ctx context.Context, req *ListEventCoHostsRequest) (*ListEventCoHostsResponse, error) {
+	return uc.listEventCoHostsUseCase.Execute(ctx, req)
+}
+
+// ListCoHostInvitations lists the co-host invitations awaiting a user's answer
+func (uc *EventManagementUseCase) ListCoHostInvitations(ctx context.Context, req *ListCoHostInvitationsRequest) ([]*domain.EventCoHost, error) {
+	return uc.listCoHostInvitationsUseCase.Execute(ctx, req)
+}
+
+// AddEventGuest adds a user to a private event's guest list
+func (uc *EventManagementUseCase) AddEventGuest(ctx context.Context, req *AddEventGuestRequest) (*domain.EventGuest, error) {
+	return uc.addEventGuestUseCase.Execute(ctx, req)
+}
+
+// RemoveEventGuest removes a user from a private event's guest list
+func (uc *EventManagementUseCase) RemoveEventGuest(ctx context.Context, req *RemoveEventGuestRequest) error {
+	return uc.removeEventGuestUseCase.Execute(ctx, req)
+}
+
+// ListEventGuests lists a private event's guests
+func (uc *EventManagementUseCase) ListEventGuests(ctx context.Context, req *ListEventGuestsRequest) (*ListEventGuestsResponse, error) {
+	return uc.listEventGuestsUseCase.Execute(ctx, req)
+}
+
+// CreateEventInviteLink creates a shareable invite link for a private event
+func (uc *EventManagementUseCase) CreateEventInviteLink(ctx context.Context, req *CreateEventInviteLinkRequest) (*CreateEventInviteLinkResponse, error) {
+	return uc.createEventInviteLinkUseCase.Execute(ctx, req)
+}
+
+// RevokeEventInviteLink revokes an event invite link
+func (uc *EventManagementUseCase) RevokeEventInviteLink(ctx context.Context, req *RevokeEventInviteLinkRequest) error {
+	return uc.revokeEventInviteLinkUseCase.Execute(ctx, req)
+}
+
+// ListEventInviteLinks lists an event's invite links
+func (uc *EventManagementUseCase) ListEventInviteLinks(ctx context.Context, req *ListEventInviteLinksRequest) (*ListEventInviteLinksResponse, error) {
+	return uc.listEventInviteLinksUseCase.Execute(ctx, req)
+}
+
+// RedeemEventInviteLink adds the user to a private event's guest list with an invite link token
+func (uc *EventManagementUseCase) RedeemEventInviteLink(ctx context.Context, req *RedeemEventInviteLinkRequest) (*domain.EventGuest, error) {
+	return uc.redeemEventInviteLinkUseCase.Execute(ctx, req)
+}
+
+// CheckInAttendee checks an attendee in at an event, or undoes the check-in
+func (uc *EventManagementUseCase) CheckInAttendee(ctx context.Context, req *CheckInAttendeeRequest) (*domain.EventRSVP, error) {
+	return uc.checkInAttendeeUseCase.Execute(ctx, req)
+}
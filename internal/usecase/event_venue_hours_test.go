@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEventUseCase_VenueOpeningHours(t *testing.T) {
+	ctx := context.Background()
+	hostUserID := uuid.New()
+
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	require.NoError(t, err)
+	timezone := "Europe/Lisbon"
+
+	// 2030-01-04 is a Friday
+	at := func(hour int) time.Time {
+		return time.Date(2030, time.January, 4, hour, 0, 0, 0, lisbon)
+	}
+
+	newVenue := func(venueType domain.VenueType) *domain.Venue {
+		return &domain.Venue{
+			ID:           uuid.New(),
+			Name:         "Card Shop",
+			Type:         venueType,
+			Timezone:     &timezone,
+			OpeningHours: &domain.OpeningHours{Weekly: []domain.OpeningPeriod{{Day: "friday", Opens: "14:00", Closes: "23:00"}}},
+		}
+	}
+
+	newRequest := func(venueID uuid.UUID, startHour, endHour int) *CreateEventRequest {
+		return &CreateEventRequest{
+			Title:      "Friday Night Magic",
+			Game:       domain.GameTypeMTG,
+			Visibility: domain.EventVisibilityPublic,
+			StartAt:    at(startHour),
+			EndAt:      at(endHour),
+			Timezone:   timezone,
+			Language:   "pt",
+			VenueID:    &venueID,
+		}
+	}
+
+	setup := func(venue *domain.Venue) (*CreateEventUseCase, *MockEventRepository) {
+		venueRepo := NewMockVenueRepository()
+		venueRepo.venues[venue.ID] = venue
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("Create", ctx, mock.AnythingOfType("*domain.Event")).Return(nil).Maybe()
+		eventRepo.On("GetByIDWithDetails", ctx, mock.AnythingOfType("uuid.UUID")).Return(&domain.EventWithDetails{}, nil).Maybe()
+		return NewCreateEventUseCase(eventRepo, venueRepo, nil, nil, nil), eventRepo
+	}
+
+	t.Run("store event within opening hours", func(t *testing.T) {
+		venue := newVenue(domain.VenueTypeStore)
+		useCase, eventRepo := setup(venue)
+
+		result, err := useCase.Execute(ctx, newRequest(venue.ID, 18, 22), hostUserID)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Warnings)
+		eventRepo.AssertCalled(t, "Create", ctx, mock.AnythingOfType("*domain.Event"))
+	})
+
+	t.Run("rejects store event outside opening hours", func(t *testing.T) {
+		venue := newVenue(domain.VenueTypeStore)
+		useCase, eventRepo := setup(venue)
+
+		result, err := useCase.Execute(ctx, newRequest(venue.ID, 10, 12), hostUserID)
+
+		assert.Equal(t, ErrEventOutsideVenueHours, err)
+		assert.Nil(t, result)
+		eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("warns when host allows event outside opening hours", func(t *testing.T) {
+		venue := newVenue(domain.VenueTypeStore)
+		useCase, _ := setup(venue)
+		req := newRequest(venue.ID, 20, 24)
+		req.AllowOutsideVenueHours = true
+
+		result, err := useCase.Execute(ctx, req, hostUserID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{ErrEventOutsideVenueHours.Error()}, result.Warnings)
+	})
+
+	t.Run("does not check other venue types", func(t *testing.T) {
+		venue := newVenue(domain.VenueTypeHome)
+		useCase, eventRepo := setup(venue)
+
+		_, err := useCase.Execute(ctx, newRequest(venue.ID, 10, 12), hostUserID)
+
+		require.NoError(t, err)
+		eventRepo.AssertCalled(t, "Create", ctx, mock.AnythingOfType("*domain.Event"))
+	})
+}
+
+func TestCreateEventUseCase_VenueSeats(t *testing.T) {
+	ctx := context.Background()
+	seats := 32
+	venue := &domain.Venue{
+		ID:        uuid.New(),
+		Name:      "Card Shop",
+		Type:      domain.VenueTypeStore,
+		Amenities: &domain.VenueAmenities{Seats: &seats},
+	}
+
+	venueRepo := NewMockVenueRepository()
+	venueRepo.venues[venue.ID] = venue
+	eventRepo := new(MockEventRepository)
+	eventRepo.On("Create", ctx, mock.AnythingOfType("*domain.Event")).Return(nil)
+	eventRepo.On("GetByIDWithDetails", ctx, mock.AnythingOfType("uuid.UUID")).Return(&domain.EventWithDetails{}, nil)
+	useCase := NewCreateEventUseCase(eventRepo, venueRepo, nil, nil, nil)
+
+	tests := []struct {
+		name         string
+		capacity     *int
+		wantWarnings []string
+	}{
+		{"fits the venue", func() *int { i := 32; return &i }(), nil},
+		{"no capacity", nil, nil},
+		{"more players than seats", func() *int { i := 64; return &i }(), []string{ErrEventExceedsVenueSeats.Error()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := useCase.Execute(ctx, &CreateEventRequest{
+				Title:      "Regional Qualifier",
+				Game:       domain.GameTypeMTG,
+				Visibility: domain.EventVisibilityPublic,
+				Capacity:   tt.capacity,
+				StartAt:    time.Now().Add(24 * time.Hour),
+				EndAt:      time.Now().Add(30 * time.Hour),
+				Timezone:   "UTC",
+				Language:   "en",
+				VenueID:    &venue.ID,
+			}, uuid.New())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantWarnings, result.Warnings)
+		})
+	}
+}
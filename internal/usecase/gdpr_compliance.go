@@ -2,8 +2,8 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,37 +23,31 @@ type ExportUserDataResponse struct {
 	Data       map[string]interface{} `json:"data"`
 }
 
-// DeleteUserAccountRequest represents the request to delete user account
+// DeleteUserAccountRequest represents a scheduled account deletion that is due to be carried out
 type DeleteUserAccountRequest struct {
-	UserID uuid.UUID `json:"-"` // Set from authentication context
+	Deletion *domain.AccountDeletion `json:"-"` // Loaded by the deletion scheduler
 }
 
 // DeleteUserAccountResponse represents the response after account deletion
 type DeleteUserAccountResponse struct {
-	DeletedAt time.Time `json:"deleted_at"`
-	UserID    uuid.UUID `json:"user_id"`
-	Message   string    `json:"message"`
+	DeletedAt time.Time                     `json:"deleted_at"`
+	UserID    uuid.UUID                     `json:"user_id"`
+	Message   string                        `json:"message"`
+	Report    *domain.AccountDeletionReport `json:"report"`
 }
 
-// ConsentUpdateRequest represents a request to update user consent
-type ConsentUpdateRequest struct {
-	UserID      uuid.UUID              `json:"-"` // Set from authentication context
-	ConsentType string                 `json:"consent_type" validate:"required"`
-	Granted     bool                   `json:"granted"`
-	Metadata    map[string]interface{} `json:"metadata,omitempty"`
-}
+// ErrConsentNotFound is returned when a user has never answered a consent type
+var ErrConsentNotFound = errors.New("consent not found")
 
-// ConsentRecord represents a consent record
-type ConsentRecord struct {
-	ID          uuid.UUID              `json:"id"`
-	UserID      uuid.UUID              `json:"user_id"`
-	ConsentType string                 `json:"consent_type"`
-	Granted     bool                   `json:"granted"`
-	GrantedAt   time.Time              `json:"granted_at"`
-	RevokedAt   *time.Time             `json:"revoked_at,omitempty"`
-	Metadata    map[string]interface{} `json:"metadata"`
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
+// ConsentUpdateRequest represents a request to grant or revoke user consent
+type ConsentUpdateRequest struct {
+	UserID        uuid.UUID              `json:"-"` // Set from authentication context
+	ConsentType   domain.ConsentType     `json:"consent_type" validate:"required"`
+	Granted       bool                   `json:"granted"`
+	PolicyVersion string                 `json:"policy_version,omitempty"`
+	IPAddress     string                 `json:"-"` // Captured from the request
+	UserAgent     string                 `json:"-"` // Captured from the request
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // ExportUserDataUseCase handles complete user data export for GDPR compliance
@@ -62,6 +56,8 @@ type ExportUserDataUseCase struct {
 	eventRepo        repository.EventRepository
 	groupRepo        repository.GroupRepository
 	notificationRepo repository.NotificationRepository
+	consentRepo      repository.ConsentRepository
+	auditRepo        repository.AuditLogRepository
 }
 
 // NewExportUserDataUseCase creates a new ExportUserDataUseCase
@@ -70,12 +66,16 @@ func NewExportUserDataUseCase(
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
 	notificationRepo repository.NotificationRepository,
+	consentRepo repository.ConsentRepository,
+	auditRepo repository.AuditLogRepository,
 ) *ExportUserDataUseCase {
 	return &ExportUserDataUseCase{
 		userRepo:         userRepo,
 		eventRepo:        eventRepo,
 		groupRepo:        groupRepo,
 		notificationRepo: notificationRepo,
+		consentRepo:      consentRepo,
+		auditRepo:        auditRepo,
 	}
 }
 
@@ -143,6 +143,18 @@ func (uc *ExportUserDataUseCase) Execute(ctx context.Context, req *ExportUserDat
 		exportData["notifications"] = userNotifications
 	}
 
+	// Export the full consent history, including revoked consents
+	consentHistory, err := uc.consentRepo.GetHistory(ctx, req.UserID)
+	if err == nil {
+		exportData["consents"] = consentHistory
+	}
+
+	// Export audit entries made by or concerning the user
+	auditEntries, err := uc.auditRepo.List(ctx, domain.AuditLogFilter{AccountID: &req.UserID}, 1000, 0)
+	if err == nil {
+		exportData["audit_log"] = auditEntries
+	}
+
 	// Export additional data from repository
 	additionalData, err := uc.userRepo.ExportUserData(ctx, req.UserID)
 	if err == nil {
@@ -158,12 +170,18 @@ func (uc *ExportUserDataUseCase) Execute(ctx context.Context, req *ExportUserDat
 	}, nil
 }
 
-// DeleteUserAccountUseCase handles complete user account deletion with cascading cleanup
+// DeleteUserAccountUseCase carries out a scheduled account deletion. Future events the user hosts are
+// handed over to a co-host or group admin or cancelled, past events are kept under the anonymized
+// account, and owned groups pass to another member. Everything is applied in one transaction.
 type DeleteUserAccountUseCase struct {
-	userRepo         repository.UserRepository
-	eventRepo        repository.EventRepository
-	groupRepo        repository.GroupRepository
-	notificationRepo repository.NotificationRepository
+	userRepo      repository.UserRepository
+	eventRepo     repository.EventRepository
+	groupRepo     repository.GroupRepository
+	coHostRepo    repository.EventCoHostRepository
+	deletionRepo  repository.AccountDeletionRepository
+	exportStorage DataExportStorage
+	notifier      AccountDeletionNotifier
+	auditRecorder AuditRecorder
 }
 
 // NewDeleteUserAccountUseCase creates a new DeleteUserAccountUseCase
@@ -171,227 +189,273 @@ func NewDeleteUserAccountUseCase(
 	userRepo repository.UserRepository,
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
-	notificationRepo repository.NotificationRepository,
+	coHostRepo repository.EventCoHostRepository,
+	deletionRepo repository.AccountDeletionRepository,
 ) *DeleteUserAccountUseCase {
 	return &DeleteUserAccountUseCase{
-		userRepo:         userRepo,
-		eventRepo:        eventRepo,
-		groupRepo:        groupRepo,
-		notificationRepo: notificationRepo,
+		userRepo:     userRepo,
+		eventRepo:    eventRepo,
+		groupRepo:    groupRepo,
+		coHostRepo:   coHostRepo,
+		deletionRepo: deletionRepo,
 	}
 }
 
-// Execute permanently deletes user account and all associated data
+// Execute plans what happens to the user's events and groups, then deletes the account
 func (uc *DeleteUserAccountUseCase) Execute(ctx context.Context, req *DeleteUserAccountRequest) (*DeleteUserAccountResponse, error) {
+	deletion := req.Deletion
+	if deletion == nil || !deletion.IsScheduled() {
+		return nil, domain.ErrAccountDeletionNotScheduled
+	}
+	userID := deletion.UserID
+
 	// Verify user exists
-	_, err := uc.userRepo.GetByID(ctx, req.UserID)
-	if err != nil {
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil || user == nil {
 		return nil, ErrUserNotFound
 	}
 
-	// Get user's groups where they are the owner
-	ownedGroups, err := uc.groupRepo.GetGroupsByOwner(ctx, req.UserID)
+	now := time.Now().UTC()
+	report := &domain.AccountDeletionReport{}
+
+	// Owned groups pass to another member; only groups nobody else belongs to are deleted
+	ownedGroups, err := uc.groupRepo.GetGroupsByOwner(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Handle group ownership transfer or deletion
+	newOwners := make(map[uuid.UUID]*uuid.UUID, len(ownedGroups))
 	for _, group := range ownedGroups {
-		// Get group members to potentially transfer ownership
 		members, err := uc.groupRepo.GetGroupMembers(ctx, group.ID)
 		if err != nil {
+			return nil, err
+		}
+
+		successor := pickGroupSuccessor(members, userID)
+		if successor == nil {
+			report.DeletedGroupIDs = append(report.DeletedGroupIDs, group.ID)
+			newOwners[group.ID] = nil
 			continue
 		}
 
-		// Find an admin to transfer ownership to
-		var newOwner *domain.GroupMember
-		for _, member := range members {
-			if member.UserID != req.UserID && member.Role == domain.GroupRoleAdmin {
-				newOwner = member
-				break
+		report.GroupTransfers = append(report.GroupTransfers, domain.GroupTransfer{GroupID: group.ID, NewOwnerUserID: successor.UserID})
+		newOwners[group.ID] = &successor.UserID
+	}
+
+	// Future events get a new host or are cancelled, past events are kept as they are
+	for offset := 0; ; offset += accountDeletionPageSize {
+		events, err := uc.eventRepo.GetUserEvents(ctx, userID, accountDeletionPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range events {
+			if !event.StartAt.After(now) {
+				report.AnonymizedEventIDs = append(report.AnonymizedEventIDs, event.ID)
+				continue
+			}
+			if event.IsCancelled() {
+				continue
+			}
+
+			newHostID, err := uc.findEventSuccessor(ctx, event, userID, newOwners)
+			if err != nil {
+				return nil, err
+			}
+
+			if newHostID == nil {
+				report.CancelledEventIDs = append(report.CancelledEventIDs, event.ID)
+				continue
 			}
+			report.EventHandovers = append(report.EventHandovers, domain.EventHandover{EventID: event.ID, NewHostUserID: *newHostID})
 		}
 
-		if newOwner != nil {
-			// Transfer ownership to an admin
-			group.OwnerUserID = newOwner.UserID
-			group.UpdatedAt = time.Now().UTC()
-			uc.groupRepo.Update(ctx, group)
-			uc.groupRepo.UpdateMemberRole(ctx, group.ID, newOwner.UserID, domain.GroupRoleOwner)
-		} else {
-			// No suitable admin found, delete the group
-			uc.groupRepo.Delete(ctx, group.ID)
+		if len(events) < accountDeletionPageSize {
+			break
 		}
 	}
 
-	// Get user's hosted events
-	userEvents, err := uc.eventRepo.GetUserEvents(ctx, req.UserID, 1000, 0)
-	if err == nil {
-		// Delete or transfer hosted events
-		for _, event := range userEvents {
-			// For MVP, we'll delete the events
-			// In production, you might want to transfer to group admins or mark as cancelled
-			uc.eventRepo.Delete(ctx, event.ID)
-		}
+	deletion.MarkCompleted(report, now)
+	exportKeys, err := uc.deletionRepo.Complete(ctx, deletion)
+	if err != nil {
+		return nil, err
 	}
 
-	// Remove user from all groups
-	userGroups, err := uc.groupRepo.GetUserGroups(ctx, req.UserID)
-	if err == nil {
-		for _, group := range userGroups {
-			uc.groupRepo.RemoveMember(ctx, group.ID, req.UserID)
+	// Export archives hold the user's personal data; once their rows are gone nothing else would remove them
+	if uc.exportStorage != nil {
+		for _, key := range exportKeys {
+			if err := uc.exportStorage.Delete(ctx, key); err != nil {
+				log.Printf("Failed to delete data export archive %s of deleted account %s: %v", key, userID, err)
+			}
 		}
 	}
 
-	// Delete user's RSVPs
-	userRSVPs, err := uc.eventRepo.GetUserRSVPs(ctx, req.UserID)
-	if err == nil {
-		for _, rsvp := range userRSVPs {
-			uc.eventRepo.DeleteRSVP(ctx, rsvp.EventID, req.UserID)
+	// Attendees hear about cancellations only once the deletion has been committed
+	if uc.notifier != nil {
+		for _, eventID := range report.CancelledEventIDs {
+			if err := uc.notifier.OnEventCancelled(ctx, eventID, accountDeletionCancellationReason); err != nil {
+				log.Printf("Failed to notify attendees of cancelled event %s: %v", eventID, err)
+			}
 		}
 	}
 
-	// Delete user's notifications
-	// This would typically be handled by the repository's DeleteUserData method
-
-	// Finally, delete all user data (this should cascade to profile and other related data)
-	if err := uc.userRepo.DeleteUserData(ctx, req.UserID); err != nil {
-		return nil, err
-	}
+	// The audit entry records what was removed without keeping the deleted account's personal data
+	recordAudit(ctx, uc.auditRecorder, auditChange(userID, domain.AuditActionAccountDelete, domain.AuditTargetUser, userID, &userID,
+		map[string]interface{}{
+			"handed_over_events": len(report.EventHandovers),
+			"cancelled_events":   len(report.CancelledEventIDs),
+			"anonymized_events":  len(report.AnonymizedEventIDs),
+			"transferred_groups": len(report.GroupTransfers),
+			"deleted_groups":     len(report.DeletedGroupIDs),
+		}, nil))
 
 	return &DeleteUserAccountResponse{
-		DeletedAt: time.Now().UTC(),
-		UserID:    req.UserID,
-		Message:   "User account and all associated data have been permanently deleted",
+		DeletedAt: now,
+		UserID:    userID,
+		Message:   "User account has been deleted and its remaining records anonymized",
+		Report:    report,
 	}, nil
 }
 
-// ConsentManagementService handles user consent tracking and management
-type ConsentManagementService struct {
-	userRepo repository.UserRepository
-	// In a real implementation, you might have a dedicated ConsentRepository
-	// For now, we'll store consent data in the user's profile or a separate table
-}
-
-// NewConsentManagementService creates a new ConsentManagementService
-func NewConsentManagementService(userRepo repository.UserRepository) *ConsentManagementService {
-	return &ConsentManagementService{
-		userRepo: userRepo,
+// findEventSuccessor picks who takes over a future event: an accepted co-host first, then the owner
+// or an admin of the event's group. It returns nil when nobody can.
+func (uc *DeleteUserAccountUseCase) findEventSuccessor(ctx context.Context, event *domain.Event, userID uuid.UUID, newOwners map[uuid.UUID]*uuid.UUID) (*uuid.UUID, error) {
+	coHosts, err := uc.coHostRepo.GetByEvent(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, coHost := range coHosts {
+		if coHost.UserID != userID && coHost.IsAccepted() {
+			return &coHost.UserID, nil
+		}
 	}
-}
 
-// UpdateConsent updates or creates a consent record for a user
-func (s *ConsentManagementService) UpdateConsent(ctx context.Context, req *ConsentUpdateRequest) (*ConsentRecord, error) {
-	// Get user profile to store consent information
-	profile, err := s.userRepo.GetProfile(ctx, req.UserID)
-	if err != nil {
-		return nil, ErrProfileNotFound
+	if event.GroupID == nil {
+		return nil, nil
 	}
 
-	// Initialize consent data if not exists
-	if profile.CommunicationPreferences == nil {
-		profile.CommunicationPreferences = make(map[string]interface{})
+	// Groups the user owned are already being handed over or deleted
+	if newOwner, ok := newOwners[*event.GroupID]; ok {
+		return newOwner, nil
 	}
 
-	// Create consent record
-	consentRecord := &ConsentRecord{
-		ID:          uuid.New(),
-		UserID:      req.UserID,
-		ConsentType: req.ConsentType,
-		Granted:     req.Granted,
-		GrantedAt:   time.Now().UTC(),
-		Metadata:    req.Metadata,
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
+	members, err := uc.groupRepo.GetGroupMembers(ctx, *event.GroupID)
+	if err != nil {
+		return nil, err
 	}
 
-	if !req.Granted {
-		now := time.Now().UTC()
-		consentRecord.RevokedAt = &now
+	var admin *uuid.UUID
+	for _, member := range members {
+		if member.UserID == userID {
+			continue
+		}
+		if member.Role == domain.GroupRoleOwner {
+			return &member.UserID, nil
+		}
+		if member.Role == domain.GroupRoleAdmin && admin == nil {
+			admin = &member.UserID
+		}
 	}
 
-	// Store consent in profile (in a real implementation, this would be in a dedicated consent table)
-	consentKey := "consent_" + req.ConsentType
-	consentData, _ := json.Marshal(consentRecord)
-	var consentMap map[string]interface{}
-	json.Unmarshal(consentData, &consentMap)
+	return admin, nil
+}
 
-	profile.CommunicationPreferences[consentKey] = consentMap
-	profile.UpdatedAt = time.Now().UTC()
+// pickGroupSuccessor chooses the next owner of a group: its longest-standing admin, otherwise its
+// longest-standing member. It returns nil when the leaving owner is the only member.
+func pickGroupSuccessor(members []*domain.GroupMember, leavingUserID uuid.UUID) *domain.GroupMember {
+	var admin, member *domain.GroupMember
+	for _, candidate := range members {
+		if candidate.UserID == leavingUserID {
+			continue
+		}
+		if candidate.Role == domain.GroupRoleAdmin && (admin == nil || candidate.JoinedAt.Before(admin.JoinedAt)) {
+			admin = candidate
+		}
+		if member == nil || candidate.JoinedAt.Before(member.JoinedAt) {
+			member = candidate
+		}
+	}
 
-	// Update profile
-	if err := s.userRepo.UpdateProfile(ctx, profile); err != nil {
-		return nil, err
+	if admin != nil {
+		return admin
 	}
+	return member
+}
 
-	return consentRecord, nil
+// ConsentManagementService handles user consent tracking and management
+type ConsentManagementService struct {
+	consentRepo repository.ConsentRepository
 }
 
-// GetUserConsents retrieves all consent records for a user
-func (s *ConsentManagementService) GetUserConsents(ctx context.Context, userID uuid.UUID) ([]*ConsentRecord, error) {
-	profile, err := s.userRepo.GetProfile(ctx, userID)
-	if err != nil {
-		return nil, ErrProfileNotFound
+// NewConsentManagementService creates a new ConsentManagementService
+func NewConsentManagementService(consentRepo repository.ConsentRepository) *ConsentManagementService {
+	return &ConsentManagementService{
+		consentRepo: consentRepo,
 	}
+}
 
-	var consents []*ConsentRecord
+// UpdateConsent records a grant or revocation, keeping every earlier record as history
+func (s *ConsentManagementService) UpdateConsent(ctx context.Context, req *ConsentUpdateRequest) (*domain.ConsentRecord, error) {
+	record := domain.NewConsentRecord(req.UserID, req.ConsentType, req.Granted, req.PolicyVersion, req.IPAddress, req.UserAgent, time.Now().UTC())
+	record.Metadata = req.Metadata
 
-	if profile.CommunicationPreferences != nil {
-		for key, value := range profile.CommunicationPreferences {
-			if len(key) > 8 && key[:8] == "consent_" {
-				consentData, _ := json.Marshal(value)
-				var consent ConsentRecord
-				if json.Unmarshal(consentData, &consent) == nil {
-					consents = append(consents, &consent)
-				}
-			}
-		}
+	if err := record.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.consentRepo.Create(ctx, record); err != nil {
+		return nil, err
 	}
 
-	return consents, nil
+	return record, nil
 }
 
-// GetConsent retrieves a specific consent record for a user
-func (s *ConsentManagementService) GetConsent(ctx context.Context, userID uuid.UUID, consentType string) (*ConsentRecord, error) {
-	profile, err := s.userRepo.GetProfile(ctx, userID)
-	if err != nil {
-		return nil, ErrProfileNotFound
-	}
+// GetUserConsents retrieves the current consent record for each type the user has answered
+func (s *ConsentManagementService) GetUserConsents(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error) {
+	return s.consentRepo.GetCurrent(ctx, userID)
+}
 
-	if profile.CommunicationPreferences == nil {
-		return nil, errors.New("consent not found")
-	}
+// GetConsentHistory retrieves every consent record for a user, newest first
+func (s *ConsentManagementService) GetConsentHistory(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error) {
+	return s.consentRepo.GetHistory(ctx, userID)
+}
 
-	consentKey := "consent_" + consentType
-	consentValue, exists := profile.CommunicationPreferences[consentKey]
-	if !exists {
-		return nil, errors.New("consent not found")
+// GetConsent retrieves the current consent record of a specific type for a user
+func (s *ConsentManagementService) GetConsent(ctx context.Context, userID uuid.UUID, consentType domain.ConsentType) (*domain.ConsentRecord, error) {
+	record, err := s.consentRepo.GetLatest(ctx, userID, consentType)
+	if err != nil {
+		return nil, err
 	}
 
-	consentData, _ := json.Marshal(consentValue)
-	var consent ConsentRecord
-	if err := json.Unmarshal(consentData, &consent); err != nil {
-		return nil, err
+	if record == nil {
+		return nil, ErrConsentNotFound
 	}
 
-	return &consent, nil
+	return record, nil
 }
 
 // HasValidConsent checks if user has granted consent for a specific type
-func (s *ConsentManagementService) HasValidConsent(ctx context.Context, userID uuid.UUID, consentType string) (bool, error) {
+func (s *ConsentManagementService) HasValidConsent(ctx context.Context, userID uuid.UUID, consentType domain.ConsentType) (bool, error) {
 	consent, err := s.GetConsent(ctx, userID, consentType)
-	if err != nil {
+	if err == ErrConsentNotFound {
 		return false, nil // No consent record means no consent
 	}
+	if err != nil {
+		return false, err
+	}
 
-	return consent.Granted && consent.RevokedAt == nil, nil
+	return consent.Granted, nil
 }
 
 // GDPRComplianceUseCase provides a unified interface for GDPR compliance operations
 type GDPRComplianceUseCase struct {
-	exportUseCase  *ExportUserDataUseCase
-	deleteUseCase  *DeleteUserAccountUseCase
-	consentService *ConsentManagementService
+	exportUseCase           *ExportUserDataUseCase
+	deleteUseCase           *DeleteUserAccountUseCase
+	scheduleDeletionUseCase *ScheduleAccountDeletionUseCase
+	cancelDeletionUseCase   *CancelAccountDeletionUseCase
+	getDeletionUseCase      *GetAccountDeletionUseCase
+	consentService          *ConsentManagementService
+	deletionRepo            repository.AccountDeletionRepository
 }
 
 // NewGDPRComplianceUseCase creates a new unified GDPR compliance use case
@@ -400,14 +464,37 @@ func NewGDPRComplianceUseCase(
 	eventRepo repository.EventRepository,
 	groupRepo repository.GroupRepository,
 	notificationRepo repository.NotificationRepository,
+	consentRepo repository.ConsentRepository,
+	auditRepo repository.AuditLogRepository,
+	coHostRepo repository.EventCoHostRepository,
+	deletionRepo repository.AccountDeletionRepository,
 ) *GDPRComplianceUseCase {
 	return &GDPRComplianceUseCase{
-		exportUseCase:  NewExportUserDataUseCase(userRepo, eventRepo, groupRepo, notificationRepo),
-		deleteUseCase:  NewDeleteUserAccountUseCase(userRepo, eventRepo, groupRepo, notificationRepo),
-		consentService: NewConsentManagementService(userRepo),
+		exportUseCase:           NewExportUserDataUseCase(userRepo, eventRepo, groupRepo, notificationRepo, consentRepo, auditRepo),
+		deleteUseCase:           NewDeleteUserAccountUseCase(userRepo, eventRepo, groupRepo, coHostRepo, deletionRepo),
+		scheduleDeletionUseCase: NewScheduleAccountDeletionUseCase(userRepo, deletionRepo),
+		cancelDeletionUseCase:   NewCancelAccountDeletionUseCase(deletionRepo),
+		getDeletionUseCase:      NewGetAccountDeletionUseCase(deletionRepo),
+		consentService:          NewConsentManagementService(consentRepo),
+		deletionRepo:            deletionRepo,
 	}
 }
 
+// SetAuditRecorder enables audit logging of account deletions
+func (uc *GDPRComplianceUseCase) SetAuditRecorder(recorder AuditRecorder) {
+	uc.deleteUseCase.auditRecorder = recorder
+}
+
+// SetExportStorage enables deleting the export archives of deleted accounts
+func (uc *GDPRComplianceUseCase) SetExportStorage(storage DataExportStorage) {
+	uc.deleteUseCase.exportStorage = storage
+}
+
+// SetNotifier enables notifying attendees of events cancelled by an account deletion
+func (uc *GDPRComplianceUseCase) SetNotifier(notifier AccountDeletionNotifier) {
+	uc.deleteUseCase.notifier = notifier
+}
+
 // ExportUserData exports all user data for GDPR compliance
 func (uc *GDPRComplianceUseCase) ExportUserData(ctx context.Context, userID uuid.UUID) (map[string]interface{}, error) {
 	req := &ExportUserDataRequest{
@@ -422,34 +509,42 @@ func (uc *GDPRComplianceUseCase) ExportUserData(ctx context.Context, userID uuid
 	return result.Data, nil
 }
 
-// DeleteUserAccount permanently deletes user account and all associated data
-func (uc *GDPRComplianceUseCase) DeleteUserAccount(ctx context.Context, userID uuid.UUID) error {
-	req := &DeleteUserAccountRequest{
-		UserID: userID,
-	}
+// ScheduleAccountDeletion schedules the user's account for deletion after the grace period
+func (uc *GDPRComplianceUseCase) ScheduleAccountDeletion(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error) {
+	return uc.scheduleDeletionUseCase.Execute(ctx, &AccountDeletionRequest{UserID: userID})
+}
 
-	_, err := uc.deleteUseCase.Execute(ctx, req)
-	return err
+// CancelAccountDeletion cancels the user's scheduled account deletion
+func (uc *GDPRComplianceUseCase) CancelAccountDeletion(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error) {
+	return uc.cancelDeletionUseCase.Execute(ctx, &AccountDeletionRequest{UserID: userID})
 }
 
-// UpdateConsent updates user consent for a specific type
-func (uc *GDPRComplianceUseCase) UpdateConsent(ctx context.Context, userID uuid.UUID, consentType string, granted bool, metadata map[string]interface{}) (*ConsentRecord, error) {
-	req := &ConsentUpdateRequest{
-		UserID:      userID,
-		ConsentType: consentType,
-		Granted:     granted,
-		Metadata:    metadata,
-	}
+// GetAccountDeletion retrieves the user's scheduled account deletion
+func (uc *GDPRComplianceUseCase) GetAccountDeletion(ctx context.Context, userID uuid.UUID) (*domain.AccountDeletion, error) {
+	return uc.getDeletionUseCase.Execute(ctx, &AccountDeletionRequest{UserID: userID})
+}
 
+// DeletionScheduler returns a scheduler that carries out account deletions once their grace period ends
+func (uc *GDPRComplianceUseCase) DeletionScheduler(batchSize int, interval time.Duration) *AccountDeletionScheduler {
+	return NewAccountDeletionScheduler(NewProcessAccountDeletionsUseCase(uc.deletionRepo, uc.deleteUseCase), batchSize, interval)
+}
+
+// UpdateConsent records a user's consent decision for a specific type
+func (uc *GDPRComplianceUseCase) UpdateConsent(ctx context.Context, req *ConsentUpdateRequest) (*domain.ConsentRecord, error) {
 	return uc.consentService.UpdateConsent(ctx, req)
 }
 
-// GetUserConsents retrieves all consent records for a user
-func (uc *GDPRComplianceUseCase) GetUserConsents(ctx context.Context, userID uuid.UUID) ([]*ConsentRecord, error) {
+// GetUserConsents retrieves the current consent records for a user
+func (uc *GDPRComplianceUseCase) GetUserConsents(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error) {
 	return uc.consentService.GetUserConsents(ctx, userID)
 }
 
+// GetConsentHistory retrieves the full consent history for a user
+func (uc *GDPRComplianceUseCase) GetConsentHistory(ctx context.Context, userID uuid.UUID) ([]*domain.ConsentRecord, error) {
+	return uc.consentService.GetConsentHistory(ctx, userID)
+}
+
 // HasValidConsent checks if user has granted consent for a specific type
-func (uc *GDPRComplianceUseCase) HasValidConsent(ctx context.Context, userID uuid.UUID, consentType string) (bool, error) {
+func (uc *GDPRComplianceUseCase) HasValidConsent(ctx context.Context, userID uuid.UUID, consentType domain.ConsentType) (bool, error) {
 	return uc.consentService.HasValidConsent(ctx, userID, consentType)
 }
@@ -0,0 +1,369 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+const (
+	// DefaultAnnouncementLimit is the page size used when none is requested
+	DefaultAnnouncementLimit = 20
+	// MaxAnnouncementLimit is the largest page size a listing may request
+	MaxAnnouncementLimit = 100
+)
+
+var (
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+)
+
+// CreateAnnouncementRequest represents the request to post a group announcement
+type CreateAnnouncementRequest struct {
+	GroupID       uuid.UUID  `json:"group_id" validate:"required"`
+	UserID        uuid.UUID  `json:"user_id" validate:"required"` // User posting the announcement
+	Title         string     `json:"title" validate:"required,max=200"`
+	Body          string     `json:"body" validate:"required,max=10000"`
+	Pinned        bool       `json:"pinned"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	NotifyMembers bool       `json:"notify_members"`
+}
+
+// UpdateAnnouncementRequest represents the request to edit a group announcement
+type UpdateAnnouncementRequest struct {
+	GroupID        uuid.UUID  `json:"group_id" validate:"required"`
+	AnnouncementID uuid.UUID  `json:"announcement_id" validate:"required"`
+	UserID         uuid.UUID  `json:"user_id" validate:"required"` // User making the request
+	Title          *string    `json:"title,omitempty" validate:"omitempty,max=200"`
+	Body           *string    `json:"body,omitempty" validate:"omitempty,max=10000"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	ClearExpiry    bool       `json:"clear_expiry"`
+}
+
+// SetAnnouncementPinnedRequest represents the request to pin or unpin a group announcement
+type SetAnnouncementPinnedRequest struct {
+	GroupID        uuid.UUID `json:"group_id" validate:"required"`
+	AnnouncementID uuid.UUID `json:"announcement_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"` // User making the request
+	Pinned         bool      `json:"pinned"`
+}
+
+// DeleteAnnouncementRequest represents the request to delete a group announcement
+type DeleteAnnouncementRequest struct {
+	GroupID        uuid.UUID `json:"group_id" validate:"required"`
+	AnnouncementID uuid.UUID `json:"announcement_id" validate:"required"`
+	UserID         uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListAnnouncementsRequest represents the request to read a group's announcement board
+type ListAnnouncementsRequest struct {
+	GroupID        uuid.UUID `json:"group_id" validate:"required"`
+	RequesterID    uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+	IncludeExpired bool      `json:"include_expired"`
+	Limit          int       `json:"limit"`
+	Offset         int       `json:"offset"`
+}
+
+// ListAnnouncementsResponse represents a page of a group's announcement board
+type ListAnnouncementsResponse struct {
+	Announcements []*domain.GroupAnnouncement `json:"announcements"`
+	Limit         int                         `json:"limit"`
+	Offset        int                         `json:"offset"`
+}
+
+// CreateAnnouncementUseCase handles posting group announcements
+type CreateAnnouncementUseCase struct {
+	groupRepo         repository.GroupRepository
+	announcementRepo  repository.GroupAnnouncementRepository
+	permissionService *domain.PermissionService
+	notifier          GroupNotifier
+}
+
+// NewCreateAnnouncementUseCase creates a new CreateAnnouncementUseCase
+func NewCreateAnnouncementUseCase(groupRepo repository.GroupRepository, announcementRepo repository.GroupAnnouncementRepository) *CreateAnnouncementUseCase {
+	return &CreateAnnouncementUseCase{
+		groupRepo:         groupRepo,
+		announcementRepo:  announcementRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute posts an announcement and optionally notifies the group's members
+func (uc *CreateAnnouncementUseCase) Execute(ctx context.Context, req *CreateAnnouncementRequest) (*domain.GroupAnnouncement, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanManageAnnouncements(req.GroupID, req.UserID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	now := time.Now().UTC()
+	announcement := &domain.GroupAnnouncement{
+		ID:           uuid.New(),
+		GroupID:      req.GroupID,
+		AuthorUserID: req.UserID,
+		Title:        req.Title,
+		Body:         req.Body,
+		ExpiresAt:    req.ExpiresAt,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if req.Pinned {
+		announcement.Pin(now)
+	}
+
+	if err := announcement.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.announcementRepo.Create(ctx, announcement); err != nil {
+		return nil, err
+	}
+
+	// Emailing every member can take a while, so it happens after the response is sent
+	if req.NotifyMembers && uc.notifier != nil {
+		groupID := req.GroupID
+		go func() {
+			if err := uc.notifier.OnGroupAnnouncement(context.Background(), announcement); err != nil {
+				log.Printf("Failed to send announcement notifications for group %s: %v", groupID, err)
+			}
+		}()
+	}
+
+	return announcement, nil
+}
+
+// UpdateAnnouncementUseCase handles editing group announcements
+type UpdateAnnouncementUseCase struct {
+	groupRepo         repository.GroupRepository
+	announcementRepo  repository.GroupAnnouncementRepository
+	permissionService *domain.PermissionService
+}
+
+// NewUpdateAnnouncementUseCase creates a new UpdateAnnouncementUseCase
+func NewUpdateAnnouncementUseCase(groupRepo repository.GroupRepository, announcementRepo repository.GroupAnnouncementRepository) *UpdateAnnouncementUseCase {
+	return &UpdateAnnouncementUseCase{
+		groupRepo:         groupRepo,
+		announcementRepo:  announcementRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute edits the title, body or expiry of an announcement
+func (uc *UpdateAnnouncementUseCase) Execute(ctx context.Context, req *UpdateAnnouncementRequest) (*domain.GroupAnnouncement, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanManageAnnouncements(req.GroupID, req.UserID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	announcement, err := getGroupAnnouncement(ctx, uc.announcementRepo, req.GroupID, req.AnnouncementID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		announcement.Title = *req.Title
+	}
+	if req.Body != nil {
+		announcement.Body = *req.Body
+	}
+	if req.ClearExpiry {
+		announcement.ExpiresAt = nil
+	} else if req.ExpiresAt != nil {
+		announcement.ExpiresAt = req.ExpiresAt
+	}
+	announcement.UpdatedAt = time.Now().UTC()
+
+	if err := announcement.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.announcementRepo.Update(ctx, announcement); err != nil {
+		return nil, err
+	}
+
+	return announcement, nil
+}
+
+// SetAnnouncementPinnedUseCase handles pinning and unpinning group announcements
+type SetAnnouncementPinnedUseCase struct {
+	groupRepo         repository.GroupRepository
+	announcementRepo  repository.GroupAnnouncementRepository
+	permissionService *domain.PermissionService
+}
+
+// NewSetAnnouncementPinnedUseCase creates a new SetAnnouncementPinnedUseCase
+func NewSetAnnouncementPinnedUseCase(groupRepo repository.GroupRepository, announcementRepo repository.GroupAnnouncementRepository) *SetAnnouncementPinnedUseCase {
+	return &SetAnnouncementPinnedUseCase{
+		groupRepo:         groupRepo,
+		announcementRepo:  announcementRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute pins or unpins an announcement
+func (uc *SetAnnouncementPinnedUseCase) Execute(ctx context.Context, req *SetAnnouncementPinnedRequest) (*domain.GroupAnnouncement, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanManageAnnouncements(req.GroupID, req.UserID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	announcement, err := getGroupAnnouncement(ctx, uc.announcementRepo, req.GroupID, req.AnnouncementID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pinning an already pinned announcement is a no-op
+	if announcement.IsPinned == req.Pinned {
+		return announcement, nil
+	}
+
+	now := time.Now().UTC()
+	if req.Pinned {
+		announcement.Pin(now)
+	} else {
+		announcement.Unpin(now)
+	}
+
+	if err := uc.announcementRepo.Update(ctx, announcement); err != nil {
+		return nil, err
+	}
+
+	return announcement, nil
+}
+
+// DeleteAnnouncementUseCase handles deleting group announcements
+type DeleteAnnouncementUseCase struct {
+	groupRepo         repository.GroupRepository
+	announcementRepo  repository.GroupAnnouncementRepository
+	permissionService *domain.PermissionService
+}
+
+// NewDeleteAnnouncementUseCase creates a new DeleteAnnouncementUseCase
+func NewDeleteAnnouncementUseCase(groupRepo repository.GroupRepository, announcementRepo repository.GroupAnnouncementRepository) *DeleteAnnouncementUseCase {
+	return &DeleteAnnouncementUseCase{
+		groupRepo:         groupRepo,
+		announcementRepo:  announcementRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute deletes an announcement
+func (uc *DeleteAnnouncementUseCase) Execute(ctx context.Context, req *DeleteAnnouncementRequest) error {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if !uc.permissionService.CanManageAnnouncements(req.GroupID, req.UserID, memberships) {
+		return ErrUnauthorizedGroupAccess
+	}
+
+	if _, err := getGroupAnnouncement(ctx, uc.announcementRepo, req.GroupID, req.AnnouncementID); err != nil {
+		return err
+	}
+
+	return uc.announcementRepo.Delete(ctx, req.AnnouncementID)
+}
+
+// ListAnnouncementsUseCase handles reading a group's announcement board
+type ListAnnouncementsUseCase struct {
+	groupRepo         repository.GroupRepository
+	announcementRepo  repository.GroupAnnouncementRepository
+	permissionService *domain.PermissionService
+}
+
+// NewListAnnouncementsUseCase creates a new ListAnnouncementsUseCase
+func NewListAnnouncementsUseCase(groupRepo repository.GroupRepository, announcementRepo repository.GroupAnnouncementRepository) *ListAnnouncementsUseCase {
+	return &ListAnnouncementsUseCase{
+		groupRepo:         groupRepo,
+		announcementRepo:  announcementRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute returns the board to group members, pinned announcements first.
+// Expired announcements are only listed for members who can manage announcements.
+func (uc *ListAnnouncementsUseCase) Execute(ctx context.Context, req *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanViewAnnouncements(req.GroupID, req.RequesterID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	includeExpired := req.IncludeExpired && uc.permissionService.CanManageAnnouncements(req.GroupID, req.RequesterID, memberships)
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultAnnouncementLimit
+	}
+	if limit > MaxAnnouncementLimit {
+		limit = MaxAnnouncementLimit
+	}
+
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	announcements, err := uc.announcementRepo.GetByGroup(ctx, req.GroupID, time.Now().UTC(), includeExpired, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAnnouncementsResponse{
+		Announcements: announcements,
+		Limit:         limit,
+		Offset:        offset,
+	}, nil
+}
+
+// getGroupMemberships loads the users' memberships of a group in the form PermissionService expects.
+// Users who are not members are left out.
+func getGroupMemberships(ctx context.Context, groupRepo repository.GroupRepository, groupID uuid.UUID, userIDs ...uuid.UUID) ([]domain.GroupMember, error) {
+	group, err := groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, ErrGroupNotFound
+	}
+
+	var memberships []domain.GroupMember
+	for _, userID := range userIDs {
+		member, err := groupRepo.GetMember(ctx, groupID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if member != nil {
+			memberships = append(memberships, *member)
+		}
+	}
+
+	return memberships, nil
+}
+
+// getGroupAnnouncement retrieves an announcement, reporting announcements of other groups as missing
+func getGroupAnnouncement(ctx context.Context, announcementRepo repository.GroupAnnouncementRepository, groupID, announcementID uuid.UUID) (*domain.GroupAnnouncement, error) {
+	announcement, err := announcementRepo.GetByID(ctx, announcementID)
+	if err != nil {
+		return nil, err
+	}
+	if announcement == nil || announcement.GroupID != groupID {
+		return nil, ErrAnnouncementNotFound
+	}
+
+	return announcement, nil
+}
@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGroupAnnouncementRepository is a mock implementation of GroupAnnouncementRepository
+type MockGroupAnnouncementRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupAnnouncementRepository) Create(ctx context.Context, announcement *domain.GroupAnnouncement) error {
+	args := m.Called(ctx, announcement)
+	return args.Error(0)
+}
+
+func (m *MockGroupAnnouncementRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupAnnouncement, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupAnnouncement), args.Error(1)
+}
+
+func (m *MockGroupAnnouncementRepository) Update(ctx context.Context, announcement *domain.GroupAnnouncement) error {
+	args := m.Called(ctx, announcement)
+	return args.Error(0)
+}
+
+func (m *MockGroupAnnouncementRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockGroupAnnouncementRepository) GetByGroup(ctx context.Context, groupID uuid.UUID, activeAt time.Time, includeExpired bool, limit, offset int) ([]*domain.GroupAnnouncement, error) {
+	args := m.Called(ctx, groupID, activeAt, includeExpired, limit, offset)
+	return args.Get(0).([]*domain.GroupAnnouncement), args.Error(1)
+}
+
+func announcementGroupRepo(ctx context.Context, groupID, userID uuid.UUID, role domain.GroupRole) *MockGroupRepository {
+	mockGroupRepo := new(MockGroupRepository)
+	mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, Name: "Porto Pioneers"}, nil)
+	if role == "" {
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(nil, nil)
+	} else {
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(&domain.GroupMember{UserID: userID, GroupID: groupID, Role: role}, nil)
+	}
+	return mockGroupRepo
+}
+
+func TestCreateAnnouncementUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("admin posts pinned announcement and notifies members", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		mockNotifier := new(MockGroupNotifier)
+		useCase := NewCreateAnnouncementUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockAnnouncementRepo)
+		useCase.notifier = mockNotifier
+
+		mockAnnouncementRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupAnnouncement")).Return(nil)
+		notified := make(chan struct{})
+		mockNotifier.On("OnGroupAnnouncement", mock.Anything, mock.AnythingOfType("*domain.GroupAnnouncement")).
+			Run(func(mock.Arguments) { close(notified) }).Return(nil)
+
+		announcement, err := useCase.Execute(ctx, &CreateAnnouncementRequest{
+			GroupID:       groupID,
+			UserID:        adminID,
+			Title:         "Store closed on Saturday",
+			Body:          "We'll play at **the library** instead.",
+			Pinned:        true,
+			NotifyMembers: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, adminID, announcement.AuthorUserID)
+		assert.True(t, announcement.IsPinned)
+		assert.NotNil(t, announcement.PinnedAt)
+		mockAnnouncementRepo.AssertExpectations(t)
+
+		// Members are notified in the background
+		select {
+		case <-notified:
+		case <-time.After(time.Second):
+			t.Fatal("Expected members to be notified")
+		}
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("notification failure does not fail the post", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		mockNotifier := new(MockGroupNotifier)
+		useCase := NewCreateAnnouncementUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleOwner), mockAnnouncementRepo)
+		useCase.notifier = mockNotifier
+
+		mockAnnouncementRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupAnnouncement")).Return(nil)
+		mockNotifier.On("OnGroupAnnouncement", mock.Anything, mock.AnythingOfType("*domain.GroupAnnouncement")).Return(errors.New("smtp down"))
+
+		_, err := useCase.Execute(ctx, &CreateAnnouncementRequest{
+			GroupID:       groupID,
+			UserID:        adminID,
+			Title:         "Title",
+			Body:          "Body",
+			NotifyMembers: true,
+		})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("members are not notified unless requested", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		mockNotifier := new(MockGroupNotifier)
+		useCase := NewCreateAnnouncementUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockAnnouncementRepo)
+		useCase.notifier = mockNotifier
+
+		mockAnnouncementRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupAnnouncement")).Return(nil)
+
+		_, err := useCase.Execute(ctx, &CreateAnnouncementRequest{
+			GroupID: groupID,
+			UserID:  adminID,
+			Title:   "Title",
+			Body:    "Body",
+		})
+
+		assert.NoError(t, err)
+		mockNotifier.AssertNotCalled(t, "OnGroupAnnouncement", mock.Anything, mock.Anything)
+	})
+
+	t.Run("member cannot post", func(t *testing.T) {
+		groupID := uuid.New()
+		memberID := uuid.New()
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		useCase := NewCreateAnnouncementUseCase(announcementGroupRepo(ctx, groupID, memberID, domain.GroupRoleMember), mockAnnouncementRepo)
+
+		_, err := useCase.Execute(ctx, &CreateAnnouncementRequest{
+			GroupID: groupID,
+			UserID:  memberID,
+			Title:   "Title",
+			Body:    "Body",
+		})
+
+		assert.Equal(t, ErrUnauthorizedGroupAccess, err)
+		mockAnnouncementRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSetAnnouncementPinnedUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unpins announcement", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		pinnedAt := time.Now().UTC().Add(-time.Hour)
+		announcement := &domain.GroupAnnouncement{ID: uuid.New(), GroupID: groupID, IsPinned: true, PinnedAt: &pinnedAt}
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		useCase := NewSetAnnouncementPinnedUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockAnnouncementRepo)
+
+		mockAnnouncementRepo.On("GetByID", ctx, announcement.ID).Return(announcement, nil)
+		mockAnnouncementRepo.On("Update", ctx, announcement).Return(nil)
+
+		result, err := useCase.Execute(ctx, &SetAnnouncementPinnedRequest{
+			GroupID:        groupID,
+			AnnouncementID: announcement.ID,
+			UserID:         adminID,
+			Pinned:         false,
+		})
+
+		assert.NoError(t, err)
+		assert.False(t, result.IsPinned)
+		assert.Nil(t, result.PinnedAt)
+		mockAnnouncementRepo.AssertExpectations(t)
+	})
+
+	t.Run("announcement from another group", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		announcement := &domain.GroupAnnouncement{ID: uuid.New(), GroupID: uuid.New()}
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		useCase := NewSetAnnouncementPinnedUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockAnnouncementRepo)
+
+		mockAnnouncementRepo.On("GetByID", ctx, announcement.ID).Return(announcement, nil)
+
+		_, err := useCase.Execute(ctx, &SetAnnouncementPinnedRequest{
+			GroupID:        groupID,
+			AnnouncementID: announcement.ID,
+			UserID:         adminID,
+			Pinned:         true,
+		})
+
+		assert.Equal(t, ErrAnnouncementNotFound, err)
+		mockAnnouncementRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListAnnouncementsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("member never sees expired announcements", func(t *testing.T) {
+		groupID := uuid.New()
+		memberID := uuid.New()
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		useCase := NewListAnnouncementsUseCase(announcementGroupRepo(ctx, groupID, memberID, domain.GroupRoleMember), mockAnnouncementRepo)
+
+		announcements := []*domain.GroupAnnouncement{{ID: uuid.New(), GroupID: groupID}}
+		mockAnnouncementRepo.On("GetByGroup", ctx, groupID, mock.AnythingOfType("time.Time"), false, DefaultAnnouncementLimit, 0).Return(announcements, nil)
+
+		result, err := useCase.Execute(ctx, &ListAnnouncementsRequest{
+			GroupID:        groupID,
+			RequesterID:    memberID,
+			IncludeExpired: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Announcements, 1)
+		mockAnnouncementRepo.AssertExpectations(t)
+	})
+
+	t.Run("admin can include expired announcements", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		useCase := NewListAnnouncementsUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockAnnouncementRepo)
+
+		mockAnnouncementRepo.On("GetByGroup", ctx, groupID, mock.AnythingOfType("time.Time"), true, MaxAnnouncementLimit, 0).Return([]*domain.GroupAnnouncement{}, nil)
+
+		_, err := useCase.Execute(ctx, &ListAnnouncementsRequest{
+			GroupID:        groupID,
+			RequesterID:    adminID,
+			IncludeExpired: true,
+			Limit:          500,
+		})
+
+		assert.NoError(t, err)
+		mockAnnouncementRepo.AssertExpectations(t)
+	})
+
+	t.Run("non-member is denied", func(t *testing.T) {
+		groupID := uuid.New()
+		outsiderID := uuid.New()
+		mockAnnouncementRepo := new(MockGroupAnnouncementRepository)
+		useCase := NewListAnnouncementsUseCase(announcementGroupRepo(ctx, groupID, outsiderID, ""), mockAnnouncementRepo)
+
+		_, err := useCase.Execute(ctx, &ListAnnouncementsRequest{
+			GroupID:     groupID,
+			RequesterID: outsiderID,
+		})
+
+		assert.Equal(t, ErrUnauthorizedGroupAccess, err)
+		mockAnnouncementRepo.AssertNotCalled(t, "GetByGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,392 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+const (
+	// DefaultGroupSearchLimit is the page size used when none is requested
+	DefaultGroupSearchLimit = 20
+	// MaxGroupSearchLimit is the largest page size a search may request
+	MaxGroupSearchLimit = 100
+	// MaxGroupSearchRadiusKm is the widest radius a location search may use
+	MaxGroupSearchRadiusKm = 500
+)
+
+var (
+	ErrGroupNotPublic             = errors.New("group is not open to join requests")
+	ErrJoinRequestNotFound        = errors.New("join request not found")
+	ErrJoinRequestNotPending      = errors.New("join request has already been reviewed")
+	ErrJoinRequestAlreadyPending  = errors.New("a join request is already pending for this group")
+	ErrInvalidGroupSearchRadius   = errors.New("invalid search radius")
+	ErrGroupSearchRadiusNeedsNear = errors.New("search radius requires a location")
+)
+
+// SearchGroupsRequest represents the request to search public groups
+type SearchGroupsRequest struct {
+	Query    *string             `json:"query,omitempty"`
+	Near     *domain.Coordinates `json:"near,omitempty"`
+	RadiusKm *int                `json:"radius_km,omitempty"`
+	Game     *domain.GameType    `json:"game,omitempty"`
+	Limit    int                 `json:"limit"`
+	Offset   int                 `json:"offset"`
+}
+
+// SearchGroupsResponse represents the public groups matching a search
+type SearchGroupsResponse struct {
+	Groups []*domain.GroupSearchResult `json:"groups"`
+	Limit  int                         `json:"limit"`
+	Offset int                         `json:"offset"`
+}
+
+// RequestToJoinGroupRequest represents a user's request to join a public group
+type RequestToJoinGroupRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User asking to join
+	Message *string   `json:"message,omitempty" validate:"omitempty,max=500"`
+}
+
+// CancelJoinRequestRequest represents the request to withdraw a pending join request
+type CancelJoinRequestRequest struct {
+	RequestID uuid.UUID `json:"request_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"` // User who made the request
+}
+
+// ListJoinRequestsRequest represents the request to list a group's pending join requests
+type ListJoinRequestsRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListJoinRequestsResponse represents a list of join requests
+type ListJoinRequestsResponse struct {
+	Requests []*domain.GroupJoinRequest `json:"requests"`
+}
+
+// ListUserJoinRequestsRequest represents the request to list a user's own join requests
+type ListUserJoinRequestsRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// ReviewJoinRequestRequest represents an admin's decision on a join request
+type ReviewJoinRequestRequest struct {
+	GroupID   uuid.UUID `json:"group_id" validate:"required"`
+	RequestID uuid.UUID `json:"request_id" validate:"required"`
+	UserID    uuid.UUID `json:"user_id" validate:"required"` // Admin reviewing the request
+	Approve   bool      `json:"approve"`
+}
+
+// SearchGroupsUseCase handles searching public groups
+type SearchGroupsUseCase struct {
+	groupRepo repository.GroupRepository
+}
+
+// NewSearchGroupsUseCase creates a new SearchGroupsUseCase
+func NewSearchGroupsUseCase(groupRepo repository.GroupRepository) *SearchGroupsUseCase {
+	return &SearchGroupsUseCase{
+		groupRepo: groupRepo,
+	}
+}
+
+// Execute searches public groups by name, distance and game
+func (uc *SearchGroupsUseCase) Execute(ctx context.Context, req *SearchGroupsRequest) (*SearchGroupsResponse, error) {
+	if req.RadiusKm != nil {
+		if req.Near == nil {
+			return nil, ErrGroupSearchRadiusNeedsNear
+		}
+		if *req.RadiusKm <= 0 || *req.RadiusKm > MaxGroupSearchRadiusKm {
+			return nil, ErrInvalidGroupSearchRadius
+		}
+	}
+
+	if req.Near != nil {
+		if err := req.Near.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Game != nil && !domain.IsValidGame(*req.Game) {
+		return nil, domain.ErrInvalidGameType
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultGroupSearchLimit
+	}
+	if limit > MaxGroupSearchLimit {
+		limit = MaxGroupSearchLimit
+	}
+
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	groups, err := uc.groupRepo.Search(ctx, domain.GroupSearchParams{
+		Query:    req.Query,
+		Near:     req.Near,
+		RadiusKm: req.RadiusKm,
+		Game:     req.Game,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchGroupsResponse{
+		Groups: groups,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// RequestToJoinGroupUseCase handles join requests for public groups
+type RequestToJoinGroupUseCase struct {
+	groupRepo       repository.GroupRepository
+	joinRequestRepo repository.GroupJoinRequestRepository
+	moderationRepo  repository.GroupModerationRepository
+	notifier        GroupNotifier
+}
+
+// NewRequestToJoinGroupUseCase creates a new RequestToJoinGroupUseCase
+func NewRequestToJoinGroupUseCase(groupRepo repository.GroupRepository, joinRequestRepo repository.GroupJoinRequestRepository, moderationRepo repository.GroupModerationRepository) *RequestToJoinGroupUseCase {
+	return &RequestToJoinGroupUseCase{
+		groupRepo:       groupRepo,
+		joinRequestRepo: joinRequestRepo,
+		moderationRepo:  moderationRepo,
+	}
+}
+
+// Execute records a pending join request and notifies the group's admins
+func (uc *RequestToJoinGroupUseCase) Execute(ctx context.Context, req *RequestToJoinGroupRequest) (*domain.GroupJoinRequest, error) {
+	group, err := uc.groupRepo.GetByID(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || !group.IsActive {
+		return nil, ErrGroupNotFound
+	}
+
+	// Private groups only grow through invites
+	if !group.IsPublic() {
+		return nil, ErrGroupNotPublic
+	}
+
+	isMember, err := uc.groupRepo.IsMember(ctx, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, ErrAlreadyGroupMember
+	}
+
+	if err := checkNotBanned(ctx, uc.moderationRepo, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.joinRequestRepo.GetPendingForUserInGroup(ctx, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrJoinRequestAlreadyPending
+	}
+
+	now := time.Now().UTC()
+	joinRequest := &domain.GroupJoinRequest{
+		ID:        uuid.New(),
+		GroupID:   req.GroupID,
+		UserID:    req.UserID,
+		Message:   req.Message,
+		Status:    domain.GroupJoinRequestStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := joinRequest.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.joinRequestRepo.Create(ctx, joinRequest); err != nil {
+		return nil, err
+	}
+
+	if uc.notifier != nil {
+		if err := uc.notifier.OnGroupJoinRequest(ctx, req.GroupID, req.UserID, req.Message); err != nil {
+			log.Printf("Failed to send join request notification for group %s: %v", req.GroupID, err)
+		}
+	}
+
+	return joinRequest, nil
+}
+
+// CancelJoinRequestUseCase handles withdrawing a pending join request
+type CancelJoinRequestUseCase struct {
+	joinRequestRepo repository.GroupJoinRequestRepository
+}
+
+// NewCancelJoinRequestUseCase creates a new CancelJoinRequestUseCase
+func NewCancelJoinRequestUseCase(joinRequestRepo repository.GroupJoinRequestRepository) *CancelJoinRequestUseCase {
+	return &CancelJoinRequestUseCase{
+		joinRequestRepo: joinRequestRepo,
+	}
+}
+
+// Execute cancels the requester's own pending join request
+func (uc *CancelJoinRequestUseCase) Execute(ctx context.Context, req *CancelJoinRequestRequest) error {
+	joinRequest, err := uc.joinRequestRepo.GetByID(ctx, req.RequestID)
+	if err != nil {
+		return err
+	}
+
+	// Other users' requests are reported as missing rather than forbidden
+	if joinRequest == nil || joinRequest.UserID != req.UserID {
+		return ErrJoinRequestNotFound
+	}
+
+	if !joinRequest.IsPending() {
+		return ErrJoinRequestNotPending
+	}
+
+	joinRequest.Status = domain.GroupJoinRequestStatusCancelled
+	joinRequest.UpdatedAt = time.Now().UTC()
+
+	return uc.joinRequestRepo.Update(ctx, joinRequest)
+}
+
+// ListJoinRequestsUseCase handles listing a group's pending join requests
+type ListJoinRequestsUseCase struct {
+	groupRepo       repository.GroupRepository
+	joinRequestRepo repository.GroupJoinRequestRepository
+}
+
+// NewListJoinRequestsUseCase creates a new ListJoinRequestsUseCase
+func NewListJoinRequestsUseCase(groupRepo repository.GroupRepository, joinRequestRepo repository.GroupJoinRequestRepository) *ListJoinRequestsUseCase {
+	return &ListJoinRequestsUseCase{
+		groupRepo:       groupRepo,
+		joinRequestRepo: joinRequestRepo,
+	}
+}
+
+// Execute returns the pending join requests of a group to its admins
+func (uc *ListJoinRequestsUseCase) Execute(ctx context.Context, req *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	if err := checkGroupPermission(ctx, uc.groupRepo, req.GroupID, req.UserID, domain.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	requests, err := uc.joinRequestRepo.GetPendingByGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListJoinRequestsResponse{
+		Requests: requests,
+	}, nil
+}
+
+// ListUserJoinRequestsUseCase handles listing a user's own join requests
+type ListUserJoinRequestsUseCase struct {
+	joinRequestRepo repository.GroupJoinRequestRepository
+}
+
+// NewListUserJoinRequestsUseCase creates a new ListUserJoinRequestsUseCase
+func NewListUserJoinRequestsUseCase(joinRequestRepo repository.GroupJoinRequestRepository) *ListUserJoinRequestsUseCase {
+	return &ListUserJoinRequestsUseCase{
+		joinRequestRepo: joinRequestRepo,
+	}
+}
+
+// Execute returns all join requests made by the user
+func (uc *ListUserJoinRequestsUseCase) Execute(ctx context.Context, req *ListUserJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	requests, err := uc.joinRequestRepo.GetUserRequests(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListJoinRequestsResponse{
+		Requests: requests,
+	}, nil
+}
+
+// ReviewJoinRequestUseCase handles approving or rejecting join requests
+type ReviewJoinRequestUseCase struct {
+	groupRepo       repository.GroupRepository
+	joinRequestRepo repository.GroupJoinRequestRepository
+	moderationRepo  repository.GroupModerationRepository
+	notifier        GroupNotifier
+}
+
+// NewReviewJoinRequestUseCase creates a new ReviewJoinRequestUseCase
+func NewReviewJoinRequestUseCase(groupRepo repository.GroupRepository, joinRequestRepo repository.GroupJoinRequestRepository, moderationRepo repository.GroupModerationRepository) *ReviewJoinRequestUseCase {
+	return &ReviewJoinRequestUseCase{
+		groupRepo:       groupRepo,
+		joinRequestRepo: joinRequestRepo,
+		moderationRepo:  moderationRepo,
+	}
+}
+
+// Execute approves or rejects a pending join request and notifies the requester.
+// Approving adds the requester as a member.
+func (uc *ReviewJoinRequestUseCase) Execute(ctx context.Context, req *ReviewJoinRequestRequest) (*domain.GroupJoinRequest, error) {
+	if err := checkGroupPermission(ctx, uc.groupRepo, req.GroupID, req.UserID, domain.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	joinRequest, err := uc.joinRequestRepo.GetByID(ctx, req.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if joinRequest == nil || joinRequest.GroupID != req.GroupID {
+		return nil, ErrJoinRequestNotFound
+	}
+	if !joinRequest.IsPending() {
+		return nil, ErrJoinRequestNotPending
+	}
+
+	now := time.Now().UTC()
+	reviewerID := req.UserID
+	joinRequest.ReviewedBy = &reviewerID
+	joinRequest.ReviewedAt = &now
+	joinRequest.UpdatedAt = now
+
+	if req.Approve {
+		// The requester may have joined through an invite in the meantime
+		isMember, err := uc.groupRepo.IsMember(ctx, req.GroupID, joinRequest.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if isMember {
+			return nil, ErrAlreadyGroupMember
+		}
+
+		// The requester may have been banned while the request was pending
+		if err := checkNotBanned(ctx, uc.moderationRepo, req.GroupID, joinRequest.UserID); err != nil {
+			return nil, err
+		}
+
+		if err := uc.joinRequestRepo.Approve(ctx, joinRequest); err != nil {
+			return nil, err
+		}
+	} else {
+		joinRequest.Status = domain.GroupJoinRequestStatusRejected
+		if err := uc.joinRequestRepo.Update(ctx, joinRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	if uc.notifier != nil {
+		if err := uc.notifier.OnGroupJoinRequestReviewed(ctx, req.GroupID, joinRequest.UserID, req.Approve); err != nil {
+			log.Printf("Failed to send join request review notification for group %s: %v", req.GroupID, err)
+		}
+	}
+
+	return joinRequest, nil
+}
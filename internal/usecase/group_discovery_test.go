@@ -0,0 +1,410 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGroupJoinRequestRepository is a mock implementation of GroupJoinRequestRepository
+type MockGroupJoinRequestRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupJoinRequestRepository) Create(ctx context.Context, request *domain.GroupJoinRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *MockGroupJoinRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupJoinRequest, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupJoinRequest), args.Error(1)
+}
+
+func (m *MockGroupJoinRequestRepository) Update(ctx context.Context, request *domain.GroupJoinRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *MockGroupJoinRequestRepository) GetPendingByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupJoinRequest, error) {
+	args := m.Called(ctx, groupID)
+	return args.Get(0).([]*domain.GroupJoinRequest), args.Error(1)
+}
+
+func (m *MockGroupJoinRequestRepository) GetPendingForUserInGroup(ctx context.Context, groupID, userID uuid.UUID) (*domain.GroupJoinRequest, error) {
+	args := m.Called(ctx, groupID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupJoinRequest), args.Error(1)
+}
+
+func (m *MockGroupJoinRequestRepository) GetUserRequests(ctx context.Context, userID uuid.UUID) ([]*domain.GroupJoinRequest, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*domain.GroupJoinRequest), args.Error(1)
+}
+
+func (m *MockGroupJoinRequestRepository) Approve(ctx context.Context, request *domain.GroupJoinRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+// MockGroupNotifier is a mock implementation of GroupNotifier
+type MockGroupNotifier struct {
+	mock.Mock
+}
+
+func (m *MockGroupNotifier) OnGroupInvite(ctx context.Context, groupID, invitedUserID, inviterUserID uuid.UUID, role domain.GroupRole, inviteToken string) error {
+	args := m.Called(ctx, groupID, invitedUserID, inviterUserID, role, inviteToken)
+	return args.Error(0)
+}
+
+func (m *MockGroupNotifier) OnGroupJoinRequest(ctx context.Context, groupID, requesterUserID uuid.UUID, message *string) error {
+	args := m.Called(ctx, groupID, requesterUserID, message)
+	return args.Error(0)
+}
+
+func (m *MockGroupNotifier) OnGroupJoinRequestReviewed(ctx context.Context, groupID, requesterUserID uuid.UUID, approved bool) error {
+	args := m.Called(ctx, groupID, requesterUserID, approved)
+	return args.Error(0)
+}
+
+func (m *MockGroupNotifier) OnGroupAnnouncement(ctx context.Context, announcement *domain.GroupAnnouncement) error {
+	args := m.Called(ctx, announcement)
+	return args.Error(0)
+}
+
+func TestSearchGroupsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("applies default limit", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		useCase := NewSearchGroupsUseCase(mockGroupRepo)
+
+		game := domain.GameTypeLorcana
+		mockGroupRepo.On("Search", ctx, domain.GroupSearchParams{
+			Game:  &game,
+			Limit: DefaultGroupSearchLimit,
+		}).Return([]*domain.GroupSearchResult{}, nil)
+
+		resp, err := useCase.Execute(ctx, &SearchGroupsRequest{Game: &game})
+
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultGroupSearchLimit, resp.Limit)
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("radius without location", func(t *testing.T) {
+		useCase := NewSearchGroupsUseCase(new(MockGroupRepository))
+
+		radius := 10
+		_, err := useCase.Execute(ctx, &SearchGroupsRequest{RadiusKm: &radius})
+
+		assert.Equal(t, ErrGroupSearchRadiusNeedsNear, err)
+	})
+
+	t.Run("radius too large", func(t *testing.T) {
+		useCase := NewSearchGroupsUseCase(new(MockGroupRepository))
+
+		radius := MaxGroupSearchRadiusKm + 1
+		_, err := useCase.Execute(ctx, &SearchGroupsRequest{
+			Near:     &domain.Coordinates{Latitude: 38.7223, Longitude: -9.1393},
+			RadiusKm: &radius,
+		})
+
+		assert.Equal(t, ErrInvalidGroupSearchRadius, err)
+	})
+
+	t.Run("invalid game", func(t *testing.T) {
+		useCase := NewSearchGroupsUseCase(new(MockGroupRepository))
+
+		game := domain.GameType("chess")
+		_, err := useCase.Execute(ctx, &SearchGroupsRequest{Game: &game})
+
+		assert.Equal(t, domain.ErrInvalidGameType, err)
+	})
+}
+
+func TestRequestToJoinGroupUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates pending request and notifies admins", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockJoinRepo := new(MockGroupJoinRequestRepository)
+		mockNotifier := new(MockGroupNotifier)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewRequestToJoinGroupUseCase(mockGroupRepo, mockJoinRepo, mockModerationRepo)
+		useCase.notifier = mockNotifier
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		message := "Hi! I play Lorcana every week."
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{
+			ID:         groupID,
+			Visibility: domain.GroupVisibilityPublic,
+			IsActive:   true,
+		}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		mockJoinRepo.On("GetPendingForUserInGroup", ctx, groupID, userID).Return(nil, nil)
+		mockJoinRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupJoinRequest")).Return(nil)
+		mockNotifier.On("OnGroupJoinRequest", ctx, groupID, userID, &message).Return(nil)
+
+		request, err := useCase.Execute(ctx, &RequestToJoinGroupRequest{
+			GroupID: groupID,
+			UserID:  userID,
+			Message: &message,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupJoinRequestStatusPending, request.Status)
+		mockJoinRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("private group", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		useCase := NewRequestToJoinGroupUseCase(mockGroupRepo, new(MockGroupJoinRequestRepository), new(MockGroupModerationRepository))
+
+		groupID := uuid.New()
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{
+			ID:         groupID,
+			Visibility: domain.GroupVisibilityPrivate,
+			IsActive:   true,
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &RequestToJoinGroupRequest{
+			GroupID: groupID,
+			UserID:  uuid.New(),
+		})
+
+		assert.Equal(t, ErrGroupNotPublic, err)
+	})
+
+	t.Run("already pending", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockJoinRepo := new(MockGroupJoinRequestRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewRequestToJoinGroupUseCase(mockGroupRepo, mockJoinRepo, mockModerationRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{
+			ID:         groupID,
+			Visibility: domain.GroupVisibilityPublic,
+			IsActive:   true,
+		}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		mockJoinRepo.On("GetPendingForUserInGroup", ctx, groupID, userID).Return(&domain.GroupJoinRequest{
+			ID:     uuid.New(),
+			Status: domain.GroupJoinRequestStatusPending,
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &RequestToJoinGroupRequest{
+			GroupID: groupID,
+			UserID:  userID,
+		})
+
+		assert.Equal(t, ErrJoinRequestAlreadyPending, err)
+		mockJoinRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("banned user", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockJoinRepo := new(MockGroupJoinRequestRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewRequestToJoinGroupUseCase(mockGroupRepo, mockJoinRepo, mockModerationRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{
+			ID:         groupID,
+			Visibility: domain.GroupVisibilityPublic,
+			IsActive:   true,
+		}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(&domain.GroupBan{
+			GroupID: groupID,
+			UserID:  userID,
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &RequestToJoinGroupRequest{
+			GroupID: groupID,
+			UserID:  userID,
+		})
+
+		assert.Equal(t, ErrUserBannedFromGroup, err)
+		mockJoinRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReviewJoinRequestUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(groupID, adminID uuid.UUID, request *domain.GroupJoinRequest) (*MockGroupRepository, *MockGroupJoinRequestRepository, *MockGroupNotifier, *ReviewJoinRequestUseCase) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockJoinRepo := new(MockGroupJoinRequestRepository)
+		mockNotifier := new(MockGroupNotifier)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewReviewJoinRequestUseCase(mockGroupRepo, mockJoinRepo, mockModerationRepo)
+		useCase.notifier = mockNotifier
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, adminID).Return(&domain.GroupMember{GroupID: groupID, UserID: adminID, Role: domain.GroupRoleAdmin}, nil)
+		mockJoinRepo.On("GetByID", ctx, request.ID).Return(request, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, request.UserID, mock.AnythingOfType("time.Time")).Return(nil, nil).Maybe()
+
+		return mockGroupRepo, mockJoinRepo, mockNotifier, useCase
+	}
+
+	t.Run("approve adds member and notifies requester", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		request := &domain.GroupJoinRequest{
+			ID:      uuid.New(),
+			GroupID: groupID,
+			UserID:  uuid.New(),
+			Status:  domain.GroupJoinRequestStatusPending,
+		}
+		mockGroupRepo, mockJoinRepo, mockNotifier, useCase := setup(groupID, adminID, request)
+
+		mockGroupRepo.On("IsMember", ctx, groupID, request.UserID).Return(false, nil)
+		mockJoinRepo.On("Approve", ctx, request).Return(nil)
+		mockNotifier.On("OnGroupJoinRequestReviewed", ctx, groupID, request.UserID, true).Return(nil)
+
+		result, err := useCase.Execute(ctx, &ReviewJoinRequestRequest{
+			GroupID:   groupID,
+			RequestID: request.ID,
+			UserID:    adminID,
+			Approve:   true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, adminID, *result.ReviewedBy)
+		mockJoinRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("reject updates status", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		request := &domain.GroupJoinRequest{
+			ID:      uuid.New(),
+			GroupID: groupID,
+			UserID:  uuid.New(),
+			Status:  domain.GroupJoinRequestStatusPending,
+		}
+		_, mockJoinRepo, mockNotifier, useCase := setup(groupID, adminID, request)
+
+		mockJoinRepo.On("Update", ctx, request).Return(nil)
+		mockNotifier.On("OnGroupJoinRequestReviewed", ctx, groupID, request.UserID, false).Return(nil)
+
+		result, err := useCase.Execute(ctx, &ReviewJoinRequestRequest{
+			GroupID:   groupID,
+			RequestID: request.ID,
+			UserID:    adminID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupJoinRequestStatusRejected, result.Status)
+		mockJoinRepo.AssertNotCalled(t, "Approve", mock.Anything, mock.Anything)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("request from another group", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		request := &domain.GroupJoinRequest{
+			ID:      uuid.New(),
+			GroupID: uuid.New(),
+			UserID:  uuid.New(),
+			Status:  domain.GroupJoinRequestStatusPending,
+		}
+		_, _, _, useCase := setup(groupID, adminID, request)
+
+		_, err := useCase.Execute(ctx, &ReviewJoinRequestRequest{
+			GroupID:   groupID,
+			RequestID: request.ID,
+			UserID:    adminID,
+			Approve:   true,
+		})
+
+		assert.Equal(t, ErrJoinRequestNotFound, err)
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		request := &domain.GroupJoinRequest{
+			ID:      uuid.New(),
+			GroupID: groupID,
+			UserID:  uuid.New(),
+			Status:  domain.GroupJoinRequestStatusRejected,
+		}
+		_, _, _, useCase := setup(groupID, adminID, request)
+
+		_, err := useCase.Execute(ctx, &ReviewJoinRequestRequest{
+			GroupID:   groupID,
+			RequestID: request.ID,
+			UserID:    adminID,
+			Approve:   true,
+		})
+
+		assert.Equal(t, ErrJoinRequestNotPending, err)
+	})
+}
+
+func TestCancelJoinRequestUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cannot cancel another user's request", func(t *testing.T) {
+		mockJoinRepo := new(MockGroupJoinRequestRepository)
+		useCase := NewCancelJoinRequestUseCase(mockJoinRepo)
+
+		request := &domain.GroupJoinRequest{
+			ID:     uuid.New(),
+			UserID: uuid.New(),
+			Status: domain.GroupJoinRequestStatusPending,
+		}
+		mockJoinRepo.On("GetByID", ctx, request.ID).Return(request, nil)
+
+		err := useCase.Execute(ctx, &CancelJoinRequestRequest{
+			RequestID: request.ID,
+			UserID:    uuid.New(),
+		})
+
+		assert.Equal(t, ErrJoinRequestNotFound, err)
+	})
+
+	t.Run("cancels own pending request", func(t *testing.T) {
+		mockJoinRepo := new(MockGroupJoinRequestRepository)
+		useCase := NewCancelJoinRequestUseCase(mockJoinRepo)
+
+		request := &domain.GroupJoinRequest{
+			ID:     uuid.New(),
+			UserID: uuid.New(),
+			Status: domain.GroupJoinRequestStatusPending,
+		}
+		mockJoinRepo.On("GetByID", ctx, request.ID).Return(request, nil)
+		mockJoinRepo.On("Update", ctx, request).Return(nil)
+
+		err := useCase.Execute(ctx, &CancelJoinRequestRequest{
+			RequestID: request.ID,
+			UserID:    request.UserID,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupJoinRequestStatusCancelled, request.Status)
+	})
+}
@@ -0,0 +1,423 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+const (
+	// DefaultGroupInviteExpiry is how long an invite stays valid when no expiry is requested
+	DefaultGroupInviteExpiry = 7 * 24 * time.Hour
+	// MaxGroupInviteExpiry is the longest an invite can stay valid
+	MaxGroupInviteExpiry = 30 * 24 * time.Hour
+)
+
+var (
+	ErrInviteNotFound      = errors.New("invite not found")
+	ErrInviteExpired       = errors.New("invite has expired")
+	ErrInviteNoLongerValid = errors.New("invite is no longer valid")
+	ErrInviteNotForUser    = errors.New("invite was sent to another user")
+	ErrInvalidInviteToken  = errors.New("invalid invite token")
+)
+
+// GroupNotifier defines the interface for group membership notifications
+type GroupNotifier interface {
+	OnGroupInvite(ctx context.Context, groupID, invitedUserID, inviterUserID uuid.UUID, role domain.GroupRole, inviteToken string) error
+	OnGroupJoinRequest(ctx context.Context, groupID, requesterUserID uuid.UUID, message *string) error
+	OnGroupJoinRequestReviewed(ctx context.Context, groupID, requesterUserID uuid.UUID, approved bool) error
+	OnGroupAnnouncement(ctx context.Context, announcement *domain.GroupAnnouncement) error
+}
+
+// CreateGroupInviteLinkRequest represents the request to create a shareable invite link
+type CreateGroupInviteLinkRequest struct {
+	GroupID   uuid.UUID      `json:"group_id" validate:"required"`
+	UserID    uuid.UUID      `json:"user_id" validate:"required"` // User making the request
+	MaxUses   *int           `json:"max_uses,omitempty" validate:"omitempty,min=1"`
+	ExpiresIn *time.Duration `json:"expires_in,omitempty"`
+}
+
+// CreateGroupInviteLinkResponse represents the response after creating an invite link
+type CreateGroupInviteLinkResponse struct {
+	Invite *domain.GroupInvite `json:"invite"`
+	Token  string              `json:"token"` // Only returned once, never stored
+}
+
+// AcceptGroupInviteRequest represents the request to accept an invite, either by ID or by token
+type AcceptGroupInviteRequest struct {
+	InviteID *uuid.UUID `json:"invite_id,omitempty"`
+	Token    string     `json:"token,omitempty"`
+	UserID   uuid.UUID  `json:"user_id" validate:"required"` // User accepting the invite
+}
+
+// AcceptGroupInviteResponse represents the response after accepting an invite
+type AcceptGroupInviteResponse struct {
+	GroupID uuid.UUID        `json:"group_id"`
+	Role    domain.GroupRole `json:"role"`
+}
+
+// DeclineGroupInviteRequest represents the request to decline a direct invite
+type DeclineGroupInviteRequest struct {
+	InviteID uuid.UUID `json:"invite_id" validate:"required"`
+	UserID   uuid.UUID `json:"user_id" validate:"required"` // User declining the invite
+}
+
+// RevokeGroupInviteRequest represents the request to revoke an invite or invite link
+type RevokeGroupInviteRequest struct {
+	GroupID  uuid.UUID `json:"group_id" validate:"required"`
+	InviteID uuid.UUID `json:"invite_id" validate:"required"`
+	UserID   uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListGroupInvitesRequest represents the request to list a group's pending invites
+type ListGroupInvitesRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListGroupInvitesResponse represents the pending invites of a group
+type ListGroupInvitesResponse struct {
+	Invites []*domain.GroupInvite `json:"invites"`
+}
+
+// ListUserInvitesRequest represents the request to list a user's pending invites
+type ListUserInvitesRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// ListUserInvitesResponse represents the pending invites addressed to a user
+type ListUserInvitesResponse struct {
+	Invites []*domain.GroupInviteWithGroup `json:"invites"`
+}
+
+// CreateGroupInviteLinkUseCase handles creating shareable invite links
+type CreateGroupInviteLinkUseCase struct {
+	groupRepo  repository.GroupRepository
+	inviteRepo repository.GroupInviteRepository
+}
+
+// NewCreateGroupInviteLinkUseCase creates a new CreateGroupInviteLinkUseCase
+func NewCreateGroupInviteLinkUseCase(groupRepo repository.GroupRepository, inviteRepo repository.GroupInviteRepository) *CreateGroupInviteLinkUseCase {
+	return &CreateGroupInviteLinkUseCase{
+		groupRepo:  groupRepo,
+		inviteRepo: inviteRepo,
+	}
+}
+
+// Execute creates a member invite link that can be used up to MaxUses times
+func (uc *CreateGroupInviteLinkUseCase) Execute(ctx context.Context, req *CreateGroupInviteLinkRequest) (*CreateGroupInviteLinkResponse, error) {
+	if err := checkGroupPermission(ctx, uc.groupRepo, req.GroupID, req.UserID, domain.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	invite := &domain.GroupInvite{
+		ID:        uuid.New(),
+		GroupID:   req.GroupID,
+		InviterID: req.UserID,
+		Role:      domain.GroupRoleMember,
+		TokenHash: domain.HashInviteToken(token),
+		Status:    domain.GroupInviteStatusPending,
+		MaxUses:   req.MaxUses,
+		ExpiresAt: now.Add(inviteExpiry(req.ExpiresIn)),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := invite.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.inviteRepo.Create(ctx, invite); err != nil {
+		return nil, err
+	}
+
+	return &CreateGroupInviteLinkResponse{
+		Invite: invite,
+		Token:  token,
+	}, nil
+}
+
+// AcceptGroupInviteUseCase handles accepting invites and redeeming invite links
+type AcceptGroupInviteUseCase struct {
+	groupRepo      repository.GroupRepository
+	inviteRepo     repository.GroupInviteRepository
+	moderationRepo repository.GroupModerationRepository
+}
+
+// NewAcceptGroupInviteUseCase creates a new AcceptGroupInviteUseCase
+func NewAcceptGroupInviteUseCase(groupRepo repository.GroupRepository, inviteRepo repository.GroupInviteRepository, moderationRepo repository.GroupModerationRepository) *AcceptGroupInviteUseCase {
+	return &AcceptGroupInviteUseCase{
+		groupRepo:      groupRepo,
+		inviteRepo:     inviteRepo,
+		moderationRepo: moderationRepo,
+	}
+}
+
+// Execute adds the user to the group if the invite is still valid
+func (uc *AcceptGroupInviteUseCase) Execute(ctx context.Context, req *AcceptGroupInviteRequest) (*AcceptGroupInviteResponse, error) {
+	invite, err := uc.findInvite(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Direct invites can only be accepted by the invited user
+	if !invite.IsLink() && *invite.InvitedUserID != req.UserID {
+		return nil, ErrInviteNotForUser
+	}
+
+	now := time.Now().UTC()
+	if err := checkInviteRedeemable(ctx, uc.inviteRepo, invite, now); err != nil {
+		return nil, err
+	}
+
+	group, err := uc.groupRepo.GetByID(ctx, invite.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || !group.IsActive {
+		return nil, ErrGroupNotFound
+	}
+
+	isMember, err := uc.groupRepo.IsMember(ctx, invite.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, ErrAlreadyGroupMember
+	}
+
+	// Shareable links must not let banned users back in
+	if err := checkNotBanned(ctx, uc.moderationRepo, invite.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	redeemed, err := uc.inviteRepo.Redeem(ctx, invite.ID, req.UserID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !redeemed {
+		// Another request used up the invite in the meantime
+		return nil, ErrInviteNoLongerValid
+	}
+
+	return &AcceptGroupInviteResponse{
+		GroupID: invite.GroupID,
+		Role:    invite.Role,
+	}, nil
+}
+
+// findInvite looks up the invite by token, or by ID for direct invites
+func (uc *AcceptGroupInviteUseCase) findInvite(ctx context.Context, req *AcceptGroupInviteRequest) (*domain.GroupInvite, error) {
+	if req.Token != "" {
+		invite, err := uc.inviteRepo.GetByTokenHash(ctx, domain.HashInviteToken(req.Token))
+		if err != nil {
+			return nil, err
+		}
+		if invite == nil {
+			return nil, ErrInvalidInviteToken
+		}
+		return invite, nil
+	}
+
+	if req.InviteID == nil {
+		return nil, ErrInvalidInviteToken
+	}
+
+	invite, err := uc.inviteRepo.GetByID(ctx, *req.InviteID)
+	if err != nil {
+		return nil, err
+	}
+	// Links can only be redeemed with their token
+	if invite == nil || invite.IsLink() {
+		return nil, ErrInviteNotFound
+	}
+
+	return invite, nil
+}
+
+// DeclineGroupInviteUseCase handles declining direct invites
+type DeclineGroupInviteUseCase struct {
+	inviteRepo repository.GroupInviteRepository
+}
+
+// NewDeclineGroupInviteUseCase creates a new DeclineGroupInviteUseCase
+func NewDeclineGroupInviteUseCase(inviteRepo repository.GroupInviteRepository) *DeclineGroupInviteUseCase {
+	return &DeclineGroupInviteUseCase{
+		inviteRepo: inviteRepo,
+	}
+}
+
+// Execute marks a direct invite as declined
+func (uc *DeclineGroupInviteUseCase) Execute(ctx context.Context, req *DeclineGroupInviteRequest) error {
+	invite, err := uc.inviteRepo.GetByID(ctx, req.InviteID)
+	if err != nil {
+		return err
+	}
+	if invite == nil || invite.IsLink() {
+		return ErrInviteNotFound
+	}
+	if *invite.InvitedUserID != req.UserID {
+		return ErrInviteNotForUser
+	}
+
+	now := time.Now().UTC()
+	if err := checkInviteRedeemable(ctx, uc.inviteRepo, invite, now); err != nil {
+		return err
+	}
+
+	invite.Status = domain.GroupInviteStatusDeclined
+	invite.RespondedAt = &now
+	invite.UpdatedAt = now
+
+	return uc.inviteRepo.Update(ctx, invite)
+}
+
+// RevokeGroupInviteUseCase handles revoking invites and invite links
+type RevokeGroupInviteUseCase struct {
+	groupRepo  repository.GroupRepository
+	inviteRepo repository.GroupInviteRepository
+}
+
+// NewRevokeGroupInviteUseCase creates a new RevokeGroupInviteUseCase
+func NewRevokeGroupInviteUseCase(groupRepo repository.GroupRepository, inviteRepo repository.GroupInviteRepository) *RevokeGroupInviteUseCase {
+	return &RevokeGroupInviteUseCase{
+		groupRepo:  groupRepo,
+		inviteRepo: inviteRepo,
+	}
+}
+
+// Execute revokes a pending invite so its token can no longer be used
+func (uc *RevokeGroupInviteUseCase) Execute(ctx context.Context, req *RevokeGroupInviteRequest) error {
+	if err := checkGroupPermission(ctx, uc.groupRepo, req.GroupID, req.UserID, domain.PermissionManageMembers); err != nil {
+		return err
+	}
+
+	invite, err := uc.inviteRepo.GetByID(ctx, req.InviteID)
+	if err != nil {
+		return err
+	}
+	if invite == nil || invite.GroupID != req.GroupID {
+		return ErrInviteNotFound
+	}
+	if invite.Status != domain.GroupInviteStatusPending {
+		return ErrInviteNoLongerValid
+	}
+
+	invite.Status = domain.GroupInviteStatusRevoked
+	invite.UpdatedAt = time.Now().UTC()
+
+	return uc.inviteRepo.Update(ctx, invite)
+}
+
+// ListGroupInvitesUseCase handles listing a group's pending invites
+type ListGroupInvitesUseCase struct {
+	groupRepo  repository.GroupRepository
+	inviteRepo repository.GroupInviteRepository
+}
+
+// NewListGroupInvitesUseCase creates a new ListGroupInvitesUseCase
+func NewListGroupInvitesUseCase(groupRepo repository.GroupRepository, inviteRepo repository.GroupInviteRepository) *ListGroupInvitesUseCase {
+	return &ListGroupInvitesUseCase{
+		groupRepo:  groupRepo,
+		inviteRepo: inviteRepo,
+	}
+}
+
+// Execute lists the invites and links of a group that can still be used
+func (uc *ListGroupInvitesUseCase) Execute(ctx context.Context, req *ListGroupInvitesRequest) (*ListGroupInvitesResponse, error) {
+	if err := checkGroupPermission(ctx, uc.groupRepo, req.GroupID, req.UserID, domain.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+
+	invites, err := uc.inviteRepo.GetPendingByGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListGroupInvitesResponse{
+		Invites: invites,
+	}, nil
+}
+
+// ListUserInvitesUseCase handles listing the invites addressed to a user
+type ListUserInvitesUseCase struct {
+	inviteRepo repository.GroupInviteRepository
+}
+
+// NewListUserInvitesUseCase creates a new ListUserInvitesUseCase
+func NewListUserInvitesUseCase(inviteRepo repository.GroupInviteRepository) *ListUserInvitesUseCase {
+	return &ListUserInvitesUseCase{
+		inviteRepo: inviteRepo,
+	}
+}
+
+// Execute lists the pending direct invites of a user
+func (uc *ListUserInvitesUseCase) Execute(ctx context.Context, req *ListUserInvitesRequest) (*ListUserInvitesResponse, error) {
+	invites, err := uc.inviteRepo.GetPendingByUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListUserInvitesResponse{
+		Invites: invites,
+	}, nil
+}
+
+// checkInviteRedeemable verifies that an invite is pending, unexpired and has uses left.
+// Pending invites found past their expiry are marked as expired.
+func checkInviteRedeemable(ctx context.Context, inviteRepo repository.GroupInviteRepository, invite *domain.GroupInvite, now time.Time) error {
+	if invite.Status != domain.GroupInviteStatusPending {
+		if invite.Status == domain.GroupInviteStatusExpired {
+			return ErrInviteExpired
+		}
+		return ErrInviteNoLongerValid
+	}
+
+	if invite.IsExpired(now) {
+		invite.Status = domain.GroupInviteStatusExpired
+		invite.UpdatedAt = now
+		if err := inviteRepo.Update(ctx, invite); err != nil {
+			return err
+		}
+		return ErrInviteExpired
+	}
+
+	if invite.IsExhausted() {
+		return ErrInviteNoLongerValid
+	}
+
+	return nil
+}
+
+// inviteExpiry returns the requested invite lifetime, applying the default and maximum
+func inviteExpiry(requested *time.Duration) time.Duration {
+	if requested == nil {
+		return DefaultGroupInviteExpiry
+	}
+	if *requested > MaxGroupInviteExpiry {
+		return MaxGroupInviteExpiry
+	}
+	return *requested
+}
+
+// generateInviteToken generates a random URL-safe invite token
+func generateInviteToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate invite token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
@@ -0,0 +1,403 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGroupInviteRepository is a mock implementation of GroupInviteRepository
+type MockGroupInviteRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupInviteRepository) Create(ctx context.Context, invite *domain.GroupInvite) error {
+	args := m.Called(ctx, invite)
+	return args.Error(0)
+}
+
+func (m *MockGroupInviteRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupInvite, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupInvite), args.Error(1)
+}
+
+func (m *MockGroupInviteRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.GroupInvite, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupInvite), args.Error(1)
+}
+
+func (m *MockGroupInviteRepository) Update(ctx context.Context, invite *domain.GroupInvite) error {
+	args := m.Called(ctx, invite)
+	return args.Error(0)
+}
+
+func (m *MockGroupInviteRepository) GetPendingByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupInvite, error) {
+	args := m.Called(ctx, groupID)
+	return args.Get(0).([]*domain.GroupInvite), args.Error(1)
+}
+
+func (m *MockGroupInviteRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) ([]*domain.GroupInviteWithGroup, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*domain.GroupInviteWithGroup), args.Error(1)
+}
+
+func (m *MockGroupInviteRepository) GetPendingForUserInGroup(ctx context.Context, groupID, userID uuid.UUID) (*domain.GroupInvite, error) {
+	args := m.Called(ctx, groupID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupInvite), args.Error(1)
+}
+
+func (m *MockGroupInviteRepository) Redeem(ctx context.Context, inviteID, userID uuid.UUID, joinedAt time.Time) (bool, error) {
+	args := m.Called(ctx, inviteID, userID, joinedAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestCreateGroupInviteLinkUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates link with max uses", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		useCase := NewCreateGroupInviteLinkUseCase(mockGroupRepo, mockInviteRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		maxUses := 10
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(&domain.GroupMember{GroupID: groupID, UserID: userID, Role: domain.GroupRoleAdmin}, nil)
+		mockInviteRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupInvite")).Return(nil)
+
+		resp, err := useCase.Execute(ctx, &CreateGroupInviteLinkRequest{
+			GroupID: groupID,
+			UserID:  userID,
+			MaxUses: &maxUses,
+		})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, resp.Token)
+		assert.True(t, resp.Invite.IsLink())
+		assert.Equal(t, domain.GroupRoleMember, resp.Invite.Role)
+		assert.Equal(t, 10, *resp.Invite.MaxUses)
+		assert.Equal(t, domain.HashInviteToken(resp.Token), resp.Invite.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(DefaultGroupInviteExpiry), resp.Invite.ExpiresAt, time.Minute)
+		mockInviteRepo.AssertExpectations(t)
+	})
+
+	t.Run("caps expiry", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		useCase := NewCreateGroupInviteLinkUseCase(mockGroupRepo, mockInviteRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		expiresIn := 365 * 24 * time.Hour
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(&domain.GroupMember{GroupID: groupID, UserID: userID, Role: domain.GroupRoleAdmin}, nil)
+		mockInviteRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupInvite")).Return(nil)
+
+		resp, err := useCase.Execute(ctx, &CreateGroupInviteLinkRequest{
+			GroupID:   groupID,
+			UserID:    userID,
+			ExpiresIn: &expiresIn,
+		})
+
+		assert.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(MaxGroupInviteExpiry), resp.Invite.ExpiresAt, time.Minute)
+	})
+
+	t.Run("member whose custom role grants member management", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		useCase := NewCreateGroupInviteLinkUseCase(mockGroupRepo, mockInviteRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		roleID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(&domain.GroupMember{
+			GroupID:      groupID,
+			UserID:       userID,
+			Role:         domain.GroupRoleMember,
+			CustomRoleID: &roleID,
+			Permissions:  []domain.Permission{domain.PermissionManageMembers},
+		}, nil)
+		mockInviteRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupInvite")).Return(nil)
+
+		_, err := useCase.Execute(ctx, &CreateGroupInviteLinkRequest{GroupID: groupID, UserID: userID})
+
+		assert.NoError(t, err)
+		mockInviteRepo.AssertExpectations(t)
+	})
+
+	t.Run("unauthorized access", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		useCase := NewCreateGroupInviteLinkUseCase(mockGroupRepo, mockInviteRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(&domain.GroupMember{GroupID: groupID, UserID: userID, Role: domain.GroupRoleMember}, nil)
+
+		resp, err := useCase.Execute(ctx, &CreateGroupInviteLinkRequest{GroupID: groupID, UserID: userID})
+
+		assert.Equal(t, ErrUnauthorizedGroupAccess, err)
+		assert.Nil(t, resp)
+		mockInviteRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAcceptGroupInviteUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("redeems link by token", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewAcceptGroupInviteUseCase(mockGroupRepo, mockInviteRepo, mockModerationRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		invite := &domain.GroupInvite{
+			ID:        uuid.New(),
+			GroupID:   groupID,
+			Role:      domain.GroupRoleMember,
+			Status:    domain.GroupInviteStatusPending,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mockInviteRepo.On("GetByTokenHash", ctx, domain.HashInviteToken("link-token")).Return(invite, nil)
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, IsActive: true}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		mockInviteRepo.On("Redeem", ctx, invite.ID, userID, mock.AnythingOfType("time.Time")).Return(true, nil)
+
+		resp, err := useCase.Execute(ctx, &AcceptGroupInviteRequest{Token: "link-token", UserID: userID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, groupID, resp.GroupID)
+		assert.Equal(t, domain.GroupRoleMember, resp.Role)
+		mockInviteRepo.AssertExpectations(t)
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewAcceptGroupInviteUseCase(mockGroupRepo, mockInviteRepo, mockModerationRepo)
+
+		mockInviteRepo.On("GetByTokenHash", ctx, domain.HashInviteToken("bogus")).Return(nil, nil)
+
+		resp, err := useCase.Execute(ctx, &AcceptGroupInviteRequest{Token: "bogus", UserID: uuid.New()})
+
+		assert.Equal(t, ErrInvalidInviteToken, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("direct invite for another user", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewAcceptGroupInviteUseCase(mockGroupRepo, mockInviteRepo, mockModerationRepo)
+
+		invitedUserID := uuid.New()
+		invite := &domain.GroupInvite{
+			ID:            uuid.New(),
+			GroupID:       uuid.New(),
+			InvitedUserID: &invitedUserID,
+			Status:        domain.GroupInviteStatusPending,
+			ExpiresAt:     time.Now().Add(time.Hour),
+		}
+
+		mockInviteRepo.On("GetByID", ctx, invite.ID).Return(invite, nil)
+
+		resp, err := useCase.Execute(ctx, &AcceptGroupInviteRequest{InviteID: &invite.ID, UserID: uuid.New()})
+
+		assert.Equal(t, ErrInviteNotForUser, err)
+		assert.Nil(t, resp)
+		mockInviteRepo.AssertNotCalled(t, "Redeem", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expired invite is marked expired", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewAcceptGroupInviteUseCase(mockGroupRepo, mockInviteRepo, mockModerationRepo)
+
+		userID := uuid.New()
+		invite := &domain.GroupInvite{
+			ID:            uuid.New(),
+			GroupID:       uuid.New(),
+			InvitedUserID: &userID,
+			Status:        domain.GroupInviteStatusPending,
+			ExpiresAt:     time.Now().Add(-time.Hour),
+		}
+
+		mockInviteRepo.On("GetByID", ctx, invite.ID).Return(invite, nil)
+		mockInviteRepo.On("Update", ctx, invite).Return(nil)
+
+		resp, err := useCase.Execute(ctx, &AcceptGroupInviteRequest{InviteID: &invite.ID, UserID: userID})
+
+		assert.Equal(t, ErrInviteExpired, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, domain.GroupInviteStatusExpired, invite.Status)
+		mockInviteRepo.AssertExpectations(t)
+	})
+
+	t.Run("banned user cannot redeem link", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewAcceptGroupInviteUseCase(mockGroupRepo, mockInviteRepo, mockModerationRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		invite := &domain.GroupInvite{
+			ID:        uuid.New(),
+			GroupID:   groupID,
+			Role:      domain.GroupRoleMember,
+			Status:    domain.GroupInviteStatusPending,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mockInviteRepo.On("GetByTokenHash", ctx, domain.HashInviteToken("link-token")).Return(invite, nil)
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, IsActive: true}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(&domain.GroupBan{
+			GroupID: groupID,
+			UserID:  userID,
+		}, nil)
+
+		resp, err := useCase.Execute(ctx, &AcceptGroupInviteRequest{Token: "link-token", UserID: userID})
+
+		assert.Equal(t, ErrUserBannedFromGroup, err)
+		assert.Nil(t, resp)
+		mockInviteRepo.AssertNotCalled(t, "Redeem", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("link used up concurrently", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewAcceptGroupInviteUseCase(mockGroupRepo, mockInviteRepo, mockModerationRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		maxUses := 1
+		invite := &domain.GroupInvite{
+			ID:        uuid.New(),
+			GroupID:   groupID,
+			Role:      domain.GroupRoleMember,
+			Status:    domain.GroupInviteStatusPending,
+			MaxUses:   &maxUses,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		mockInviteRepo.On("GetByTokenHash", ctx, domain.HashInviteToken("link-token")).Return(invite, nil)
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, IsActive: true}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		mockInviteRepo.On("Redeem", ctx, invite.ID, userID, mock.AnythingOfType("time.Time")).Return(false, nil)
+
+		resp, err := useCase.Execute(ctx, &AcceptGroupInviteRequest{Token: "link-token", UserID: userID})
+
+		assert.Equal(t, ErrInviteNoLongerValid, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestDeclineGroupInviteUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	mockInviteRepo := new(MockGroupInviteRepository)
+	useCase := NewDeclineGroupInviteUseCase(mockInviteRepo)
+
+	userID := uuid.New()
+	invite := &domain.GroupInvite{
+		ID:            uuid.New(),
+		GroupID:       uuid.New(),
+		InvitedUserID: &userID,
+		Status:        domain.GroupInviteStatusPending,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+
+	mockInviteRepo.On("GetByID", ctx, invite.ID).Return(invite, nil)
+	mockInviteRepo.On("Update", ctx, invite).Return(nil)
+
+	err := useCase.Execute(ctx, &DeclineGroupInviteRequest{InviteID: invite.ID, UserID: userID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.GroupInviteStatusDeclined, invite.Status)
+	assert.NotNil(t, invite.RespondedAt)
+	mockInviteRepo.AssertExpectations(t)
+}
+
+func TestRevokeGroupInviteUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("revokes pending invite", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		useCase := NewRevokeGroupInviteUseCase(mockGroupRepo, mockInviteRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		invite := &domain.GroupInvite{
+			ID:      uuid.New(),
+			GroupID: groupID,
+			Status:  domain.GroupInviteStatusPending,
+		}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(&domain.GroupMember{GroupID: groupID, UserID: userID, Role: domain.GroupRoleAdmin}, nil)
+		mockInviteRepo.On("GetByID", ctx, invite.ID).Return(invite, nil)
+		mockInviteRepo.On("Update", ctx, invite).Return(nil)
+
+		err := useCase.Execute(ctx, &RevokeGroupInviteRequest{GroupID: groupID, InviteID: invite.ID, UserID: userID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupInviteStatusRevoked, invite.Status)
+		mockInviteRepo.AssertExpectations(t)
+	})
+
+	t.Run("invite from another group", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		useCase := NewRevokeGroupInviteUseCase(mockGroupRepo, mockInviteRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		invite := &domain.GroupInvite{
+			ID:      uuid.New(),
+			GroupID: uuid.New(),
+			Status:  domain.GroupInviteStatusPending,
+		}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(&domain.GroupMember{GroupID: groupID, UserID: userID, Role: domain.GroupRoleAdmin}, nil)
+		mockInviteRepo.On("GetByID", ctx, invite.ID).Return(invite, nil)
+
+		err := useCase.Execute(ctx, &RevokeGroupInviteRequest{GroupID: groupID, InviteID: invite.ID, UserID: userID})
+
+		assert.Equal(t, ErrInviteNotFound, err)
+		mockInviteRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
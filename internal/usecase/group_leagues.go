@@ -0,0 +1,560 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrLeagueNotFound            = errors.New("league not found")
+	ErrLeagueEventNotFound       = errors.New("event is not part of the league")
+	ErrEventNotInGroup           = errors.New("event does not belong to the league's group")
+	ErrEventOutsideLeagueSeason  = errors.New("event does not start within the league season")
+	ErrEventAlreadyInLeague      = errors.New("event already counts towards a league")
+	ErrDuplicateLeaguePlayer     = errors.New("a player can only have one result per event")
+	ErrDuplicateLeaguePlacement  = errors.New("a placement can only be awarded once per event")
+	ErrLeaguePlayerNotAttending  = errors.New("results can only be recorded for players going to or checked in at the event")
+	ErrLeagueSeasonExcludesEvent = errors.New("the league season must include every linked event")
+)
+
+// CreateLeagueRequest represents the request to create a group league
+type CreateLeagueRequest struct {
+	GroupID     uuid.UUID                `json:"group_id" validate:"required"`
+	UserID      uuid.UUID                `json:"user_id" validate:"required"` // User making the request
+	Name        string                   `json:"name" validate:"required,max=100"`
+	Description *string                  `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SeasonStart time.Time                `json:"season_start" validate:"required"`
+	SeasonEnd   time.Time                `json:"season_end" validate:"required"`
+	PointScheme domain.LeaguePointScheme `json:"point_scheme"`
+}
+
+// UpdateLeagueRequest represents the request to update a group league
+type UpdateLeagueRequest struct {
+	GroupID     uuid.UUID                 `json:"group_id" validate:"required"`
+	LeagueID    uuid.UUID                 `json:"league_id" validate:"required"`
+	UserID      uuid.UUID                 `json:"user_id" validate:"required"` // User making the request
+	Name        *string                   `json:"name,omitempty" validate:"omitempty,max=100"`
+	Description *string                   `json:"description,omitempty" validate:"omitempty,max=1000"`
+	SeasonStart *time.Time                `json:"season_start,omitempty"`
+	SeasonEnd   *time.Time                `json:"season_end,omitempty"`
+	PointScheme *domain.LeaguePointScheme `json:"point_scheme,omitempty"`
+}
+
+// DeleteLeagueRequest represents the request to delete a group league
+type DeleteLeagueRequest struct {
+	GroupID  uuid.UUID `json:"group_id" validate:"required"`
+	LeagueID uuid.UUID `json:"league_id" validate:"required"`
+	UserID   uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListGroupLeaguesRequest represents the request to list a group's leagues
+type ListGroupLeaguesRequest struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+}
+
+// ListGroupLeaguesResponse represents a list of group leagues
+type ListGroupLeaguesResponse struct {
+	Leagues []*domain.GroupLeague `json:"leagues"`
+}
+
+// LeagueEventRequest represents the request to link or unlink an event from a league
+type LeagueEventRequest struct {
+	GroupID  uuid.UUID `json:"group_id" validate:"required"`
+	LeagueID uuid.UUID `json:"league_id" validate:"required"`
+	EventID  uuid.UUID `json:"event_id" validate:"required"`
+	UserID   uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// GetLeagueEventsRequest represents the request to get the events of a league
+type GetLeagueEventsRequest struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	LeagueID    uuid.UUID `json:"league_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+	Limit       int       `json:"limit" validate:"min=1,max=100"`
+	Offset      int       `json:"offset" validate:"min=0"`
+}
+
+// LeaguePlayerResult represents one player's result in a results submission
+type LeaguePlayerResult struct {
+	UserID    uuid.UUID `json:"user_id" validate:"required"`
+	Placement *int      `json:"placement,omitempty" validate:"omitempty,min=1"` // nil for participation only
+}
+
+// RecordLeagueResultsRequest represents the request to record the results of a league event
+type RecordLeagueResultsRequest struct {
+	GroupID  uuid.UUID            `json:"group_id" validate:"required"`
+	LeagueID uuid.UUID            `json:"league_id" validate:"required"`
+	EventID  uuid.UUID            `json:"event_id" validate:"required"`
+	UserID   uuid.UUID            `json:"user_id" validate:"required"` // User making the request
+	Results  []LeaguePlayerResult `json:"results"`
+}
+
+// RecordLeagueResultsResponse represents the recorded results of a league event
+type RecordLeagueResultsResponse struct {
+	Results []*domain.LeagueResult `json:"results"`
+}
+
+// GetLeagueStandingsRequest represents the request to get a league table
+type GetLeagueStandingsRequest struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	LeagueID    uuid.UUID `json:"league_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+}
+
+// GetLeagueStandingsResponse represents a league and its current table
+type GetLeagueStandingsResponse struct {
+	League    *domain.GroupLeague      `json:"league"`
+	Standings []*domain.LeagueStanding `json:"standings"`
+}
+
+// CreateLeagueUseCase handles league creation
+type CreateLeagueUseCase struct {
+	groupRepo  repository.GroupRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewCreateLeagueUseCase creates a new CreateLeagueUseCase
+func NewCreateLeagueUseCase(groupRepo repository.GroupRepository, leagueRepo repository.GroupLeagueRepository) *CreateLeagueUseCase {
+	return &CreateLeagueUseCase{
+		groupRepo:  groupRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute creates a new league for the group
+func (uc *CreateLeagueUseCase) Execute(ctx context.Context, req *CreateLeagueRequest) (*domain.GroupLeague, error) {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	league := &domain.GroupLeague{
+		ID:          uuid.New(),
+		GroupID:     req.GroupID,
+		Name:        req.Name,
+		Description: req.Description,
+		SeasonStart: req.SeasonStart.UTC(),
+		SeasonEnd:   req.SeasonEnd.UTC(),
+		PointScheme: req.PointScheme,
+		CreatedBy:   req.UserID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := league.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.leagueRepo.Create(ctx, league); err != nil {
+		return nil, err
+	}
+
+	return league, nil
+}
+
+// UpdateLeagueUseCase handles league updates
+type UpdateLeagueUseCase struct {
+	groupRepo  repository.GroupRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewUpdateLeagueUseCase creates a new UpdateLeagueUseCase
+func NewUpdateLeagueUseCase(groupRepo repository.GroupRepository, leagueRepo repository.GroupLeagueRepository) *UpdateLeagueUseCase {
+	return &UpdateLeagueUseCase{
+		groupRepo:  groupRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute updates a league's details, season and point scheme.
+// Changing the point scheme rescores every recorded result. The season cannot
+// be moved so that an event already linked to the league falls outside it.
+func (uc *UpdateLeagueUseCase) Execute(ctx context.Context, req *UpdateLeagueRequest) (*domain.GroupLeague, error) {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	league, err := getGroupLeague(ctx, uc.leagueRepo, req.GroupID, req.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		league.Name = *req.Name
+	}
+	if req.Description != nil {
+		league.Description = req.Description
+	}
+	if req.SeasonStart != nil {
+		league.SeasonStart = req.SeasonStart.UTC()
+	}
+	if req.SeasonEnd != nil {
+		league.SeasonEnd = req.SeasonEnd.UTC()
+	}
+	if req.PointScheme != nil {
+		league.PointScheme = *req.PointScheme
+	}
+	league.UpdatedAt = time.Now().UTC()
+
+	if err := league.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Linked events must stay within the season, as they had to be when they were linked
+	if req.SeasonStart != nil || req.SeasonEnd != nil {
+		outside, err := uc.leagueRepo.HasEventsOutsideSeason(ctx, league.ID, league.SeasonStart, league.SeasonEnd)
+		if err != nil {
+			return nil, err
+		}
+		if outside {
+			return nil, ErrLeagueSeasonExcludesEvent
+		}
+	}
+
+	if err := uc.leagueRepo.Update(ctx, league); err != nil {
+		return nil, err
+	}
+
+	return league, nil
+}
+
+// DeleteLeagueUseCase handles league deletion
+type DeleteLeagueUseCase struct {
+	groupRepo  repository.GroupRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewDeleteLeagueUseCase creates a new DeleteLeagueUseCase
+func NewDeleteLeagueUseCase(groupRepo repository.GroupRepository, leagueRepo repository.GroupLeagueRepository) *DeleteLeagueUseCase {
+	return &DeleteLeagueUseCase{
+		groupRepo:  groupRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute deletes a league with its linked events and results; the events themselves are kept
+func (uc *DeleteLeagueUseCase) Execute(ctx context.Context, req *DeleteLeagueRequest) error {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return err
+	}
+
+	if _, err := getGroupLeague(ctx, uc.leagueRepo, req.GroupID, req.LeagueID); err != nil {
+		return err
+	}
+
+	return uc.leagueRepo.Delete(ctx, req.LeagueID)
+}
+
+// ListGroupLeaguesUseCase handles listing a group's leagues
+type ListGroupLeaguesUseCase struct {
+	groupRepo  repository.GroupRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewListGroupLeaguesUseCase creates a new ListGroupLeaguesUseCase
+func NewListGroupLeaguesUseCase(groupRepo repository.GroupRepository, leagueRepo repository.GroupLeagueRepository) *ListGroupLeaguesUseCase {
+	return &ListGroupLeaguesUseCase{
+		groupRepo:  groupRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute returns the leagues of a group to users who can access it
+func (uc *ListGroupLeaguesUseCase) Execute(ctx context.Context, req *ListGroupLeaguesRequest) (*ListGroupLeaguesResponse, error) {
+	if err := checkGroupAccess(ctx, uc.groupRepo, req.GroupID, req.RequesterID); err != nil {
+		return nil, err
+	}
+
+	leagues, err := uc.leagueRepo.GetByGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListGroupLeaguesResponse{
+		Leagues: leagues,
+	}, nil
+}
+
+// LinkLeagueEventUseCase handles adding group events to a league
+type LinkLeagueEventUseCase struct {
+	groupRepo  repository.GroupRepository
+	eventRepo  repository.EventRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewLinkLeagueEventUseCase creates a new LinkLeagueEventUseCase
+func NewLinkLeagueEventUseCase(groupRepo repository.GroupRepository, eventRepo repository.EventRepository, leagueRepo repository.GroupLeagueRepository) *LinkLeagueEventUseCase {
+	return &LinkLeagueEventUseCase{
+		groupRepo:  groupRepo,
+		eventRepo:  eventRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute links a group event that starts within the season to the league
+func (uc *LinkLeagueEventUseCase) Execute(ctx context.Context, req *LeagueEventRequest) error {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return err
+	}
+
+	league, err := getGroupLeague(ctx, uc.leagueRepo, req.GroupID, req.LeagueID)
+	if err != nil {
+		return err
+	}
+
+	event, err := uc.eventRepo.GetByID(ctx, req.EventID)
+	if err != nil {
+		return err
+	}
+	if event == nil {
+		return ErrEventNotFound
+	}
+
+	// Only events listed by GetGroupEvents for this group can count towards its leagues
+	if event.GroupID == nil || *event.GroupID != league.GroupID {
+		return ErrEventNotInGroup
+	}
+
+	if !league.IsInSeason(event.StartAt) {
+		return ErrEventOutsideLeagueSeason
+	}
+
+	linkedLeagueID, err := uc.leagueRepo.GetLeagueIDForEvent(ctx, req.EventID)
+	if err != nil {
+		return err
+	}
+	if linkedLeagueID != nil {
+		return ErrEventAlreadyInLeague
+	}
+
+	return uc.leagueRepo.LinkEvent(ctx, req.LeagueID, req.EventID, time.Now().UTC())
+}
+
+// UnlinkLeagueEventUseCase handles removing events from a league
+type UnlinkLeagueEventUseCase struct {
+	groupRepo  repository.GroupRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewUnlinkLeagueEventUseCase creates a new UnlinkLeagueEventUseCase
+func NewUnlinkLeagueEventUseCase(groupRepo repository.GroupRepository, leagueRepo repository.GroupLeagueRepository) *UnlinkLeagueEventUseCase {
+	return &UnlinkLeagueEventUseCase{
+		groupRepo:  groupRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute removes an event and its recorded results from the league
+func (uc *UnlinkLeagueEventUseCase) Execute(ctx context.Context, req *LeagueEventRequest) error {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return err
+	}
+
+	if _, err := getGroupLeague(ctx, uc.leagueRepo, req.GroupID, req.LeagueID); err != nil {
+		return err
+	}
+
+	linked, err := uc.leagueRepo.IsEventLinked(ctx, req.LeagueID, req.EventID)
+	if err != nil {
+		return err
+	}
+	if !linked {
+		return ErrLeagueEventNotFound
+	}
+
+	return uc.leagueRepo.UnlinkEvent(ctx, req.LeagueID, req.EventID)
+}
+
+// GetLeagueEventsUseCase handles retrieving the events of a league
+type GetLeagueEventsUseCase struct {
+	groupRepo  repository.GroupRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewGetLeagueEventsUseCase creates a new GetLeagueEventsUseCase
+func NewGetLeagueEventsUseCase(groupRepo repository.GroupRepository, leagueRepo repository.GroupLeagueRepository) *GetLeagueEventsUseCase {
+	return &GetLeagueEventsUseCase{
+		groupRepo:  groupRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute retrieves league events with the same privacy controls as group events
+func (uc *GetLeagueEventsUseCase) Execute(ctx context.Context, req *GetLeagueEventsRequest) (*GetGroupEventsResponse, error) {
+	if err := checkGroupAccess(ctx, uc.groupRepo, req.GroupID, req.RequesterID); err != nil {
+		return nil, err
+	}
+
+	if _, err := getGroupLeague(ctx, uc.leagueRepo, req.GroupID, req.LeagueID); err != nil {
+		return nil, err
+	}
+
+	events, err := uc.leagueRepo.GetLeagueEvents(ctx, req.LeagueID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetGroupEventsResponse{
+		Events: events,
+	}, nil
+}
+
+// RecordLeagueResultsUseCase handles recording the results of league events
+type RecordLeagueResultsUseCase struct {
+	groupRepo  repository.GroupRepository
+	eventRepo  repository.EventRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewRecordLeagueResultsUseCase creates a new RecordLeagueResultsUseCase
+func NewRecordLeagueResultsUseCase(groupRepo repository.GroupRepository, eventRepo repository.EventRepository, leagueRepo repository.GroupLeagueRepository) *RecordLeagueResultsUseCase {
+	return &RecordLeagueResultsUseCase{
+		groupRepo:  groupRepo,
+		eventRepo:  eventRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute records the results of a league event, replacing any previously recorded results.
+// Every player must be going to or checked in at the event.
+func (uc *RecordLeagueResultsUseCase) Execute(ctx context.Context, req *RecordLeagueResultsRequest) (*RecordLeagueResultsResponse, error) {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	if _, err := getGroupLeague(ctx, uc.leagueRepo, req.GroupID, req.LeagueID); err != nil {
+		return nil, err
+	}
+
+	linked, err := uc.leagueRepo.IsEventLinked(ctx, req.LeagueID, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if !linked {
+		return nil, ErrLeagueEventNotFound
+	}
+
+	now := time.Now().UTC()
+	players := make(map[uuid.UUID]bool, len(req.Results))
+	placements := make(map[int]bool, len(req.Results))
+	results := make([]*domain.LeagueResult, 0, len(req.Results))
+
+	for _, playerResult := range req.Results {
+		if players[playerResult.UserID] {
+			return nil, ErrDuplicateLeaguePlayer
+		}
+		players[playerResult.UserID] = true
+
+		if playerResult.Placement != nil {
+			if placements[*playerResult.Placement] {
+				return nil, ErrDuplicateLeaguePlacement
+			}
+			placements[*playerResult.Placement] = true
+		}
+
+		rsvp, err := uc.eventRepo.GetRSVP(ctx, req.EventID, playerResult.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if rsvp == nil || (rsvp.Status != domain.RSVPStatusGoing && !rsvp.IsCheckedIn()) {
+			return nil, ErrLeaguePlayerNotAttending
+		}
+
+		result := &domain.LeagueResult{
+			LeagueID:   req.LeagueID,
+			EventID:    req.EventID,
+			UserID:     playerResult.UserID,
+			Placement:  playerResult.Placement,
+			RecordedBy: req.UserID,
+			RecordedAt: now,
+		}
+		if err := result.Validate(); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if err := uc.leagueRepo.ReplaceEventResults(ctx, req.LeagueID, req.EventID, results); err != nil {
+		return nil, err
+	}
+
+	return &RecordLeagueResultsResponse{
+		Results: results,
+	}, nil
+}
+
+// GetLeagueStandingsUseCase handles computing league tables
+type GetLeagueStandingsUseCase struct {
+	groupRepo  repository.GroupRepository
+	leagueRepo repository.GroupLeagueRepository
+}
+
+// NewGetLeagueStandingsUseCase creates a new GetLeagueStandingsUseCase
+func NewGetLeagueStandingsUseCase(groupRepo repository.GroupRepository, leagueRepo repository.GroupLeagueRepository) *GetLeagueStandingsUseCase {
+	return &GetLeagueStandingsUseCase{
+		groupRepo:  groupRepo,
+		leagueRepo: leagueRepo,
+	}
+}
+
+// Execute computes the league table from all results recorded so far
+func (uc *GetLeagueStandingsUseCase) Execute(ctx context.Context, req *GetLeagueStandingsRequest) (*GetLeagueStandingsResponse, error) {
+	if err := checkGroupAccess(ctx, uc.groupRepo, req.GroupID, req.RequesterID); err != nil {
+		return nil, err
+	}
+
+	league, err := getGroupLeague(ctx, uc.leagueRepo, req.GroupID, req.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := uc.leagueRepo.GetResults(ctx, req.LeagueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetLeagueStandingsResponse{
+		League:    league,
+		Standings: domain.ComputeLeagueStandings(league.PointScheme, results),
+	}, nil
+}
+
+// checkGroupAccess verifies that the group exists and the user can see its content
+func checkGroupAccess(ctx context.Context, groupRepo repository.GroupRepository, groupID, userID uuid.UUID) error {
+	group, err := groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return ErrGroupNotFound
+	}
+
+	canAccess, err := groupRepo.CanUserAccessGroup(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if !canAccess {
+		return ErrUnauthorizedGroupAccess
+	}
+
+	return nil
+}
+
+// getGroupLeague retrieves a league, reporting leagues of other groups as missing
+func getGroupLeague(ctx context.Context, leagueRepo repository.GroupLeagueRepository, groupID, leagueID uuid.UUID) (*domain.GroupLeague, error) {
+	league, err := leagueRepo.GetByID(ctx, leagueID)
+	if err != nil {
+		return nil, err
+	}
+	if league == nil || league.GroupID != groupID {
+		return nil, ErrLeagueNotFound
+	}
+
+	return league, nil
+}
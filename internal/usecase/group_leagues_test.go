@@ -0,0 +1,500 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGroupLeagueRepository is a mock implementation of GroupLeagueRepository
+type MockGroupLeagueRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupLeagueRepository) Create(ctx context.Context, league *domain.GroupLeague) error {
+	args := m.Called(ctx, league)
+	return args.Error(0)
+}
+
+func (m *MockGroupLeagueRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupLeague, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupLeague), args.Error(1)
+}
+
+func (m *MockGroupLeagueRepository) Update(ctx context.Context, league *domain.GroupLeague) error {
+	args := m.Called(ctx, league)
+	return args.Error(0)
+}
+
+func (m *MockGroupLeagueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockGroupLeagueRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupLeague, error) {
+	args := m.Called(ctx, groupID)
+	return args.Get(0).([]*domain.GroupLeague), args.Error(1)
+}
+
+func (m *MockGroupLeagueRepository) LinkEvent(ctx context.Context, leagueID, eventID uuid.UUID, linkedAt time.Time) error {
+	args := m.Called(ctx, leagueID, eventID, linkedAt)
+	return args.Error(0)
+}
+
+func (m *MockGroupLeagueRepository) UnlinkEvent(ctx context.Context, leagueID, eventID uuid.UUID) error {
+	args := m.Called(ctx, leagueID, eventID)
+	return args.Error(0)
+}
+
+func (m *MockGroupLeagueRepository) IsEventLinked(ctx context.Context, leagueID, eventID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, leagueID, eventID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGroupLeagueRepository) GetLeagueIDForEvent(ctx context.Context, eventID uuid.UUID) (*uuid.UUID, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*uuid.UUID), args.Error(1)
+}
+
+func (m *MockGroupLeagueRepository) GetLeagueEvents(ctx context.Context, leagueID uuid.UUID, limit, offset int) ([]*domain.Event, error) {
+	args := m.Called(ctx, leagueID, limit, offset)
+	return args.Get(0).([]*domain.Event), args.Error(1)
+}
+
+func (m *MockGroupLeagueRepository) HasEventsOutsideSeason(ctx context.Context, leagueID uuid.UUID, seasonStart, seasonEnd time.Time) (bool, error) {
+	args := m.Called(ctx, leagueID, seasonStart, seasonEnd)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockGroupLeagueRepository) ReplaceEventResults(ctx context.Context, leagueID, eventID uuid.UUID, results []*domain.LeagueResult) error {
+	args := m.Called(ctx, leagueID, eventID, results)
+	return args.Error(0)
+}
+
+func (m *MockGroupLeagueRepository) GetResults(ctx context.Context, leagueID uuid.UUID) ([]*domain.LeagueResult, error) {
+	args := m.Called(ctx, leagueID)
+	return args.Get(0).([]*domain.LeagueResult), args.Error(1)
+}
+
+func (m *MockGroupLeagueRepository) GetEventResults(ctx context.Context, leagueID, eventID uuid.UUID) ([]*domain.LeagueResult, error) {
+	args := m.Called(ctx, leagueID, eventID)
+	return args.Get(0).([]*domain.LeagueResult), args.Error(1)
+}
+
+func testLeague(groupID uuid.UUID) *domain.GroupLeague {
+	seasonStart := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	return &domain.GroupLeague{
+		ID:          uuid.New(),
+		GroupID:     groupID,
+		Name:        "October League",
+		SeasonStart: seasonStart,
+		SeasonEnd:   seasonStart.AddDate(0, 1, 0),
+		PointScheme: domain.LeaguePointScheme{
+			PlacementPoints:     []int{3, 2, 1},
+			ParticipationPoints: 1,
+		},
+	}
+}
+
+func TestCreateLeagueUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("admin creates league", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockLeagueRepo := new(MockGroupLeagueRepository)
+		useCase := NewCreateLeagueUseCase(mockGroupRepo, mockLeagueRepo)
+
+		groupID := uuid.New()
+		adminID := uuid.New()
+		seasonStart := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, adminID).Return(true, nil)
+		mockLeagueRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupLeague")).Return(nil)
+
+		league, err := useCase.Execute(ctx, &CreateLeagueRequest{
+			GroupID:     groupID,
+			UserID:      adminID,
+			Name:        "October League",
+			SeasonStart: seasonStart,
+			SeasonEnd:   seasonStart.AddDate(0, 1, 0),
+			PointScheme: domain.LeaguePointScheme{PlacementPoints: []int{3, 2, 1}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, groupID, league.GroupID)
+		assert.Equal(t, adminID, league.CreatedBy)
+		mockLeagueRepo.AssertExpectations(t)
+	})
+
+	t.Run("member cannot create league", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockLeagueRepo := new(MockGroupLeagueRepository)
+		useCase := NewCreateLeagueUseCase(mockGroupRepo, mockLeagueRepo)
+
+		groupID := uuid.New()
+		memberID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, memberID).Return(false, nil)
+
+		_, err := useCase.Execute(ctx, &CreateLeagueRequest{
+			GroupID: groupID,
+			UserID:  memberID,
+			Name:    "October League",
+		})
+
+		assert.Equal(t, ErrUnauthorizedGroupAccess, err)
+		mockLeagueRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestLinkLeagueEventUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(groupID, adminID uuid.UUID, league *domain.GroupLeague, event *domain.Event) (*MockGroupLeagueRepository, *LinkLeagueEventUseCase) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockLeagueRepo := new(MockGroupLeagueRepository)
+		useCase := NewLinkLeagueEventUseCase(mockGroupRepo, mockEventRepo, mockLeagueRepo)
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, adminID).Return(true, nil)
+		mockLeagueRepo.On("GetByID", ctx, league.ID).Return(league, nil)
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+
+		return mockLeagueRepo, useCase
+	}
+
+	t.Run("links group event in season", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		league := testLeague(groupID)
+		event := &domain.Event{ID: uuid.New(), GroupID: &groupID, StartAt: league.SeasonStart.AddDate(0, 0, 7)}
+		mockLeagueRepo, useCase := setup(groupID, adminID, league, event)
+
+		mockLeagueRepo.On("GetLeagueIDForEvent", ctx, event.ID).Return(nil, nil)
+		mockLeagueRepo.On("LinkEvent", ctx, league.ID, event.ID, mock.AnythingOfType("time.Time")).Return(nil)
+
+		err := useCase.Execute(ctx, &LeagueEventRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  event.ID,
+			UserID:   adminID,
+		})
+
+		assert.NoError(t, err)
+		mockLeagueRepo.AssertExpectations(t)
+	})
+
+	t.Run("event from another group", func(t *testing.T) {
+		groupID := uuid.New()
+		otherGroupID := uuid.New()
+		adminID := uuid.New()
+		league := testLeague(groupID)
+		event := &domain.Event{ID: uuid.New(), GroupID: &otherGroupID, StartAt: league.SeasonStart.AddDate(0, 0, 7)}
+		_, useCase := setup(groupID, adminID, league, event)
+
+		err := useCase.Execute(ctx, &LeagueEventRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  event.ID,
+			UserID:   adminID,
+		})
+
+		assert.Equal(t, ErrEventNotInGroup, err)
+	})
+
+	t.Run("event outside season", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		league := testLeague(groupID)
+		event := &domain.Event{ID: uuid.New(), GroupID: &groupID, StartAt: league.SeasonEnd.AddDate(0, 0, 1)}
+		_, useCase := setup(groupID, adminID, league, event)
+
+		err := useCase.Execute(ctx, &LeagueEventRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  event.ID,
+			UserID:   adminID,
+		})
+
+		assert.Equal(t, ErrEventOutsideLeagueSeason, err)
+	})
+
+	t.Run("event already in a league", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		league := testLeague(groupID)
+		event := &domain.Event{ID: uuid.New(), GroupID: &groupID, StartAt: league.SeasonStart.AddDate(0, 0, 7)}
+		mockLeagueRepo, useCase := setup(groupID, adminID, league, event)
+
+		otherLeagueID := uuid.New()
+		mockLeagueRepo.On("GetLeagueIDForEvent", ctx, event.ID).Return(&otherLeagueID, nil)
+
+		err := useCase.Execute(ctx, &LeagueEventRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  event.ID,
+			UserID:   adminID,
+		})
+
+		assert.Equal(t, ErrEventAlreadyInLeague, err)
+		mockLeagueRepo.AssertNotCalled(t, "LinkEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUpdateLeagueUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(groupID, adminID uuid.UUID, league *domain.GroupLeague) (*MockGroupLeagueRepository, *UpdateLeagueUseCase) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockLeagueRepo := new(MockGroupLeagueRepository)
+		useCase := NewUpdateLeagueUseCase(mockGroupRepo, mockLeagueRepo)
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, adminID).Return(true, nil)
+		mockLeagueRepo.On("GetByID", ctx, league.ID).Return(league, nil)
+
+		return mockLeagueRepo, useCase
+	}
+
+	t.Run("extends the season", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		league := testLeague(groupID)
+		mockLeagueRepo, useCase := setup(groupID, adminID, league)
+
+		seasonEnd := league.SeasonEnd.AddDate(0, 1, 0)
+		mockLeagueRepo.On("HasEventsOutsideSeason", ctx, league.ID, league.SeasonStart, seasonEnd).Return(false, nil)
+		mockLeagueRepo.On("Update", ctx, league).Return(nil)
+
+		updated, err := useCase.Execute(ctx, &UpdateLeagueRequest{
+			GroupID:   groupID,
+			LeagueID:  league.ID,
+			UserID:    adminID,
+			SeasonEnd: &seasonEnd,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, seasonEnd, updated.SeasonEnd)
+		mockLeagueRepo.AssertExpectations(t)
+	})
+
+	t.Run("season would exclude a linked event", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		league := testLeague(groupID)
+		mockLeagueRepo, useCase := setup(groupID, adminID, league)
+
+		seasonStart := league.SeasonStart.AddDate(0, 0, 14)
+		mockLeagueRepo.On("HasEventsOutsideSeason", ctx, league.ID, seasonStart, league.SeasonEnd).Return(true, nil)
+
+		_, err := useCase.Execute(ctx, &UpdateLeagueRequest{
+			GroupID:     groupID,
+			LeagueID:    league.ID,
+			UserID:      adminID,
+			SeasonStart: &seasonStart,
+		})
+
+		assert.Equal(t, ErrLeagueSeasonExcludesEvent, err)
+		mockLeagueRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRecordLeagueResultsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(groupID, adminID uuid.UUID, league *domain.GroupLeague, eventID uuid.UUID) (*MockGroupLeagueRepository, *MockEventRepository, *RecordLeagueResultsUseCase) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockLeagueRepo := new(MockGroupLeagueRepository)
+		useCase := NewRecordLeagueResultsUseCase(mockGroupRepo, mockEventRepo, mockLeagueRepo)
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, adminID).Return(true, nil)
+		mockLeagueRepo.On("GetByID", ctx, league.ID).Return(league, nil)
+		mockLeagueRepo.On("IsEventLinked", ctx, league.ID, eventID).Return(true, nil)
+
+		return mockLeagueRepo, mockEventRepo, useCase
+	}
+
+	attending := func(mockEventRepo *MockEventRepository, eventID, userID uuid.UUID) {
+		mockEventRepo.On("GetRSVP", ctx, eventID, userID).Return(&domain.EventRSVP{
+			EventID: eventID,
+			UserID:  userID,
+			Status:  domain.RSVPStatusGoing,
+		}, nil)
+	}
+
+	t.Run("replaces event results", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		eventID := uuid.New()
+		league := testLeague(groupID)
+		mockLeagueRepo, mockEventRepo, useCase := setup(groupID, adminID, league, eventID)
+
+		winnerID := uuid.New()
+		playerID := uuid.New()
+		attending(mockEventRepo, eventID, winnerID)
+		attending(mockEventRepo, eventID, playerID)
+		mockLeagueRepo.On("ReplaceEventResults", ctx, league.ID, eventID, mock.AnythingOfType("[]*domain.LeagueResult")).Return(nil)
+
+		first := 1
+		resp, err := useCase.Execute(ctx, &RecordLeagueResultsRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  eventID,
+			UserID:   adminID,
+			Results: []LeaguePlayerResult{
+				{UserID: winnerID, Placement: &first},
+				{UserID: playerID},
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Results, 2)
+		assert.Equal(t, adminID, resp.Results[0].RecordedBy)
+		mockLeagueRepo.AssertExpectations(t)
+	})
+
+	t.Run("duplicate placement", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		eventID := uuid.New()
+		league := testLeague(groupID)
+		mockLeagueRepo, mockEventRepo, useCase := setup(groupID, adminID, league, eventID)
+
+		winnerID := uuid.New()
+		attending(mockEventRepo, eventID, winnerID)
+
+		first := 1
+		_, err := useCase.Execute(ctx, &RecordLeagueResultsRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  eventID,
+			UserID:   adminID,
+			Results: []LeaguePlayerResult{
+				{UserID: winnerID, Placement: &first},
+				{UserID: uuid.New(), Placement: &first},
+			},
+		})
+
+		assert.Equal(t, ErrDuplicateLeaguePlacement, err)
+		mockLeagueRepo.AssertNotCalled(t, "ReplaceEventResults", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("player who did not attend", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		eventID := uuid.New()
+		league := testLeague(groupID)
+		mockLeagueRepo, mockEventRepo, useCase := setup(groupID, adminID, league, eventID)
+
+		declinedID := uuid.New()
+		mockEventRepo.On("GetRSVP", ctx, eventID, declinedID).Return(&domain.EventRSVP{
+			EventID: eventID,
+			UserID:  declinedID,
+			Status:  domain.RSVPStatusDeclined,
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &RecordLeagueResultsRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  eventID,
+			UserID:   adminID,
+			Results:  []LeaguePlayerResult{{UserID: declinedID}},
+		})
+
+		assert.Equal(t, ErrLeaguePlayerNotAttending, err)
+		mockLeagueRepo.AssertNotCalled(t, "ReplaceEventResults", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("player without an RSVP", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		eventID := uuid.New()
+		league := testLeague(groupID)
+		_, mockEventRepo, useCase := setup(groupID, adminID, league, eventID)
+
+		strangerID := uuid.New()
+		mockEventRepo.On("GetRSVP", ctx, eventID, strangerID).Return(nil, nil)
+
+		_, err := useCase.Execute(ctx, &RecordLeagueResultsRequest{
+			GroupID:  groupID,
+			LeagueID: league.ID,
+			EventID:  eventID,
+			UserID:   adminID,
+			Results:  []LeaguePlayerResult{{UserID: strangerID}},
+		})
+
+		assert.Equal(t, ErrLeaguePlayerNotAttending, err)
+	})
+}
+
+func TestGetLeagueStandingsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("member sees standings", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockLeagueRepo := new(MockGroupLeagueRepository)
+		useCase := NewGetLeagueStandingsUseCase(mockGroupRepo, mockLeagueRepo)
+
+		groupID := uuid.New()
+		memberID := uuid.New()
+		league := testLeague(groupID)
+		winner := uuid.New()
+		first := 1
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserAccessGroup", ctx, groupID, memberID).Return(true, nil)
+		mockLeagueRepo.On("GetByID", ctx, league.ID).Return(league, nil)
+		mockLeagueRepo.On("GetResults", ctx, league.ID).Return([]*domain.LeagueResult{
+			{LeagueID: league.ID, EventID: uuid.New(), UserID: winner, Placement: &first},
+		}, nil)
+
+		resp, err := useCase.Execute(ctx, &GetLeagueStandingsRequest{
+			GroupID:     groupID,
+			LeagueID:    league.ID,
+			RequesterID: memberID,
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Standings, 1)
+		assert.Equal(t, winner, resp.Standings[0].UserID)
+		assert.Equal(t, 4, resp.Standings[0].Points)
+	})
+
+	t.Run("league from another group", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockLeagueRepo := new(MockGroupLeagueRepository)
+		useCase := NewGetLeagueStandingsUseCase(mockGroupRepo, mockLeagueRepo)
+
+		groupID := uuid.New()
+		memberID := uuid.New()
+		league := testLeague(uuid.New())
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserAccessGroup", ctx, groupID, memberID).Return(true, nil)
+		mockLeagueRepo.On("GetByID", ctx, league.ID).Return(league, nil)
+
+		_, err := useCase.Execute(ctx, &GetLeagueStandingsRequest{
+			GroupID:     groupID,
+			LeagueID:    league.ID,
+			RequesterID: memberID,
+		})
+
+		assert.Equal(t, ErrLeagueNotFound, err)
+	})
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,13 +20,20 @@ var (
 	ErrInsufficientPermissions = errors.New("insufficient permissions")
 	ErrCannotRemoveOwner       = errors.New("cannot remove group owner")
 	ErrInvalidRoleTransition   = errors.New("invalid role transition")
+	ErrAlreadyGroupMember      = errors.New("user is already a member of the group")
 )
 
 // CreateGroupRequest represents the request to create a new group
 type CreateGroupRequest struct {
-	Name        string    `json:"name" validate:"required,max=100"`
-	Description *string   `json:"description,omitempty" validate:"max=1000"`
-	OwnerUserID uuid.UUID `json:"owner_user_id" validate:"required"`
+	Name        string                 `json:"name" validate:"required,max=100"`
+	Description *string                `json:"description,omitempty" validate:"max=1000"`
+	OwnerUserID uuid.UUID              `json:"owner_user_id" validate:"required"`
+	Visibility  domain.GroupVisibility `json:"visibility,omitempty"`
+	City        *string                `json:"city,omitempty"`
+	Country     *string                `json:"country,omitempty"`
+	Latitude    *float64               `json:"latitude,omitempty"`
+	Longitude   *float64               `json:"longitude,omitempty"`
+	Games       []domain.GameType      `json:"games,omitempty"`
 }
 
 // CreateGroupResponse represents the response after successful group creation
@@ -35,10 +43,16 @@ type CreateGroupResponse struct {
 
 // UpdateGroupRequest represents the request to update a group
 type UpdateGroupRequest struct {
-	GroupID     uuid.UUID `json:"group_id" validate:"required"`
-	Name        *string   `json:"name,omitempty" validate:"max=100"`
-	Description *string   `json:"description,omitempty" validate:"max=1000"`
-	UserID      uuid.UUID `json:"user_id" validate:"required"` // User making the request
+	GroupID     uuid.UUID               `json:"group_id" validate:"required"`
+	Name        *string                 `json:"name,omitempty" validate:"max=100"`
+	Description *string                 `json:"description,omitempty" validate:"max=1000"`
+	Visibility  *domain.GroupVisibility `json:"visibility,omitempty"`
+	City        *string                 `json:"city,omitempty"`
+	Country     *string                 `json:"country,omitempty"`
+	Latitude    *float64                `json:"latitude,omitempty"`
+	Longitude   *float64                `json:"longitude,omitempty"`
+	Games       []domain.GameType       `json:"games,omitempty"`             // nil leaves the games unchanged
+	UserID      uuid.UUID               `json:"user_id" validate:"required"` // User making the request
 }
 
 // UpdateGroupResponse represents the response after successful group update
@@ -77,12 +91,24 @@ func (uc *CreateGroupUseCase) Execute(ctx context.Context, req *CreateGroupReque
 		return nil, ErrInvalidGroupOwner
 	}
 
+	// Groups are private unless explicitly listed
+	visibility := req.Visibility
+	if visibility == "" {
+		visibility = domain.GroupVisibilityPrivate
+	}
+
 	// Create group entity
 	group := &domain.Group{
 		ID:          uuid.New(),
 		Name:        req.Name,
 		Description: req.Description,
 		OwnerUserID: req.OwnerUserID,
+		Visibility:  visibility,
+		City:        req.City,
+		Country:     req.Country,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		Games:       req.Games,
 		CreatedAt:   time.Now().UTC(),
 		UpdatedAt:   time.Now().UTC(),
 		IsActive:    true,
@@ -142,6 +168,24 @@ func (uc *UpdateGroupUseCase) Execute(ctx context.Context, req *UpdateGroupReque
 	if req.Description != nil {
 		group.Description = req.Description
 	}
+	if req.Visibility != nil {
+		group.Visibility = *req.Visibility
+	}
+	if req.City != nil {
+		group.City = req.City
+	}
+	if req.Country != nil {
+		group.Country = req.Country
+	}
+	if req.Latitude != nil {
+		group.Latitude = req.Latitude
+	}
+	if req.Longitude != nil {
+		group.Longitude = req.Longitude
+	}
+	if req.Games != nil {
+		group.Games = req.Games
+	}
 
 	group.UpdatedAt = time.Now().UTC()
 
@@ -162,7 +206,8 @@ func (uc *UpdateGroupUseCase) Execute(ctx context.Context, req *UpdateGroupReque
 
 // DeleteGroupUseCase handles group deletion
 type DeleteGroupUseCase struct {
-	groupRepo repository.GroupRepository
+	groupRepo     repository.GroupRepository
+	auditRecorder AuditRecorder
 }
 
 // NewDeleteGroupUseCase creates a new DeleteGroupUseCase
@@ -197,10 +242,13 @@ func (uc *DeleteGroupUseCase) Execute(ctx context.Context, req *DeleteGroupReque
 		return err
 	}
 
+	recordAudit(ctx, uc.auditRecorder, auditChange(req.UserID, domain.AuditActionGroupDelete, domain.AuditTargetGroup, group.ID, nil, group, nil))
+
 	return nil
 }
 
-// Execute invites a user to join a group with the specified role
+// Execute invites a user to join a group with the specified role.
+// The user only becomes a member once the pending invite is accepted.
 func (uc *InviteGroupMemberUseCase) Execute(ctx context.Context, req *InviteGroupMemberRequest) (*InviteGroupMemberResponse, error) {
 	// Verify that the group exists
 	group, err := uc.groupRepo.GetByID(ctx, req.GroupID)
@@ -217,15 +265,15 @@ func (uc *InviteGroupMemberUseCase) Execute(ctx context.Context, req *InviteGrou
 		return nil, err
 	}
 	if user == nil {
-		return nil, errors.New("user to invite not found")
+		return nil, ErrUserNotFound
 	}
 
 	// Check if the inviter has permission to manage members
-	canManage, err := uc.groupRepo.CanUserManageGroup(ctx, req.GroupID, req.InviterID)
+	inviter, err := uc.groupRepo.GetMember(ctx, req.GroupID, req.InviterID)
 	if err != nil {
 		return nil, err
 	}
-	if !canManage {
+	if inviter == nil || !uc.permissionService.CanInviteMembers(req.GroupID, req.InviterID, []domain.GroupMember{*inviter}) {
 		return nil, ErrInsufficientPermissions
 	}
 
@@ -235,7 +283,21 @@ func (uc *InviteGroupMemberUseCase) Execute(ctx context.Context, req *InviteGrou
 		return nil, err
 	}
 	if isMember {
-		return nil, errors.New("user is already a member of the group")
+		return nil, ErrAlreadyGroupMember
+	}
+
+	// Banned users cannot be invited until the ban is lifted
+	if err := checkNotBanned(ctx, uc.moderationRepo, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	// Users who blocked each other cannot invite one another
+	blocked, err := uc.blockRepo.IsBlockedEitherWay(ctx, req.InviterID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, ErrUserBlocked
 	}
 
 	// Validate role assignment permissions
@@ -243,33 +305,59 @@ func (uc *InviteGroupMemberUseCase) Execute(ctx context.Context, req *InviteGrou
 		return nil, err
 	}
 
-	// Create group member entity
-	member := &domain.GroupMember{
-		GroupID:  req.GroupID,
-		UserID:   req.UserID,
-		Role:     req.Role,
-		JoinedAt: time.Now().UTC(),
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, err
 	}
 
-	// Validate member entity
-	if err := member.Validate(); err != nil {
-		return nil, err
+	now := time.Now().UTC()
+	invitedUserID := req.UserID
+	invite := &domain.GroupInvite{
+		ID:            uuid.New(),
+		GroupID:       req.GroupID,
+		InvitedUserID: &invitedUserID,
+		InviterID:     req.InviterID,
+		Role:          req.Role,
+		TokenHash:     domain.HashInviteToken(token),
+		Status:        domain.GroupInviteStatusPending,
+		ExpiresAt:     now.Add(inviteExpiry(req.ExpiresIn)),
+		CreatedAt:     now,
+		UpdatedAt:     now,
 	}
 
-	// Add member to repository
-	if err := uc.groupRepo.AddMember(ctx, member); err != nil {
+	// Validate invite entity
+	if err := invite.Validate(); err != nil {
 		return nil, err
 	}
 
-	// If assigning owner role, handle ownership transfer
-	if req.Role == domain.GroupRoleOwner {
-		if err := uc.handleOwnershipTransfer(ctx, req.GroupID, req.InviterID, req.UserID); err != nil {
+	// Re-inviting replaces any pending invite, so only the latest token is valid
+	existing, err := uc.inviteRepo.GetPendingForUserInGroup(ctx, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing.Status = domain.GroupInviteStatusRevoked
+		existing.UpdatedAt = now
+		if err := uc.inviteRepo.Update(ctx, existing); err != nil {
 			return nil, err
 		}
 	}
 
+	// Store the invite
+	if err := uc.inviteRepo.Create(ctx, invite); err != nil {
+		return nil, err
+	}
+
+	// Notify the invited user; the raw token only travels in the notification
+	if uc.notifier != nil {
+		if err := uc.notifier.OnGroupInvite(ctx, req.GroupID, req.UserID, req.InviterID, req.Role, token); err != nil {
+			log.Printf("Failed to send group invite notification for group %s: %v", req.GroupID, err)
+		}
+	}
+
 	return &InviteGroupMemberResponse{
-		Member: member,
+		Invite: invite,
+		Token:  token,
 	}, nil
 }
 
@@ -293,32 +381,6 @@ func (uc *InviteGroupMemberUseCase) validateRoleAssignment(ctx context.Context,
 	return nil
 }
 
-// handleOwnershipTransfer transfers group ownership from current owner to new owner
-func (uc *InviteGroupMemberUseCase) handleOwnershipTransfer(ctx context.Context, groupID, currentOwnerID, newOwnerID uuid.UUID) error {
-	// Get the group to update ownership
-	group, err := uc.groupRepo.GetByID(ctx, groupID)
-	if err != nil {
-		return err
-	}
-	if group == nil {
-		return ErrGroupNotFound
-	}
-
-	// Update group owner
-	group.OwnerUserID = newOwnerID
-	group.UpdatedAt = time.Now().UTC()
-	if err := uc.groupRepo.Update(ctx, group); err != nil {
-		return err
-	}
-
-	// Demote previous owner to admin
-	if err := uc.groupRepo.UpdateMemberRole(ctx, groupID, currentOwnerID, domain.GroupRoleAdmin); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // Execute removes a member from a group
 func (uc *RemoveGroupMemberUseCase) Execute(ctx context.Context, req *RemoveGroupMemberRequest) error {
 	// Verify that the group exists
@@ -345,47 +407,37 @@ func (uc *RemoveGroupMemberUseCase) Execute(ctx context.Context, req *RemoveGrou
 	}
 
 	// Check permissions for removal
-	if err := uc.validateRemovalPermissions(ctx, req.GroupID, req.RemoverID, req.UserID); err != nil {
+	if err := uc.validateRemovalPermissions(ctx, req.GroupID, req.RemoverID, member); err != nil {
 		return err
 	}
 
-	// Remove member from repository
-	if err := uc.groupRepo.RemoveMember(ctx, req.GroupID, req.UserID); err != nil {
-		return err
+	// Leaving a group is not a moderation action
+	if req.RemoverID == req.UserID {
+		return uc.groupRepo.RemoveMember(ctx, req.GroupID, req.UserID)
 	}
 
-	return nil
+	// Remove member and record the removal in the moderation audit trail
+	action := domain.NewGroupModerationAction(req.GroupID, req.RemoverID, req.UserID, domain.GroupModerationActionRemoveMember, req.Reason, time.Now().UTC())
+	return uc.moderationRepo.RemoveMember(ctx, req.GroupID, req.UserID, action)
 }
 
 // validateRemovalPermissions checks if the remover can remove the specified member
-func (uc *RemoveGroupMemberUseCase) validateRemovalPermissions(ctx context.Context, groupID, removerID, userToRemoveID uuid.UUID) error {
+func (uc *RemoveGroupMemberUseCase) validateRemovalPermissions(ctx context.Context, groupID, removerID uuid.UUID, memberToRemove *domain.GroupMember) error {
 	// Users can always remove themselves
-	if removerID == userToRemoveID {
+	if removerID == memberToRemove.UserID {
 		return nil
 	}
 
-	// Check if remover has management permissions
-	canManage, err := uc.groupRepo.CanUserManageGroup(ctx, groupID, removerID)
+	remover, err := uc.groupRepo.GetMember(ctx, groupID, removerID)
 	if err != nil {
 		return err
 	}
-	if !canManage {
+	if remover == nil {
 		return ErrInsufficientPermissions
 	}
 
-	// Get roles for additional validation
-	removerRole, err := uc.groupRepo.GetMemberRole(ctx, groupID, removerID)
-	if err != nil {
-		return err
-	}
-
-	memberToRemoveRole, err := uc.groupRepo.GetMemberRole(ctx, groupID, userToRemoveID)
-	if err != nil {
-		return err
-	}
-
-	// Admins cannot remove other admins or owners, only owners can
-	if removerRole == domain.GroupRoleAdmin && (memberToRemoveRole == domain.GroupRoleAdmin || memberToRemoveRole == domain.GroupRoleOwner) {
+	// Owners remove anyone but owners; admins and members granted member management remove regular members only
+	if !uc.permissionService.CanRemoveMembers(groupID, removerID, []domain.GroupMember{*remover, *memberToRemove}, memberToRemove.UserID) {
 		return ErrInsufficientPermissions
 	}
 
@@ -428,6 +480,11 @@ func (uc *UpdateMemberRoleUseCase) Execute(ctx context.Context, req *UpdateMembe
 		return nil, err
 	}
 
+	if member.Role != req.NewRole {
+		recordAudit(ctx, uc.auditRecorder, auditChange(req.UpdaterID, domain.AuditActionRoleChange, domain.AuditTargetGroup, req.GroupID, &req.UserID,
+			map[string]interface{}{"role": member.Role}, map[string]interface{}{"role": req.NewRole}))
+	}
+
 	// Get updated member
 	updatedMember, err := uc.groupRepo.GetMember(ctx, req.GroupID, req.UserID)
 	if err != nil {
@@ -535,11 +592,13 @@ type InviteGroupMemberRequest struct {
 	UserID    uuid.UUID        `json:"user_id" validate:"required"`    // User to invite
 	Role      domain.GroupRole `json:"role" validate:"required"`       // Role to assign
 	InviterID uuid.UUID        `json:"inviter_id" validate:"required"` // User making the invitation
+	ExpiresIn *time.Duration   `json:"expires_in,omitempty"`           // Defaults to DefaultGroupInviteExpiry
 }
 
 // InviteGroupMemberResponse represents the response after successful member invitation
 type InviteGroupMemberResponse struct {
-	Member *domain.GroupMember `json:"member"`
+	Invite *domain.GroupInvite `json:"invite"`
+	Token  string              `json:"-"` // Raw token, only ever sent to the invited user
 }
 
 // RemoveGroupMemberRequest represents the request to remove a member from a group
@@ -547,6 +606,7 @@ type RemoveGroupMemberRequest struct {
 	GroupID   uuid.UUID `json:"group_id" validate:"required"`
 	UserID    uuid.UUID `json:"user_id" validate:"required"`    // User to remove
 	RemoverID uuid.UUID `json:"remover_id" validate:"required"` // User making the removal
+	Reason    *string   `json:"reason,omitempty" validate:"omitempty,max=500"`
 }
 
 // UpdateMemberRoleRequest represents the request to update a member's role
@@ -575,33 +635,47 @@ type GetGroupMembersResponse struct {
 
 // InviteGroupMemberUseCase handles group member invitations
 type InviteGroupMemberUseCase struct {
-	groupRepo repository.GroupRepository
-	userRepo  repository.UserRepository
+	groupRepo         repository.GroupRepository
+	userRepo          repository.UserRepository
+	inviteRepo        repository.GroupInviteRepository
+	moderationRepo    repository.GroupModerationRepository
+	blockRepo         repository.UserBlockRepository
+	notifier          GroupNotifier
+	permissionService *domain.PermissionService
 }
 
 // NewInviteGroupMemberUseCase creates a new InviteGroupMemberUseCase
-func NewInviteGroupMemberUseCase(groupRepo repository.GroupRepository, userRepo repository.UserRepository) *InviteGroupMemberUseCase {
+func NewInviteGroupMemberUseCase(groupRepo repository.GroupRepository, userRepo repository.UserRepository, inviteRepo repository.GroupInviteRepository, moderationRepo repository.GroupModerationRepository, blockRepo repository.UserBlockRepository) *InviteGroupMemberUseCase {
 	return &InviteGroupMemberUseCase{
-		groupRepo: groupRepo,
-		userRepo:  userRepo,
+		groupRepo:         groupRepo,
+		userRepo:          userRepo,
+		inviteRepo:        inviteRepo,
+		moderationRepo:    moderationRepo,
+		blockRepo:         blockRepo,
+		permissionService: domain.NewPermissionService(),
 	}
 }
 
 // RemoveGroupMemberUseCase handles group member removal
 type RemoveGroupMemberUseCase struct {
-	groupRepo repository.GroupRepository
+	groupRepo         repository.GroupRepository
+	moderationRepo    repository.GroupModerationRepository
+	permissionService *domain.PermissionService
 }
 
 // NewRemoveGroupMemberUseCase creates a new RemoveGroupMemberUseCase
-func NewRemoveGroupMemberUseCase(groupRepo repository.GroupRepository) *RemoveGroupMemberUseCase {
+func NewRemoveGroupMemberUseCase(groupRepo repository.GroupRepository, moderationRepo repository.GroupModerationRepository) *RemoveGroupMemberUseCase {
 	return &RemoveGroupMemberUseCase{
-		groupRepo: groupRepo,
+		groupRepo:         groupRepo,
+		moderationRepo:    moderationRepo,
+		permissionService: domain.NewPermissionService(),
 	}
 }
 
 // UpdateMemberRoleUseCase handles member role updates
 type UpdateMemberRoleUseCase struct {
-	groupRepo repository.GroupRepository
+	groupRepo     repository.GroupRepository
+	auditRecorder AuditRecorder
 }
 
 // NewUpdateMemberRoleUseCase creates a new UpdateMemberRoleUseCase
@@ -625,23 +699,10 @@ func NewGetGroupMembersUseCase(groupRepo repository.GroupRepository) *GetGroupMe
 
 // Execute retrieves group events with privacy controls
 func (uc *GetGroupEventsUseCase) Execute(ctx context.Context, req *GetGroupEventsRequest) (*GetGroupEventsResponse, error) {
-	// Verify that the group exists
-	group, err := uc.groupRepo.GetByID(ctx, req.GroupID)
-	if err != nil {
-		return nil, err
-	}
-	if group == nil {
-		return nil, ErrGroupNotFound
-	}
-
-	// Check if requester can access the group
-	canAccess, err := uc.groupRepo.CanUserAccessGroup(ctx, req.GroupID, req.RequesterID)
-	if err != nil {
+	// Verify that the group exists and the requester can access it
+	if err := checkGroupAccess(ctx, uc.groupRepo, req.GroupID, req.RequesterID); err != nil {
 		return nil, err
 	}
-	if !canAccess {
-		return nil, ErrUnauthorizedGroupAccess
-	}
 
 	// Get group events
 	events, err := uc.eventRepo.GetGroupEvents(ctx, req.GroupID, req.Limit, req.Offset)
@@ -691,6 +752,47 @@ type GroupManagementUseCase struct {
 	updateMemberRoleUseCase  *UpdateMemberRoleUseCase
 	getGroupMembersUseCase   *GetGroupMembersUseCase
 	getGroupEventsUseCase    *GetGroupEventsUseCase
+
+	createGroupInviteLinkUseCase *CreateGroupInviteLinkUseCase
+	acceptGroupInviteUseCase     *AcceptGroupInviteUseCase
+	declineGroupInviteUseCase    *DeclineGroupInviteUseCase
+	revokeGroupInviteUseCase     *RevokeGroupInviteUseCase
+	listGroupInvitesUseCase      *ListGroupInvitesUseCase
+	listUserInvitesUseCase       *ListUserInvitesUseCase
+
+	searchGroupsUseCase         *SearchGroupsUseCase
+	requestToJoinGroupUseCase   *RequestToJoinGroupUseCase
+	cancelJoinRequestUseCase    *CancelJoinRequestUseCase
+	listJoinRequestsUseCase     *ListJoinRequestsUseCase
+	listUserJoinRequestsUseCase *ListUserJoinRequestsUseCase
+	reviewJoinRequestUseCase    *ReviewJoinRequestUseCase
+
+	createLeagueUseCase        *CreateLeagueUseCase
+	updateLeagueUseCase        *UpdateLeagueUseCase
+	deleteLeagueUseCase        *DeleteLeagueUseCase
+	listGroupLeaguesUseCase    *ListGroupLeaguesUseCase
+	linkLeagueEventUseCase     *LinkLeagueEventUseCase
+	unlinkLeagueEventUseCase   *UnlinkLeagueEventUseCase
+	getLeagueEventsUseCase     *GetLeagueEventsUseCase
+	recordLeagueResultsUseCase *RecordLeagueResultsUseCase
+	getLeagueStandingsUseCase  *GetLeagueStandingsUseCase
+
+	createAnnouncementUseCase    *CreateAnnouncementUseCase
+	updateAnnouncementUseCase    *UpdateAnnouncementUseCase
+	setAnnouncementPinnedUseCase *SetAnnouncementPinnedUseCase
+	deleteAnnouncementUseCase    *DeleteAnnouncementUseCase
+	listAnnouncementsUseCase     *ListAnnouncementsUseCase
+
+	banGroupMemberUseCase   *BanGroupMemberUseCase
+	unbanGroupMemberUseCase *UnbanGroupMemberUseCase
+	listGroupBansUseCase    *ListGroupBansUseCase
+	getModerationLogUseCase *GetModerationLogUseCase
+
+	createGroupRoleUseCase *CreateGroupRoleUseCase
+	updateGroupRoleUseCase *UpdateGroupRoleUseCase
+	deleteGroupRoleUseCase *DeleteGroupRoleUseCase
+	listGroupRolesUseCase  *ListGroupRolesUseCase
+	assignGroupRoleUseCase *AssignGroupRoleUseCase
 }
 
 // NewGroupManagementUseCase creates a new unified group management use case
@@ -698,19 +800,82 @@ func NewGroupManagementUseCase(
 	groupRepo repository.GroupRepository,
 	userRepo repository.UserRepository,
 	eventRepo repository.EventRepository,
+	inviteRepo repository.GroupInviteRepository,
+	joinRequestRepo repository.GroupJoinRequestRepository,
+	leagueRepo repository.GroupLeagueRepository,
+	announcementRepo repository.GroupAnnouncementRepository,
+	moderationRepo repository.GroupModerationRepository,
+	roleRepo repository.GroupCustomRoleRepository,
+	blockRepo repository.UserBlockRepository,
 ) *GroupManagementUseCase {
 	return &GroupManagementUseCase{
 		createGroupUseCase:       NewCreateGroupUseCase(groupRepo, userRepo),
 		updateGroupUseCase:       NewUpdateGroupUseCase(groupRepo),
 		deleteGroupUseCase:       NewDeleteGroupUseCase(groupRepo),
-		inviteGroupMemberUseCase: NewInviteGroupMemberUseCase(groupRepo, userRepo),
-		removeGroupMemberUseCase: NewRemoveGroupMemberUseCase(groupRepo),
+		inviteGroupMemberUseCase: NewInviteGroupMemberUseCase(groupRepo, userRepo, inviteRepo, moderationRepo, blockRepo),
+		removeGroupMemberUseCase: NewRemoveGroupMemberUseCase(groupRepo, moderationRepo),
 		updateMemberRoleUseCase:  NewUpdateMemberRoleUseCase(groupRepo),
 		getGroupMembersUseCase:   NewGetGroupMembersUseCase(groupRepo),
 		getGroupEventsUseCase:    NewGetGroupEventsUseCase(groupRepo, eventRepo),
+
+		createGroupInviteLinkUseCase: NewCreateGroupInviteLinkUseCase(groupRepo, inviteRepo),
+		acceptGroupInviteUseCase:     NewAcceptGroupInviteUseCase(groupRepo, inviteRepo, moderationRepo),
+		declineGroupInviteUseCase:    NewDeclineGroupInviteUseCase(inviteRepo),
+		revokeGroupInviteUseCase:     NewRevokeGroupInviteUseCase(groupRepo, inviteRepo),
+		listGroupInvitesUseCase:      NewListGroupInvitesUseCase(groupRepo, inviteRepo),
+		listUserInvitesUseCase:       NewListUserInvitesUseCase(inviteRepo),
+
+		searchGroupsUseCase:         NewSearchGroupsUseCase(groupRepo),
+		requestToJoinGroupUseCase:   NewRequestToJoinGroupUseCase(groupRepo, joinRequestRepo, moderationRepo),
+		cancelJoinRequestUseCase:    NewCancelJoinRequestUseCase(joinRequestRepo),
+		listJoinRequestsUseCase:     NewListJoinRequestsUseCase(groupRepo, joinRequestRepo),
+		listUserJoinRequestsUseCase: NewListUserJoinRequestsUseCase(joinRequestRepo),
+		reviewJoinRequestUseCase:    NewReviewJoinRequestUseCase(groupRepo, joinRequestRepo, moderationRepo),
+
+		createLeagueUseCase:        NewCreateLeagueUseCase(groupRepo, leagueRepo),
+		updateLeagueUseCase:        NewUpdateLeagueUseCase(groupRepo, leagueRepo),
+		deleteLeagueUseCase:        NewDeleteLeagueUseCase(groupRepo, leagueRepo),
+		listGroupLeaguesUseCase:    NewListGroupLeaguesUseCase(groupRepo, leagueRepo),
+		linkLeagueEventUseCase:     NewLinkLeagueEventUseCase(groupRepo, eventRepo, leagueRepo),
+		unlinkLeagueEventUseCase:   NewUnlinkLeagueEventUseCase(groupRepo, leagueRepo),
+		getLeagueEventsUseCase:     NewGetLeagueEventsUseCase(groupRepo, leagueRepo),
+		recordLeagueResultsUseCase: NewRecordLeagueResultsUseCase(groupRepo, eventRepo, leagueRepo),
+		getLeagueStandingsUseCase:  NewGetLeagueStandingsUseCase(groupRepo, leagueRepo),
+
+		createAnnouncementUseCase:    NewCreateAnnouncementUseCase(groupRepo, announcementRepo),
+		updateAnnouncementUseCase:    NewUpdateAnnouncementUseCase(groupRepo, announcementRepo),
+		setAnnouncementPinnedUseCase: NewSetAnnouncementPinnedUseCase(groupRepo, announcementRepo),
+		deleteAnnouncementUseCase:    NewDeleteAnnouncementUseCase(groupRepo, announcementRepo),
+		listAnnouncementsUseCase:     NewListAnnouncementsUseCase(groupRepo, announcementRepo),
+
+		banGroupMemberUseCase:   NewBanGroupMemberUseCase(groupRepo, userRepo, moderationRepo),
+		unbanGroupMemberUseCase: NewUnbanGroupMemberUseCase(groupRepo, moderationRepo),
+		listGroupBansUseCase:    NewListGroupBansUseCase(groupRepo, moderationRepo),
+		getModerationLogUseCase: NewGetModerationLogUseCase(groupRepo, moderationRepo),
+
+		createGroupRoleUseCase: NewCreateGroupRoleUseCase(groupRepo, roleRepo),
+		updateGroupRoleUseCase: NewUpdateGroupRoleUseCase(groupRepo, roleRepo),
+		deleteGroupRoleUseCase: NewDeleteGroupRoleUseCase(groupRepo, roleRepo),
+		listGroupRolesUseCase:  NewListGroupRolesUseCase(groupRepo, roleRepo),
+		assignGroupRoleUseCase: NewAssignGroupRoleUseCase(groupRepo, roleRepo),
 	}
 }
 
+// SetNotifier enables notifications for group invitations, join requests and announcements
+func (uc *GroupManagementUseCase) SetNotifier(notifier GroupNotifier) {
+	uc.inviteGroupMemberUseCase.notifier = notifier
+	uc.requestToJoinGroupUseCase.notifier = notifier
+	uc.reviewJoinRequestUseCase.notifier = notifier
+	uc.createAnnouncementUseCase.notifier = notifier
+}
+
+// SetAuditRecorder enables audit logging of group deletions and member role changes
+func (uc *GroupManagementUseCase) SetAuditRecorder(recorder AuditRecorder) {
+	uc.deleteGroupUseCase.auditRecorder = recorder
+	uc.updateMemberRoleUseCase.auditRecorder = recorder
+	uc.assignGroupRoleUseCase.auditRecorder = recorder
+}
+
 // CreateGroup creates a new group
 func (uc *GroupManagementUseCase) CreateGroup(ctx context.Context, req *CreateGroupRequest) (*CreateGroupResponse, error) {
 	return uc.createGroupUseCase.Execute(ctx, req)
@@ -731,6 +896,66 @@ func (uc *GroupManagementUseCase) InviteGroupMember(ctx context.Context, req *In
 	return uc.inviteGroupMemberUseCase.Execute(ctx, req)
 }
 
+// CreateGroupInviteLink creates a shareable invite link for a group
+func (uc *GroupManagementUseCase) CreateGroupInviteLink(ctx context.Context, req *CreateGroupInviteLinkRequest) (*CreateGroupInviteLinkResponse, error) {
+	return uc.createGroupInviteLinkUseCase.Execute(ctx, req)
+}
+
+// AcceptGroupInvite accepts a group invite or redeems an invite link
+func (uc *GroupManagementUseCase) AcceptGroupInvite(ctx context.Context, req *AcceptGroupInviteRequest) (*AcceptGroupInviteResponse, error) {
+	return uc.acceptGroupInviteUseCase.Execute(ctx, req)
+}
+
+// DeclineGroupInvite declines a direct group invite
+func (uc *GroupManagementUseCase) DeclineGroupInvite(ctx context.Context, req *DeclineGroupInviteRequest) error {
+	return uc.declineGroupInviteUseCase.Execute(ctx, req)
+}
+
+// RevokeGroupInvite revokes a pending group invite or invite link
+func (uc *GroupManagementUseCase) RevokeGroupInvite(ctx context.Context, req *RevokeGroupInviteRequest) error {
+	return uc.revokeGroupInviteUseCase.Execute(ctx, req)
+}
+
+// ListGroupInvites retrieves the pending invites of a group
+func (uc *GroupManagementUseCase) ListGroupInvites(ctx context.Context, req *ListGroupInvitesRequest) (*ListGroupInvitesResponse, error) {
+	return uc.listGroupInvitesUseCase.Execute(ctx, req)
+}
+
+// ListUserInvites retrieves the pending invites addressed to a user
+func (uc *GroupManagementUseCase) ListUserInvites(ctx context.Context, req *ListUserInvitesRequest) (*ListUserInvitesResponse, error) {
+	return uc.listUserInvitesUseCase.Execute(ctx, req)
+}
+
+// SearchGroups searches public groups by name, distance and game
+func (uc *GroupManagementUseCase) SearchGroups(ctx context.Context, req *SearchGroupsRequest) (*SearchGroupsResponse, error) {
+	return uc.searchGroupsUseCase.Execute(ctx, req)
+}
+
+// RequestToJoinGroup asks to join a public group
+func (uc *GroupManagementUseCase) RequestToJoinGroup(ctx context.Context, req *RequestToJoinGroupRequest) (*domain.GroupJoinRequest, error) {
+	return uc.requestToJoinGroupUseCase.Execute(ctx, req)
+}
+
+// CancelJoinRequest withdraws a pending join request
+func (uc *GroupManagementUseCase) CancelJoinRequest(ctx context.Context, req *CancelJoinRequestRequest) error {
+	return uc.cancelJoinRequestUseCase.Execute(ctx, req)
+}
+
+// ListJoinRequests retrieves the pending join requests of a group
+func (uc *GroupManagementUseCase) ListJoinRequests(ctx context.Context, req *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return uc.listJoinRequestsUseCase.Execute(ctx, req)
+}
+
+// ListUserJoinRequests retrieves the join requests made by a user
+func (uc *GroupManagementUseCase) ListUserJoinRequests(ctx context.Context, req *ListUserJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return uc.listUserJoinRequestsUseCase.Execute(ctx, req)
+}
+
+// ReviewJoinRequest approves or rejects a pending join request
+func (uc *GroupManagementUseCase) ReviewJoinRequest(ctx context.Context, req *ReviewJoinRequestRequest) (*domain.GroupJoinRequest, error) {
+	return uc.reviewJoinRequestUseCase.Execute(ctx, req)
+}
+
 // RemoveGroupMember removes a member from a group
 func (uc *GroupManagementUseCase) RemoveGroupMember(ctx context.Context, req *RemoveGroupMemberRequest) error {
 	return uc.removeGroupMemberUseCase.Execute(ctx, req)
@@ -751,6 +976,121 @@ func (uc *GroupManagementUseCase) GetGroupEvents(ctx context.Context, req *GetGr
 	return uc.getGroupEventsUseCase.Execute(ctx, req)
 }
 
+// CreateLeague creates a league for a group
+func (uc *GroupManagementUseCase) CreateLeague(ctx context.Context, req *CreateLeagueRequest) (*domain.GroupLeague, error) {
+	return uc.createLeagueUseCase.Execute(ctx, req)
+}
+
+// UpdateLeague updates a group league
+func (uc *GroupManagementUseCase) UpdateLeague(ctx context.Context, req *UpdateLeagueRequest) (*domain.GroupLeague, error) {
+	return uc.updateLeagueUseCase.Execute(ctx, req)
+}
+
+// DeleteLeague deletes a group league
+func (uc *GroupManagementUseCase) DeleteLeague(ctx context.Context, req *DeleteLeagueRequest) error {
+	return uc.deleteLeagueUseCase.Execute(ctx, req)
+}
+
+// ListGroupLeagues retrieves the leagues of a group
+func (uc *GroupManagementUseCase) ListGroupLeagues(ctx context.Context, req *ListGroupLeaguesRequest) (*ListGroupLeaguesResponse, error) {
+	return uc.listGroupLeaguesUseCase.Execute(ctx, req)
+}
+
+// LinkLeagueEvent adds a group event to a league
+func (uc *GroupManagementUseCase) LinkLeagueEvent(ctx context.Context, req *LeagueEventRequest) error {
+	return uc.linkLeagueEventUseCase.Execute(ctx, req)
+}
+
+// UnlinkLeagueEvent removes an event from a league
+func (uc *GroupManagementUseCase) UnlinkLeagueEvent(ctx context.Context, req *LeagueEventRequest) error {
+	return uc.unlinkLeagueEventUseCase.Execute(ctx, req)
+}
+
+// GetLeagueEvents retrieves the events of a league
+func (uc *GroupManagementUseCase) GetLeagueEvents(ctx context.Context, req *GetLeagueEventsRequest) (*GetGroupEventsResponse, error) {
+	return uc.getLeagueEventsUseCase.Execute(ctx, req)
+}
+
+// RecordLeagueResults records the results of a league event
+func (uc *GroupManagementUseCase) RecordLeagueResults(ctx context.Context, req *RecordLeagueResultsRequest) (*RecordLeagueResultsResponse, error) {
+	return uc.recordLeagueResultsUseCase.Execute(ctx, req)
+}
+
+// GetLeagueStandings retrieves a league table
+func (uc *GroupManagementUseCase) GetLeagueStandings(ctx context.Context, req *GetLeagueStandingsRequest) (*GetLeagueStandingsResponse, error) {
+	return uc.getLeagueStandingsUseCase.Execute(ctx, req)
+}
+
+// CreateAnnouncement posts an announcement to a group
+func (uc *GroupManagementUseCase) CreateAnnouncement(ctx context.Context, req *CreateAnnouncementRequest) (*domain.GroupAnnouncement, error) {
+	return uc.createAnnouncementUseCase.Execute(ctx, req)
+}
+
+// UpdateAnnouncement edits a group announcement
+func (uc *GroupManagementUseCase) UpdateAnnouncement(ctx context.Context, req *UpdateAnnouncementRequest) (*domain.GroupAnnouncement, error) {
+	return uc.updateAnnouncementUseCase.Execute(ctx, req)
+}
+
+// SetAnnouncementPinned pins or unpins a group announcement
+func (uc *GroupManagementUseCase) SetAnnouncementPinned(ctx context.Context, req *SetAnnouncementPinnedRequest) (*domain.GroupAnnouncement, error) {
+	return uc.setAnnouncementPinnedUseCase.Execute(ctx, req)
+}
+
+// DeleteAnnouncement deletes a group announcement
+func (uc *GroupManagementUseCase) DeleteAnnouncement(ctx context.Context, req *DeleteAnnouncementRequest) error {
+	return uc.deleteAnnouncementUseCase.Execute(ctx, req)
+}
+
+// ListAnnouncements retrieves a group's announcement board
+func (uc *GroupManagementUseCase) ListAnnouncements(ctx context.Context, req *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	return uc.listAnnouncementsUseCase.Execute(ctx, req)
+}
+
+// BanGroupMember bans a user from a group
+func (uc *GroupManagementUseCase) BanGroupMember(ctx context.Context, req *BanGroupMemberRequest) (*domain.GroupBan, error) {
+	return uc.banGroupMemberUseCase.Execute(ctx, req)
+}
+
+// UnbanGroupMember lifts a user's ban from a group
+func (uc *GroupManagementUseCase) UnbanGroupMember(ctx context.Context, req *UnbanGroupMemberRequest) error {
+	return uc.unbanGroupMemberUseCase.Execute(ctx, req)
+}
+
+// ListGroupBans retrieves a group's active bans
+func (uc *GroupManagementUseCase) ListGroupBans(ctx context.Context, req *ListGroupBansRequest) (*ListGroupBansResponse, error) {
+	return uc.listGroupBansUseCase.Execute(ctx, req)
+}
+
+// GetModerationLog retrieves a group's moderation audit trail
+func (uc *GroupManagementUseCase) GetModerationLog(ctx context.Context, req *GetModerationLogRequest) (*GetModerationLogResponse, error) {
+	return uc.getModerationLogUseCase.Execute(ctx, req)
+}
+
+// CreateGroupRole defines a custom role in a group
+func (uc *GroupManagementUseCase) CreateGroupRole(ctx context.Context, req *CreateGroupRoleRequest) (*domain.GroupCustomRole, error) {
+	return uc.createGroupRoleUseCase.Execute(ctx, req)
+}
+
+// UpdateGroupRole updates a group's custom role
+func (uc *GroupManagementUseCase) UpdateGroupRole(ctx context.Context, req *UpdateGroupRoleRequest) (*domain.GroupCustomRole, error) {
+	return uc.updateGroupRoleUseCase.Execute(ctx, req)
+}
+
+// DeleteGroupRole deletes a group's custom role
+func (uc *GroupManagementUseCase) DeleteGroupRole(ctx context.Context, req *DeleteGroupRoleRequest) error {
+	return uc.deleteGroupRoleUseCase.Execute(ctx, req)
+}
+
+// ListGroupRoles retrieves a group's custom roles
+func (uc *GroupManagementUseCase) ListGroupRoles(ctx context.Context, req *ListGroupRolesRequest) (*ListGroupRolesResponse, error) {
+	return uc.listGroupRolesUseCase.Execute(ctx, req)
+}
+
+// AssignGroupRole sets or clears a member's custom role
+func (uc *GroupManagementUseCase) AssignGroupRole(ctx context.Context, req *AssignGroupRoleRequest) error {
+	return uc.assignGroupRoleUseCase.Execute(ctx, req)
+}
+
 // GetGroupRequest represents the request to get a group
 type GetGroupRequest struct {
 	GroupID          uuid.UUID  `json:"group_id" validate:"required"`
@@ -70,6 +70,14 @@ func (m *MockGroupRepository) GetGroupsByOwner(ctx context.Context, ownerID uuid
 	return args.Get(0).([]*domain.Group), args.Error(1)
 }
 
+func (m *MockGroupRepository) Search(ctx context.Context, params domain.GroupSearchParams) ([]*domain.GroupSearchResult, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.GroupSearchResult), args.Error(1)
+}
+
 func (m *MockGroupRepository) AddMember(ctx context.Context, member *domain.GroupMember) error {
 	args := m.Called(ctx, member)
 	return args.Error(0)
@@ -399,7 +407,10 @@ func TestInviteGroupMemberUseCase_Execute(t *testing.T) {
 	t.Run("successful member invitation", func(t *testing.T) {
 		mockGroupRepo := new(MockGroupRepository)
 		mockUserRepo := new(MockUserRepository)
-		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo, mockInviteRepo, mockModerationRepo, mockBlockRepo)
 
 		groupID := uuid.New()
 		userID := uuid.New()
@@ -427,25 +438,81 @@ func TestInviteGroupMemberUseCase_Execute(t *testing.T) {
 
 		mockGroupRepo.On("GetByID", ctx, groupID).Return(group, nil)
 		mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
-		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, inviterID).Return(true, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, inviterID).Return(&domain.GroupMember{GroupID: groupID, UserID: inviterID, Role: domain.GroupRoleOwner}, nil)
 		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		mockBlockRepo.On("IsBlockedEitherWay", ctx, inviterID, userID).Return(false, nil)
 		mockGroupRepo.On("GetMemberRole", ctx, groupID, inviterID).Return(domain.GroupRoleOwner, nil)
-		mockGroupRepo.On("AddMember", ctx, mock.AnythingOfType("*domain.GroupMember")).Return(nil)
+		mockInviteRepo.On("GetPendingForUserInGroup", ctx, groupID, userID).Return(nil, nil)
+		mockInviteRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupInvite")).Return(nil)
 
 		resp, err := useCase.Execute(ctx, req)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, resp)
-		assert.Equal(t, userID, resp.Member.UserID)
-		assert.Equal(t, domain.GroupRoleMember, resp.Member.Role)
+		assert.Equal(t, userID, *resp.Invite.InvitedUserID)
+		assert.Equal(t, domain.GroupRoleMember, resp.Invite.Role)
+		assert.Equal(t, domain.GroupInviteStatusPending, resp.Invite.Status)
+		assert.Equal(t, domain.HashInviteToken(resp.Token), resp.Invite.TokenHash)
+		assert.NotEqual(t, resp.Token, resp.Invite.TokenHash)
+		mockGroupRepo.AssertNotCalled(t, "AddMember", mock.Anything, mock.Anything)
 		mockGroupRepo.AssertExpectations(t)
 		mockUserRepo.AssertExpectations(t)
+		mockInviteRepo.AssertExpectations(t)
+	})
+
+	t.Run("re-invite revokes previous pending invite", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockUserRepo := new(MockUserRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo, mockInviteRepo, mockModerationRepo, mockBlockRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		inviterID := uuid.New()
+
+		previous := &domain.GroupInvite{
+			ID:            uuid.New(),
+			GroupID:       groupID,
+			InvitedUserID: &userID,
+			Status:        domain.GroupInviteStatusPending,
+		}
+
+		req := &InviteGroupMemberRequest{
+			GroupID:   groupID,
+			UserID:    userID,
+			Role:      domain.GroupRoleMember,
+			InviterID: inviterID,
+		}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, IsActive: true}, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, inviterID).Return(&domain.GroupMember{GroupID: groupID, UserID: inviterID, Role: domain.GroupRoleAdmin}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		mockBlockRepo.On("IsBlockedEitherWay", ctx, inviterID, userID).Return(false, nil)
+		mockGroupRepo.On("GetMemberRole", ctx, groupID, inviterID).Return(domain.GroupRoleAdmin, nil)
+		mockInviteRepo.On("GetPendingForUserInGroup", ctx, groupID, userID).Return(previous, nil)
+		mockInviteRepo.On("Update", ctx, previous).Return(nil)
+		mockInviteRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupInvite")).Return(nil)
+
+		resp, err := useCase.Execute(ctx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, domain.GroupInviteStatusRevoked, previous.Status)
+		mockInviteRepo.AssertExpectations(t)
 	})
 
 	t.Run("user already member", func(t *testing.T) {
 		mockGroupRepo := new(MockGroupRepository)
 		mockUserRepo := new(MockUserRepository)
-		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo, mockInviteRepo, mockModerationRepo, mockBlockRepo)
 
 		groupID := uuid.New()
 		userID := uuid.New()
@@ -473,12 +540,13 @@ func TestInviteGroupMemberUseCase_Execute(t *testing.T) {
 
 		mockGroupRepo.On("GetByID", ctx, groupID).Return(group, nil)
 		mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
-		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, inviterID).Return(true, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, inviterID).Return(&domain.GroupMember{GroupID: groupID, UserID: inviterID, Role: domain.GroupRoleOwner}, nil)
 		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(true, nil)
 
 		resp, err := useCase.Execute(ctx, req)
 
 		assert.Error(t, err)
+		assert.Equal(t, ErrAlreadyGroupMember, err)
 		assert.Contains(t, err.Error(), "already a member")
 		assert.Nil(t, resp)
 		mockGroupRepo.AssertExpectations(t)
@@ -488,7 +556,10 @@ func TestInviteGroupMemberUseCase_Execute(t *testing.T) {
 	t.Run("insufficient permissions", func(t *testing.T) {
 		mockGroupRepo := new(MockGroupRepository)
 		mockUserRepo := new(MockUserRepository)
-		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo, mockInviteRepo, mockModerationRepo, mockBlockRepo)
 
 		groupID := uuid.New()
 		userID := uuid.New()
@@ -516,7 +587,7 @@ func TestInviteGroupMemberUseCase_Execute(t *testing.T) {
 
 		mockGroupRepo.On("GetByID", ctx, groupID).Return(group, nil)
 		mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
-		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, inviterID).Return(false, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, inviterID).Return(&domain.GroupMember{GroupID: groupID, UserID: inviterID, Role: domain.GroupRoleMember}, nil)
 
 		resp, err := useCase.Execute(ctx, req)
 
@@ -533,7 +604,8 @@ func TestRemoveGroupMemberUseCase_Execute(t *testing.T) {
 
 	t.Run("successful member removal", func(t *testing.T) {
 		mockGroupRepo := new(MockGroupRepository)
-		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo, mockModerationRepo)
 
 		groupID := uuid.New()
 		userID := uuid.New()
@@ -560,20 +632,24 @@ func TestRemoveGroupMemberUseCase_Execute(t *testing.T) {
 
 		mockGroupRepo.On("GetByID", ctx, groupID).Return(group, nil)
 		mockGroupRepo.On("GetMember", ctx, groupID, userID).Return(member, nil)
-		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, removerID).Return(true, nil)
-		mockGroupRepo.On("GetMemberRole", ctx, groupID, removerID).Return(domain.GroupRoleOwner, nil)
-		mockGroupRepo.On("GetMemberRole", ctx, groupID, userID).Return(domain.GroupRoleMember, nil)
-		mockGroupRepo.On("RemoveMember", ctx, groupID, userID).Return(nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, removerID).Return(&domain.GroupMember{GroupID: groupID, UserID: removerID, Role: domain.GroupRoleOwner}, nil)
+		mockModerationRepo.On("RemoveMember", ctx, groupID, userID, mock.MatchedBy(func(action *domain.GroupModerationAction) bool {
+			return action.Action == domain.GroupModerationActionRemoveMember &&
+				action.ActorUserID == removerID &&
+				action.TargetUserID == userID
+		})).Return(nil)
 
 		err := useCase.Execute(ctx, req)
 
 		assert.NoError(t, err)
 		mockGroupRepo.AssertExpectations(t)
+		mockModerationRepo.AssertExpectations(t)
 	})
 
 	t.Run("user removes themselves", func(t *testing.T) {
 		mockGroupRepo := new(MockGroupRepository)
-		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo, mockModerationRepo)
 
 		groupID := uuid.New()
 		userID := uuid.New()
@@ -610,7 +686,8 @@ func TestRemoveGroupMemberUseCase_Execute(t *testing.T) {
 
 	t.Run("cannot remove owner", func(t *testing.T) {
 		mockGroupRepo := new(MockGroupRepository)
-		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo, mockModerationRepo)
 
 		groupID := uuid.New()
 		userID := uuid.New()
@@ -647,7 +724,8 @@ func TestRemoveGroupMemberUseCase_Execute(t *testing.T) {
 
 	t.Run("user not member", func(t *testing.T) {
 		mockGroupRepo := new(MockGroupRepository)
-		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewRemoveGroupMemberUseCase(mockGroupRepo, mockModerationRepo)
 
 		groupID := uuid.New()
 		userID := uuid.New()
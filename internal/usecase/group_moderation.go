@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+const (
+	// DefaultModerationLimit is the page size used when none is requested
+	DefaultModerationLimit = 50
+	// MaxModerationLimit is the largest page size a listing may request
+	MaxModerationLimit = 100
+)
+
+var (
+	ErrUserBannedFromGroup = errors.New("user is banned from the group")
+	ErrBanNotFound         = errors.New("ban not found")
+)
+
+// BanGroupMemberRequest represents the request to ban a user from a group
+type BanGroupMemberRequest struct {
+	GroupID     uuid.UUID  `json:"group_id" validate:"required"`
+	UserID      uuid.UUID  `json:"user_id" validate:"required"`      // User to ban
+	ModeratorID uuid.UUID  `json:"moderator_id" validate:"required"` // User issuing the ban
+	Reason      *string    `json:"reason,omitempty" validate:"omitempty,max=500"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"` // Nil for a permanent ban
+}
+
+// UnbanGroupMemberRequest represents the request to lift a user's ban from a group
+type UnbanGroupMemberRequest struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	UserID      uuid.UUID `json:"user_id" validate:"required"`      // User to unban
+	ModeratorID uuid.UUID `json:"moderator_id" validate:"required"` // User lifting the ban
+}
+
+// ListGroupBansRequest represents the request to list a group's active bans
+type ListGroupBansRequest struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+	Limit       int       `json:"limit"`
+	Offset      int       `json:"offset"`
+}
+
+// ListGroupBansResponse represents a page of a group's active bans
+type ListGroupBansResponse struct {
+	Bans   []*domain.GroupBan `json:"bans"`
+	Limit  int                `json:"limit"`
+	Offset int                `json:"offset"`
+}
+
+// GetModerationLogRequest represents the request to read a group's moderation audit trail
+type GetModerationLogRequest struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+	Limit       int       `json:"limit"`
+	Offset      int       `json:"offset"`
+}
+
+// GetModerationLogResponse represents a page of a group's moderation audit trail
+type GetModerationLogResponse struct {
+	Actions []*domain.GroupModerationAction `json:"actions"`
+	Limit   int                             `json:"limit"`
+	Offset  int                             `json:"offset"`
+}
+
+// BanGroupMemberUseCase handles banning users from groups
+type BanGroupMemberUseCase struct {
+	groupRepo         repository.GroupRepository
+	userRepo          repository.UserRepository
+	moderationRepo    repository.GroupModerationRepository
+	permissionService *domain.PermissionService
+}
+
+// NewBanGroupMemberUseCase creates a new BanGroupMemberUseCase
+func NewBanGroupMemberUseCase(groupRepo repository.GroupRepository, userRepo repository.UserRepository, moderationRepo repository.GroupModerationRepository) *BanGroupMemberUseCase {
+	return &BanGroupMemberUseCase{
+		groupRepo:         groupRepo,
+		userRepo:          userRepo,
+		moderationRepo:    moderationRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute bans a user, removing them from the group if they are a member.
+// Banning an already banned user replaces the reason and expiry.
+func (uc *BanGroupMemberUseCase) Execute(ctx context.Context, req *BanGroupMemberRequest) (*domain.GroupBan, error) {
+	if req.UserID == req.ModeratorID {
+		return nil, domain.ErrCannotBanSelf
+	}
+
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.ModeratorID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if !uc.permissionService.CanBanMember(req.GroupID, req.ModeratorID, memberships, req.UserID) {
+		return nil, ErrInsufficientPermissions
+	}
+
+	now := time.Now().UTC()
+	ban := &domain.GroupBan{
+		ID:        uuid.New(),
+		GroupID:   req.GroupID,
+		UserID:    req.UserID,
+		BannedBy:  req.ModeratorID,
+		Reason:    req.Reason,
+		ExpiresAt: req.ExpiresAt,
+		CreatedAt: now,
+	}
+
+	if err := ban.Validate(); err != nil {
+		return nil, err
+	}
+
+	action := domain.NewGroupModerationAction(req.GroupID, req.ModeratorID, req.UserID, domain.GroupModerationActionBan, req.Reason, now)
+	action.ExpiresAt = req.ExpiresAt
+
+	if err := uc.moderationRepo.Ban(ctx, ban, action); err != nil {
+		return nil, err
+	}
+
+	return ban, nil
+}
+
+// UnbanGroupMemberUseCase handles lifting group bans
+type UnbanGroupMemberUseCase struct {
+	groupRepo         repository.GroupRepository
+	moderationRepo    repository.GroupModerationRepository
+	permissionService *domain.PermissionService
+}
+
+// NewUnbanGroupMemberUseCase creates a new UnbanGroupMemberUseCase
+func NewUnbanGroupMemberUseCase(groupRepo repository.GroupRepository, moderationRepo repository.GroupModerationRepository) *UnbanGroupMemberUseCase {
+	return &UnbanGroupMemberUseCase{
+		groupRepo:         groupRepo,
+		moderationRepo:    moderationRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute lifts an active ban. The user still has to be invited or request to join again.
+func (uc *UnbanGroupMemberUseCase) Execute(ctx context.Context, req *UnbanGroupMemberRequest) error {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.ModeratorID)
+	if err != nil {
+		return err
+	}
+	if !uc.permissionService.CanUnbanMember(req.GroupID, req.ModeratorID, memberships) {
+		return ErrInsufficientPermissions
+	}
+
+	now := time.Now().UTC()
+	ban, err := uc.moderationRepo.GetActiveBan(ctx, req.GroupID, req.UserID, now)
+	if err != nil {
+		return err
+	}
+	if ban == nil {
+		return ErrBanNotFound
+	}
+
+	action := domain.NewGroupModerationAction(req.GroupID, req.ModeratorID, req.UserID, domain.GroupModerationActionUnban, nil, now)
+
+	return uc.moderationRepo.Unban(ctx, req.GroupID, req.UserID, action)
+}
+
+// ListGroupBansUseCase handles listing a group's active bans
+type ListGroupBansUseCase struct {
+	groupRepo         repository.GroupRepository
+	moderationRepo    repository.GroupModerationRepository
+	permissionService *domain.PermissionService
+}
+
+// NewListGroupBansUseCase creates a new ListGroupBansUseCase
+func NewListGroupBansUseCase(groupRepo repository.GroupRepository, moderationRepo repository.GroupModerationRepository) *ListGroupBansUseCase {
+	return &ListGroupBansUseCase{
+		groupRepo:         groupRepo,
+		moderationRepo:    moderationRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute returns the group's active bans to its admins, newest first
+func (uc *ListGroupBansUseCase) Execute(ctx context.Context, req *ListGroupBansRequest) (*ListGroupBansResponse, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanViewModerationLog(req.GroupID, req.RequesterID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	bans, err := uc.moderationRepo.GetActiveBans(ctx, req.GroupID, time.Now().UTC(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListGroupBansResponse{
+		Bans:   bans,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// GetModerationLogUseCase handles reading a group's moderation audit trail
+type GetModerationLogUseCase struct {
+	groupRepo         repository.GroupRepository
+	moderationRepo    repository.GroupModerationRepository
+	permissionService *domain.PermissionService
+}
+
+// NewGetModerationLogUseCase creates a new GetModerationLogUseCase
+func NewGetModerationLogUseCase(groupRepo repository.GroupRepository, moderationRepo repository.GroupModerationRepository) *GetModerationLogUseCase {
+	return &GetModerationLogUseCase{
+		groupRepo:         groupRepo,
+		moderationRepo:    moderationRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute returns the group's moderation actions to its admins, newest first
+func (uc *GetModerationLogUseCase) Execute(ctx context.Context, req *GetModerationLogRequest) (*GetModerationLogResponse, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanViewModerationLog(req.GroupID, req.RequesterID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	actions, err := uc.moderationRepo.GetActions(ctx, req.GroupID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetModerationLogResponse{
+		Actions: actions,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// checkNotBanned returns ErrUserBannedFromGroup if the user has an active ban in the group
+func checkNotBanned(ctx context.Context, moderationRepo repository.GroupModerationRepository, groupID, userID uuid.UUID) error {
+	ban, err := moderationRepo.GetActiveBan(ctx, groupID, userID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if ban != nil {
+		return ErrUserBannedFromGroup
+	}
+
+	return nil
+}
+
+// moderationPage applies the default and maximum page size to a moderation listing
+func moderationPage(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = DefaultModerationLimit
+	}
+	if limit > MaxModerationLimit {
+		limit = MaxModerationLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	return limit, offset
+}
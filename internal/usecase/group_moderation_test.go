@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGroupModerationRepository is a mock implementation of GroupModerationRepository
+type MockGroupModerationRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupModerationRepository) Ban(ctx context.Context, ban *domain.GroupBan, action *domain.GroupModerationAction) error {
+	args := m.Called(ctx, ban, action)
+	return args.Error(0)
+}
+
+func (m *MockGroupModerationRepository) Unban(ctx context.Context, groupID, userID uuid.UUID, action *domain.GroupModerationAction) error {
+	args := m.Called(ctx, groupID, userID, action)
+	return args.Error(0)
+}
+
+func (m *MockGroupModerationRepository) GetActiveBan(ctx context.Context, groupID, userID uuid.UUID, activeAt time.Time) (*domain.GroupBan, error) {
+	args := m.Called(ctx, groupID, userID, activeAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupBan), args.Error(1)
+}
+
+func (m *MockGroupModerationRepository) GetActiveBans(ctx context.Context, groupID uuid.UUID, activeAt time.Time, limit, offset int) ([]*domain.GroupBan, error) {
+	args := m.Called(ctx, groupID, activeAt, limit, offset)
+	return args.Get(0).([]*domain.GroupBan), args.Error(1)
+}
+
+func (m *MockGroupModerationRepository) RemoveMember(ctx context.Context, groupID, userID uuid.UUID, action *domain.GroupModerationAction) error {
+	args := m.Called(ctx, groupID, userID, action)
+	return args.Error(0)
+}
+
+func (m *MockGroupModerationRepository) GetActions(ctx context.Context, groupID uuid.UUID, limit, offset int) ([]*domain.GroupModerationAction, error) {
+	args := m.Called(ctx, groupID, limit, offset)
+	return args.Get(0).([]*domain.GroupModerationAction), args.Error(1)
+}
+
+func TestBanGroupMemberUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(groupID uuid.UUID, members ...*domain.GroupMember) (*MockGroupRepository, *MockUserRepository, *MockGroupModerationRepository, *BanGroupMemberUseCase) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockUserRepo := new(MockUserRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewBanGroupMemberUseCase(mockGroupRepo, mockUserRepo, mockModerationRepo)
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		for _, member := range members {
+			mockGroupRepo.On("GetMember", ctx, groupID, member.UserID).Return(member, nil)
+		}
+
+		return mockGroupRepo, mockUserRepo, mockModerationRepo, useCase
+	}
+
+	t.Run("admin bans member temporarily", func(t *testing.T) {
+		groupID := uuid.New()
+		admin := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleAdmin}
+		member := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleMember}
+		_, mockUserRepo, mockModerationRepo, useCase := setup(groupID, admin, member)
+
+		reason := "Harassment at the store"
+		expiresAt := time.Now().UTC().Add(30 * 24 * time.Hour)
+		mockUserRepo.On("GetByID", ctx, member.UserID).Return(&domain.User{ID: member.UserID}, nil)
+		mockModerationRepo.On("Ban", ctx, mock.AnythingOfType("*domain.GroupBan"), mock.MatchedBy(func(action *domain.GroupModerationAction) bool {
+			return action.Action == domain.GroupModerationActionBan &&
+				action.ActorUserID == admin.UserID &&
+				action.TargetUserID == member.UserID &&
+				action.ExpiresAt != nil
+		})).Return(nil)
+
+		ban, err := useCase.Execute(ctx, &BanGroupMemberRequest{
+			GroupID:     groupID,
+			UserID:      member.UserID,
+			ModeratorID: admin.UserID,
+			Reason:      &reason,
+			ExpiresAt:   &expiresAt,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, member.UserID, ban.UserID)
+		assert.Equal(t, admin.UserID, ban.BannedBy)
+		mockModerationRepo.AssertExpectations(t)
+	})
+
+	t.Run("admin can ban a non-member", func(t *testing.T) {
+		groupID := uuid.New()
+		admin := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleAdmin}
+		mockGroupRepo, mockUserRepo, mockModerationRepo, useCase := setup(groupID, admin)
+
+		outsiderID := uuid.New()
+		mockGroupRepo.On("GetMember", ctx, groupID, outsiderID).Return(nil, nil)
+		mockUserRepo.On("GetByID", ctx, outsiderID).Return(&domain.User{ID: outsiderID}, nil)
+		mockModerationRepo.On("Ban", ctx, mock.AnythingOfType("*domain.GroupBan"), mock.AnythingOfType("*domain.GroupModerationAction")).Return(nil)
+
+		_, err := useCase.Execute(ctx, &BanGroupMemberRequest{
+			GroupID:     groupID,
+			UserID:      outsiderID,
+			ModeratorID: admin.UserID,
+		})
+
+		assert.NoError(t, err)
+		mockModerationRepo.AssertExpectations(t)
+	})
+
+	t.Run("admin cannot ban another admin", func(t *testing.T) {
+		groupID := uuid.New()
+		admin := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleAdmin}
+		otherAdmin := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleAdmin}
+		_, mockUserRepo, mockModerationRepo, useCase := setup(groupID, admin, otherAdmin)
+
+		mockUserRepo.On("GetByID", ctx, otherAdmin.UserID).Return(&domain.User{ID: otherAdmin.UserID}, nil)
+
+		_, err := useCase.Execute(ctx, &BanGroupMemberRequest{
+			GroupID:     groupID,
+			UserID:      otherAdmin.UserID,
+			ModeratorID: admin.UserID,
+		})
+
+		assert.Equal(t, ErrInsufficientPermissions, err)
+		mockModerationRepo.AssertNotCalled(t, "Ban", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("cannot ban yourself", func(t *testing.T) {
+		_, _, mockModerationRepo, useCase := setup(uuid.New())
+		ownerID := uuid.New()
+
+		_, err := useCase.Execute(ctx, &BanGroupMemberRequest{
+			GroupID:     uuid.New(),
+			UserID:      ownerID,
+			ModeratorID: ownerID,
+		})
+
+		assert.Equal(t, domain.ErrCannotBanSelf, err)
+		mockModerationRepo.AssertNotCalled(t, "Ban", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUnbanGroupMemberUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not banned", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		userID := uuid.New()
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewUnbanGroupMemberUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockModerationRepo)
+
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+
+		err := useCase.Execute(ctx, &UnbanGroupMemberRequest{
+			GroupID:     groupID,
+			UserID:      userID,
+			ModeratorID: adminID,
+		})
+
+		assert.Equal(t, ErrBanNotFound, err)
+		mockModerationRepo.AssertNotCalled(t, "Unban", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("member cannot unban", func(t *testing.T) {
+		groupID := uuid.New()
+		memberID := uuid.New()
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewUnbanGroupMemberUseCase(announcementGroupRepo(ctx, groupID, memberID, domain.GroupRoleMember), mockModerationRepo)
+
+		err := useCase.Execute(ctx, &UnbanGroupMemberRequest{
+			GroupID:     groupID,
+			UserID:      uuid.New(),
+			ModeratorID: memberID,
+		})
+
+		assert.Equal(t, ErrInsufficientPermissions, err)
+	})
+}
+
+func TestGetModerationLogUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("member cannot read the moderation log", func(t *testing.T) {
+		groupID := uuid.New()
+		memberID := uuid.New()
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewGetModerationLogUseCase(announcementGroupRepo(ctx, groupID, memberID, domain.GroupRoleMember), mockModerationRepo)
+
+		_, err := useCase.Execute(ctx, &GetModerationLogRequest{GroupID: groupID, RequesterID: memberID})
+
+		assert.Equal(t, ErrUnauthorizedGroupAccess, err)
+		mockModerationRepo.AssertNotCalled(t, "GetActions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("admin reads the moderation log", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockModerationRepo := new(MockGroupModerationRepository)
+		useCase := NewGetModerationLogUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleOwner), mockModerationRepo)
+
+		actions := []*domain.GroupModerationAction{
+			domain.NewGroupModerationAction(groupID, adminID, uuid.New(), domain.GroupModerationActionBan, nil, time.Now()),
+		}
+		mockModerationRepo.On("GetActions", ctx, groupID, DefaultModerationLimit, 0).Return(actions, nil)
+
+		result, err := useCase.Execute(ctx, &GetModerationLogRequest{GroupID: groupID, RequesterID: adminID})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Actions, 1)
+	})
+}
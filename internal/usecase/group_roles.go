@@ -0,0 +1,348 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// MaxCustomRolesPerGroup is the number of custom roles a group may define
+const MaxCustomRolesPerGroup = 20
+
+var (
+	ErrCustomRoleNotFound  = errors.New("custom role not found")
+	ErrCustomRoleNameTaken = errors.New("a custom role with this name already exists in the group")
+	ErrTooManyCustomRoles  = errors.New("group has reached the maximum number of custom roles")
+)
+
+// CreateGroupRoleRequest represents the request to define a custom group role
+type CreateGroupRoleRequest struct {
+	GroupID     uuid.UUID           `json:"group_id" validate:"required"`
+	UserID      uuid.UUID           `json:"user_id" validate:"required"` // User making the request
+	Name        string              `json:"name" validate:"required,min=1,max=50"`
+	Description *string             `json:"description,omitempty" validate:"omitempty,max=500"`
+	Permissions []domain.Permission `json:"permissions" validate:"required,min=1"`
+}
+
+// UpdateGroupRoleRequest represents the request to update a custom group role
+type UpdateGroupRoleRequest struct {
+	GroupID     uuid.UUID           `json:"group_id" validate:"required"`
+	RoleID      uuid.UUID           `json:"role_id" validate:"required"`
+	UserID      uuid.UUID           `json:"user_id" validate:"required"` // User making the request
+	Name        *string             `json:"name,omitempty" validate:"omitempty,min=1,max=50"`
+	Description *string             `json:"description,omitempty" validate:"omitempty,max=500"`
+	Permissions []domain.Permission `json:"permissions,omitempty"` // Nil keeps the current permissions
+}
+
+// DeleteGroupRoleRequest represents the request to delete a custom group role
+type DeleteGroupRoleRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	RoleID  uuid.UUID `json:"role_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ListGroupRolesRequest represents the request to list a group's custom roles
+type ListGroupRolesRequest struct {
+	GroupID     uuid.UUID `json:"group_id" validate:"required"`
+	RequesterID uuid.UUID `json:"requester_id" validate:"required"` // User making the request
+}
+
+// ListGroupRolesResponse represents a group's custom roles
+type ListGroupRolesResponse struct {
+	Roles []*domain.GroupCustomRole `json:"roles"`
+}
+
+// AssignGroupRoleRequest represents the request to set or clear a member's custom role
+type AssignGroupRoleRequest struct {
+	GroupID    uuid.UUID  `json:"group_id" validate:"required"`
+	UserID     uuid.UUID  `json:"user_id" validate:"required"`     // Member receiving the role
+	AssignerID uuid.UUID  `json:"assigner_id" validate:"required"` // User making the request
+	RoleID     *uuid.UUID `json:"role_id,omitempty"`               // Nil clears the member's custom role
+}
+
+// CreateGroupRoleUseCase handles defining custom group roles
+type CreateGroupRoleUseCase struct {
+	groupRepo         repository.GroupRepository
+	roleRepo          repository.GroupCustomRoleRepository
+	permissionService *domain.PermissionService
+}
+
+// NewCreateGroupRoleUseCase creates a new CreateGroupRoleUseCase
+func NewCreateGroupRoleUseCase(groupRepo repository.GroupRepository, roleRepo repository.GroupCustomRoleRepository) *CreateGroupRoleUseCase {
+	return &CreateGroupRoleUseCase{
+		groupRepo:         groupRepo,
+		roleRepo:          roleRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute defines a new custom role in a group
+func (uc *CreateGroupRoleUseCase) Execute(ctx context.Context, req *CreateGroupRoleRequest) (*domain.GroupCustomRole, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanManageCustomRoles(req.GroupID, req.UserID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	now := time.Now().UTC()
+	role := &domain.GroupCustomRole{
+		ID:          uuid.New(),
+		GroupID:     req.GroupID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Permissions: req.Permissions,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.roleRepo.GetByGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= MaxCustomRolesPerGroup {
+		return nil, ErrTooManyCustomRoles
+	}
+	if err := checkCustomRoleNameAvailable(existing, role); err != nil {
+		return nil, err
+	}
+
+	if err := uc.roleRepo.Create(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// UpdateGroupRoleUseCase handles updating custom group roles
+type UpdateGroupRoleUseCase struct {
+	groupRepo         repository.GroupRepository
+	roleRepo          repository.GroupCustomRoleRepository
+	permissionService *domain.PermissionService
+}
+
+// NewUpdateGroupRoleUseCase creates a new UpdateGroupRoleUseCase
+func NewUpdateGroupRoleUseCase(groupRepo repository.GroupRepository, roleRepo repository.GroupCustomRoleRepository) *UpdateGroupRoleUseCase {
+	return &UpdateGroupRoleUseCase{
+		groupRepo:         groupRepo,
+		roleRepo:          roleRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute updates a custom role; members holding it pick up the new permissions immediately
+func (uc *UpdateGroupRoleUseCase) Execute(ctx context.Context, req *UpdateGroupRoleRequest) (*domain.GroupCustomRole, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.permissionService.CanManageCustomRoles(req.GroupID, req.UserID, memberships) {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	role, err := getGroupCustomRole(ctx, uc.roleRepo, req.GroupID, req.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		role.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		role.Description = req.Description
+	}
+	if req.Permissions != nil {
+		role.Permissions = req.Permissions
+	}
+	role.UpdatedAt = time.Now().UTC()
+
+	if err := role.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		existing, err := uc.roleRepo.GetByGroup(ctx, req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkCustomRoleNameAvailable(existing, role); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.roleRepo.Update(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// DeleteGroupRoleUseCase handles deleting custom group roles
+type DeleteGroupRoleUseCase struct {
+	groupRepo         repository.GroupRepository
+	roleRepo          repository.GroupCustomRoleRepository
+	permissionService *domain.PermissionService
+}
+
+// NewDeleteGroupRoleUseCase creates a new DeleteGroupRoleUseCase
+func NewDeleteGroupRoleUseCase(groupRepo repository.GroupRepository, roleRepo repository.GroupCustomRoleRepository) *DeleteGroupRoleUseCase {
+	return &DeleteGroupRoleUseCase{
+		groupRepo:         groupRepo,
+		roleRepo:          roleRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute deletes a custom role; members holding it fall back to their base role
+func (uc *DeleteGroupRoleUseCase) Execute(ctx context.Context, req *DeleteGroupRoleRequest) error {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if !uc.permissionService.CanManageCustomRoles(req.GroupID, req.UserID, memberships) {
+		return ErrUnauthorizedGroupAccess
+	}
+
+	if _, err := getGroupCustomRole(ctx, uc.roleRepo, req.GroupID, req.RoleID); err != nil {
+		return err
+	}
+
+	return uc.roleRepo.Delete(ctx, req.RoleID)
+}
+
+// ListGroupRolesUseCase handles listing a group's custom roles
+type ListGroupRolesUseCase struct {
+	groupRepo repository.GroupRepository
+	roleRepo  repository.GroupCustomRoleRepository
+}
+
+// NewListGroupRolesUseCase creates a new ListGroupRolesUseCase
+func NewListGroupRolesUseCase(groupRepo repository.GroupRepository, roleRepo repository.GroupCustomRoleRepository) *ListGroupRolesUseCase {
+	return &ListGroupRolesUseCase{
+		groupRepo: groupRepo,
+		roleRepo:  roleRepo,
+	}
+}
+
+// Execute lists a group's custom roles; any member may see them
+func (uc *ListGroupRolesUseCase) Execute(ctx context.Context, req *ListGroupRolesRequest) (*ListGroupRolesResponse, error) {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.RequesterID)
+	if err != nil {
+		return nil, err
+	}
+	if len(memberships) == 0 {
+		return nil, ErrUnauthorizedGroupAccess
+	}
+
+	roles, err := uc.roleRepo.GetByGroup(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListGroupRolesResponse{
+		Roles: roles,
+	}, nil
+}
+
+// AssignGroupRoleUseCase handles assigning custom roles to group members
+type AssignGroupRoleUseCase struct {
+	groupRepo         repository.GroupRepository
+	roleRepo          repository.GroupCustomRoleRepository
+	permissionService *domain.PermissionService
+	auditRecorder     AuditRecorder
+}
+
+// NewAssignGroupRoleUseCase creates a new AssignGroupRoleUseCase
+func NewAssignGroupRoleUseCase(groupRepo repository.GroupRepository, roleRepo repository.GroupCustomRoleRepository) *AssignGroupRoleUseCase {
+	return &AssignGroupRoleUseCase{
+		groupRepo:         groupRepo,
+		roleRepo:          roleRepo,
+		permissionService: domain.NewPermissionService(),
+	}
+}
+
+// Execute sets or clears a member's custom role
+func (uc *AssignGroupRoleUseCase) Execute(ctx context.Context, req *AssignGroupRoleRequest) error {
+	memberships, err := getGroupMemberships(ctx, uc.groupRepo, req.GroupID, req.AssignerID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if !uc.permissionService.CanManageCustomRoles(req.GroupID, req.AssignerID, memberships) {
+		return ErrInsufficientPermissions
+	}
+
+	var member *domain.GroupMember
+	for i := range memberships {
+		if memberships[i].UserID == req.UserID {
+			member = &memberships[i]
+		}
+	}
+	if member == nil {
+		return ErrUserNotGroupMember
+	}
+
+	if req.RoleID != nil {
+		if _, err := getGroupCustomRole(ctx, uc.roleRepo, req.GroupID, *req.RoleID); err != nil {
+			return err
+		}
+	}
+
+	if err := uc.roleRepo.AssignToMember(ctx, req.GroupID, req.UserID, req.RoleID); err != nil {
+		return err
+	}
+
+	audit := auditChange(req.AssignerID, domain.AuditActionRoleChange, domain.AuditTargetGroup, req.GroupID, &req.UserID,
+		map[string]interface{}{"custom_role_id": member.CustomRoleID}, map[string]interface{}{"custom_role_id": req.RoleID})
+	if audit.HasChanges() {
+		recordAudit(ctx, uc.auditRecorder, audit)
+	}
+
+	return nil
+}
+
+// checkGroupPermission verifies that the group exists and the user holds a grantable permission in it
+func checkGroupPermission(ctx context.Context, groupRepo repository.GroupRepository, groupID, userID uuid.UUID, permission domain.Permission) error {
+	memberships, err := getGroupMemberships(ctx, groupRepo, groupID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !domain.NewPermissionService().HasPermission(groupID, userID, memberships, permission) {
+		return ErrUnauthorizedGroupAccess
+	}
+
+	return nil
+}
+
+// getGroupCustomRole retrieves a custom role, reporting roles of other groups as missing
+func getGroupCustomRole(ctx context.Context, roleRepo repository.GroupCustomRoleRepository, groupID, roleID uuid.UUID) (*domain.GroupCustomRole, error) {
+	role, err := roleRepo.GetByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil || role.GroupID != groupID {
+		return nil, ErrCustomRoleNotFound
+	}
+
+	return role, nil
+}
+
+// checkCustomRoleNameAvailable verifies no other role in the group uses the same name, ignoring case
+func checkCustomRoleNameAvailable(existing []*domain.GroupCustomRole, role *domain.GroupCustomRole) error {
+	for _, other := range existing {
+		if other.ID != role.ID && strings.EqualFold(other.Name, role.Name) {
+			return ErrCustomRoleNameTaken
+		}
+	}
+	return nil
+}
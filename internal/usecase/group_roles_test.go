@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGroupCustomRoleRepository is a mock implementation of GroupCustomRoleRepository
+type MockGroupCustomRoleRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupCustomRoleRepository) Create(ctx context.Context, role *domain.GroupCustomRole) error {
+	args := m.Called(ctx, role)
+	return args.Error(0)
+}
+
+func (m *MockGroupCustomRoleRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.GroupCustomRole, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupCustomRole), args.Error(1)
+}
+
+func (m *MockGroupCustomRoleRepository) Update(ctx context.Context, role *domain.GroupCustomRole) error {
+	args := m.Called(ctx, role)
+	return args.Error(0)
+}
+
+func (m *MockGroupCustomRoleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockGroupCustomRoleRepository) GetByGroup(ctx context.Context, groupID uuid.UUID) ([]*domain.GroupCustomRole, error) {
+	args := m.Called(ctx, groupID)
+	return args.Get(0).([]*domain.GroupCustomRole), args.Error(1)
+}
+
+func (m *MockGroupCustomRoleRepository) AssignToMember(ctx context.Context, groupID, userID uuid.UUID, roleID *uuid.UUID) error {
+	args := m.Called(ctx, groupID, userID, roleID)
+	return args.Error(0)
+}
+
+func TestCreateGroupRoleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("admin defines a judge role", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockRoleRepo := new(MockGroupCustomRoleRepository)
+		useCase := NewCreateGroupRoleUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockRoleRepo)
+
+		mockRoleRepo.On("GetByGroup", ctx, groupID).Return([]*domain.GroupCustomRole{}, nil)
+		mockRoleRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupCustomRole")).Return(nil)
+
+		role, err := useCase.Execute(ctx, &CreateGroupRoleRequest{
+			GroupID:     groupID,
+			UserID:      adminID,
+			Name:        " Judge ",
+			Permissions: []domain.Permission{domain.PermissionCreateEvent, domain.PermissionCheckIn},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Judge", role.Name)
+		assert.Equal(t, groupID, role.GroupID)
+		mockRoleRepo.AssertExpectations(t)
+	})
+
+	t.Run("name already taken", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockRoleRepo := new(MockGroupCustomRoleRepository)
+		useCase := NewCreateGroupRoleUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleOwner), mockRoleRepo)
+
+		mockRoleRepo.On("GetByGroup", ctx, groupID).Return([]*domain.GroupCustomRole{
+			{ID: uuid.New(), GroupID: groupID, Name: "judge", Permissions: []domain.Permission{domain.PermissionCheckIn}},
+		}, nil)
+
+		_, err := useCase.Execute(ctx, &CreateGroupRoleRequest{
+			GroupID:     groupID,
+			UserID:      adminID,
+			Name:        "Judge",
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+		})
+
+		assert.Equal(t, ErrCustomRoleNameTaken, err)
+		mockRoleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("permission that cannot be granted", func(t *testing.T) {
+		groupID := uuid.New()
+		adminID := uuid.New()
+		mockRoleRepo := new(MockGroupCustomRoleRepository)
+		useCase := NewCreateGroupRoleUseCase(announcementGroupRepo(ctx, groupID, adminID, domain.GroupRoleAdmin), mockRoleRepo)
+
+		_, err := useCase.Execute(ctx, &CreateGroupRoleRequest{
+			GroupID:     groupID,
+			UserID:      adminID,
+			Name:        "Co-owner",
+			Permissions: []domain.Permission{domain.PermissionDeleteGroup},
+		})
+
+		assert.Equal(t, domain.ErrInvalidCustomRolePermission, err)
+		mockRoleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("member cannot define roles", func(t *testing.T) {
+		groupID := uuid.New()
+		memberID := uuid.New()
+		mockRoleRepo := new(MockGroupCustomRoleRepository)
+		useCase := NewCreateGroupRoleUseCase(announcementGroupRepo(ctx, groupID, memberID, domain.GroupRoleMember), mockRoleRepo)
+
+		_, err := useCase.Execute(ctx, &CreateGroupRoleRequest{
+			GroupID:     groupID,
+			UserID:      memberID,
+			Name:        "Judge",
+			Permissions: []domain.Permission{domain.PermissionCheckIn},
+		})
+
+		assert.Equal(t, ErrUnauthorizedGroupAccess, err)
+	})
+}
+
+func TestAssignGroupRoleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(groupID uuid.UUID, members ...*domain.GroupMember) (*MockGroupRepository, *MockGroupCustomRoleRepository, *AssignGroupRoleUseCase) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockRoleRepo := new(MockGroupCustomRoleRepository)
+		useCase := NewAssignGroupRoleUseCase(mockGroupRepo, mockRoleRepo)
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		for _, member := range members {
+			mockGroupRepo.On("GetMember", ctx, groupID, member.UserID).Return(member, nil)
+		}
+
+		return mockGroupRepo, mockRoleRepo, useCase
+	}
+
+	t.Run("admin assigns role to member", func(t *testing.T) {
+		groupID := uuid.New()
+		admin := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleAdmin}
+		member := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleMember}
+		_, mockRoleRepo, useCase := setup(groupID, admin, member)
+
+		role := &domain.GroupCustomRole{ID: uuid.New(), GroupID: groupID, Name: "Judge"}
+		mockRoleRepo.On("GetByID", ctx, role.ID).Return(role, nil)
+		mockRoleRepo.On("AssignToMember", ctx, groupID, member.UserID, &role.ID).Return(nil)
+
+		err := useCase.Execute(ctx, &AssignGroupRoleRequest{
+			GroupID:    groupID,
+			UserID:     member.UserID,
+			AssignerID: admin.UserID,
+			RoleID:     &role.ID,
+		})
+
+		assert.NoError(t, err)
+		mockRoleRepo.AssertExpectations(t)
+	})
+
+	t.Run("role from another group", func(t *testing.T) {
+		groupID := uuid.New()
+		admin := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleOwner}
+		member := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleMember}
+		_, mockRoleRepo, useCase := setup(groupID, admin, member)
+
+		role := &domain.GroupCustomRole{ID: uuid.New(), GroupID: uuid.New(), Name: "Judge"}
+		mockRoleRepo.On("GetByID", ctx, role.ID).Return(role, nil)
+
+		err := useCase.Execute(ctx, &AssignGroupRoleRequest{
+			GroupID:    groupID,
+			UserID:     member.UserID,
+			AssignerID: admin.UserID,
+			RoleID:     &role.ID,
+		})
+
+		assert.Equal(t, ErrCustomRoleNotFound, err)
+		mockRoleRepo.AssertNotCalled(t, "AssignToMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("target is not a member", func(t *testing.T) {
+		groupID := uuid.New()
+		admin := &domain.GroupMember{GroupID: groupID, UserID: uuid.New(), Role: domain.GroupRoleAdmin}
+		mockGroupRepo, mockRoleRepo, useCase := setup(groupID, admin)
+
+		outsiderID := uuid.New()
+		mockGroupRepo.On("GetMember", ctx, groupID, outsiderID).Return(nil, nil)
+
+		err := useCase.Execute(ctx, &AssignGroupRoleRequest{
+			GroupID:    groupID,
+			UserID:     outsiderID,
+			AssignerID: admin.UserID,
+		})
+
+		assert.Equal(t, ErrUserNotGroupMember, err)
+		mockRoleRepo.AssertNotCalled(t, "AssignToMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrGroupWebhookNotFound = errors.New("group webhook not found")
+)
+
+// SetGroupWebhookRequest represents the request to register or update a group's Discord webhook
+type SetGroupWebhookRequest struct {
+	GroupID  uuid.UUID `json:"group_id" validate:"required"`
+	UserID   uuid.UUID `json:"user_id" validate:"required"` // User making the request
+	URL      string    `json:"url" validate:"required,url"`
+	Locale   string    `json:"locale,omitempty" validate:"omitempty,oneof=en pt"`
+	Timezone string    `json:"timezone,omitempty"`
+	IsActive *bool     `json:"is_active,omitempty"`
+}
+
+// GetGroupWebhookRequest represents the request to get a group's Discord webhook
+type GetGroupWebhookRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// DeleteGroupWebhookRequest represents the request to remove a group's Discord webhook
+type DeleteGroupWebhookRequest struct {
+	GroupID uuid.UUID `json:"group_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// GroupWebhookResponse represents a group's webhook configuration
+type GroupWebhookResponse struct {
+	Webhook *domain.GroupWebhook `json:"webhook"`
+}
+
+// SetGroupWebhookUseCase handles registering a group's Discord webhook
+type SetGroupWebhookUseCase struct {
+	groupRepo   repository.GroupRepository
+	webhookRepo repository.GroupWebhookRepository
+}
+
+// NewSetGroupWebhookUseCase creates a new SetGroupWebhookUseCase
+func NewSetGroupWebhookUseCase(groupRepo repository.GroupRepository, webhookRepo repository.GroupWebhookRepository) *SetGroupWebhookUseCase {
+	return &SetGroupWebhookUseCase{
+		groupRepo:   groupRepo,
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Execute registers the webhook, replacing any existing configuration
+func (uc *SetGroupWebhookUseCase) Execute(ctx context.Context, req *SetGroupWebhookRequest) (*GroupWebhookResponse, error) {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.webhookRepo.GetByGroupID(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	webhook := existing
+	if webhook == nil {
+		webhook = &domain.GroupWebhook{
+			ID:        uuid.New(),
+			GroupID:   req.GroupID,
+			Locale:    "pt",
+			Timezone:  "UTC",
+			IsActive:  true,
+			CreatedBy: &req.UserID,
+			CreatedAt: now,
+		}
+	}
+
+	webhook.URL = req.URL
+	if req.Locale != "" {
+		webhook.Locale = req.Locale
+	}
+	if req.Timezone != "" {
+		webhook.Timezone = req.Timezone
+	}
+	if req.IsActive != nil {
+		webhook.IsActive = *req.IsActive
+	}
+	webhook.UpdatedAt = now
+
+	if err := webhook.Validate(); err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		err = uc.webhookRepo.Create(ctx, webhook)
+	} else {
+		// A new URL or settings clear the previous delivery error
+		webhook.LastError = nil
+		err = uc.webhookRepo.Update(ctx, webhook)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupWebhookResponse{
+		Webhook: webhook,
+	}, nil
+}
+
+// GetGroupWebhookUseCase handles retrieving a group's Discord webhook
+type GetGroupWebhookUseCase struct {
+	groupRepo   repository.GroupRepository
+	webhookRepo repository.GroupWebhookRepository
+}
+
+// NewGetGroupWebhookUseCase creates a new GetGroupWebhookUseCase
+func NewGetGroupWebhookUseCase(groupRepo repository.GroupRepository, webhookRepo repository.GroupWebhookRepository) *GetGroupWebhookUseCase {
+	return &GetGroupWebhookUseCase{
+		groupRepo:   groupRepo,
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Execute retrieves the webhook configuration of a group
+func (uc *GetGroupWebhookUseCase) Execute(ctx context.Context, req *GetGroupWebhookRequest) (*GroupWebhookResponse, error) {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	webhook, err := uc.webhookRepo.GetByGroupID(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil {
+		return nil, ErrGroupWebhookNotFound
+	}
+
+	return &GroupWebhookResponse{
+		Webhook: webhook,
+	}, nil
+}
+
+// DeleteGroupWebhookUseCase handles removing a group's Discord webhook
+type DeleteGroupWebhookUseCase struct {
+	groupRepo   repository.GroupRepository
+	webhookRepo repository.GroupWebhookRepository
+}
+
+// NewDeleteGroupWebhookUseCase creates a new DeleteGroupWebhookUseCase
+func NewDeleteGroupWebhookUseCase(groupRepo repository.GroupRepository, webhookRepo repository.GroupWebhookRepository) *DeleteGroupWebhookUseCase {
+	return &DeleteGroupWebhookUseCase{
+		groupRepo:   groupRepo,
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Execute removes the webhook configuration of a group
+func (uc *DeleteGroupWebhookUseCase) Execute(ctx context.Context, req *DeleteGroupWebhookRequest) error {
+	if err := checkGroupManagement(ctx, uc.groupRepo, req.GroupID, req.UserID); err != nil {
+		return err
+	}
+
+	webhook, err := uc.webhookRepo.GetByGroupID(ctx, req.GroupID)
+	if err != nil {
+		return err
+	}
+	if webhook == nil {
+		return ErrGroupWebhookNotFound
+	}
+
+	return uc.webhookRepo.Delete(ctx, req.GroupID)
+}
+
+// checkGroupManagement verifies that the group exists and the user can manage it
+func checkGroupManagement(ctx context.Context, groupRepo repository.GroupRepository, groupID, userID uuid.UUID) error {
+	group, err := groupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return ErrGroupNotFound
+	}
+
+	canManage, err := groupRepo.CanUserManageGroup(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if !canManage {
+		return ErrUnauthorizedGroupAccess
+	}
+
+	return nil
+}
+
+// GroupWebhookManagementUseCase provides a unified interface for group webhook operations
+type GroupWebhookManagementUseCase struct {
+	setGroupWebhookUseCase    *SetGroupWebhookUseCase
+	getGroupWebhookUseCase    *GetGroupWebhookUseCase
+	deleteGroupWebhookUseCase *DeleteGroupWebhookUseCase
+}
+
+// NewGroupWebhookManagementUseCase creates a new unified group webhook management use case
+func NewGroupWebhookManagementUseCase(
+	groupRepo repository.GroupRepository,
+	webhookRepo repository.GroupWebhookRepository,
+) *GroupWebhookManagementUseCase {
+	return &GroupWebhookManagementUseCase{
+		setGroupWebhookUseCase:    NewSetGroupWebhookUseCase(groupRepo, webhookRepo),
+		getGroupWebhookUseCase:    NewGetGroupWebhookUseCase(groupRepo, webhookRepo),
+		deleteGroupWebhookUseCase: NewDeleteGroupWebhookUseCase(groupRepo, webhookRepo),
+	}
+}
+
+// SetWebhook registers or updates a group's webhook
+func (uc *GroupWebhookManagementUseCase) SetWebhook(ctx context.Context, req *SetGroupWebhookRequest) (*GroupWebhookResponse, error) {
+	return uc.setGroupWebhookUseCase.Execute(ctx, req)
+}
+
+// GetWebhook retrieves a group's webhook
+func (uc *GroupWebhookManagementUseCase) GetWebhook(ctx context.Context, req *GetGroupWebhookRequest) (*GroupWebhookResponse, error) {
+	return uc.getGroupWebhookUseCase.Execute(ctx, req)
+}
+
+// DeleteWebhook removes a group's webhook
+func (uc *GroupWebhookManagementUseCase) DeleteWebhook(ctx context.Context, req *DeleteGroupWebhookRequest) error {
+	return uc.deleteGroupWebhookUseCase.Execute(ctx, req)
+}
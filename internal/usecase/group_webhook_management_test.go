@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGroupWebhookRepository is a mock implementation of GroupWebhookRepository
+type MockGroupWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *MockGroupWebhookRepository) Create(ctx context.Context, webhook *domain.GroupWebhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockGroupWebhookRepository) GetByGroupID(ctx context.Context, groupID uuid.UUID) (*domain.GroupWebhook, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.GroupWebhook), args.Error(1)
+}
+
+func (m *MockGroupWebhookRepository) Update(ctx context.Context, webhook *domain.GroupWebhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockGroupWebhookRepository) Delete(ctx context.Context, groupID uuid.UUID) error {
+	args := m.Called(ctx, groupID)
+	return args.Error(0)
+}
+
+func (m *MockGroupWebhookRepository) RecordDelivery(ctx context.Context, id uuid.UUID, deliveredAt time.Time, errorMessage *string) error {
+	args := m.Called(ctx, id, deliveredAt, errorMessage)
+	return args.Error(0)
+}
+
+func TestSetGroupWebhookUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	webhookURL := "https://discord.com/api/webhooks/123456789/token"
+
+	t.Run("registers new webhook", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockWebhookRepo := new(MockGroupWebhookRepository)
+		useCase := NewSetGroupWebhookUseCase(mockGroupRepo, mockWebhookRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		req := &SetGroupWebhookRequest{
+			GroupID:  groupID,
+			UserID:   userID,
+			URL:      webhookURL,
+			Timezone: "Europe/Lisbon",
+		}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, userID).Return(true, nil)
+		mockWebhookRepo.On("GetByGroupID", ctx, groupID).Return(nil, nil)
+		mockWebhookRepo.On("Create", ctx, mock.AnythingOfType("*domain.GroupWebhook")).Return(nil)
+
+		resp, err := useCase.Execute(ctx, req)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "pt", resp.Webhook.Locale)
+		assert.Equal(t, "Europe/Lisbon", resp.Webhook.Timezone)
+		assert.True(t, resp.Webhook.IsActive)
+		mockGroupRepo.AssertExpectations(t)
+		mockWebhookRepo.AssertExpectations(t)
+	})
+
+	t.Run("updates existing webhook", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockWebhookRepo := new(MockGroupWebhookRepository)
+		useCase := NewSetGroupWebhookUseCase(mockGroupRepo, mockWebhookRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		lastError := "HTTP 404"
+		existing := &domain.GroupWebhook{
+			ID:        uuid.New(),
+			GroupID:   groupID,
+			URL:       "https://discord.com/api/webhooks/1/old",
+			Locale:    "pt",
+			Timezone:  "UTC",
+			IsActive:  true,
+			LastError: &lastError,
+		}
+
+		req := &SetGroupWebhookRequest{
+			GroupID: groupID,
+			UserID:  userID,
+			URL:     webhookURL,
+			Locale:  "en",
+		}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, userID).Return(true, nil)
+		mockWebhookRepo.On("GetByGroupID", ctx, groupID).Return(existing, nil)
+		mockWebhookRepo.On("Update", ctx, existing).Return(nil)
+
+		resp, err := useCase.Execute(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, webhookURL, resp.Webhook.URL)
+		assert.Equal(t, "en", resp.Webhook.Locale)
+		assert.Nil(t, resp.Webhook.LastError)
+		mockWebhookRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects non discord url", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockWebhookRepo := new(MockGroupWebhookRepository)
+		useCase := NewSetGroupWebhookUseCase(mockGroupRepo, mockWebhookRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		req := &SetGroupWebhookRequest{
+			GroupID: groupID,
+			UserID:  userID,
+			URL:     "https://example.com/hook",
+		}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, userID).Return(true, nil)
+		mockWebhookRepo.On("GetByGroupID", ctx, groupID).Return(nil, nil)
+
+		resp, err := useCase.Execute(ctx, req)
+
+		assert.Equal(t, domain.ErrInvalidWebhookURL, err)
+		assert.Nil(t, resp)
+		mockWebhookRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unauthorized access", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockWebhookRepo := new(MockGroupWebhookRepository)
+		useCase := NewSetGroupWebhookUseCase(mockGroupRepo, mockWebhookRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		req := &SetGroupWebhookRequest{
+			GroupID: groupID,
+			UserID:  userID,
+			URL:     webhookURL,
+		}
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, userID).Return(false, nil)
+
+		resp, err := useCase.Execute(ctx, req)
+
+		assert.Equal(t, ErrUnauthorizedGroupAccess, err)
+		assert.Nil(t, resp)
+		mockWebhookRepo.AssertExpectations(t)
+	})
+}
+
+func TestDeleteGroupWebhookUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("webhook not found", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockWebhookRepo := new(MockGroupWebhookRepository)
+		useCase := NewDeleteGroupWebhookUseCase(mockGroupRepo, mockWebhookRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, userID).Return(true, nil)
+		mockWebhookRepo.On("GetByGroupID", ctx, groupID).Return(nil, nil)
+
+		err := useCase.Execute(ctx, &DeleteGroupWebhookRequest{GroupID: groupID, UserID: userID})
+
+		assert.Equal(t, ErrGroupWebhookNotFound, err)
+		mockWebhookRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("successful deletion", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockWebhookRepo := new(MockGroupWebhookRepository)
+		useCase := NewDeleteGroupWebhookUseCase(mockGroupRepo, mockWebhookRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID}, nil)
+		mockGroupRepo.On("CanUserManageGroup", ctx, groupID, userID).Return(true, nil)
+		mockWebhookRepo.On("GetByGroupID", ctx, groupID).Return(&domain.GroupWebhook{GroupID: groupID}, nil)
+		mockWebhookRepo.On("Delete", ctx, groupID).Return(nil)
+
+		err := useCase.Execute(ctx, &DeleteGroupWebhookRequest{GroupID: groupID, UserID: userID})
+
+		assert.NoError(t, err)
+		mockWebhookRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+// GetMapClustersRequest represents the map area to cluster
+type GetMapClustersRequest struct {
+	Bounds domain.BoundingBox       `json:"bounds" validate:"required"`
+	Zoom   int                      `json:"zoom"`
+	Game   *domain.GameType         `json:"game,omitempty"`
+	Layers []domain.MapClusterLayer `json:"layers,omitempty"` // Defaults to both venues and events
+}
+
+// GetMapClustersResponse represents the venue and event clusters within a map area
+type GetMapClustersResponse struct {
+	Zoom     int                  `json:"zoom"`
+	CellSize float64              `json:"cell_size"`
+	Venues   []*domain.MapCluster `json:"venues"`
+	Events   []*domain.MapCluster `json:"events"`
+}
+
+// GetMapClustersUseCase handles clustering venues and upcoming events for zoomed-out maps
+type GetMapClustersUseCase struct {
+	clusterRepo repository.MapClusterRepository
+}
+
+// NewGetMapClustersUseCase creates a new GetMapClustersUseCase
+func NewGetMapClustersUseCase(clusterRepo repository.MapClusterRepository) *GetMapClustersUseCase {
+	return &GetMapClustersUseCase{
+		clusterRepo: clusterRepo,
+	}
+}
+
+// Execute returns the clusters of the requested layers
+func (uc *GetMapClustersUseCase) Execute(ctx context.Context, req *GetMapClustersRequest) (*GetMapClustersResponse, error) {
+	params := domain.MapClusterParams{
+		Bounds: req.Bounds,
+		Zoom:   req.Zoom,
+		Game:   req.Game,
+		From:   time.Now().UTC(),
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	layers := req.Layers
+	if len(layers) == 0 {
+		layers = []domain.MapClusterLayer{domain.MapClusterLayerVenues, domain.MapClusterLayerEvents}
+	}
+	for _, layer := range layers {
+		if !domain.IsValidMapClusterLayer(layer) {
+			return nil, domain.ErrInvalidMapLayer
+		}
+	}
+
+	grid, err := domain.NewMapGrid(req.Zoom)
+	if err != nil {
+		return nil, err
+	}
+	if grid.CountCells(req.Bounds) > domain.MaxMapClusterCells {
+		return nil, domain.ErrMapBoundsTooLarge
+	}
+
+	response := &GetMapClustersResponse{
+		Zoom:     grid.Zoom,
+		CellSize: grid.CellSize,
+		Venues:   []*domain.MapCluster{},
+		Events:   []*domain.MapCluster{},
+	}
+
+	for _, layer := range layers {
+		switch layer {
+		case domain.MapClusterLayerVenues:
+			response.Venues, err = uc.clusterRepo.GetVenueClusters(ctx, grid, params)
+		case domain.MapClusterLayerEvents:
+			response.Events, err = uc.clusterRepo.GetEventClusters(ctx, grid, params)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// MapClustersUseCase combines map clustering operations
+type MapClustersUseCase struct {
+	getMapClustersUseCase *GetMapClustersUseCase
+}
+
+// NewMapClustersUseCase creates a new MapClustersUseCase
+func NewMapClustersUseCase(clusterRepo repository.MapClusterRepository) *MapClustersUseCase {
+	return &MapClustersUseCase{
+		getMapClustersUseCase: NewGetMapClustersUseCase(clusterRepo),
+	}
+}
+
+// GetMapClusters returns venue and event clusters for a map area
+func (uc *MapClustersUseCase) GetMapClusters(ctx context.Context, req *GetMapClustersRequest) (*GetMapClustersResponse, error) {
+	return uc.getMapClustersUseCase.Execute(ctx, req)
+}
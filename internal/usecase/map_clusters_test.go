@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMapClusterRepository is a mock implementation of MapClusterRepository
+type MockMapClusterRepository struct {
+	mock.Mock
+}
+
+func (m *MockMapClusterRepository) GetVenueClusters(ctx context.Context, grid *domain.MapGrid, params domain.MapClusterParams) ([]*domain.MapCluster, error) {
+	args := m.Called(ctx, grid, params)
+	return args.Get(0).([]*domain.MapCluster), args.Error(1)
+}
+
+func (m *MockMapClusterRepository) GetEventClusters(ctx context.Context, grid *domain.MapGrid, params domain.MapClusterParams) ([]*domain.MapCluster, error) {
+	args := m.Called(ctx, grid, params)
+	return args.Get(0).([]*domain.MapCluster), args.Error(1)
+}
+
+func TestGetMapClustersUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	portugal := domain.BoundingBox{
+		NorthEast: domain.Coordinates{Latitude: 42.2, Longitude: -6.1},
+		SouthWest: domain.Coordinates{Latitude: 36.9, Longitude: -9.6},
+	}
+	venueClusters := []*domain.MapCluster{{ID: "7/-25/117", Layer: domain.MapClusterLayerVenues, Count: 40}}
+	eventClusters := []*domain.MapCluster{{ID: "7/-25/117", Layer: domain.MapClusterLayerEvents, Count: 12}}
+
+	t.Run("clusters both layers by default", func(t *testing.T) {
+		clusterRepo := new(MockMapClusterRepository)
+		clusterRepo.On("GetVenueClusters", ctx, mock.AnythingOfType("*domain.MapGrid"), mock.AnythingOfType("domain.MapClusterParams")).Return(venueClusters, nil)
+		clusterRepo.On("GetEventClusters", ctx, mock.AnythingOfType("*domain.MapGrid"), mock.AnythingOfType("domain.MapClusterParams")).Return(eventClusters, nil)
+
+		result, err := NewGetMapClustersUseCase(clusterRepo).Execute(ctx, &GetMapClustersRequest{Bounds: portugal, Zoom: 7})
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, result.Zoom)
+		assert.Equal(t, venueClusters, result.Venues)
+		assert.Equal(t, eventClusters, result.Events)
+	})
+
+	t.Run("clusters only the requested layer", func(t *testing.T) {
+		clusterRepo := new(MockMapClusterRepository)
+		clusterRepo.On("GetEventClusters", ctx, mock.AnythingOfType("*domain.MapGrid"), mock.AnythingOfType("domain.MapClusterParams")).Return(eventClusters, nil)
+
+		result, err := NewGetMapClustersUseCase(clusterRepo).Execute(ctx, &GetMapClustersRequest{
+			Bounds: portugal,
+			Zoom:   7,
+			Layers: []domain.MapClusterLayer{domain.MapClusterLayerEvents},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Venues)
+		assert.Equal(t, eventClusters, result.Events)
+		clusterRepo.AssertNotCalled(t, "GetVenueClusters", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		clusterRepo := new(MockMapClusterRepository)
+		useCase := NewGetMapClustersUseCase(clusterRepo)
+		world := domain.BoundingBox{
+			NorthEast: domain.Coordinates{Latitude: 85, Longitude: 180},
+			SouthWest: domain.Coordinates{Latitude: -85, Longitude: -180},
+		}
+
+		tests := []struct {
+			name    string
+			req     *GetMapClustersRequest
+			wantErr error
+		}{
+			{"invalid zoom", &GetMapClustersRequest{Bounds: portugal, Zoom: 25}, domain.ErrInvalidMapZoom},
+			{"swapped bounds", &GetMapClustersRequest{Bounds: domain.BoundingBox{NorthEast: portugal.SouthWest, SouthWest: portugal.NorthEast}, Zoom: 7}, domain.ErrInvalidMapBounds},
+			{"unknown layer", &GetMapClustersRequest{Bounds: portugal, Zoom: 7, Layers: []domain.MapClusterLayer{"groups"}}, domain.ErrInvalidMapLayer},
+			{"whole world zoomed in", &GetMapClustersRequest{Bounds: world, Zoom: 12}, domain.ErrMapBoundsTooLarge},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := useCase.Execute(ctx, tt.req)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, result)
+			})
+		}
+		clusterRepo.AssertNotCalled(t, "GetVenueClusters", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
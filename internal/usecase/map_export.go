@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matchtcg/backend/internal/domain"
+)
+
+const (
+	// MaxMapExportFeatures caps how many venues or events a single export returns
+	MaxMapExportFeatures = 1000
+
+	// mapExportPageSize matches the largest page the venue and event searches return
+	mapExportPageSize = 100
+)
+
+// MapExportFeature is a venue or event placed on a map
+type MapExportFeature struct {
+	ID          uuid.UUID                `json:"id"`
+	Name        string                   `json:"name"`
+	Coordinates domain.Coordinates       `json:"coordinates"`
+	Venue       *domain.Venue            `json:"venue"`           // The venue itself, or where the event takes place
+	Event       *domain.EventWithDetails `json:"event,omitempty"` // Set for event features only
+}
+
+// MapExportUseCase exports venues and upcoming public events for community maps
+type MapExportUseCase struct {
+	venueManagementUseCase *VenueManagementUseCase
+	eventManagementUseCase *EventManagementUseCase
+}
+
+// NewMapExportUseCase creates a new MapExportUseCase
+func NewMapExportUseCase(venueManagementUseCase *VenueManagementUseCase, eventManagementUseCase *EventManagementUseCase) *MapExportUseCase {
+	return &MapExportUseCase{
+		venueManagementUseCase: venueManagementUseCase,
+		eventManagementUseCase: eventManagementUseCase,
+	}
+}
+
+// ExportVenues returns the venues matching a venue search. Limit caps the whole export rather than a
+// page, up to MaxMapExportFeatures.
+func (uc *MapExportUseCase) ExportVenues(ctx context.Context, req *SearchVenuesRequest) ([]*MapExportFeature, error) {
+	if req == nil {
+		return nil, ErrInvalidSearchParams
+	}
+
+	limit := mapExportLimit(req.Limit)
+	page := *req
+	features := []*MapExportFeature{}
+
+	for len(features) < limit {
+		page.Limit = min(mapExportPageSize, limit-len(features))
+
+		// Searching may fill in defaults, so every page searches a fresh copy
+		pageReq := page
+		result, err := uc.venueManagementUseCase.SearchVenues(ctx, &pageReq)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, venue := range result.Venues {
+			features = append(features, &MapExportFeature{
+				ID:          venue.ID,
+				Name:        venue.Name,
+				Coordinates: venue.GetCoordinates(),
+				Venue:       venue.Venue,
+			})
+		}
+
+		if !result.HasMore || len(result.Venues) == 0 {
+			break
+		}
+		page.Offset += page.Limit
+	}
+
+	return features, nil
+}
+
+// ExportEvents returns the upcoming public events matching an event search. Events without a venue
+// have nowhere to be placed and are left out.
+func (uc *MapExportUseCase) ExportEvents(ctx context.Context, req *SearchEventsRequest) ([]*MapExportFeature, error) {
+	if req == nil {
+		return nil, ErrInvalidSearchParams
+	}
+
+	// Event search does not check its location filter, so it is checked here the way venue search does
+	if req.RadiusKm != nil && req.Near == nil {
+		return nil, ErrInvalidSearchParams
+	}
+	if req.Near != nil {
+		if err := req.Near.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if req.RadiusKm != nil && (*req.RadiusKm < 1 || *req.RadiusKm > 100) {
+		return nil, ErrInvalidSearchRadius
+	}
+
+	limit := mapExportLimit(req.Limit)
+	page := *req
+
+	// Exports are published outside the platform, so only public events are ever included
+	public := domain.EventVisibilityPublic
+	page.Visibility = &public
+	page.UserID = uuid.Nil
+
+	now := time.Now()
+	if page.StartFrom == nil || page.StartFrom.Before(now) {
+		page.StartFrom = &now
+	}
+
+	features := []*MapExportFeature{}
+	for len(features) < limit {
+		page.Limit = min(mapExportPageSize, limit-len(features))
+
+		result, err := uc.eventManagementUseCase.SearchEvents(ctx, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, found := range result.Events {
+			event := found.Event
+			if event.Venue == nil {
+				continue
+			}
+			features = append(features, &MapExportFeature{
+				ID:          event.ID,
+				Name:        event.Title,
+				Coordinates: event.Venue.GetCoordinates(),
+				Venue:       event.Venue,
+				Event:       event,
+			})
+		}
+
+		if !result.HasMore || len(result.Events) == 0 {
+			break
+		}
+		page.Offset += page.Limit
+	}
+
+	return features, nil
+}
+
+// mapExportLimit applies the export cap to a requested limit
+func mapExportLimit(limit int) int {
+	if limit <= 0 || limit > MaxMapExportFeatures {
+		return MaxMapExportFeatures
+	}
+	return limit
+}
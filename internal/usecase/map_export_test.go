@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapExportUseCase_ExportVenues(t *testing.T) {
+	ctx := context.Background()
+
+	venues := make([]*domain.Venue, 150)
+	for i := range venues {
+		venues[i] = &domain.Venue{
+			ID:        uuid.New(),
+			Name:      fmt.Sprintf("Store %d", i),
+			City:      "Porto",
+			Latitude:  41.1 + float64(i)/1000,
+			Longitude: -8.6,
+		}
+	}
+
+	venueRepo := NewMockVenueRepository()
+	var pages [][2]int
+	venueRepo.searchByCityFunc = func(ctx context.Context, city string, limit, offset int) ([]*domain.Venue, error) {
+		pages = append(pages, [2]int{limit, offset})
+		end := min(offset+limit, len(venues))
+		if offset >= end {
+			return nil, nil
+		}
+		return venues[offset:end], nil
+	}
+
+	venueManagement := NewVenueManagementUseCase(venueRepo, service.NewGeocodingService(&service.MockGeocodingProvider{}), domain.NewGeospatialService())
+	useCase := NewMapExportUseCase(venueManagement, nil)
+	city := "Porto"
+
+	t.Run("pages through every matching venue", func(t *testing.T) {
+		pages = nil
+
+		features, err := useCase.ExportVenues(ctx, &SearchVenuesRequest{City: &city})
+
+		require.NoError(t, err)
+		require.Len(t, features, 150)
+		assert.Equal(t, [][2]int{{100, 0}, {100, 100}}, pages)
+		assert.Equal(t, venues[120].ID, features[120].ID)
+		assert.Equal(t, "Store 120", features[120].Name)
+		assert.Equal(t, venues[120].GetCoordinates(), features[120].Coordinates)
+		assert.Nil(t, features[120].Event)
+	})
+
+	t.Run("limit caps the whole export", func(t *testing.T) {
+		pages = nil
+
+		features, err := useCase.ExportVenues(ctx, &SearchVenuesRequest{City: &city, Limit: 120, Offset: 10})
+
+		require.NoError(t, err)
+		require.Len(t, features, 120)
+		assert.Equal(t, [][2]int{{100, 10}, {20, 110}}, pages)
+		assert.Equal(t, venues[10].ID, features[0].ID)
+	})
+
+	t.Run("search errors pass through", func(t *testing.T) {
+		_, err := useCase.ExportVenues(ctx, &SearchVenuesRequest{SortBy: "distance"})
+		assert.Equal(t, ErrInvalidVenueSort, err)
+	})
+}
+
+func TestMapExportUseCase_ExportEvents(t *testing.T) {
+	ctx := context.Background()
+	venue := &domain.Venue{ID: uuid.New(), Name: "Arena Porto", Latitude: 41.1466, Longitude: -8.6060}
+
+	newEvent := func(title string, venue *domain.Venue) *domain.EventWithDetails {
+		event := &domain.EventWithDetails{
+			Event: domain.Event{
+				ID:         uuid.New(),
+				Title:      title,
+				Game:       domain.GameTypeMTG,
+				Visibility: domain.EventVisibilityPublic,
+				StartAt:    time.Now().Add(24 * time.Hour),
+			},
+			Venue: venue,
+		}
+		if venue != nil {
+			event.VenueID = &venue.ID
+		}
+		return event
+	}
+
+	t.Run("exports upcoming public events at venues", func(t *testing.T) {
+		eventRepo := &MockEventRepository{}
+		eventManagement := NewEventManagementUseCase(eventRepo, nil, &MockGroupRepository{}, nil, nil, nil, domain.NewGeospatialService(), nil, nil, new(MockEventInviteRepository), nil)
+		useCase := NewMapExportUseCase(nil, eventManagement)
+
+		events := []*domain.EventWithDetails{newEvent("Friday Night Magic", venue), newEvent("Online Draft", nil)}
+		eventRepo.On("SearchWithDetails", ctx, mock.MatchedBy(func(params domain.EventSearchParams) bool {
+			return params.Visibility != nil && *params.Visibility == domain.EventVisibilityPublic &&
+				params.StartFrom != nil && !params.StartFrom.Before(time.Now().Add(-time.Minute)) &&
+				params.Game != nil && *params.Game == domain.GameTypeMTG
+		})).Return(events, nil)
+
+		game := domain.GameTypeMTG
+		private := domain.EventVisibilityPrivate
+		lastYear := time.Now().AddDate(-1, 0, 0)
+		features, err := useCase.ExportEvents(ctx, &SearchEventsRequest{
+			Game:       &game,
+			Visibility: &private,
+			StartFrom:  &lastYear,
+			UserID:     uuid.New(),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, features, 1, "events without a venue are left out")
+		assert.Equal(t, "Friday Night Magic", features[0].Name)
+		assert.Equal(t, venue.GetCoordinates(), features[0].Coordinates)
+		assert.Equal(t, venue, features[0].Venue)
+		assert.Equal(t, events[0], features[0].Event)
+		eventRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects an invalid location filter", func(t *testing.T) {
+		eventRepo := &MockEventRepository{}
+		eventManagement := NewEventManagementUseCase(eventRepo, nil, &MockGroupRepository{}, nil, nil, nil, domain.NewGeospatialService(), nil, nil, new(MockEventInviteRepository), nil)
+		useCase := NewMapExportUseCase(nil, eventManagement)
+
+		porto := &domain.Coordinates{Latitude: 41.1579, Longitude: -8.6291}
+		tooFar := 500
+		nearby := 10
+
+		tests := []struct {
+			name string
+			req  *SearchEventsRequest
+			want error
+		}{
+			{"radius out of range", &SearchEventsRequest{Near: porto, RadiusKm: &tooFar}, ErrInvalidSearchRadius},
+			{"invalid coordinates", &SearchEventsRequest{Near: &domain.Coordinates{Latitude: 91}}, domain.ErrInvalidCoordinates},
+			{"radius without a location", &SearchEventsRequest{RadiusKm: &nearby}, ErrInvalidSearchParams},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := useCase.ExportEvents(ctx, tt.req)
+				assert.Equal(t, tt.want, err)
+			})
+		}
+		eventRepo.AssertNotCalled(t, "SearchWithDetails", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrInvalidCurrentPassword = errors.New("current password is incorrect")
+	ErrPasswordUnchanged      = errors.New("new password must differ from the current password")
+)
+
+// ChangePasswordRequest represents a user's request to change their password
+type ChangePasswordRequest struct {
+	UserID          uuid.UUID `json:"user_id" validate:"required"` // User making the request
+	CurrentPassword string    `json:"current_password" validate:"required"`
+	NewPassword     string    `json:"new_password" validate:"required,min=8"`
+}
+
+// ChangePasswordUseCase handles password changes for authenticated users
+type ChangePasswordUseCase struct {
+	userRepo       repository.UserRepository
+	passwordHasher PasswordHasher
+	auditRecorder  AuditRecorder
+}
+
+// NewChangePasswordUseCase creates a new ChangePasswordUseCase
+func NewChangePasswordUseCase(userRepo repository.UserRepository, passwordHasher PasswordHasher, auditRecorder AuditRecorder) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		userRepo:       userRepo,
+		passwordHasher: passwordHasher,
+		auditRecorder:  auditRecorder,
+	}
+}
+
+// Execute verifies the current password and replaces it with the new one
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, req *ChangePasswordRequest) error {
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsActive {
+		return ErrUserNotFound
+	}
+
+	valid, err := uc.passwordHasher.VerifyPassword(req.CurrentPassword, user.PasswordHash)
+	if err != nil || !valid {
+		return ErrInvalidCurrentPassword
+	}
+
+	if len(req.NewPassword) < 8 {
+		return ErrWeakPassword
+	}
+
+	if req.NewPassword == req.CurrentPassword {
+		return ErrPasswordUnchanged
+	}
+
+	passwordHash, err := uc.passwordHasher.HashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	user.PasswordHash = passwordHash
+	user.UpdatedAt = time.Now().UTC()
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Password hashes are never written to the audit log, only the fact that the password changed
+	entry := &domain.AuditLogEntry{
+		ActorID:       &user.ID,
+		Action:        domain.AuditActionPasswordChange,
+		TargetType:    domain.AuditTargetUser,
+		TargetID:      user.ID,
+		SubjectUserID: &user.ID,
+	}
+	recordAudit(ctx, uc.auditRecorder, entry)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestChangePasswordUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("changes password and records audit entry", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockHasher := new(MockPasswordHasher)
+		mockRecorder := new(MockAuditRecorder)
+		useCase := NewChangePasswordUseCase(mockUserRepo, mockHasher, mockRecorder)
+
+		userID := uuid.New()
+		user := &domain.User{ID: userID, Email: "player@example.com", PasswordHash: "old_hash", IsActive: true}
+
+		mockUserRepo.On("GetByID", ctx, userID).Return(user, nil)
+		mockHasher.On("VerifyPassword", "OldPassword1!", "old_hash").Return(true, nil)
+		mockHasher.On("HashPassword", "NewPassword1!").Return("new_hash", nil)
+		mockUserRepo.On("Update", ctx, mock.MatchedBy(func(u *domain.User) bool {
+			return u.PasswordHash == "new_hash"
+		})).Return(nil)
+		mockRecorder.On("Record", ctx, mock.MatchedBy(func(entry *domain.AuditLogEntry) bool {
+			return entry.Action == domain.AuditActionPasswordChange && entry.TargetID == userID && !entry.HasChanges()
+		})).Return(nil)
+
+		err := useCase.Execute(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "OldPassword1!", NewPassword: "NewPassword1!"})
+
+		assert.NoError(t, err)
+		mockUserRepo.AssertExpectations(t)
+		mockRecorder.AssertExpectations(t)
+	})
+
+	t.Run("wrong current password", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockHasher := new(MockPasswordHasher)
+		useCase := NewChangePasswordUseCase(mockUserRepo, mockHasher, nil)
+
+		userID := uuid.New()
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, PasswordHash: "old_hash", IsActive: true}, nil)
+		mockHasher.On("VerifyPassword", "guess", "old_hash").Return(false, nil)
+
+		err := useCase.Execute(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "guess", NewPassword: "NewPassword1!"})
+
+		assert.Equal(t, ErrInvalidCurrentPassword, err)
+		mockUserRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("weak new password", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockHasher := new(MockPasswordHasher)
+		useCase := NewChangePasswordUseCase(mockUserRepo, mockHasher, nil)
+
+		userID := uuid.New()
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, PasswordHash: "old_hash", IsActive: true}, nil)
+		mockHasher.On("VerifyPassword", "OldPassword1!", "old_hash").Return(true, nil)
+
+		err := useCase.Execute(ctx, &ChangePasswordRequest{UserID: userID, CurrentPassword: "OldPassword1!", NewPassword: "short"})
+
+		assert.Equal(t, ErrWeakPassword, err)
+	})
+}
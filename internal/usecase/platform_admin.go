@@ -0,0 +1,558 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrPlatformAdminOnly     = errors.New("only platform admins can use the admin API")
+	ErrNotificationNotFound  = errors.New("notification not found")
+	ErrNotificationNotFailed = errors.New("only failed notifications can be resent")
+	ErrCannotImpersonate     = errors.New("platform staff and inactive accounts cannot be impersonated")
+)
+
+// ImpersonationTokenIssuer issues short-lived tokens that let an admin act as a user
+type ImpersonationTokenIssuer interface {
+	GenerateImpersonationToken(userID, email, impersonatorID string) (string, time.Time, error)
+}
+
+// NotificationSender delivers a queued notification
+type NotificationSender interface {
+	SendNotification(ctx context.Context, notification *domain.Notification) error
+}
+
+// SearchUsersRequest represents an admin search over user accounts
+type SearchUsersRequest struct {
+	AdminID uuid.UUID `json:"admin_id" validate:"required"` // User making the request
+	Query   string    `json:"query,omitempty"`              // Matches email or display name
+	Limit   int       `json:"limit"`
+	Offset  int       `json:"offset"`
+}
+
+// SearchUsersResponse represents a page of user accounts
+type SearchUsersResponse struct {
+	Users  []*domain.User `json:"users"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
+
+// SetUserActiveRequest represents the request to deactivate or reactivate an account
+type SetUserActiveRequest struct {
+	AdminID uuid.UUID `json:"admin_id" validate:"required"` // User making the request
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+	Active  bool      `json:"active"`
+	Reason  *string   `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// ChangePlatformRoleRequest represents the request to change a user's platform role
+type ChangePlatformRoleRequest struct {
+	AdminID uuid.UUID           `json:"admin_id" validate:"required"` // User making the request
+	UserID  uuid.UUID           `json:"user_id" validate:"required"`
+	Role    domain.PlatformRole `json:"role" validate:"required"`
+	Reason  *string             `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// ImpersonateUserRequest represents an admin's request to act as a user for support
+type ImpersonateUserRequest struct {
+	AdminID uuid.UUID `json:"admin_id" validate:"required"` // User making the request
+	UserID  uuid.UUID `json:"user_id" validate:"required"`
+	Reason  string    `json:"reason" validate:"required,max=500"` // Support ticket or justification
+}
+
+// ImpersonateUserResponse represents a support session token
+type ImpersonateUserResponse struct {
+	UserID      uuid.UUID `json:"user_id"`
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// ListNotificationsRequest represents the request to inspect the notification queue
+type ListNotificationsRequest struct {
+	AdminID uuid.UUID                 `json:"admin_id" validate:"required"` // User making the request
+	Status  domain.NotificationStatus `json:"status,omitempty"`             // Defaults to failed notifications
+	Limit   int                       `json:"limit"`
+	Offset  int                       `json:"offset"`
+}
+
+// ListNotificationsResponse represents a page of the notification queue and its totals per status
+type ListNotificationsResponse struct {
+	Notifications []*domain.Notification            `json:"notifications"`
+	Counts        map[domain.NotificationStatus]int `json:"counts"`
+	Limit         int                               `json:"limit"`
+	Offset        int                               `json:"offset"`
+}
+
+// ResendNotificationRequest represents the request to force a failed notification to be sent again
+type ResendNotificationRequest struct {
+	AdminID        uuid.UUID `json:"admin_id" validate:"required"` // User making the request
+	NotificationID uuid.UUID `json:"notification_id" validate:"required"`
+	Reason         *string   `json:"reason,omitempty" validate:"omitempty,max=500"`
+}
+
+// ListAdminActionsRequest represents the request to read the admin audit trail
+type ListAdminActionsRequest struct {
+	AdminID      uuid.UUID  `json:"admin_id" validate:"required"` // User making the request
+	TargetUserID *uuid.UUID `json:"target_user_id,omitempty"`
+	Limit        int        `json:"limit"`
+	Offset       int        `json:"offset"`
+}
+
+// ListAdminActionsResponse represents a page of the admin audit trail
+type ListAdminActionsResponse struct {
+	Actions []*domain.AdminAction `json:"actions"`
+	Limit   int                   `json:"limit"`
+	Offset  int                   `json:"offset"`
+}
+
+// SearchUsersUseCase handles admins searching user accounts
+type SearchUsersUseCase struct {
+	adminRepo repository.PlatformAdminRepository
+	userRepo  repository.UserRepository
+}
+
+// NewSearchUsersUseCase creates a new SearchUsersUseCase
+func NewSearchUsersUseCase(adminRepo repository.PlatformAdminRepository, userRepo repository.UserRepository) *SearchUsersUseCase {
+	return &SearchUsersUseCase{
+		adminRepo: adminRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute returns accounts matching the query
+func (uc *SearchUsersUseCase) Execute(ctx context.Context, req *SearchUsersRequest) (*SearchUsersResponse, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	users, err := uc.adminRepo.SearchUsers(ctx, req.Query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchUsersResponse{
+		Users:  users,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// SetUserActiveUseCase handles admins deactivating and reactivating accounts
+type SetUserActiveUseCase struct {
+	adminRepo repository.PlatformAdminRepository
+	userRepo  repository.UserRepository
+}
+
+// NewSetUserActiveUseCase creates a new SetUserActiveUseCase
+func NewSetUserActiveUseCase(adminRepo repository.PlatformAdminRepository, userRepo repository.UserRepository) *SetUserActiveUseCase {
+	return &SetUserActiveUseCase{
+		adminRepo: adminRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute deactivates or reactivates an account and records the change in the audit trail
+func (uc *SetUserActiveUseCase) Execute(ctx context.Context, req *SetUserActiveRequest) (*domain.User, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	if req.UserID == req.AdminID {
+		return nil, domain.ErrAdminCannotTargetSelf
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	// Nothing to change, nothing to audit
+	if user.IsActive == req.Active {
+		return user, nil
+	}
+
+	actionType := domain.AdminActionDeactivateUser
+	if req.Active {
+		actionType = domain.AdminActionReactivateUser
+	}
+
+	now := time.Now().UTC()
+	action := domain.NewAdminAction(req.AdminID, actionType, user.ID, req.Reason, nil, now)
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.adminRepo.SetUserActive(ctx, user.ID, req.Active, action); err != nil {
+		return nil, err
+	}
+
+	user.IsActive = req.Active
+	user.UpdatedAt = now
+	return user, nil
+}
+
+// ChangePlatformRoleUseCase handles admins granting and revoking platform roles
+type ChangePlatformRoleUseCase struct {
+	adminRepo     repository.PlatformAdminRepository
+	userRepo      repository.UserRepository
+	auditRecorder AuditRecorder
+}
+
+// NewChangePlatformRoleUseCase creates a new ChangePlatformRoleUseCase
+func NewChangePlatformRoleUseCase(adminRepo repository.PlatformAdminRepository, userRepo repository.UserRepository) *ChangePlatformRoleUseCase {
+	return &ChangePlatformRoleUseCase{
+		adminRepo: adminRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute changes a user's platform role and records the change in the audit trail.
+// Admins cannot change their own role, so the platform can never lose its last admin by accident.
+func (uc *ChangePlatformRoleUseCase) Execute(ctx context.Context, req *ChangePlatformRoleRequest) (*domain.User, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	if !domain.IsValidPlatformRole(req.Role) {
+		return nil, domain.ErrInvalidPlatformRole
+	}
+
+	if req.UserID == req.AdminID {
+		return nil, domain.ErrAdminCannotTargetSelf
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if user.PlatformRole == req.Role {
+		return user, nil
+	}
+
+	now := time.Now().UTC()
+	metadata := map[string]interface{}{
+		"from": string(user.PlatformRole),
+		"to":   string(req.Role),
+	}
+	action := domain.NewAdminAction(req.AdminID, domain.AdminActionChangeRole, user.ID, req.Reason, metadata, now)
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.adminRepo.SetPlatformRole(ctx, user.ID, req.Role, action); err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, uc.auditRecorder, auditChange(req.AdminID, domain.AuditActionRoleChange, domain.AuditTargetUser, user.ID, &user.ID,
+		map[string]interface{}{"platform_role": user.PlatformRole}, map[string]interface{}{"platform_role": req.Role}))
+
+	user.PlatformRole = req.Role
+	user.UpdatedAt = now
+	return user, nil
+}
+
+// ImpersonateUserUseCase handles admins acting as a user for support
+type ImpersonateUserUseCase struct {
+	adminRepo   repository.PlatformAdminRepository
+	userRepo    repository.UserRepository
+	tokenIssuer ImpersonationTokenIssuer
+}
+
+// NewImpersonateUserUseCase creates a new ImpersonateUserUseCase
+func NewImpersonateUserUseCase(adminRepo repository.PlatformAdminRepository, userRepo repository.UserRepository, tokenIssuer ImpersonationTokenIssuer) *ImpersonateUserUseCase {
+	return &ImpersonateUserUseCase{
+		adminRepo:   adminRepo,
+		userRepo:    userRepo,
+		tokenIssuer: tokenIssuer,
+	}
+}
+
+// Execute records the impersonation in the audit trail and issues a support token for the user
+func (uc *ImpersonateUserUseCase) Execute(ctx context.Context, req *ImpersonateUserRequest) (*ImpersonateUserResponse, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	if req.UserID == req.AdminID {
+		return nil, domain.ErrAdminCannotTargetSelf
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if !user.IsActive || user.PlatformRole.CanModerateContent() {
+		return nil, ErrCannotImpersonate
+	}
+
+	reason := req.Reason
+	action := domain.NewAdminAction(req.AdminID, domain.AdminActionImpersonateUser, user.ID, &reason, nil, time.Now().UTC())
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The audit entry is written before any token exists
+	if err := uc.adminRepo.CreateAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := uc.tokenIssuer.GenerateImpersonationToken(user.ID.String(), user.Email, req.AdminID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImpersonateUserResponse{
+		UserID:      user.ID,
+		AccessToken: token,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+// ListNotificationsUseCase handles admins inspecting the notification queue
+type ListNotificationsUseCase struct {
+	notificationRepo repository.NotificationRepository
+	userRepo         repository.UserRepository
+}
+
+// NewListNotificationsUseCase creates a new ListNotificationsUseCase
+func NewListNotificationsUseCase(notificationRepo repository.NotificationRepository, userRepo repository.UserRepository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+	}
+}
+
+// Execute returns notifications in one status along with the size of every queue
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	status := req.Status
+	if status == "" {
+		status = domain.NotificationStatusFailed
+	}
+	if !(&domain.Notification{Status: status}).IsValidStatus() {
+		return nil, domain.ErrInvalidNotificationStatus
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	notifications, err := uc.notificationRepo.GetNotificationsByStatus(ctx, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.notificationRepo.CountByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListNotificationsResponse{
+		Notifications: notifications,
+		Counts:        counts,
+		Limit:         limit,
+		Offset:        offset,
+	}, nil
+}
+
+// ResendNotificationUseCase handles admins forcing failed notifications out again
+type ResendNotificationUseCase struct {
+	adminRepo        repository.PlatformAdminRepository
+	notificationRepo repository.NotificationRepository
+	userRepo         repository.UserRepository
+	sender           NotificationSender
+}
+
+// NewResendNotificationUseCase creates a new ResendNotificationUseCase
+func NewResendNotificationUseCase(
+	adminRepo repository.PlatformAdminRepository,
+	notificationRepo repository.NotificationRepository,
+	userRepo repository.UserRepository,
+	sender NotificationSender,
+) *ResendNotificationUseCase {
+	return &ResendNotificationUseCase{
+		adminRepo:        adminRepo,
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		sender:           sender,
+	}
+}
+
+// Execute sends a failed notification again, even when it has used up its automatic retries.
+// The returned notification carries the outcome of the new attempt.
+func (uc *ResendNotificationUseCase) Execute(ctx context.Context, req *ResendNotificationRequest) (*domain.Notification, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	notification, err := uc.notificationRepo.GetByID(ctx, req.NotificationID)
+	if err != nil {
+		return nil, err
+	}
+	if notification == nil {
+		return nil, ErrNotificationNotFound
+	}
+
+	if !notification.IsFailed() {
+		return nil, ErrNotificationNotFailed
+	}
+
+	metadata := map[string]interface{}{
+		"notification_id": notification.ID.String(),
+		"type":            string(notification.Type),
+		"retry_count":     notification.RetryCount,
+	}
+	action := domain.NewAdminAction(req.AdminID, domain.AdminActionResendNotification, notification.UserID, req.Reason, metadata, time.Now().UTC())
+	if err := action.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.adminRepo.CreateAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	notification.Status = domain.NotificationStatusPending
+	if err := uc.notificationRepo.Update(ctx, notification); err != nil {
+		return nil, err
+	}
+
+	if err := uc.sender.SendNotification(ctx, notification); err != nil {
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+// ListAdminActionsUseCase handles reading the admin audit trail
+type ListAdminActionsUseCase struct {
+	adminRepo repository.PlatformAdminRepository
+	userRepo  repository.UserRepository
+}
+
+// NewListAdminActionsUseCase creates a new ListAdminActionsUseCase
+func NewListAdminActionsUseCase(adminRepo repository.PlatformAdminRepository, userRepo repository.UserRepository) *ListAdminActionsUseCase {
+	return &ListAdminActionsUseCase{
+		adminRepo: adminRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute returns admin actions newest first
+func (uc *ListAdminActionsUseCase) Execute(ctx context.Context, req *ListAdminActionsRequest) (*ListAdminActionsResponse, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	actions, err := uc.adminRepo.GetActions(ctx, req.TargetUserID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAdminActionsResponse{
+		Actions: actions,
+		Limit:   limit,
+		Offset:  offset,
+	}, nil
+}
+
+// PlatformAdminUseCase combines the operations available through the admin API
+type PlatformAdminUseCase struct {
+	searchUsersUseCase        *SearchUsersUseCase
+	setUserActiveUseCase      *SetUserActiveUseCase
+	changePlatformRoleUseCase *ChangePlatformRoleUseCase
+	impersonateUserUseCase    *ImpersonateUserUseCase
+	listNotificationsUseCase  *ListNotificationsUseCase
+	resendNotificationUseCase *ResendNotificationUseCase
+	listAdminActionsUseCase   *ListAdminActionsUseCase
+}
+
+// NewPlatformAdminUseCase creates a new unified platform admin use case
+func NewPlatformAdminUseCase(
+	adminRepo repository.PlatformAdminRepository,
+	userRepo repository.UserRepository,
+	notificationRepo repository.NotificationRepository,
+	tokenIssuer ImpersonationTokenIssuer,
+	sender NotificationSender,
+) *PlatformAdminUseCase {
+	return &PlatformAdminUseCase{
+		searchUsersUseCase:        NewSearchUsersUseCase(adminRepo, userRepo),
+		setUserActiveUseCase:      NewSetUserActiveUseCase(adminRepo, userRepo),
+		changePlatformRoleUseCase: NewChangePlatformRoleUseCase(adminRepo, userRepo),
+		impersonateUserUseCase:    NewImpersonateUserUseCase(adminRepo, userRepo, tokenIssuer),
+		listNotificationsUseCase:  NewListNotificationsUseCase(notificationRepo, userRepo),
+		resendNotificationUseCase: NewResendNotificationUseCase(adminRepo, notificationRepo, userRepo, sender),
+		listAdminActionsUseCase:   NewListAdminActionsUseCase(adminRepo, userRepo),
+	}
+}
+
+// SetAuditRecorder enables audit logging of platform role changes
+func (uc *PlatformAdminUseCase) SetAuditRecorder(recorder AuditRecorder) {
+	uc.changePlatformRoleUseCase.auditRecorder = recorder
+}
+
+// SearchUsers returns accounts matching a query
+func (uc *PlatformAdminUseCase) SearchUsers(ctx context.Context, req *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return uc.searchUsersUseCase.Execute(ctx, req)
+}
+
+// SetUserActive deactivates or reactivates an account
+func (uc *PlatformAdminUseCase) SetUserActive(ctx context.Context, req *SetUserActiveRequest) (*domain.User, error) {
+	return uc.setUserActiveUseCase.Execute(ctx, req)
+}
+
+// ChangePlatformRole grants or revokes a platform role
+func (uc *PlatformAdminUseCase) ChangePlatformRole(ctx context.Context, req *ChangePlatformRoleRequest) (*domain.User, error) {
+	return uc.changePlatformRoleUseCase.Execute(ctx, req)
+}
+
+// ImpersonateUser issues a support token for acting as a user
+func (uc *PlatformAdminUseCase) ImpersonateUser(ctx context.Context, req *ImpersonateUserRequest) (*ImpersonateUserResponse, error) {
+	return uc.impersonateUserUseCase.Execute(ctx, req)
+}
+
+// ListNotifications returns a page of the notification queue
+func (uc *PlatformAdminUseCase) ListNotifications(ctx context.Context, req *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return uc.listNotificationsUseCase.Execute(ctx, req)
+}
+
+// ResendNotification forces a failed notification to be sent again
+func (uc *PlatformAdminUseCase) ResendNotification(ctx context.Context, req *ResendNotificationRequest) (*domain.Notification, error) {
+	return uc.resendNotificationUseCase.Execute(ctx, req)
+}
+
+// ListAdminActions returns a page of the admin audit trail
+func (uc *PlatformAdminUseCase) ListAdminActions(ctx context.Context, req *ListAdminActionsRequest) (*ListAdminActionsResponse, error) {
+	return uc.listAdminActionsUseCase.Execute(ctx, req)
+}
+
+// requirePlatformAdmin returns ErrPlatformAdminOnly unless the user is an active platform admin.
+// The role is read from the database so a revoked role takes effect before the token expires.
+func requirePlatformAdmin(ctx context.Context, userRepo repository.UserRepository, userID uuid.UUID) error {
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsActive || !user.PlatformRole.IsAdmin() {
+		return ErrPlatformAdminOnly
+	}
+
+	return nil
+}
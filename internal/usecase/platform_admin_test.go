@@ -0,0 +1,318 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPlatformAdminRepository is a mock implementation of PlatformAdminRepository
+type MockPlatformAdminRepository struct {
+	mock.Mock
+}
+
+func (m *MockPlatformAdminRepository) SearchUsers(ctx context.Context, query string, limit, offset int) ([]*domain.User, error) {
+	args := m.Called(ctx, query, limit, offset)
+	return args.Get(0).([]*domain.User), args.Error(1)
+}
+
+func (m *MockPlatformAdminRepository) SetUserActive(ctx context.Context, userID uuid.UUID, active bool, action *domain.AdminAction) error {
+	args := m.Called(ctx, userID, active, action)
+	return args.Error(0)
+}
+
+func (m *MockPlatformAdminRepository) SetPlatformRole(ctx context.Context, userID uuid.UUID, role domain.PlatformRole, action *domain.AdminAction) error {
+	args := m.Called(ctx, userID, role, action)
+	return args.Error(0)
+}
+
+func (m *MockPlatformAdminRepository) CreateAction(ctx context.Context, action *domain.AdminAction) error {
+	args := m.Called(ctx, action)
+	return args.Error(0)
+}
+
+func (m *MockPlatformAdminRepository) GetActions(ctx context.Context, targetUserID *uuid.UUID, limit, offset int) ([]*domain.AdminAction, error) {
+	args := m.Called(ctx, targetUserID, limit, offset)
+	return args.Get(0).([]*domain.AdminAction), args.Error(1)
+}
+
+// MockImpersonationTokenIssuer is a mock implementation of ImpersonationTokenIssuer
+type MockImpersonationTokenIssuer struct {
+	mock.Mock
+}
+
+func (m *MockImpersonationTokenIssuer) GenerateImpersonationToken(userID, email, impersonatorID string) (string, time.Time, error) {
+	args := m.Called(userID, email, impersonatorID)
+	return args.String(0), args.Get(1).(time.Time), args.Error(2)
+}
+
+// MockNotificationSender is a mock implementation of NotificationSender
+type MockNotificationSender struct {
+	mock.Mock
+}
+
+func (m *MockNotificationSender) SendNotification(ctx context.Context, notification *domain.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func TestPlatformAdmin_RequiresAdmin(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		user *domain.User
+	}{
+		{name: "regular user", user: &domain.User{ID: uuid.New(), IsActive: true, PlatformRole: domain.PlatformRoleUser}},
+		{name: "moderator", user: &domain.User{ID: uuid.New(), IsActive: true, PlatformRole: domain.PlatformRoleModerator}},
+		{name: "deactivated admin", user: &domain.User{ID: uuid.New(), IsActive: false, PlatformRole: domain.PlatformRoleAdmin}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := new(MockUserRepository)
+			useCase := NewSearchUsersUseCase(new(MockPlatformAdminRepository), mockUserRepo)
+
+			mockUserRepo.On("GetByID", ctx, tt.user.ID).Return(tt.user, nil)
+
+			_, err := useCase.Execute(ctx, &SearchUsersRequest{AdminID: tt.user.ID, Query: "alice"})
+
+			assert.Equal(t, ErrPlatformAdminOnly, err)
+		})
+	}
+}
+
+func TestSetUserActiveUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}
+
+	t.Run("deactivates an account with an audit entry", func(t *testing.T) {
+		mockAdminRepo := new(MockPlatformAdminRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewSetUserActiveUseCase(mockAdminRepo, mockUserRepo)
+
+		userID := uuid.New()
+		reason := "Chargeback fraud"
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, IsActive: true}, nil)
+		mockAdminRepo.On("SetUserActive", ctx, userID, false, mock.MatchedBy(func(action *domain.AdminAction) bool {
+			return action.Action == domain.AdminActionDeactivateUser && action.AdminID == adminID && action.TargetUserID == userID
+		})).Return(nil)
+
+		user, err := useCase.Execute(ctx, &SetUserActiveRequest{AdminID: adminID, UserID: userID, Active: false, Reason: &reason})
+
+		assert.NoError(t, err)
+		assert.False(t, user.IsActive)
+		mockAdminRepo.AssertExpectations(t)
+	})
+
+	t.Run("reactivating an active account changes nothing", func(t *testing.T) {
+		mockAdminRepo := new(MockPlatformAdminRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewSetUserActiveUseCase(mockAdminRepo, mockUserRepo)
+
+		userID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, IsActive: true}, nil)
+
+		user, err := useCase.Execute(ctx, &SetUserActiveRequest{AdminID: adminID, UserID: userID, Active: true})
+
+		assert.NoError(t, err)
+		assert.True(t, user.IsActive)
+		mockAdminRepo.AssertNotCalled(t, "SetUserActive", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("admins cannot deactivate themselves", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewSetUserActiveUseCase(new(MockPlatformAdminRepository), mockUserRepo)
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+
+		_, err := useCase.Execute(ctx, &SetUserActiveRequest{AdminID: adminID, UserID: adminID, Active: false})
+
+		assert.Equal(t, domain.ErrAdminCannotTargetSelf, err)
+	})
+}
+
+func TestChangePlatformRoleUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}
+
+	t.Run("promotes a user to moderator", func(t *testing.T) {
+		mockAdminRepo := new(MockPlatformAdminRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewChangePlatformRoleUseCase(mockAdminRepo, mockUserRepo)
+
+		userID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, IsActive: true, PlatformRole: domain.PlatformRoleUser}, nil)
+		mockAdminRepo.On("SetPlatformRole", ctx, userID, domain.PlatformRoleModerator, mock.MatchedBy(func(action *domain.AdminAction) bool {
+			return action.Metadata["from"] == "user" && action.Metadata["to"] == "moderator"
+		})).Return(nil)
+
+		user, err := useCase.Execute(ctx, &ChangePlatformRoleRequest{AdminID: adminID, UserID: userID, Role: domain.PlatformRoleModerator})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.PlatformRoleModerator, user.PlatformRole)
+		mockAdminRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewChangePlatformRoleUseCase(new(MockPlatformAdminRepository), mockUserRepo)
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+
+		_, err := useCase.Execute(ctx, &ChangePlatformRoleRequest{AdminID: adminID, UserID: uuid.New(), Role: "superuser"})
+
+		assert.Equal(t, domain.ErrInvalidPlatformRole, err)
+	})
+}
+
+func TestImpersonateUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}
+
+	t.Run("records the impersonation before issuing a token", func(t *testing.T) {
+		mockAdminRepo := new(MockPlatformAdminRepository)
+		mockUserRepo := new(MockUserRepository)
+		mockIssuer := new(MockImpersonationTokenIssuer)
+		useCase := NewImpersonateUserUseCase(mockAdminRepo, mockUserRepo, mockIssuer)
+
+		user := &domain.User{ID: uuid.New(), Email: "player@example.com", IsActive: true, PlatformRole: domain.PlatformRoleUser}
+		expiresAt := time.Now().Add(15 * time.Minute)
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockUserRepo.On("GetByID", ctx, user.ID).Return(user, nil)
+		mockAdminRepo.On("CreateAction", ctx, mock.MatchedBy(func(action *domain.AdminAction) bool {
+			return action.Action == domain.AdminActionImpersonateUser && *action.Reason == "Ticket #88"
+		})).Return(nil)
+		mockIssuer.On("GenerateImpersonationToken", user.ID.String(), user.Email, adminID.String()).Return("support-token", expiresAt, nil)
+
+		resp, err := useCase.Execute(ctx, &ImpersonateUserRequest{AdminID: adminID, UserID: user.ID, Reason: "Ticket #88"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "support-token", resp.AccessToken)
+		assert.Equal(t, expiresAt, resp.ExpiresAt)
+		mockAdminRepo.AssertExpectations(t)
+	})
+
+	t.Run("reason is required", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockIssuer := new(MockImpersonationTokenIssuer)
+		useCase := NewImpersonateUserUseCase(new(MockPlatformAdminRepository), mockUserRepo, mockIssuer)
+
+		userID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID, IsActive: true}, nil)
+
+		_, err := useCase.Execute(ctx, &ImpersonateUserRequest{AdminID: adminID, UserID: userID})
+
+		assert.Equal(t, domain.ErrImpersonationReasonRequired, err)
+		mockIssuer.AssertNotCalled(t, "GenerateImpersonationToken", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("platform staff cannot be impersonated", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewImpersonateUserUseCase(new(MockPlatformAdminRepository), mockUserRepo, new(MockImpersonationTokenIssuer))
+
+		modID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockUserRepo.On("GetByID", ctx, modID).Return(&domain.User{ID: modID, IsActive: true, PlatformRole: domain.PlatformRoleModerator}, nil)
+
+		_, err := useCase.Execute(ctx, &ImpersonateUserRequest{AdminID: adminID, UserID: modID, Reason: "Ticket #89"})
+
+		assert.Equal(t, ErrCannotImpersonate, err)
+	})
+}
+
+func TestResendNotificationUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}
+
+	t.Run("resends a notification past its retry limit", func(t *testing.T) {
+		mockAdminRepo := new(MockPlatformAdminRepository)
+		mockNotificationRepo := new(MockNotificationRepository)
+		mockUserRepo := new(MockUserRepository)
+		mockSender := new(MockNotificationSender)
+		useCase := NewResendNotificationUseCase(mockAdminRepo, mockNotificationRepo, mockUserRepo, mockSender)
+
+		errorMessage := "smtp timeout"
+		notification := &domain.Notification{
+			ID:           uuid.New(),
+			UserID:       uuid.New(),
+			Type:         domain.NotificationTypeEventReminder,
+			Status:       domain.NotificationStatusFailed,
+			RetryCount:   domain.MaxRetryCount,
+			ErrorMessage: &errorMessage,
+		}
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockNotificationRepo.On("GetByID", ctx, notification.ID).Return(notification, nil)
+		mockAdminRepo.On("CreateAction", ctx, mock.MatchedBy(func(action *domain.AdminAction) bool {
+			return action.Action == domain.AdminActionResendNotification && action.TargetUserID == notification.UserID
+		})).Return(nil)
+		mockNotificationRepo.On("Update", ctx, notification).Return(nil)
+		mockSender.On("SendNotification", ctx, notification).Run(func(args mock.Arguments) {
+			args.Get(1).(*domain.Notification).MarkAsSent()
+		}).Return(nil)
+
+		result, err := useCase.Execute(ctx, &ResendNotificationRequest{AdminID: adminID, NotificationID: notification.ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NotificationStatusSent, result.Status)
+		mockAdminRepo.AssertExpectations(t)
+		mockSender.AssertExpectations(t)
+	})
+
+	t.Run("only failed notifications can be resent", func(t *testing.T) {
+		mockNotificationRepo := new(MockNotificationRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewResendNotificationUseCase(new(MockPlatformAdminRepository), mockNotificationRepo, mockUserRepo, new(MockNotificationSender))
+
+		notification := &domain.Notification{ID: uuid.New(), Status: domain.NotificationStatusSent}
+
+		mockUserRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		mockNotificationRepo.On("GetByID", ctx, notification.ID).Return(notification, nil)
+
+		_, err := useCase.Execute(ctx, &ResendNotificationRequest{AdminID: adminID, NotificationID: notification.ID})
+
+		assert.Equal(t, ErrNotificationNotFailed, err)
+	})
+}
+
+func TestListNotificationsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+
+	mockNotificationRepo := new(MockNotificationRepository)
+	mockUserRepo := new(MockUserRepository)
+	useCase := NewListNotificationsUseCase(mockNotificationRepo, mockUserRepo)
+
+	counts := map[domain.NotificationStatus]int{domain.NotificationStatusFailed: 3, domain.NotificationStatusPending: 12}
+
+	mockUserRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}, nil)
+	mockNotificationRepo.On("GetNotificationsByStatus", ctx, domain.NotificationStatusFailed, DefaultModerationLimit, 0).
+		Return([]*domain.Notification{{ID: uuid.New(), Status: domain.NotificationStatusFailed}}, nil)
+	mockNotificationRepo.On("CountByStatus", ctx).Return(counts, nil)
+
+	resp, err := useCase.Execute(ctx, &ListNotificationsRequest{AdminID: adminID})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Notifications, 1)
+	assert.Equal(t, 3, resp.Counts[domain.NotificationStatusFailed])
+}
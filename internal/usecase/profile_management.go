@@ -126,18 +126,31 @@ func (uc *UpdateProfileUseCase) Execute(ctx context.Context, req *UpdateProfileR
 
 // GetUserProfileUseCase handles retrieving user profiles
 type GetUserProfileUseCase struct {
-	userRepo repository.UserRepository
+	userRepo  repository.UserRepository
+	blockRepo repository.UserBlockRepository
 }
 
 // NewGetUserProfileUseCase creates a new GetUserProfileUseCase
-func NewGetUserProfileUseCase(userRepo repository.UserRepository) *GetUserProfileUseCase {
+func NewGetUserProfileUseCase(userRepo repository.UserRepository, blockRepo repository.UserBlockRepository) *GetUserProfileUseCase {
 	return &GetUserProfileUseCase{
-		userRepo: userRepo,
+		userRepo:  userRepo,
+		blockRepo: blockRepo,
 	}
 }
 
 // Execute retrieves a user profile with appropriate privacy controls
 func (uc *GetUserProfileUseCase) Execute(ctx context.Context, req *GetUserProfileRequest) (*UserProfileResponse, error) {
+	// Users blocked by the profile owner see the profile as if it did not exist
+	if req.UserID != req.TargetUserID {
+		blocked, err := uc.blockRepo.IsBlocked(ctx, req.TargetUserID, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, ErrUserNotFound
+		}
+	}
+
 	// Get user with profile
 	userWithProfile, err := uc.userRepo.GetUserWithProfile(ctx, req.TargetUserID)
 	if err != nil {
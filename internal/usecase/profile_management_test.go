@@ -21,7 +21,7 @@ func TestUpdateProfileUseCase_Execute_Success(t *testing.T) {
 	displayName := "Updated Name"
 	locale := "en"
 	timezone := "Europe/Lisbon"
-	country := "Portugal"
+	country := "PT"
 	city := "Porto"
 
 	existingProfile := &domain.Profile{
@@ -29,7 +29,7 @@ func TestUpdateProfileUseCase_Execute_Success(t *testing.T) {
 		DisplayName:    stringPtr("Old Name"),
 		Locale:         "pt",
 		Timezone:       "UTC",
-		Country:        stringPtr("Spain"),
+		Country:        stringPtr("ES"),
 		City:           stringPtr("Madrid"),
 		PreferredGames: []string{"mtg"},
 		CommunicationPreferences: map[string]interface{}{
@@ -114,7 +114,7 @@ func TestUpdateProfileUseCase_Execute_PartialUpdate(t *testing.T) {
 		DisplayName:    stringPtr("Old Name"),
 		Locale:         "pt",
 		Timezone:       "UTC",
-		Country:        stringPtr("Portugal"),
+		Country:        stringPtr("PT"),
 		City:           stringPtr("Lisbon"),
 		PreferredGames: []string{"mtg"},
 		CommunicationPreferences: map[string]interface{}{
@@ -149,9 +149,9 @@ func TestUpdateProfileUseCase_Execute_PartialUpdate(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, newDisplayName, *result.Profile.DisplayName)
-	assert.Equal(t, "pt", result.Profile.Locale)         // Should remain unchanged
-	assert.Equal(t, "UTC", result.Profile.Timezone)      // Should remain unchanged
-	assert.Equal(t, "Portugal", *result.Profile.Country) // Should remain unchanged
+	assert.Equal(t, "pt", result.Profile.Locale)    // Should remain unchanged
+	assert.Equal(t, "UTC", result.Profile.Timezone) // Should remain unchanged
+	assert.Equal(t, "PT", *result.Profile.Country)  // Should remain unchanged
 
 	mockUserRepo.AssertExpectations(t)
 }
@@ -159,7 +159,8 @@ func TestUpdateProfileUseCase_Execute_PartialUpdate(t *testing.T) {
 func TestGetUserProfileUseCase_Execute_OwnProfile(t *testing.T) {
 	// Arrange
 	mockUserRepo := new(MockUserRepository)
-	useCase := NewGetUserProfileUseCase(mockUserRepo)
+	mockBlockRepo := new(MockUserBlockRepository)
+	useCase := NewGetUserProfileUseCase(mockUserRepo, mockBlockRepo)
 
 	userID := uuid.New()
 	user := &domain.User{
@@ -172,7 +173,7 @@ func TestGetUserProfileUseCase_Execute_OwnProfile(t *testing.T) {
 		DisplayName:    stringPtr("Test User"),
 		Locale:         "en",
 		Timezone:       "UTC",
-		Country:        stringPtr("Portugal"),
+		Country:        stringPtr("PT"),
 		City:           stringPtr("Lisbon"),
 		PreferredGames: []string{"mtg"},
 		CommunicationPreferences: map[string]interface{}{
@@ -216,7 +217,8 @@ func TestGetUserProfileUseCase_Execute_OwnProfile(t *testing.T) {
 func TestGetUserProfileUseCase_Execute_OtherUserProfile_PublicView(t *testing.T) {
 	// Arrange
 	mockUserRepo := new(MockUserRepository)
-	useCase := NewGetUserProfileUseCase(mockUserRepo)
+	mockBlockRepo := new(MockUserBlockRepository)
+	useCase := NewGetUserProfileUseCase(mockUserRepo, mockBlockRepo)
 
 	requestingUserID := uuid.New()
 	targetUserID := uuid.New()
@@ -231,7 +233,7 @@ func TestGetUserProfileUseCase_Execute_OtherUserProfile_PublicView(t *testing.T)
 		DisplayName:    stringPtr("Target User"),
 		Locale:         "en",
 		Timezone:       "UTC",
-		Country:        stringPtr("Portugal"),
+		Country:        stringPtr("PT"),
 		City:           stringPtr("Lisbon"),
 		PreferredGames: []string{"mtg"},
 		CommunicationPreferences: map[string]interface{}{
@@ -258,6 +260,7 @@ func TestGetUserProfileUseCase_Execute_OtherUserProfile_PublicView(t *testing.T)
 	}
 
 	// Mock expectations
+	mockBlockRepo.On("IsBlocked", mock.Anything, targetUserID, requestingUserID).Return(false, nil)
 	mockUserRepo.On("GetUserWithProfile", mock.Anything, targetUserID).Return(userWithProfile, nil)
 
 	// Act
@@ -278,7 +281,8 @@ func TestGetUserProfileUseCase_Execute_OtherUserProfile_PublicView(t *testing.T)
 func TestGetUserProfileUseCase_Execute_OtherUserProfile_HiddenName(t *testing.T) {
 	// Arrange
 	mockUserRepo := new(MockUserRepository)
-	useCase := NewGetUserProfileUseCase(mockUserRepo)
+	mockBlockRepo := new(MockUserBlockRepository)
+	useCase := NewGetUserProfileUseCase(mockUserRepo, mockBlockRepo)
 
 	requestingUserID := uuid.New()
 	targetUserID := uuid.New()
@@ -293,7 +297,7 @@ func TestGetUserProfileUseCase_Execute_OtherUserProfile_HiddenName(t *testing.T)
 		DisplayName:    stringPtr("Target User"),
 		Locale:         "en",
 		Timezone:       "UTC",
-		Country:        stringPtr("Portugal"),
+		Country:        stringPtr("PT"),
 		City:           stringPtr("Lisbon"),
 		PreferredGames: []string{"mtg"},
 		CommunicationPreferences: map[string]interface{}{
@@ -320,6 +324,7 @@ func TestGetUserProfileUseCase_Execute_OtherUserProfile_HiddenName(t *testing.T)
 	}
 
 	// Mock expectations
+	mockBlockRepo.On("IsBlocked", mock.Anything, targetUserID, requestingUserID).Return(false, nil)
 	mockUserRepo.On("GetUserWithProfile", mock.Anything, targetUserID).Return(userWithProfile, nil)
 
 	// Act
@@ -341,7 +346,8 @@ func TestGetUserProfileUseCase_Execute_OtherUserProfile_HiddenName(t *testing.T)
 func TestGetUserProfileUseCase_Execute_UserNotFound(t *testing.T) {
 	// Arrange
 	mockUserRepo := new(MockUserRepository)
-	useCase := NewGetUserProfileUseCase(mockUserRepo)
+	mockBlockRepo := new(MockUserBlockRepository)
+	useCase := NewGetUserProfileUseCase(mockUserRepo, mockBlockRepo)
 
 	userID := uuid.New()
 	targetUserID := uuid.New()
@@ -352,6 +358,7 @@ func TestGetUserProfileUseCase_Execute_UserNotFound(t *testing.T) {
 	}
 
 	// Mock expectations
+	mockBlockRepo.On("IsBlocked", mock.Anything, targetUserID, userID).Return(false, nil)
 	mockUserRepo.On("GetUserWithProfile", mock.Anything, targetUserID).Return((*domain.UserWithProfile)(nil), errors.New("not found"))
 
 	// Act
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrUserBlocked    = errors.New("action not allowed between users who have blocked each other")
+	ErrUserNotBlocked = errors.New("user is not blocked")
+)
+
+// BlockUserRequest represents the request to block another user
+type BlockUserRequest struct {
+	BlockerID uuid.UUID `json:"blocker_id" validate:"required"` // User making the request
+	BlockedID uuid.UUID `json:"blocked_id" validate:"required"`
+}
+
+// UnblockUserRequest represents the request to lift a block
+type UnblockUserRequest struct {
+	BlockerID uuid.UUID `json:"blocker_id" validate:"required"` // User making the request
+	BlockedID uuid.UUID `json:"blocked_id" validate:"required"`
+}
+
+// ListBlockedUsersRequest represents the request to list the users someone has blocked
+type ListBlockedUsersRequest struct {
+	UserID uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// BlockUserUseCase handles adding users to a block list
+type BlockUserUseCase struct {
+	blockRepo repository.UserBlockRepository
+	userRepo  repository.UserRepository
+}
+
+// NewBlockUserUseCase creates a new BlockUserUseCase
+func NewBlockUserUseCase(blockRepo repository.UserBlockRepository, userRepo repository.UserRepository) *BlockUserUseCase {
+	return &BlockUserUseCase{
+		blockRepo: blockRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute blocks a user. Blocking an already blocked user succeeds without change.
+func (uc *BlockUserUseCase) Execute(ctx context.Context, req *BlockUserRequest) (*domain.UserBlock, error) {
+	block := &domain.UserBlock{
+		BlockerID: req.BlockerID,
+		BlockedID: req.BlockedID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := block.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, req.BlockedID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	if err := uc.blockRepo.Create(ctx, block); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// UnblockUserUseCase handles removing users from a block list
+type UnblockUserUseCase struct {
+	blockRepo repository.UserBlockRepository
+}
+
+// NewUnblockUserUseCase creates a new UnblockUserUseCase
+func NewUnblockUserUseCase(blockRepo repository.UserBlockRepository) *UnblockUserUseCase {
+	return &UnblockUserUseCase{
+		blockRepo: blockRepo,
+	}
+}
+
+// Execute lifts a block the requester made
+func (uc *UnblockUserUseCase) Execute(ctx context.Context, req *UnblockUserRequest) error {
+	blocked, err := uc.blockRepo.IsBlocked(ctx, req.BlockerID, req.BlockedID)
+	if err != nil {
+		return err
+	}
+	if !blocked {
+		return ErrUserNotBlocked
+	}
+
+	return uc.blockRepo.Delete(ctx, req.BlockerID, req.BlockedID)
+}
+
+// ListBlockedUsersUseCase handles retrieving a user's block list
+type ListBlockedUsersUseCase struct {
+	blockRepo repository.UserBlockRepository
+}
+
+// NewListBlockedUsersUseCase creates a new ListBlockedUsersUseCase
+func NewListBlockedUsersUseCase(blockRepo repository.UserBlockRepository) *ListBlockedUsersUseCase {
+	return &ListBlockedUsersUseCase{
+		blockRepo: blockRepo,
+	}
+}
+
+// Execute lists the users the requester has blocked
+func (uc *ListBlockedUsersUseCase) Execute(ctx context.Context, req *ListBlockedUsersRequest) ([]*domain.UserBlock, error) {
+	return uc.blockRepo.GetBlockedByUser(ctx, req.UserID)
+}
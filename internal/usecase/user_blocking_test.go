@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUserBlockRepository is a mock implementation of UserBlockRepository
+type MockUserBlockRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserBlockRepository) Create(ctx context.Context, block *domain.UserBlock) error {
+	args := m.Called(ctx, block)
+	return args.Error(0)
+}
+
+func (m *MockUserBlockRepository) Delete(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	args := m.Called(ctx, blockerID, blockedID)
+	return args.Error(0)
+}
+
+func (m *MockUserBlockRepository) IsBlocked(ctx context.Context, blockerID, blockedID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, blockerID, blockedID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserBlockRepository) IsBlockedEitherWay(ctx context.Context, userID, otherUserID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, userID, otherUserID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserBlockRepository) GetBlockedByUser(ctx context.Context, blockerID uuid.UUID) ([]*domain.UserBlock, error) {
+	args := m.Called(ctx, blockerID)
+	return args.Get(0).([]*domain.UserBlock), args.Error(1)
+}
+
+func (m *MockUserBlockRepository) GetBlockedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func TestBlockUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("user blocks a harasser", func(t *testing.T) {
+		mockBlockRepo := new(MockUserBlockRepository)
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewBlockUserUseCase(mockBlockRepo, mockUserRepo)
+
+		blockerID := uuid.New()
+		blockedID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, blockedID).Return(&domain.User{ID: blockedID}, nil)
+		mockBlockRepo.On("Create", ctx, mock.AnythingOfType("*domain.UserBlock")).Return(nil)
+
+		block, err := useCase.Execute(ctx, &BlockUserRequest{BlockerID: blockerID, BlockedID: blockedID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, blockerID, block.BlockerID)
+		assert.Equal(t, blockedID, block.BlockedID)
+		mockBlockRepo.AssertExpectations(t)
+	})
+
+	t.Run("cannot block self", func(t *testing.T) {
+		useCase := NewBlockUserUseCase(new(MockUserBlockRepository), new(MockUserRepository))
+		userID := uuid.New()
+
+		_, err := useCase.Execute(ctx, &BlockUserRequest{BlockerID: userID, BlockedID: userID})
+
+		assert.Equal(t, domain.ErrCannotBlockSelf, err)
+	})
+
+	t.Run("blocked user does not exist", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		useCase := NewBlockUserUseCase(new(MockUserBlockRepository), mockUserRepo)
+		blockedID := uuid.New()
+
+		mockUserRepo.On("GetByID", ctx, blockedID).Return(nil, nil)
+
+		_, err := useCase.Execute(ctx, &BlockUserRequest{BlockerID: uuid.New(), BlockedID: blockedID})
+
+		assert.Equal(t, ErrUserNotFound, err)
+	})
+}
+
+func TestUnblockUserUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	blockerID := uuid.New()
+	blockedID := uuid.New()
+
+	t.Run("lifts an existing block", func(t *testing.T) {
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewUnblockUserUseCase(mockBlockRepo)
+
+		mockBlockRepo.On("IsBlocked", ctx, blockerID, blockedID).Return(true, nil)
+		mockBlockRepo.On("Delete", ctx, blockerID, blockedID).Return(nil)
+
+		err := useCase.Execute(ctx, &UnblockUserRequest{BlockerID: blockerID, BlockedID: blockedID})
+
+		assert.NoError(t, err)
+		mockBlockRepo.AssertExpectations(t)
+	})
+
+	t.Run("user is not blocked", func(t *testing.T) {
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewUnblockUserUseCase(mockBlockRepo)
+
+		mockBlockRepo.On("IsBlocked", ctx, blockerID, blockedID).Return(false, nil)
+
+		err := useCase.Execute(ctx, &UnblockUserRequest{BlockerID: blockerID, BlockedID: blockedID})
+
+		assert.Equal(t, ErrUserNotBlocked, err)
+		mockBlockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserBlocking_Enforcement(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("blocked user cannot see the blocker's profile", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewGetUserProfileUseCase(mockUserRepo, mockBlockRepo)
+
+		blockerID := uuid.New()
+		blockedID := uuid.New()
+
+		mockBlockRepo.On("IsBlocked", ctx, blockerID, blockedID).Return(true, nil)
+
+		result, err := useCase.Execute(ctx, &GetUserProfileRequest{UserID: blockedID, TargetUserID: blockerID})
+
+		assert.Equal(t, ErrUserNotFound, err)
+		assert.Nil(t, result)
+		mockUserRepo.AssertNotCalled(t, "GetUserWithProfile", mock.Anything, mock.Anything)
+	})
+
+	t.Run("blocked user cannot RSVP to the host's private event", func(t *testing.T) {
+		event := newPrivateEvent()
+		blockedID := uuid.New()
+
+		mockEventRepo := new(MockEventRepository)
+		mockInviteRepo := new(MockEventInviteRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewRSVPToEventUseCase(mockEventRepo, new(MockGroupRepository), new(MockGroupModerationRepository), mockInviteRepo, mockBlockRepo, nil)
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+		mockBlockRepo.On("IsBlocked", ctx, event.HostUserID, blockedID).Return(true, nil)
+
+		_, err := useCase.Execute(ctx, &RSVPToEventRequest{
+			EventID:     event.ID,
+			UserID:      blockedID,
+			Status:      domain.RSVPStatusGoing,
+			InviteToken: "still-valid-token",
+		})
+
+		assert.Equal(t, ErrUnauthorizedAccess, err)
+		mockInviteRepo.AssertNotCalled(t, "GetLinkByTokenHash", mock.Anything, mock.Anything)
+		mockEventRepo.AssertNotCalled(t, "CreateRSVP", mock.Anything, mock.Anything)
+	})
+
+	t.Run("blocked users are hidden from attendee lists", func(t *testing.T) {
+		event := newPrivateEvent()
+		event.Visibility = domain.EventVisibilityPublic
+		viewerID := uuid.New()
+		blockedID := uuid.New()
+		friendID := uuid.New()
+
+		mockEventRepo := new(MockEventRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewGetEventAttendeesUseCase(mockEventRepo, new(MockGroupRepository), new(MockEventInviteRepository), mockBlockRepo)
+
+		now := time.Now()
+		rsvps := []*domain.EventRSVP{
+			{EventID: event.ID, UserID: blockedID, Status: domain.RSVPStatusGoing, CreatedAt: now},
+			{EventID: event.ID, UserID: friendID, Status: domain.RSVPStatusGoing, CreatedAt: now},
+		}
+
+		mockEventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+		mockEventRepo.On("GetEventRSVPs", ctx, event.ID).Return(rsvps, nil)
+		mockBlockRepo.On("GetBlockedUserIDs", ctx, viewerID).Return([]uuid.UUID{blockedID}, nil)
+
+		result, err := useCase.Execute(ctx, &GetEventAttendeesRequest{EventID: event.ID, UserID: viewerID})
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Going, 1)
+		assert.Equal(t, friendID, result.Going[0].UserID)
+		// Counts still reflect the event's real occupancy
+		assert.Equal(t, 2, result.GoingCount)
+	})
+
+	t.Run("blocked users cannot be invited to groups", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepository)
+		mockUserRepo := new(MockUserRepository)
+		mockInviteRepo := new(MockGroupInviteRepository)
+		mockModerationRepo := new(MockGroupModerationRepository)
+		mockBlockRepo := new(MockUserBlockRepository)
+		useCase := NewInviteGroupMemberUseCase(mockGroupRepo, mockUserRepo, mockInviteRepo, mockModerationRepo, mockBlockRepo)
+
+		groupID := uuid.New()
+		userID := uuid.New()
+		inviterID := uuid.New()
+
+		mockGroupRepo.On("GetByID", ctx, groupID).Return(&domain.Group{ID: groupID, IsActive: true}, nil)
+		mockUserRepo.On("GetByID", ctx, userID).Return(&domain.User{ID: userID}, nil)
+		mockGroupRepo.On("GetMember", ctx, groupID, inviterID).Return(&domain.GroupMember{GroupID: groupID, UserID: inviterID, Role: domain.GroupRoleOwner}, nil)
+		mockGroupRepo.On("IsMember", ctx, groupID, userID).Return(false, nil)
+		mockModerationRepo.On("GetActiveBan", ctx, groupID, userID, mock.AnythingOfType("time.Time")).Return(nil, nil)
+		mockBlockRepo.On("IsBlockedEitherWay", ctx, inviterID, userID).Return(true, nil)
+
+		_, err := useCase.Execute(ctx, &InviteGroupMemberRequest{
+			GroupID:   groupID,
+			UserID:    userID,
+			Role:      domain.GroupRoleMember,
+			InviterID: inviterID,
+		})
+
+		assert.Equal(t, ErrUserBlocked, err)
+		mockInviteRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
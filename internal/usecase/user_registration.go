@@ -86,6 +86,7 @@ func (uc *RegisterUserUseCase) Execute(ctx context.Context, req *RegisterUserReq
 		CreatedAt:    time.Now().UTC(),
 		UpdatedAt:    time.Now().UTC(),
 		IsActive:     true,
+		PlatformRole: domain.PlatformRoleUser,
 	}
 
 	// Validate user entity
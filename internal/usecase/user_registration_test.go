@@ -121,7 +121,7 @@ func TestRegisterUserUseCase_Execute_Success(t *testing.T) {
 		DisplayName: "Test User",
 		Locale:      "en",
 		Timezone:    "UTC",
-		Country:     "Portugal",
+		Country:     "PT",
 		City:        "Lisbon",
 	}
 
@@ -239,6 +239,7 @@ func TestRegisterUserUseCase_Execute_DefaultLocale(t *testing.T) {
 		Email:       "test@example.com",
 		Password:    "password123",
 		Timezone:    "UTC",
+		Country:     "PT",
 		// Locale not provided - should default to "pt"
 	}
 
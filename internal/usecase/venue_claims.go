@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrVenueClaimNotFound   = errors.New("venue claim not found")
+	ErrVenueNotClaimable    = errors.New("only store venues can be claimed")
+	ErrVenueAlreadyVerified = errors.New("venue already has a verified owner")
+	ErrDuplicateVenueClaim  = errors.New("you already have a pending claim on this venue")
+	ErrNotVenueOwner        = errors.New("only the verified owner of the event's venue can mark it official")
+)
+
+// ClaimVenueRequest represents a user's request to be recognized as a store's owner
+type ClaimVenueRequest struct {
+	VenueID    uuid.UUID `json:"venue_id" validate:"required"`
+	ClaimantID uuid.UUID `json:"claimant_id" validate:"required"` // User making the request
+	Evidence   string    `json:"evidence" validate:"required,max=2000"`
+}
+
+// ListVenueClaimsRequest represents the request to read the venue claim queue
+type ListVenueClaimsRequest struct {
+	AdminID uuid.UUID               `json:"admin_id" validate:"required"` // User making the request
+	Status  domain.VenueClaimStatus `json:"status,omitempty"`             // Defaults to pending claims
+	Limit   int                     `json:"limit"`
+	Offset  int                     `json:"offset"`
+}
+
+// ListVenueClaimsResponse represents a page of the venue claim queue
+type ListVenueClaimsResponse struct {
+	Claims []*domain.VenueClaim `json:"claims"`
+	Limit  int                  `json:"limit"`
+	Offset int                  `json:"offset"`
+}
+
+// ReviewVenueClaimRequest represents an admin's decision on a venue claim
+type ReviewVenueClaimRequest struct {
+	ClaimID uuid.UUID               `json:"claim_id" validate:"required"`
+	AdminID uuid.UUID               `json:"admin_id" validate:"required"` // User making the request
+	Status  domain.VenueClaimStatus `json:"status" validate:"required"`   // Approved or rejected
+	Note    *string                 `json:"note,omitempty" validate:"omitempty,max=1000"`
+}
+
+// SetEventOfficialRequest represents a venue owner marking an event at their store as official
+type SetEventOfficialRequest struct {
+	EventID  uuid.UUID `json:"event_id" validate:"required"`
+	UserID   uuid.UUID `json:"user_id" validate:"required"` // User making the request
+	Official bool      `json:"official"`
+}
+
+// ClaimVenueUseCase handles store owners claiming their venue
+type ClaimVenueUseCase struct {
+	claimRepo repository.VenueClaimRepository
+	venueRepo repository.VenueRepository
+}
+
+// NewClaimVenueUseCase creates a new ClaimVenueUseCase
+func NewClaimVenueUseCase(claimRepo repository.VenueClaimRepository, venueRepo repository.VenueRepository) *ClaimVenueUseCase {
+	return &ClaimVenueUseCase{
+		claimRepo: claimRepo,
+		venueRepo: venueRepo,
+	}
+}
+
+// Execute files a claim for platform admins to review
+func (uc *ClaimVenueUseCase) Execute(ctx context.Context, req *ClaimVenueRequest) (*domain.VenueClaim, error) {
+	claim := domain.NewVenueClaim(req.VenueID, req.ClaimantID, req.Evidence, time.Now().UTC())
+	if err := claim.Validate(); err != nil {
+		return nil, err
+	}
+
+	venue, err := uc.venueRepo.GetByID(ctx, req.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue == nil {
+		return nil, ErrVenueNotFound
+	}
+	if venue.Type != domain.VenueTypeStore {
+		return nil, ErrVenueNotClaimable
+	}
+	if venue.IsVerified() {
+		return nil, ErrVenueAlreadyVerified
+	}
+
+	duplicate, err := uc.claimRepo.HasPendingClaim(ctx, req.VenueID, req.ClaimantID)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate {
+		return nil, ErrDuplicateVenueClaim
+	}
+
+	if err := uc.claimRepo.CreateClaim(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+// ListVenueClaimsUseCase handles platform admins reading the venue claim queue
+type ListVenueClaimsUseCase struct {
+	claimRepo repository.VenueClaimRepository
+	userRepo  repository.UserRepository
+}
+
+// NewListVenueClaimsUseCase creates a new ListVenueClaimsUseCase
+func NewListVenueClaimsUseCase(claimRepo repository.VenueClaimRepository, userRepo repository.UserRepository) *ListVenueClaimsUseCase {
+	return &ListVenueClaimsUseCase{
+		claimRepo: claimRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute returns venue claims to platform admins, oldest first
+func (uc *ListVenueClaimsUseCase) Execute(ctx context.Context, req *ListVenueClaimsRequest) (*ListVenueClaimsResponse, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	status := req.Status
+	if status == "" {
+		status = domain.VenueClaimStatusPending
+	}
+	if !domain.IsValidVenueClaimStatus(status) {
+		return nil, domain.ErrInvalidVenueClaimStatus
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	claims, err := uc.claimRepo.GetClaims(ctx, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListVenueClaimsResponse{
+		Claims: claims,
+		Limit:  limit,
+		Offset: offset,
+	}, nil
+}
+
+// ReviewVenueClaimUseCase handles platform admins approving or rejecting venue claims
+type ReviewVenueClaimUseCase struct {
+	claimRepo repository.VenueClaimRepository
+	venueRepo repository.VenueRepository
+	userRepo  repository.UserRepository
+}
+
+// NewReviewVenueClaimUseCase creates a new ReviewVenueClaimUseCase
+func NewReviewVenueClaimUseCase(claimRepo repository.VenueClaimRepository, venueRepo repository.VenueRepository, userRepo repository.UserRepository) *ReviewVenueClaimUseCase {
+	return &ReviewVenueClaimUseCase{
+		claimRepo: claimRepo,
+		venueRepo: venueRepo,
+		userRepo:  userRepo,
+	}
+}
+
+// Execute records the decision. An approved claimant becomes the venue's verified owner and takes
+// over its edit rights from the creator.
+func (uc *ReviewVenueClaimUseCase) Execute(ctx context.Context, req *ReviewVenueClaimRequest) (*domain.VenueClaim, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	claim, err := uc.claimRepo.GetClaim(ctx, req.ClaimID)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, ErrVenueClaimNotFound
+	}
+
+	if req.Status == domain.VenueClaimStatusApproved {
+		venue, err := uc.venueRepo.GetByID(ctx, claim.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		if venue == nil {
+			return nil, ErrVenueNotFound
+		}
+		if venue.IsVerified() {
+			return nil, ErrVenueAlreadyVerified
+		}
+	}
+
+	if err := claim.Review(req.Status, req.AdminID, req.Note, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.claimRepo.ReviewClaim(ctx, claim); err != nil {
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+// SetEventOfficialUseCase handles verified store owners marking events at their venue as official
+type SetEventOfficialUseCase struct {
+	claimRepo repository.VenueClaimRepository
+	eventRepo repository.EventRepository
+	venueRepo repository.VenueRepository
+}
+
+// NewSetEventOfficialUseCase creates a new SetEventOfficialUseCase
+func NewSetEventOfficialUseCase(claimRepo repository.VenueClaimRepository, eventRepo repository.EventRepository, venueRepo repository.VenueRepository) *SetEventOfficialUseCase {
+	return &SetEventOfficialUseCase{
+		claimRepo: claimRepo,
+		eventRepo: eventRepo,
+		venueRepo: venueRepo,
+	}
+}
+
+// Execute marks the event as official, or clears the mark, and returns the updated event
+func (uc *SetEventOfficialUseCase) Execute(ctx context.Context, req *SetEventOfficialRequest) (*domain.Event, error) {
+	event, err := uc.eventRepo.GetByID(ctx, req.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrEventNotFound
+	}
+	if event.VenueID == nil {
+		return nil, ErrNotVenueOwner
+	}
+
+	venue, err := uc.venueRepo.GetByID(ctx, *event.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue == nil || !venue.IsOwnedBy(req.UserID) {
+		return nil, ErrNotVenueOwner
+	}
+
+	if err := uc.claimRepo.SetEventOfficial(ctx, event.ID, req.Official); err != nil {
+		return nil, err
+	}
+
+	event.IsOfficial = req.Official
+	return event, nil
+}
+
+// VenueClaimsUseCase combines venue ownership claims, their review and official events
+type VenueClaimsUseCase struct {
+	claimVenueUseCase       *ClaimVenueUseCase
+	listVenueClaimsUseCase  *ListVenueClaimsUseCase
+	reviewVenueClaimUseCase *ReviewVenueClaimUseCase
+	setEventOfficialUseCase *SetEventOfficialUseCase
+}
+
+// NewVenueClaimsUseCase creates a new VenueClaimsUseCase
+func NewVenueClaimsUseCase(
+	claimRepo repository.VenueClaimRepository,
+	venueRepo repository.VenueRepository,
+	eventRepo repository.EventRepository,
+	userRepo repository.UserRepository,
+) *VenueClaimsUseCase {
+	return &VenueClaimsUseCase{
+		claimVenueUseCase:       NewClaimVenueUseCase(claimRepo, venueRepo),
+		listVenueClaimsUseCase:  NewListVenueClaimsUseCase(claimRepo, userRepo),
+		reviewVenueClaimUseCase: NewReviewVenueClaimUseCase(claimRepo, venueRepo, userRepo),
+		setEventOfficialUseCase: NewSetEventOfficialUseCase(claimRepo, eventRepo, venueRepo),
+	}
+}
+
+// ClaimVenue files an ownership claim on a store venue
+func (uc *VenueClaimsUseCase) ClaimVenue(ctx context.Context, req *ClaimVenueRequest) (*domain.VenueClaim, error) {
+	return uc.claimVenueUseCase.Execute(ctx, req)
+}
+
+// ListVenueClaims returns the venue claim queue to platform admins
+func (uc *VenueClaimsUseCase) ListVenueClaims(ctx context.Context, req *ListVenueClaimsRequest) (*ListVenueClaimsResponse, error) {
+	return uc.listVenueClaimsUseCase.Execute(ctx, req)
+}
+
+// ReviewVenueClaim approves or rejects a venue claim
+func (uc *VenueClaimsUseCase) ReviewVenueClaim(ctx context.Context, req *ReviewVenueClaimRequest) (*domain.VenueClaim, error) {
+	return uc.reviewVenueClaimUseCase.Execute(ctx, req)
+}
+
+// SetEventOfficial marks an event at a verified store as official, or clears the mark
+func (uc *VenueClaimsUseCase) SetEventOfficial(ctx context.Context, req *SetEventOfficialRequest) (*domain.Event, error) {
+	return uc.setEventOfficialUseCase.Execute(ctx, req)
+}
@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVenueClaimRepository is a mock implementation of VenueClaimRepository
+type MockVenueClaimRepository struct {
+	mock.Mock
+}
+
+func (m *MockVenueClaimRepository) CreateClaim(ctx context.Context, claim *domain.VenueClaim) error {
+	args := m.Called(ctx, claim)
+	return args.Error(0)
+}
+
+func (m *MockVenueClaimRepository) GetClaim(ctx context.Context, id uuid.UUID) (*domain.VenueClaim, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VenueClaim), args.Error(1)
+}
+
+func (m *MockVenueClaimRepository) HasPendingClaim(ctx context.Context, venueID, claimantID uuid.UUID) (bool, error) {
+	args := m.Called(ctx, venueID, claimantID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockVenueClaimRepository) GetClaims(ctx context.Context, status domain.VenueClaimStatus, limit, offset int) ([]*domain.VenueClaim, error) {
+	args := m.Called(ctx, status, limit, offset)
+	return args.Get(0).([]*domain.VenueClaim), args.Error(1)
+}
+
+func (m *MockVenueClaimRepository) ReviewClaim(ctx context.Context, claim *domain.VenueClaim) error {
+	args := m.Called(ctx, claim)
+	return args.Error(0)
+}
+
+func (m *MockVenueClaimRepository) SetEventOfficial(ctx context.Context, eventID uuid.UUID, official bool) error {
+	args := m.Called(ctx, eventID, official)
+	return args.Error(0)
+}
+
+func newClaimableVenue(venueType domain.VenueType) *domain.Venue {
+	creatorID := uuid.New()
+	return &domain.Venue{
+		ID:        uuid.New(),
+		Name:      "Dragon's Den",
+		Type:      venueType,
+		Address:   "1 Rua Augusta",
+		City:      "Lisbon",
+		Country:   "Portugal",
+		CreatedBy: &creatorID,
+	}
+}
+
+func TestClaimVenueUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	claimantID := uuid.New()
+
+	t.Run("files a pending claim", func(t *testing.T) {
+		claimRepo := new(MockVenueClaimRepository)
+		venueRepo := NewMockVenueRepository()
+		venue := newClaimableVenue(domain.VenueTypeStore)
+		venueRepo.venues[venue.ID] = venue
+
+		claimRepo.On("HasPendingClaim", ctx, venue.ID, claimantID).Return(false, nil)
+		claimRepo.On("CreateClaim", ctx, mock.AnythingOfType("*domain.VenueClaim")).Return(nil)
+
+		claim, err := NewClaimVenueUseCase(claimRepo, venueRepo).Execute(ctx, &ClaimVenueRequest{
+			VenueID:    venue.ID,
+			ClaimantID: claimantID,
+			Evidence:   "Business registration 12345",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.VenueClaimStatusPending, claim.Status)
+		claimRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid claims", func(t *testing.T) {
+		home := newClaimableVenue(domain.VenueTypeHome)
+		verified := newClaimableVenue(domain.VenueTypeStore)
+		ownerID := uuid.New()
+		verifiedAt := time.Now()
+		verified.OwnerID = &ownerID
+		verified.VerifiedAt = &verifiedAt
+		pending := newClaimableVenue(domain.VenueTypeStore)
+
+		venueRepo := NewMockVenueRepository()
+		for _, venue := range []*domain.Venue{home, verified, pending} {
+			venueRepo.venues[venue.ID] = venue
+		}
+		claimRepo := new(MockVenueClaimRepository)
+		claimRepo.On("HasPendingClaim", ctx, pending.ID, claimantID).Return(true, nil)
+		useCase := NewClaimVenueUseCase(claimRepo, venueRepo)
+
+		tests := []struct {
+			name     string
+			venueID  uuid.UUID
+			evidence string
+			wantErr  error
+		}{
+			{"missing evidence", pending.ID, " ", domain.ErrVenueClaimEvidenceRequired},
+			{"unknown venue", uuid.New(), "Business registration", ErrVenueNotFound},
+			{"not a store", home.ID, "Business registration", ErrVenueNotClaimable},
+			{"already verified", verified.ID, "Business registration", ErrVenueAlreadyVerified},
+			{"duplicate claim", pending.ID, "Business registration", ErrDuplicateVenueClaim},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				claim, err := useCase.Execute(ctx, &ClaimVenueRequest{VenueID: tt.venueID, ClaimantID: claimantID, Evidence: tt.evidence})
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, claim)
+			})
+		}
+		claimRepo.AssertNotCalled(t, "CreateClaim", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReviewVenueClaimUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}
+
+	t.Run("approves a claim", func(t *testing.T) {
+		claimRepo := new(MockVenueClaimRepository)
+		userRepo := new(MockUserRepository)
+		venueRepo := NewMockVenueRepository()
+		venue := newClaimableVenue(domain.VenueTypeStore)
+		venueRepo.venues[venue.ID] = venue
+		claim := domain.NewVenueClaim(venue.ID, uuid.New(), "Business registration", time.Now())
+
+		userRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		claimRepo.On("GetClaim", ctx, claim.ID).Return(claim, nil)
+		claimRepo.On("ReviewClaim", ctx, claim).Return(nil)
+
+		reviewed, err := NewReviewVenueClaimUseCase(claimRepo, venueRepo, userRepo).Execute(ctx, &ReviewVenueClaimRequest{
+			ClaimID: claim.ID,
+			AdminID: adminID,
+			Status:  domain.VenueClaimStatusApproved,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.VenueClaimStatusApproved, reviewed.Status)
+		assert.Equal(t, &adminID, reviewed.ReviewedBy)
+		claimRepo.AssertExpectations(t)
+	})
+
+	t.Run("does not approve a claim on a verified venue", func(t *testing.T) {
+		claimRepo := new(MockVenueClaimRepository)
+		userRepo := new(MockUserRepository)
+		venueRepo := NewMockVenueRepository()
+		venue := newClaimableVenue(domain.VenueTypeStore)
+		ownerID := uuid.New()
+		verifiedAt := time.Now()
+		venue.OwnerID = &ownerID
+		venue.VerifiedAt = &verifiedAt
+		venueRepo.venues[venue.ID] = venue
+		claim := domain.NewVenueClaim(venue.ID, uuid.New(), "Business registration", time.Now())
+
+		userRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		claimRepo.On("GetClaim", ctx, claim.ID).Return(claim, nil)
+
+		_, err := NewReviewVenueClaimUseCase(claimRepo, venueRepo, userRepo).Execute(ctx, &ReviewVenueClaimRequest{
+			ClaimID: claim.ID,
+			AdminID: adminID,
+			Status:  domain.VenueClaimStatusApproved,
+		})
+
+		assert.Equal(t, ErrVenueAlreadyVerified, err)
+		claimRepo.AssertNotCalled(t, "ReviewClaim", mock.Anything, mock.Anything)
+	})
+
+	t.Run("claim reviewed concurrently by another admin", func(t *testing.T) {
+		claimRepo := new(MockVenueClaimRepository)
+		userRepo := new(MockUserRepository)
+		venueRepo := NewMockVenueRepository()
+		venue := newClaimableVenue(domain.VenueTypeStore)
+		venueRepo.venues[venue.ID] = venue
+		claim := domain.NewVenueClaim(venue.ID, uuid.New(), "Business registration", time.Now())
+
+		userRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		claimRepo.On("GetClaim", ctx, claim.ID).Return(claim, nil)
+		claimRepo.On("ReviewClaim", ctx, claim).Return(domain.ErrVenueClaimAlreadyReviewed)
+
+		_, err := NewReviewVenueClaimUseCase(claimRepo, venueRepo, userRepo).Execute(ctx, &ReviewVenueClaimRequest{
+			ClaimID: claim.ID,
+			AdminID: adminID,
+			Status:  domain.VenueClaimStatusRejected,
+		})
+
+		assert.Equal(t, domain.ErrVenueClaimAlreadyReviewed, err)
+	})
+
+	t.Run("rejects non-admins", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true}, nil)
+
+		_, err := NewReviewVenueClaimUseCase(new(MockVenueClaimRepository), NewMockVenueRepository(), userRepo).Execute(ctx, &ReviewVenueClaimRequest{
+			ClaimID: uuid.New(),
+			AdminID: adminID,
+			Status:  domain.VenueClaimStatusRejected,
+		})
+
+		assert.Equal(t, ErrPlatformAdminOnly, err)
+	})
+}
+
+func TestSetEventOfficialUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	ownerID := uuid.New()
+	verifiedAt := time.Now()
+
+	venue := newClaimableVenue(domain.VenueTypeStore)
+	venue.OwnerID = &ownerID
+	venue.VerifiedAt = &verifiedAt
+	venueRepo := NewMockVenueRepository()
+	venueRepo.venues[venue.ID] = venue
+
+	event := &domain.Event{ID: uuid.New(), HostUserID: uuid.New(), VenueID: &venue.ID}
+	elsewhere := &domain.Event{ID: uuid.New(), HostUserID: ownerID}
+
+	t.Run("owner marks an event official", func(t *testing.T) {
+		claimRepo := new(MockVenueClaimRepository)
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+		claimRepo.On("SetEventOfficial", ctx, event.ID, true).Return(nil)
+
+		updated, err := NewSetEventOfficialUseCase(claimRepo, eventRepo, venueRepo).Execute(ctx, &SetEventOfficialRequest{
+			EventID:  event.ID,
+			UserID:   ownerID,
+			Official: true,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, updated.IsOfficial)
+		claimRepo.AssertExpectations(t)
+	})
+
+	t.Run("only the venue owner can mark events", func(t *testing.T) {
+		claimRepo := new(MockVenueClaimRepository)
+		eventRepo := new(MockEventRepository)
+		eventRepo.On("GetByID", ctx, event.ID).Return(event, nil)
+		eventRepo.On("GetByID", ctx, elsewhere.ID).Return(elsewhere, nil)
+		useCase := NewSetEventOfficialUseCase(claimRepo, eventRepo, venueRepo)
+
+		_, err := useCase.Execute(ctx, &SetEventOfficialRequest{EventID: event.ID, UserID: event.HostUserID, Official: true})
+		assert.Equal(t, ErrNotVenueOwner, err)
+
+		_, err = useCase.Execute(ctx, &SetEventOfficialRequest{EventID: elsewhere.ID, UserID: ownerID, Official: true})
+		assert.Equal(t, ErrNotVenueOwner, err)
+
+		claimRepo.AssertNotCalled(t, "SetEventOfficial", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+const (
+	defaultVenueDuplicateLimit = 10
+	maxVenueDuplicateLimit     = 50
+)
+
+var (
+	ErrVerifiedVenueMerge = errors.New("a verified venue can only be merged into another venue with the same verified owner")
+)
+
+// FindVenueDuplicatesRequest represents the request for likely duplicates of a venue
+type FindVenueDuplicatesRequest struct {
+	VenueID uuid.UUID `json:"venue_id" validate:"required"`
+	Limit   int       `json:"limit"`
+}
+
+// MergeVenuesRequest represents a platform admin merging a duplicate venue into another
+type MergeVenuesRequest struct {
+	SourceID uuid.UUID `json:"source_id" validate:"required"` // Venue that is merged away
+	TargetID uuid.UUID `json:"target_id" validate:"required"` // Venue that is kept
+	AdminID  uuid.UUID `json:"admin_id" validate:"required"`  // User making the request
+}
+
+// FindVenueDuplicatesUseCase handles suggesting venues that likely duplicate another one
+type FindVenueDuplicatesUseCase struct {
+	venueRepo     repository.VenueRepository
+	duplicateRepo repository.VenueDuplicateRepository
+}
+
+// NewFindVenueDuplicatesUseCase creates a new FindVenueDuplicatesUseCase
+func NewFindVenueDuplicatesUseCase(venueRepo repository.VenueRepository, duplicateRepo repository.VenueDuplicateRepository) *FindVenueDuplicatesUseCase {
+	return &FindVenueDuplicatesUseCase{
+		venueRepo:     venueRepo,
+		duplicateRepo: duplicateRepo,
+	}
+}
+
+// Execute returns the likely duplicates of the venue, most similar first
+func (uc *FindVenueDuplicatesUseCase) Execute(ctx context.Context, req *FindVenueDuplicatesRequest) ([]*domain.VenueDuplicateCandidate, error) {
+	venue, err := uc.venueRepo.GetByID(ctx, req.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue == nil {
+		return nil, ErrVenueNotFound
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultVenueDuplicateLimit
+	}
+	if limit > maxVenueDuplicateLimit {
+		limit = maxVenueDuplicateLimit
+	}
+
+	similar, err := uc.duplicateRepo.FindSimilarVenues(ctx, venue, domain.VenueDuplicateRadiusKm, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.FindVenueDuplicates(venue, similar), nil
+}
+
+// MergeVenuesUseCase handles platform admins merging duplicate venues
+type MergeVenuesUseCase struct {
+	venueRepo     repository.VenueRepository
+	duplicateRepo repository.VenueDuplicateRepository
+	userRepo      repository.UserRepository
+	auditRecorder AuditRecorder
+}
+
+// NewMergeVenuesUseCase creates a new MergeVenuesUseCase
+func NewMergeVenuesUseCase(venueRepo repository.VenueRepository, duplicateRepo repository.VenueDuplicateRepository, userRepo repository.UserRepository) *MergeVenuesUseCase {
+	return &MergeVenuesUseCase{
+		venueRepo:     venueRepo,
+		duplicateRepo: duplicateRepo,
+		userRepo:      userRepo,
+	}
+}
+
+// Execute moves the source venue's events to the target, deletes the source and leaves a redirect from
+// its ID. The target venue is returned.
+func (uc *MergeVenuesUseCase) Execute(ctx context.Context, req *MergeVenuesRequest) (*domain.Venue, error) {
+	if err := requirePlatformAdmin(ctx, uc.userRepo, req.AdminID); err != nil {
+		return nil, err
+	}
+
+	redirect := domain.NewVenueRedirect(req.SourceID, req.TargetID, req.AdminID, time.Now().UTC())
+	if err := redirect.Validate(); err != nil {
+		return nil, err
+	}
+
+	source, err := uc.venueRepo.GetByID(ctx, req.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, ErrVenueNotFound
+	}
+
+	target, err := uc.venueRepo.GetByID(ctx, req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, ErrVenueNotFound
+	}
+
+	// Merging must not take a verified listing, and its official events, away from its owner
+	if source.IsVerified() && !target.IsOwnedBy(*source.OwnerID) {
+		return nil, ErrVerifiedVenueMerge
+	}
+
+	if err := uc.duplicateRepo.MergeVenues(ctx, redirect); err != nil {
+		return nil, err
+	}
+
+	recordAudit(ctx, uc.auditRecorder, auditChange(req.AdminID, domain.AuditActionVenueMerge, domain.AuditTargetVenue, source.ID, source.CreatedBy, source, redirect))
+
+	return target, nil
+}
+
+// VenueDuplicatesUseCase combines venue duplicate suggestions and merging
+type VenueDuplicatesUseCase struct {
+	findVenueDuplicatesUseCase *FindVenueDuplicatesUseCase
+	mergeVenuesUseCase         *MergeVenuesUseCase
+}
+
+// NewVenueDuplicatesUseCase creates a new VenueDuplicatesUseCase
+func NewVenueDuplicatesUseCase(
+	venueRepo repository.VenueRepository,
+	duplicateRepo repository.VenueDuplicateRepository,
+	userRepo repository.UserRepository,
+) *VenueDuplicatesUseCase {
+	return &VenueDuplicatesUseCase{
+		findVenueDuplicatesUseCase: NewFindVenueDuplicatesUseCase(venueRepo, duplicateRepo),
+		mergeVenuesUseCase:         NewMergeVenuesUseCase(venueRepo, duplicateRepo, userRepo),
+	}
+}
+
+// SetAuditRecorder enables audit logging of venue merges
+func (uc *VenueDuplicatesUseCase) SetAuditRecorder(recorder AuditRecorder) {
+	uc.mergeVenuesUseCase.auditRecorder = recorder
+}
+
+// FindVenueDuplicates returns venues that likely duplicate the given one
+func (uc *VenueDuplicatesUseCase) FindVenueDuplicates(ctx context.Context, req *FindVenueDuplicatesRequest) ([]*domain.VenueDuplicateCandidate, error) {
+	return uc.findVenueDuplicatesUseCase.Execute(ctx, req)
+}
+
+// MergeVenues merges a duplicate venue into another
+func (uc *VenueDuplicatesUseCase) MergeVenues(ctx context.Context, req *MergeVenuesRequest) (*domain.Venue, error) {
+	return uc.mergeVenuesUseCase.Execute(ctx, req)
+}
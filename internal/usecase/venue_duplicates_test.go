@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVenueDuplicateRepository is a mock implementation of VenueDuplicateRepository
+type MockVenueDuplicateRepository struct {
+	mock.Mock
+}
+
+func (m *MockVenueDuplicateRepository) FindSimilarVenues(ctx context.Context, venue *domain.Venue, radiusKm float64, limit int) ([]*domain.Venue, error) {
+	args := m.Called(ctx, venue, radiusKm, limit)
+	return args.Get(0).([]*domain.Venue), args.Error(1)
+}
+
+func (m *MockVenueDuplicateRepository) MergeVenues(ctx context.Context, redirect *domain.VenueRedirect) error {
+	args := m.Called(ctx, redirect)
+	return args.Error(0)
+}
+
+func (m *MockVenueDuplicateRepository) GetRedirect(ctx context.Context, fromVenueID uuid.UUID) (*domain.VenueRedirect, error) {
+	args := m.Called(ctx, fromVenueID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VenueRedirect), args.Error(1)
+}
+
+func newPortoVenue(name string) *domain.Venue {
+	creatorID := uuid.New()
+	return &domain.Venue{
+		ID:        uuid.New(),
+		Name:      name,
+		Type:      domain.VenueTypeStore,
+		Address:   "Rua de Santa Catarina 120",
+		City:      "Porto",
+		Country:   "Portugal",
+		Latitude:  41.1466,
+		Longitude: -8.6060,
+		CreatedBy: &creatorID,
+	}
+}
+
+func TestFindVenueDuplicatesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	venue := newPortoVenue("Arena Porto")
+	duplicate := newPortoVenue("Arena Porto TCG")
+	unrelated := newPortoVenue("Dragon's Den")
+	unrelated.Address = "Rua de Passos Manuel 8"
+
+	venueRepo := NewMockVenueRepository()
+	venueRepo.venues[venue.ID] = venue
+	duplicateRepo := new(MockVenueDuplicateRepository)
+	duplicateRepo.On("FindSimilarVenues", ctx, venue, domain.VenueDuplicateRadiusKm, maxVenueDuplicateLimit).
+		Return([]*domain.Venue{duplicate, unrelated}, nil)
+	useCase := NewFindVenueDuplicatesUseCase(venueRepo, duplicateRepo)
+
+	candidates, err := useCase.Execute(ctx, &FindVenueDuplicatesRequest{VenueID: venue.ID, Limit: 500})
+
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, duplicate, candidates[0].Venue)
+	assert.True(t, candidates[0].SameAddress)
+
+	_, err = useCase.Execute(ctx, &FindVenueDuplicatesRequest{VenueID: uuid.New()})
+	assert.Equal(t, ErrVenueNotFound, err)
+}
+
+func TestMergeVenuesUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &domain.User{ID: adminID, IsActive: true, PlatformRole: domain.PlatformRoleAdmin}
+
+	t.Run("merges a duplicate and records it", func(t *testing.T) {
+		source := newPortoVenue("Arena Porto TCG")
+		target := newPortoVenue("Arena Porto")
+		venueRepo := NewMockVenueRepository()
+		venueRepo.venues[source.ID] = source
+		venueRepo.venues[target.ID] = target
+
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		duplicateRepo := new(MockVenueDuplicateRepository)
+		duplicateRepo.On("MergeVenues", ctx, mock.MatchedBy(func(redirect *domain.VenueRedirect) bool {
+			return redirect.FromVenueID == source.ID && redirect.ToVenueID == target.ID && *redirect.MergedBy == adminID
+		})).Return(nil)
+		recorder := new(MockAuditRecorder)
+		recorder.On("Record", ctx, mock.MatchedBy(func(entry *domain.AuditLogEntry) bool {
+			return entry.Action == domain.AuditActionVenueMerge && entry.TargetID == source.ID
+		})).Return(nil)
+
+		useCase := NewVenueDuplicatesUseCase(venueRepo, duplicateRepo, userRepo)
+		useCase.SetAuditRecorder(recorder)
+
+		merged, err := useCase.MergeVenues(ctx, &MergeVenuesRequest{SourceID: source.ID, TargetID: target.ID, AdminID: adminID})
+
+		require.NoError(t, err)
+		assert.Equal(t, target, merged)
+		duplicateRepo.AssertExpectations(t)
+		recorder.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid merges", func(t *testing.T) {
+		ownerID := uuid.New()
+		verifiedAt := time.Now()
+		verified := newPortoVenue("Arena Porto")
+		verified.OwnerID = &ownerID
+		verified.VerifiedAt = &verifiedAt
+		target := newPortoVenue("Arena Porto TCG")
+
+		venueRepo := NewMockVenueRepository()
+		venueRepo.venues[verified.ID] = verified
+		venueRepo.venues[target.ID] = target
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", ctx, adminID).Return(admin, nil)
+		duplicateRepo := new(MockVenueDuplicateRepository)
+		useCase := NewMergeVenuesUseCase(venueRepo, duplicateRepo, userRepo)
+
+		tests := []struct {
+			name     string
+			sourceID uuid.UUID
+			targetID uuid.UUID
+			wantErr  error
+		}{
+			{"into itself", target.ID, target.ID, domain.ErrVenueMergeIntoSelf},
+			{"unknown source", uuid.New(), target.ID, ErrVenueNotFound},
+			{"unknown target", target.ID, uuid.New(), ErrVenueNotFound},
+			{"verified venue into another owner's venue", verified.ID, target.ID, ErrVerifiedVenueMerge},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				merged, err := useCase.Execute(ctx, &MergeVenuesRequest{SourceID: tt.sourceID, TargetID: tt.targetID, AdminID: adminID})
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, merged)
+			})
+		}
+		duplicateRepo.AssertNotCalled(t, "MergeVenues", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects non-admins", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		userRepo.On("GetByID", ctx, adminID).Return(&domain.User{ID: adminID, IsActive: true}, nil)
+
+		_, err := NewMergeVenuesUseCase(NewMockVenueRepository(), new(MockVenueDuplicateRepository), userRepo).Execute(ctx, &MergeVenuesRequest{
+			SourceID: uuid.New(),
+			TargetID: uuid.New(),
+			AdminID:  adminID,
+		})
+
+		assert.Equal(t, ErrPlatformAdminOnly, err)
+	})
+}
+
+func TestVenueManagementUseCase_GetVenueFollowsRedirect(t *testing.T) {
+	ctx := context.Background()
+	target := newPortoVenue("Arena Porto")
+	mergedID := uuid.New()
+
+	venueRepo := NewMockVenueRepository()
+	venueRepo.venues[target.ID] = target
+	duplicateRepo := new(MockVenueDuplicateRepository)
+	duplicateRepo.On("GetRedirect", ctx, mergedID).Return(domain.NewVenueRedirect(mergedID, target.ID, uuid.New(), time.Now()), nil)
+	duplicateRepo.On("GetRedirect", ctx, mock.Anything).Return(nil, nil)
+
+	uc := NewVenueManagementUseCase(venueRepo, service.NewGeocodingService(&service.MockGeocodingProvider{}), domain.NewGeospatialService())
+	uc.SetDuplicateRepository(duplicateRepo)
+
+	venue, err := uc.GetVenue(ctx, mergedID)
+	require.NoError(t, err)
+	assert.Equal(t, target.ID, venue.ID)
+
+	_, err = uc.GetVenue(ctx, uuid.New())
+	assert.Equal(t, ErrVenueNotFound, err)
+}
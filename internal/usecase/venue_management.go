@@ -19,13 +19,19 @@ var (
 	ErrGeocodingRequired   = errors.New("address geocoding failed")
 	ErrInvalidSearchRadius = errors.New("search radius must be between 1 and 100 km")
 	ErrInvalidSearchParams = errors.New("invalid search parameters")
+	ErrInvalidVenueSort    = errors.New("venues can only be sorted by rating")
 )
 
+// VenueSortByRating ranks venue search results by their weighted review rating
+const VenueSortByRating = "rating"
+
 // VenueManagementUseCase handles venue-related business operations
 type VenueManagementUseCase struct {
 	venueRepo         repository.VenueRepository
 	geocodingService  *service.GeocodingService
 	geospatialService *domain.GeospatialService
+	auditRecorder     AuditRecorder
+	duplicateRepo     repository.VenueDuplicateRepository
 }
 
 // NewVenueManagementUseCase creates a new venue management use case
@@ -41,6 +47,16 @@ func NewVenueManagementUseCase(
 	}
 }
 
+// SetAuditRecorder enables audit logging of venue deletions
+func (uc *VenueManagementUseCase) SetAuditRecorder(recorder AuditRecorder) {
+	uc.auditRecorder = recorder
+}
+
+// SetDuplicateRepository enables GetVenue to follow the redirects left behind by venue merges
+func (uc *VenueManagementUseCase) SetDuplicateRepository(duplicateRepo repository.VenueDuplicateRepository) {
+	uc.duplicateRepo = duplicateRepo
+}
+
 // CreateVenueRequest represents the request to create a venue
 type CreateVenueRequest struct {
 	Name      string                 `json:"name"`
@@ -52,6 +68,12 @@ type CreateVenueRequest struct {
 	Longitude *float64               `json:"longitude,omitempty"`
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 	CreatedBy uuid.UUID              `json:"created_by"`
+
+	// Opening hours are interpreted in the venue's IANA timezone, which is required alongside them
+	Timezone     *string              `json:"timezone,omitempty"`
+	OpeningHours *domain.OpeningHours `json:"opening_hours,omitempty"`
+
+	Amenities *domain.VenueAmenities `json:"amenities,omitempty"`
 }
 
 // CreateVenue creates a new venue with address geocoding
@@ -71,6 +93,10 @@ func (uc *VenueManagementUseCase) CreateVenue(ctx context.Context, req *CreateVe
 		Metadata:  req.Metadata,
 		CreatedBy: &req.CreatedBy,
 		CreatedAt: time.Now().UTC(),
+
+		Timezone:     req.Timezone,
+		OpeningHours: req.OpeningHours,
+		Amenities:    req.Amenities,
 	}
 
 	// If coordinates are provided, validate and use them
@@ -101,7 +127,7 @@ func (uc *VenueManagementUseCase) CreateVenue(ctx context.Context, req *CreateVe
 		return nil, err
 	}
 
-	// Check for duplicate venues at the same location (within 100m)
+	// Check for likely duplicates of the venue nearby
 	if venue.Latitude != 0 && venue.Longitude != 0 {
 		if err := uc.checkForDuplicateVenue(ctx, venue); err != nil {
 			return nil, err
@@ -128,6 +154,11 @@ type UpdateVenueRequest struct {
 	Longitude *float64               `json:"longitude,omitempty"`
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 	UserID    uuid.UUID              `json:"user_id"`
+
+	Timezone     *string              `json:"timezone,omitempty"`
+	OpeningHours *domain.OpeningHours `json:"opening_hours,omitempty"` // Replaces the existing hours
+
+	Amenities *domain.VenueAmenities `json:"amenities,omitempty"` // Replaces the existing amenities
 }
 
 // UpdateVenue updates an existing venue
@@ -141,8 +172,8 @@ func (uc *VenueManagementUseCase) UpdateVenue(ctx context.Context, req *UpdateVe
 		return nil, ErrVenueNotFound
 	}
 
-	// Check authorization - only creator can update venue
-	if venue.CreatedBy == nil || *venue.CreatedBy != req.UserID {
+	// Check authorization - only the verified owner, or the creator of an unclaimed venue, can update it
+	if !venue.CanBeEditedBy(req.UserID) {
 		return nil, ErrUnauthorizedVenue
 	}
 
@@ -169,6 +200,15 @@ func (uc *VenueManagementUseCase) UpdateVenue(ctx context.Context, req *UpdateVe
 	if req.Metadata != nil {
 		venue.Metadata = req.Metadata
 	}
+	if req.Timezone != nil {
+		venue.Timezone = req.Timezone
+	}
+	if req.OpeningHours != nil {
+		venue.OpeningHours = req.OpeningHours
+	}
+	if req.Amenities != nil {
+		venue.Amenities = req.Amenities
+	}
 
 	// Handle coordinate updates
 	if req.Latitude != nil && req.Longitude != nil {
@@ -203,12 +243,38 @@ func (uc *VenueManagementUseCase) UpdateVenue(ctx context.Context, req *UpdateVe
 	return venue, nil
 }
 
-// GetVenue retrieves a venue by ID with coordinate information
+// GetVenue retrieves a venue by ID with coordinate information. The ID of a venue that was merged
+// into another resolves to the venue that replaced it, so the result's ID may differ from id.
 func (uc *VenueManagementUseCase) GetVenue(ctx context.Context, id uuid.UUID) (*domain.Venue, error) {
 	venue, err := uc.venueRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get venue: %w", err)
 	}
+	if venue == nil {
+		return uc.followVenueRedirect(ctx, id)
+	}
+
+	return venue, nil
+}
+
+// followVenueRedirect resolves the ID of a merged venue to the venue it was merged into
+func (uc *VenueManagementUseCase) followVenueRedirect(ctx context.Context, id uuid.UUID) (*domain.Venue, error) {
+	if uc.duplicateRepo == nil {
+		return nil, ErrVenueNotFound
+	}
+
+	redirect, err := uc.duplicateRepo.GetRedirect(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue redirect: %w", err)
+	}
+	if redirect == nil {
+		return nil, ErrVenueNotFound
+	}
+
+	venue, err := uc.venueRepo.GetByID(ctx, redirect.ToVenueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
 	if venue == nil {
 		return nil, ErrVenueNotFound
 	}
@@ -227,8 +293,8 @@ func (uc *VenueManagementUseCase) DeleteVenue(ctx context.Context, id, userID uu
 		return ErrVenueNotFound
 	}
 
-	// Check authorization - only creator can delete venue
-	if venue.CreatedBy == nil || *venue.CreatedBy != userID {
+	// Check authorization - only the verified owner, or the creator of an unclaimed venue, can delete it
+	if !venue.CanBeEditedBy(userID) {
 		return ErrUnauthorizedVenue
 	}
 
@@ -237,6 +303,8 @@ func (uc *VenueManagementUseCase) DeleteVenue(ctx context.Context, id, userID uu
 		return fmt.Errorf("failed to delete venue: %w", err)
 	}
 
+	recordAudit(ctx, uc.auditRecorder, auditChange(userID, domain.AuditActionVenueDelete, domain.AuditTargetVenue, venue.ID, venue.CreatedBy, venue, nil))
+
 	return nil
 }
 
@@ -261,6 +329,13 @@ type SearchVenuesRequest struct {
 
 	// Creator filter
 	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+
+	// Amenity filters, such as enough seats for a large event
+	Amenities domain.VenueAmenityFilter `json:"amenities"`
+
+	// Sorting by rating or filtering by amenities applies every given filter at once; otherwise the
+	// first filter set picks the search
+	SortBy string `json:"sort_by,omitempty"`
 }
 
 // SearchVenuesResponse represents the response from venue search
@@ -287,7 +362,10 @@ func (uc *VenueManagementUseCase) SearchVenues(ctx context.Context, req *SearchV
 	var err error
 
 	// Determine search strategy based on parameters
-	if req.Latitude != nil && req.Longitude != nil && req.RadiusKm != nil {
+	if req.SortBy == VenueSortByRating || req.Amenities.IsSet() {
+		// Venues matching all filters, best rated first if asked
+		venues, err = uc.venueRepo.Search(ctx, uc.venueSearchParams(req))
+	} else if req.Latitude != nil && req.Longitude != nil && req.RadiusKm != nil {
 		// Location-based search
 		venues, err = uc.venueRepo.SearchNearby(ctx, *req.Latitude, *req.Longitude, *req.RadiusKm, req.Limit, req.Offset)
 	} else if req.Name != nil {
@@ -306,7 +384,7 @@ func (uc *VenueManagementUseCase) SearchVenues(ctx context.Context, req *SearchV
 		// Creator-based search
 		venues, err = uc.venueRepo.GetByCreator(ctx, *req.CreatedBy, req.Limit, req.Offset)
 	} else {
-		// Default to popular venues
+		// Default to the best rated venues
 		venues, err = uc.venueRepo.GetPopularVenues(ctx, req.Limit, req.Offset)
 	}
 
@@ -409,6 +487,29 @@ func (uc *VenueManagementUseCase) validateCreateVenueRequest(req *CreateVenueReq
 	return nil
 }
 
+// venueSearchParams converts a validated search request to repository search parameters
+func (uc *VenueManagementUseCase) venueSearchParams(req *SearchVenuesRequest) domain.VenueSearchParams {
+	params := domain.VenueSearchParams{
+		Name:      req.Name,
+		City:      req.City,
+		Country:   req.Country,
+		Type:      req.Type,
+		CreatedBy: req.CreatedBy,
+		Amenities: req.Amenities,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+
+		SortByRating: req.SortBy == VenueSortByRating,
+	}
+
+	if req.Latitude != nil && req.Longitude != nil {
+		params.Near = &domain.Coordinates{Latitude: *req.Latitude, Longitude: *req.Longitude}
+		params.RadiusKm = req.RadiusKm
+	}
+
+	return params
+}
+
 // validateSearchVenuesRequest validates the search venues request
 func (uc *VenueManagementUseCase) validateSearchVenuesRequest(req *SearchVenuesRequest) error {
 	if req == nil {
@@ -426,6 +527,15 @@ func (uc *VenueManagementUseCase) validateSearchVenuesRequest(req *SearchVenuesR
 		req.Offset = 0
 	}
 
+	if req.SortBy != "" && req.SortBy != VenueSortByRating {
+		return ErrInvalidVenueSort
+	}
+
+	if (req.Amenities.MinTables != nil && *req.Amenities.MinTables < 0) ||
+		(req.Amenities.MinSeats != nil && *req.Amenities.MinSeats < 0) {
+		return ErrInvalidSearchParams
+	}
+
 	// Validate location-based search parameters
 	if req.Latitude != nil || req.Longitude != nil || req.RadiusKm != nil {
 		if req.Latitude == nil || req.Longitude == nil {
@@ -449,29 +559,17 @@ func (uc *VenueManagementUseCase) validateSearchVenuesRequest(req *SearchVenuesR
 	return nil
 }
 
-// checkForDuplicateVenue checks if a venue already exists at the same location
+// checkForDuplicateVenue checks if a venue with a similar name or the same address already exists nearby
 func (uc *VenueManagementUseCase) checkForDuplicateVenue(ctx context.Context, venue *domain.Venue) error {
-	// Search for venues within 100 meters (0.1 km)
-	nearbyVenues, err := uc.venueRepo.SearchNearby(ctx, venue.Latitude, venue.Longitude, 1, 10, 0)
+	// Search for venues within 1 km; domain.MatchVenueDuplicate narrows them down by distance and name
+	nearbyVenues, err := uc.venueRepo.SearchNearby(ctx, venue.Latitude, venue.Longitude, 1, 50, 0)
 	if err != nil {
 		// If search fails, we don't block venue creation
 		return nil
 	}
 
-	for _, nearby := range nearbyVenues {
-		if nearby.ID == venue.ID {
-			continue // Skip self
-		}
-
-		distance := uc.geospatialService.CalculateDistance(
-			venue.GetCoordinates(),
-			nearby.GetCoordinates(),
-		)
-
-		// If there's a venue within 100 meters with the same name, consider it a duplicate
-		if distance < 0.1 && nearby.Name == venue.Name {
-			return ErrVenueAlreadyExists
-		}
+	if len(domain.FindVenueDuplicates(venue, nearbyVenues)) > 0 {
+		return ErrVenueAlreadyExists
 	}
 
 	return nil
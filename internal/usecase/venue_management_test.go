@@ -24,6 +24,7 @@ type MockVenueRepository struct {
 	getByCreatorFunc      func(ctx context.Context, creatorID uuid.UUID, limit, offset int) ([]*domain.Venue, error)
 	getByTypeFunc         func(ctx context.Context, venueType domain.VenueType, limit, offset int) ([]*domain.Venue, error)
 	getPopularVenuesFunc  func(ctx context.Context, limit, offset int) ([]*domain.Venue, error)
+	searchFunc            func(ctx context.Context, params domain.VenueSearchParams) ([]*domain.Venue, error)
 	getVenuesInBoundsFunc func(ctx context.Context, northLat, southLat, eastLon, westLon float64) ([]*domain.Venue, error)
 	findNearestVenueFunc  func(ctx context.Context, lat, lon float64) (*domain.Venue, error)
 	countByCityFunc       func(ctx context.Context, city string) (int, error)
@@ -164,6 +165,17 @@ func (m *MockVenueRepository) GetPopularVenues(ctx context.Context, limit, offse
 	return venues, nil
 }
 
+func (m *MockVenueRepository) Search(ctx context.Context, params domain.VenueSearchParams) ([]*domain.Venue, error) {
+	if m.searchFunc != nil {
+		return m.searchFunc(ctx, params)
+	}
+	var venues []*domain.Venue
+	for _, venue := range m.venues {
+		venues = append(venues, venue)
+	}
+	return venues, nil
+}
+
 func (m *MockVenueRepository) GetVenuesInBounds(ctx context.Context, northLat, southLat, eastLon, westLon float64) ([]*domain.Venue, error) {
 	if m.getVenuesInBoundsFunc != nil {
 		return m.getVenuesInBoundsFunc(ctx, northLat, southLat, eastLon, westLon)
@@ -292,6 +304,52 @@ func TestVenueManagementUseCase_CreateVenue(t *testing.T) {
 			},
 			expectedError: domain.ErrInvalidCoordinates,
 		},
+		{
+			name: "successful venue creation with opening hours",
+			request: &CreateVenueRequest{
+				Name:      "Card Shop",
+				Type:      domain.VenueTypeStore,
+				Address:   "789 Elm St",
+				City:      "Braga",
+				Country:   "Portugal",
+				Latitude:  func() *float64 { f := 41.5454; return &f }(),
+				Longitude: func() *float64 { f := -8.4265; return &f }(),
+				CreatedBy: userID,
+				Timezone:  func() *string { s := "Europe/Lisbon"; return &s }(),
+				OpeningHours: &domain.OpeningHours{
+					Weekly:     []domain.OpeningPeriod{{Day: "friday", Opens: "14:00", Closes: "23:00"}},
+					Exceptions: []domain.OpeningHoursException{{Date: "2025-12-25", Closed: true}},
+				},
+			},
+			expectVenue: true,
+		},
+		{
+			name: "opening hours without timezone",
+			request: &CreateVenueRequest{
+				Name:         "Card Shop",
+				Type:         domain.VenueTypeStore,
+				Address:      "789 Elm St",
+				City:         "Braga",
+				Country:      "Portugal",
+				CreatedBy:    userID,
+				OpeningHours: &domain.OpeningHours{Weekly: []domain.OpeningPeriod{{Day: "friday", Opens: "14:00", Closes: "23:00"}}},
+			},
+			expectedError: domain.ErrVenueTimezoneRequired,
+		},
+		{
+			name: "similar venue nearby",
+			request: &CreateVenueRequest{
+				Name:      "HOME VENUE!",
+				Type:      domain.VenueTypeHome,
+				Address:   "458 Oak St",
+				City:      "Porto",
+				Country:   "Portugal",
+				Latitude:  func() *float64 { f := 41.1580; return &f }(),
+				Longitude: func() *float64 { f := -8.6290; return &f }(),
+				CreatedBy: userID,
+			},
+			expectedError: ErrVenueAlreadyExists,
+		},
 	}
 
 	for _, tt := range tests {
@@ -329,6 +387,9 @@ func TestVenueManagementUseCase_CreateVenue(t *testing.T) {
 				if venue.CreatedBy == nil || *venue.CreatedBy != tt.request.CreatedBy {
 					t.Errorf("expected created_by %s, got %v", tt.request.CreatedBy, venue.CreatedBy)
 				}
+				if venue.OpeningHours != tt.request.OpeningHours {
+					t.Errorf("expected opening hours %v, got %v", tt.request.OpeningHours, venue.OpeningHours)
+				}
 			}
 		})
 	}
@@ -361,6 +422,25 @@ func TestVenueManagementUseCase_UpdateVenue(t *testing.T) {
 	}
 	mockRepo.venues[venueID] = testVenue
 
+	// A claimed store is edited by its verified owner instead of its creator
+	ownerID := uuid.New()
+	verifiedAt := time.Now().UTC()
+	claimedVenueID := uuid.New()
+	mockRepo.venues[claimedVenueID] = &domain.Venue{
+		ID:         claimedVenueID,
+		Name:       "Claimed Store",
+		Type:       domain.VenueTypeStore,
+		Address:    "1 Rua Augusta",
+		City:       "Lisbon",
+		Country:    "Portugal",
+		Latitude:   38.7101,
+		Longitude:  -9.1366,
+		CreatedBy:  &userID,
+		CreatedAt:  time.Now().UTC(),
+		OwnerID:    &ownerID,
+		VerifiedAt: &verifiedAt,
+	}
+
 	tests := []struct {
 		name          string
 		request       *UpdateVenueRequest
@@ -394,6 +474,24 @@ func TestVenueManagementUseCase_UpdateVenue(t *testing.T) {
 			},
 			expectedError: ErrUnauthorizedVenue,
 		},
+		{
+			name: "verified owner updates claimed venue",
+			request: &UpdateVenueRequest{
+				ID:     claimedVenueID,
+				Name:   func() *string { s := "Owner's Store"; return &s }(),
+				UserID: ownerID,
+			},
+			expectUpdate: true,
+		},
+		{
+			name: "creator cannot update claimed venue",
+			request: &UpdateVenueRequest{
+				ID:     claimedVenueID,
+				Name:   func() *string { s := "Creator's Store"; return &s }(),
+				UserID: userID,
+			},
+			expectedError: ErrUnauthorizedVenue,
+		},
 	}
 
 	for _, tt := range tests {
@@ -682,6 +780,49 @@ func TestVenueManagementUseCase_SearchVenues(t *testing.T) {
 			},
 			expectedError: ErrInvalidSearchRadius,
 		},
+		{
+			name: "sort by rating",
+			request: &SearchVenuesRequest{
+				City:   func() *string { s := "Porto"; return &s }(),
+				SortBy: VenueSortByRating,
+				Limit:  10,
+				Offset: 0,
+			},
+			expectResults: true,
+		},
+		{
+			name: "unsupported sort",
+			request: &SearchVenuesRequest{
+				SortBy: "distance",
+				Limit:  10,
+				Offset: 0,
+			},
+			expectedError: ErrInvalidVenueSort,
+		},
+		{
+			name: "filter by amenities",
+			request: &SearchVenuesRequest{
+				Amenities: domain.VenueAmenityFilter{MinSeats: func() *int { i := 64; return &i }(), WiFi: true},
+				Limit:     10,
+				Offset:    0,
+			},
+			expectResults: true,
+		},
+		{
+			name: "negative minimum seats",
+			request: &SearchVenuesRequest{
+				Amenities: domain.VenueAmenityFilter{MinSeats: func() *int { i := -1; return &i }()},
+				Limit:     10,
+				Offset:    0,
+			},
+			expectedError: ErrInvalidSearchParams,
+		},
+	}
+
+	var searches []domain.VenueSearchParams
+	mockRepo.searchFunc = func(ctx context.Context, params domain.VenueSearchParams) ([]*domain.Venue, error) {
+		searches = append(searches, params)
+		return []*domain.Venue{venue2}, nil
 	}
 
 	for _, tt := range tests {
@@ -716,6 +857,16 @@ func TestVenueManagementUseCase_SearchVenues(t *testing.T) {
 			}
 		})
 	}
+
+	if len(searches) != 2 {
+		t.Fatalf("expected 2 combined searches, got %d", len(searches))
+	}
+	if !searches[0].SortByRating || searches[0].City == nil || *searches[0].City != "Porto" {
+		t.Errorf("expected sorting by rating to search with the city filter, got %+v", searches[0])
+	}
+	if searches[1].SortByRating || searches[1].Amenities.MinSeats == nil || *searches[1].Amenities.MinSeats != 64 || !searches[1].Amenities.WiFi {
+		t.Errorf("expected amenity filters to be passed through, got %+v", searches[1])
+	}
 }
 
 func TestVenueManagementUseCase_GetNearestVenue(t *testing.T) {
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/matchtcg/backend/internal/repository"
+)
+
+var (
+	ErrVenueReviewNotFound = errors.New("venue review not found")
+	ErrVenueNotAttended    = errors.New("only players who attended an event at this venue can review it")
+	ErrOwnVenueReview      = errors.New("venue owners and listing creators cannot review the venue")
+)
+
+// ReviewVenueRequest represents a player rating a venue; posting again replaces their earlier review
+type ReviewVenueRequest struct {
+	VenueID uuid.UUID               `json:"venue_id" validate:"required"`
+	UserID  uuid.UUID               `json:"user_id" validate:"required"` // User making the request
+	Rating  int                     `json:"rating" validate:"required,min=1,max=5"`
+	Text    *string                 `json:"text,omitempty" validate:"omitempty,max=2000"`
+	Tags    []domain.VenueReviewTag `json:"tags,omitempty"`
+}
+
+// ListVenueReviewsRequest represents the request to read a venue's reviews
+type ListVenueReviewsRequest struct {
+	VenueID uuid.UUID `json:"venue_id" validate:"required"`
+	Limit   int       `json:"limit"`
+	Offset  int       `json:"offset"`
+}
+
+// ListVenueReviewsResponse represents a page of a venue's reviews with its rating
+type ListVenueReviewsResponse struct {
+	Reviews       []*domain.VenueReview `json:"reviews"`
+	RatingAverage float64               `json:"rating_average"`
+	RatingCount   int                   `json:"rating_count"`
+	Limit         int                   `json:"limit"`
+	Offset        int                   `json:"offset"`
+}
+
+// DeleteVenueReviewRequest represents a player removing their review of a venue
+type DeleteVenueReviewRequest struct {
+	VenueID uuid.UUID `json:"venue_id" validate:"required"`
+	UserID  uuid.UUID `json:"user_id" validate:"required"` // User making the request
+}
+
+// ReviewVenueUseCase handles players rating venues they attended events at
+type ReviewVenueUseCase struct {
+	reviewRepo repository.VenueReviewRepository
+	venueRepo  repository.VenueRepository
+}
+
+// NewReviewVenueUseCase creates a new ReviewVenueUseCase
+func NewReviewVenueUseCase(reviewRepo repository.VenueReviewRepository, venueRepo repository.VenueRepository) *ReviewVenueUseCase {
+	return &ReviewVenueUseCase{
+		reviewRepo: reviewRepo,
+		venueRepo:  venueRepo,
+	}
+}
+
+// Execute saves the player's review and returns it
+func (uc *ReviewVenueUseCase) Execute(ctx context.Context, req *ReviewVenueRequest) (*domain.VenueReview, error) {
+	now := time.Now().UTC()
+	review := domain.NewVenueReview(req.VenueID, req.UserID, req.Rating, req.Text, req.Tags, now)
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+
+	venue, err := uc.venueRepo.GetByID(ctx, req.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue == nil {
+		return nil, ErrVenueNotFound
+	}
+	if venue.IsOwnerOrCreator(req.UserID) {
+		return nil, ErrOwnVenueReview
+	}
+
+	attended, err := uc.reviewRepo.HasAttendedEventAt(ctx, req.UserID, req.VenueID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !attended {
+		return nil, ErrVenueNotAttended
+	}
+
+	existing, err := uc.reviewRepo.GetReview(ctx, req.VenueID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing.Update(req.Rating, req.Text, req.Tags, now)
+		review = existing
+	}
+
+	if err := uc.reviewRepo.UpsertReview(ctx, review); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// ListVenueReviewsUseCase handles reading a venue's reviews
+type ListVenueReviewsUseCase struct {
+	reviewRepo repository.VenueReviewRepository
+	venueRepo  repository.VenueRepository
+}
+
+// NewListVenueReviewsUseCase creates a new ListVenueReviewsUseCase
+func NewListVenueReviewsUseCase(reviewRepo repository.VenueReviewRepository, venueRepo repository.VenueRepository) *ListVenueReviewsUseCase {
+	return &ListVenueReviewsUseCase{
+		reviewRepo: reviewRepo,
+		venueRepo:  venueRepo,
+	}
+}
+
+// Execute returns the venue's reviews, newest first, with its aggregate rating
+func (uc *ListVenueReviewsUseCase) Execute(ctx context.Context, req *ListVenueReviewsRequest) (*ListVenueReviewsResponse, error) {
+	venue, err := uc.venueRepo.GetByID(ctx, req.VenueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue == nil {
+		return nil, ErrVenueNotFound
+	}
+
+	limit, offset := moderationPage(req.Limit, req.Offset)
+	reviews, err := uc.reviewRepo.GetReviews(ctx, req.VenueID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListVenueReviewsResponse{
+		Reviews:       reviews,
+		RatingAverage: venue.RatingAverage,
+		RatingCount:   venue.RatingCount,
+		Limit:         limit,
+		Offset:        offset,
+	}, nil
+}
+
+// DeleteVenueReviewUseCase handles players removing their venue review
+type DeleteVenueReviewUseCase struct {
+	reviewRepo repository.VenueReviewRepository
+}
+
+// NewDeleteVenueReviewUseCase creates a new DeleteVenueReviewUseCase
+func NewDeleteVenueReviewUseCase(reviewRepo repository.VenueReviewRepository) *DeleteVenueReviewUseCase {
+	return &DeleteVenueReviewUseCase{
+		reviewRepo: reviewRepo,
+	}
+}
+
+// Execute deletes the player's review, which drops it from the venue's rating
+func (uc *DeleteVenueReviewUseCase) Execute(ctx context.Context, req *DeleteVenueReviewRequest) error {
+	review, err := uc.reviewRepo.GetReview(ctx, req.VenueID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if review == nil {
+		return ErrVenueReviewNotFound
+	}
+
+	return uc.reviewRepo.DeleteReview(ctx, req.VenueID, req.UserID)
+}
+
+// VenueReviewsUseCase combines venue reviews and ratings
+type VenueReviewsUseCase struct {
+	reviewVenueUseCase       *ReviewVenueUseCase
+	listVenueReviewsUseCase  *ListVenueReviewsUseCase
+	deleteVenueReviewUseCase *DeleteVenueReviewUseCase
+}
+
+// NewVenueReviewsUseCase creates a new VenueReviewsUseCase
+func NewVenueReviewsUseCase(reviewRepo repository.VenueReviewRepository, venueRepo repository.VenueRepository) *VenueReviewsUseCase {
+	return &VenueReviewsUseCase{
+		reviewVenueUseCase:       NewReviewVenueUseCase(reviewRepo, venueRepo),
+		listVenueReviewsUseCase:  NewListVenueReviewsUseCase(reviewRepo, venueRepo),
+		deleteVenueReviewUseCase: NewDeleteVenueReviewUseCase(reviewRepo),
+	}
+}
+
+// ReviewVenue creates or replaces the player's review of a venue
+func (uc *VenueReviewsUseCase) ReviewVenue(ctx context.Context, req *ReviewVenueRequest) (*domain.VenueReview, error) {
+	return uc.reviewVenueUseCase.Execute(ctx, req)
+}
+
+// ListVenueReviews returns a page of a venue's reviews
+func (uc *VenueReviewsUseCase) ListVenueReviews(ctx context.Context, req *ListVenueReviewsRequest) (*ListVenueReviewsResponse, error) {
+	return uc.listVenueReviewsUseCase.Execute(ctx, req)
+}
+
+// DeleteVenueReview removes the player's review of a venue
+func (uc *VenueReviewsUseCase) DeleteVenueReview(ctx context.Context, req *DeleteVenueReviewRequest) error {
+	return uc.deleteVenueReviewUseCase.Execute(ctx, req)
+}
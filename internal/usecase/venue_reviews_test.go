@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matchtcg/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockVenueReviewRepository is a mock implementation of VenueReviewRepository
+type MockVenueReviewRepository struct {
+	mock.Mock
+}
+
+func (m *MockVenueReviewRepository) UpsertReview(ctx context.Context, review *domain.VenueReview) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockVenueReviewRepository) GetReview(ctx context.Context, venueID, userID uuid.UUID) (*domain.VenueReview, error) {
+	args := m.Called(ctx, venueID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VenueReview), args.Error(1)
+}
+
+func (m *MockVenueReviewRepository) GetReviews(ctx context.Context, venueID uuid.UUID, limit, offset int) ([]*domain.VenueReview, error) {
+	args := m.Called(ctx, venueID, limit, offset)
+	return args.Get(0).([]*domain.VenueReview), args.Error(1)
+}
+
+func (m *MockVenueReviewRepository) DeleteReview(ctx context.Context, venueID, userID uuid.UUID) error {
+	args := m.Called(ctx, venueID, userID)
+	return args.Error(0)
+}
+
+func (m *MockVenueReviewRepository) HasAttendedEventAt(ctx context.Context, userID, venueID uuid.UUID, before time.Time) (bool, error) {
+	args := m.Called(ctx, userID, venueID, before)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestReviewVenueUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	venue := newClaimableVenue(domain.VenueTypeStore)
+	venueRepo := NewMockVenueRepository()
+	venueRepo.venues[venue.ID] = venue
+
+	owned := newClaimableVenue(domain.VenueTypeStore)
+	ownerID := uuid.New()
+	verifiedAt := time.Now()
+	owned.OwnerID = &ownerID
+	owned.VerifiedAt = &verifiedAt
+	venueRepo.venues[owned.ID] = owned
+
+	t.Run("reviews an attended venue", func(t *testing.T) {
+		reviewRepo := new(MockVenueReviewRepository)
+		reviewRepo.On("HasAttendedEventAt", ctx, userID, venue.ID, mock.AnythingOfType("time.Time")).Return(true, nil)
+		reviewRepo.On("GetReview", ctx, venue.ID, userID).Return(nil, nil)
+		reviewRepo.On("UpsertReview", ctx, mock.AnythingOfType("*domain.VenueReview")).Return(nil)
+
+		review, err := NewReviewVenueUseCase(reviewRepo, venueRepo).Execute(ctx, &ReviewVenueRequest{
+			VenueID: venue.ID,
+			UserID:  userID,
+			Rating:  5,
+			Tags:    []domain.VenueReviewTag{domain.VenueReviewTagParking, domain.VenueReviewTagAccessible},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, review.Rating)
+		assert.Len(t, review.Tags, 2)
+		reviewRepo.AssertExpectations(t)
+	})
+
+	t.Run("replaces an earlier review", func(t *testing.T) {
+		earlier := domain.NewVenueReview(venue.ID, userID, 2, nil, nil, time.Now().Add(-24*time.Hour))
+		reviewRepo := new(MockVenueReviewRepository)
+		reviewRepo.On("HasAttendedEventAt", ctx, userID, venue.ID, mock.AnythingOfType("time.Time")).Return(true, nil)
+		reviewRepo.On("GetReview", ctx, venue.ID, userID).Return(earlier, nil)
+		reviewRepo.On("UpsertReview", ctx, earlier).Return(nil)
+
+		review, err := NewReviewVenueUseCase(reviewRepo, venueRepo).Execute(ctx, &ReviewVenueRequest{
+			VenueID: venue.ID,
+			UserID:  userID,
+			Rating:  4,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, earlier.ID, review.ID)
+		assert.Equal(t, 4, review.Rating)
+		reviewRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects invalid reviews", func(t *testing.T) {
+		stranger := uuid.New()
+		reviewRepo := new(MockVenueReviewRepository)
+		reviewRepo.On("HasAttendedEventAt", ctx, stranger, venue.ID, mock.AnythingOfType("time.Time")).Return(false, nil)
+		useCase := NewReviewVenueUseCase(reviewRepo, venueRepo)
+
+		tests := []struct {
+			name    string
+			req     *ReviewVenueRequest
+			wantErr error
+		}{
+			{"rating out of range", &ReviewVenueRequest{VenueID: venue.ID, UserID: userID, Rating: 6}, domain.ErrInvalidVenueRating},
+			{"unknown tag", &ReviewVenueRequest{VenueID: venue.ID, UserID: userID, Rating: 3, Tags: []domain.VenueReviewTag{"karaoke"}}, domain.ErrInvalidVenueReviewTag},
+			{"unknown venue", &ReviewVenueRequest{VenueID: uuid.New(), UserID: userID, Rating: 3}, ErrVenueNotFound},
+			{"never attended", &ReviewVenueRequest{VenueID: venue.ID, UserID: stranger, Rating: 3}, ErrVenueNotAttended},
+			{"listing creator", &ReviewVenueRequest{VenueID: venue.ID, UserID: *venue.CreatedBy, Rating: 5}, ErrOwnVenueReview},
+			{"venue owner", &ReviewVenueRequest{VenueID: owned.ID, UserID: *owned.OwnerID, Rating: 5}, ErrOwnVenueReview},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				review, err := useCase.Execute(ctx, tt.req)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, review)
+			})
+		}
+		reviewRepo.AssertNotCalled(t, "UpsertReview", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListVenueReviewsUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	venue := newClaimableVenue(domain.VenueTypeStore)
+	venue.RatingAverage = 4.5
+	venue.RatingCount = 2
+	venueRepo := NewMockVenueRepository()
+	venueRepo.venues[venue.ID] = venue
+
+	reviews := []*domain.VenueReview{domain.NewVenueReview(venue.ID, uuid.New(), 5, nil, nil, time.Now())}
+	reviewRepo := new(MockVenueReviewRepository)
+	reviewRepo.On("GetReviews", ctx, venue.ID, DefaultModerationLimit, 0).Return(reviews, nil)
+
+	result, err := NewListVenueReviewsUseCase(reviewRepo, venueRepo).Execute(ctx, &ListVenueReviewsRequest{VenueID: venue.ID})
+
+	require.NoError(t, err)
+	assert.Equal(t, reviews, result.Reviews)
+	assert.Equal(t, 4.5, result.RatingAverage)
+	assert.Equal(t, 2, result.RatingCount)
+}
+
+func TestDeleteVenueReviewUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+	venueID := uuid.New()
+	userID := uuid.New()
+
+	reviewRepo := new(MockVenueReviewRepository)
+	reviewRepo.On("GetReview", ctx, venueID, userID).Return(domain.NewVenueReview(venueID, userID, 3, nil, nil, time.Now()), nil)
+	reviewRepo.On("GetReview", ctx, venueID, mock.Anything).Return(nil, nil)
+	reviewRepo.On("DeleteReview", ctx, venueID, userID).Return(nil)
+	useCase := NewDeleteVenueReviewUseCase(reviewRepo)
+
+	require.NoError(t, useCase.Execute(ctx, &DeleteVenueReviewRequest{VenueID: venueID, UserID: userID}))
+	assert.Equal(t, ErrVenueReviewNotFound, useCase.Execute(ctx, &DeleteVenueReviewRequest{VenueID: venueID, UserID: uuid.New()}))
+	reviewRepo.AssertNumberOfCalls(t, "DeleteReview", 1)
+}
@@ -0,0 +1,8 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_group_webhooks_updated_at ON group_webhooks;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_group_webhooks_active;
+
+-- Drop group webhooks table
+DROP TABLE IF EXISTS group_webhooks;
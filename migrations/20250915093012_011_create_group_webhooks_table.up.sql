@@ -0,0 +1,23 @@
+-- Create group webhooks table
+CREATE TABLE group_webhooks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL UNIQUE REFERENCES groups(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    locale VARCHAR(5) NOT NULL DEFAULT 'pt',
+    timezone VARCHAR(50) NOT NULL DEFAULT 'UTC',
+    is_active BOOLEAN DEFAULT TRUE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    last_delivered_at TIMESTAMP WITH TIME ZONE,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_group_webhooks_active ON group_webhooks(group_id) WHERE is_active = true;
+
+-- Create trigger for group webhooks table
+CREATE TRIGGER update_group_webhooks_updated_at 
+    BEFORE UPDATE ON group_webhooks 
+    FOR EACH ROW 
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,14 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_group_invites_updated_at ON group_invites;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_group_invites_unique_pending;
+DROP INDEX IF EXISTS idx_group_invites_pending;
+DROP INDEX IF EXISTS idx_group_invites_invited_user_id;
+DROP INDEX IF EXISTS idx_group_invites_group_id;
+
+-- Drop group invites table
+DROP TABLE IF EXISTS group_invites;
+
+-- Drop group invite status enum type
+DROP TYPE IF EXISTS group_invite_status;
@@ -0,0 +1,35 @@
+-- Create group invite status enum type
+CREATE TYPE group_invite_status AS ENUM ('pending', 'accepted', 'declined', 'revoked', 'expired');
+
+-- Create group invites table
+-- Direct invites target a single user; shareable links have no invited user and may be used several times
+CREATE TABLE group_invites (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    invited_user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    inviter_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role group_role NOT NULL DEFAULT 'member',
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    status group_invite_status NOT NULL DEFAULT 'pending',
+    max_uses INTEGER CHECK (max_uses IS NULL OR max_uses > 0),
+    use_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_group_invites_group_id ON group_invites(group_id);
+CREATE INDEX idx_group_invites_invited_user_id ON group_invites(invited_user_id) WHERE invited_user_id IS NOT NULL;
+CREATE INDEX idx_group_invites_pending ON group_invites(group_id, expires_at) WHERE status = 'pending';
+
+-- Only one pending direct invite per user and group
+CREATE UNIQUE INDEX idx_group_invites_unique_pending ON group_invites(group_id, invited_user_id)
+WHERE status = 'pending' AND invited_user_id IS NOT NULL;
+
+-- Create trigger for group invites table
+CREATE TRIGGER update_group_invites_updated_at 
+    BEFORE UPDATE ON group_invites 
+    FOR EACH ROW 
+    EXECUTE FUNCTION update_updated_at_column();
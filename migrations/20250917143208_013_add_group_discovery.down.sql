@@ -0,0 +1,31 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_group_join_requests_updated_at ON group_join_requests;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_group_join_requests_unique_pending;
+DROP INDEX IF EXISTS idx_group_join_requests_user_id;
+DROP INDEX IF EXISTS idx_group_join_requests_group_pending;
+
+-- Drop group join requests table
+DROP TABLE IF EXISTS group_join_requests;
+
+-- Drop join request status enum type
+DROP TYPE IF EXISTS group_join_request_status;
+
+-- Drop group discovery indexes
+DROP INDEX IF EXISTS idx_groups_location_gist;
+DROP INDEX IF EXISTS idx_groups_games;
+DROP INDEX IF EXISTS idx_groups_public;
+
+-- Drop group discovery columns
+ALTER TABLE groups
+    DROP CONSTRAINT IF EXISTS valid_group_location,
+    DROP COLUMN IF EXISTS games,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS country,
+    DROP COLUMN IF EXISTS city,
+    DROP COLUMN IF EXISTS visibility;
+
+-- Drop group visibility enum type
+DROP TYPE IF EXISTS group_visibility;
@@ -0,0 +1,48 @@
+-- Create group visibility enum type
+CREATE TYPE group_visibility AS ENUM ('public', 'private');
+
+-- Add discovery fields to groups table
+ALTER TABLE groups
+    ADD COLUMN visibility group_visibility NOT NULL DEFAULT 'private',
+    ADD COLUMN city VARCHAR(100),
+    ADD COLUMN country VARCHAR(2),
+    ADD COLUMN latitude DECIMAL(10, 8),
+    ADD COLUMN longitude DECIMAL(11, 8),
+    ADD COLUMN games TEXT[] NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT valid_group_location CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+-- Create indexes for group discovery
+CREATE INDEX idx_groups_public ON groups(visibility) WHERE visibility = 'public' AND is_active = true;
+CREATE INDEX idx_groups_games ON groups USING GIN(games);
+CREATE INDEX idx_groups_location_gist ON groups USING GIST((ST_MakePoint(longitude, latitude)::geography))
+WHERE latitude IS NOT NULL AND longitude IS NOT NULL;
+
+-- Create join request status enum type
+CREATE TYPE group_join_request_status AS ENUM ('pending', 'approved', 'rejected', 'cancelled');
+
+-- Create group join requests table
+CREATE TABLE group_join_requests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    message TEXT,
+    status group_join_request_status NOT NULL DEFAULT 'pending',
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_group_join_requests_group_pending ON group_join_requests(group_id, created_at) WHERE status = 'pending';
+CREATE INDEX idx_group_join_requests_user_id ON group_join_requests(user_id);
+
+-- Only one pending request per user and group
+CREATE UNIQUE INDEX idx_group_join_requests_unique_pending ON group_join_requests(group_id, user_id)
+WHERE status = 'pending';
+
+-- Create trigger for group join requests table
+CREATE TRIGGER update_group_join_requests_updated_at 
+    BEFORE UPDATE ON group_join_requests 
+    FOR EACH ROW 
+    EXECUTE FUNCTION update_updated_at_column();
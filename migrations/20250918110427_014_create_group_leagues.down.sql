@@ -0,0 +1,12 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_group_leagues_updated_at ON group_leagues;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_league_results_user_id;
+DROP INDEX IF EXISTS idx_group_leagues_season;
+DROP INDEX IF EXISTS idx_group_leagues_group_id;
+
+-- Drop league tables
+DROP TABLE IF EXISTS league_results;
+DROP TABLE IF EXISTS league_events;
+DROP TABLE IF EXISTS group_leagues;
@@ -0,0 +1,48 @@
+-- Create group leagues table
+-- A league runs for one season and scores linked group events with its point scheme
+CREATE TABLE group_leagues (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    season_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    season_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    placement_points INTEGER[] NOT NULL DEFAULT '{}',
+    participation_points INTEGER NOT NULL DEFAULT 0 CHECK (participation_points >= 0),
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT valid_league_season CHECK (season_end > season_start)
+);
+
+-- Create league events table
+-- An event counts towards at most one league
+CREATE TABLE league_events (
+    league_id UUID NOT NULL REFERENCES group_leagues(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL UNIQUE REFERENCES events(id) ON DELETE CASCADE,
+    linked_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (league_id, event_id)
+);
+
+-- Create league results table
+CREATE TABLE league_results (
+    league_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    placement INTEGER CHECK (placement IS NULL OR placement > 0),
+    recorded_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recorded_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (league_id, event_id, user_id),
+    FOREIGN KEY (league_id, event_id) REFERENCES league_events(league_id, event_id) ON DELETE CASCADE
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_group_leagues_group_id ON group_leagues(group_id);
+CREATE INDEX idx_group_leagues_season ON group_leagues(season_start, season_end);
+CREATE INDEX idx_league_results_user_id ON league_results(user_id);
+
+-- Create trigger for group leagues table
+CREATE TRIGGER update_group_leagues_updated_at 
+    BEFORE UPDATE ON group_leagues 
+    FOR EACH ROW 
+    EXECUTE FUNCTION update_updated_at_column();
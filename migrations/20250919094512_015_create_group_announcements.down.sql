@@ -0,0 +1,9 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_group_announcements_updated_at ON group_announcements;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_group_announcements_expires_at;
+DROP INDEX IF EXISTS idx_group_announcements_board;
+
+-- Drop group announcements table
+DROP TABLE IF EXISTS group_announcements;
@@ -0,0 +1,26 @@
+-- Create group announcements table
+-- Announcements are visible to group members only; expired announcements stay visible to admins
+CREATE TABLE group_announcements (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    author_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    is_pinned BOOLEAN NOT NULL DEFAULT false,
+    pinned_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT valid_announcement_pin CHECK (is_pinned = (pinned_at IS NOT NULL)),
+    CONSTRAINT valid_announcement_expiry CHECK (expires_at IS NULL OR expires_at > created_at)
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_group_announcements_board ON group_announcements(group_id, is_pinned DESC, created_at DESC);
+CREATE INDEX idx_group_announcements_expires_at ON group_announcements(expires_at) WHERE expires_at IS NOT NULL;
+
+-- Create trigger for group announcements table
+CREATE TRIGGER update_group_announcements_updated_at 
+    BEFORE UPDATE ON group_announcements 
+    FOR EACH ROW 
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,11 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_group_moderation_actions_target;
+DROP INDEX IF EXISTS idx_group_moderation_actions_group;
+DROP INDEX IF EXISTS idx_group_bans_user_id;
+
+-- Drop group moderation tables
+DROP TABLE IF EXISTS group_moderation_actions;
+DROP TABLE IF EXISTS group_bans;
+
+-- Drop group moderation action enum type
+DROP TYPE IF EXISTS group_moderation_action;
@@ -0,0 +1,34 @@
+-- Create group moderation action enum type
+CREATE TYPE group_moderation_action AS ENUM ('remove_member', 'ban', 'unban');
+
+-- Create group bans table
+-- A user has at most one ban per group; banning again replaces the reason and expiry
+CREATE TABLE group_bans (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    banned_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT unique_group_ban UNIQUE (group_id, user_id),
+    CONSTRAINT valid_ban_expiry CHECK (expires_at IS NULL OR expires_at > created_at)
+);
+
+-- Create group moderation actions table
+-- Append-only audit trail of removals, bans and unbans performed by group admins
+CREATE TABLE group_moderation_actions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    actor_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action group_moderation_action NOT NULL,
+    reason TEXT,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_group_bans_user_id ON group_bans(user_id);
+CREATE INDEX idx_group_moderation_actions_group ON group_moderation_actions(group_id, created_at DESC);
+CREATE INDEX idx_group_moderation_actions_target ON group_moderation_actions(group_id, target_user_id);
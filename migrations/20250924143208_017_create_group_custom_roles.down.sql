@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_group_custom_roles_updated_at ON group_custom_roles;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_group_members_custom_role_id;
+
+-- Drop custom role assignment
+ALTER TABLE group_members DROP COLUMN IF EXISTS custom_role_id;
+
+-- Drop group custom roles table
+DROP TABLE IF EXISTS group_custom_roles;
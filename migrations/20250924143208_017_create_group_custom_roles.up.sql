@@ -0,0 +1,25 @@
+-- Create group custom roles table
+-- Custom roles grant members permissions on top of their base owner/admin/member role
+CREATE TABLE group_custom_roles (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    description TEXT,
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    CONSTRAINT unique_group_custom_role_name UNIQUE (group_id, name)
+);
+
+-- Members hold at most one custom role; deleting the role unassigns it
+ALTER TABLE group_members
+    ADD COLUMN custom_role_id UUID REFERENCES group_custom_roles(id) ON DELETE SET NULL;
+
+-- Create indexes for performance
+CREATE INDEX idx_group_members_custom_role_id ON group_members(custom_role_id) WHERE custom_role_id IS NOT NULL;
+
+-- Create trigger for group custom roles table
+CREATE TRIGGER update_group_custom_roles_updated_at
+    BEFORE UPDATE ON group_custom_roles
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,11 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_event_cohosts_updated_at ON event_cohosts;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_event_cohosts_user_status;
+
+-- Drop event co-hosts table
+DROP TABLE IF EXISTS event_cohosts;
+
+-- Drop event co-host status enum type
+DROP TYPE IF EXISTS event_cohost_status;
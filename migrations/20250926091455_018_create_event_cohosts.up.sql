@@ -0,0 +1,25 @@
+-- Create event co-host status enum type
+CREATE TYPE event_cohost_status AS ENUM ('pending', 'accepted', 'declined');
+
+-- Create event co-hosts table
+-- Hosts delegate scoped rights (edit, manage attendees, check-in) that apply once the invitation is accepted
+CREATE TABLE event_cohosts (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    invited_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    status event_cohost_status NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    responded_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (event_id, user_id)
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_event_cohosts_user_status ON event_cohosts(user_id, status);
+
+-- Create trigger for event co-hosts table
+CREATE TRIGGER update_event_cohosts_updated_at
+    BEFORE UPDATE ON event_cohosts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,10 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_event_invite_links_updated_at ON event_invite_links;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_event_guests_user_id;
+DROP INDEX IF EXISTS idx_event_invite_links_event_id;
+
+-- Drop event guest list tables
+DROP TABLE IF EXISTS event_guests;
+DROP TABLE IF EXISTS event_invite_links;
@@ -0,0 +1,35 @@
+-- Create event invite links table
+-- Shareable links add whoever redeems them to a private event's guest list; only the token hash is stored
+CREATE TABLE event_invite_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    max_uses INTEGER CHECK (max_uses IS NULL OR max_uses > 0),
+    use_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create event guests table
+-- Guests of a private event can view it and RSVP
+CREATE TABLE event_guests (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    invited_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    invite_link_id UUID REFERENCES event_invite_links(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_event_invite_links_event_id ON event_invite_links(event_id);
+CREATE INDEX idx_event_guests_user_id ON event_guests(user_id);
+
+-- Create trigger for event invite links table
+CREATE TRIGGER update_event_invite_links_updated_at
+    BEFORE UPDATE ON event_invite_links
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_user_blocks_blocked;
+
+-- Drop user blocks table
+DROP TABLE IF EXISTS user_blocks;
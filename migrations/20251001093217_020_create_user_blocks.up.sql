@@ -0,0 +1,12 @@
+-- Create user blocks table
+-- A block hides the two users from each other's profiles, attendee lists and invitations
+CREATE TABLE user_blocks (
+    blocker_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT user_blocks_not_self CHECK (blocker_id <> blocked_id)
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_user_blocks_blocked ON user_blocks(blocked_id);
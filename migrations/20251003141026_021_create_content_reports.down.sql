@@ -0,0 +1,27 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_reports_updated_at ON reports;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_events_hidden;
+DROP INDEX IF EXISTS idx_content_moderation_actions_target;
+DROP INDEX IF EXISTS idx_content_moderation_actions_created;
+DROP INDEX IF EXISTS idx_reports_open_per_reporter;
+DROP INDEX IF EXISTS idx_reports_target;
+DROP INDEX IF EXISTS idx_reports_status_created;
+
+-- Drop tables
+DROP TABLE IF EXISTS content_moderation_actions;
+DROP TABLE IF EXISTS reports;
+
+-- Drop report enum types
+DROP TYPE IF EXISTS content_moderation_action;
+DROP TYPE IF EXISTS report_status;
+DROP TYPE IF EXISTS report_reason;
+DROP TYPE IF EXISTS report_target_type;
+
+-- Drop moderation columns
+ALTER TABLE events DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE users DROP COLUMN IF EXISTS platform_role;
+
+-- Drop platform role enum type
+DROP TYPE IF EXISTS platform_role;
@@ -0,0 +1,58 @@
+-- Create platform role enum type
+-- Platform roles are for operators of the service and are unrelated to group roles
+CREATE TYPE platform_role AS ENUM ('user', 'moderator', 'admin');
+
+ALTER TABLE users ADD COLUMN platform_role platform_role NOT NULL DEFAULT 'user';
+
+-- Events hidden by platform moderators are kept but no longer listed
+ALTER TABLE events ADD COLUMN hidden_at TIMESTAMP WITH TIME ZONE;
+
+-- Create report enum types
+CREATE TYPE report_target_type AS ENUM ('event', 'group', 'venue', 'user');
+CREATE TYPE report_reason AS ENUM ('spam', 'harassment', 'inappropriate_content', 'scam', 'misleading_information', 'other');
+CREATE TYPE report_status AS ENUM ('open', 'resolved', 'dismissed');
+CREATE TYPE content_moderation_action AS ENUM ('hide_event', 'deactivate_group', 'remove_venue', 'deactivate_user');
+
+-- Create reports table
+-- Targets are polymorphic so reports outlive the content they are about
+CREATE TABLE reports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    reporter_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    target_type report_target_type NOT NULL,
+    target_id UUID NOT NULL,
+    reason report_reason NOT NULL,
+    details TEXT,
+    status report_status NOT NULL DEFAULT 'open',
+    resolved_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    resolution_note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create content moderation actions table
+-- Append-only audit log of actions platform moderators take on reported content
+CREATE TABLE content_moderation_actions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action content_moderation_action NOT NULL,
+    target_type report_target_type NOT NULL,
+    target_id UUID NOT NULL,
+    report_id UUID REFERENCES reports(id) ON DELETE SET NULL,
+    note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_reports_status_created ON reports(status, created_at);
+CREATE INDEX idx_reports_target ON reports(target_type, target_id);
+CREATE UNIQUE INDEX idx_reports_open_per_reporter ON reports(reporter_id, target_type, target_id) WHERE status = 'open';
+CREATE INDEX idx_content_moderation_actions_created ON content_moderation_actions(created_at DESC);
+CREATE INDEX idx_content_moderation_actions_target ON content_moderation_actions(target_type, target_id);
+CREATE INDEX idx_events_hidden ON events(hidden_at) WHERE hidden_at IS NOT NULL;
+
+-- Create trigger for reports table
+CREATE TRIGGER update_reports_updated_at
+    BEFORE UPDATE ON reports
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
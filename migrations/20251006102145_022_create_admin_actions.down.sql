@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_users_platform_role;
+DROP INDEX IF EXISTS idx_admin_actions_target_user;
+DROP INDEX IF EXISTS idx_admin_actions_created;
+
+-- Drop tables
+DROP TABLE IF EXISTS admin_actions;
+
+-- Drop admin action enum type
+DROP TYPE IF EXISTS admin_action;
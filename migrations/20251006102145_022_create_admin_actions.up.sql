@@ -0,0 +1,19 @@
+-- Create admin action enum type
+CREATE TYPE admin_action AS ENUM ('deactivate_user', 'reactivate_user', 'change_role', 'impersonate_user', 'resend_notification');
+
+-- Create admin actions table
+-- Append-only audit trail of account operations performed through the admin API
+CREATE TABLE admin_actions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    admin_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    action admin_action NOT NULL,
+    target_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT,
+    metadata JSONB,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_admin_actions_created ON admin_actions(created_at DESC);
+CREATE INDEX idx_admin_actions_target_user ON admin_actions(target_user_id, created_at DESC);
+CREATE INDEX idx_users_platform_role ON users(platform_role) WHERE platform_role <> 'user';
@@ -0,0 +1,13 @@
+-- Drop trigger and function
+DROP TRIGGER IF EXISTS prevent_audit_log_update ON audit_log;
+DROP FUNCTION IF EXISTS prevent_audit_log_update();
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_audit_log_request;
+DROP INDEX IF EXISTS idx_audit_log_target;
+DROP INDEX IF EXISTS idx_audit_log_subject;
+DROP INDEX IF EXISTS idx_audit_log_actor;
+DROP INDEX IF EXISTS idx_audit_log_created;
+
+-- Drop tables
+DROP TABLE IF EXISTS audit_log;
@@ -0,0 +1,36 @@
+-- Create audit log table
+-- Append-only record of security and data changes. There are no foreign keys to users so
+-- entries outlive the accounts and records they describe; retention removes them explicitly.
+CREATE TABLE audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor_id UUID,
+    action VARCHAR(50) NOT NULL,
+    target_type VARCHAR(20) NOT NULL,
+    target_id UUID NOT NULL,
+    subject_user_id UUID,
+    before_state JSONB,
+    after_state JSONB,
+    request_id VARCHAR(64),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_audit_log_created ON audit_log(created_at DESC);
+CREATE INDEX idx_audit_log_actor ON audit_log(actor_id, created_at DESC) WHERE actor_id IS NOT NULL;
+CREATE INDEX idx_audit_log_subject ON audit_log(subject_user_id, created_at DESC) WHERE subject_user_id IS NOT NULL;
+CREATE INDEX idx_audit_log_target ON audit_log(target_type, target_id, created_at DESC);
+CREATE INDEX idx_audit_log_request ON audit_log(request_id) WHERE request_id IS NOT NULL;
+
+-- Create trigger function rejecting changes to recorded entries
+CREATE OR REPLACE FUNCTION prevent_audit_log_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log entries are immutable';
+END;
+$$ language 'plpgsql';
+
+-- Create trigger for audit log table
+CREATE TRIGGER prevent_audit_log_update
+    BEFORE UPDATE ON audit_log
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_audit_log_update();
@@ -0,0 +1,9 @@
+-- Drop trigger and function
+DROP TRIGGER IF EXISTS prevent_consents_update ON consents;
+DROP FUNCTION IF EXISTS prevent_consents_update();
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_consents_user_type_created;
+
+-- Drop tables
+DROP TABLE IF EXISTS consents;
@@ -0,0 +1,53 @@
+-- Create consents table
+-- Append-only history of consent grants and revocations. A user's current consent for a type
+-- is the most recent row for that type.
+CREATE TABLE consents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    consent_type VARCHAR(50) NOT NULL,
+    granted BOOLEAN NOT NULL,
+    policy_version VARCHAR(50) NOT NULL,
+    ip_address VARCHAR(64),
+    user_agent VARCHAR(512),
+    metadata JSONB,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_consents_user_type_created ON consents(user_id, consent_type, created_at DESC);
+
+-- Create trigger function rejecting changes to recorded consents
+CREATE OR REPLACE FUNCTION prevent_consents_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'consent records are immutable';
+END;
+$$ language 'plpgsql';
+
+-- Create trigger for consents table
+CREATE TRIGGER prevent_consents_update
+    BEFORE UPDATE ON consents
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_consents_update();
+
+-- Move consents previously stored in profile communication preferences
+INSERT INTO consents (user_id, consent_type, granted, policy_version, metadata, created_at)
+SELECT p.user_id,
+       substring(pref.key FROM 9),
+       COALESCE((pref.value->>'granted')::BOOLEAN, false),
+       'legacy',
+       pref.value->'metadata',
+       COALESCE((pref.value->>'created_at')::TIMESTAMP WITH TIME ZONE, NOW())
+FROM profiles p, jsonb_each(p.communication_preferences) AS pref
+WHERE pref.key LIKE 'consent\_%' AND jsonb_typeof(pref.value) = 'object';
+
+UPDATE profiles
+SET communication_preferences = (
+    SELECT COALESCE(jsonb_object_agg(pref.key, pref.value), '{}'::jsonb)
+    FROM jsonb_each(communication_preferences) AS pref
+    WHERE pref.key NOT LIKE 'consent\_%'
+)
+WHERE EXISTS (
+    SELECT 1 FROM jsonb_object_keys(communication_preferences) AS pref_key
+    WHERE pref_key LIKE 'consent\_%'
+);
@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_data_exports_expires;
+DROP INDEX IF EXISTS idx_data_exports_pending;
+DROP INDEX IF EXISTS idx_data_exports_user_created;
+
+-- Drop tables
+DROP TABLE IF EXISTS data_exports;
+
+-- Drop types
+DROP TYPE IF EXISTS data_export_status;
@@ -0,0 +1,22 @@
+-- Create data export status enum type
+CREATE TYPE data_export_status AS ENUM ('pending', 'processing', 'ready', 'failed');
+
+-- Create data exports table
+-- Each row is one requested archive of a user's data. The archive itself is stored outside the
+-- database under storage_key and is downloadable until expires_at.
+CREATE TABLE data_exports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status data_export_status NOT NULL DEFAULT 'pending',
+    storage_key VARCHAR(255),
+    file_size BIGINT,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Create indexes for performance
+CREATE INDEX idx_data_exports_user_created ON data_exports(user_id, created_at DESC);
+CREATE INDEX idx_data_exports_pending ON data_exports(created_at) WHERE status = 'pending';
+CREATE INDEX idx_data_exports_expires ON data_exports(expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,13 @@
+-- Drop account deletion columns
+ALTER TABLE users DROP COLUMN IF EXISTS anonymized_at;
+ALTER TABLE events DROP COLUMN IF EXISTS cancelled_at;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_account_deletions_due;
+DROP INDEX IF EXISTS idx_account_deletions_scheduled_user;
+
+-- Drop tables
+DROP TABLE IF EXISTS account_deletions;
+
+-- Drop types
+DROP TYPE IF EXISTS account_deletion_status;
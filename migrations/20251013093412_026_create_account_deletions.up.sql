@@ -0,0 +1,26 @@
+-- Create account deletion status enum type
+CREATE TYPE account_deletion_status AS ENUM ('scheduled', 'cancelled', 'completed');
+
+-- Create account deletions table
+-- A deletion is scheduled with a grace period during which the user can cancel it. Once carried
+-- out, report records which hosted events and groups were handed over, cancelled or kept.
+CREATE TABLE account_deletions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status account_deletion_status NOT NULL DEFAULT 'scheduled',
+    requested_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    scheduled_for TIMESTAMP WITH TIME ZONE NOT NULL,
+    cancelled_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    report JSONB
+);
+
+-- Create indexes for performance
+CREATE UNIQUE INDEX idx_account_deletions_scheduled_user ON account_deletions(user_id) WHERE status = 'scheduled';
+CREATE INDEX idx_account_deletions_due ON account_deletions(scheduled_for) WHERE status = 'scheduled';
+
+-- Events whose host deleted their account are cancelled rather than deleted
+ALTER TABLE events ADD COLUMN cancelled_at TIMESTAMP WITH TIME ZONE;
+
+-- Deleted accounts are kept as anonymized rows so past events and shared group records survive
+ALTER TABLE users ADD COLUMN anonymized_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,4 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_notifications_processed_created;
+DROP INDEX IF EXISTS idx_users_last_activity;
+DROP INDEX IF EXISTS idx_events_end_at;
@@ -0,0 +1,6 @@
+-- Support retention sweeps over past events and inactive accounts
+CREATE INDEX idx_events_end_at ON events(end_at);
+CREATE INDEX idx_users_last_activity ON users((COALESCE(last_login, created_at))) WHERE anonymized_at IS NULL;
+
+-- Support retention sweeps over processed notifications
+CREATE INDEX idx_notifications_processed_created ON notifications(created_at) WHERE status <> 'pending';
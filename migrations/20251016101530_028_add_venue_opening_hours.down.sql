@@ -0,0 +1,3 @@
+-- Drop venue opening hours columns
+ALTER TABLE venues DROP COLUMN IF EXISTS opening_hours;
+ALTER TABLE venues DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,4 @@
+-- Add structured opening hours to venues
+-- Hours are wall clock times interpreted in the venue's IANA timezone
+ALTER TABLE venues ADD COLUMN timezone VARCHAR(50);
+ALTER TABLE venues ADD COLUMN opening_hours JSONB;
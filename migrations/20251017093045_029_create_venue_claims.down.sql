@@ -0,0 +1,17 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_venue_claims_updated_at ON venue_claims;
+
+-- Drop ownership columns
+ALTER TABLE events DROP COLUMN IF EXISTS is_official;
+ALTER TABLE venues DROP COLUMN IF EXISTS verified_at;
+ALTER TABLE venues DROP COLUMN IF EXISTS owner_id;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_venue_claims_status_created;
+DROP INDEX IF EXISTS idx_venue_claims_pending;
+
+-- Drop tables
+DROP TABLE IF EXISTS venue_claims;
+
+-- Drop venue claim status enum type
+DROP TYPE IF EXISTS venue_claim_status;
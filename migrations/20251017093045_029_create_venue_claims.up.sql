@@ -0,0 +1,34 @@
+-- Create venue claim status enum type
+CREATE TYPE venue_claim_status AS ENUM ('pending', 'approved', 'rejected');
+
+-- Create venue claims table
+-- Store owners claim a venue with evidence; platform admins approve or reject the claim
+CREATE TABLE venue_claims (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    venue_id UUID NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    claimant_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    evidence TEXT NOT NULL,
+    status venue_claim_status NOT NULL DEFAULT 'pending',
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- A claimant can have only one pending claim per venue
+CREATE UNIQUE INDEX idx_venue_claims_pending ON venue_claims(venue_id, claimant_id) WHERE status = 'pending';
+CREATE INDEX idx_venue_claims_status_created ON venue_claims(status, created_at);
+
+-- Verified owner of a venue, set when a claim is approved
+ALTER TABLE venues ADD COLUMN owner_id UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE venues ADD COLUMN verified_at TIMESTAMP WITH TIME ZONE;
+
+-- Events marked official by the verified owner of their venue
+ALTER TABLE events ADD COLUMN is_official BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create trigger for venue_claims table
+CREATE TRIGGER update_venue_claims_updated_at
+    BEFORE UPDATE ON venue_claims
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
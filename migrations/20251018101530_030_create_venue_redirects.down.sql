@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_venues_address_trgm;
+DROP INDEX IF EXISTS idx_venue_redirects_to_venue;
+
+-- Drop tables
+DROP TABLE IF EXISTS venue_redirects;
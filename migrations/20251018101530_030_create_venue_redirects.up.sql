@@ -0,0 +1,13 @@
+-- Create venue redirects table
+-- When a duplicate venue is merged away its ID keeps pointing at the venue that replaced it
+CREATE TABLE venue_redirects (
+    from_venue_id UUID PRIMARY KEY,
+    to_venue_id UUID NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    merged_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    merged_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_venue_redirects_to_venue ON venue_redirects(to_venue_id);
+
+-- Trigram index on addresses for duplicate suggestions
+CREATE INDEX idx_venues_address_trgm ON venues USING GIN(address gin_trgm_ops);
@@ -0,0 +1,14 @@
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_venue_reviews_updated_at ON venue_reviews;
+
+-- Drop rating aggregates
+DROP INDEX IF EXISTS idx_venues_rating;
+ALTER TABLE venues DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE venues DROP COLUMN IF EXISTS rating_average;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_venue_reviews_user;
+DROP INDEX IF EXISTS idx_venue_reviews_venue_created;
+
+-- Drop tables
+DROP TABLE IF EXISTS venue_reviews;
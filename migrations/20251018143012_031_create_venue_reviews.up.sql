@@ -0,0 +1,28 @@
+-- Create venue reviews table
+-- Players who attended an event at a venue rate it once; posting again replaces their review
+CREATE TABLE venue_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    venue_id UUID NOT NULL REFERENCES venues(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    text TEXT,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(venue_id, user_id)
+);
+
+CREATE INDEX idx_venue_reviews_venue_created ON venue_reviews(venue_id, created_at DESC);
+CREATE INDEX idx_venue_reviews_user ON venue_reviews(user_id);
+
+-- Rating aggregates kept on the venue so searches can sort by them
+ALTER TABLE venues ADD COLUMN rating_average NUMERIC(3, 2) NOT NULL DEFAULT 0;
+ALTER TABLE venues ADD COLUMN rating_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_venues_rating ON venues(rating_average DESC, rating_count DESC);
+
+-- Create trigger for venue_reviews table
+CREATE TRIGGER update_venue_reviews_updated_at
+    BEFORE UPDATE ON venue_reviews
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
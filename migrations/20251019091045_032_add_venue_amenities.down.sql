@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_venues_seats;
+DROP INDEX IF EXISTS idx_venues_amenities;
+
+-- Drop amenities
+ALTER TABLE venues DROP COLUMN IF EXISTS amenities;
@@ -0,0 +1,6 @@
+-- Add structured amenities to venues
+-- Counts are stored as JSON numbers and left out when the venue hasn't listed them
+ALTER TABLE venues ADD COLUMN amenities JSONB;
+
+CREATE INDEX idx_venues_amenities ON venues USING GIN (amenities jsonb_path_ops);
+CREATE INDEX idx_venues_seats ON venues (((amenities->>'seats')::int)) WHERE amenities ? 'seats';
@@ -0,0 +1,5 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_audit_log_impersonator;
+
+-- Drop impersonator
+ALTER TABLE audit_log DROP COLUMN IF EXISTS impersonator_id;
@@ -0,0 +1,4 @@
+-- Record the admin behind changes made through an impersonation token
+ALTER TABLE audit_log ADD COLUMN impersonator_id UUID;
+
+CREATE INDEX idx_audit_log_impersonator ON audit_log(impersonator_id, created_at DESC) WHERE impersonator_id IS NOT NULL;
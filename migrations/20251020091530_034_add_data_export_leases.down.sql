@@ -0,0 +1,6 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_data_exports_processing;
+
+-- Drop lease columns
+ALTER TABLE data_exports DROP COLUMN IF EXISTS attempts;
+ALTER TABLE data_exports DROP COLUMN IF EXISTS started_at;
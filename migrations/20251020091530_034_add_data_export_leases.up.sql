@@ -0,0 +1,5 @@
+-- Track when an export was claimed so exports left in processing by a crashed worker can be recovered
+ALTER TABLE data_exports ADD COLUMN started_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE data_exports ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_data_exports_processing ON data_exports(started_at) WHERE status = 'processing';
@@ -0,0 +1,2 @@
+-- Drop check-in column
+ALTER TABLE event_rsvp DROP COLUMN IF EXISTS checked_in_at;
@@ -0,0 +1,2 @@
+-- Record when attendees were checked in at the event
+ALTER TABLE event_rsvp ADD COLUMN checked_in_at TIMESTAMP WITH TIME ZONE;